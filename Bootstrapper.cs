@@ -17,6 +17,7 @@ using HyPrism.Services.Game.Download;
 using HyPrism.Services.Game.Instance;
 using HyPrism.Services.Game.Launch;
 using HyPrism.Services.Game.Mod;
+using HyPrism.Services.Game.Settings;
 using HyPrism.Services.Game.Sources;
 using HyPrism.Services.Game.Version;
 
@@ -45,28 +46,70 @@ public static class Bootstrapper
             var appDir = UtilityService.GetEffectiveAppDir();
             services.AddSingleton(new AppPathConfiguration(appDir));
 
-            services.AddSingleton(_ => 
-            {
-                var client = new HttpClient
-                {
-                    Timeout = TimeSpan.FromMinutes(30)
-                };
-                client.DefaultRequestHeaders.Add("User-Agent", "HyPrism/1.0");
-                return client;
-            });
+            // All services share one proxy-aware client; per-operation timeouts come from NetworkPolicy
+            services.AddSingleton(sp =>
+                new NetworkPolicy(sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton(sp =>
+                new BandwidthService(sp.GetRequiredService<AppPathConfiguration>().AppDir));
+            services.AddSingleton<IBandwidthService>(sp => sp.GetRequiredService<BandwidthService>());
+            services.AddSingleton(sp =>
+                new HttpClientFactory(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IBandwidthService>()));
+            services.AddSingleton(sp =>
+                sp.GetRequiredService<HttpClientFactory>().Create(
+                    sp.GetRequiredService<NetworkPolicy>().GetTimeout(RequestClass.LargeDownload)));
 
             // Config
             services.AddSingleton<ConfigService>(sp =>
                 new ConfigService(sp.GetRequiredService<AppPathConfiguration>().AppDir));
             services.AddSingleton<IConfigService>(sp => sp.GetRequiredService<ConfigService>());
 
+            services.AddSingleton(sp =>
+                new SecretStore(sp.GetRequiredService<AppPathConfiguration>().AppDir));
+            services.AddSingleton<ISecretStore>(sp => sp.GetRequiredService<SecretStore>());
+
+            services.AddSingleton<LogStreamService>();
+            services.AddSingleton<ILogStreamService>(sp => sp.GetRequiredService<LogStreamService>());
+
+            services.AddSingleton(sp =>
+                new ConnectivityService(
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton<IConnectivityService>(sp => sp.GetRequiredService<ConnectivityService>());
+
             #endregion
 
             #region Data & Utility Services
 
-            services.AddSingleton<NewsService>();
+            services.AddSingleton(sp =>
+                new NewsService(
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir));
             services.AddSingleton<INewsService>(sp => sp.GetRequiredService<NewsService>());
 
+            services.AddSingleton(sp =>
+                new ChangelogService(
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<INewsService>()));
+            services.AddSingleton<IChangelogService>(sp => sp.GetRequiredService<ChangelogService>());
+
+            services.AddSingleton(sp =>
+                new AnnouncementService(
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>(),
+                    sp.GetRequiredService<ISettingsService>()));
+            services.AddSingleton<IAnnouncementService>(sp => sp.GetRequiredService<AnnouncementService>());
+
+            services.AddSingleton(sp =>
+                new EndpointService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>()));
+            services.AddSingleton<IEndpointService>(sp => sp.GetRequiredService<EndpointService>());
+
             services.AddSingleton(sp =>
                 new ProfileService(
                     sp.GetRequiredService<AppPathConfiguration>().AppDir,
@@ -74,6 +117,9 @@ public static class Bootstrapper
                     sp.GetRequiredService<AvatarService>()));
             services.AddSingleton<IProfileService>(sp => sp.GetRequiredService<ProfileService>());
 
+            services.AddSingleton(sp =>
+                new MeteredConnectionService(sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton<IMeteredConnectionService>(sp => sp.GetRequiredService<MeteredConnectionService>());
             services.AddSingleton<DownloadService>();
             services.AddSingleton<IDownloadService>(sp => sp.GetRequiredService<DownloadService>());
 
@@ -102,6 +148,8 @@ public static class Bootstrapper
             services.AddSingleton(sp =>
                 new ModService(
                     sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IDownloadService>(),
+                    sp.GetRequiredService<IConnectivityService>(),
                     sp.GetRequiredService<AppPathConfiguration>().AppDir,
                     sp.GetRequiredService<ConfigService>(),
                     sp.GetRequiredService<InstanceService>(),
@@ -111,9 +159,23 @@ public static class Bootstrapper
             services.AddSingleton(sp =>
                 new LaunchService(
                     sp.GetRequiredService<AppPathConfiguration>().AppDir,
-                    sp.GetRequiredService<HttpClient>()));
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IDownloadService>(),
+                    sp.GetRequiredService<ProgressNotificationService>(),
+                    sp.GetRequiredService<IEndpointService>()));
             services.AddSingleton<ILaunchService>(sp => sp.GetRequiredService<LaunchService>());
 
+            services.AddSingleton(sp =>
+                new JavaRuntimeService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>(),
+                    sp.GetRequiredService<IDownloadService>(),
+                    sp.GetRequiredService<ILaunchService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<ProgressNotificationService>()));
+            services.AddSingleton<IJavaRuntimeService>(sp => sp.GetRequiredService<JavaRuntimeService>());
+
             services.AddSingleton(sp =>
                 new AssetService(
                     sp.GetRequiredService<InstanceService>(),
@@ -124,7 +186,8 @@ public static class Bootstrapper
                     sp.GetRequiredService<InstanceService>(),
                     sp.GetRequiredService<AppPathConfiguration>().AppDir));
 
-            services.AddSingleton<GameProcessService>();
+            services.AddSingleton(sp =>
+                new GameProcessService(sp.GetRequiredService<AppPathConfiguration>().AppDir));
             services.AddSingleton<IGameProcessService>(sp => sp.GetRequiredService<GameProcessService>());
 
             services.AddSingleton(sp =>
@@ -133,7 +196,9 @@ public static class Bootstrapper
 
             services.AddSingleton(sp =>
                 new UpdateService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
                     sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IDownloadService>(),
                     sp.GetRequiredService<ConfigService>(),
                     sp.GetRequiredService<VersionService>(),
                     sp.GetRequiredService<InstanceService>(),
@@ -157,6 +222,7 @@ public static class Bootstrapper
                 new GameLauncher(
                     sp.GetRequiredService<IConfigService>(),
                     sp.GetRequiredService<ILaunchService>(),
+                    sp.GetRequiredService<IJavaRuntimeService>(),
                     sp.GetRequiredService<IInstanceService>(),
                     sp.GetRequiredService<IGameProcessService>(),
                     sp.GetRequiredService<IProgressNotificationService>(),
@@ -165,9 +231,119 @@ public static class Bootstrapper
                     sp.GetRequiredService<IUserIdentityService>(),
                     sp.GetRequiredService<AvatarService>(),
                     sp.GetRequiredService<HttpClient>(),
-                    sp.GetRequiredService<HytaleAuthService>()));
+                    sp.GetRequiredService<HytaleAuthService>(),
+                    sp.GetRequiredService<ILogStreamService>(),
+                    sp.GetRequiredService<ISteamDeckService>(),
+                    sp.GetRequiredService<IGraphicsDiagnosticsService>(),
+                    sp.GetRequiredService<IModService>()));
             services.AddSingleton<IGameLauncher>(sp => sp.GetRequiredService<GameLauncher>());
 
+            services.AddSingleton(sp =>
+                new CrashAnalyzerService(
+                    sp.GetRequiredService<ILogStreamService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IGraphicsDiagnosticsService>()));
+            services.AddSingleton<ICrashAnalyzerService>(sp => sp.GetRequiredService<CrashAnalyzerService>());
+
+            services.AddSingleton(sp =>
+                new GameLogStreamService(
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IGameProcessService>()));
+            services.AddSingleton<IGameLogStreamService>(sp => sp.GetRequiredService<GameLogStreamService>());
+
+            services.AddSingleton(sp =>
+                new GameStatsService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IProgressNotificationService>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IModService>()));
+            services.AddSingleton<IGameStatsService>(sp => sp.GetRequiredService<GameStatsService>());
+
+            services.AddSingleton(sp =>
+                new PlaytimeLimitService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IPlaytimeLimitService>(sp => sp.GetRequiredService<PlaytimeLimitService>());
+
+            services.AddSingleton(sp =>
+                new GameSettingsService(
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton<IGameSettingsService>(sp => sp.GetRequiredService<GameSettingsService>());
+
+            services.AddSingleton(sp =>
+                new InstanceMigrationService(
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<IGameSettingsService>()));
+            services.AddSingleton<IInstanceMigrationService>(sp => sp.GetRequiredService<InstanceMigrationService>());
+
+            services.AddSingleton(sp =>
+                new StartupRecoveryService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>()));
+            services.AddSingleton<IStartupRecoveryService>(sp => sp.GetRequiredService<StartupRecoveryService>());
+
+            services.AddSingleton(sp =>
+                new StartupCheckService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>()));
+            services.AddSingleton<IStartupCheckService>(sp => sp.GetRequiredService<StartupCheckService>());
+
+            services.AddSingleton(sp =>
+                new DefenderService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>()));
+            services.AddSingleton<IDefenderService>(sp => sp.GetRequiredService<DefenderService>());
+
+            services.AddSingleton(sp =>
+                new TrashService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton<ITrashService>(sp => sp.GetRequiredService<TrashService>());
+
+            services.AddSingleton(sp =>
+                new WorldService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<ITrashService>()));
+            services.AddSingleton<IWorldService>(sp => sp.GetRequiredService<WorldService>());
+
+            services.AddSingleton(sp =>
+                new InstanceBackupService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<ITrashService>()));
+            services.AddSingleton<IInstanceBackupService>(sp => sp.GetRequiredService<InstanceBackupService>());
+
+            services.AddSingleton(sp =>
+                new InstanceActivityService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IInstanceActivityService>(sp => sp.GetRequiredService<InstanceActivityService>());
+
+            services.AddSingleton(sp =>
+                new InstanceArtworkService(
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<ISettingsService>()));
+            services.AddSingleton<IInstanceArtworkService>(sp => sp.GetRequiredService<InstanceArtworkService>());
+
+            services.AddSingleton(sp =>
+                new PreReleaseService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IWorldService>()));
+            services.AddSingleton<IPreReleaseService>(sp => sp.GetRequiredService<PreReleaseService>());
+
             services.AddSingleton(sp =>
                 new GameSessionService(
                     sp.GetRequiredService<IConfigService>(),
@@ -179,10 +355,65 @@ public static class Bootstrapper
                     sp.GetRequiredService<IProgressNotificationService>(),
                     sp.GetRequiredService<IPatchManager>(),
                     sp.GetRequiredService<IGameLauncher>(),
+                    sp.GetRequiredService<ITaskManagerService>(),
+                    sp.GetRequiredService<IConnectivityService>(),
+                    sp.GetRequiredService<IInstallLogService>(),
+                    sp.GetRequiredService<IPreReleaseService>(),
+                    sp.GetRequiredService<IEndpointService>(),
                     sp.GetRequiredService<HttpClient>(),
                     sp.GetRequiredService<AppPathConfiguration>()));
             services.AddSingleton<IGameSessionService>(sp => sp.GetRequiredService<GameSessionService>());
 
+            services.AddSingleton(sp =>
+                new SafeModeService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IGameSessionService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<ISafeModeService>(sp => sp.GetRequiredService<SafeModeService>());
+
+            services.AddSingleton(sp =>
+                new ModBisectService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<ISafeModeService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IModBisectService>(sp => sp.GetRequiredService<ModBisectService>());
+
+            services.AddSingleton(sp =>
+                new WorldModSnapshotService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IWorldService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<ISafeModeService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IWorldModSnapshotService>(sp => sp.GetRequiredService<WorldModSnapshotService>());
+
+            services.AddSingleton(sp =>
+                new SearchService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IWorldService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<INewsService>()));
+            services.AddSingleton<ISearchService>(sp => sp.GetRequiredService<SearchService>());
+
+            services.AddSingleton(sp =>
+                new DashboardService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IInstanceActivityService>(),
+                    sp.GetRequiredService<IVersionService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<INewsService>(),
+                    sp.GetRequiredService<IAnnouncementService>()));
+            services.AddSingleton<IDashboardService>(sp => sp.GetRequiredService<DashboardService>());
+
             #endregion
 
             #region User & Skin Management
@@ -219,12 +450,16 @@ public static class Bootstrapper
                 new HytaleVersionSource(
                     sp.GetRequiredService<AppPathConfiguration>().AppDir,
                     sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>(),
                     sp.GetRequiredService<HytaleAuthService>(),
-                    sp.GetRequiredService<IConfigService>()));
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IEndpointService>()));
 
             services.AddSingleton(sp =>
                 new MirrorVersionSource(
                     sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>(),
+                    sp.GetRequiredService<IEndpointService>(),
                     "default"));
 
             #endregion
@@ -238,22 +473,112 @@ public static class Bootstrapper
                 new ProgressNotificationService(sp.GetRequiredService<DiscordService>()));
             services.AddSingleton<IProgressNotificationService>(sp => sp.GetRequiredService<ProgressNotificationService>());
 
+            services.AddSingleton<TaskManagerService>();
+            services.AddSingleton<ITaskManagerService>(sp => sp.GetRequiredService<TaskManagerService>());
+
             services.AddSingleton<BrowserService>();
             services.AddSingleton<IBrowserService>(sp => sp.GetRequiredService<BrowserService>());
 
             services.AddSingleton<DiscordService>();
             services.AddSingleton<IDiscordService>(sp => sp.GetRequiredService<DiscordService>());
 
+            services.AddSingleton(sp =>
+                new TelemetryService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<ITelemetryService>(sp => sp.GetRequiredService<TelemetryService>());
+
+            services.AddSingleton(sp =>
+                new CloudSyncService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<ISecretStore>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IWorldService>(),
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<ICloudSyncService>(sp => sp.GetRequiredService<CloudSyncService>());
+
+            services.AddSingleton(sp =>
+                new ErrorReportingService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IErrorReportingService>(sp => sp.GetRequiredService<ErrorReportingService>());
+
+            services.AddSingleton(sp =>
+                new IssueReportService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IBrowserService>(),
+                    sp.GetRequiredService<IGraphicsDiagnosticsService>()));
+            services.AddSingleton<IIssueReportService>(sp => sp.GetRequiredService<IssueReportService>());
+
+            services.AddSingleton(sp =>
+                new QuickActionService(
+                    sp.GetRequiredService<ISettingsService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IGameSessionService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IProfileManagementService>()));
+            services.AddSingleton<IQuickActionService>(sp => sp.GetRequiredService<QuickActionService>());
+
+            services.AddSingleton(sp =>
+                new HeadlessLaunchService(
+                    sp.GetRequiredService<IQuickActionService>(),
+                    sp.GetRequiredService<IGameSessionService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IHeadlessLaunchService>(sp => sp.GetRequiredService<HeadlessLaunchService>());
+
+            services.AddSingleton(sp =>
+                new GlobalHotkeyService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IQuickActionService>()));
+            services.AddSingleton<IGlobalHotkeyService>(sp => sp.GetRequiredService<GlobalHotkeyService>());
+
             services.AddSingleton<RosettaService>();
 
+            services.AddSingleton(sp =>
+                new SteamDeckService(sp.GetRequiredService<IConfigService>()));
+            services.AddSingleton<ISteamDeckService>(sp => sp.GetRequiredService<SteamDeckService>());
+
+            services.AddSingleton(sp =>
+                new DesktopShortcutService(
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IInstanceArtworkService>(),
+                    sp.GetRequiredService<IProfileService>()));
+            services.AddSingleton<IDesktopShortcutService>(sp => sp.GetRequiredService<DesktopShortcutService>());
+
+            services.AddSingleton(sp =>
+                new WindowStateService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IProgressNotificationService>()));
+            services.AddSingleton<IWindowStateService>(sp => sp.GetRequiredService<WindowStateService>());
+
             services.AddSingleton<FileDialogService>();
             services.AddSingleton<IFileDialogService>(sp => sp.GetRequiredService<FileDialogService>());
 
             services.AddSingleton(sp =>
-                new ButlerService(sp.GetRequiredService<AppPathConfiguration>().AppDir));
+                new ButlerService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<HttpClient>(),
+                    sp.GetRequiredService<NetworkPolicy>(),
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstallLogService>(),
+                    sp.GetRequiredService<ProgressNotificationService>()));
             services.AddSingleton<IButlerService>(sp => sp.GetRequiredService<ButlerService>());
 
+            services.AddSingleton(sp =>
+                new InstallLogService(sp.GetRequiredService<AppPathConfiguration>().AppDir));
+            services.AddSingleton<IInstallLogService>(sp => sp.GetRequiredService<InstallLogService>());
+
             services.AddSingleton<GpuDetectionService>();
+            services.AddSingleton(sp =>
+                new GraphicsDiagnosticsService(sp.GetRequiredService<GpuDetectionService>()));
+            services.AddSingleton<IGraphicsDiagnosticsService>(sp => sp.GetRequiredService<GraphicsDiagnosticsService>());
 
             services.AddSingleton(sp =>
                 new SettingsService(
@@ -261,6 +586,14 @@ public static class Bootstrapper
                     sp.GetRequiredService<LocalizationService>()));
             services.AddSingleton<ISettingsService>(sp => sp.GetRequiredService<SettingsService>());
 
+            services.AddSingleton(sp =>
+                new OnboardingService(
+                    sp.GetRequiredService<AppPathConfiguration>().AppDir,
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IProfileService>(),
+                    sp.GetRequiredService<LocalizationService>()));
+            services.AddSingleton<IOnboardingService>(sp => sp.GetRequiredService<OnboardingService>());
+
             services.AddSingleton<ThemeService>();
             services.AddSingleton<IThemeService>(sp => sp.GetRequiredService<ThemeService>());
 
@@ -271,6 +604,20 @@ public static class Bootstrapper
 
             #region IPC Bridge
 
+            services.AddSingleton<EventBus>();
+            services.AddSingleton<IEventBus>(sp => sp.GetRequiredService<EventBus>());
+
+            services.AddSingleton(sp =>
+                new AutomationApiService(
+                    sp.GetRequiredService<IConfigService>(),
+                    sp.GetRequiredService<IInstanceService>(),
+                    sp.GetRequiredService<IGameSessionService>(),
+                    sp.GetRequiredService<IGameProcessService>(),
+                    sp.GetRequiredService<IModService>(),
+                    sp.GetRequiredService<IQuickActionService>(),
+                    sp.GetRequiredService<ISecretStore>()));
+            services.AddSingleton<IAutomationApiService>(sp => sp.GetRequiredService<AutomationApiService>());
+
             // IpcService needs all other services → receives IServiceProvider
             services.AddSingleton<IpcService>();
 
@@ -1,7 +1,7 @@
 import React, { useState, useEffect, useRef, lazy, Suspense, useCallback } from 'react';
 import { useTranslation } from 'react-i18next';
 import { AnimatePresence } from 'framer-motion';
-import { ipc, on, send, NewsItem, InstanceInfo } from '@/lib/ipc';
+import { ipc, on, send, NewsItem, InstanceInfo, PlaytimeWarning, GraphicsDiagnostics, ModCompatibilityReport, PreReleaseNotice, StartupRecoveryReport, StartupCheckReport, DownloadConfirmation } from '@/lib/ipc';
 import { BackgroundImage } from './components/layout/BackgroundImage';
 import { MusicPlayer } from './components/layout/MusicPlayer';
 import { UpdateOverlay } from './components/layout/UpdateOverlay';
@@ -13,12 +13,20 @@ import { ProfilesPage } from './pages/ProfilesPage';
 import { InstancesPage } from './pages/InstancesPage';
 import { SettingsPage } from './pages/SettingsPage';
 import { LogsPage } from './pages/LogsPage';
+import { useGamepadNavigation } from './hooks/useGamepadNavigation';
 // Controller detection removed - not using floating indicator
 
 // Lazy load heavy modals for better initial load performance
 const NewsPreview = lazy(() => import('./components/NewsPreview').then(m => ({ default: m.NewsPreview })));
 const ErrorModal = lazy(() => import('./components/modals/ErrorModal').then(m => ({ default: m.ErrorModal })));
 const DeleteConfirmationModal = lazy(() => import('./components/modals/DeleteConfirmationModal').then(m => ({ default: m.DeleteConfirmationModal })));
+const PlaytimeLimitModal = lazy(() => import('./components/modals/PlaytimeLimitModal').then(m => ({ default: m.PlaytimeLimitModal })));
+const GraphicsWarningModal = lazy(() => import('./components/modals/GraphicsWarningModal').then(m => ({ default: m.GraphicsWarningModal })));
+const ModCompatibilityModal = lazy(() => import('./components/modals/ModCompatibilityModal').then(m => ({ default: m.ModCompatibilityModal })));
+const RecoveryReportModal = lazy(() => import('./components/modals/RecoveryReportModal').then(m => ({ default: m.RecoveryReportModal })));
+const StartupCheckModal = lazy(() => import('./components/modals/StartupCheckModal').then(m => ({ default: m.StartupCheckModal })));
+const PreReleaseNoticeModal = lazy(() => import('./components/modals/PreReleaseNoticeModal').then(m => ({ default: m.PreReleaseNoticeModal })));
+const DownloadConfirmationModal = lazy(() => import('./components/modals/DownloadConfirmationModal').then(m => ({ default: m.DownloadConfirmationModal })));
 const OnboardingModal = lazy(() => import('./components/modals/OnboardingModal').then(m => ({ default: m.OnboardingModal })));
 
 // Functions that map to real IPC channels
@@ -34,7 +42,6 @@ function EventsOn(event: string, cb: (...args: any[]) => void): () => void {
     'game-state': 'hyprism:game:state',
     'error': 'hyprism:game:error',
     'update:available': 'hyprism:update:available',
-    'update:progress': 'hyprism:update:progress',
   };
   const channel = channelMap[event] ?? event;
   return on(channel, (data: unknown) => cb(data));
@@ -201,12 +208,19 @@ const App: React.FC = () => {
   // Update state
   const [updateAsset, setUpdateAsset] = useState<any>(null);
   const [isUpdatingLauncher, setIsUpdatingLauncher] = useState<boolean>(false);
-  const [updateStats, setUpdateStats] = useState({ d: 0, t: 0 });
+  const [updateStats, setUpdateStats] = useState<{ d: number; t: number; speed?: number; eta?: number }>({ d: 0, t: 0 });
 
   // Modal state
   const [showDelete, setShowDelete] = useState<boolean>(false);
 
   const [error, setError] = useState<any>(null);
+  const [playtimeWarning, setPlaytimeWarning] = useState<PlaytimeWarning | null>(null);
+  const [graphicsWarning, setGraphicsWarning] = useState<GraphicsDiagnostics | null>(null);
+  const [modCompatibility, setModCompatibility] = useState<ModCompatibilityReport | null>(null);
+  const [preReleaseNotice, setPreReleaseNotice] = useState<PreReleaseNotice | null>(null);
+  const [downloadConfirmations, setDownloadConfirmations] = useState<DownloadConfirmation[]>([]);
+  const [recoveryReport, setRecoveryReport] = useState<StartupRecoveryReport | null>(null);
+  const [startupChecks, setStartupChecks] = useState<StartupCheckReport | null>(null);
   const [launchTimeoutError, setLaunchTimeoutError] = useState<{ message: string; logs: string[] } | null>(null);
   const [avatarRefreshTrigger, setAvatarRefreshTrigger] = useState<number>(0);
 
@@ -270,6 +284,36 @@ const App: React.FC = () => {
     };
   }, []);
 
+  // Gamepad navigation: on by default on a Steam Deck or in Gamescope, changed from Settings
+  const [gamepadMode, setGamepadMode] = useState(false);
+  useGamepadNavigation(gamepadMode);
+
+  useEffect(() => {
+    ipc.steamDeck.status().then((status) => setGamepadMode(status.gamepadMode)).catch(() => setGamepadMode(false));
+
+    const handleGamepadMode = (evt: Event) => setGamepadMode((evt as CustomEvent<boolean>).detail);
+    window.addEventListener('hyprism:gamepad-mode', handleGamepadMode);
+    return () => window.removeEventListener('hyprism:gamepad-mode', handleGamepadMode);
+  }, []);
+
+  // Leftovers of an install the last run did not finish; kept partial downloads alone are not worth a dialog
+  useEffect(() => {
+    ipc.instance.recoveryReport()
+      .then((report) => {
+        if (report.items.some((item) => item.action !== 'resume')) setRecoveryReport(report);
+      })
+      .catch(() => {});
+  }, []);
+
+  // Data folder problems found on startup, with what to do about them
+  useEffect(() => {
+    ipc.app.startupChecks()
+      .then((report) => {
+        if (report.issues.length > 0) setStartupChecks(report);
+      })
+      .catch(() => {});
+  }, []);
+
   // Onboarding state
   const [showOnboarding, setShowOnboarding] = useState<boolean>(false);
   const [onboardingChecked, setOnboardingChecked] = useState<boolean>(false);
@@ -506,6 +550,11 @@ const App: React.FC = () => {
       }
     });
 
+    // Show the last known launcher update at once; a stale result is rechecked in the background
+    ipc.update.status()
+      .then((status) => status?.update && setUpdateAsset(status.update))
+      .catch((e) => console.warn('[IPC] update status:', e));
+
     // Load background mode, news settings, and accent color
     GetBackgroundMode().then((mode: string) => setBackgroundMode(mode || 'slideshow'));
     GetAccentColor().then((color: string) => setAccentColor(color || '#FFA845'));
@@ -691,22 +740,74 @@ const App: React.FC = () => {
       console.log('Update available:', asset);
     });
 
-    const unsubUpdateProgress = EventsOn('update:progress', (_stage: string, progress: number, _message: string, _file: string, _speed: string, downloaded: number, total: number) => {
-      setProgress(progress);
-      setUpdateStats({ d: downloaded, t: total });
+    const unsubUpdateProgress = ipc.progress.onUpdate((op) => {
+      if (op.kind !== 'launcher-update') return;
+      setProgress(op.progress);
+      setUpdateStats({ d: op.downloadedBytes, t: op.totalBytes, speed: op.bytesPerSecond, eta: op.etaSeconds });
     });
 
     const unsubError = EventsOn('error', (err: any) => {
+      // Backend errors carry a message ID + positional args; fall back to the English message
+      if (err?.messageKey && i18n.exists(err.messageKey)) {
+        let message = i18n.t(err.messageKey);
+        (Array.isArray(err.args) ? err.args : []).forEach((arg: any, i: number) => {
+          message = message.replace(new RegExp(`\\{${i}\\}`, 'g'), String(arg));
+        });
+        err = { ...err, message };
+      }
+      if (err?.hintKey && i18n.exists(err.hintKey)) {
+        err = { ...err, hint: i18n.t(err.hintKey) };
+      }
       setError(err);
       clearDownloadState();
     });
 
+    // Global hotkeys and the automation API can toggle music while the window is hidden
+    const unsubMusicToggled = ipc.quickActions.onMusicToggled(({ musicEnabled }) => setIsMuted(!musicEnabled));
+
+    // Playtime limits: the OS notification reaches the player in-game, the modal offers the PIN override
+    const unsubPlaytime = ipc.playtime.onWarning((warning) => {
+      setPlaytimeWarning(warning);
+      const title = warning.terminating ? i18n.t('playtime.limitReached') : i18n.t('playtime.limitSoon', { minutes: warning.minutesLeft });
+      try { new Notification(title, { body: i18n.t(`playtime.reasons.${warning.reason}`) }); } catch { /* notifications unavailable */ }
+    });
+
+    // The launch goes on regardless; this only explains a crash the driver check saw coming
+    const unsubGraphics = ipc.game.onGraphicsWarning((diagnostics) => setGraphicsWarning(diagnostics));
+    const unsubModCompatibility = ipc.mods.onCompatibilityWarning((report) => setModCompatibility(report));
+
+    // The backend holds back the first pre-release launch until the player confirms the warning
+    const unsubPreRelease = ipc.prerelease.onNoticeRequired((notice) => {
+      clearDownloadState();
+      setPreReleaseNotice(notice);
+    });
+
+    // Metered connection: large downloads wait until the player allows them, one question at a time
+    const unsubMetered = ipc.metered.onConfirmationRequired((confirmation) =>
+      setDownloadConfirmations((prev) => prev.some((c) => c.id === confirmation.id) ? prev : [...prev, confirmation]));
+    ipc.metered.pending()
+      .then((pending) => pending.length > 0 && setDownloadConfirmations(pending))
+      .catch((e) => console.warn('[IPC] metered pending:', e));
+
+    // Desktop shortcuts and CLI flags can switch the instance and profile while the launcher is open
+    const unsubSecondInstance = ipc.app.onSecondInstance(() => {
+      refreshInstances();
+      GetNick().then((n: string) => n && setUsername(n));
+    });
+
     return () => {
       unsubProgress();
       unsubGameState();
       unsubUpdate();
       unsubUpdateProgress();
       unsubError();
+      unsubMusicToggled();
+      unsubPlaytime();
+      unsubGraphics();
+      unsubModCompatibility();
+      unsubPreRelease();
+      unsubMetered();
+      unsubSecondInstance();
     };
   }, []);
 
@@ -747,12 +848,19 @@ const App: React.FC = () => {
       return;
     }
 
-    const trimmedUsername = username.trim();
-    if (!trimmedUsername || trimmedUsername.length < 1 || trimmedUsername.length > 16) {
+    const validation = await ipc.profile.validateNick(username.trim());
+    if (!validation.valid) {
+      let technical = validation.message ?? t('app.nicknameLengthError');
+      if (validation.messageKey && i18n.exists(validation.messageKey)) {
+        technical = i18n.t(validation.messageKey);
+        (validation.args ?? []).forEach((arg, i) => {
+          technical = technical.replace(new RegExp(`\\{${i}\\}`, 'g'), String(arg));
+        });
+      }
       setError({
         type: 'VALIDATION',
         message: t('app.invalidNickname'),
-        technical: t('app.nicknameLengthError'),
+        technical,
         timestamp: new Date().toISOString(),
         launcherVersion: launcherVersion
       });
@@ -799,7 +907,39 @@ const App: React.FC = () => {
     setDownloadingBranch(branch);
     setDownloadingVersion(version);
     setDownloadState('downloading');
-    send('hyprism:game:launch', { branch, version });
+    send('hyprism:game:launch', launchingInstance ? { instanceId: launchingInstance.id } : { branch, version });
+  };
+
+  // Launch an instance with its mods disabled; the backend re-enables them when the game exits
+  const handleLaunchSafeModeFromInstances = (instanceId: string) => {
+    if (isGameRunning || isDownloading) return;
+
+    const launchingInstance = instances.find(inst => inst.id === instanceId);
+    if (!launchingInstance) return;
+
+    setSelectedInstance(launchingInstance);
+    selectedInstanceRef.current = launchingInstance;
+    setIsDownloading(true);
+    setDownloadingBranch(launchingInstance.branch);
+    setDownloadingVersion(launchingInstance.version);
+    setDownloadState('downloading');
+    send('hyprism:game:launchSafeMode', { instanceId });
+  };
+
+  // Launch the current mod bisect step; only the step's mods stay enabled until the game exits
+  const handleLaunchBisectStepFromInstances = (instanceId: string) => {
+    if (isGameRunning || isDownloading) return;
+
+    const launchingInstance = instances.find(inst => inst.id === instanceId);
+    if (!launchingInstance) return;
+
+    setSelectedInstance(launchingInstance);
+    selectedInstanceRef.current = launchingInstance;
+    setIsDownloading(true);
+    setDownloadingBranch(launchingInstance.branch);
+    setDownloadingVersion(launchingInstance.version);
+    setDownloadState('downloading');
+    ipc.mods.bisectLaunch();
   };
 
   const handleGameUpdate = async () => {
@@ -964,7 +1104,7 @@ const App: React.FC = () => {
         url: item?.url || '',
         date: dateMs ? formatDateConsistent(dateMs, i18n.language) : (item?.date || ''),
         author: item?.author || '',
-        imageUrl: item?.imageUrl || item?.coverImageUrl || '',
+        imageUrl: item?.localImageUrl || item?.imageUrl || item?.coverImageUrl || '',
         source: item?.source || 'hytale',
       };
     }).sort((a: any, b: any) => {
@@ -993,6 +1133,8 @@ const App: React.FC = () => {
           progress={progress}
           downloaded={updateStats.d}
           total={updateStats.t}
+          bytesPerSecond={updateStats.speed}
+          etaSeconds={updateStats.eta}
         />
       )}
 
@@ -1075,6 +1217,8 @@ const App: React.FC = () => {
               canCancel={isDownloading && !isGameRunning}
               onCancelDownload={handleCancelDownload}
               onLaunchInstance={handleLaunchFromInstances}
+              onLaunchInstanceSafeMode={handleLaunchSafeModeFromInstances}
+              onLaunchBisectStep={handleLaunchBisectStepFromInstances}
               officialServerBlocked={officialServerBlocked}
             />
           )}
@@ -1125,10 +1269,68 @@ const App: React.FC = () => {
           />
         )}
 
+        {playtimeWarning && (
+          <PlaytimeLimitModal
+            warning={playtimeWarning}
+            onClose={() => setPlaytimeWarning(null)}
+          />
+        )}
+
+        {graphicsWarning && (
+          <GraphicsWarningModal
+            diagnostics={graphicsWarning}
+            onClose={() => setGraphicsWarning(null)}
+          />
+        )}
+
+        {modCompatibility && (
+          <ModCompatibilityModal
+            report={modCompatibility}
+            onClose={() => setModCompatibility(null)}
+          />
+        )}
+
+        {recoveryReport && (
+          <RecoveryReportModal
+            report={recoveryReport}
+            onClose={() => setRecoveryReport(null)}
+          />
+        )}
+
+        {startupChecks && (
+          <StartupCheckModal
+            report={startupChecks}
+            onClose={() => setStartupChecks(null)}
+          />
+        )}
+
+        {preReleaseNotice && (
+          <PreReleaseNoticeModal
+            notice={preReleaseNotice}
+            onContinue={() => {
+              setPreReleaseNotice(null);
+              doLaunch();
+            }}
+            onClose={() => setPreReleaseNotice(null)}
+          />
+        )}
+
+        {downloadConfirmations.length > 0 && (
+          <DownloadConfirmationModal
+            key={downloadConfirmations[0].id}
+            confirmation={downloadConfirmations[0]}
+            onAnswered={() => setDownloadConfirmations((prev) => prev.slice(1))}
+          />
+        )}
+
         {error && (
           <ErrorModal
             error={{...error, launcherVersion: launcherVersion}}
             onClose={() => setError(null)}
+            onRepair={error.code === 'E_FILES_ALTERED' && selectedInstance ? () => {
+              ipc.instance.repairFiles({ instanceId: selectedInstance.id });
+              setError(null);
+            } : undefined}
           />
         )}
 
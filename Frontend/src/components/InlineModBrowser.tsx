@@ -26,6 +26,16 @@ const getReleaseTypeLabel = (type: number, t: (key: string) => string) => {
   }
 };
 
+const findCategory = (tree: ModCategory[], id: number): ModCategory | undefined => {
+  if (id === 0) return undefined;
+  for (const cat of tree) {
+    if (cat.id === id) return cat;
+    const found = findCategory(cat.children ?? [], id);
+    if (found) return found;
+  }
+  return undefined;
+};
+
 const readFileAsBase64 = (file: File): Promise<string> => {
   return new Promise((resolve, reject) => {
     const reader = new FileReader();
@@ -41,6 +51,10 @@ const readFileAsBase64 = (file: File): Promise<string> => {
   });
 };
 
+// A CurseForge link or projectID:fileID pasted into the search box; plain text stays a search
+const isModReference = (text: string): boolean =>
+  /^\s*\d+\s*:\s*\d+\s*$/.test(text) || /^\s*(https?:\/\/)?(www\.)?curseforge\.com\/\S+\s*$/i.test(text);
+
 // ------- Types -------
 
 type DownloadJob = {
@@ -79,7 +93,10 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
   const [searchQuery, setSearchQuery] = useState('');
   const [searchResults, setSearchResults] = useState<ModInfo[]>([]);
   const [categories, setCategories] = useState<ModCategory[]>([]);
+  // Class or category ID; 0 searches everything
   const [selectedCategory, setSelectedCategory] = useState(0);
+  // Set from a mod's author; lists that author's projects instead of the search results
+  const [authorFilter, setAuthorFilter] = useState<{ id?: number | null; name: string } | null>(null);
   const [selectedSortField, setSelectedSortField] = useState(6);
   const [isSearching, setIsSearching] = useState(false);
   const [hasSearched, setHasSearched] = useState(false);
@@ -104,6 +121,9 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
   const [detailSelectedFileId, setDetailSelectedFileId] = useState<string | undefined>();
   const [activeScreenshot, setActiveScreenshot] = useState(0);
   const [fullscreenImage, setFullscreenImage] = useState<{ url: string; title: string } | null>(null);
+  // Remote screenshot URL -> file:// URL of the backend's cached copy
+  const [cachedImages, setCachedImages] = useState<Record<string, string>>({});
+  const localImage = (url?: string) => (url && cachedImages[url]) || url;
 
   // --- Download ---
   const [isDownloading, setIsDownloading] = useState(false);
@@ -133,7 +153,7 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
   // ------- Data loading -------
 
   useEffect(() => {
-    ipc.mods.categories().then(cats => setCategories(cats || [])).catch(() => {});
+    ipc.mods.categoryTree().then(tree => setCategories(tree || [])).catch(() => {});
   }, []);
 
   useEffect(() => {
@@ -153,19 +173,31 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
 
     try {
       const pageSize = 20;
-      const cats = selectedCategory === 0 ? [] : [selectedCategory.toString()];
-
-      const result = await ipc.mods.search({
-        query: searchQuery,
-        page,
-        pageSize,
-        categories: cats,
-        sortField: selectedSortField,
-        sortOrder: 1, // desc
-      });
+      const selected = findCategory(categories, selectedCategory);
+
+      const result = authorFilter
+        ? await ipc.mods.byAuthor({ authorId: authorFilter.id, authorName: authorFilter.name, page, pageSize })
+        : await ipc.mods.search({
+          query: searchQuery,
+          page,
+          pageSize,
+          classId: selected ? (selected.isClass ? selected.id : selected.classId) : null,
+          categoryIds: selected && !selected.isClass ? [selected.id] : [],
+          sortField: selectedSortField,
+          sortOrder: 1, // desc
+        });
 
       const mods: ModInfo[] = result?.mods ?? [];
 
+      // Backend explains network failures (offline, captive portal, blocked host) with a message ID
+      if (result?.messageKey) {
+        let message = t(result.messageKey);
+        (result.args ?? []).forEach((arg, i) => {
+          message = message.replace(new RegExp(`\\{${i}\\}`, 'g'), String(arg));
+        });
+        setError(message);
+      }
+
       if (append) {
         setSearchResults(prev => [...prev, ...mods]);
       } else {
@@ -183,11 +215,13 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
     setIsSearching(false);
     setIsLoadingMore(false);
     setHasSearched(true);
-  }, [searchQuery, selectedCategory, selectedSortField]);
+  }, [searchQuery, selectedCategory, selectedSortField, categories, authorFilter]);
 
   // Debounced search on query/filter changes
   useEffect(() => {
     if (searchTimeoutRef.current) clearTimeout(searchTimeoutRef.current);
+    // A pasted link is installed with the button below the search box, not searched for
+    if (isModReference(searchQuery)) return;
     searchTimeoutRef.current = setTimeout(() => handleSearch(0, false), 300);
     return () => { if (searchTimeoutRef.current) clearTimeout(searchTimeoutRef.current); };
   }, [searchQuery, selectedCategory, selectedSortField, handleSearch]);
@@ -220,10 +254,21 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
     }
   };
 
+  // Thumbnails first so the strip fills quickly, then the full-size images
+  const cacheScreenshots = (mod: ModInfo) => {
+    const shots = mod.screenshots ?? [];
+    const merge = (cached: Record<string, string>) => setCachedImages(prev => ({ ...prev, ...cached }));
+    const thumbnails = shots.map(s => s.thumbnailUrl).filter(u => u && !cachedImages[u]);
+    const full = shots.map(s => s.url).filter(u => u && !cachedImages[u]);
+    if (thumbnails.length > 0) ipc.mods.cacheImages({ urls: thumbnails }).then(merge).catch(() => {});
+    if (full.length > 0) ipc.mods.cacheImages({ urls: full }).then(merge).catch(() => {});
+  };
+
   const handleModClick = async (mod: ModInfo) => {
     setSelectedMod(mod);
     setActiveScreenshot(0);
     setIsLoadingModFiles(true);
+    cacheScreenshots(mod);
 
     if (mod.id) {
       const files = await loadModFiles(mod.id);
@@ -357,6 +402,29 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
     onModsInstalled?.();
   };
 
+  const handleInstallFromReference = async () => {
+    const input = searchQuery.trim();
+    setIsImporting(true);
+    setImportProgress(t('modManager.installingMod').replace('{{name}}', input));
+    try {
+      const result = await ipc.mods.resolveInstall({ input, branch: currentBranch, version: currentVersion, instanceId: currentInstanceId });
+      if (result.success) {
+        setImportProgress(t('modManager.installedMod', { name: result.name }));
+        setTimeout(() => setImportProgress(null), 3000);
+        setSearchQuery('');
+        onModsInstalled?.();
+      } else {
+        setImportProgress(null);
+        setError(t(result.messageKey ?? 'modManager.resolve.installFailed', { name: result.name }));
+      }
+    } catch {
+      setImportProgress(null);
+      setError(t('modManager.resolve.installFailed', { name: input }));
+    } finally {
+      setIsImporting(false);
+    }
+  };
+
   const handleInstallSingleMod = async (modId: string, fileId: string, name: string) => {
     try {
       await runDownloadQueue([{ id: modId, name, fileId }]);
@@ -407,13 +475,34 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
 
   // ------- Render -------
 
-  const getCategoryName = (id: number) => {
-    const cat = categories.find(c => c.id === id);
-    if (!cat) return t('modManager.allMods');
-    const key = `modManager.category.${cat.name.replace(/[\s\\/]+/g, '_').toLowerCase()}`;
+  const translateCategory = (name: string) => {
+    const key = `modManager.category.${name.replace(/[\s\\/]+/g, '_').toLowerCase()}`;
     const translated = t(key);
-    return translated !== key ? translated : cat.name;
+    return translated !== key ? translated : name;
   };
+  const getCategoryName = (id: number) => {
+    const cat = findCategory(categories, id);
+    return cat ? translateCategory(cat.name) : t('modManager.allMods');
+  };
+
+  // Classes first, their categories indented below them
+  const renderCategoryOption = (cat: ModCategory, depth: number): React.ReactNode => (
+    <React.Fragment key={cat.id}>
+      <button
+        onClick={() => { setSelectedCategory(cat.id); setIsCategoryDropdownOpen(false); }}
+        className={`w-full py-2.5 pr-4 text-sm text-left hover:bg-white/10 transition-colors ${
+          selectedCategory === cat.id ? 'text-white' : cat.isClass ? 'text-white/80 font-semibold' : 'text-white/60'
+        }`}
+        style={{
+          paddingLeft: `${16 + depth * 12}px`,
+          ...(selectedCategory === cat.id ? { backgroundColor: `${accentColor}20` } : {}),
+        }}
+      >
+        {translateCategory(cat.name)}
+      </button>
+      {(cat.children ?? []).map(child => renderCategoryOption(child, depth + 1))}
+    </React.Fragment>
+  );
   const getSortName = (id: number) => sortOptions.find(s => s.id === id)?.name ?? '';
 
   return (
@@ -460,23 +549,17 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
               <ChevronDown size={14} className={`transition-transform ${isCategoryDropdownOpen ? 'rotate-180' : ''}`} />
             </button>
             {isCategoryDropdownOpen && (
-              <div className="absolute right-0 top-full mt-1 w-48 bg-[#1a1a1a] border border-white/10 rounded-xl shadow-xl z-50 overflow-hidden max-h-64 overflow-y-auto">
-                {categories.map(cat => (
-                  <button
-                    key={cat.id}
-                    onClick={() => { setSelectedCategory(cat.id); setIsCategoryDropdownOpen(false); }}
-                    className={`w-full px-4 py-2.5 text-sm text-left hover:bg-white/10 transition-colors ${
-                      selectedCategory === cat.id ? 'text-white' : 'text-white/60'
-                    }`}
-                    style={selectedCategory === cat.id ? { backgroundColor: `${accentColor}20` } : undefined}
-                  >
-                    {(() => {
-                      const key = `modManager.category.${cat.name.replace(/[\s\\/]+/g, '_').toLowerCase()}`;
-                      const translated = t(key);
-                      return translated !== key ? translated : cat.name;
-                    })()}
-                  </button>
-                ))}
+              <div className="absolute right-0 top-full mt-1 w-56 bg-[#1a1a1a] border border-white/10 rounded-xl shadow-xl z-50 overflow-hidden max-h-80 overflow-y-auto">
+                <button
+                  onClick={() => { setSelectedCategory(0); setIsCategoryDropdownOpen(false); }}
+                  className={`w-full px-4 py-2.5 text-sm text-left hover:bg-white/10 transition-colors ${
+                    selectedCategory === 0 ? 'text-white' : 'text-white/60'
+                  }`}
+                  style={selectedCategory === 0 ? { backgroundColor: `${accentColor}20` } : undefined}
+                >
+                  {t('modManager.allMods')}
+                </button>
+                {categories.map(cat => renderCategoryOption(cat, 0))}
               </div>
             )}
           </div>
@@ -509,6 +592,41 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
           </div>
         </div>
 
+        {/* Author filter */}
+        {authorFilter && (
+          <div className="flex items-center gap-2">
+            <span
+              className="flex items-center gap-1.5 pl-3 pr-1.5 py-1 rounded-lg text-xs"
+              style={{ backgroundColor: `${accentColor}20`, color: accentColor }}
+            >
+              {t('modManager.byAuthor', { author: authorFilter.name })}
+              <button
+                onClick={() => setAuthorFilter(null)}
+                title={t('common.clear')}
+                className="p-0.5 rounded hover:bg-white/10 transition-colors"
+              >
+                <X size={12} />
+              </button>
+            </span>
+          </div>
+        )}
+
+        {/* Install straight from a pasted link or ID */}
+        {isModReference(searchQuery) && (
+          <div className="flex items-center justify-between gap-3 px-3 py-2 rounded-xl border border-white/[0.08] bg-[#2c2c2e]">
+            <span className="text-sm text-white/70 truncate">{t('modManager.resolve.hint')}</span>
+            <button
+              onClick={handleInstallFromReference}
+              disabled={isImporting || isDownloading}
+              className="px-3 py-1.5 rounded-lg text-xs font-medium flex items-center gap-1.5 transition-all flex-shrink-0 disabled:opacity-50"
+              style={{ backgroundColor: accentColor, color: accentTextColor }}
+            >
+              {isImporting ? <Loader2 size={12} className="animate-spin" /> : <Download size={12} />}
+              {t('modManager.resolve.install')}
+            </button>
+          </div>
+        )}
+
         {/* Batch download bar (only when mods are selected) */}
         {selectedMods.size > 0 && !isDownloading && (
           <div className="flex items-center justify-between px-3 py-2 rounded-xl border border-white/[0.08] bg-[#2c2c2e]">
@@ -757,11 +875,11 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
                 <div className="relative px-4 pt-3">
                   <div className="aspect-video bg-[#0a0a0a] rounded-xl overflow-hidden">
                     <img
-                      src={selectedMod.screenshots[activeScreenshot]?.url || selectedMod.screenshots[activeScreenshot]?.thumbnailUrl}
+                      src={localImage(selectedMod.screenshots[activeScreenshot]?.url) || localImage(selectedMod.screenshots[activeScreenshot]?.thumbnailUrl)}
                       alt={selectedMod.screenshots[activeScreenshot]?.title}
                       className="w-full h-full object-cover cursor-pointer"
                       onClick={() => setFullscreenImage({
-                        url: selectedMod.screenshots![activeScreenshot].url,
+                        url: localImage(selectedMod.screenshots![activeScreenshot].url) ?? '',
                         title: selectedMod.screenshots![activeScreenshot].title,
                       })}
                     />
@@ -776,7 +894,7 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
                             i === activeScreenshot ? 'border-white/40' : 'border-transparent opacity-60 hover:opacity-100'
                           }`}
                         >
-                          <img src={ss.thumbnailUrl} alt="" className="w-full h-full object-cover" loading="lazy" />
+                          <img src={localImage(ss.thumbnailUrl)} alt="" className="w-full h-full object-cover" loading="lazy" />
                         </button>
                       ))}
                     </div>
@@ -791,7 +909,18 @@ export const InlineModBrowser: React.FC<InlineModBrowserProps> = ({
                 <div className="grid grid-cols-2 gap-3 text-sm">
                   <div>
                     <span className="text-white/40 text-xs">{t('modManager.author')}</span>
-                    <p className="text-white/80 mt-0.5">{selectedMod.author || t('modManager.unknownAuthor')}</p>
+                    {selectedMod.author ? (
+                      <button
+                        onClick={() => setAuthorFilter({ id: selectedMod.authorId, name: selectedMod.author })}
+                        title={t('modManager.moreByAuthor')}
+                        className="block text-left text-white/80 mt-0.5 hover:underline"
+                        style={{ textDecorationColor: accentColor }}
+                      >
+                        {selectedMod.author}
+                      </button>
+                    ) : (
+                      <p className="text-white/80 mt-0.5">{t('modManager.unknownAuthor')}</p>
+                    )}
                   </div>
                   <div>
                     <span className="text-white/40 text-xs">{t('modManager.downloads')}</span>
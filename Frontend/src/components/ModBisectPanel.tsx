@@ -0,0 +1,106 @@
+import React from 'react';
+import { useTranslation } from 'react-i18next';
+import { Bug, Play, Check, X, Loader2 } from 'lucide-react';
+import { useAccentColor } from '../contexts/AccentColorContext';
+import type { ModBisectState } from '@/lib/ipc';
+
+interface ModBisectPanelProps {
+  state: ModBisectState;
+  // The game is running or being installed; a step can't be launched or reported
+  busy: boolean;
+  onLaunch: () => void;
+  onReport: (crashed: boolean) => void;
+  onDisableCulprit: () => void;
+  onCancel: () => void;
+}
+
+// Guides the user through a mod bisect of the selected instance, one test launch at a time
+export const ModBisectPanel: React.FC<ModBisectPanelProps> = ({
+  state,
+  busy,
+  onLaunch,
+  onReport,
+  onDisableCulprit,
+  onCancel,
+}) => {
+  const { t } = useTranslation();
+  const { accentColor, accentTextColor } = useAccentColor();
+
+  const buttonClass = 'px-3 py-1.5 rounded-lg text-xs font-medium flex items-center gap-1.5 transition-all disabled:opacity-40 disabled:cursor-not-allowed';
+
+  return (
+    <div className="mx-4 mt-3 p-3 rounded-xl border border-white/[0.08] bg-[#2c2c2e]/60 flex items-center gap-3">
+      <Bug size={18} className="flex-shrink-0" style={{ color: accentColor }} />
+
+      <div className="flex-1 min-w-0 text-sm">
+        {state.status === 'found' && state.culprit ? (
+          <>
+            <p className="text-white font-medium">{t('instances.bisect.found', { name: state.culprit.name })}</p>
+            <p className="text-white/50 text-xs">{t('instances.bisect.foundHint', { steps: state.step - 1 })}</p>
+          </>
+        ) : state.launched ? (
+          <>
+            <p className="text-white font-medium">{t('instances.bisect.askResult', { step: state.step })}</p>
+            <p className="text-white/50 text-xs truncate">{state.testing.map(m => m.name).join(', ')}</p>
+          </>
+        ) : (
+          <>
+            <p className="text-white font-medium">
+              {t('instances.bisect.step', { step: state.step, testing: state.testing.length, suspects: state.suspects.length })}
+            </p>
+            <p className="text-white/50 text-xs">{t('instances.bisect.remaining', { count: state.remainingSteps })}</p>
+          </>
+        )}
+      </div>
+
+      <div className="flex items-center gap-2 flex-shrink-0">
+        {state.status === 'found' ? (
+          <button
+            onClick={onDisableCulprit}
+            className={buttonClass}
+            style={{ backgroundColor: accentColor, color: accentTextColor }}
+          >
+            <X size={12} />
+            {t('instances.bisect.disableCulprit')}
+          </button>
+        ) : state.launched ? (
+          <>
+            <button
+              onClick={() => onReport(true)}
+              disabled={busy}
+              className={`${buttonClass} bg-red-500/15 text-red-400 hover:bg-red-500/20 border border-red-500/20`}
+            >
+              <Bug size={12} />
+              {t('instances.bisect.crashed')}
+            </button>
+            <button
+              onClick={() => onReport(false)}
+              disabled={busy}
+              className={`${buttonClass} bg-green-500/15 text-green-400 hover:bg-green-500/20 border border-green-500/20`}
+            >
+              <Check size={12} />
+              {t('instances.bisect.worked')}
+            </button>
+          </>
+        ) : (
+          <button
+            onClick={onLaunch}
+            disabled={busy}
+            className={buttonClass}
+            style={{ backgroundColor: accentColor, color: accentTextColor }}
+          >
+            {busy ? <Loader2 size={12} className="animate-spin" /> : <Play size={12} fill="currentColor" />}
+            {t('instances.bisect.launch')}
+          </button>
+        )}
+        <button
+          onClick={onCancel}
+          disabled={busy && state.status !== 'found'}
+          className={`${buttonClass} text-white/60 hover:text-white hover:bg-white/10`}
+        >
+          {state.status === 'found' ? t('common.close') : t('common.cancel')}
+        </button>
+      </div>
+    </div>
+  );
+};
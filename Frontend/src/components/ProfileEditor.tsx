@@ -1,10 +1,10 @@
 import React, { useState, useEffect, useCallback } from 'react';
 import { useTranslation } from 'react-i18next';
-import { X, RefreshCw, Check, User, Edit3, Copy, CheckCircle, Plus, Trash2, Dices, FolderOpen, CopyPlus, Lock, Image } from 'lucide-react';
+import { X, RefreshCw, Check, User, Edit3, Copy, CheckCircle, Plus, Trash2, Dices, FolderOpen, CopyPlus, Lock, Image, Undo2 } from 'lucide-react';
 import { motion, AnimatePresence } from 'framer-motion';
 import { useAccentColor } from '../contexts/AccentColorContext';
 
-import { ipc, Profile } from '@/lib/ipc';
+import { ipc, Profile, SkinHistoryEntry } from '@/lib/ipc';
 import { DeleteProfileConfirmationModal } from './modals/DeleteProfileConfirmationModal';
 import { ProfileCreationWizard } from './ProfileCreationWizard';
 
@@ -18,6 +18,10 @@ async function SetNick(name: string): Promise<boolean> {
   const r = await ipc.profile.setNick(name);
   return r.success;
 }
+async function ValidateNick(name: string): Promise<{ messageKey?: string; args?: unknown[] } | null> {
+  const r = await ipc.profile.validateNick(name);
+  return r.valid ? null : { messageKey: r.messageKey, args: r.args };
+}
 async function GetUUID(): Promise<string> {
   const p = await ipc.profile.get();
   return p.uuid ?? '';
@@ -99,6 +103,7 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
     const [isEditingUsername, setIsEditingUsername] = useState(false);
     const [isEditingUuid, setIsEditingUuid] = useState(false);
     const [editUsername, setEditUsername] = useState('');
+    const [usernameError, setUsernameError] = useState<string | null>(null);
     const [editUuid, setEditUuid] = useState('');
     const [copiedUuid, setCopiedUuid] = useState(false);
     const [saveStatus, setSaveStatus] = useState<'idle' | 'saving' | 'saved'>('idle');
@@ -117,6 +122,8 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
     
     // Delete confirmation modal state
     const [deleteConfirmation, setDeleteConfirmation] = useState<{ id: string; name: string } | null>(null);
+    const [skinHistory, setSkinHistory] = useState<SkinHistoryEntry[]>([]);
+    const [skinUndoError, setSkinUndoError] = useState<string | null>(null);
 
     // Whether the current profile is an official Hytale account (locked editing)
     const isCurrentOfficial = profiles[currentProfileIndex]?.isOfficial === true;
@@ -179,6 +186,43 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
         }
     }, [isOpen, loadAvatar, loadProfiles]);
 
+    const currentProfileId = profiles[currentProfileIndex]?.id;
+
+    const loadSkinHistory = useCallback(async () => {
+        if (!currentProfileId) {
+            setSkinHistory([]);
+            return;
+        }
+        try {
+            setSkinHistory(await ipc.profile.skinHistory({ profileId: currentProfileId }));
+        } catch (err) {
+            console.error('Failed to load skin history:', err);
+            setSkinHistory([]);
+        }
+    }, [currentProfileId]);
+
+    useEffect(() => {
+        if (isOpen) loadSkinHistory();
+    }, [isOpen, loadSkinHistory]);
+
+    // Without an entry the newest one is restored; the replaced skin joins the history
+    const handleUndoSkinChange = async (entryId?: string) => {
+        if (!currentProfileId) return;
+        setSkinUndoError(null);
+        try {
+            const result = await ipc.profile.undoSkinChange({ profileId: currentProfileId, entryId });
+            if (!result.success) {
+                setSkinUndoError(result.error ?? t('profiles.skinHistory.undoFailed'));
+                return;
+            }
+            await Promise.all([loadAvatar(), loadProfiles(), loadSkinHistory()]);
+            onProfileUpdate?.();
+        } catch (err) {
+            console.error('Failed to undo skin change:', err);
+            setSkinUndoError(t('profiles.skinHistory.undoFailed'));
+        }
+    };
+
     // Poll for avatar updates while editor is open
     useEffect(() => {
         if (!isOpen) return;
@@ -231,12 +275,41 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
         }
     }, [loadProfiles]);
 
+    // Live feedback against the backend nickname rules while the name is being edited
+    useEffect(() => {
+        if (!isEditingUsername) {
+            setUsernameError(null);
+            return;
+        }
+        let cancelled = false;
+        const timer = setTimeout(async () => {
+            const problem = await ValidateNick(editUsername.trim()).catch(() => null);
+            if (cancelled) return;
+            if (!problem?.messageKey) {
+                setUsernameError(null);
+                return;
+            }
+            let message = t(problem.messageKey);
+            (problem.args ?? []).forEach((arg, i) => {
+                message = message.replace(new RegExp(`\\{${i}\\}`, 'g'), String(arg));
+            });
+            setUsernameError(message);
+        }, 250);
+        return () => {
+            cancelled = true;
+            clearTimeout(timer);
+        };
+    }, [editUsername, isEditingUsername, t]);
+
     const handleSaveUsername = async () => {
         const trimmedUsername = editUsername.trim();
-        if (trimmedUsername && trimmedUsername.length >= 1 && trimmedUsername.length <= 16) {
+        if (trimmedUsername && !(await ValidateNick(trimmedUsername))) {
             setSaveStatus('saving');
             try {
-                await SetNick(trimmedUsername);
+                if (!await SetNick(trimmedUsername)) {
+                    setSaveStatus('idle');
+                    return;
+                }
                 setUsernameState(trimmedUsername);
                 setIsEditingUsername(false);
                 setIsCreatingNewProfile(false);
@@ -632,7 +705,6 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
                                                         value={editUsername}
                                                         onChange={(e) => setEditUsername(e.target.value)}
                                                         onKeyDown={handleUsernameKeyDown}
-                                                        maxLength={16}
                                                         autoFocus
                                                         placeholder={isCreatingNewProfile ? t('profiles.enterName') : ''}
                                                         className="bg-[#2c2c2e] text-white text-xl font-bold px-3 py-1 rounded-lg border outline-none w-48 text-center"
@@ -699,6 +771,10 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
                                             )}
                                         </div>
                                         
+                                        {isEditingUsername && usernameError && (
+                                            <p className="text-xs text-red-400 text-center">{usernameError}</p>
+                                        )}
+
                                         {/* New profile hint */}
                                         {isCreatingNewProfile && isEditingUsername && (
                                             <p className="text-xs text-white/40 text-center">
@@ -795,6 +871,43 @@ export const ProfileEditor: React.FC<ProfileEditorProps> = ({ isOpen, onClose, o
                                         )}
                                     </div>
 
+                                    {/* Skin History */}
+                                    {skinHistory.length > 0 && (
+                                        <div className="p-4 rounded-2xl bg-[#2c2c2e] border border-white/[0.06]">
+                                            <div className="flex items-center justify-between mb-1">
+                                                <label className="text-sm text-white/60">{t('profiles.skinHistory.title')}</label>
+                                                <motion.button
+                                                    whileHover={{ scale: 1.05 }}
+                                                    whileTap={{ scale: 0.95 }}
+                                                    onClick={() => handleUndoSkinChange()}
+                                                    className="px-2 py-1 rounded-lg flex items-center gap-1 text-xs"
+                                                    style={{ backgroundColor: `${accentColor}33`, color: accentColor }}
+                                                >
+                                                    <Undo2 size={14} />
+                                                    {t('profiles.skinHistory.undo')}
+                                                </motion.button>
+                                            </div>
+                                            <p className="text-[11px] text-white/30 mb-3">{t('profiles.skinHistory.hint')}</p>
+                                            <div className="flex gap-2 overflow-x-auto pb-1">
+                                                {skinHistory.map((entry) => (
+                                                    <button
+                                                        key={entry.id}
+                                                        onClick={() => handleUndoSkinChange(entry.id)}
+                                                        title={t('profiles.skinHistory.restore', { date: new Date(entry.savedAtUtc).toLocaleString() })}
+                                                        className="flex-shrink-0 w-14 h-14 rounded-xl overflow-hidden border border-white/[0.08] hover:border-white/30 bg-[#1c1c1e] flex items-center justify-center transition-colors"
+                                                    >
+                                                        {entry.avatar ? (
+                                                            <img src={entry.avatar} className="w-full h-full object-cover object-[center_15%]" alt="" />
+                                                        ) : (
+                                                            <User size={20} className="text-white/30" />
+                                                        )}
+                                                    </button>
+                                                ))}
+                                            </div>
+                                            {skinUndoError && <p className="text-[11px] text-red-400 mt-2">{skinUndoError}</p>}
+                                        </div>
+                                    )}
+
                                     {/* Open Profile Folder Button */}
                                     <motion.button
                                         whileHover={{ scale: 1.02 }}
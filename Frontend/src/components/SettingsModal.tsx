@@ -1,8 +1,9 @@
 import React, { useState, useEffect, useRef } from 'react';
 import { useTranslation } from 'react-i18next';
 import { motion, AnimatePresence } from 'framer-motion';
-import { X, Github, Bug, Check, AlertTriangle, ChevronDown, ExternalLink, Power, FolderOpen, Trash2, Settings, Database, Globe, Code, Image, Loader2, FlaskConical, RotateCcw, Monitor, Zap, Download, HardDrive, Package, Box, Wifi, Server, Edit3, FileText } from 'lucide-react';
+import { X, Github, Bug, Check, AlertTriangle, ChevronDown, ExternalLink, Power, Minimize2, FolderOpen, Trash2, Settings, Database, Globe, Code, Image, Loader2, FlaskConical, RotateCcw, Monitor, Zap, Download, HardDrive, Package, Box, Wifi, Server, Edit3, FileText, ShieldAlert, Keyboard, FileCheck, Terminal, Copy, Gamepad2, Gauge } from 'lucide-react';
 import { ipc, on } from '@/lib/ipc';
+import type { AutomationApiStatus, QuickActionHotkey, SteamDeckStatus } from '@/lib/ipc';
 import { changeLanguage } from '../i18n';
 
 // Alias for compatibility — maps to ipc.browser.open
@@ -24,8 +25,11 @@ export interface InstalledVersionInfo {
 }
 
 // Settings-backed helpers
-async function GetCloseAfterLaunch(): Promise<boolean> { return (await ipc.settings.get()).closeAfterLaunch ?? false; }
 async function SetCloseAfterLaunch(v: boolean): Promise<void> { await ipc.settings.update({ closeAfterLaunch: v }); }
+async function SetMinimizeOnLaunch(v: boolean): Promise<void> { await ipc.settings.update({ minimizeOnLaunch: v }); }
+async function SetRestoreOnGameExit(v: boolean): Promise<void> { await ipc.settings.update({ restoreOnGameExit: v }); }
+async function SetVerifyFilesBeforeLaunch(v: boolean): Promise<void> { await ipc.settings.update({ verifyFilesBeforeLaunch: v }); }
+async function SetMeteredConnection(v: boolean): Promise<void> { await ipc.settings.update({ meteredConnection: v }); }
 async function GetBackgroundMode(): Promise<string> { return (await ipc.settings.get()).backgroundMode ?? 'image'; }
 async function SetBackgroundMode(v: string): Promise<void> { await ipc.settings.update({ backgroundMode: v }); }
 async function GetCustomInstanceDir(): Promise<string> { return (await ipc.settings.get()).instanceDirectory ?? ''; }
@@ -126,6 +130,18 @@ export const SettingsModal: React.FC<SettingsModalProps> = ({
 
     const [selectedLauncherBranch, setSelectedLauncherBranch] = useState(launcherBranch);
     const [closeAfterLaunch, setCloseAfterLaunch] = useState(false);
+    const [minimizeOnLaunch, setMinimizeOnLaunch] = useState(false);
+    const [restoreOnGameExit, setRestoreOnGameExit] = useState(true);
+    const [verifyFilesBeforeLaunch, setVerifyFilesBeforeLaunch] = useState(true);
+    const [meteredConnection, setMeteredConnection] = useState(false);
+    const [meteredThresholdMb, setMeteredThresholdMb] = useState(100);
+    const [errorReporting, setErrorReporting] = useState(false);
+    const [hotkeys, setHotkeys] = useState<QuickActionHotkey[]>([]);
+    const [hotkeyError, setHotkeyError] = useState<string | null>(null);
+    const [automationApi, setAutomationApi] = useState<AutomationApiStatus | null>(null);
+    const [tokenCopied, setTokenCopied] = useState(false);
+    const [steamDeck, setSteamDeck] = useState<SteamDeckStatus | null>(null);
+    const [steamShortcutMessage, setSteamShortcutMessage] = useState<{ type: 'success' | 'error'; text: string } | null>(null);
     const [launcherFolderPath, setLauncherFolderPath] = useState('');
     const [instanceDir, setInstanceDir] = useState('');
     const [devModeEnabled, setDevModeEnabled] = useState(false);
@@ -187,8 +203,17 @@ export const SettingsModal: React.FC<SettingsModalProps> = ({
     useEffect(() => {
         const loadSettings = async () => {
             try {
-                const closeAfter = await GetCloseAfterLaunch();
-                setCloseAfterLaunch(closeAfter);
+                const launchSettings = await ipc.settings.get();
+                setCloseAfterLaunch(launchSettings.closeAfterLaunch ?? false);
+                setMinimizeOnLaunch(launchSettings.minimizeOnLaunch ?? false);
+                setRestoreOnGameExit(launchSettings.restoreOnGameExit ?? true);
+                setVerifyFilesBeforeLaunch(launchSettings.verifyFilesBeforeLaunch ?? true);
+                setMeteredConnection(launchSettings.meteredConnection ?? false);
+                setMeteredThresholdMb(launchSettings.meteredConfirmThresholdMb ?? 100);
+                setErrorReporting((await ipc.errorReporting.status()).enabled);
+                setHotkeys(await ipc.quickActions.hotkeys());
+                setAutomationApi(await ipc.automation.status());
+                setSteamDeck(await ipc.steamDeck.status());
                 
                 const folderPath = await GetLauncherFolderPath();
                 setLauncherFolderPath(folderPath);
@@ -443,6 +468,74 @@ export const SettingsModal: React.FC<SettingsModalProps> = ({
         await SetCloseAfterLaunch(newValue);
     };
 
+    const handleGamepadModeChange = async (mode: SteamDeckStatus['gamepadModeSetting']) => {
+        const status = await ipc.steamDeck.setGamepadMode({ mode });
+        setSteamDeck(status);
+        window.dispatchEvent(new CustomEvent('hyprism:gamepad-mode', { detail: status.gamepadMode }));
+    };
+
+    const handleAddSteamShortcut = async () => {
+        const result = await ipc.steamDeck.addShortcut();
+        if (!result.success) {
+            setSteamShortcutMessage({ type: 'error', text: result.error ?? t('settings.generalSettings.steamShortcutFailed') });
+            return;
+        }
+        setSteamShortcutMessage({
+            type: 'success',
+            text: result.usersAdded === 0
+                ? t('settings.generalSettings.steamShortcutExists')
+                : result.restartSteam ? t('settings.generalSettings.steamShortcutRestart') : t('settings.generalSettings.steamShortcutAdded'),
+        });
+        setSteamDeck(await ipc.steamDeck.status());
+    };
+
+    const handleMinimizeOnLaunchChange = async () => {
+        const newValue = !minimizeOnLaunch;
+        setMinimizeOnLaunch(newValue);
+        await SetMinimizeOnLaunch(newValue);
+    };
+
+    const handleRestoreOnGameExitChange = async () => {
+        const newValue = !restoreOnGameExit;
+        setRestoreOnGameExit(newValue);
+        await SetRestoreOnGameExit(newValue);
+    };
+
+    const handleVerifyFilesBeforeLaunchChange = async () => {
+        const newValue = !verifyFilesBeforeLaunch;
+        setVerifyFilesBeforeLaunch(newValue);
+        await SetVerifyFilesBeforeLaunch(newValue);
+    };
+
+    const handleMeteredConnectionChange = async () => {
+        const newValue = !meteredConnection;
+        setMeteredConnection(newValue);
+        await SetMeteredConnection(newValue);
+    };
+
+    const handleErrorReportingChange = async () => {
+        const status = await ipc.errorReporting.setEnabled({ enabled: !errorReporting });
+        setErrorReporting(status.enabled);
+    };
+
+    const handleHotkeyChange = async (action: string, accelerator: string) => {
+        if (hotkeys.find(h => h.action === action)?.accelerator === accelerator.trim()) return;
+        const result = await ipc.quickActions.setHotkey({ action, accelerator });
+        setHotkeys(result.hotkeys);
+        setHotkeyError(result.error ?? null);
+    };
+
+    const handleAutomationApiChange = async () => {
+        setAutomationApi(await ipc.automation.setEnabled({ enabled: !automationApi?.enabled }));
+    };
+
+    const handleCopyAutomationToken = async () => {
+        if (!automationApi?.token) return;
+        await navigator.clipboard.writeText(automationApi.token);
+        setTokenCopied(true);
+        setTimeout(() => setTokenCopied(false), 2000);
+    };
+
     const handleOpenLauncherFolder = async () => {
         try {
             const path = launcherFolderPath || await GetLauncherFolderPath();
@@ -852,6 +945,272 @@ export const SettingsModal: React.FC<SettingsModalProps> = ({
                                             </div>
                                         </div>
 
+                                        {/* Minimize On Launch */}
+                                        <div 
+                                            className={`flex items-center justify-between p-4 rounded-2xl ${gc} cursor-pointer hover:border-white/[0.12] transition-all`}
+                                            onClick={handleMinimizeOnLaunchChange}
+                                        >
+                                            <div className="flex items-center gap-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <Minimize2 size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.minimizeLauncher')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.minimizeLauncherHint')}</p>
+                                                </div>
+                                            </div>
+                                            <div 
+                                                className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                style={{ backgroundColor: minimizeOnLaunch ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                            >
+                                                <div 
+                                                    className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${minimizeOnLaunch ? 'translate-x-6' : 'translate-x-1'}`}
+                                                    style={{ backgroundColor: minimizeOnLaunch ? accentTextColor : 'white' }}
+                                                />
+                                            </div>
+                                        </div>
+
+                                        {/* Restore When Game Exits */}
+                                        <div 
+                                            className={`flex items-center justify-between p-4 rounded-2xl ${gc} ${minimizeOnLaunch && !closeAfterLaunch ? 'cursor-pointer hover:border-white/[0.12]' : 'opacity-50 pointer-events-none'} transition-all`}
+                                            onClick={handleRestoreOnGameExitChange}
+                                        >
+                                            <div className="flex items-center gap-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <RotateCcw size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.restoreLauncher')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.restoreLauncherHint')}</p>
+                                                </div>
+                                            </div>
+                                            <div 
+                                                className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                style={{ backgroundColor: restoreOnGameExit ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                            >
+                                                <div 
+                                                    className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${restoreOnGameExit ? 'translate-x-6' : 'translate-x-1'}`}
+                                                    style={{ backgroundColor: restoreOnGameExit ? accentTextColor : 'white' }}
+                                                />
+                                            </div>
+                                        </div>
+
+                                        {/* Verify Game Files Before Launch */}
+                                        <div 
+                                            className={`flex items-center justify-between p-4 rounded-2xl ${gc} cursor-pointer hover:border-white/[0.12] transition-all`}
+                                            onClick={handleVerifyFilesBeforeLaunchChange}
+                                        >
+                                            <div className="flex items-center gap-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <FileCheck size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.verifyFilesBeforeLaunch')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.verifyFilesBeforeLaunchHint')}</p>
+                                                </div>
+                                            </div>
+                                            <div 
+                                                className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                style={{ backgroundColor: verifyFilesBeforeLaunch ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                            >
+                                                <div 
+                                                    className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${verifyFilesBeforeLaunch ? 'translate-x-6' : 'translate-x-1'}`}
+                                                    style={{ backgroundColor: verifyFilesBeforeLaunch ? accentTextColor : 'white' }}
+                                                />
+                                            </div>
+                                        </div>
+
+                                        {/* Metered Connection */}
+                                        <div 
+                                            className={`flex items-center justify-between p-4 rounded-2xl ${gc} cursor-pointer hover:border-white/[0.12] transition-all`}
+                                            onClick={handleMeteredConnectionChange}
+                                        >
+                                            <div className="flex items-center gap-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <Gauge size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.meteredConnection')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.meteredConnectionHint', { size: meteredThresholdMb })}</p>
+                                                </div>
+                                            </div>
+                                            <div 
+                                                className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                style={{ backgroundColor: meteredConnection ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                            >
+                                                <div 
+                                                    className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${meteredConnection ? 'translate-x-6' : 'translate-x-1'}`}
+                                                    style={{ backgroundColor: meteredConnection ? accentTextColor : 'white' }}
+                                                />
+                                            </div>
+                                        </div>
+
+                                        {/* Error Reporting (opt-in) */}
+                                        <div 
+                                            className={`flex items-center justify-between p-4 rounded-2xl ${gc} cursor-pointer hover:border-white/[0.12] transition-all`}
+                                            onClick={handleErrorReportingChange}
+                                        >
+                                            <div className="flex items-center gap-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <ShieldAlert size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.errorReporting')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.errorReportingHint')}</p>
+                                                </div>
+                                            </div>
+                                            <div 
+                                                className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                style={{ backgroundColor: errorReporting ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                            >
+                                                <div 
+                                                    className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${errorReporting ? 'translate-x-6' : 'translate-x-1'}`}
+                                                    style={{ backgroundColor: errorReporting ? accentTextColor : 'white' }}
+                                                />
+                                            </div>
+                                        </div>
+
+                                        {/* Global Hotkeys */}
+                                        <div className={`p-4 rounded-2xl ${gc}`}>
+                                            <div className="flex items-center gap-3 mb-3">
+                                                <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                    <Keyboard size={16} className="text-white/70" />
+                                                </div>
+                                                <div>
+                                                    <span className="text-white text-sm font-medium">{t('settings.generalSettings.globalHotkeys')}</span>
+                                                    <p className="text-xs text-white/40">{t('settings.generalSettings.globalHotkeysHint')}</p>
+                                                </div>
+                                            </div>
+                                            <div className="space-y-2">
+                                                {hotkeys.map((hotkey) => (
+                                                    <div key={hotkey.action} className="flex items-center justify-between gap-3">
+                                                        <span className="text-sm text-white/70">{t(`settings.generalSettings.hotkeyActions.${hotkey.action}`)}</span>
+                                                        <input
+                                                            key={`${hotkey.action}:${hotkey.accelerator}`}
+                                                            type="text"
+                                                            defaultValue={hotkey.accelerator}
+                                                            placeholder={t('settings.generalSettings.hotkeyPlaceholder')}
+                                                            onBlur={(e) => handleHotkeyChange(hotkey.action, e.target.value)}
+                                                            onKeyDown={(e) => { if (e.key === 'Enter') e.currentTarget.blur(); }}
+                                                            title={hotkey.accelerator && !hotkey.registered ? t('settings.generalSettings.hotkeyNotRegistered') : undefined}
+                                                            className={`w-56 h-9 px-3 rounded-lg ${gc} text-white text-sm focus:outline-none ${hotkey.accelerator && !hotkey.registered ? 'border border-red-500/60' : ''}`}
+                                                        />
+                                                    </div>
+                                                ))}
+                                            </div>
+                                            {hotkeyError && <p className="text-xs text-red-400 mt-2">{hotkeyError}</p>}
+                                        </div>
+
+                                        {/* Gamepad mode and Steam shortcut */}
+                                        <div className={`p-4 rounded-2xl ${gc}`}>
+                                            <div className="flex items-center justify-between gap-3">
+                                                <div className="flex items-center gap-3">
+                                                    <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                        <Gamepad2 size={16} className="text-white/70" />
+                                                    </div>
+                                                    <div>
+                                                        <span className="text-white text-sm font-medium">{t('settings.generalSettings.gamepadMode')}</span>
+                                                        <p className="text-xs text-white/40">
+                                                            {steamDeck?.isSteamDeck || steamDeck?.isGamescope
+                                                                ? t('settings.generalSettings.gamepadModeDetected')
+                                                                : t('settings.generalSettings.gamepadModeHint')}
+                                                        </p>
+                                                    </div>
+                                                </div>
+                                                <div className="flex gap-1 flex-shrink-0">
+                                                    {(['auto', 'on', 'off'] as const).map((mode) => {
+                                                        const isSelected = (steamDeck?.gamepadModeSetting ?? 'auto') === mode;
+                                                        return (
+                                                            <button
+                                                                key={mode}
+                                                                onClick={() => handleGamepadModeChange(mode)}
+                                                                className="px-3 h-8 rounded-lg text-xs font-medium transition-colors"
+                                                                style={isSelected
+                                                                    ? { backgroundColor: accentColor, color: accentTextColor }
+                                                                    : { backgroundColor: 'rgba(255,255,255,0.06)', color: 'rgba(255,255,255,0.7)' }}
+                                                            >
+                                                                {t(`settings.generalSettings.gamepadMode_${mode}`)}
+                                                            </button>
+                                                        );
+                                                    })}
+                                                </div>
+                                            </div>
+                                            {steamDeck?.steamFound && (
+                                                <div className="mt-3 flex items-center justify-between gap-3">
+                                                    <p className="text-xs text-white/40">
+                                                        {steamDeck.shortcutAdded ? t('settings.generalSettings.steamShortcutExists') : t('settings.generalSettings.steamShortcutHint')}
+                                                    </p>
+                                                    <button
+                                                        onClick={handleAddSteamShortcut}
+                                                        disabled={steamDeck.shortcutAdded}
+                                                        className="px-3 h-8 rounded-lg bg-white/[0.06] text-white/70 text-xs font-medium hover:bg-white/10 transition-colors flex-shrink-0 disabled:opacity-40 disabled:cursor-not-allowed"
+                                                    >
+                                                        {t('settings.generalSettings.steamShortcutAdd')}
+                                                    </button>
+                                                </div>
+                                            )}
+                                            {steamShortcutMessage && (
+                                                <p className={`text-xs mt-2 ${steamShortcutMessage.type === 'success' ? 'text-green-400' : 'text-red-400'}`}>
+                                                    {steamShortcutMessage.text}
+                                                </p>
+                                            )}
+                                        </div>
+
+                                        {/* Automation API (opt-in) */}
+                                        <div className={`p-4 rounded-2xl ${gc}`}>
+                                            <div
+                                                className="flex items-center justify-between cursor-pointer"
+                                                onClick={handleAutomationApiChange}
+                                            >
+                                                <div className="flex items-center gap-3">
+                                                    <div className="w-8 h-8 rounded-lg bg-white/[0.06] flex items-center justify-center">
+                                                        <Terminal size={16} className="text-white/70" />
+                                                    </div>
+                                                    <div>
+                                                        <span className="text-white text-sm font-medium">{t('settings.generalSettings.automationApi')}</span>
+                                                        <p className="text-xs text-white/40">{t('settings.generalSettings.automationApiHint')}</p>
+                                                    </div>
+                                                </div>
+                                                <div 
+                                                    className="w-12 h-7 rounded-full flex items-center transition-all duration-200"
+                                                    style={{ backgroundColor: automationApi?.enabled ? accentColor : 'rgba(255,255,255,0.15)' }}
+                                                >
+                                                    <div 
+                                                        className={`w-5 h-5 rounded-full shadow-md transform transition-all duration-200 ${automationApi?.enabled ? 'translate-x-6' : 'translate-x-1'}`}
+                                                        style={{ backgroundColor: automationApi?.enabled ? accentTextColor : 'white' }}
+                                                    />
+                                                </div>
+                                            </div>
+                                            {automationApi?.enabled && (
+                                                <div className="mt-3 space-y-2">
+                                                    <p className="text-xs text-white/50 font-mono break-all">{automationApi.url}</p>
+                                                    {!automationApi.running && <p className="text-xs text-red-400">{t('settings.generalSettings.automationApiNotRunning')}</p>}
+                                                    <div className="flex items-center gap-2">
+                                                        <input
+                                                            type="password"
+                                                            readOnly
+                                                            value={automationApi.token}
+                                                            className={`flex-1 h-9 px-3 rounded-lg ${gc} text-white text-sm font-mono focus:outline-none`}
+                                                        />
+                                                        <button
+                                                            onClick={handleCopyAutomationToken}
+                                                            title={t('settings.generalSettings.automationApiCopyToken')}
+                                                            className="w-9 h-9 rounded-lg bg-white/[0.06] flex items-center justify-center text-white/70 hover:bg-white/10 transition-colors"
+                                                        >
+                                                            {tokenCopied ? <Check size={16} /> : <Copy size={16} />}
+                                                        </button>
+                                                        <button
+                                                            onClick={async () => setAutomationApi(await ipc.automation.regenerateToken())}
+                                                            title={t('settings.generalSettings.automationApiRegenerateToken')}
+                                                            className="w-9 h-9 rounded-lg bg-white/[0.06] flex items-center justify-center text-white/70 hover:bg-white/10 transition-colors"
+                                                        >
+                                                            <RotateCcw size={16} />
+                                                        </button>
+                                                    </div>
+                                                </div>
+                                            )}
+                                        </div>
+
                                     </div>
                                 </div>
                             )}
@@ -1311,6 +1670,14 @@ export const SettingsModal: React.FC<SettingsModalProps> = ({
                                             <span>{t('settings.dataSettings.openLauncherFolder')}</span>
                                         </button>
 
+                                        <button
+                                            onClick={() => ipc.installLogs.openFolder()}
+                                            className={`w-full h-12 px-4 rounded-xl ${gc} flex items-center gap-3 text-white/70 hover:text-white hover:border-white/20 transition-colors`}
+                                        >
+                                            <FileText size={18} />
+                                            <span>{t('settings.dataSettings.openInstallLogs')}</span>
+                                        </button>
+
                                         <button
                                             onClick={() => setShowDeleteConfirm(true)}
                                             className={`w-full h-12 px-4 rounded-xl ${gc} !border-red-500/30 flex items-center gap-3 text-red-400 hover:text-red-300 hover:bg-red-500/10 transition-colors`}
@@ -4,15 +4,17 @@ import { DownloadCloud } from 'lucide-react';
 import { useTranslation } from 'react-i18next';
 import { useAccentColor } from '../../contexts/AccentColorContext';
 
-import { formatBytes } from '../../utils/format';
+import { formatBytes, formatDuration } from '../../utils/format';
 
 interface UpdateOverlayProps {
   progress: number;
   downloaded: number;
   total: number;
+  bytesPerSecond?: number;
+  etaSeconds?: number;
 }
 
-export const UpdateOverlay: React.FC<UpdateOverlayProps> = memo(({ progress, downloaded, total }) => {
+export const UpdateOverlay: React.FC<UpdateOverlayProps> = memo(({ progress, downloaded, total, bytesPerSecond, etaSeconds }) => {
   const { t } = useTranslation();
   const { accentColor } = useAccentColor();
 
@@ -60,6 +62,8 @@ export const UpdateOverlay: React.FC<UpdateOverlayProps> = memo(({ progress, dow
         <div className="flex justify-between items-center mt-4 text-sm">
           <span className="text-gray-400">
             {formatBytes(downloaded)} / {formatBytes(total)}
+            {!!bytesPerSecond && ` · ${formatBytes(bytesPerSecond)}/s`}
+            {etaSeconds != null && ` · ${formatDuration(etaSeconds)}`}
           </span>
           <span className="font-bold" style={{ color: accentColor }}>{Math.round(progress)}%</span>
         </div>
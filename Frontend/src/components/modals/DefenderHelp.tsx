@@ -0,0 +1,85 @@
+import React, { useEffect, useState } from 'react';
+import { ShieldAlert, Copy, Check } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { DefenderReport } from '@/lib/ipc';
+
+// Errors Defender quarantining or scanning freshly extracted files typically shows up as
+export const DEFENDER_ERROR_CODES = ['E_AV_BLOCKED', 'E_ACCESS_DENIED', 'E_FILES_ALTERED', 'E_CLIENT_MISSING', 'E_JAVA_MISSING'];
+
+const fileName = (path: string) => path.split(/[\\/]/).pop() || path;
+
+/**
+ * Explains what Microsoft Defender did to launcher files and offers the command that excludes the data folder.
+ * Renders nothing outside Windows.
+ */
+export const DefenderHelp: React.FC = () => {
+  const { t } = useTranslation();
+  const [report, setReport] = useState<DefenderReport | null>(null);
+  const [copied, setCopied] = useState(false);
+
+  useEffect(() => {
+    let cancelled = false;
+    ipc.system.defenderCheck()
+      .then((result) => { if (!cancelled) setReport(result); })
+      .catch(() => {});
+    return () => { cancelled = true; };
+  }, []);
+
+  if (!report?.supported) return null;
+
+  const copyCommand = () => {
+    navigator.clipboard.writeText(report.exclusionCommand);
+    setCopied(true);
+    setTimeout(() => setCopied(false), 2000);
+  };
+
+  return (
+    <div className="p-3 rounded-lg border border-white/5 bg-white/[0.03] flex gap-2">
+      <ShieldAlert size={16} className="text-blue-400 flex-shrink-0 mt-0.5" />
+      <div className="text-sm min-w-0 flex-1 space-y-2">
+        <p className="text-gray-400 text-xs font-medium">{t('error.defender.title')}</p>
+
+        {report.detections.length > 0 ? (
+          <>
+            <p className="text-gray-200">{t('error.defender.found', { count: report.detections.length })}</p>
+            <ul className="space-y-1 max-h-24 overflow-y-auto">
+              {report.detections.map((detection) => (
+                <li key={`${detection.path}:${detection.detectedAt}`} className="text-xs text-gray-400 truncate" title={detection.path}>
+                  {t(`error.defender.actions.${detection.action}`)}: <span className="text-gray-200">{fileName(detection.path)}</span>
+                  {detection.threatName && <span className="text-gray-500"> ({detection.threatName})</span>}
+                  {detection.detectedAt && <span className="text-gray-600"> · {new Date(detection.detectedAt).toLocaleString()}</span>}
+                </li>
+              ))}
+            </ul>
+          </>
+        ) : (
+          <p className="text-gray-200">
+            {report.defenderAvailable ? t('error.defender.noneFound') : t('error.defender.unavailable')}
+          </p>
+        )}
+
+        {report.controlledFolderAccess && (
+          <p className="text-xs text-yellow-400">{t('error.defender.controlledFolderAccess')}</p>
+        )}
+
+        {report.defenderAvailable && (
+          <div>
+            <p className="text-xs text-gray-400 mb-1">{t('error.defender.exclusionHint')}</p>
+            <div className="flex items-start gap-2 p-2 rounded-md bg-black/50 border border-white/5">
+              <code className="flex-1 text-xs text-gray-300 font-mono break-all">{report.exclusionCommand}</code>
+              <button
+                onClick={copyCommand}
+                className="p-1 rounded text-gray-400 hover:text-white transition-colors flex-shrink-0"
+                title={copied ? t('common.copied') : t('error.defender.copyCommand')}
+              >
+                {copied ? <Check size={14} /> : <Copy size={14} />}
+              </button>
+            </div>
+            <p className="mt-1 text-[11px] text-gray-500">{t('error.defender.exclusionWarning')}</p>
+          </div>
+        )}
+      </div>
+    </div>
+  );
+};
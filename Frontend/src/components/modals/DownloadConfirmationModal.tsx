@@ -0,0 +1,78 @@
+import React, { useState } from 'react';
+import { motion } from 'framer-motion';
+import { Gauge, Loader2 } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { DownloadConfirmation } from '@/lib/ipc';
+import { formatBytes } from '../../utils/format';
+import { ModalOverlay } from './ModalOverlay';
+
+interface DownloadConfirmationModalProps {
+  confirmation: DownloadConfirmation;
+  onAnswered: () => void;
+}
+
+export const DownloadConfirmationModal: React.FC<DownloadConfirmationModalProps> = ({ confirmation, onAnswered }) => {
+  const { t } = useTranslation();
+  const [busy, setBusy] = useState(false);
+
+  const respond = async (allow: boolean) => {
+    setBusy(true);
+    try {
+      await ipc.metered.respond({ id: confirmation.id, allow });
+    } catch (e) {
+      console.warn('[IPC] metered respond:', e);
+    } finally {
+      setBusy(false);
+      onAnswered();
+    }
+  };
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-md overflow-hidden glass-panel-static-solid !border-amber-500/20"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-amber-500/10 flex items-center justify-center mb-4">
+            <Gauge size={28} className="text-amber-400" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('metered.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('metered.description')}</p>
+        </div>
+
+        <div className="px-6 pb-4">
+          <div className="flex items-center justify-between px-3 py-2 rounded-lg bg-white/5 text-sm">
+            <span className="text-white truncate">{confirmation.name}</span>
+            <span className="text-white/50 flex-shrink-0 ml-2">
+              {confirmation.bytes >= 0 ? formatBytes(confirmation.bytes) : t('metered.unknownSize')}
+            </span>
+          </div>
+          <p className="mt-2 text-xs text-white/50">{t(`metered.categories.${confirmation.category}`)}</p>
+        </div>
+
+        <div className="flex gap-2 p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={() => respond(false)}
+            disabled={busy}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium disabled:opacity-40"
+          >
+            {t('metered.decline')}
+          </button>
+          <button
+            onClick={() => respond(true)}
+            disabled={busy}
+            className="flex-1 flex items-center justify-center gap-2 px-4 py-3 rounded-xl bg-amber-500/20 text-amber-300 hover:bg-amber-500/30 transition-colors font-medium disabled:opacity-40"
+          >
+            {busy && <Loader2 size={16} className="animate-spin" />}
+            {t('metered.allow')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
@@ -1,10 +1,16 @@
 import React, { useState, useEffect, useRef } from 'react';
 import { motion, AnimatePresence } from 'framer-motion';
 import { useTranslation } from 'react-i18next';
-import { X, Image, Loader2 } from 'lucide-react';
+import { X, Image, Loader2, Terminal, Copy, FileDown } from 'lucide-react';
 import { useAccentColor } from '../../contexts/AccentColorContext';
+import { getBundledArtworkUrl, resolveArtworkUrl } from '../../utils/artwork';
 
-import { invoke } from '@/lib/ipc';
+import { invoke, ipc } from '@/lib/ipc';
+import type { DisplayServerSettings, LaunchCommand } from '@/lib/ipc';
+
+type DisplayServerMode = DisplayServerSettings['mode'];
+type ArtworkTarget = 'icon' | 'banner';
+const DISPLAY_SERVER_MODES: DisplayServerMode[] = ['auto', 'wayland', 'x11'];
 
 interface EditInstanceModalProps {
   isOpen: boolean;
@@ -31,9 +37,26 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
   const [customName, setCustomName] = useState<string>(initialName);
   const [iconFile, setIconFile] = useState<File | null>(null);
   const [iconPreview, setIconPreview] = useState<string | null>(initialIconUrl || null);
+  const [iconBundled, setIconBundled] = useState<string | null>(null);
+  const [bannerFile, setBannerFile] = useState<File | null>(null);
+  const [bannerBundled, setBannerBundled] = useState<string | null>(null);
+  const [bannerCleared, setBannerCleared] = useState(false);
+  const [bannerPreview, setBannerPreview] = useState<string | null>(null);
+  const [bundledArtwork, setBundledArtwork] = useState<string[]>([]);
+  const [artworkTarget, setArtworkTarget] = useState<ArtworkTarget>('icon');
   const [isSaving, setIsSaving] = useState(false);
+  const [extraArgs, setExtraArgs] = useState<string>('');
+  const [initialExtraArgs, setInitialExtraArgs] = useState<string>('');
+  const [displayServer, setDisplayServer] = useState<DisplayServerSettings | null>(null);
+  const [initialDisplayServer, setInitialDisplayServer] = useState<DisplayServerMode>('auto');
+  const [gameVersion, setGameVersion] = useState<string>('');
+  const [initialGameVersion, setInitialGameVersion] = useState<string>('');
+  const [gameVersions, setGameVersions] = useState<string[]>([]);
+  const [preview, setPreview] = useState<LaunchCommand | null>(null);
+  const [scriptResult, setScriptResult] = useState<{ path?: string; error?: string } | null>(null);
 
   const fileInputRef = useRef<HTMLInputElement>(null);
+  const bannerInputRef = useRef<HTMLInputElement>(null);
 
   // Reset state when modal opens
   useEffect(() => {
@@ -41,14 +64,68 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
       setCustomName(initialName);
       setIconPreview(initialIconUrl || null);
       setIconFile(null);
+      setIconBundled(null);
+      setBannerFile(null);
+      setBannerBundled(null);
+      setBannerCleared(false);
+      setBannerPreview(null);
       setIsSaving(false);
+      setPreview(null);
+      setScriptResult(null);
+      ipc.instance.getExtraArgs({ instanceId }).then((value) => {
+        setExtraArgs(value);
+        setInitialExtraArgs(value);
+      }).catch(() => {
+        setExtraArgs('');
+        setInitialExtraArgs('');
+      });
+      ipc.instance.getDisplayServer({ instanceId }).then((value) => {
+        setDisplayServer(value);
+        setInitialDisplayServer(value.mode);
+      }).catch(() => setDisplayServer(null));
+      ipc.instance.getGameVersion({ instanceId }).then((value) => {
+        setGameVersion(value ?? '');
+        setInitialGameVersion(value ?? '');
+      }).catch(() => {
+        setGameVersion('');
+        setInitialGameVersion('');
+      });
+      ipc.mods.gameVersions().then(setGameVersions).catch(() => setGameVersions([]));
+      ipc.instance.getArtwork({ instanceId })
+        .then((artwork) => setBannerPreview(resolveArtworkUrl(artwork.banner, true)))
+        .catch(() => setBannerPreview(null));
+      ipc.instance.bundledArtwork().then(setBundledArtwork).catch(() => setBundledArtwork([]));
+    }
+  }, [isOpen, instanceId, initialName, initialIconUrl]);
+
+  // The preview reflects saved arguments and display server, so save pending ones first
+  const saveExtraArgs = async () => {
+    if (extraArgs !== initialExtraArgs) {
+      await ipc.instance.setExtraArgs({ instanceId, extraArgs: extraArgs.trim() || null });
+      setInitialExtraArgs(extraArgs);
+    }
+    if (displayServer && displayServer.mode !== initialDisplayServer) {
+      await ipc.instance.setDisplayServer({ instanceId, mode: displayServer.mode });
+      setInitialDisplayServer(displayServer.mode);
     }
-  }, [isOpen, initialName, initialIconUrl]);
+  };
+
+  const handlePreview = async () => {
+    await saveExtraArgs();
+    setPreview(await ipc.instance.launchPreview({ instanceId }));
+  };
+
+  const handleExportScript = async () => {
+    await saveExtraArgs();
+    const result = await ipc.instance.exportLaunchScript({ instanceId });
+    setScriptResult(result.path || result.error ? result : null);
+  };
 
   const handleIconSelect = (e: React.ChangeEvent<HTMLInputElement>) => {
     const file = e.target.files?.[0];
     if (file) {
       setIconFile(file);
+      setIconBundled(null);
       // Create preview URL
       const reader = new FileReader();
       reader.onloadend = () => {
@@ -58,6 +135,41 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
     }
   };
 
+  const handleBannerSelect = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const file = e.target.files?.[0];
+    if (file) {
+      setBannerFile(file);
+      setBannerBundled(null);
+      setBannerCleared(false);
+      const reader = new FileReader();
+      reader.onloadend = () => {
+        setBannerPreview(reader.result as string);
+      };
+      reader.readAsDataURL(file);
+    }
+  };
+
+  const handleBannerClear = () => {
+    setBannerFile(null);
+    setBannerBundled(null);
+    setBannerCleared(true);
+    setBannerPreview(null);
+  };
+
+  const handleBundledSelect = (name: string) => {
+    const url = getBundledArtworkUrl(name);
+    if (artworkTarget === 'icon') {
+      setIconBundled(name);
+      setIconFile(null);
+      setIconPreview(url);
+    } else {
+      setBannerBundled(name);
+      setBannerFile(null);
+      setBannerCleared(false);
+      setBannerPreview(url);
+    }
+  };
+
   const handleSave = async () => {
     if (isSaving) return;
     setIsSaving(true);
@@ -71,6 +183,12 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
         });
       }
 
+      await saveExtraArgs();
+
+      if (gameVersion !== initialGameVersion) {
+        await ipc.instance.setGameVersion({ instanceId, gameVersion: gameVersion || null });
+      }
+
       // Handle icon upload if provided
       if (iconFile) {
         try {
@@ -82,6 +200,21 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
         } catch (err) {
           console.warn('Failed to set icon:', err);
         }
+      } else if (iconBundled) {
+        await ipc.instance.setArtwork({ instanceId, kind: 'icon', bundled: iconBundled });
+      }
+
+      if (bannerFile) {
+        try {
+          const base64 = await fileToBase64(bannerFile);
+          await ipc.instance.setArtwork({ instanceId, kind: 'banner', imageBase64: base64 });
+        } catch (err) {
+          console.warn('Failed to set banner:', err);
+        }
+      } else if (bannerBundled) {
+        await ipc.instance.setArtwork({ instanceId, kind: 'banner', bundled: bannerBundled });
+      } else if (bannerCleared) {
+        await ipc.instance.setArtwork({ instanceId, kind: 'banner' });
       }
 
       // Notify parent
@@ -176,6 +309,174 @@ export const EditInstanceModal: React.FC<EditInstanceModalProps> = ({
                 />
               </div>
             </div>
+
+            {/* Banner */}
+            <div className="space-y-1">
+              <div className="flex items-center justify-between">
+                <label className="text-xs text-white/50">{t('instances.banner')}</label>
+                {bannerPreview && (
+                  <button
+                    onClick={handleBannerClear}
+                    className="text-xs text-white/40 hover:text-white transition-colors"
+                  >
+                    {t('instances.removeBanner')}
+                  </button>
+                )}
+              </div>
+              <div
+                className="h-16 rounded-xl border-2 border-dashed flex items-center justify-center overflow-hidden cursor-pointer hover:border-white/40 transition-colors bg-cover bg-center"
+                style={{
+                  borderColor: bannerPreview ? accentColor : 'rgba(255,255,255,0.2)',
+                  backgroundImage: bannerPreview ? `url("${bannerPreview}")` : undefined,
+                }}
+                onClick={() => bannerInputRef.current?.click()}
+              >
+                {!bannerPreview && <Image size={20} className="text-white/30" />}
+              </div>
+              <input
+                ref={bannerInputRef}
+                type="file"
+                accept="image/png,image/jpeg,image/webp"
+                className="hidden"
+                onChange={handleBannerSelect}
+              />
+            </div>
+
+            {/* Bundled artwork */}
+            {bundledArtwork.length > 0 && (
+              <div className="space-y-1">
+                <div className="flex items-center justify-between">
+                  <label className="text-xs text-white/50">{t('instances.bundledArtwork')}</label>
+                  <div className="flex gap-1 p-0.5 rounded-lg bg-[#2c2c2e]">
+                    {(['icon', 'banner'] as ArtworkTarget[]).map((target) => (
+                      <button
+                        key={target}
+                        onClick={() => setArtworkTarget(target)}
+                        className="px-2 py-0.5 rounded-md text-xs transition-colors"
+                        style={artworkTarget === target ? { backgroundColor: accentColor, color: accentTextColor } : { color: 'rgba(255,255,255,0.5)' }}
+                      >
+                        {t(target === 'icon' ? 'instances.artworkIcon' : 'instances.artworkBanner')}
+                      </button>
+                    ))}
+                  </div>
+                </div>
+                <div className="flex gap-2 overflow-x-auto pb-1">
+                  {bundledArtwork.map((name) => {
+                    const url = getBundledArtworkUrl(name);
+                    if (!url) return null;
+                    const selected = (artworkTarget === 'icon' ? iconBundled : bannerBundled) === name;
+                    return (
+                      <button
+                        key={name}
+                        onClick={() => handleBundledSelect(name)}
+                        className="w-14 h-10 flex-shrink-0 rounded-lg overflow-hidden border-2 transition-colors"
+                        style={{ borderColor: selected ? accentColor : 'transparent' }}
+                      >
+                        <img src={url} alt="" loading="lazy" className="w-full h-full object-cover" />
+                      </button>
+                    );
+                  })}
+                </div>
+              </div>
+            )}
+
+            {/* Extra client arguments */}
+            <div className="space-y-1">
+              <label className="text-xs text-white/50">{t('instances.extraClientArgs')}</label>
+              <div className="flex gap-2">
+                <input
+                  type="text"
+                  value={extraArgs}
+                  onChange={(e) => setExtraArgs(e.target.value)}
+                  placeholder={t('instances.extraClientArgsPlaceholder')}
+                  className="flex-1 h-10 px-3 rounded-xl bg-[#2c2c2e] border border-white/[0.06] text-white text-sm font-mono focus:outline-none focus:border-white/20 transition-colors"
+                />
+                <button
+                  onClick={handlePreview}
+                  title={t('instances.launchPreview')}
+                  className="h-10 px-3 rounded-xl bg-white/5 text-white/60 hover:text-white hover:bg-white/10 transition-colors"
+                >
+                  <Terminal size={16} />
+                </button>
+                <button
+                  onClick={handleExportScript}
+                  title={t('instances.exportLaunchScript')}
+                  className="h-10 px-3 rounded-xl bg-white/5 text-white/60 hover:text-white hover:bg-white/10 transition-colors"
+                >
+                  <FileDown size={16} />
+                </button>
+              </div>
+              <p className="text-[11px] text-white/30">{t('instances.extraClientArgsHint')}</p>
+              {scriptResult && (
+                <p className={`text-[11px] break-all ${scriptResult.error ? 'text-red-400' : 'text-white/50'}`}>
+                  {scriptResult.error
+                    ? t('instances.exportLaunchScriptFailed', { error: scriptResult.error })
+                    : t('instances.exportLaunchScriptDone', { path: scriptResult.path })}
+                </p>
+              )}
+            </div>
+
+            {/* Display server (Linux only) */}
+            {displayServer?.supported && (
+              <div className="space-y-1">
+                <label className="text-xs text-white/50">{t('instances.displayServer')}</label>
+                <div className="flex gap-2">
+                  {DISPLAY_SERVER_MODES.map((mode) => {
+                    const active = displayServer.mode === mode;
+                    return (
+                      <button
+                        key={mode}
+                        onClick={() => setDisplayServer({ ...displayServer, mode })}
+                        className={`flex-1 h-9 rounded-xl text-sm transition-colors ${active ? 'font-semibold' : 'bg-white/5 text-white/60 hover:text-white hover:bg-white/10'}`}
+                        style={active ? { backgroundColor: accentColor, color: accentTextColor } : undefined}
+                      >
+                        {t(`instances.displayServerModes.${mode}`)}
+                      </button>
+                    );
+                  })}
+                </div>
+                <p className="text-[11px] text-white/30">
+                  {t('instances.displayServerHint', { session: displayServer.session ?? t('instances.displayServerUnknown') })}
+                </p>
+              </div>
+            )}
+
+            {/* Game version mods are checked against at launch */}
+            <div className="space-y-1">
+              <label className="text-xs text-white/50">{t('instances.gameVersion')}</label>
+              <select
+                value={gameVersion}
+                onChange={(e) => setGameVersion(e.target.value)}
+                className="w-full h-10 px-3 rounded-xl bg-[#2c2c2e] border border-white/[0.06] text-white text-sm focus:outline-none focus:border-white/20 transition-colors"
+              >
+                <option value="">{t('instances.gameVersionNone')}</option>
+                {/* Keep a saved version selectable even if CurseForge no longer lists it */}
+                {gameVersion && !gameVersions.includes(gameVersion) && <option value={gameVersion}>{gameVersion}</option>}
+                {gameVersions.map((version) => (
+                  <option key={version} value={version}>{version}</option>
+                ))}
+              </select>
+              <p className="text-[11px] text-white/30">{t('instances.gameVersionHint')}</p>
+            </div>
+
+            {preview && (
+              <div className="space-y-1">
+                <div className="flex items-center justify-between">
+                  <label className="text-xs text-white/50">{t('instances.launchPreview')}</label>
+                  <button
+                    onClick={() => navigator.clipboard.writeText(preview.commandLine)}
+                    title={t('common.copy')}
+                    className="p-1 rounded-lg text-white/40 hover:text-white hover:bg-white/10 transition-colors"
+                  >
+                    <Copy size={14} />
+                  </button>
+                </div>
+                <pre className="max-h-40 overflow-auto p-3 rounded-xl bg-black/40 border border-white/[0.06] text-[11px] text-white/70 font-mono whitespace-pre-wrap break-all select-text">
+                  {preview.commandLine}
+                </pre>
+                <p className="text-[11px] text-white/30">{t('instances.launchPreviewHint')}</p>
+              </div>
+            )}
           </div>
 
           {/* Footer */}
@@ -1,10 +1,11 @@
 import React from 'react';
 import { motion } from 'framer-motion';
-import { AlertTriangle, X, Copy, RefreshCw, Bug } from 'lucide-react';
+import { AlertTriangle, X, Copy, RefreshCw, Bug, Lightbulb, ExternalLink, Wrench } from 'lucide-react';
 import { useTranslation } from 'react-i18next';
 import { ipc } from '@/lib/ipc';
 
 import { ModalOverlay } from './ModalOverlay';
+import { DefenderHelp, DEFENDER_ERROR_CODES } from './DefenderHelp';
 
 interface ErrorModalProps {
   error: {
@@ -13,50 +14,37 @@ interface ErrorModalProps {
     technical?: string;
     timestamp?: string;
     launcherVersion?: string;
+    // Stable code from the backend (e.g. E_DISK_FULL) with a remediation hint and guide link
+    code?: string;
+    hint?: string;
+    helpUrl?: string;
   };
   onClose: () => void;
+  // Offered for errors a repair fixes, e.g. altered game files
+  onRepair?: () => void;
 }
 
-export const ErrorModal: React.FC<ErrorModalProps> = ({ error, onClose }) => {
+export const ErrorModal: React.FC<ErrorModalProps> = ({ error, onClose, onRepair }) => {
   const { t } = useTranslation();
 
   const [copied, setCopied] = React.useState(false);
 
   const copyError = () => {
-    const errorText = `Error Type: ${error.type}\nMessage: ${error.message}\nTechnical: ${error.technical || 'N/A'}\nTimestamp: ${error.timestamp || new Date().toISOString()}\nLauncher Version: ${error.launcherVersion || 'Unknown'}`;
+    const errorText = `Error Type: ${error.type}\n${error.code ? `Code: ${error.code}\n` : ''}Message: ${error.message}\nTechnical: ${error.technical || 'N/A'}\nTimestamp: ${error.timestamp || new Date().toISOString()}\nLauncher Version: ${error.launcherVersion || 'Unknown'}`;
     navigator.clipboard.writeText(errorText);
     setCopied(true);
     setTimeout(() => setCopied(false), 2000);
   };
 
+  // The backend builds the issue (environment block, sanitized log excerpt) and opens it in the browser
   const reportIssue = () => {
-    const title = encodeURIComponent(`[Bug] ${error.type}: ${error.message}`);
-    const body = encodeURIComponent(
-`## Description
-<!-- Please describe what you were doing when the error occurred -->
-
-## Error Details
-- **Type:** ${error.type}
-- **Message:** ${error.message}
-- **Technical:** ${error.technical || 'N/A'}
-- **Timestamp:** ${error.timestamp || new Date().toISOString()}
-- **Launcher Version:** ${error.launcherVersion || 'Unknown'}
-
-## System Info
-- **Platform:** ${navigator.platform}
-- **User Agent:** ${navigator.userAgent}
-
-## Steps to Reproduce
-1. 
-2. 
-3. 
-
-## Additional Context
-<!-- Add any other context about the problem here -->
-`
-    );
-    const url = `https://github.com/yyyumeniku/HyPrism/issues/new?title=${title}&body=${body}&labels=bug`;
-    ipc.browser.open(url);
+    ipc.app.reportIssue({
+      type: error.type,
+      message: error.message,
+      code: error.code,
+      technical: error.technical,
+      timestamp: error.timestamp,
+    });
   };
 
   const getErrorColor = (type: string) => {
@@ -89,6 +77,7 @@ export const ErrorModal: React.FC<ErrorModalProps> = ({ error, onClose }) => {
               <h2 className="text-lg font-bold text-white">{t('error.title')}</h2>
               <span className={`text-xs font-medium ${getErrorColor(error.type)}`}>
                 {error.type}
+                {error.code && <span className="ml-2 font-mono text-gray-500">{error.code}</span>}
               </span>
             </div>
           </div>
@@ -104,6 +93,29 @@ export const ErrorModal: React.FC<ErrorModalProps> = ({ error, onClose }) => {
         <div className="p-5 space-y-4">
           <div>
             <h3 className="text-white font-medium mb-1">{error.message}</h3>
+            {error.hint && (
+              <div className="mt-3 p-3 rounded-lg border border-white/5 bg-white/[0.03] flex gap-2">
+                <Lightbulb size={16} className="text-yellow-400 flex-shrink-0 mt-0.5" />
+                <div className="text-sm">
+                  <p className="text-gray-400 text-xs font-medium mb-0.5">{t('error.whatToDo')}</p>
+                  <p className="text-gray-200">{error.hint}</p>
+                  {error.helpUrl && (
+                    <button
+                      onClick={() => ipc.browser.open(error.helpUrl!)}
+                      className="mt-1.5 text-xs text-blue-400 hover:text-blue-300 flex items-center gap-1 transition-colors"
+                    >
+                      {t('error.troubleshoot')}
+                      <ExternalLink size={11} />
+                    </button>
+                  )}
+                </div>
+              </div>
+            )}
+            {error.code && DEFENDER_ERROR_CODES.includes(error.code) && (
+              <div className="mt-3">
+                <DefenderHelp />
+              </div>
+            )}
             {error.technical && (
               <div className="mt-3 p-3 bg-black/50 rounded-lg border border-white/5">
                 <p className="text-xs text-gray-400 font-mono break-all">
@@ -153,12 +165,23 @@ export const ErrorModal: React.FC<ErrorModalProps> = ({ error, onClose }) => {
             </button>
           </div>
 
-          <button
-            onClick={onClose}
-            className="px-5 py-2 rounded-lg bg-red-500/20 text-red-400 hover:bg-red-500/30 transition-colors font-medium text-sm"
-          >
-            {t('common.dismiss')}
-          </button>
+          <div className="flex gap-2">
+            {onRepair && (
+              <button
+                onClick={onRepair}
+                className="px-5 py-2 rounded-lg bg-white/10 text-white hover:bg-white/15 transition-colors font-medium text-sm flex items-center gap-2"
+              >
+                <Wrench size={14} />
+                {t('error.repair')}
+              </button>
+            )}
+            <button
+              onClick={onClose}
+              className="px-5 py-2 rounded-lg bg-red-500/20 text-red-400 hover:bg-red-500/30 transition-colors font-medium text-sm"
+            >
+              {t('common.dismiss')}
+            </button>
+          </div>
         </div>
       </motion.div>
     </ModalOverlay>
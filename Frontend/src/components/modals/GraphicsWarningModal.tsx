@@ -0,0 +1,56 @@
+import React from 'react';
+import { motion } from 'framer-motion';
+import { MonitorX } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import type { GraphicsDiagnostics } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface GraphicsWarningModalProps {
+  diagnostics: GraphicsDiagnostics;
+  onClose: () => void;
+}
+
+export const GraphicsWarningModal: React.FC<GraphicsWarningModalProps> = ({ diagnostics, onClose }) => {
+  const { t } = useTranslation();
+  const warnings = diagnostics.warnings.filter((w) => w.severity !== 'info');
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-md overflow-hidden glass-panel-static-solid !border-amber-500/20"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-amber-500/10 flex items-center justify-center mb-4">
+            <MonitorX size={28} className="text-amber-400" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('graphics.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('graphics.hint')}</p>
+        </div>
+
+        <ul className="px-6 pb-6 space-y-2">
+          {warnings.map((warning) => (
+            <li
+              key={warning.id}
+              className={`text-sm rounded-xl px-4 py-3 bg-white/5 ${warning.severity === 'error' ? 'text-red-300' : 'text-amber-200'}`}
+            >
+              {t(`graphics.warnings.${warning.id}`, { defaultValue: warning.message })}
+            </li>
+          ))}
+        </ul>
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={onClose}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium"
+          >
+            {t('common.ok')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
@@ -0,0 +1,185 @@
+import React, { useCallback, useEffect, useState } from 'react';
+import { motion } from 'framer-motion';
+import { Check, FolderOpen, Globe, Loader2, X } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc, type SaveInfo } from '@/lib/ipc';
+import { useAccentColor } from '../../contexts/AccentColorContext';
+import { formatBytes } from '../../utils/format';
+import { ModalOverlay } from './ModalOverlay';
+
+interface ImportOfficialWorldsModalProps {
+  instanceId: string;
+  instanceName: string;
+  onClose: () => void;
+  /** Called with the names the worlds got in the instance. */
+  onImported: (names: string[]) => void;
+}
+
+/** Path of a world without its folder name, i.e. the saves folder it was found in. */
+const savesDirOf = (save: SaveInfo) => save.path.replace(/[\\/][^\\/]+[\\/]?$/, '');
+
+export const ImportOfficialWorldsModal: React.FC<ImportOfficialWorldsModalProps> = ({ instanceId, instanceName, onClose, onImported }) => {
+  const { t } = useTranslation();
+  const { accentColor } = useAccentColor();
+  const [dirs, setDirs] = useState<string[]>([]);
+  const [saves, setSaves] = useState<SaveInfo[]>([]);
+  const [selected, setSelected] = useState<Set<string>>(new Set());
+  const [isLoading, setIsLoading] = useState(true);
+  const [isImporting, setIsImporting] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const scan = useCallback(async (savesDir?: string) => {
+    setIsLoading(true);
+    setError(null);
+    try {
+      const result = await ipc.instance.officialSaves(savesDir ? { savesDir } : {});
+      setDirs(result.dirs);
+      setSaves(result.saves);
+    } catch (e) {
+      console.warn('[IPC] official saves:', e);
+      setDirs([]);
+      setSaves([]);
+    }
+    setSelected(new Set());
+    setIsLoading(false);
+  }, []);
+
+  useEffect(() => {
+    scan();
+  }, [scan]);
+
+  const handleBrowse = async () => {
+    const folder = await ipc.file.browseFolder();
+    if (folder) scan(folder);
+  };
+
+  const toggle = (save: SaveInfo) => {
+    setSelected((prev) => {
+      const next = new Set(prev);
+      if (next.has(save.path)) next.delete(save.path);
+      else next.add(save.path);
+      return next;
+    });
+  };
+
+  const handleImport = async () => {
+    setIsImporting(true);
+    setError(null);
+    const imported: string[] = [];
+    try {
+      // Worlds can come from several saves folders; import each folder's worlds in one call
+      const bySavesDir = new Map<string, string[]>();
+      for (const save of saves.filter((s) => selected.has(s.path))) {
+        const dir = savesDirOf(save);
+        bySavesDir.set(dir, [...(bySavesDir.get(dir) ?? []), save.name]);
+      }
+      for (const [sourceDir, saveNames] of bySavesDir) {
+        imported.push(...await ipc.instance.importSaves({ instanceId, sourceDir, saveNames }));
+      }
+    } catch (e) {
+      console.warn('[IPC] import saves:', e);
+    }
+    setIsImporting(false);
+
+    if (imported.length === selected.size) {
+      onImported(imported);
+    } else {
+      setError(t('instances.importWorlds.failed', { count: selected.size - imported.length }));
+      if (imported.length > 0) scan(dirs.length === 1 ? dirs[0] : undefined);
+    }
+  };
+
+  return (
+    <ModalOverlay zClass="z-[300]" onClick={(e) => e.target === e.currentTarget && !isImporting && onClose()}>
+      <motion.div
+        initial={{ scale: 0.95, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.95, opacity: 0 }}
+        className="w-full max-w-md mx-4 overflow-hidden glass-panel-static-solid"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex items-center justify-between px-6 pt-6 pb-2">
+          <div className="min-w-0">
+            <h3 className="text-white font-bold text-lg">{t('instances.importWorlds.title')}</h3>
+            <p className="text-white/40 text-xs truncate">{instanceName}</p>
+          </div>
+          <button
+            onClick={onClose}
+            disabled={isImporting}
+            className="p-2 rounded-lg text-white/50 hover:text-white hover:bg-white/10 transition-colors"
+          >
+            <X size={16} />
+          </button>
+        </div>
+
+        <div className="px-6 flex items-center gap-2">
+          <p className="flex-1 min-w-0 text-white/50 text-xs truncate" title={dirs.join('\n')}>
+            {dirs.length > 0 ? dirs.join(', ') : t('instances.importWorlds.notFound')}
+          </p>
+          <button
+            onClick={handleBrowse}
+            disabled={isImporting}
+            title={t('instances.importWorlds.browse')}
+            className="p-2 rounded-lg text-white/60 hover:text-white hover:bg-white/10 transition-colors flex-shrink-0"
+          >
+            <FolderOpen size={14} />
+          </button>
+        </div>
+
+        <div className="px-6 py-4 space-y-2 max-h-80 overflow-y-auto">
+          {isLoading ? (
+            <div className="flex justify-center py-6">
+              <Loader2 size={20} className="animate-spin text-white/40" />
+            </div>
+          ) : saves.length === 0 ? (
+            <p className="text-center text-white/40 text-sm py-6">{t('instances.importWorlds.empty')}</p>
+          ) : (
+            saves.map((save) => {
+              const isSelected = selected.has(save.path);
+              return (
+                <button
+                  key={save.path}
+                  onClick={() => toggle(save)}
+                  disabled={isImporting}
+                  className={`w-full flex items-center gap-3 rounded-xl px-3 py-2 text-left transition-colors ${
+                    isSelected ? 'bg-white/10' : 'bg-white/5 hover:bg-white/10'
+                  }`}
+                >
+                  <div
+                    className="w-4 h-4 rounded border flex items-center justify-center flex-shrink-0"
+                    style={isSelected ? { backgroundColor: accentColor, borderColor: accentColor } : { borderColor: 'rgba(255,255,255,0.3)' }}
+                  >
+                    {isSelected && <Check size={12} className="text-white" />}
+                  </div>
+                  <Globe size={16} className="text-white/40 flex-shrink-0" />
+                  <div className="flex-1 min-w-0">
+                    <p className="text-white text-sm truncate">{save.name}</p>
+                    <p className="text-white/40 text-xs">
+                      {save.lastModified && new Date(save.lastModified).toLocaleDateString()}
+                      {save.lastModified && save.sizeBytes ? ' · ' : ''}
+                      {save.sizeBytes ? formatBytes(save.sizeBytes) : ''}
+                    </p>
+                  </div>
+                </button>
+              );
+            })
+          )}
+        </div>
+
+        {error && <p className="px-6 pb-2 text-xs text-red-400">{error}</p>}
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={handleImport}
+            disabled={isImporting || selected.size === 0}
+            className="flex-1 px-4 py-3 rounded-xl font-medium transition-colors flex items-center justify-center gap-2 disabled:opacity-50"
+            style={{ backgroundColor: `${accentColor}33`, color: accentColor }}
+          >
+            {isImporting && <Loader2 size={16} className="animate-spin" />}
+            {t('instances.importWorlds.import', { count: selected.size })}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
@@ -0,0 +1,173 @@
+import React, { useCallback, useEffect, useState } from 'react';
+import { motion } from 'framer-motion';
+import { Archive, Loader2, RotateCcw, Trash2, X } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc, type InstanceBackupInfo } from '@/lib/ipc';
+import { useAccentColor } from '../../contexts/AccentColorContext';
+import { formatBytes } from '../../utils/format';
+import { ModalOverlay } from './ModalOverlay';
+
+interface InstanceBackupsModalProps {
+  instanceId: string;
+  instanceName: string;
+  onClose: () => void;
+  /** Called after a backup was restored, so the caller can reload mods and worlds. */
+  onRestored: () => void;
+}
+
+export const InstanceBackupsModal: React.FC<InstanceBackupsModalProps> = ({ instanceId, instanceName, onClose, onRestored }) => {
+  const { t } = useTranslation();
+  const { accentColor } = useAccentColor();
+  const [backups, setBackups] = useState<InstanceBackupInfo[]>([]);
+  const [isLoading, setIsLoading] = useState(true);
+  const [busy, setBusy] = useState<string | null>(null);
+  const [confirmRestore, setConfirmRestore] = useState<InstanceBackupInfo | null>(null);
+  const [error, setError] = useState<string | null>(null);
+
+  const loadBackups = useCallback(async () => {
+    try {
+      setBackups(await ipc.instance.backups({ instanceId }));
+    } catch (e) {
+      console.warn('[IPC] instance backups:', e);
+      setBackups([]);
+    }
+    setIsLoading(false);
+  }, [instanceId]);
+
+  useEffect(() => {
+    loadBackups();
+  }, [loadBackups]);
+
+  const handleBackup = async () => {
+    setBusy('backup');
+    setError(null);
+    try {
+      const backup = await ipc.instance.backup({ instanceId });
+      if (!backup) setError(t('instances.backups.backupFailed'));
+      await loadBackups();
+    } catch {
+      setError(t('instances.backups.backupFailed'));
+    }
+    setBusy(null);
+  };
+
+  const handleRestore = async (backup: InstanceBackupInfo) => {
+    setBusy(backup.fileName);
+    setError(null);
+    try {
+      const ok = await ipc.instance.restoreBackup({ instanceId, fileName: backup.fileName });
+      if (ok) {
+        setConfirmRestore(null);
+        onRestored();
+      } else {
+        setError(t('instances.backups.restoreFailed'));
+      }
+    } catch {
+      setError(t('instances.backups.restoreFailed'));
+    }
+    setBusy(null);
+  };
+
+  const handleDelete = async (backup: InstanceBackupInfo) => {
+    setBusy(backup.fileName);
+    try {
+      await ipc.instance.deleteBackup({ instanceId, fileName: backup.fileName });
+      await loadBackups();
+    } catch (e) {
+      console.warn('[IPC] delete instance backup:', e);
+    }
+    setBusy(null);
+  };
+
+  return (
+    <ModalOverlay zClass="z-[300]" onClick={(e) => e.target === e.currentTarget && !busy && onClose()}>
+      <motion.div
+        initial={{ scale: 0.95, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.95, opacity: 0 }}
+        className="w-full max-w-md mx-4 overflow-hidden glass-panel-static-solid"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex items-center justify-between px-6 pt-6 pb-2">
+          <div className="min-w-0">
+            <h3 className="text-white font-bold text-lg">{t('instances.backups.title')}</h3>
+            <p className="text-white/40 text-xs truncate">{instanceName}</p>
+          </div>
+          <button
+            onClick={onClose}
+            disabled={busy !== null}
+            className="p-2 rounded-lg text-white/50 hover:text-white hover:bg-white/10 transition-colors"
+          >
+            <X size={16} />
+          </button>
+        </div>
+
+        <p className="px-6 text-white/50 text-xs">{t('instances.backups.hint')}</p>
+
+        <div className="px-6 py-4 space-y-2 max-h-80 overflow-y-auto">
+          {isLoading ? (
+            <div className="flex justify-center py-6">
+              <Loader2 size={20} className="animate-spin text-white/40" />
+            </div>
+          ) : backups.length === 0 ? (
+            <p className="text-center text-white/40 text-sm py-6">{t('instances.backups.empty')}</p>
+          ) : (
+            backups.map((backup) => (
+              <div key={backup.fileName} className="flex items-center gap-3 rounded-xl bg-white/5 px-3 py-2">
+                <Archive size={16} className="text-white/40 flex-shrink-0" />
+                <div className="flex-1 min-w-0">
+                  <p className="text-white text-sm truncate">{new Date(backup.createdAt).toLocaleString()}</p>
+                  <p className="text-white/40 text-xs">{formatBytes(backup.sizeBytes)}</p>
+                </div>
+                {confirmRestore?.fileName === backup.fileName ? (
+                  <button
+                    onClick={() => handleRestore(backup)}
+                    disabled={busy !== null}
+                    className="px-3 py-1.5 rounded-lg text-xs font-medium bg-amber-500/20 text-amber-300 hover:bg-amber-500/30 transition-all flex items-center gap-1"
+                  >
+                    {busy === backup.fileName && <Loader2 size={12} className="animate-spin" />}
+                    {t('instances.backups.confirmRestore')}
+                  </button>
+                ) : (
+                  <button
+                    onClick={() => setConfirmRestore(backup)}
+                    disabled={busy !== null}
+                    title={t('instances.backups.restore')}
+                    className="p-2 rounded-lg text-white/60 hover:text-white hover:bg-white/10 transition-colors"
+                  >
+                    <RotateCcw size={14} />
+                  </button>
+                )}
+                <button
+                  onClick={() => handleDelete(backup)}
+                  disabled={busy !== null}
+                  title={t('common.delete')}
+                  className="p-2 rounded-lg text-red-400/70 hover:text-red-300 hover:bg-red-500/10 transition-colors"
+                >
+                  <Trash2 size={14} />
+                </button>
+              </div>
+            ))
+          )}
+        </div>
+
+        {error && <p className="px-6 pb-2 text-xs text-red-400">{error}</p>}
+        {confirmRestore && !error && (
+          <p className="px-6 pb-2 text-xs text-amber-200">{t('instances.backups.restoreWarning')}</p>
+        )}
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={handleBackup}
+            disabled={busy !== null}
+            className="flex-1 px-4 py-3 rounded-xl font-medium transition-colors flex items-center justify-center gap-2 disabled:opacity-50"
+            style={{ backgroundColor: `${accentColor}33`, color: accentColor }}
+          >
+            {busy === 'backup' ? <Loader2 size={16} className="animate-spin" /> : <Archive size={16} />}
+            {t('instances.backups.backupNow')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
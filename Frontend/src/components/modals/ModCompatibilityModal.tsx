@@ -0,0 +1,55 @@
+import React from 'react';
+import { motion } from 'framer-motion';
+import { PackageX } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import type { ModCompatibilityReport } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface ModCompatibilityModalProps {
+  report: ModCompatibilityReport;
+  onClose: () => void;
+}
+
+export const ModCompatibilityModal: React.FC<ModCompatibilityModalProps> = ({ report, onClose }) => {
+  const { t } = useTranslation();
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-md overflow-hidden glass-panel-static-solid !border-amber-500/20"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-amber-500/10 flex items-center justify-center mb-4">
+            <PackageX size={28} className="text-amber-400" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('modCompatibility.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('modCompatibility.hint', { version: report.gameVersion })}</p>
+        </div>
+
+        <ul className="px-6 pb-6 space-y-2 max-h-64 overflow-y-auto">
+          {report.mods.map((mod) => (
+            <li key={mod.id} className="text-sm rounded-xl px-4 py-3 bg-white/5 text-amber-200">
+              <div className="font-medium truncate">{mod.name}</div>
+              {mod.gameVersions && mod.gameVersions.length > 0 && (
+                <div className="text-xs text-white/40 truncate">{t('modCompatibility.supports', { versions: mod.gameVersions.join(', ') })}</div>
+              )}
+            </li>
+          ))}
+        </ul>
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={onClose}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium"
+          >
+            {t('common.ok')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
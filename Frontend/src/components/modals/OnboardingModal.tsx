@@ -145,6 +145,7 @@ export const OnboardingModal: React.FC<OnboardingModalProps> = ({ onComplete })
     const [defaultInstanceDir, setDefaultInstanceDir] = useState('');
     const [isLoading, setIsLoading] = useState(false);
     const [isGeneratingUsername, setIsGeneratingUsername] = useState(false);
+    const [usernameError, setUsernameError] = useState<string | null>(null);
     const [launcherVersion, setLauncherVersion] = useState('2.0.2');
     
     // Visual settings - restore from cache
@@ -328,6 +329,36 @@ export const OnboardingModal: React.FC<OnboardingModalProps> = ({ onComplete })
         i18n.changeLanguage(langCode);
     };
     
+    // Live feedback against the backend nickname rules
+    useEffect(() => {
+        if (isAuthenticated) {
+            setUsernameError(null);
+            return;
+        }
+        let cancelled = false;
+        const timer = setTimeout(async () => {
+            try {
+                const result = await ipc.profile.validateNick(username.trim());
+                if (cancelled) return;
+                if (result.valid || !result.messageKey) {
+                    setUsernameError(null);
+                    return;
+                }
+                let message = t(result.messageKey);
+                (result.args ?? []).forEach((arg, i) => {
+                    message = message.replace(new RegExp(`\\{${i}\\}`, 'g'), String(arg));
+                });
+                setUsernameError(message);
+            } catch {
+                if (!cancelled) setUsernameError(null);
+            }
+        }, 250);
+        return () => {
+            cancelled = true;
+            clearTimeout(timer);
+        };
+    }, [username, isAuthenticated, t]);
+
     const handleGenerateUsername = async () => {
         setIsGeneratingUsername(true);
         try {
@@ -888,10 +919,9 @@ export const OnboardingModal: React.FC<OnboardingModalProps> = ({ onComplete })
                                     <input
                                         type="text"
                                         value={username}
-                                        onChange={(e) => setUsername(e.target.value.slice(0, 16))}
+                                        onChange={(e) => setUsername(e.target.value)}
                                         placeholder={t('onboarding.enterUsername')}
                                         className="flex-1 h-12 px-4 rounded-xl bg-[#1a1a1a]/80 border border-white/10 text-white text-sm focus:outline-none focus:border-white/30"
-                                        maxLength={16}
                                     />
                                     <button
                                         onClick={handleGenerateUsername}
@@ -902,7 +932,11 @@ export const OnboardingModal: React.FC<OnboardingModalProps> = ({ onComplete })
                                         <RefreshCw size={18} className={isGeneratingUsername ? 'animate-spin' : ''} />
                                     </button>
                                 </div>
-                                <p className="text-xs text-white/40 mt-2">{t('onboarding.usernameHint')}</p>
+                                {usernameError ? (
+                                    <p className="text-xs text-red-400 mt-2">{usernameError}</p>
+                                ) : (
+                                    <p className="text-xs text-white/40 mt-2">{t('onboarding.usernameHint')}</p>
+                                )}
                             </div>
                         </div>
                     )}
@@ -1259,7 +1293,7 @@ export const OnboardingModal: React.FC<OnboardingModalProps> = ({ onComplete })
                             {currentStep !== 'about' ? (
                                 <button
                                     onClick={handleNextStep}
-                                    disabled={currentStep === 'profile' && (!username.trim() || username.trim().length < 1)}
+                                    disabled={currentStep === 'profile' && (!username.trim() || usernameError !== null)}
                                     className="flex items-center gap-2 px-6 py-3 rounded-xl font-medium transition-all hover:opacity-90 disabled:opacity-50 disabled:cursor-not-allowed"
                                     style={{ backgroundColor: accentColor, color: accentTextColor }}
                                 >
@@ -0,0 +1,94 @@
+import React, { useEffect, useState } from 'react';
+import { motion } from 'framer-motion';
+import { Hourglass, KeyRound } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { PlaytimeWarning } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface PlaytimeLimitModalProps {
+  warning: PlaytimeWarning;
+  onClose: () => void;
+}
+
+const OVERRIDE_MINUTES = 30;
+
+export const PlaytimeLimitModal: React.FC<PlaytimeLimitModalProps> = ({ warning, onClose }) => {
+  const { t } = useTranslation();
+  const [pinSet, setPinSet] = useState(false);
+  const [pin, setPin] = useState('');
+  const [error, setError] = useState<string | null>(null);
+
+  useEffect(() => {
+    ipc.playtime.status().then((status) => setPinSet(status.pinSet)).catch(() => setPinSet(false));
+  }, []);
+
+  const title = warning.terminating ? t('playtime.limitReached') : t('playtime.limitSoon', { minutes: warning.minutesLeft });
+  const reason = t(`playtime.reasons.${warning.reason}`);
+
+  const handleOverride = async () => {
+    const result = await ipc.playtime.override({ pin, minutes: OVERRIDE_MINUTES });
+    if (result.error) {
+      setError(t('playtime.wrongPin'));
+      setPin('');
+      return;
+    }
+    onClose();
+  };
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-md overflow-hidden glass-panel-static-solid !border-amber-500/20"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-amber-500/10 flex items-center justify-center mb-4">
+            <Hourglass size={28} className="text-amber-400" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{title}</h2>
+          <p className="mt-2 text-sm text-gray-400">{reason}</p>
+          {warning.terminating && <p className="mt-1 text-sm text-gray-400">{t('playtime.closing')}</p>}
+        </div>
+
+        {pinSet && (
+          <div className="px-6 pb-6">
+            <label className="block text-xs text-white/50 mb-2">{t('playtime.overrideHint', { minutes: OVERRIDE_MINUTES })}</label>
+            <div className="flex gap-2">
+              <input
+                type="password"
+                inputMode="numeric"
+                value={pin}
+                onChange={(e) => { setPin(e.target.value); setError(null); }}
+                onKeyDown={(e) => { if (e.key === 'Enter' && pin) handleOverride(); }}
+                placeholder={t('playtime.pin')}
+                className="flex-1 h-11 px-4 rounded-xl glass-control-solid text-white text-sm focus:outline-none"
+              />
+              <button
+                onClick={handleOverride}
+                disabled={!pin}
+                className="flex items-center gap-2 px-4 h-11 rounded-xl bg-white/10 text-white text-sm hover:bg-white/15 transition-colors disabled:opacity-40"
+              >
+                <KeyRound size={16} />
+                {t('playtime.override')}
+              </button>
+            </div>
+            {error && <p className="mt-2 text-xs text-red-400">{error}</p>}
+          </div>
+        )}
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={onClose}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium"
+          >
+            {t('common.ok')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
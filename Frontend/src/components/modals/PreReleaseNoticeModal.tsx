@@ -0,0 +1,105 @@
+import React, { useState } from 'react';
+import { motion } from 'framer-motion';
+import { FlaskConical, Archive, Loader2 } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { PreReleaseNotice } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface PreReleaseNoticeModalProps {
+  notice: PreReleaseNotice;
+  onContinue: () => void;
+  onClose: () => void;
+}
+
+export const PreReleaseNoticeModal: React.FC<PreReleaseNoticeModalProps> = ({ notice, onContinue, onClose }) => {
+  const { t } = useTranslation();
+  const [busy, setBusy] = useState(false);
+  const [failed, setFailed] = useState<string[]>([]);
+
+  const hasWorlds = notice.releaseInstances.length > 0;
+
+  const handleAcknowledge = async (backupWorlds: boolean) => {
+    setBusy(true);
+    try {
+      const result = await ipc.prerelease.acknowledge({ backupWorlds });
+      if (result.errors.length > 0) {
+        setFailed(result.errors);
+        return;
+      }
+      onContinue();
+    } catch {
+      setFailed([t('prerelease.unknownError')]);
+    } finally {
+      setBusy(false);
+    }
+  };
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-md overflow-hidden glass-panel-static-solid !border-amber-500/20"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-amber-500/10 flex items-center justify-center mb-4">
+            <FlaskConical size={28} className="text-amber-400" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('prerelease.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('prerelease.description')}</p>
+          <p className="mt-1 text-sm text-gray-400">{t('prerelease.isolation')}</p>
+        </div>
+
+        {hasWorlds && (
+          <div className="px-6 pb-4">
+            <p className="text-xs text-white/50 mb-2">{t('prerelease.releaseWorlds')}</p>
+            <div className="space-y-1">
+              {notice.releaseInstances.map((source) => (
+                <div key={source.instanceId} className="flex items-center justify-between px-3 py-2 rounded-lg bg-white/5 text-sm">
+                  <span className="text-white truncate">{source.instanceName}</span>
+                  <span className="text-white/50 flex-shrink-0 ml-2">{t('prerelease.worldCount', { count: source.worldCount })}</span>
+                </div>
+              ))}
+            </div>
+          </div>
+        )}
+
+        {failed.length > 0 && (
+          <p className="px-6 pb-4 text-xs text-red-400">{t('prerelease.backupFailed', { instances: failed.join(', ') })}</p>
+        )}
+
+        <div className="flex flex-col gap-2 p-5 border-t border-white/10 bg-black/30">
+          {hasWorlds && (
+            <button
+              onClick={() => handleAcknowledge(true)}
+              disabled={busy}
+              className="flex items-center justify-center gap-2 px-4 py-3 rounded-xl bg-amber-500/20 text-amber-300 hover:bg-amber-500/30 transition-colors font-medium disabled:opacity-40"
+            >
+              {busy ? <Loader2 size={16} className="animate-spin" /> : <Archive size={16} />}
+              {t('prerelease.backupAndContinue')}
+            </button>
+          )}
+          <div className="flex gap-2">
+            <button
+              onClick={onClose}
+              disabled={busy}
+              className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium disabled:opacity-40"
+            >
+              {t('common.cancel')}
+            </button>
+            <button
+              onClick={() => handleAcknowledge(false)}
+              disabled={busy}
+              className="flex-1 px-4 py-3 rounded-xl bg-white/10 text-white hover:bg-white/15 transition-colors font-medium disabled:opacity-40"
+            >
+              {hasWorlds ? t('prerelease.continueWithoutBackup') : t('prerelease.continue')}
+            </button>
+          </div>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
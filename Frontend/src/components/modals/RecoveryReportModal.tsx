@@ -0,0 +1,78 @@
+import React from 'react';
+import { motion } from 'framer-motion';
+import { LifeBuoy, Wrench } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { StartupRecoveryReport } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface RecoveryReportModalProps {
+  report: StartupRecoveryReport;
+  onClose: () => void;
+}
+
+const formatSize = (bytes: number) =>
+  bytes >= 1024 * 1024 * 1024 ? `${(bytes / 1024 / 1024 / 1024).toFixed(1)} GB` : `${Math.max(1, Math.round(bytes / 1024 / 1024))} MB`;
+
+export const RecoveryReportModal: React.FC<RecoveryReportModalProps> = ({ report, onClose }) => {
+  const { t } = useTranslation();
+
+  const handleRepair = (instanceId: string) => {
+    ipc.instance.repairFiles({ instanceId });
+    onClose();
+  };
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-lg overflow-hidden glass-panel-static-solid"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-white/5 flex items-center justify-center mb-4">
+            <LifeBuoy size={28} className="text-white/70" />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('recovery.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('recovery.description')}</p>
+          {report.freedBytes > 0 && (
+            <p className="mt-1 text-xs text-white/40">{t('recovery.freed', { size: formatSize(report.freedBytes) })}</p>
+          )}
+        </div>
+
+        <div className="px-6 pb-6 max-h-72 overflow-y-auto space-y-2">
+          {report.items.map((item) => (
+            <div key={item.path} className="flex items-center gap-3 p-3 rounded-xl bg-white/5">
+              <div className="flex-1 min-w-0">
+                <p className="text-sm text-white">
+                  {t(`recovery.items.${item.kind}.${item.action}`, { name: item.instanceName || t('recovery.unnamedInstance') })}
+                </p>
+                <p className="text-xs text-white/40 truncate" title={item.path}>{item.path}</p>
+              </div>
+              {item.action === 'repair' && item.instanceId && (
+                <button
+                  onClick={() => handleRepair(item.instanceId!)}
+                  className="flex items-center gap-2 px-3 h-9 rounded-lg bg-white/10 text-white text-sm hover:bg-white/15 transition-colors"
+                >
+                  <Wrench size={14} />
+                  {t('recovery.repair')}
+                </button>
+              )}
+            </div>
+          ))}
+        </div>
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={onClose}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium"
+          >
+            {t('common.ok')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
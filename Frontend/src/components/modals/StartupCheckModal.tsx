@@ -0,0 +1,98 @@
+import React, { useState } from 'react';
+import { motion } from 'framer-motion';
+import { FolderX, RefreshCw } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc } from '@/lib/ipc';
+import type { StartupCheckReport } from '@/lib/ipc';
+import { ModalOverlay } from './ModalOverlay';
+
+interface StartupCheckModalProps {
+  report: StartupCheckReport;
+  onClose: () => void;
+}
+
+const formatSize = (bytes: number) =>
+  bytes >= 1024 * 1024 * 1024 ? `${(bytes / 1024 / 1024 / 1024).toFixed(1)} GB` : `${Math.round(bytes / 1024 / 1024)} MB`;
+
+export const StartupCheckModal: React.FC<StartupCheckModalProps> = ({ report: initialReport, onClose }) => {
+  const { t } = useTranslation();
+  const [report, setReport] = useState(initialReport);
+  const [checking, setChecking] = useState(false);
+
+  const handleRecheck = async () => {
+    setChecking(true);
+    try {
+      setReport(await ipc.app.recheckStartup());
+    } catch {
+      // Keep the previous result
+    } finally {
+      setChecking(false);
+    }
+  };
+
+  return (
+    <ModalOverlay zClass="z-[200]">
+      <motion.div
+        initial={{ scale: 0.9, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.9, opacity: 0 }}
+        className="w-full max-w-lg overflow-hidden glass-panel-static-solid"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex flex-col items-center pt-8 pb-4 px-6 text-center">
+          <div className="w-16 h-16 rounded-full bg-white/5 flex items-center justify-center mb-4">
+            <FolderX size={28} className={report.hasErrors ? 'text-red-400' : 'text-yellow-400'} />
+          </div>
+          <h2 className="text-xl font-bold text-white">{t('startupChecks.title')}</h2>
+          <p className="mt-2 text-sm text-gray-400">{t('startupChecks.description')}</p>
+        </div>
+
+        <div className="px-6 pb-6 max-h-80 overflow-y-auto space-y-2">
+          {report.issues.length === 0 && (
+            <p className="text-sm text-center text-green-400">{t('startupChecks.allClear')}</p>
+          )}
+          {report.issues.map((issue) => (
+            <div key={`${issue.code}:${issue.path}`} className="p-3 rounded-xl bg-white/5">
+              <div className="flex items-center gap-2">
+                <p className="flex-1 text-sm font-medium text-white">
+                  {t(`startupChecks.issues.${issue.code}.title`, {
+                    count: issue.value ?? 0,
+                    size: formatSize(issue.value ?? 0),
+                  })}
+                </p>
+                <span
+                  className={`px-2 py-0.5 rounded-md text-[11px] font-medium ${
+                    issue.severity === 'error' ? 'bg-red-500/15 text-red-400' : 'bg-yellow-500/15 text-yellow-400'
+                  }`}
+                >
+                  {t(issue.severity === 'error' ? 'startupChecks.errorBadge' : 'startupChecks.warningBadge')}
+                </span>
+              </div>
+              <p className="mt-1 text-xs text-gray-400">
+                {t(`startupChecks.issues.${issue.code}.hint`, { count: issue.value ?? 0 })}
+              </p>
+              <p className="mt-1 text-xs text-white/40 truncate" title={issue.path}>{issue.path}</p>
+            </div>
+          ))}
+        </div>
+
+        <div className="flex gap-3 p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={handleRecheck}
+            disabled={checking}
+            className="flex-1 flex items-center justify-center gap-2 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium disabled:opacity-50"
+          >
+            <RefreshCw size={16} className={checking ? 'animate-spin' : ''} />
+            {t('startupChecks.recheck')}
+          </button>
+          <button
+            onClick={onClose}
+            className="flex-1 px-4 py-3 rounded-xl bg-white/5 text-gray-300 hover:bg-white/10 transition-colors font-medium"
+          >
+            {t('common.ok')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
@@ -0,0 +1,153 @@
+import React, { useCallback, useEffect, useState } from 'react';
+import { motion } from 'framer-motion';
+import { Archive, Globe, Loader2, RotateCcw, Trash2, X } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { ipc, type TrashEntry } from '@/lib/ipc';
+import { formatBytes } from '../../utils/format';
+import { ModalOverlay } from './ModalOverlay';
+
+interface TrashModalProps {
+  onClose: () => void;
+  /** Called after an entry was restored, so the caller can reload worlds. */
+  onRestored: () => void;
+}
+
+export const TrashModal: React.FC<TrashModalProps> = ({ onClose, onRestored }) => {
+  const { t } = useTranslation();
+  const [entries, setEntries] = useState<TrashEntry[]>([]);
+  const [isLoading, setIsLoading] = useState(true);
+  const [busy, setBusy] = useState<string | null>(null);
+  const [confirmEmpty, setConfirmEmpty] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const loadEntries = useCallback(async () => {
+    try {
+      setEntries(await ipc.trash.list());
+    } catch (e) {
+      console.warn('[IPC] trash list:', e);
+      setEntries([]);
+    }
+    setIsLoading(false);
+  }, []);
+
+  useEffect(() => {
+    loadEntries();
+  }, [loadEntries]);
+
+  const handleRestore = async (entry: TrashEntry) => {
+    setBusy(entry.id);
+    setError(null);
+    try {
+      if (await ipc.trash.restore({ id: entry.id })) {
+        onRestored();
+      } else {
+        setError(t('trash.restoreFailed'));
+      }
+    } catch {
+      setError(t('trash.restoreFailed'));
+    }
+    await loadEntries();
+    setBusy(null);
+  };
+
+  const handleDelete = async (entry: TrashEntry) => {
+    setBusy(entry.id);
+    try {
+      await ipc.trash.delete({ id: entry.id });
+    } catch (e) {
+      console.warn('[IPC] trash delete:', e);
+    }
+    await loadEntries();
+    setBusy(null);
+  };
+
+  const handleEmpty = async () => {
+    setBusy('empty');
+    try {
+      await ipc.trash.empty();
+    } catch (e) {
+      console.warn('[IPC] trash empty:', e);
+    }
+    setConfirmEmpty(false);
+    await loadEntries();
+    setBusy(null);
+  };
+
+  return (
+    <ModalOverlay zClass="z-[300]" onClick={(e) => e.target === e.currentTarget && !busy && onClose()}>
+      <motion.div
+        initial={{ scale: 0.95, opacity: 0 }}
+        animate={{ scale: 1, opacity: 1 }}
+        exit={{ scale: 0.95, opacity: 0 }}
+        className="w-full max-w-md mx-4 overflow-hidden glass-panel-static-solid"
+        onClick={(e) => e.stopPropagation()}
+      >
+        <div className="flex items-center justify-between px-6 pt-6 pb-2">
+          <h3 className="text-white font-bold text-lg">{t('trash.title')}</h3>
+          <button
+            onClick={onClose}
+            disabled={busy !== null}
+            className="p-2 rounded-lg text-white/50 hover:text-white hover:bg-white/10 transition-colors"
+          >
+            <X size={16} />
+          </button>
+        </div>
+
+        <p className="px-6 text-white/50 text-xs">{t('trash.hint')}</p>
+
+        <div className="px-6 py-4 space-y-2 max-h-80 overflow-y-auto">
+          {isLoading ? (
+            <div className="flex justify-center py-6">
+              <Loader2 size={20} className="animate-spin text-white/40" />
+            </div>
+          ) : entries.length === 0 ? (
+            <p className="text-center text-white/40 text-sm py-6">{t('trash.empty')}</p>
+          ) : (
+            entries.map((entry) => (
+              <div key={entry.id} className="flex items-center gap-3 rounded-xl bg-white/5 px-3 py-2">
+                {entry.kind === 'world'
+                  ? <Globe size={16} className="text-white/40 flex-shrink-0" />
+                  : <Archive size={16} className="text-white/40 flex-shrink-0" />}
+                <div className="flex-1 min-w-0">
+                  <p className="text-white text-sm truncate">{entry.name}</p>
+                  <p className="text-white/40 text-xs">
+                    {t('trash.deletedAt', { date: new Date(entry.deletedAt).toLocaleString() })} · {formatBytes(entry.sizeBytes)}
+                  </p>
+                </div>
+                <button
+                  onClick={() => handleRestore(entry)}
+                  disabled={busy !== null}
+                  title={t('trash.restore')}
+                  className="p-2 rounded-lg text-white/60 hover:text-white hover:bg-white/10 transition-colors"
+                >
+                  {busy === entry.id ? <Loader2 size={14} className="animate-spin" /> : <RotateCcw size={14} />}
+                </button>
+                <button
+                  onClick={() => handleDelete(entry)}
+                  disabled={busy !== null}
+                  title={t('trash.deleteForever')}
+                  className="p-2 rounded-lg text-red-400/70 hover:text-red-300 hover:bg-red-500/10 transition-colors"
+                >
+                  <Trash2 size={14} />
+                </button>
+              </div>
+            ))
+          )}
+        </div>
+
+        {error && <p className="px-6 pb-2 text-xs text-red-400">{error}</p>}
+
+        <div className="flex p-5 border-t border-white/10 bg-black/30">
+          <button
+            onClick={() => (confirmEmpty ? handleEmpty() : setConfirmEmpty(true))}
+            disabled={busy !== null || entries.length === 0}
+            className="flex-1 px-4 py-3 rounded-xl font-medium transition-colors flex items-center justify-center gap-2 disabled:opacity-50 bg-red-500/20 text-red-300 hover:bg-red-500/30"
+          >
+            {busy === 'empty' ? <Loader2 size={16} className="animate-spin" /> : <Trash2 size={16} />}
+            {confirmEmpty ? t('trash.confirmEmpty') : t('trash.emptyTrash')}
+          </button>
+        </div>
+      </motion.div>
+    </ModalOverlay>
+  );
+};
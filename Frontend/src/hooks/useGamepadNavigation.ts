@@ -0,0 +1,120 @@
+import { useEffect } from 'react';
+
+/**
+ * Lets a gamepad drive the launcher (Steam Deck, Big Picture).
+ *
+ * - D-pad / left stick: move focus to the nearest focusable element in that direction
+ * - A: click the focused element
+ * - B: send Escape, which closes the open modal
+ *
+ * While enabled, `gamepad-mode` is set on `<html>` so focus is always visible.
+ */
+
+const FOCUSABLE = 'button:not([disabled]), a[href], input:not([disabled]), select:not([disabled]), textarea:not([disabled]), [tabindex]:not([tabindex="-1"])';
+
+const BUTTON_A = 0;
+const BUTTON_B = 1;
+const DPAD_UP = 12;
+const DPAD_DOWN = 13;
+const DPAD_LEFT = 14;
+const DPAD_RIGHT = 15;
+
+const STICK_THRESHOLD = 0.6;
+const FIRST_REPEAT_MS = 400;
+const REPEAT_MS = 120;
+
+type Direction = 'up' | 'down' | 'left' | 'right';
+
+function visibleFocusables(): HTMLElement[] {
+  return Array.from(document.querySelectorAll<HTMLElement>(FOCUSABLE)).filter((el) => {
+    const rect = el.getBoundingClientRect();
+    return rect.width > 0 && rect.height > 0 && getComputedStyle(el).visibility !== 'hidden';
+  });
+}
+
+function moveFocus(direction: Direction) {
+  const candidates = visibleFocusables();
+  const current = document.activeElement as HTMLElement | null;
+  if (!current || current === document.body || !candidates.includes(current)) {
+    candidates[0]?.focus();
+    return;
+  }
+
+  const from = current.getBoundingClientRect();
+  const fx = from.left + from.width / 2;
+  const fy = from.top + from.height / 2;
+
+  let best: HTMLElement | null = null;
+  let bestScore = Infinity;
+  for (const el of candidates) {
+    if (el === current) continue;
+    const rect = el.getBoundingClientRect();
+    const dx = rect.left + rect.width / 2 - fx;
+    const dy = rect.top + rect.height / 2 - fy;
+    const along = direction === 'up' ? -dy : direction === 'down' ? dy : direction === 'left' ? -dx : dx;
+    const across = direction === 'up' || direction === 'down' ? Math.abs(dx) : Math.abs(dy);
+    if (along <= 0) continue;
+    // Prefer elements straight ahead over closer ones off to the side
+    const score = along + across * 2;
+    if (score < bestScore) {
+      bestScore = score;
+      best = el;
+    }
+  }
+
+  if (best) {
+    best.focus();
+    best.scrollIntoView({ block: 'nearest', inline: 'nearest' });
+  }
+}
+
+export function useGamepadNavigation(enabled: boolean) {
+  useEffect(() => {
+    if (!enabled) return;
+    document.documentElement.classList.add('gamepad-mode');
+
+    const pressed = new Set<string>();
+    const nextRepeat = new Map<string, number>();
+    let frame = 0;
+
+    const handle = (key: string, down: boolean, now: number, action: () => void, repeat: boolean) => {
+      if (!down) {
+        pressed.delete(key);
+        return;
+      }
+      if (!pressed.has(key)) {
+        pressed.add(key);
+        nextRepeat.set(key, now + FIRST_REPEAT_MS);
+        action();
+      } else if (repeat && now >= (nextRepeat.get(key) ?? 0)) {
+        nextRepeat.set(key, now + REPEAT_MS);
+        action();
+      }
+    };
+
+    const poll = (now: number) => {
+      for (const pad of navigator.getGamepads()) {
+        if (!pad) continue;
+        const button = (i: number) => pad.buttons[i]?.pressed ?? false;
+        const [x = 0, y = 0] = pad.axes;
+
+        handle(`${pad.index}:up`, button(DPAD_UP) || y < -STICK_THRESHOLD, now, () => moveFocus('up'), true);
+        handle(`${pad.index}:down`, button(DPAD_DOWN) || y > STICK_THRESHOLD, now, () => moveFocus('down'), true);
+        handle(`${pad.index}:left`, button(DPAD_LEFT) || x < -STICK_THRESHOLD, now, () => moveFocus('left'), true);
+        handle(`${pad.index}:right`, button(DPAD_RIGHT) || x > STICK_THRESHOLD, now, () => moveFocus('right'), true);
+        handle(`${pad.index}:a`, button(BUTTON_A), now, () => (document.activeElement as HTMLElement | null)?.click(), false);
+        handle(`${pad.index}:b`, button(BUTTON_B), now, () => {
+          const target = document.activeElement ?? document.body;
+          target.dispatchEvent(new KeyboardEvent('keydown', { key: 'Escape', bubbles: true }));
+        }, false);
+      }
+      frame = requestAnimationFrame(poll);
+    };
+
+    frame = requestAnimationFrame(poll);
+    return () => {
+      cancelAnimationFrame(frame);
+      document.documentElement.classList.remove('gamepad-mode');
+    };
+  }, [enabled]);
+}
@@ -68,6 +68,8 @@ export interface ProgressUpdate {
   args?: unknown[];
   downloadedBytes: number;
   totalBytes: number;
+  bytesPerSecond: number;
+  etaSeconds?: number;
 }
 
 export interface GameState {
@@ -78,7 +80,30 @@ export interface GameState {
 export interface GameError {
   type: string;
   message: string;
+  messageKey?: string;
+  args?: unknown[];
   technical?: string;
+  code?: string;
+  hintKey?: string;
+  hint?: string;
+  helpUrl?: string;
+}
+
+export interface JavaRuntimeInfo {
+  id: string;
+  name: string;
+  featureVersion: number;
+  version?: string;
+  vendor: string;
+  homePath: string;
+  javaPath: string;
+  archiveSha256?: string;
+  sourceUrl?: string;
+  installedAt?: string;
+  isDefault: boolean;
+  isSystem: boolean;
+  isInstalled: boolean;
+  isCompatible: boolean;
 }
 
 export interface NewsItem {
@@ -89,7 +114,37 @@ export interface NewsItem {
   publishedAt?: string;
   author?: string;
   imageUrl?: string;
+  localImageUrl?: string;
   source?: string;
+  sourceName?: string;
+}
+
+export interface NewsFeed {
+  items: NewsItem[];
+  fromCache: boolean;
+  stale: boolean;
+  fetchedAt?: string;
+}
+
+export interface Announcement {
+  id: string;
+  severity: 'info' | 'warning' | 'critical';
+  title?: string;
+  message: string;
+  url?: string;
+  minLauncherVersion?: string;
+  maxLauncherVersion?: string;
+  startsAt?: string;
+  expiresAt?: string;
+  dismissible: boolean;
+}
+
+export interface NewsSourceInfo {
+  id: string;
+  name: string;
+  type: 'hytale' | 'hyprism' | 'feed';
+  url?: string;
+  enabled: boolean;
 }
 
 export interface Profile {
@@ -115,11 +170,21 @@ export interface ProfileSnapshot {
   avatarPath?: string;
 }
 
+export interface NicknameValidationResult {
+  valid: boolean;
+  messageKey?: string;
+  args?: unknown[];
+  message?: string;
+}
+
 export interface SettingsSnapshot {
   language: string;
   musicEnabled: boolean;
   launcherBranch: string;
   closeAfterLaunch: boolean;
+  minimizeOnLaunch: boolean;
+  restoreOnGameExit: boolean;
+  verifyFilesBeforeLaunch: boolean;
   showDiscordAnnouncements: boolean;
   disableNews: boolean;
   backgroundMode: string;
@@ -131,6 +196,9 @@ export interface SettingsSnapshot {
   dataDirectory: string;
   instanceDirectory: string;
   gpuPreference?: string;
+  proxyUrl?: string;
+  meteredConnection?: boolean;
+  meteredConfirmThresholdMb?: number;
   launchOnStartup?: boolean;
   minimizeToTray?: boolean;
   animations?: boolean;
@@ -158,6 +226,7 @@ export interface ModInfo {
   slug: string;
   summary: string;
   author: string;
+  authorId?: number | null;
   downloadCount: number;
   iconUrl: string;
   thumbnailUrl: string;
@@ -170,6 +239,18 @@ export interface ModInfo {
 export interface ModSearchResult {
   mods: ModInfo[];
   totalCount: number;
+  messageKey?: string;
+  args?: unknown[];
+}
+
+export interface ModAuthorResult {
+  mods: ModInfo[];
+  totalCount: number;
+  messageKey?: string;
+  args?: unknown[];
+  authorId?: number | null;
+  authorName: string;
+  authorUrl: string;
 }
 
 export interface ModFileInfo {
@@ -194,6 +275,10 @@ export interface ModCategory {
   id: number;
   name: string;
   slug: string;
+  isClass: boolean;
+  classId?: number | null;
+  parentId?: number | null;
+  children: ModCategory[];
 }
 
 export interface InstalledMod {
@@ -213,13 +298,250 @@ export interface InstalledMod {
   latestFileId?: string;
   latestVersion?: string;
   screenshots?: ModScreenshot[];
+  dependencies?: string[] | null;
+  gameVersions?: string[] | null;
+}
+
+export interface ModBulkToggleProgress {
+  instancePath: string;
+  enabled: boolean;
+  done: number;
+  total: number;
+  modName: string;
+}
+
+export interface ModBulkToggleResult {
+  changed: number;
+  failed: number;
+}
+
+export interface ModResolveResult {
+  success: boolean;
+  modId: string;
+  fileId: string;
+  name: string;
+  messageKey?: string | null;
+}
+
+export interface ModCompatibilityReport {
+  instancePath: string;
+  gameVersion: string;
+  mods: InstalledMod[];
+}
+
+export interface ModUninstallImpact {
+  modId: string;
+  dependents: InstalledMod[];
+  orphans: InstalledMod[];
+}
+
+export interface ModUpdateCheckProgress {
+  instancePath: string;
+  checked: number;
+  total: number;
+  update?: InstalledMod;
+}
+
+export interface GameSettings {
+  files: string[];
+  renderDistance?: number;
+  fieldOfView?: number;
+  fullscreen?: boolean;
+  vSync?: boolean;
+  masterVolume?: number;
+  musicVolume?: number;
+  sfxVolume?: number;
+  keybinds: Record<string, string>;
+}
+
+export interface SettingsSyncConfig {
+  enabled: boolean;
+  sourceInstanceId: string;
+  include: string[];
+  exclude: string[];
+}
+
+export interface DisplayServerSettings {
+  mode: 'auto' | 'wayland' | 'x11';
+  supported: boolean;
+  session?: 'wayland' | 'x11' | null;
+}
+
+export interface LaunchCommand {
+  executable: string;
+  workingDirectory: string;
+  arguments: string[];
+  environment: Record<string, string>;
+  commandLine: string;
 }
 
 export interface SaveInfo {
   name: string;
+  path: string;
   previewPath?: string;
   lastModified?: string;
   sizeBytes?: number;
+  instanceId: string;
+  instanceName: string;
+  branch: string;
+  version: number;
+  hasModSnapshot?: boolean;
+}
+
+export interface CloudSyncWorld {
+  instanceId: string;
+  worldName: string;
+}
+
+export interface CloudSyncConfig {
+  enabled: boolean;
+  backend: 'folder' | 'webdav';
+  folderPath: string;
+  webDavUrl: string;
+  webDavUsername: string;
+  worlds: CloudSyncWorld[];
+  syncSkins: boolean;
+  syncSettings: boolean;
+  syncAfterPlaying: boolean;
+}
+
+export interface CloudSyncConflict {
+  key: string;
+  kind: 'world' | 'skins' | 'settings';
+  name: string;
+  localModifiedUtc?: string | null;
+  remoteModifiedUtc?: string | null;
+}
+
+export interface CloudSyncResult {
+  success: boolean;
+  finishedAt: string;
+  uploaded: string[];
+  downloaded: string[];
+  conflicts: CloudSyncConflict[];
+  failed: Record<string, string>;
+  error?: string | null;
+}
+
+export interface InstanceBackupInfo {
+  fileName: string;
+  path: string;
+  instanceId: string;
+  createdAt: string;
+  sizeBytes: number;
+}
+
+export interface SearchResult {
+  kind: 'instance' | 'world' | 'installedMod' | 'mod' | 'setting' | 'news';
+  id: string;
+  title: string;
+  titleKey?: string | null;
+  subtitle?: string | null;
+  instanceId?: string | null;
+  target?: string | null;
+  iconUrl?: string | null;
+  score: number;
+}
+
+export interface LauncherUpdateInfo {
+  version: string;
+  currentVersion: string;
+  downloadUrl: string;
+  assetName: string;
+  releaseUrl: string;
+  isBeta: boolean;
+}
+
+export interface LauncherUpdateCheck {
+  checkedAtUtc: string;
+  launcherVersion: string;
+  branch: string;
+  update?: LauncherUpdateInfo | null;
+}
+
+export interface BandwidthMonth {
+  month: string;
+  categories: Record<string, number>;
+  totalBytes: number;
+}
+
+export interface DownloadConfirmation {
+  id: string;
+  category: string;
+  name: string;
+  bytes: number;
+  requestedAtUtc: string;
+}
+
+export interface InstanceArtwork {
+  icon?: string | null;
+  banner?: string | null;
+}
+
+export interface InstanceActivity {
+  instanceId: string;
+  name: string;
+  branch: string;
+  version: number;
+  isFavorite: boolean;
+  lastPlayedAt?: string | null;
+  launchCount: number;
+}
+
+export interface DashboardInstance {
+  id: string;
+  name: string;
+  branch: string;
+  version: number;
+  isInstalled: boolean;
+  pendingModUpdates?: number | null;
+}
+
+export interface DashboardBranch {
+  branch: string;
+  latestVersion?: number | null;
+  installedVersion?: number | null;
+}
+
+export interface DashboardInfo {
+  selectedInstance?: DashboardInstance | null;
+  lastPlayed?: InstanceActivity | null;
+  branches: DashboardBranch[];
+  isGameRunning: boolean;
+  news: NewsItem[];
+  announcements: Announcement[];
+}
+
+export interface TrashEntry {
+  id: string;
+  kind: 'world' | 'instanceBackup';
+  name: string;
+  instanceId: string;
+  originalPath: string;
+  deletedAt: string;
+  sizeBytes: number;
+}
+
+export interface WorldModEntry {
+  id: string;
+  name: string;
+  curseForgeId: string;
+  fileId: string;
+  fileName: string;
+}
+
+export interface WorldModSnapshotStatus {
+  snapshot: { playedAt: string;
+  mods: WorldModEntry[];
+}
+
+export interface WorldModRestoreResult {
+  success: boolean;
+  installed: number;
+  enabled: number;
+  disabled: number;
+  failed: string[];
+  messageKey?: string | null;
 }
 
 export interface AppConfig {
@@ -249,6 +571,8 @@ export interface InstalledInstance {
   validationStatus?: 'Valid' | 'NotInstalled' | 'Corrupted' | 'Unknown';
   validationDetails?: InstanceValidationDetails;
   customName?: string;
+  icon?: string | null;
+  banner?: string | null;
 }
 
 export interface InstanceInfo {
@@ -257,6 +581,8 @@ export interface InstanceInfo {
   branch: string;
   version: number;
   isInstalled: boolean;
+  icon?: string | null;
+  banner?: string | null;
 }
 
 export interface LanguageInfo {
@@ -270,6 +596,50 @@ export interface GpuAdapterInfo {
   type: string;
 }
 
+export interface PlatformComponentSupport {
+  component: 'game' | 'butler' | 'jre' | 'temurin';
+  platform: string;
+  artifactArch?: string;
+  available: boolean;
+  emulated: boolean;
+}
+
+export interface SteamDeckStatus {
+  isSteamDeck: boolean;
+  isGamescope: boolean;
+  gamepadModeSetting: 'auto' | 'on' | 'off';
+  gamepadMode: boolean;
+  steamFound: boolean;
+  shortcutAdded: boolean;
+}
+
+export interface SkinHistoryEntry {
+  id: string;
+  savedAtUtc: string;
+  avatar?: string;
+}
+
+export interface DesktopShortcutResult {
+  success: boolean;
+  path?: string;
+  error?: string;
+}
+
+export interface SteamShortcutResult {
+  success: boolean;
+  usersAdded: number;
+  restartSteam: boolean;
+  error?: string;
+}
+
+export interface NetworkStatus {
+  state: 'ok' | 'offline' | 'captive_portal' | 'cdn_blocked';
+  messageKey?: string;
+  blockedHosts: string[];
+  proxyConfigured: boolean;
+  checkedAt: string;
+}
+
 export interface VersionInfo {
   version: number;
   source: 'Official' | 'Mirror';
@@ -282,6 +652,386 @@ export interface VersionListResponse {
   officialSourceAvailable: boolean;
 }
 
+export interface OperationProgress {
+  operationId: string;
+  kind: string;
+  stage: string;
+  state: 'running' | 'completed' | 'failed' | 'cancelled';
+  progress: number;
+  messageKey?: string;
+  args?: unknown[];
+  downloadedBytes: number;
+  totalBytes: number;
+  bytesPerSecond: number;
+  etaSeconds?: number;
+}
+
+export interface BackgroundTask {
+  id: string;
+  kind: string;
+  title: string;
+  state: 'running' | 'completed' | 'failed' | 'cancelled';
+  progress: number;
+  messageKey?: string;
+  error?: string;
+  canCancel: boolean;
+  startedAt: string;
+  finishedAt?: string;
+}
+
+export interface ReplayedEvent {
+  seq: number;
+  channel: string;
+  timestamp: string;
+  payload: unknown;
+}
+
+export interface SecondInstanceArgs {
+  args: string[];
+}
+
+export interface LogLine {
+  seq: number;
+  source: 'launcher' | 'game';
+  timestamp: string;
+  level: string;
+  category: string;
+  message: string;
+}
+
+export interface CrashIssue {
+  id: string;
+  severity: 'error' | 'warning';
+  title: string;
+  description: string;
+  suggestions: string[];
+  evidence: string[];
+}
+
+export interface GraphicsWarning {
+  id: 'no_driver' | 'software_renderer' | 'only_32bit' | 'vulkan_no_driver' | 'dx_feature_level' | 'tools_missing';
+  severity: 'error' | 'warning' | 'info';
+  message: string;
+}
+
+export interface GraphicsDiagnostics {
+  checkedAt: string;
+  platform: string;
+  vulkanAvailable?: boolean | null;
+  vulkanVersion?: string | null;
+  vulkanDevices: string[];
+  openGlAvailable?: boolean | null;
+  openGlVersion?: string | null;
+  openGlRenderer?: string | null;
+  directXFeatureLevel?: string | null;
+  warnings: GraphicsWarning[];
+  summary: string;
+}
+
+export interface CrashDiagnosis {
+  hasLog: boolean;
+  source: 'session' | 'file';
+  logPath?: string;
+  issues: CrashIssue[];
+  excerpt: string[];
+  graphics?: GraphicsDiagnostics | null;
+  analyzedAt: string;
+}
+
+export interface InstallQueueEntry {
+  id: string;
+  kind: 'install' | 'repair';
+  instanceId?: string;
+  branch: string;
+  version: number;
+  state: 'running' | 'queued';
+  position: number;
+  queuedAt: string;
+}
+
+export interface GameFilesReport {
+  hasManifest: boolean;
+  version: number;
+  deep: boolean;
+  checkedFiles: number;
+  missing: string[];
+  modified: string[];
+  patched: string[];
+  isIntact: boolean;
+}
+
+export interface InstanceMigrationReport {
+  dryRun: boolean;
+  worldsCopied: string[];
+  worldsSkipped: string[];
+  modsCopied: string[];
+  modsSwitched: string[];
+  modsSkipped: string[];
+  incompatibleMods: { id: string;
+  name: string;
+  reason: string;
+}
+
+export interface GameChangelog {
+  branch: string;
+  version: number;
+  title: string;
+  date: string;
+  url: string;
+  notes: string[];
+  summary: string;
+  source: 'feed' | 'hytale';
+}
+
+export interface GameLogChunk {
+  instanceId: string;
+  file?: string;
+  lines: string[];
+  ended: boolean;
+}
+
+export interface GameStatsSample {
+  instanceId?: string;
+  pid: number;
+  timestamp: string;
+  cpuPercent: number;
+  rssBytes: number;
+  gpuPercent?: number;
+  gpuMemoryBytes?: number;
+}
+
+export interface GameSessionStats {
+  instanceId?: string;
+  instanceName?: string;
+  pid: number;
+  startedAt: string;
+  endedAt?: string;
+  sampleCount: number;
+  averageCpuPercent: number;
+  peakCpuPercent: number;
+  peakRssBytes: number;
+  peakGpuPercent?: number;
+  peakGpuMemoryBytes?: number;
+  enabledMods: string[];
+}
+
+export interface SafeModeDisabledMod {
+  id: string;
+  name: string;
+  fileName: string;
+}
+
+export interface SafeModeSession {
+  instanceId: string;
+  instancePath: string;
+  kind: 'safe-mode' | 'subset';
+  startedAt: string;
+  launched: boolean;
+  disabledMods: SafeModeDisabledMod[];
+}
+
+export interface ModBisectMod {
+  id: string;
+  name: string;
+}
+
+export interface ModBisectState {
+  instanceId: string;
+  instanceName: string;
+  status: 'testing' | 'found';
+  step: number;
+  launched: boolean;
+  suspects: ModBisectMod[];
+  testing: ModBisectMod[];
+  cleared: ModBisectMod[];
+  culprit?: ModBisectMod;
+  remainingSteps: number;
+  startedAt: string;
+}
+
+export interface LogPage {
+  lines: LogLine[];
+  hasMore: boolean;
+  latestSeq: number;
+}
+
+export interface OnboardingState {
+  isFirstRun: boolean;
+  nickname: string;
+  branch: string;
+  language: string;
+  instanceDirectory: string;
+  defaultInstanceDirectory: string;
+}
+
+export interface InstallLocation {
+  path: string;
+  kind: 'default' | 'current' | 'home' | 'drive';
+  freeBytes: number;
+  totalBytes: number;
+  hasEnoughSpace: boolean;
+  isDefault: boolean;
+  isRecommended: boolean;
+}
+
+export interface TelemetryEvent {
+  name: 'launcher_start' | 'game_launch' | 'error';
+  timestamp: string;
+  category?: string;
+}
+
+export interface TelemetryBatch {
+  schemaVersion: number;
+  installId: string;
+  launcherVersion: string;
+  os: string;
+  arch: string;
+  events: TelemetryEvent[];
+}
+
+export interface TelemetryStatus {
+  enabled: boolean;
+  endpoint: string;
+  queuedEvents: number;
+}
+
+export interface ErrorReport {
+  schemaVersion: number;
+  id: string;
+  timestamp: string;
+  kind: 'error' | 'crash';
+  launcherVersion: string;
+  os: string;
+  arch: string;
+  osVersion: string;
+  operation: string;
+  code?: string;
+  messageKey?: string;
+  message: string;
+  technical?: string;
+}
+
+export interface ErrorReportingStatus {
+  enabled: boolean;
+  endpoint: string;
+  pendingReports: number;
+}
+
+export interface AutomationApiStatus {
+  enabled: boolean;
+  running: boolean;
+  url: string;
+  token: string;
+}
+
+export interface QuickActionResult {
+  action: string;
+  success: boolean;
+  message?: string;
+}
+
+export interface QuickActionHotkey {
+  action: string;
+  accelerator: string;
+  registered: boolean;
+}
+
+export interface PlaytimeStatus {
+  enabled: boolean;
+  dailyLimitMinutes: number;
+  allowedFrom: string;
+  allowedUntil: string;
+  warnMinutes: number;
+  pinSet: boolean;
+  playedTodayMinutes: number;
+  remainingMinutes?: number | null;
+  overrideUntil?: string | null;
+}
+
+export interface InstallLogInfo {
+  name: string;
+  kind: string;
+  branch: string;
+  version: number;
+  startedAt: string;
+  sizeBytes: number;
+  outcome: '' | 'success' | 'failed' | 'cancelled';
+}
+
+export interface PreReleaseWorldSource {
+  instanceId: string;
+  instanceName: string;
+  worldCount: number;
+}
+
+export interface PreReleaseNotice {
+  releaseInstances: PreReleaseWorldSource[];
+}
+
+export interface PreReleaseAcknowledgeResult {
+  backups: string[];
+  errors: string[];
+}
+
+export interface PlaytimeWarning {
+  reason: 'dailyLimit' | 'allowedHours';
+  minutesLeft: number;
+  terminating: boolean;
+}
+
+export interface RecoveredItem {
+  kind: 'install' | 'update' | 'staging' | 'download';
+  action: 'cleaned' | 'resume' | 'repair';
+  path: string;
+  instanceId?: string;
+  instanceName?: string;
+  sizeBytes: number;
+}
+
+export interface StartupRecoveryReport {
+  checkedAt: string;
+  items: RecoveredItem[];
+  freedBytes: number;
+}
+
+export interface StartupCheckIssue {
+  code: 'notWritable' | 'readOnlyMount' | 'cloudSync' | 'cloudOnlyFiles' | 'lowSpace' | 'lowInodes' | 'longPaths';
+  severity: 'error' | 'warning';
+  path: string;
+  detail: string;
+  value?: number;
+}
+
+export interface StartupCheckReport {
+  checkedAt: string;
+  issues: StartupCheckIssue[];
+  hasErrors: boolean;
+}
+
+export interface DefenderDetection {
+  threatName: string;
+  path: string;
+  detectedAt?: string;
+  action: 'quarantined' | 'removed' | 'blocked' | 'detected';
+}
+
+export interface DefenderReport {
+  supported: boolean;
+  defenderAvailable: boolean;
+  realTimeProtection: boolean;
+  controlledFolderAccess: boolean;
+  detections: DefenderDetection[];
+  exclusionPaths: string[];
+  exclusionCommand: string;
+}
+
+export interface OnboardingResult {
+  success: boolean;
+  messageKey?: string;
+  args?: unknown[];
+  state?: OnboardingState;
+}
+
 // #endregion
 
 // #region Typed IPC API (from @ipc annotations)
@@ -293,7 +1043,13 @@ const _config = {
 
 const _game = {
   launch: (data?: unknown) => send('hyprism:game:launch', data),
+  launchSafeMode: (data?: unknown) => send('hyprism:game:launchSafeMode', data),
+  safeModeSession: (data?: unknown) => invoke<SafeModeSession | null>('hyprism:game:safeModeSession', data),
   cancel: (data?: unknown) => send('hyprism:game:cancel', data),
+  cancelInstall: (data?: unknown) => invoke<boolean>('hyprism:game:cancelInstall', data),
+  installQueue: (data?: unknown) => invoke<InstallQueueEntry[]>('hyprism:game:installQueue', data),
+  installQueueCancel: (data?: unknown) => invoke<boolean>('hyprism:game:installQueueCancel', data),
+  installQueueMove: (data?: unknown) => invoke<boolean>('hyprism:game:installQueueMove', data),
   stop: (data?: unknown) => invoke<boolean>('hyprism:game:stop', data),
   instances: () => invoke<InstalledInstance[]>('hyprism:game:instances'),
   isRunning: (data?: unknown) => invoke<boolean>('hyprism:game:isRunning', data),
@@ -301,7 +1057,19 @@ const _game = {
   onProgress: (cb: (data: ProgressUpdate) => void) => on('hyprism:game:progress', cb as (d: unknown) => void),
   onState: (cb: (data: GameState) => void) => on('hyprism:game:state', cb as (d: unknown) => void),
   onError: (cb: (data: GameError) => void) => on('hyprism:game:error', cb as (d: unknown) => void),
+  analyzeCrash: (data?: unknown) => invoke<CrashDiagnosis>('hyprism:game:analyzeCrash', data),
+  startLogStream: (data?: unknown) => invoke<boolean>('hyprism:game:startLogStream', data),
+  stopLogStream: (data?: unknown) => send('hyprism:game:stopLogStream', data),
+  onLogStream: (cb: (data: GameLogChunk) => void) => on('hyprism:game:logStream', cb as (d: unknown) => void),
+  onInstallQueueChanged: (cb: (data: InstallQueueEntry[]) => void) => on('hyprism:game:installQueueChanged', cb as (d: unknown) => void),
+  onStats: (cb: (data: GameStatsSample) => void) => on('hyprism:game:stats', cb as (d: unknown) => void),
+  onVersionsChanged: (cb: (data: { branch: string; versions: number[] }) => void) => on('hyprism:game:versionsChanged', cb as (d: unknown) => void),
+  sessionStats: (data?: unknown) => invoke<GameSessionStats | null>('hyprism:game:sessionStats', data),
+  statsHistory: (data?: unknown) => invoke<GameSessionStats[]>('hyprism:game:statsHistory', data),
   versionsWithSources: (data?: unknown) => invoke<VersionListResponse>('hyprism:game:versionsWithSources', data),
+  refreshVersions: (data?: unknown) => invoke<VersionListResponse>('hyprism:game:refreshVersions', data, 30000),
+  changelog: (data?: unknown) => invoke<GameChangelog | null>('hyprism:game:changelog', data, 20000),
+  onGraphicsWarning: (cb: (data: GraphicsDiagnostics) => void) => on('hyprism:game:graphicsWarning', cb as (d: unknown) => void),
 };
 
 const _instance = {
@@ -312,22 +1080,84 @@ const _instance = {
   export: (data?: unknown) => invoke<string>('hyprism:instance:export', data),
   import: (data?: unknown) => invoke<boolean>('hyprism:instance:import', data),
   saves: (data?: unknown) => invoke<SaveInfo[]>('hyprism:instance:saves', data),
+  allSaves: (data?: unknown) => invoke<SaveInfo[]>('hyprism:instance:allSaves', data),
   openSaveFolder: (data?: unknown) => send('hyprism:instance:openSaveFolder', data),
+  deleteSave: (data?: unknown) => invoke<boolean>('hyprism:instance:deleteSave', data),
+  duplicateSave: (data?: unknown) => invoke<string | null>('hyprism:instance:duplicateSave', data, 600000),
+  officialSaves: (data?: unknown) => invoke<{ dirs: string[], saves: SaveInfo[] }>('hyprism:instance:officialSaves', data),
+  importSaves: (data?: unknown) => invoke<string[]>('hyprism:instance:importSaves', data, 600000),
+  backup: (data?: unknown) => invoke<InstanceBackupInfo | null>('hyprism:instance:backup', data, 600000),
+  backups: (data?: unknown) => invoke<InstanceBackupInfo[]>('hyprism:instance:backups', data),
+  restoreBackup: (data?: unknown) => invoke<boolean>('hyprism:instance:restoreBackup', data, 600000),
+  deleteBackup: (data?: unknown) => invoke<boolean>('hyprism:instance:deleteBackup', data),
+  saveModSnapshot: (data?: unknown) => invoke<WorldModSnapshotStatus | null>('hyprism:instance:saveModSnapshot', data),
+  restoreSaveMods: (data?: unknown) => invoke<WorldModRestoreResult>('hyprism:instance:restoreSaveMods', data, 300000),
+  recent: (data?: unknown) => invoke<InstanceActivity[]>('hyprism:instance:recent', data),
+  favorites: (data?: unknown) => invoke<InstanceActivity[]>('hyprism:instance:favorites', data),
+  setFavorite: (data?: unknown) => invoke<boolean>('hyprism:instance:setFavorite', data),
   getIcon: (data?: unknown) => invoke<string | null>('hyprism:instance:getIcon', data),
+  getArtwork: (data?: unknown) => invoke<InstanceArtwork>('hyprism:instance:getArtwork', data),
+  setArtwork: (data?: unknown) => invoke<boolean>('hyprism:instance:setArtwork', data),
+  bundledArtwork: (data?: unknown) => invoke<string[]>('hyprism:instance:bundledArtwork', data),
   select: (data?: unknown) => invoke<boolean>('hyprism:instance:select', data),
   getSelected: (data?: unknown) => invoke<InstanceInfo | null>('hyprism:instance:getSelected', data),
   list: () => invoke<InstanceInfo[]>('hyprism:instance:list'),
+  verifyFiles: (data?: unknown) => invoke<GameFilesReport | null>('hyprism:instance:verifyFiles', data, 600000),
+  repairFiles: (data?: unknown) => invoke<boolean>('hyprism:instance:repairFiles', data),
+  migrate: (data?: unknown) => invoke<InstanceMigrationReport | null>('hyprism:instance:migrate', data, 600000),
+  adopt: (data?: unknown) => invoke<{ success: boolean, instance?: InstanceInfo, error?: string }>('hyprism:instance:adopt', data, 600000),
+  getExtraArgs: (data?: unknown) => invoke<string>('hyprism:instance:getExtraArgs', data),
+  setExtraArgs: (data?: unknown) => invoke<boolean>('hyprism:instance:setExtraArgs', data),
+  getDisplayServer: (data?: unknown) => invoke<DisplayServerSettings>('hyprism:instance:getDisplayServer', data),
+  setDisplayServer: (data?: unknown) => invoke<{ success: boolean, error?: string }>('hyprism:instance:setDisplayServer', data),
+  getGameVersion: (data?: unknown) => invoke<string | null>('hyprism:instance:getGameVersion', data),
+  setGameVersion: (data?: unknown) => invoke<boolean>('hyprism:instance:setGameVersion', data),
+  launchPreview: (data?: unknown) => invoke<LaunchCommand | null>('hyprism:instance:launchPreview', data),
+  exportLaunchScript: (data?: unknown) => invoke<{ path?: string, error?: string }>('hyprism:instance:exportLaunchScript', data),
+  createShortcut: (data?: unknown) => invoke<DesktopShortcutResult>('hyprism:instance:createShortcut', data),
+  recoveryReport: (data?: unknown) => invoke<StartupRecoveryReport>('hyprism:instance:recoveryReport', data),
+};
+
+const _java = {
+  list: () => invoke<JavaRuntimeInfo[]>('hyprism:java:list'),
+  available: (data?: unknown) => invoke<JavaRuntimeInfo[]>('hyprism:java:available', data),
+  detect: (data?: unknown) => invoke<JavaRuntimeInfo[]>('hyprism:java:detect', data),
+  install: (data?: unknown) => invoke<JavaRuntimeInfo | null>('hyprism:java:install', data),
+  verify: (data?: unknown) => invoke<boolean>('hyprism:java:verify', data),
+  repair: (data?: unknown) => invoke<JavaRuntimeInfo | null>('hyprism:java:repair', data),
+  remove: (data?: unknown) => invoke<boolean>('hyprism:java:remove', data),
+  getInstanceRuntime: (data?: unknown) => invoke<string>('hyprism:java:getInstanceRuntime', data),
+  setInstanceRuntime: (data?: unknown) => invoke<boolean>('hyprism:java:setInstanceRuntime', data),
+};
+
+const _gameSettings = {
+  load: (data?: unknown) => invoke<GameSettings>('hyprism:gameSettings:load', data),
+  save: (data?: unknown) => invoke<boolean>('hyprism:gameSettings:save', data),
+  getValue: (data?: unknown) => invoke<unknown>('hyprism:gameSettings:getValue', data),
+  setValue: (data?: unknown) => invoke<boolean>('hyprism:gameSettings:setValue', data),
+  copy: (data?: unknown) => invoke<number>('hyprism:gameSettings:copy', data),
+  getSync: (data?: unknown) => invoke<SettingsSyncConfig>('hyprism:gameSettings:getSync', data),
+  setSync: (data?: unknown) => invoke<boolean>('hyprism:gameSettings:setSync', data),
 };
 
 const _news = {
   get: () => invoke<NewsItem[]>('hyprism:news:get'),
+  feed: (data?: unknown) => invoke<NewsFeed>('hyprism:news:feed', data),
+  sources: (data?: unknown) => invoke<NewsSourceInfo[]>('hyprism:news:sources', data),
+  configureSources: (data?: unknown) => invoke<NewsSourceInfo[]>('hyprism:news:configureSources', data),
+};
+
+const _announcements = {
+  get: () => invoke<Announcement[]>('hyprism:announcements:get'),
+  dismiss: (data?: unknown) => invoke<boolean>('hyprism:announcements:dismiss', data),
 };
 
 const _profile = {
   get: () => invoke<ProfileSnapshot>('hyprism:profile:get'),
   list: () => invoke<Profile[]>('hyprism:profile:list'),
   switch: (data?: unknown) => invoke<{ success: boolean }>('hyprism:profile:switch', data),
-  setNick: (data?: unknown) => invoke<{ success: boolean }>('hyprism:profile:setNick', data),
+  setNick: (data?: unknown) => invoke<{ success: boolean; messageKey?: string; args?: unknown[] }>('hyprism:profile:setNick', data),
+  validateNick: (data?: unknown) => invoke<NicknameValidationResult>('hyprism:profile:validateNick', data),
   setUuid: (data?: unknown) => invoke<{ success: boolean }>('hyprism:profile:setUuid', data),
   create: (data?: unknown) => invoke<Profile>('hyprism:profile:create', data),
   delete: (data?: unknown) => invoke<{ success: boolean }>('hyprism:profile:delete', data),
@@ -336,6 +1166,8 @@ const _profile = {
   duplicate: (data?: unknown) => invoke<Profile>('hyprism:profile:duplicate', data),
   openFolder: (data?: unknown) => send('hyprism:profile:openFolder', data),
   avatarForUuid: (data?: unknown) => invoke<string>('hyprism:profile:avatarForUuid', data),
+  skinHistory: (data?: unknown) => invoke<SkinHistoryEntry[]>('hyprism:profile:skinHistory', data),
+  undoSkinChange: (data?: unknown) => invoke<{ success: boolean; error?: string }>('hyprism:profile:undoSkinChange', data),
 };
 
 const _auth = {
@@ -352,6 +1184,12 @@ const _settings = {
   setInstanceDir: (data?: unknown) => invoke<{ success: boolean, path: string, noop?: boolean, reason?: string, error?: string }>('hyprism:settings:setInstanceDir', data, 300000),
 };
 
+const _onboarding = {
+  state: (data?: unknown) => invoke<OnboardingState>('hyprism:onboarding:state', data),
+  locations: (data?: unknown) => invoke<InstallLocation[]>('hyprism:onboarding:locations', data),
+  complete: (data?: unknown) => invoke<OnboardingResult>('hyprism:onboarding:complete', data),
+};
+
 const _i18n = {
   get: () => invoke<Record<string, string>>('hyprism:i18n:get'),
   current: () => invoke<string>('hyprism:i18n:current'),
@@ -370,25 +1208,152 @@ const _browser = {
   open: (data: string) => send('hyprism:browser:open', data),
 };
 
+const _app = {
+  reportIssue: (data?: unknown) => invoke<string>('hyprism:app:reportIssue', data),
+  onSecondInstance: (cb: (data: SecondInstanceArgs) => void) => on('hyprism:app:secondInstance', cb as (d: unknown) => void),
+  dashboard: (data?: unknown) => invoke<DashboardInfo>('hyprism:app:dashboard', data, 15000),
+  startupChecks: (data?: unknown) => invoke<StartupCheckReport>('hyprism:app:startupChecks', data),
+  recheckStartup: (data?: unknown) => invoke<StartupCheckReport>('hyprism:app:recheckStartup', data, 30000),
+};
+
 const _mods = {
   list: () => invoke<InstalledMod[]>('hyprism:mods:list'),
   search: (data?: unknown) => invoke<ModSearchResult>('hyprism:mods:search', data, 15000),
   installed: (data?: unknown) => invoke<InstalledMod[]>('hyprism:mods:installed', data),
   uninstall: (data?: unknown) => invoke<boolean>('hyprism:mods:uninstall', data),
-  checkUpdates: (data?: unknown) => invoke<InstalledMod[]>('hyprism:mods:checkUpdates', data, 30000),
+  uninstallImpact: (data?: unknown) => invoke<ModUninstallImpact | null>('hyprism:mods:uninstallImpact', data, 15000),
+  checkUpdates: (data?: unknown) => invoke<InstalledMod[]>('hyprism:mods:checkUpdates', data, 120000),
+  onUpdateCheck: (cb: (data: ModUpdateCheckProgress) => void) => on('hyprism:mods:updateCheck', cb as (d: unknown) => void),
   install: (data?: unknown) => invoke<boolean>('hyprism:mods:install', data, 30000),
+  resolveInstall: (data?: unknown) => invoke<ModResolveResult>('hyprism:mods:resolveInstall', data, 60000),
   files: (data?: unknown) => invoke<ModFilesResult>('hyprism:mods:files', data),
   categories: (data?: unknown) => invoke<ModCategory[]>('hyprism:mods:categories', data),
+  categoryTree: (data?: unknown) => invoke<ModCategory[]>('hyprism:mods:categoryTree', data),
+  byAuthor: (data?: unknown) => invoke<ModAuthorResult>('hyprism:mods:byAuthor', data, 15000),
+  cacheImages: (data?: unknown) => invoke<Record<string, string>>('hyprism:mods:cacheImages', data, 60000),
   installLocal: (data?: unknown) => invoke<boolean>('hyprism:mods:installLocal', data),
   installBase64: (data?: unknown) => invoke<boolean>('hyprism:mods:installBase64', data),
   openFolder: (data?: unknown) => send('hyprism:mods:openFolder', data),
   toggle: (data?: unknown) => invoke<boolean>('hyprism:mods:toggle', data),
+  setAllEnabled: (data?: unknown) => invoke<ModBulkToggleResult>('hyprism:mods:setAllEnabled', data, 60000),
+  onBulkToggleProgress: (cb: (data: ModBulkToggleProgress) => void) => on('hyprism:mods:bulkToggleProgress', cb as (d: unknown) => void),
+  gameVersions: (data?: unknown) => invoke<string[]>('hyprism:mods:gameVersions', data, 15000),
+  onCompatibilityWarning: (cb: (data: ModCompatibilityReport) => void) => on('hyprism:mods:compatibilityWarning', cb as (d: unknown) => void),
+  bisectStart: (data?: unknown) => invoke<{ state: ModBisectState | null, error?: string }>('hyprism:mods:bisectStart', data),
+  bisectState: (data?: unknown) => invoke<ModBisectState | null>('hyprism:mods:bisectState', data),
+  bisectLaunch: (data?: unknown) => send('hyprism:mods:bisectLaunch', data),
+  bisectReport: (data?: unknown) => invoke<{ state: ModBisectState | null, error?: string }>('hyprism:mods:bisectReport', data),
+  bisectCancel: (data?: unknown) => invoke<boolean>('hyprism:mods:bisectCancel', data),
   exportToFolder: (data?: unknown) => invoke<string>('hyprism:mods:exportToFolder', data),
   importList: (data?: unknown) => invoke<number>('hyprism:mods:importList', data),
 };
 
 const _system = {
   gpuAdapters: () => invoke<GpuAdapterInfo[]>('hyprism:system:gpuAdapters'),
+  networkStatus: (data?: unknown) => invoke<NetworkStatus>('hyprism:system:networkStatus', data, 20000),
+  bandwidthUsage: (data?: unknown) => invoke<BandwidthMonth[]>('hyprism:system:bandwidthUsage', data),
+  resetBandwidthUsage: (data?: unknown) => invoke<boolean>('hyprism:system:resetBandwidthUsage', data),
+  platformSupport: (data?: unknown) => invoke<PlatformComponentSupport[]>('hyprism:system:platformSupport', data),
+  graphicsCheck: (data?: unknown) => invoke<GraphicsDiagnostics>('hyprism:system:graphicsCheck', data, 30000),
+  defenderCheck: (data?: unknown) => invoke<DefenderReport>('hyprism:system:defenderCheck', data, 30000),
+};
+
+const _tasks = {
+  list: () => invoke<BackgroundTask[]>('hyprism:tasks:list'),
+  cancel: (data?: unknown) => invoke<boolean>('hyprism:tasks:cancel', data),
+  onUpdated: (cb: (data: BackgroundTask) => void) => on('hyprism:tasks:updated', cb as (d: unknown) => void),
+};
+
+const _progress = {
+  onUpdate: (cb: (data: OperationProgress) => void) => on('hyprism:progress:update', cb as (d: unknown) => void),
+};
+
+const _telemetry = {
+  status: (data?: unknown) => invoke<TelemetryStatus>('hyprism:telemetry:status', data),
+  setEnabled: (data?: unknown) => invoke<TelemetryStatus>('hyprism:telemetry:setEnabled', data),
+  preview: (data?: unknown) => invoke<TelemetryBatch>('hyprism:telemetry:preview', data),
+};
+
+const _errorReporting = {
+  status: (data?: unknown) => invoke<ErrorReportingStatus>('hyprism:errorReporting:status', data),
+  setEnabled: (data?: unknown) => invoke<ErrorReportingStatus>('hyprism:errorReporting:setEnabled', data),
+  setEndpoint: (data?: unknown) => invoke<{ status: ErrorReportingStatus, error?: string }>('hyprism:errorReporting:setEndpoint', data),
+  preview: (data?: unknown) => invoke<ErrorReport>('hyprism:errorReporting:preview', data),
+};
+
+const _automation = {
+  status: (data?: unknown) => invoke<AutomationApiStatus>('hyprism:automation:status', data),
+  setEnabled: (data?: unknown) => invoke<AutomationApiStatus>('hyprism:automation:setEnabled', data),
+  regenerateToken: (data?: unknown) => invoke<AutomationApiStatus>('hyprism:automation:regenerateToken', data),
+};
+
+const _search = {
+  query: (data?: unknown) => invoke<SearchResult[]>('hyprism:search:query', data, 15000),
+};
+
+const _update = {
+  status: (data?: unknown) => invoke<LauncherUpdateCheck | null>('hyprism:update:status', data),
+  onAvailable: (cb: (data: LauncherUpdateInfo) => void) => on('hyprism:update:available', cb as (d: unknown) => void),
+};
+
+const _metered = {
+  pending: (data?: unknown) => invoke<DownloadConfirmation[]>('hyprism:metered:pending', data),
+  respond: (data?: unknown) => invoke<boolean>('hyprism:metered:respond', data),
+  onConfirmationRequired: (cb: (data: DownloadConfirmation) => void) => on('hyprism:metered:confirmationRequired', cb as (d: unknown) => void),
+};
+
+const _trash = {
+  list: () => invoke<TrashEntry[]>('hyprism:trash:list'),
+  restore: (data?: unknown) => invoke<boolean>('hyprism:trash:restore', data, 600000),
+  delete: (data?: unknown) => invoke<boolean>('hyprism:trash:delete', data),
+  empty: (data?: unknown) => invoke<number>('hyprism:trash:empty', data, 600000),
+};
+
+const _sync = {
+  getConfig: (data?: unknown) => invoke<{ config: CloudSyncConfig, hasPassword: boolean }>('hyprism:sync:getConfig', data),
+  setConfig: (data?: unknown) => invoke<boolean>('hyprism:sync:setConfig', data),
+  test: (data?: unknown) => invoke<{ error?: string }>('hyprism:sync:test', data, 60000),
+  run: (data?: unknown) => invoke<CloudSyncResult>('hyprism:sync:run', data, 600000),
+  status: (data?: unknown) => invoke<{ lastResult: CloudSyncResult | null, conflicts: CloudSyncConflict[] }>('hyprism:sync:status', data),
+  resolve: (data?: unknown) => invoke<{ error?: string }>('hyprism:sync:resolve', data, 600000),
+};
+
+const _quickActions = {
+  run: (data?: unknown) => invoke<QuickActionResult>('hyprism:quickActions:run', data),
+  hotkeys: (data?: unknown) => invoke<QuickActionHotkey[]>('hyprism:quickActions:hotkeys', data),
+  setHotkey: (data?: unknown) => invoke<{ hotkeys: QuickActionHotkey[], error?: string }>('hyprism:quickActions:setHotkey', data),
+  onMusicToggled: (cb: (data: { musicEnabled: boolean }) => void) => on('hyprism:quickActions:musicToggled', cb as (d: unknown) => void),
+};
+
+const _playtime = {
+  status: (data?: unknown) => invoke<PlaytimeStatus>('hyprism:playtime:status', data),
+  setLimits: (data?: unknown) => invoke<{ status: PlaytimeStatus, error?: string }>('hyprism:playtime:setLimits', data),
+  setPin: (data?: unknown) => invoke<{ success: boolean, error?: string }>('hyprism:playtime:setPin', data),
+  override: (data?: unknown) => invoke<{ status: PlaytimeStatus, error?: string }>('hyprism:playtime:override', data),
+  onWarning: (cb: (data: PlaytimeWarning) => void) => on('hyprism:playtime:warning', cb as (d: unknown) => void),
+};
+
+const _installLogs = {
+  list: () => invoke<InstallLogInfo[]>('hyprism:installLogs:list'),
+  get: () => invoke<string | null>('hyprism:installLogs:get'),
+  openFolder: (data?: unknown) => send('hyprism:installLogs:openFolder', data),
+};
+
+const _prerelease = {
+  notice: (data?: unknown) => invoke<PreReleaseNotice | null>('hyprism:prerelease:notice', data),
+  acknowledge: (data?: unknown) => invoke<PreReleaseAcknowledgeResult>('hyprism:prerelease:acknowledge', data),
+  onNoticeRequired: (cb: (data: PreReleaseNotice) => void) => on('hyprism:prerelease:noticeRequired', cb as (d: unknown) => void),
+};
+
+const _steamDeck = {
+  status: (data?: unknown) => invoke<SteamDeckStatus>('hyprism:steamDeck:status', data),
+  setGamepadMode: (data?: unknown) => invoke<SteamDeckStatus>('hyprism:steamDeck:setGamepadMode', data),
+  addShortcut: (data?: unknown) => invoke<SteamShortcutResult>('hyprism:steamDeck:addShortcut', data),
+};
+
+const _events = {
+  cursor: (data?: unknown) => invoke<number>('hyprism:events:cursor', data),
+  replay: (data?: unknown) => invoke<ReplayedEvent[]>('hyprism:events:replay', data),
 };
 
 const _console = {
@@ -398,7 +1363,10 @@ const _console = {
 };
 
 const _logs = {
-  get: () => invoke<string[]>('hyprism:logs:get'),
+  query: (data?: unknown) => invoke<LogPage>('hyprism:logs:query', data),
+  subscribe: (data?: unknown) => send('hyprism:logs:subscribe', data),
+  unsubscribe: (data?: unknown) => send('hyprism:logs:unsubscribe', data),
+  onLine: (cb: (data: LogLine) => void) => on('hyprism:logs:line', cb as (d: unknown) => void),
 };
 
 const _file = {
@@ -414,15 +1382,36 @@ export const ipc = {
   config: _config,
   game: _game,
   instance: _instance,
+  java: _java,
+  gameSettings: _gameSettings,
   news: _news,
+  announcements: _announcements,
   profile: _profile,
   auth: _auth,
   settings: _settings,
+  onboarding: _onboarding,
   i18n: _i18n,
   windowCtl: _window,
   browser: _browser,
+  app: _app,
   mods: _mods,
   system: _system,
+  tasks: _tasks,
+  progress: _progress,
+  telemetry: _telemetry,
+  errorReporting: _errorReporting,
+  automation: _automation,
+  search: _search,
+  update: _update,
+  metered: _metered,
+  trash: _trash,
+  sync: _sync,
+  quickActions: _quickActions,
+  playtime: _playtime,
+  installLogs: _installLogs,
+  prerelease: _prerelease,
+  steamDeck: _steamDeck,
+  events: _events,
   consoleCtl: _console,
   logs: _logs,
   file: _file,
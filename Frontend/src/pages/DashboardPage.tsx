@@ -7,6 +7,7 @@ import { useAccentColor } from '../contexts/AccentColorContext';
 import { ipc, InstanceInfo } from '@/lib/ipc';
 import { DiscordIcon } from '../components/icons/DiscordIcon';
 import { formatBytes } from '../utils/format';
+import { resolveArtworkUrl } from '../utils/artwork';
 import previewLogo from '../assets/images/preview_logo.png';
 
 interface DashboardPageProps {
@@ -61,12 +62,6 @@ export const DashboardPage: React.FC<DashboardPageProps> = memo((props) => {
   const [showCancelButton, setShowCancelButton] = useState(false);
   const [selectedInstanceIcon, setSelectedInstanceIcon] = useState<string | null>(null);
 
-  const withCacheBust = (iconUrl: string) => {
-    if (!iconUrl) return iconUrl;
-    const separator = iconUrl.includes('?') ? '&' : '?';
-    return `${iconUrl}${separator}t=${Date.now()}`;
-  };
-
   useEffect(() => {
     ipc.profile.get().then(p => { if (p.avatarPath) setLocalAvatar(p.avatarPath); }).catch(() => {});
   }, [props.uuid, props.avatarRefreshTrigger]);
@@ -100,7 +95,7 @@ export const DashboardPage: React.FC<DashboardPageProps> = memo((props) => {
       const cachedIcon = localStorage.getItem(storageKey);
       if (cachedIcon)
       {
-        setSelectedInstanceIcon(withCacheBust(cachedIcon));
+        setSelectedInstanceIcon(resolveArtworkUrl(cachedIcon, true));
       }
       else
       {
@@ -120,7 +115,7 @@ export const DashboardPage: React.FC<DashboardPageProps> = memo((props) => {
 
         if (icon) {
           try { localStorage.setItem(storageKey, icon); } catch { /* ignore */ }
-          setSelectedInstanceIcon(withCacheBust(icon));
+          setSelectedInstanceIcon(resolveArtworkUrl(icon, true));
         } else {
           try { localStorage.removeItem(storageKey); } catch { /* ignore */ }
           setSelectedInstanceIcon(null);
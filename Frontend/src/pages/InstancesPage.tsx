@@ -6,16 +6,21 @@ import {
   Clock, Box, Loader2, AlertTriangle, Check, Plus,
   Search, Package, MoreVertical,
   ChevronRight, Image, Map, Globe, Play, X, Edit2,
-  Download, AlertCircle
+  Download, AlertCircle, ShieldOff, Bug, Layers, Power, PowerOff, RotateCcw, Archive, Copy, Star, MonitorUp
 } from 'lucide-react';
 import { useAccentColor } from '../contexts/AccentColorContext';
 
-import { ipc, InstalledInstance, invoke, send, SaveInfo, InstanceValidationDetails } from '@/lib/ipc';
+import { ipc, InstalledInstance, invoke, send, SaveInfo, InstanceValidationDetails, ModBisectState, ModUninstallImpact, WorldModSnapshotStatus } from '@/lib/ipc';
 import { InlineModBrowser } from '../components/InlineModBrowser';
+import { ModBisectPanel } from '../components/ModBisectPanel';
 import { formatBytes } from '../utils/format';
+import { resolveArtworkUrl } from '../utils/artwork';
 import { GameBranch } from '@/constants/enums';
 import { CreateInstanceModal } from '../components/modals/CreateInstanceModal';
 import { EditInstanceModal } from '../components/modals/EditInstanceModal';
+import { InstanceBackupsModal } from '../components/modals/InstanceBackupsModal';
+import { ImportOfficialWorldsModal } from '../components/modals/ImportOfficialWorldsModal';
+import { TrashModal } from '../components/modals/TrashModal';
 
 // IPC calls for instance operations - uses invoke to send to backend
 const ExportInstance = async (instanceId: string): Promise<string> => {
@@ -63,22 +68,32 @@ const GetInstanceInstalledMods = async (branch: string, version: number, instanc
   }
 };
 
-const UninstallInstanceMod = async (modId: string, branch: string, version: number, instanceId?: string): Promise<boolean> => {
+const UninstallInstanceMod = async (modId: string, branch: string, version: number, instanceId?: string, removeOrphans = false): Promise<boolean> => {
   try {
-    return await invoke<boolean>('hyprism:mods:uninstall', { modId, branch, version, instanceId });
+    return await invoke<boolean>('hyprism:mods:uninstall', { modId, branch, version, instanceId, removeOrphans });
   } catch (e) {
     console.warn('[IPC] UninstallInstanceMod:', e);
     return false;
   }
 };
 
+const GetModUninstallImpact = async (modId: string, branch: string, version: number, instanceId?: string): Promise<ModUninstallImpact | null> => {
+  try {
+    return await ipc.mods.uninstallImpact({ modId, branch, version, instanceId });
+  } catch (e) {
+    console.warn('[IPC] GetModUninstallImpact:', e);
+    return null;
+  }
+};
+
 const OpenInstanceModsFolder = (instanceId: string): void => {
   send('hyprism:instance:openModsFolder', { instanceId });
 };
 
 const CheckInstanceModUpdates = async (branch: string, version: number, instanceId?: string): Promise<ModInfo[]> => {
   try {
-    return await invoke<ModInfo[]>('hyprism:mods:checkUpdates', { branch, version, instanceId });
+    // Large mod lists take a while; per-mod results also arrive on hyprism:mods:updateCheck
+    return await invoke<ModInfo[]>('hyprism:mods:checkUpdates', { branch, version, instanceId }, 120000);
   } catch (e) {
     console.warn('[IPC] CheckInstanceModUpdates:', e);
     return [];
@@ -86,35 +101,34 @@ const CheckInstanceModUpdates = async (branch: string, version: number, instance
 };
 
 // World/Save IPC calls
-const GetInstanceSaves = async (branch: string, version: number): Promise<SaveInfo[]> => {
+const GetInstanceSaves = async (instanceId: string): Promise<SaveInfo[]> => {
   try {
-    return await invoke<SaveInfo[]>('hyprism:instance:saves', { branch, version });
+    return await invoke<SaveInfo[]>('hyprism:instance:saves', { instanceId });
   } catch (e) {
     console.warn('[IPC] GetInstanceSaves:', e);
     return [];
   }
 };
 
-const OpenSaveFolder = (branch: string, version: number, saveName: string): void => {
-  send('hyprism:instance:openSaveFolder', { branch, version, saveName });
-};
-
-const DeleteSaveFolder = async (branch: string, version: number, saveName: string): Promise<boolean> => {
+const GetAllSaves = async (): Promise<SaveInfo[]> => {
   try {
-    return await invoke<boolean>('hyprism:instance:deleteSave', { branch, version, saveName });
+    return await invoke<SaveInfo[]>('hyprism:instance:allSaves');
   } catch (e) {
-    console.warn('[IPC] DeleteSaveFolder:', e);
-    return false;
+    console.warn('[IPC] GetAllSaves:', e);
+    return [];
   }
 };
 
-// Instance icon IPC calls
-const GetInstanceIcon = async (instanceId: string): Promise<string | null> => {
+const OpenSaveFolder = (save: SaveInfo): void => {
+  send('hyprism:instance:openSaveFolder', { instanceId: save.instanceId, saveName: save.name });
+};
+
+const DeleteSaveFolder = async (save: SaveInfo): Promise<boolean> => {
   try {
-    return await invoke<string | null>('hyprism:instance:getIcon', { instanceId });
+    return await invoke<boolean>('hyprism:instance:deleteSave', { instanceId: save.instanceId, saveName: save.name });
   } catch (e) {
-    console.warn('[IPC] GetInstanceIcon:', e);
-    return null;
+    console.warn('[IPC] DeleteSaveFolder:', e);
+    return false;
   }
 };
 
@@ -136,6 +150,7 @@ interface ModInfo {
   releaseType?: number;
   latestVersion?: string;
   latestFileId?: number;
+  gameVersions?: string[] | null;
 }
 
 // Convert InstalledInstance to InstalledVersionInfo
@@ -147,7 +162,8 @@ const toVersionInfo = (inst: InstalledInstance): InstalledVersionInfo => ({
   sizeBytes: inst.totalSize,
   isLatest: false,
   isLatestInstance: inst.version === 0,
-  iconPath: undefined,
+  iconPath: inst.icon ?? undefined,
+  bannerPath: inst.banner ?? undefined,
   validationStatus: inst.validationStatus,
   validationDetails: inst.validationDetails,
   customName: inst.customName,
@@ -167,6 +183,7 @@ export interface InstalledVersionInfo {
   lastPlayedAt?: string;
   updatedAt?: string;
   iconPath?: string;
+  bannerPath?: string;
   customName?: string;
   validationStatus?: 'Valid' | 'NotInstalled' | 'Corrupted' | 'Unknown';
   validationDetails?: InstanceValidationDetails;
@@ -204,6 +221,10 @@ interface InstancesPageProps {
   onCancelDownload?: () => void;
   // Launch callback — routes through App.tsx so download state is tracked
   onLaunchInstance?: (branch: string, version: number) => void;
+  // Launch with every mod disabled until the game exits
+  onLaunchInstanceSafeMode?: (instanceId: string) => void;
+  // Launch the current step of the mod bisect
+  onLaunchBisectStep?: (instanceId: string) => void;
   // Official server blocking
   officialServerBlocked?: boolean;
 }
@@ -229,6 +250,8 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
   canCancel = false,
   onCancelDownload,
   onLaunchInstance,
+  onLaunchInstanceSafeMode,
+  onLaunchBisectStep,
   officialServerBlocked = false,
 }) => {
   const { t } = useTranslation();
@@ -239,6 +262,7 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
   const [instanceDir, setInstanceDir] = useState('');
   const [instanceToDelete, setInstanceToDelete] = useState<InstalledVersionInfo | null>(null);
   const [exportingInstance, setExportingInstance] = useState<string | null>(null);
+  const [backupsInstance, setBackupsInstance] = useState<InstalledVersionInfo | null>(null);
   const [isImporting, setIsImporting] = useState(false);
   const [message, setMessage] = useState<{ type: 'success' | 'error'; text: string } | null>(null);
 
@@ -262,6 +286,11 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
   const contentSelectionAnchorRef = useRef<number | null>(null);
   const [modToDelete, setModToDelete] = useState<ModInfo | null>(null);
   const [isDeletingMod, setIsDeletingMod] = useState(false);
+  const [uninstallImpact, setUninstallImpact] = useState<ModUninstallImpact | null>(null);
+  const [bulkToggle, setBulkToggle] = useState<{ enabled: boolean; done: number; total: number } | null>(null);
+  // CurseForge game version set in the instance settings; mods whose files don't list it get a badge
+  const [instanceGameVersion, setInstanceGameVersion] = useState<string | null>(null);
+  const [removeOrphans, setRemoveOrphans] = useState(true);
   const [editingInstanceName, setEditingInstanceName] = useState(false);
   const [showEditModal, setShowEditModal] = useState(false);
   const [editNameValue, setEditNameValue] = useState('');
@@ -273,9 +302,19 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
   // Saves/Worlds for selected instance
   const [saves, setSaves] = useState<SaveInfo[]>([]);
   const [isLoadingSaves, setIsLoadingSaves] = useState(false);
+  const [showImportWorlds, setShowImportWorlds] = useState(false);
+  const [showTrash, setShowTrash] = useState(false);
+  const [showAllSaves, setShowAllSaves] = useState(false);
+  const [duplicateSave, setDuplicateSave] = useState<SaveInfo | null>(null);
+  const [duplicateSaveName, setDuplicateSaveName] = useState('');
+  const [isDuplicatingSave, setIsDuplicatingSave] = useState(false);
+  const [saveModRestore, setSaveModRestore] = useState<{ save: SaveInfo; status: WorldModSnapshotStatus } | null>(null);
+  const [isRestoringSaveMods, setIsRestoringSaveMods] = useState(false);
 
   // Instance icons cache
   const [instanceIcons, setInstanceIcons] = useState<Record<string, string>>({});
+  const [instanceBanners, setInstanceBanners] = useState<Record<string, string>>({});
+  const [favoriteIds, setFavoriteIds] = useState<Set<string>>(new Set());
 
   // Instance action menu
   const [showInstanceMenu, setShowInstanceMenu] = useState(false);
@@ -385,6 +424,58 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     GetCustomInstanceDir().then(dir => dir && setInstanceDir(dir)).catch(() => {});
   }, [loadInstances]);
 
+  useEffect(() => {
+    ipc.instance.favorites()
+      .then((favorites) => setFavoriteIds(new Set(favorites.map((f) => f.instanceId))))
+      .catch(() => {});
+  }, [instances]);
+
+  // The shortcut launches with whichever profile is active now
+  const handleCreateShortcut = async (inst: InstalledVersionInfo) => {
+    try {
+      const [profiles, activeIndex] = await Promise.all([ipc.profile.list(), ipc.profile.activeIndex()]);
+      const profileId = profiles[activeIndex]?.id;
+      const result = await ipc.instance.createShortcut({ instanceId: inst.id, profileId });
+      setMessage(result.success
+        ? { type: 'success', text: t('instances.shortcutCreated') }
+        : { type: 'error', text: t('instances.shortcutFailed', { error: result.error }) });
+    } catch (e) {
+      console.warn('[IPC] createShortcut:', e);
+      setMessage({ type: 'error', text: t('instances.shortcutFailed', { error: String(e) }) });
+    }
+    setTimeout(() => setMessage(null), 3000);
+  };
+
+  const handleToggleFavorite = async (inst: InstalledVersionInfo) => {
+    const favorite = !favoriteIds.has(inst.id);
+    try {
+      if (await ipc.instance.setFavorite({ instanceId: inst.id, favorite })) {
+        setFavoriteIds((prev) => {
+          const next = new Set(prev);
+          if (favorite) next.add(inst.id);
+          else next.delete(inst.id);
+          return next;
+        });
+      }
+    } catch (e) {
+      console.warn('[IPC] setFavorite:', e);
+    }
+  };
+
+  const loadInstanceGameVersion = useCallback(async () => {
+    if (!selectedInstance) {
+      setInstanceGameVersion(null);
+      return;
+    }
+    try {
+      setInstanceGameVersion(await ipc.instance.getGameVersion({ instanceId: selectedInstance.id }));
+    } catch {
+      setInstanceGameVersion(null);
+    }
+  }, [selectedInstance]);
+
+  useEffect(() => { loadInstanceGameVersion(); }, [loadInstanceGameVersion]);
+
   // Load installed mods when selected instance changes
   const loadInstalledMods = useCallback(async () => {
     if (!selectedInstance) {
@@ -432,6 +523,12 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
 
   // Load saves when selected instance changes
   const loadSaves = useCallback(async () => {
+    if (showAllSaves) {
+      setIsLoadingSaves(true);
+      setSaves(await GetAllSaves());
+      setIsLoadingSaves(false);
+      return;
+    }
     if (!selectedInstance) {
       setSaves([]);
       return;
@@ -439,14 +536,28 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     
     setIsLoadingSaves(true);
     try {
-      const savesData = await GetInstanceSaves(selectedInstance.branch, selectedInstance.version);
+      const savesData = await GetInstanceSaves(selectedInstance.id);
       setSaves(savesData || []);
     } catch (err) {
       console.error('Failed to load saves:', err);
       setSaves([]);
     }
     setIsLoadingSaves(false);
-  }, [selectedInstance]);
+  }, [selectedInstance, showAllSaves]);
+
+  // Worlds of every instance, grouped by the instance they belong to
+  const saveGroups = useMemo(() => {
+    const groups: { instanceId: string; instanceName: string; saves: SaveInfo[] }[] = [];
+    for (const save of saves) {
+      let group = groups.find((g) => g.instanceId === save.instanceId);
+      if (!group) {
+        group = { instanceId: save.instanceId, instanceName: save.instanceName, saves: [] };
+        groups.push(group);
+      }
+      group.saves.push(save);
+    }
+    return groups;
+  }, [saves]);
 
   useEffect(() => {
     if (activeTab === 'worlds') {
@@ -454,21 +565,19 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     }
   }, [loadSaves, activeTab]);
 
-  // Load instance icons
+  // Instance icons and banners come with the instance list; reloading the list picks up edits
   useEffect(() => {
-    const loadIcons = async () => {
-      for (const inst of instances) {
-        const key = inst.id;
-        if (!instanceIcons[key]) {
-          const icon = await GetInstanceIcon(inst.id);
-          if (icon) {
-            setInstanceIcons(prev => ({ ...prev, [key]: icon }));
-          }
-        }
-      }
-    };
-    loadIcons();
-  }, [instances, instanceIcons]);
+    const icons: Record<string, string> = {};
+    const banners: Record<string, string> = {};
+    for (const inst of instances) {
+      const icon = resolveArtworkUrl(inst.iconPath, true);
+      const banner = resolveArtworkUrl(inst.bannerPath, true);
+      if (icon) icons[inst.id] = icon;
+      if (banner) banners[inst.id] = banner;
+    }
+    setInstanceIcons(icons);
+    setInstanceBanners(banners);
+  }, [instances]);
 
   // Normalize backend payload casing and defaults
   const normalizeInstalledMods = (mods: unknown[]): ModInfo[] => {
@@ -488,6 +597,7 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
         fileId: mod.fileId as number || mod.FileId as number,
         latestVersion: mod.latestVersion as string || mod.LatestVersion as string,
         latestFileId: mod.latestFileId as number || mod.LatestFileId as number,
+        gameVersions: (mod.gameVersions ?? mod.GameVersions) as string[] | null | undefined,
       } as ModInfo;
     });
   };
@@ -553,20 +663,88 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     ipc.browser.open(getCurseForgeUrl(mod));
   }, [getCurseForgeUrl]);
 
-  const handleDeleteSave = useCallback(async (e: React.MouseEvent, saveName: string) => {
+  const handleDeleteSave = useCallback(async (e: React.MouseEvent, save: SaveInfo) => {
     e.preventDefault();
     e.stopPropagation();
-    if (!selectedInstance) return;
 
-    const ok = await DeleteSaveFolder(selectedInstance.branch, selectedInstance.version, saveName);
+    const ok = await DeleteSaveFolder(save);
     if (ok) {
-      setMessage({ type: 'success', text: 'World deleted' });
+      setMessage({ type: 'success', text: t('trash.movedWorld') });
       await loadSaves();
     } else {
-      setMessage({ type: 'error', text: 'Failed to delete world' });
+      setMessage({ type: 'error', text: t('trash.deleteFailed') });
+    }
+    setTimeout(() => setMessage(null), 3000);
+  }, [loadSaves, t]);
+
+  const handleOpenDuplicateSave = useCallback((e: React.MouseEvent, save: SaveInfo) => {
+    e.preventDefault();
+    e.stopPropagation();
+    setDuplicateSave(save);
+    setDuplicateSaveName(t('instances.duplicateWorld.defaultName', { name: save.name }));
+  }, [t]);
+
+  const handleDuplicateSave = async () => {
+    if (!duplicateSave || !duplicateSaveName.trim()) return;
+    setIsDuplicatingSave(true);
+    try {
+      const copy = await ipc.instance.duplicateSave({
+        instanceId: duplicateSave.instanceId,
+        saveName: duplicateSave.name,
+        newName: duplicateSaveName.trim(),
+      });
+      if (copy) {
+        setDuplicateSave(null);
+        setMessage({ type: 'success', text: t('instances.duplicateWorld.done', { name: copy }) });
+        await loadSaves();
+      } else {
+        setMessage({ type: 'error', text: t('instances.duplicateWorld.failed') });
+      }
+    } catch {
+      setMessage({ type: 'error', text: t('instances.duplicateWorld.failed') });
+    }
+    setIsDuplicatingSave(false);
+    setTimeout(() => setMessage(null), 3000);
+  };
+
+  const handleOpenSaveModRestore = useCallback(async (e: React.MouseEvent, save: SaveInfo) => {
+    e.preventDefault();
+    e.stopPropagation();
+
+    try {
+      const status = await ipc.instance.saveModSnapshot({ instanceId: save.instanceId, saveName: save.name });
+      if (status) {
+        setSaveModRestore({ save, status });
+        return;
+      }
+    } catch (err) {
+      console.warn('[IPC] saveModSnapshot:', err);
     }
+    setMessage({ type: 'error', text: t('instances.modSnapshot.notFound') });
     setTimeout(() => setMessage(null), 3000);
-  }, [selectedInstance, loadSaves, t]);
+  }, [t]);
+
+  const handleRestoreSaveMods = async () => {
+    if (!saveModRestore) return;
+    const { save } = saveModRestore;
+    setIsRestoringSaveMods(true);
+    try {
+      const result = await ipc.instance.restoreSaveMods({ instanceId: save.instanceId, saveName: save.name });
+      setSaveModRestore(null);
+      await loadInstalledMods();
+      if (result.messageKey) {
+        setMessage({ type: 'error', text: t(result.messageKey) });
+      } else if (result.failed.length > 0) {
+        setMessage({ type: 'error', text: t('instances.modSnapshot.partial', { names: result.failed.join(', ') }) });
+      } else {
+        setMessage({ type: 'success', text: t('instances.modSnapshot.restored') });
+      }
+    } catch {
+      setMessage({ type: 'error', text: t('instances.modSnapshot.failed') });
+    }
+    setIsRestoringSaveMods(false);
+    setTimeout(() => setMessage(null), 3000);
+  };
 
   const handleExport = async (inst: InstalledVersionInfo) => {
     setExportingInstance(inst.id);
@@ -630,6 +808,52 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     OpenInstanceModsFolder(inst.id);
   };
 
+  // Mod bisect (crash hunting); refreshed when a test session starts or ends
+  const [bisectState, setBisectState] = useState<ModBisectState | null>(null);
+
+  const refreshBisectState = useCallback(async () => {
+    try {
+      setBisectState(await ipc.mods.bisectState());
+    } catch {
+      setBisectState(null);
+    }
+  }, []);
+
+  useEffect(() => {
+    refreshBisectState();
+  }, [refreshBisectState, isGameRunning, isDownloading]);
+
+  const handleStartBisect = async (inst: InstalledVersionInfo) => {
+    const result = await ipc.mods.bisectStart({ instanceId: inst.id });
+    if (result.error) {
+      setMessage({ type: 'error', text: result.error });
+      return;
+    }
+    setBisectState(result.state);
+  };
+
+  const handleBisectReport = async (crashed: boolean) => {
+    const result = await ipc.mods.bisectReport({ crashed });
+    if (result.error) setMessage({ type: 'error', text: result.error });
+    setBisectState(result.state);
+  };
+
+  const handleBisectCancel = async () => {
+    await ipc.mods.bisectCancel();
+    setBisectState(null);
+  };
+
+  const handleDisableBisectCulprit = async () => {
+    const culprit = bisectState?.culprit;
+    if (!bisectState || !culprit) return;
+    const mod = installedMods.find(m => m.id === culprit.id);
+    if (mod?.enabled) {
+      await ipc.mods.toggle({ modId: culprit.id, instanceId: bisectState.instanceId });
+      if (selectedInstance?.id === bisectState.instanceId) await loadInstalledMods();
+    }
+    await handleBisectCancel();
+  };
+
   // Launch an instance
   const handleLaunchInstance = (inst: InstalledVersionInfo) => {
     const runningIdentityKnown = !!runningBranch && runningVersion !== undefined;
@@ -661,11 +885,23 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     }
   };
 
+  // Look up what depends on the mod while the delete confirmation is open
+  useEffect(() => {
+    setUninstallImpact(null);
+    setRemoveOrphans(true);
+    if (!modToDelete || !selectedInstance) return;
+    let cancelled = false;
+    GetModUninstallImpact(modToDelete.id, selectedInstance.branch, selectedInstance.version, selectedInstance.id)
+      .then((impact) => { if (!cancelled) setUninstallImpact(impact); });
+    return () => { cancelled = true; };
+  }, [modToDelete, selectedInstance]);
+
   const handleDeleteMod = async (mod: ModInfo) => {
     if (!selectedInstance) return;
     setIsDeletingMod(true);
     try {
-      await UninstallInstanceMod(mod.id, selectedInstance.branch, selectedInstance.version, selectedInstance.id);
+      const cascade = removeOrphans && (uninstallImpact?.orphans.length ?? 0) > 0;
+      await UninstallInstanceMod(mod.id, selectedInstance.branch, selectedInstance.version, selectedInstance.id, cascade);
       setModToDelete(null);
       await loadInstalledMods();
       setMessage({ type: 'success', text: t('modManager.modDeleted') });
@@ -676,6 +912,29 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
     setIsDeletingMod(false);
   };
 
+  const handleSetAllModsEnabled = async (enabled: boolean) => {
+    if (!selectedInstance || bulkToggle) return;
+    setBulkToggle({ enabled, done: 0, total: 0 });
+    const unsubscribe = ipc.mods.onBulkToggleProgress((progress) => {
+      if (progress.enabled === enabled) setBulkToggle({ enabled, done: progress.done, total: progress.total });
+    });
+    try {
+      const result = await ipc.mods.setAllEnabled({ enabled, instanceId: selectedInstance.id });
+      await loadInstalledMods();
+      if (result.failed > 0) {
+        setMessage({ type: 'error', text: t('modManager.toggleAllFailed', { count: result.failed }) });
+      } else {
+        setMessage({ type: 'success', text: t(enabled ? 'modManager.allEnabled' : 'modManager.allDisabled', { count: result.changed }) });
+      }
+      setTimeout(() => setMessage(null), 3000);
+    } catch {
+      setMessage({ type: 'error', text: t('modManager.toggleAllError') });
+    } finally {
+      unsubscribe();
+      setBulkToggle(null);
+    }
+  };
+
   const handleBulkDeleteMods = async () => {
     if (!selectedInstance || selectedMods.size === 0) return;
     setIsDeletingMod(true);
@@ -906,16 +1165,21 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                   
                   {/* Instance Info */}
                   <div className="flex-1 min-w-0">
-                    <p 
-                      className="text-white text-sm font-medium leading-tight overflow-hidden whitespace-nowrap"
-                      title={getInstanceDisplayName(inst)}
-                      style={{
-                        maskImage: 'linear-gradient(to right, black 85%, transparent 100%)',
-                        WebkitMaskImage: 'linear-gradient(to right, black 85%, transparent 100%)'
-                      }}
-                    >
-                      {getInstanceDisplayName(inst)}
-                    </p>
+                    <div className="flex items-center gap-1">
+                      <p 
+                        className="text-white text-sm font-medium leading-tight overflow-hidden whitespace-nowrap"
+                        title={getInstanceDisplayName(inst)}
+                        style={{
+                          maskImage: 'linear-gradient(to right, black 85%, transparent 100%)',
+                          WebkitMaskImage: 'linear-gradient(to right, black 85%, transparent 100%)'
+                        }}
+                      >
+                        {getInstanceDisplayName(inst)}
+                      </p>
+                      {favoriteIds.has(inst.id) && (
+                        <Star size={11} className="flex-shrink-0 fill-current" style={{ color: accentColor }} />
+                      )}
+                    </div>
                     <div className="flex items-center gap-2 mt-0.5">
                       <span className="text-white/40 text-xs">
                         {inst.sizeBytes ? formatBytes(inst.sizeBytes) : t('common.unknown')}
@@ -1023,6 +1287,12 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
           <>
             {/* Unified instance detail panel */}
             <div className={`flex-1 flex flex-col overflow-hidden rounded-2xl glass-panel-static-solid`}>
+            {instanceBanners[selectedInstance.id] && (
+              <div
+                className="h-24 flex-shrink-0 bg-cover bg-center border-b border-white/[0.06]"
+                style={{ backgroundImage: `url("${instanceBanners[selectedInstance.id]}")` }}
+              />
+            )}
             {/* Tabs & Actions */}
             <div className="flex items-center justify-between gap-4 px-3 py-3 flex-shrink-0 border-b border-white/[0.06]">
               {/* Left side: Tabs */}
@@ -1204,6 +1474,32 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                         <Edit2 size={14} />
                         {t('common.edit')}
                       </button>
+                      {onLaunchInstanceSafeMode && installedMods.some(m => m.enabled) && (
+                        <button
+                          onClick={() => {
+                            onLaunchInstanceSafeMode(selectedInstance.id);
+                            setShowInstanceMenu(false);
+                          }}
+                          disabled={isGameRunning || isDownloading || officialServerBlocked}
+                          className="w-full px-4 py-2.5 text-sm text-left text-white/70 hover:text-white hover:bg-white/10 flex items-center gap-2 disabled:opacity-40 disabled:cursor-not-allowed"
+                        >
+                          <ShieldOff size={14} />
+                          {t('instances.launchSafeMode')}
+                        </button>
+                      )}
+                      {installedMods.filter(m => m.enabled).length >= 2 && (
+                        <button
+                          onClick={() => {
+                            handleStartBisect(selectedInstance);
+                            setShowInstanceMenu(false);
+                          }}
+                          disabled={isGameRunning || isDownloading}
+                          className="w-full px-4 py-2.5 text-sm text-left text-white/70 hover:text-white hover:bg-white/10 flex items-center gap-2 disabled:opacity-40 disabled:cursor-not-allowed"
+                        >
+                          <Bug size={14} />
+                          {t('instances.bisect.start')}
+                        </button>
+                      )}
                       <button
                         onClick={() => {
                           handleOpenFolder(selectedInstance);
@@ -1239,6 +1535,36 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                         )}
                         {t('common.export')}
                       </button>
+                      <button
+                        onClick={() => {
+                          handleToggleFavorite(selectedInstance);
+                          setShowInstanceMenu(false);
+                        }}
+                        className="w-full px-4 py-2.5 text-sm text-left text-white/70 hover:text-white hover:bg-white/10 flex items-center gap-2"
+                      >
+                        <Star size={14} className={favoriteIds.has(selectedInstance.id) ? 'fill-current' : ''} />
+                        {favoriteIds.has(selectedInstance.id) ? t('instances.unfavorite') : t('instances.favorite')}
+                      </button>
+                      <button
+                        onClick={() => {
+                          handleCreateShortcut(selectedInstance);
+                          setShowInstanceMenu(false);
+                        }}
+                        className="w-full px-4 py-2.5 text-sm text-left text-white/70 hover:text-white hover:bg-white/10 flex items-center gap-2"
+                      >
+                        <MonitorUp size={14} />
+                        {t('instances.createShortcut')}
+                      </button>
+                      <button
+                        onClick={() => {
+                          setBackupsInstance(selectedInstance);
+                          setShowInstanceMenu(false);
+                        }}
+                        className="w-full px-4 py-2.5 text-sm text-left text-white/70 hover:text-white hover:bg-white/10 flex items-center gap-2"
+                      >
+                        <Archive size={14} />
+                        {t('instances.backups.title')}
+                      </button>
                       <div className="border-t border-white/10 my-1" />
                       <button
                         onClick={() => {
@@ -1311,6 +1637,16 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                     activeTab === 'content' ? 'opacity-100 z-10' : 'opacity-0 z-0 pointer-events-none'
                   }`}
                 >
+                  {bisectState && bisectState.instanceId === selectedInstance.id && (
+                    <ModBisectPanel
+                      state={bisectState}
+                      busy={isGameRunning || isDownloading}
+                      onLaunch={() => onLaunchBisectStep?.(selectedInstance.id)}
+                      onReport={handleBisectReport}
+                      onDisableCulprit={handleDisableBisectCulprit}
+                      onCancel={handleBisectCancel}
+                    />
+                  )}
                   {selectedInstance.validationStatus === 'Valid' && (
                     <>
                   {/* Content Header */}
@@ -1342,6 +1678,31 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                       >
                         <RefreshCw size={16} className={isLoadingMods ? 'animate-spin' : ''} />
                       </button>
+                      {bulkToggle ? (
+                        <span className="flex items-center gap-2 px-2 text-xs text-white/50">
+                          <Loader2 size={14} className="animate-spin" />
+                          {bulkToggle.total > 0 && `${bulkToggle.done}/${bulkToggle.total}`}
+                        </span>
+                      ) : installedMods.length > 0 && (
+                        <>
+                          <button
+                            onClick={() => handleSetAllModsEnabled(true)}
+                            disabled={isGameRunning || installedMods.every(m => m.enabled)}
+                            className="p-2 rounded-xl text-white/50 hover:text-white hover:bg-white/[0.06] transition-all disabled:opacity-30 disabled:pointer-events-none"
+                            title={t('modManager.enableAll')}
+                          >
+                            <Power size={16} />
+                          </button>
+                          <button
+                            onClick={() => handleSetAllModsEnabled(false)}
+                            disabled={isGameRunning || installedMods.every(m => !m.enabled)}
+                            className="p-2 rounded-xl text-white/50 hover:text-white hover:bg-white/[0.06] transition-all disabled:opacity-30 disabled:pointer-events-none"
+                            title={t('modManager.disableAll')}
+                          >
+                            <PowerOff size={16} />
+                          </button>
+                        </>
+                      )}
                       {selectedMods.size > 0 && (
                         <button
                           onClick={handleBulkDeleteMods}
@@ -1396,6 +1757,8 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                       <div className="divide-y divide-white/5">
                         {filteredMods.map((mod, index) => {
                           const hasUpdate = modsWithUpdates.some(u => u.id === mod.id);
+                          const wrongGameVersion = !!instanceGameVersion && !!mod.gameVersions?.length
+                            && !mod.gameVersions.some(v => v.toLowerCase() === instanceGameVersion.toLowerCase());
                           const isSelected = selectedMods.has(mod.id);
 
                           return (
@@ -1454,6 +1817,14 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                                       {t('modManager.updateBadge')}
                                     </span>
                                   )}
+                                  {wrongGameVersion && (
+                                    <span
+                                      className="px-1.5 py-0.5 rounded text-[10px] font-medium bg-amber-500/20 text-amber-400 flex-shrink-0"
+                                      title={t('modManager.gameVersionMismatchHint', { version: instanceGameVersion, versions: mod.gameVersions!.join(', ') })}
+                                    >
+                                      {t('modManager.gameVersionMismatch')}
+                                    </span>
+                                  )}
                                 </div>
                                 <p className="text-white/40 text-xs truncate">
                                   {mod.author || t('modManager.unknownAuthor')}
@@ -1563,6 +1934,29 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                       {t('instances.saves')}
                     </h3>
                     <div className="flex items-center gap-2">
+                      {selectedInstance && (
+                        <button
+                          onClick={() => setShowImportWorlds(true)}
+                          className="p-2 rounded-xl text-white/50 hover:text-white hover:bg-white/10 transition-all"
+                          title={t('instances.importWorlds.title')}
+                        >
+                          <Download size={16} />
+                        </button>
+                      )}
+                      <button
+                        onClick={() => setShowTrash(true)}
+                        className="p-2 rounded-xl text-white/50 hover:text-white hover:bg-white/10 transition-all"
+                        title={t('trash.title')}
+                      >
+                        <Trash2 size={16} />
+                      </button>
+                      <button
+                        onClick={() => setShowAllSaves((v) => !v)}
+                        className={`p-2 rounded-xl transition-all ${showAllSaves ? 'text-white bg-white/10' : 'text-white/50 hover:text-white hover:bg-white/10'}`}
+                        title={t('instances.allInstancesSaves')}
+                      >
+                        <Layers size={16} />
+                      </button>
                       <button
                         onClick={loadSaves}
                         disabled={isLoadingSaves}
@@ -1587,66 +1981,93 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
                         <p className="text-sm mt-1">{t('instances.noSavesHint')}</p>
                       </div>
                     ) : (
-                      <div className="grid grid-cols-2 md:grid-cols-3 lg:grid-cols-4 gap-4">
-                        {saves.map((save) => (
-                          <div
-                            key={save.name}
-                            onClick={() => OpenSaveFolder(selectedInstance!.branch, selectedInstance!.version, save.name)}
-                            className="group relative rounded-xl overflow-hidden border border-white/10 hover:border-white/20 transition-all bg-white/5 hover:bg-white/10 cursor-pointer"
-                          >
-                            {/* Preview Image */}
-                            <div className="aspect-video w-full bg-black/40 flex items-center justify-center overflow-hidden">
-                              {save.previewPath ? (
-                                <img
-                                  src={save.previewPath}
-                                  alt={save.name}
-                                  className="w-full h-full object-cover group-hover:scale-105 transition-transform duration-300"
-                                  onError={(e) => {
-                                    (e.target as HTMLImageElement).style.display = 'none';
-                                    (e.target as HTMLImageElement).nextElementSibling?.classList.remove('hidden');
-                                  }}
-                                />
-                              ) : null}
-                              <div className={`flex items-center justify-center ${save.previewPath ? 'hidden' : ''}`}>
-                                <Image size={32} className="text-white/20" />
-                              </div>
-                            </div>
-
-                            {/* Save Info */}
-                            <div className="p-3">
-                              <p className="text-white font-medium text-sm truncate">{save.name}</p>
-                              <div className="flex items-center justify-between mt-1 text-xs text-white/40">
-                                {save.lastModified && (
-                                  <span className="flex items-center gap-1">
-                                    <Clock size={10} />
-                                    {new Date(save.lastModified).toLocaleDateString()}
-                                  </span>
-                                )}
-                                {save.sizeBytes && (
-                                  <span>{formatBytes(save.sizeBytes)}</span>
-                                )}
-                              </div>
-                            </div>
-
-                            {/* Hover Overlay */}
-                            <div className="absolute inset-0 bg-black/55 opacity-0 group-hover:opacity-100 transition-opacity flex flex-col items-center justify-center gap-3">
-                              <button
-                                onClick={(e) => {
-                                  e.stopPropagation();
-                                  OpenSaveFolder(selectedInstance!.branch, selectedInstance!.version, save.name);
-                                }}
-                                className="px-6 py-3 rounded-xl bg-white/20 hover:bg-white/30 text-white text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
-                              >
-                                <FolderOpen size={18} />
-                                {t('common.openFolder')}
-                              </button>
-                              <button
-                                onClick={(e) => handleDeleteSave(e, save.name)}
-                                className="px-6 py-3 rounded-xl bg-red-500/30 hover:bg-red-500/40 text-red-100 text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
-                              >
-                                <Trash2 size={18} />
-                                {t('common.delete')}
-                              </button>
+                      <div className="space-y-6">
+                        {saveGroups.map((group) => (
+                          <div key={group.instanceId}>
+                            {showAllSaves && (
+                              <p className="text-xs font-semibold uppercase tracking-wider text-white/40 mb-3">{group.instanceName}</p>
+                            )}
+                            <div className="grid grid-cols-2 md:grid-cols-3 lg:grid-cols-4 gap-4">
+                              {group.saves.map((save) => (
+                                <div
+                                  key={save.name}
+                                  onClick={() => OpenSaveFolder(save)}
+                                  className="group relative rounded-xl overflow-hidden border border-white/10 hover:border-white/20 transition-all bg-white/5 hover:bg-white/10 cursor-pointer"
+                                >
+                                  {/* Preview Image */}
+                                  <div className="aspect-video w-full bg-black/40 flex items-center justify-center overflow-hidden">
+                                    {save.previewPath ? (
+                                      <img
+                                        src={save.previewPath}
+                                        alt={save.name}
+                                        className="w-full h-full object-cover group-hover:scale-105 transition-transform duration-300"
+                                        onError={(e) => {
+                                          (e.target as HTMLImageElement).style.display = 'none';
+                                          (e.target as HTMLImageElement).nextElementSibling?.classList.remove('hidden');
+                                        }}
+                                      />
+                                    ) : null}
+                                    <div className={`flex items-center justify-center ${save.previewPath ? 'hidden' : ''}`}>
+                                      <Image size={32} className="text-white/20" />
+                                    </div>
+                                  </div>
+
+                                  {/* Save Info */}
+                                  <div className="p-3">
+                                    <p className="text-white font-medium text-sm truncate">{save.name}</p>
+                                    <div className="flex items-center justify-between mt-1 text-xs text-white/40">
+                                      {save.lastModified && (
+                                        <span className="flex items-center gap-1">
+                                          <Clock size={10} />
+                                          {new Date(save.lastModified).toLocaleDateString()}
+                                        </span>
+                                      )}
+                                      {save.sizeBytes && (
+                                        <span>{formatBytes(save.sizeBytes)}</span>
+                                      )}
+                                    </div>
+                                  </div>
+
+                                  {/* Hover Overlay */}
+                                  <div className="absolute inset-0 bg-black/55 opacity-0 group-hover:opacity-100 transition-opacity flex flex-col items-center justify-center gap-3">
+                                    <button
+                                      onClick={(e) => {
+                                        e.stopPropagation();
+                                        OpenSaveFolder(save);
+                                      }}
+                                      className="px-6 py-3 rounded-xl bg-white/20 hover:bg-white/30 text-white text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
+                                    >
+                                      <FolderOpen size={18} />
+                                      {t('common.openFolder')}
+                                    </button>
+                                    <button
+                                      onClick={(e) => handleOpenDuplicateSave(e, save)}
+                                      title={t('instances.duplicateWorld.hint')}
+                                      className="px-6 py-3 rounded-xl bg-white/20 hover:bg-white/30 text-white text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
+                                    >
+                                      <Copy size={18} />
+                                      {t('instances.duplicateWorld.action')}
+                                    </button>
+                                    {save.hasModSnapshot && (
+                                      <button
+                                        onClick={(e) => handleOpenSaveModRestore(e, save)}
+                                        title={t('instances.modSnapshot.hint')}
+                                        className="px-6 py-3 rounded-xl bg-white/20 hover:bg-white/30 text-white text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
+                                      >
+                                        <RotateCcw size={18} />
+                                        {t('instances.modSnapshot.restore')}
+                                      </button>
+                                    )}
+                                    <button
+                                      onClick={(e) => handleDeleteSave(e, save)}
+                                      className="px-6 py-3 rounded-xl bg-red-500/30 hover:bg-red-500/40 text-red-100 text-sm font-semibold flex items-center justify-center gap-2 min-w-[200px]"
+                                    >
+                                      <Trash2 size={18} />
+                                      {t('common.delete')}
+                                    </button>
+                                  </div>
+                                </div>
+                              ))}
                             </div>
                           </div>
                         ))}
@@ -1663,6 +2084,7 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
               onClose={() => setShowEditModal(false)}
               onSave={() => {
                 loadInstances();
+                loadInstanceGameVersion();
               }}
               instanceId={selectedInstance.id}
               initialName={selectedInstance.customName || getInstanceDisplayName(selectedInstance)}
@@ -1768,6 +2190,30 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
               <p className="text-white/60 text-sm mb-4">
                 {t('modManager.deleteModConfirm')} <strong>{modToDelete.name}</strong>?
               </p>
+              {uninstallImpact && uninstallImpact.dependents.length > 0 && (
+                <div className="flex gap-2 items-start rounded-xl bg-amber-500/10 px-3 py-2 mb-3 text-xs text-amber-200">
+                  <AlertTriangle size={14} className="flex-shrink-0 mt-0.5" />
+                  <span>
+                    {t('modManager.requiredBy')}{' '}
+                    <strong>{uninstallImpact.dependents.map((d) => d.name).join(', ')}</strong>
+                  </span>
+                </div>
+              )}
+              {uninstallImpact && uninstallImpact.orphans.length > 0 && (
+                <label className="flex gap-2 items-start mb-4 text-xs text-white/60 cursor-pointer">
+                  <input
+                    type="checkbox"
+                    checked={removeOrphans}
+                    onChange={(e) => setRemoveOrphans(e.target.checked)}
+                    className="mt-0.5"
+                    style={{ accentColor }}
+                  />
+                  <span>
+                    {t('modManager.removeOrphans')}{' '}
+                    <span className="text-white/80">{uninstallImpact.orphans.map((o) => o.name).join(', ')}</span>
+                  </span>
+                </label>
+              )}
               <div className="flex gap-2 justify-end">
                 <button onClick={() => setModToDelete(null)}
                   className="px-4 py-2 rounded-xl text-sm text-white/60 hover:text-white hover:bg-white/10 transition-all">
@@ -1786,6 +2232,171 @@ export const InstancesPage: React.FC<InstancesPageProps> = ({
         )}
       </AnimatePresence>
 
+      {/* Instance Backups */}
+      <AnimatePresence>
+        {backupsInstance && (
+          <InstanceBackupsModal
+            instanceId={backupsInstance.id}
+            instanceName={getInstanceDisplayName(backupsInstance)}
+            onClose={() => setBackupsInstance(null)}
+            onRestored={() => {
+              setBackupsInstance(null);
+              loadInstances();
+              loadInstalledMods();
+              loadSaves();
+              setMessage({ type: 'success', text: t('instances.backups.restored') });
+              setTimeout(() => setMessage(null), 3000);
+            }}
+          />
+        )}
+      </AnimatePresence>
+
+      {/* Import Worlds From The Official Launcher */}
+      <AnimatePresence>
+        {showImportWorlds && selectedInstance && (
+          <ImportOfficialWorldsModal
+            instanceId={selectedInstance.id}
+            instanceName={getInstanceDisplayName(selectedInstance)}
+            onClose={() => setShowImportWorlds(false)}
+            onImported={(names) => {
+              setShowImportWorlds(false);
+              loadSaves();
+              setMessage({ type: 'success', text: t('instances.importWorlds.imported', { count: names.length }) });
+              setTimeout(() => setMessage(null), 3000);
+            }}
+          />
+        )}
+      </AnimatePresence>
+
+      {/* Trash */}
+      <AnimatePresence>
+        {showTrash && (
+          <TrashModal
+            onClose={() => setShowTrash(false)}
+            onRestored={() => {
+              loadSaves();
+              setMessage({ type: 'success', text: t('trash.restored') });
+              setTimeout(() => setMessage(null), 3000);
+            }}
+          />
+        )}
+      </AnimatePresence>
+
+      {/* Duplicate World */}
+      <AnimatePresence>
+        {duplicateSave && (
+          <motion.div
+            initial={{ opacity: 0 }}
+            animate={{ opacity: 1 }}
+            exit={{ opacity: 0 }}
+            className={`fixed inset-0 z-[300] flex items-center justify-center bg-[#0a0a0a]/90`}
+            onClick={(e) => e.target === e.currentTarget && !isDuplicatingSave && setDuplicateSave(null)}
+          >
+            <motion.div
+              initial={{ scale: 0.95, opacity: 0 }}
+              animate={{ scale: 1, opacity: 1 }}
+              exit={{ scale: 0.95, opacity: 0 }}
+              className={`p-6 w-full max-w-sm mx-4 shadow-2xl glass-panel-static-solid`}
+            >
+              <h3 className="text-white font-bold text-lg mb-2">{t('instances.duplicateWorld.title')}</h3>
+              <p className="text-white/60 text-sm mb-4">{t('instances.duplicateWorld.hint')}</p>
+              <input
+                type="text"
+                value={duplicateSaveName}
+                onChange={(e) => setDuplicateSaveName(e.target.value)}
+                onKeyDown={(e) => e.key === 'Enter' && handleDuplicateSave()}
+                autoFocus
+                disabled={isDuplicatingSave}
+                placeholder={t('instances.duplicateWorld.name')}
+                className="w-full px-3 py-2 mb-4 rounded-xl bg-white/5 border border-white/10 text-white text-sm placeholder-white/30 focus:outline-none focus:border-white/30"
+              />
+              <div className="flex gap-2 justify-end">
+                <button onClick={() => setDuplicateSave(null)}
+                  disabled={isDuplicatingSave}
+                  className="px-4 py-2 rounded-xl text-sm text-white/60 hover:text-white hover:bg-white/10 transition-all">
+                  {t('common.cancel')}
+                </button>
+                <button
+                  onClick={handleDuplicateSave}
+                  disabled={isDuplicatingSave || !duplicateSaveName.trim()}
+                  className="px-4 py-2 rounded-xl text-sm font-medium transition-all flex items-center gap-2 disabled:opacity-50"
+                  style={{ backgroundColor: `${accentColor}33`, color: accentColor }}>
+                  {isDuplicatingSave && <Loader2 size={14} className="animate-spin" />}
+                  {t('instances.duplicateWorld.action')}
+                </button>
+              </div>
+            </motion.div>
+          </motion.div>
+        )}
+      </AnimatePresence>
+
+      {/* Restore World Mods Confirmation */}
+      <AnimatePresence>
+        {saveModRestore && (
+          <motion.div
+            initial={{ opacity: 0 }}
+            animate={{ opacity: 1 }}
+            exit={{ opacity: 0 }}
+            className={`fixed inset-0 z-[300] flex items-center justify-center bg-[#0a0a0a]/90`}
+            onClick={(e) => e.target === e.currentTarget && !isRestoringSaveMods && setSaveModRestore(null)}
+          >
+            <motion.div
+              initial={{ scale: 0.95, opacity: 0 }}
+              animate={{ scale: 1, opacity: 1 }}
+              exit={{ scale: 0.95, opacity: 0 }}
+              className={`p-6 max-w-sm mx-4 shadow-2xl glass-panel-static-solid`}
+            >
+              <h3 className="text-white font-bold text-lg mb-2">{t('instances.modSnapshot.title')}</h3>
+              <p className="text-white/60 text-sm mb-4">
+                {t('instances.modSnapshot.confirm', {
+                  world: saveModRestore.save.name,
+                  date: new Date(saveModRestore.status.snapshot.playedAt).toLocaleString(),
+                })}
+              </p>
+              {saveModRestore.status.matches ? (
+                <p className="text-white/60 text-xs mb-4">{t('instances.modSnapshot.upToDate')}</p>
+              ) : (
+                <div className="space-y-2 mb-4 text-xs">
+                  {saveModRestore.status.missing.length > 0 && (
+                    <p className="text-white/60">
+                      {t('instances.modSnapshot.missing')}{' '}
+                      <span className="text-white/80">{saveModRestore.status.missing.map((m) => m.name).join(', ')}</span>
+                    </p>
+                  )}
+                  {saveModRestore.status.changed.length > 0 && (
+                    <p className="text-white/60">
+                      {t('instances.modSnapshot.changed')}{' '}
+                      <span className="text-white/80">{saveModRestore.status.changed.map((m) => m.name).join(', ')}</span>
+                    </p>
+                  )}
+                  {saveModRestore.status.extra.length > 0 && (
+                    <p className="text-white/60">
+                      {t('instances.modSnapshot.extra')}{' '}
+                      <span className="text-white/80">{saveModRestore.status.extra.map((m) => m.name).join(', ')}</span>
+                    </p>
+                  )}
+                </div>
+              )}
+              <div className="flex gap-2 justify-end">
+                <button onClick={() => setSaveModRestore(null)}
+                  disabled={isRestoringSaveMods}
+                  className="px-4 py-2 rounded-xl text-sm text-white/60 hover:text-white hover:bg-white/10 transition-all">
+                  {t('common.cancel')}
+                </button>
+                <button
+                  onClick={handleRestoreSaveMods}
+                  disabled={isRestoringSaveMods || saveModRestore.status.matches}
+                  className="px-4 py-2 rounded-xl text-sm font-medium transition-all flex items-center gap-2 disabled:opacity-50"
+                  style={{ backgroundColor: `${accentColor}33`, color: accentColor }}>
+                  {isRestoringSaveMods && <Loader2 size={14} className="animate-spin" />}
+                  {t('instances.modSnapshot.restore')}
+                </button>
+              </div>
+            </motion.div>
+          </motion.div>
+        )}
+      </AnimatePresence>
+
       {/* Create Instance Modal */}
       <CreateInstanceModal
         isOpen={showCreateModal}
@@ -2,7 +2,8 @@ import React, { useState, useEffect, useCallback, useRef } from 'react';
 import { useTranslation } from 'react-i18next';
 import { RefreshCw, Copy, Check, Download, Search } from 'lucide-react';
 import { useAccentColor } from '../contexts/AccentColorContext';
-import { invoke } from '@/lib/ipc';
+import { ipc } from '@/lib/ipc';
+import type { LogLine } from '@/lib/ipc';
 
 type LogLevel = 'all' | 'INF' | 'SUC' | 'WRN' | 'ERR' | 'DBG';
 
@@ -14,24 +15,19 @@ interface LogEntry {
   raw: string;
 }
 
-const parseLogEntry = (line: string): LogEntry => {
-  // Format: "HH:mm:ss | LVL | Category | Message"
-  const parts = line.split(' | ');
-  if (parts.length >= 4) {
-    return {
-      timestamp: parts[0],
-      level: parts[1],
-      category: parts[2],
-      message: parts.slice(3).join(' | '),
-      raw: line,
-    };
-  }
+// Launcher lines kept on screen; older ones drop off as new lines stream in
+const MAX_ENTRIES = 500;
+const LOG_FILTER = { source: 'launcher' };
+
+const toLogEntry = (line: LogLine): LogEntry => {
+  const timestamp = new Date(line.timestamp).toLocaleTimeString([], { hour12: false });
   return {
-    timestamp: '',
-    level: 'INF',
-    category: 'Unknown',
-    message: line,
-    raw: line,
+    timestamp,
+    level: line.level,
+    category: line.category,
+    message: line.message,
+    // Same layout as the log file: "HH:mm:ss | LVL | Category | Message"
+    raw: `${timestamp} | ${line.level} | ${line.category} | ${line.message}`,
   };
 };
 
@@ -66,21 +62,31 @@ export const LogsPage: React.FC<LogsPageProps> = ({ embedded = false }) => {
   const [isDragging, setIsDragging] = useState(false);
   const scrollRef = useRef<HTMLDivElement>(null);
   const autoScrollRef = useRef(true);
+  // Sequence number of the newest line shown, so streamed lines are never added twice
+  const lastSeqRef = useRef(0);
+
+  const appendLines = useCallback((lines: LogLine[]) => {
+    const fresh = lines.filter(line => line.seq > lastSeqRef.current);
+    if (fresh.length === 0) return;
+    lastSeqRef.current = fresh[fresh.length - 1].seq;
+    setLogs(prev => [...prev, ...fresh.map(toLogEntry)].slice(-MAX_ENTRIES));
+  }, []);
 
   const fetchLogs = useCallback(async () => {
-    if (logs.length === 0) setLoading(true);
+    if (lastSeqRef.current === 0) setLoading(true);
     else setIsRefreshing(true);
     try {
-      const rawLogs = await invoke<string[]>('hyprism:logs:get', { count: 100 });
-      const parsed = (rawLogs || []).map(parseLogEntry);
-      setLogs(parsed);
+      const page = await ipc.logs.query({ ...LOG_FILTER, limit: MAX_ENTRIES });
+      lastSeqRef.current = 0;
+      setLogs([]);
+      appendLines(page.lines);
     } catch (err) {
       console.error('Failed to fetch logs:', err);
     } finally {
       setLoading(false);
       setIsRefreshing(false);
     }
-  }, [logs.length]);
+  }, [appendLines]);
 
   // Initial fetch
   useEffect(() => {
@@ -88,12 +94,21 @@ export const LogsPage: React.FC<LogsPageProps> = ({ embedded = false }) => {
     fetchLogs();
   }, []);
 
-  // Auto-refresh every 2 seconds
+  // While live, new lines are pushed as they are logged; catch up on what was missed while paused
   useEffect(() => {
     if (!autoRefresh) return;
-    const interval = setInterval(fetchLogs, 2000);
-    return () => clearInterval(interval);
-  }, [autoRefresh, fetchLogs]);
+    const unsubscribe = ipc.logs.onLine(line => appendLines([line]));
+    ipc.logs.subscribe(LOG_FILTER);
+    if (lastSeqRef.current > 0) {
+      ipc.logs.query({ ...LOG_FILTER, afterSeq: lastSeqRef.current, limit: MAX_ENTRIES })
+        .then(page => appendLines(page.lines))
+        .catch(err => console.error('Failed to fetch logs:', err));
+    }
+    return () => {
+      unsubscribe();
+      ipc.logs.unsubscribe();
+    };
+  }, [autoRefresh, appendLines]);
 
   // Auto-scroll to bottom when new logs arrive
   useEffect(() => {
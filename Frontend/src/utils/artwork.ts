@@ -0,0 +1,35 @@
+/**
+ * Instance artwork helpers
+ */
+
+// Bundled artwork shares the launcher backgrounds
+const backgroundModules = {
+  ...import.meta.glob('../assets/backgrounds/bg_*.jpg', { query: '?url', import: 'default', eager: true }),
+  ...import.meta.glob('../assets/backgrounds/bg_*.png', { query: '?url', import: 'default', eager: true }),
+};
+
+const bundledArtwork: Record<string, string> = Object.fromEntries(
+  Object.entries(backgroundModules).map(([path, url]) => [path.match(/bg_\d+/)?.[0] ?? path, url as string])
+);
+
+const BUNDLED_PREFIX = 'bundled:';
+
+/**
+ * Get the URL of a bundled image by the name the backend uses
+ * @param name - Name like "bg_5.jpg"
+ * @returns The asset URL, or null for unknown names
+ */
+export const getBundledArtworkUrl = (name: string): string | null =>
+  bundledArtwork[name.replace(/\.(jpg|png)$/, '')] ?? null;
+
+/**
+ * Turn an instance icon or banner from the backend into an image URL
+ * @param value - A file:// URL or "bundled:{name}"
+ * @param cacheBust - Append a timestamp to file URLs so a replaced image is reloaded
+ * @returns The URL to show, or null for the default look
+ */
+export const resolveArtworkUrl = (value?: string | null, cacheBust = false): string | null => {
+  if (!value) return null;
+  if (value.startsWith(BUNDLED_PREFIX)) return getBundledArtworkUrl(value.slice(BUNDLED_PREFIX.length));
+  return cacheBust ? `${value}${value.includes('?') ? '&' : '?'}t=${Date.now()}` : value;
+};
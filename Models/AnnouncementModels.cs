@@ -0,0 +1,69 @@
+using System.Text.Json.Serialization;
+
+namespace HyPrism.Models;
+
+/// <summary>
+/// A maintainer announcement as published in the HyPrism repository's <c>announcements.json</c>.
+/// </summary>
+public class Announcement
+{
+    [JsonPropertyName("id")]
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// One of: info, warning, critical.
+    /// </summary>
+    [JsonPropertyName("severity")]
+    public string Severity { get; set; } = "info";
+
+    [JsonPropertyName("title")]
+    public string? Title { get; set; }
+
+    [JsonPropertyName("message")]
+    public string Message { get; set; } = "";
+
+    /// <summary>
+    /// Optional per-language messages keyed by locale code (e.g. "ru-RU"); <see cref="Message"/> is the fallback.
+    /// </summary>
+    [JsonPropertyName("translations")]
+    public Dictionary<string, string>? Translations { get; set; }
+
+    /// <summary>
+    /// Optional link with more details or the fix.
+    /// </summary>
+    [JsonPropertyName("url")]
+    public string? Url { get; set; }
+
+    /// <summary>
+    /// Lowest launcher version (inclusive) the announcement applies to.
+    /// </summary>
+    [JsonPropertyName("minLauncherVersion")]
+    public string? MinLauncherVersion { get; set; }
+
+    /// <summary>
+    /// Highest launcher version (inclusive) the announcement applies to.
+    /// </summary>
+    [JsonPropertyName("maxLauncherVersion")]
+    public string? MaxLauncherVersion { get; set; }
+
+    [JsonPropertyName("startsAt")]
+    public DateTime? StartsAt { get; set; }
+
+    [JsonPropertyName("expiresAt")]
+    public DateTime? ExpiresAt { get; set; }
+
+    /// <summary>
+    /// Whether the user may hide the announcement. Defaults to true.
+    /// </summary>
+    [JsonPropertyName("dismissible")]
+    public bool Dismissible { get; set; } = true;
+}
+
+/// <summary>
+/// Root of the announcements feed.
+/// </summary>
+public class AnnouncementFeed
+{
+    [JsonPropertyName("announcements")]
+    public List<Announcement> Announcements { get; set; } = new();
+}
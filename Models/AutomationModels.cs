@@ -0,0 +1,24 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// State of the local automation API.
+/// </summary>
+public class AutomationApiStatus
+{
+    public bool Enabled { get; set; }
+
+    /// <summary>
+    /// Whether the listener is up; <c>false</c> while enabled means the port could not be bound.
+    /// </summary>
+    public bool Running { get; set; }
+
+    /// <summary>
+    /// Base URL of the API, e.g. <c>http://localhost:47821/api/v1/</c>.
+    /// </summary>
+    public string Url { get; set; } = "";
+
+    /// <summary>
+    /// Bearer token every request must carry. Empty while the API is disabled.
+    /// </summary>
+    public string Token { get; set; } = "";
+}
@@ -0,0 +1,35 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Categories downloaded bytes are counted under.
+/// </summary>
+public static class TrafficCategory
+{
+    public const string Game = "game";
+    public const string Mods = "mods";
+    public const string Java = "java";
+    public const string Updates = "updates";
+
+    /// <summary>
+    /// News, version lists, skins and other API calls.
+    /// </summary>
+    public const string Other = "other";
+}
+
+/// <summary>
+/// Bytes the launcher downloaded in one calendar month.
+/// </summary>
+public class BandwidthMonth
+{
+    /// <summary>
+    /// Month in <c>yyyy-MM</c> form, local time.
+    /// </summary>
+    public string Month { get; set; } = "";
+
+    /// <summary>
+    /// Bytes per <see cref="TrafficCategory"/>.
+    /// </summary>
+    public Dictionary<string, long> Categories { get; set; } = new();
+
+    public long TotalBytes { get; set; }
+}
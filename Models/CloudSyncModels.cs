@@ -0,0 +1,122 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Cloud sync settings, stored as <c>CloudSync</c> in config.json. The WebDAV password is kept in the
+/// secret store, not here.
+/// </summary>
+public class CloudSyncConfig
+{
+    public bool Enabled { get; set; } = false;
+
+    /// <summary>
+    /// "folder" (a folder kept in sync by Dropbox, OneDrive, Syncthing and the like) or "webdav".
+    /// </summary>
+    public string Backend { get; set; } = "folder";
+
+    public string FolderPath { get; set; } = "";
+
+    /// <summary>
+    /// Collection URL the files are stored in, e.g. <c>https://cloud.example.com/remote.php/dav/files/me/HyPrism/</c>.
+    /// </summary>
+    public string WebDavUrl { get; set; } = "";
+
+    public string WebDavUsername { get; set; } = "";
+
+    /// <summary>
+    /// Worlds to sync. Worlds are matched by name on the remote, so the same world can belong to
+    /// differently named instances on each computer.
+    /// </summary>
+    public List<CloudSyncWorld> Worlds { get; set; } = new();
+
+    /// <summary>
+    /// Whether the saved skins of profiles (<c>Profiles</c> in the launcher data directory) are synced.
+    /// </summary>
+    public bool SyncSkins { get; set; } = true;
+
+    /// <summary>
+    /// Whether launcher preferences (language, theme, launch behaviour) are synced. Accounts, tokens and
+    /// paths never are.
+    /// </summary>
+    public bool SyncSettings { get; set; } = true;
+
+    /// <summary>
+    /// Whether to sync after each game session, in addition to syncing on request.
+    /// </summary>
+    public bool SyncAfterPlaying { get; set; } = true;
+}
+
+public class CloudSyncWorld
+{
+    public string InstanceId { get; set; } = "";
+
+    public string WorldName { get; set; } = "";
+}
+
+/// <summary>
+/// A file on the sync backend.
+/// </summary>
+public class CloudSyncRemoteFile
+{
+    public string Name { get; set; } = "";
+
+    public DateTime ModifiedUtc { get; set; }
+
+    public long Size { get; set; }
+}
+
+/// <summary>
+/// What the last sync saw of an item, kept in <c>cloud-sync-state.json</c> to tell which side changed since.
+/// </summary>
+public class CloudSyncItemState
+{
+    public DateTime? LocalModifiedUtc { get; set; }
+
+    public DateTime? RemoteModifiedUtc { get; set; }
+
+    /// <summary>
+    /// Content hash for items without a useful modification time (launcher settings).
+    /// </summary>
+    public string? Hash { get; set; }
+}
+
+/// <summary>
+/// An item changed both locally and on the remote since the last sync. It is left alone until resolved.
+/// </summary>
+public class CloudSyncConflict
+{
+    /// <summary>
+    /// Item key: <c>world:{name}</c>, <c>skins</c> or <c>settings</c>.
+    /// </summary>
+    public string Key { get; set; } = "";
+
+    /// <summary>
+    /// "world", "skins" or "settings".
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    public DateTime? LocalModifiedUtc { get; set; }
+
+    public DateTime? RemoteModifiedUtc { get; set; }
+}
+
+public class CloudSyncResult
+{
+    public bool Success { get; set; }
+
+    public DateTime FinishedAt { get; set; } = DateTime.UtcNow;
+
+    public List<string> Uploaded { get; set; } = new();
+
+    public List<string> Downloaded { get; set; } = new();
+
+    public List<CloudSyncConflict> Conflicts { get; set; } = new();
+
+    /// <summary>
+    /// Items that failed, with the reason.
+    /// </summary>
+    public Dictionary<string, string> Failed { get; set; } = new();
+
+    public string? Error { get; set; }
+}
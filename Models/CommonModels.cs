@@ -20,6 +20,43 @@ public class DownloadProgress
     public bool Cancelled { get; set; }
 }
 
+/// <summary>
+/// An install/launch session in the install queue.
+/// </summary>
+public class InstallQueueEntry
+{
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// "install" for install/launch sessions, "repair" for game file repairs.
+    /// </summary>
+    public string Kind { get; set; } = "install";
+
+    /// <summary>
+    /// Target instance of a repair; null for install sessions, which target the configured branch.
+    /// </summary>
+    public string? InstanceId { get; set; }
+
+    public string Branch { get; set; } = "";
+
+    /// <summary>
+    /// Requested version; 0 means the latest instance of the branch.
+    /// </summary>
+    public int Version { get; set; }
+
+    /// <summary>
+    /// "running" or "queued".
+    /// </summary>
+    public string State { get; set; } = "queued";
+
+    /// <summary>
+    /// 0 for the running session, 1 for the next queued one, and so on.
+    /// </summary>
+    public int Position { get; set; }
+
+    public DateTime QueuedAt { get; set; }
+}
+
 public class ProgressUpdateMessage
 {
     public string State { get; set; } = "unknown";
@@ -28,6 +65,50 @@ public class ProgressUpdateMessage
     public object[]? Args { get; set; }
     public long DownloadedBytes { get; set; }
     public long TotalBytes { get; set; }
+
+    /// <summary>
+    /// Smoothed download speed, 0 outside downloads.
+    /// </summary>
+    public double BytesPerSecond { get; set; }
+
+    /// <summary>
+    /// Estimated seconds left in the current download, or null if unknown.
+    /// </summary>
+    public double? EtaSeconds { get; set; }
+}
+
+/// <summary>
+/// Error pushed to the frontend. <see cref="MessageKey"/> and <see cref="Args"/> let the UI
+/// render the message in the user's language; <see cref="Message"/> is the English fallback.
+/// <see cref="Code"/> is a stable ID from <c>ErrorCodes</c> for errors with a known cause.
+/// </summary>
+public class GameErrorMessage
+{
+    public string Type { get; set; } = "";
+    public string Message { get; set; } = "";
+    public string? MessageKey { get; set; }
+    public object[]? Args { get; set; }
+    public string? Technical { get; set; }
+
+    /// <summary>
+    /// Machine-readable error code, e.g. <c>E_DISK_FULL</c>.
+    /// </summary>
+    public string? Code { get; set; }
+
+    /// <summary>
+    /// Message ID of what the user can do about the error.
+    /// </summary>
+    public string? HintKey { get; set; }
+
+    /// <summary>
+    /// English fallback of <see cref="HintKey"/>.
+    /// </summary>
+    public string? Hint { get; set; }
+
+    /// <summary>
+    /// Troubleshooting guide section for <see cref="Code"/>.
+    /// </summary>
+    public string? HelpUrl { get; set; }
 }
 
 /// <summary>
@@ -0,0 +1,69 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Result of analyzing the most recent game session or crash log.
+/// </summary>
+public class CrashDiagnosis
+{
+    /// <summary>
+    /// Whether any log output was available to analyze.
+    /// </summary>
+    public bool HasLog { get; set; }
+
+    /// <summary>
+    /// Where the analyzed lines came from: "session" (live capture) or "file".
+    /// </summary>
+    public string Source { get; set; } = "session";
+
+    /// <summary>
+    /// Path of the analyzed log file, when <see cref="Source"/> is "file".
+    /// </summary>
+    public string? LogPath { get; set; }
+
+    /// <summary>
+    /// Known issues matched in the log, most severe first.
+    /// </summary>
+    public List<CrashIssue> Issues { get; set; } = new();
+
+    /// <summary>
+    /// The last error-looking lines of the log, for display when nothing known matched.
+    /// </summary>
+    public List<string> Excerpt { get; set; } = new();
+
+    /// <summary>
+    /// Result of the graphics check from the last launch, or null if none ran this session.
+    /// </summary>
+    public GraphicsDiagnostics? Graphics { get; set; }
+
+    public DateTime AnalyzedAt { get; set; } = DateTime.UtcNow;
+}
+
+/// <summary>
+/// A known failure signature matched in a game log.
+/// </summary>
+public class CrashIssue
+{
+    /// <summary>
+    /// Stable identifier (e.g. "gpu_driver", "wayland", "broken_mod", "out_of_memory").
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// "error" when the issue is a likely crash cause, "warning" otherwise.
+    /// </summary>
+    public string Severity { get; set; } = "error";
+
+    public string Title { get; set; } = "";
+
+    public string Description { get; set; } = "";
+
+    /// <summary>
+    /// Suggested steps the user can take to fix the issue.
+    /// </summary>
+    public List<string> Suggestions { get; set; } = new();
+
+    /// <summary>
+    /// Log lines that matched the signature (at most a few).
+    /// </summary>
+    public List<string> Evidence { get; set; } = new();
+}
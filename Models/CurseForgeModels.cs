@@ -36,6 +36,7 @@ public class CurseForgeMod
     public List<CurseForgeAuthor>? Authors { get; set; }
     public List<CurseForgeFile>? LatestFiles { get; set; }
     public List<CurseForgeScreenshot>? Screenshots { get; set; }
+    public int MainFileId { get; set; }
 }
 
 public class CurseForgeScreenshot
@@ -59,6 +60,7 @@ public class CurseForgeCategory
     public string? Name { get; set; }
     public string? Slug { get; set; }
     public int ParentCategoryId { get; set; }
+    public int? ClassId { get; set; }
     public bool? IsClass { get; set; }
 }
 
@@ -81,6 +83,39 @@ public class CurseForgeFile
     public int ReleaseType { get; set; }
     public int DownloadCount { get; set; }
     public List<string>? GameVersions { get; set; }
+    public List<CurseForgeFileHash>? Hashes { get; set; }
+    public List<CurseForgeFileDependency>? Dependencies { get; set; }
+}
+
+public class CurseForgeFileDependency
+{
+    public int ModId { get; set; }
+    
+    /// <summary>
+    /// 1 = Embedded, 2 = Optional, 3 = Required, 4 = Tool, 5 = Incompatible, 6 = Include.
+    /// </summary>
+    public int RelationType { get; set; }
+}
+
+public class CurseForgeGameVersionsResponse
+{
+    public List<CurseForgeGameVersionType>? Data { get; set; }
+}
+
+public class CurseForgeGameVersionType
+{
+    public int Type { get; set; }
+    public List<string>? Versions { get; set; }
+}
+
+public class CurseForgeFileHash
+{
+    public string? Value { get; set; }
+    
+    /// <summary>
+    /// 1 = SHA-1, 2 = MD5.
+    /// </summary>
+    public int Algo { get; set; }
 }
 
 public class CurseForgeCategoriesResponse
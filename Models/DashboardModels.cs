@@ -0,0 +1,68 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Everything the home screen shows at startup, gathered in one call by <c>DashboardService</c>.
+/// Parts that could not be loaded in time are empty or <c>null</c>.
+/// </summary>
+public class DashboardInfo
+{
+    /// <summary>
+    /// The selected instance, or <c>null</c> if none is selected.
+    /// </summary>
+    public DashboardInstance? SelectedInstance { get; set; }
+
+    /// <summary>
+    /// The most recently launched instance.
+    /// </summary>
+    public InstanceActivity? LastPlayed { get; set; }
+
+    public List<DashboardBranch> Branches { get; set; } = new();
+
+    public bool IsGameRunning { get; set; }
+
+    public List<NewsItemResponse> News { get; set; } = new();
+
+    public List<Announcement> Announcements { get; set; } = new();
+}
+
+/// <summary>
+/// Install state of an instance on the dashboard.
+/// </summary>
+public class DashboardInstance
+{
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    public string Branch { get; set; } = "";
+
+    /// <summary>
+    /// Installed version; 0 for an instance that follows the latest version.
+    /// </summary>
+    public int Version { get; set; }
+
+    public bool IsInstalled { get; set; }
+
+    /// <summary>
+    /// Installed CurseForge mods with a newer file, or <c>null</c> while that is not known yet.
+    /// </summary>
+    public int? PendingModUpdates { get; set; }
+}
+
+/// <summary>
+/// Newest available and newest installed version of a game branch.
+/// </summary>
+public class DashboardBranch
+{
+    public string Branch { get; set; } = "";
+
+    /// <summary>
+    /// Newest version the version sources offer, or <c>null</c> if they could not be reached.
+    /// </summary>
+    public int? LatestVersion { get; set; }
+
+    /// <summary>
+    /// Newest version installed in any instance of the branch, or <c>null</c> if none is installed.
+    /// </summary>
+    public int? InstalledVersion { get; set; }
+}
@@ -0,0 +1,54 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// What Microsoft Defender did to the launcher's files, and how to exclude them.
+/// </summary>
+public class DefenderReport
+{
+    /// <summary>
+    /// Whether this is Windows. Nothing else is filled in otherwise.
+    /// </summary>
+    public bool Supported { get; set; }
+
+    /// <summary>
+    /// Whether Defender answered at all; <c>false</c> when another antivirus replaced it or PowerShell failed.
+    /// </summary>
+    public bool DefenderAvailable { get; set; }
+
+    public bool RealTimeProtection { get; set; }
+
+    /// <summary>
+    /// Whether Controlled folder access is on, which denies writes to protected folders such as Documents
+    /// by apps not on its allow list.
+    /// </summary>
+    public bool ControlledFolderAccess { get; set; }
+
+    /// <summary>
+    /// Detections of files in the launcher data or instance folders, newest first.
+    /// </summary>
+    public List<DefenderDetection> Detections { get; set; } = new();
+
+    /// <summary>
+    /// The folders <see cref="ExclusionCommand"/> excludes.
+    /// </summary>
+    public List<string> ExclusionPaths { get; set; } = new();
+
+    /// <summary>
+    /// PowerShell command, to be run as administrator, that excludes <see cref="ExclusionPaths"/> from scanning.
+    /// </summary>
+    public string ExclusionCommand { get; set; } = "";
+}
+
+public class DefenderDetection
+{
+    public string ThreatName { get; set; } = "";
+
+    public string Path { get; set; } = "";
+
+    public DateTime? DetectedAt { get; set; }
+
+    /// <summary>
+    /// "quarantined", "removed", "blocked" or "detected" when Defender took no action or another one.
+    /// </summary>
+    public string Action { get; set; } = "detected";
+}
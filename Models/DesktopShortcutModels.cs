@@ -0,0 +1,16 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Outcome of creating a desktop shortcut that quick-launches an instance.
+/// </summary>
+public class DesktopShortcutResult
+{
+    public bool Success { get; set; }
+
+    /// <summary>
+    /// Full path of the created shortcut: a <c>.desktop</c> file, a <c>.lnk</c> or a macOS <c>.app</c> stub.
+    /// </summary>
+    public string? Path { get; set; }
+
+    public string? Error { get; set; }
+}
@@ -0,0 +1,22 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Display server preference of an instance, with what the current session offers.
+/// </summary>
+public class DisplayServerSettings
+{
+    /// <summary>
+    /// "auto", "wayland" or "x11".
+    /// </summary>
+    public string Mode { get; set; } = "auto";
+
+    /// <summary>
+    /// Whether the preference applies on this OS (Linux only).
+    /// </summary>
+    public bool Supported { get; set; }
+
+    /// <summary>
+    /// Display server of the running session, or null if unknown.
+    /// </summary>
+    public string? Session { get; set; }
+}
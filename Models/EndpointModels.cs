@@ -0,0 +1,58 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// URL templates of the game download endpoints, published as <c>endpoints.json</c> in the HyPrism repository.
+/// Placeholders such as <c>{os}</c> are filled with <see cref="Expand"/>. A field missing from the
+/// published file keeps its built-in default.
+/// </summary>
+public class EndpointConfig
+{
+    /// <summary>
+    /// Newest file layout this launcher understands; files with a higher schema are ignored.
+    /// </summary>
+    public const int CurrentSchema = 1;
+
+    public int Schema { get; set; } = CurrentSchema;
+
+    /// <summary>
+    /// Official patch list. Placeholders: <c>{os}</c>, <c>{arch}</c>, <c>{branch}</c>, <c>{fromBuild}</c>.
+    /// </summary>
+    public string PatchesApi { get; set; } = "https://account-data.hytale.com/patches/{os}/{arch}/{branch}/{fromBuild}";
+
+    /// <summary>
+    /// Host serving signed official patch files; downloads from other hosts are treated as mirror downloads.
+    /// </summary>
+    public string OfficialPatchHost { get; set; } = "game-patches.hytale.com";
+
+    /// <summary>
+    /// Community mirror file index.
+    /// </summary>
+    public string MirrorIndex { get; set; } = "https://thecute.cloud/ShipOfYarn/api.php";
+
+    /// <summary>
+    /// Official Java runtime manifest. Placeholder: <c>{branch}</c>.
+    /// </summary>
+    public string JreManifest { get; set; } = "https://launcher.hytale.com/version/{branch}/jre.json";
+
+    /// <summary>
+    /// Official Java runtime archive, used when the manifest is unreachable.
+    /// Placeholders: <c>{os}</c>, <c>{arch}</c>, <c>{version}</c>, <c>{ext}</c>.
+    /// </summary>
+    public string JreDownload { get; set; } = "https://launcher.hytale.com/redist/jre/{os}/{arch}/jre-{version}.{ext}";
+
+    /// <summary>
+    /// Fills the placeholders of a template; values are URL-escaped.
+    /// </summary>
+    /// <param name="template">Template with <c>{name}</c> placeholders.</param>
+    /// <param name="values">Placeholder names and values.</param>
+    /// <returns>The expanded URL.</returns>
+    public static string Expand(string template, params (string Name, object Value)[] values)
+    {
+        var result = template;
+        foreach (var (name, value) in values)
+        {
+            result = result.Replace("{" + name + "}", Uri.EscapeDataString(Convert.ToString(value, System.Globalization.CultureInfo.InvariantCulture) ?? ""));
+        }
+        return result;
+    }
+}
@@ -0,0 +1,79 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// One error report as sent to a plain JSON error reporting endpoint.
+/// </summary>
+public class ErrorReport
+{
+    public int SchemaVersion { get; set; } = 1;
+
+    /// <summary>
+    /// Random ID of this report, also used as the Sentry event ID.
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    public DateTime Timestamp { get; set; }
+
+    /// <summary>
+    /// "error" for an error shown to the user, "crash" for an unhandled exception.
+    /// </summary>
+    public string Kind { get; set; } = "error";
+
+    public string LauncherVersion { get; set; } = "";
+
+    /// <summary>
+    /// "windows", "linux" or "darwin".
+    /// </summary>
+    public string Os { get; set; } = "";
+
+    /// <summary>
+    /// OS architecture, "amd64" or "arm64".
+    /// </summary>
+    public string Arch { get; set; } = "";
+
+    /// <summary>
+    /// OS name and version, e.g. "Microsoft Windows 10.0.22631".
+    /// </summary>
+    public string OsVersion { get; set; } = "";
+
+    /// <summary>
+    /// The failing operation: the error type (e.g. "download", "launch") or where a crash was caught.
+    /// </summary>
+    public string Operation { get; set; } = "";
+
+    /// <summary>
+    /// Error code such as <c>E_DISK_FULL</c>, if the cause is known.
+    /// </summary>
+    public string? Code { get; set; }
+
+    public string? MessageKey { get; set; }
+
+    /// <summary>
+    /// English message, with the user's home folder and name removed.
+    /// </summary>
+    public string Message { get; set; } = "";
+
+    /// <summary>
+    /// Stack trace or other technical details, sanitized like <see cref="Message"/> and truncated.
+    /// </summary>
+    public string? Technical { get; set; }
+}
+
+/// <summary>
+/// Error reporting opt-in state.
+/// </summary>
+public class ErrorReportingStatus
+{
+    public bool Enabled { get; set; }
+
+    /// <summary>
+    /// Where reports are sent: a URL receiving <see cref="ErrorReport"/> JSON, or a Sentry DSN.
+    /// Empty means reports are only kept locally.
+    /// </summary>
+    public string Endpoint { get; set; } = "";
+
+    /// <summary>
+    /// Reports waiting to be sent, e.g. a crash saved while the launcher was exiting.
+    /// </summary>
+    public int PendingReports { get; set; }
+}
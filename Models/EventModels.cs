@@ -0,0 +1,26 @@
+using System.Text.Json;
+
+namespace HyPrism.Models;
+
+/// <summary>
+/// An event pushed from .NET to the frontend, as recorded in the event bus replay buffer.
+/// </summary>
+public class BusEvent
+{
+    /// <summary>
+    /// Global sequence number, increasing across all channels.
+    /// </summary>
+    public long Seq { get; set; }
+
+    /// <summary>
+    /// The IPC event channel (see <c>IpcEvents</c>).
+    /// </summary>
+    public string Channel { get; set; } = "";
+
+    public DateTime Timestamp { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// The serialized event payload, exactly as it was sent on the channel.
+    /// </summary>
+    public JsonElement Payload { get; set; }
+}
@@ -0,0 +1,63 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Size, timestamp and SHA-256 of every game file of an instance, recorded after install.
+/// Stored as <c>.game_manifest.json</c> in the instance folder.
+/// </summary>
+public class GameFilesManifest
+{
+    public DateTime CreatedAt { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// Game version the files belong to; 0 if unknown.
+    /// </summary>
+    public int Version { get; set; }
+
+    /// <summary>
+    /// Entries keyed by path relative to the instance folder, using forward slashes.
+    /// </summary>
+    public Dictionary<string, GameFileEntry> Files { get; set; } = new();
+}
+
+public class GameFileEntry
+{
+    public long Size { get; set; }
+
+    public DateTime ModifiedUtc { get; set; }
+
+    public string Sha256 { get; set; } = "";
+}
+
+/// <summary>
+/// Result of checking an instance's game files against its manifest.
+/// </summary>
+public class GameFilesReport
+{
+    /// <summary>
+    /// <c>false</c> when the instance has no manifest (installed before manifests existed).
+    /// </summary>
+    public bool HasManifest { get; set; }
+
+    public int Version { get; set; }
+
+    /// <summary>
+    /// Whether every file was hashed, rather than only those whose size or timestamp changed.
+    /// </summary>
+    public bool Deep { get; set; }
+
+    public int CheckedFiles { get; set; }
+
+    public List<string> Missing { get; set; } = new();
+
+    /// <summary>
+    /// Files whose content differs from the install (edited by the user or damaged).
+    /// </summary>
+    public List<string> Modified { get; set; } = new();
+
+    /// <summary>
+    /// Files patched by the launcher itself (e.g. the client binary for custom auth); not a problem.
+    /// </summary>
+    public List<string> Patched { get; set; } = new();
+
+    public bool IsIntact => HasManifest && Missing.Count == 0 && Modified.Count == 0;
+}
@@ -0,0 +1,20 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Contents of <c>game.pid</c>: the game process the launcher started last, so a restarted launcher can
+/// find it again without scanning process names.
+/// </summary>
+public class GameProcessRecord
+{
+    public int ProcessId { get; set; }
+
+    /// <summary>
+    /// Full path of the started executable; a process with the same ID running something else is not the game.
+    /// </summary>
+    public string ExecutablePath { get; set; } = "";
+
+    /// <summary>
+    /// Process start time (UTC); a process with the same ID started later is not the game.
+    /// </summary>
+    public DateTime StartedAt { get; set; }
+}
@@ -0,0 +1,85 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Common game client options read from an instance's UserData settings files.
+/// </summary>
+/// <remarks>
+/// Options the client has not written yet are null. Every other key in the files is left untouched.
+/// </remarks>
+public class GameSettings
+{
+    /// <summary>
+    /// Settings files found in the instance's UserData folder, relative to it.
+    /// </summary>
+    public List<string> Files { get; set; } = new();
+
+    public int? RenderDistance { get; set; }
+
+    public int? FieldOfView { get; set; }
+
+    public bool? Fullscreen { get; set; }
+
+    public bool? VSync { get; set; }
+
+    /// <summary>
+    /// Volumes in the range the client uses (0–1 or 0–100, whichever the file contains).
+    /// </summary>
+    public double? MasterVolume { get; set; }
+
+    public double? MusicVolume { get; set; }
+
+    public double? SfxVolume { get; set; }
+
+    /// <summary>
+    /// Key bindings: action name to bound key, as stored by the client.
+    /// </summary>
+    public Dictionary<string, string> Keybinds { get; set; } = new();
+}
+
+/// <summary>
+/// Changes to apply to an instance's game settings. Null fields are left as they are.
+/// </summary>
+public class GameSettingsUpdate
+{
+    public int? RenderDistance { get; set; }
+
+    public int? FieldOfView { get; set; }
+
+    public bool? Fullscreen { get; set; }
+
+    public bool? VSync { get; set; }
+
+    public double? MasterVolume { get; set; }
+
+    public double? MusicVolume { get; set; }
+
+    public double? SfxVolume { get; set; }
+
+    /// <summary>
+    /// Bindings to change; other bindings are kept.
+    /// </summary>
+    public Dictionary<string, string>? Keybinds { get; set; }
+}
+
+/// <summary>
+/// Copies game settings files into newly created instances, stored as <c>SettingsSync</c> in config.json.
+/// </summary>
+public class SettingsSyncConfig
+{
+    public bool Enabled { get; set; } = false;
+
+    /// <summary>
+    /// Instance whose settings are copied; empty uses the selected instance.
+    /// </summary>
+    public string SourceInstanceId { get; set; } = "";
+
+    /// <summary>
+    /// File name patterns (relative to UserData, <c>*</c> and <c>?</c> wildcards) to copy.
+    /// </summary>
+    public List<string> Include { get; set; } = new() { "*.json" };
+
+    /// <summary>
+    /// Patterns skipped even when they match <see cref="Include"/>.
+    /// </summary>
+    public List<string> Exclude { get; set; } = new();
+}
@@ -0,0 +1,66 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// One resource usage reading of the running game, pushed every few seconds.
+/// </summary>
+public class GameStatsSample
+{
+    public string? InstanceId { get; set; }
+
+    public int Pid { get; set; }
+
+    public DateTime Timestamp { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// CPU usage since the previous sample, as a share of all logical cores (0-100).
+    /// </summary>
+    public double CpuPercent { get; set; }
+
+    /// <summary>
+    /// Resident set size (working set on Windows).
+    /// </summary>
+    public long RssBytes { get; set; }
+
+    /// <summary>
+    /// GPU utilization of the game process; <c>null</c> where it cannot be read.
+    /// </summary>
+    public double? GpuPercent { get; set; }
+
+    public long? GpuMemoryBytes { get; set; }
+}
+
+/// <summary>
+/// Resource usage summary of one game session, kept in <c>game-stats.json</c>.
+/// </summary>
+public class GameSessionStats
+{
+    public string? InstanceId { get; set; }
+
+    public string? InstanceName { get; set; }
+
+    public int Pid { get; set; }
+
+    public DateTime StartedAt { get; set; }
+
+    /// <summary>
+    /// <c>null</c> while the session is running.
+    /// </summary>
+    public DateTime? EndedAt { get; set; }
+
+    public int SampleCount { get; set; }
+
+    public double AverageCpuPercent { get; set; }
+
+    public double PeakCpuPercent { get; set; }
+
+    public long PeakRssBytes { get; set; }
+
+    public double? PeakGpuPercent { get; set; }
+
+    public long? PeakGpuMemoryBytes { get; set; }
+
+    /// <summary>
+    /// Names of the mods enabled in the instance when the session started.
+    /// </summary>
+    public List<string> EnabledMods { get; set; } = new();
+}
@@ -0,0 +1,81 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Result of the graphics check run before a launch: which graphics APIs work and what looks wrong.
+/// </summary>
+public class GraphicsDiagnostics
+{
+    public DateTime CheckedAt { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// "windows", "linux" or "macos".
+    /// </summary>
+    public string Platform { get; set; } = "";
+
+    /// <summary>
+    /// Whether Vulkan works, or null if it could not be determined.
+    /// </summary>
+    public bool? VulkanAvailable { get; set; }
+
+    /// <summary>
+    /// Highest Vulkan version the devices support, e.g. "1.3.275".
+    /// </summary>
+    public string? VulkanVersion { get; set; }
+
+    /// <summary>
+    /// Names of the Vulkan devices, software ones included.
+    /// </summary>
+    public List<string> VulkanDevices { get; set; } = new();
+
+    /// <summary>
+    /// Whether hardware OpenGL works, or null if it could not be determined.
+    /// </summary>
+    public bool? OpenGlAvailable { get; set; }
+
+    /// <summary>
+    /// OpenGL version string, e.g. "4.6.0 NVIDIA 550.54.14".
+    /// </summary>
+    public string? OpenGlVersion { get; set; }
+
+    /// <summary>
+    /// OpenGL renderer string, e.g. "NVIDIA GeForce RTX 3060/PCIe/SSE2".
+    /// </summary>
+    public string? OpenGlRenderer { get; set; }
+
+    /// <summary>
+    /// Highest Direct3D feature level of the default adapter on Windows, e.g. "12_1".
+    /// </summary>
+    public string? DirectXFeatureLevel { get; set; }
+
+    /// <summary>
+    /// Problems found, most severe first.
+    /// </summary>
+    public List<GraphicsWarning> Warnings { get; set; } = new();
+
+    /// <summary>
+    /// One line describing the findings, for logs and issue reports.
+    /// </summary>
+    public string Summary { get; set; } = "";
+}
+
+/// <summary>
+/// A problem found by the graphics check.
+/// </summary>
+public class GraphicsWarning
+{
+    /// <summary>
+    /// Stable identifier: "no_driver", "software_renderer", "only_32bit", "vulkan_no_driver",
+    /// "dx_feature_level" or "tools_missing".
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// "error" when the game will likely not start, "warning" when it may misbehave, "info" otherwise.
+    /// </summary>
+    public string Severity { get; set; } = "warning";
+
+    /// <summary>
+    /// English description; the frontend shows <c>graphics.warnings.{Id}</c> when it has one.
+    /// </summary>
+    public string Message { get; set; } = "";
+}
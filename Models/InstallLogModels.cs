@@ -0,0 +1,30 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A log file written for one install, update or repair session.
+/// </summary>
+public class InstallLogInfo
+{
+    /// <summary>
+    /// File name in <c>Logs/Installs</c>, used to fetch the log.
+    /// </summary>
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// "install" or "repair", as in <see cref="InstallQueueEntry.Kind"/>.
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    public string Branch { get; set; } = "";
+
+    public int Version { get; set; }
+
+    public DateTime StartedAt { get; set; }
+
+    public long SizeBytes { get; set; }
+
+    /// <summary>
+    /// "success", "failed" or "cancelled"; empty while the session runs or if the launcher closed during it.
+    /// </summary>
+    public string Outcome { get; set; } = "";
+}
@@ -58,4 +58,14 @@ public class InstalledInstance
     public InstanceValidationDetails? ValidationDetails { get; set; }
     
     public string? CustomName { get; set; }
+
+    /// <summary>
+    /// Icon as a <c>file://</c> URL or <c>bundled:{name}</c>; see <see cref="InstanceArtwork"/>.
+    /// </summary>
+    public string? Icon { get; set; }
+
+    /// <summary>
+    /// Banner as a <c>file://</c> URL or <c>bundled:{name}</c>.
+    /// </summary>
+    public string? Banner { get; set; }
 }
@@ -0,0 +1,37 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// One launch of an instance, kept in <c>launch-history.json</c>.
+/// </summary>
+public class InstanceLaunchRecord
+{
+    public string InstanceId { get; set; } = "";
+
+    public DateTime LaunchedAt { get; set; }
+}
+
+/// <summary>
+/// An instance with its launch history and favorite flag, for the recent and favorite lists.
+/// </summary>
+public class InstanceActivity
+{
+    public string InstanceId { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    public string Branch { get; set; } = "";
+
+    public int Version { get; set; }
+
+    public bool IsFavorite { get; set; }
+
+    /// <summary>
+    /// Last launch, or <c>null</c> if the instance was never launched.
+    /// </summary>
+    public DateTime? LastPlayedAt { get; set; }
+
+    /// <summary>
+    /// Launches found in the kept history.
+    /// </summary>
+    public int LaunchCount { get; set; }
+}
@@ -0,0 +1,23 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Images an instance can have.
+/// </summary>
+public static class ArtworkKind
+{
+    public const string Icon = "icon";
+    public const string Banner = "banner";
+}
+
+/// <summary>
+/// Icon and banner of an instance as the frontend shows them.
+/// </summary>
+/// <remarks>
+/// Each value is a <c>file://</c> URL of an image in the instance folder, or <c>bundled:{name}</c> for
+/// launcher art, which the frontend resolves from its background images. Null uses the default look.
+/// </remarks>
+public class InstanceArtwork
+{
+    public string? Icon { get; set; }
+    public string? Banner { get; set; }
+}
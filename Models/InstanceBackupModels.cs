@@ -0,0 +1,21 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// An archive made by <c>InstanceBackupService</c>: the instance's <c>UserData</c> (worlds, mods and the
+/// mod manifest) and its <c>meta.json</c> settings. Game files are not included.
+/// </summary>
+public class InstanceBackupInfo
+{
+    /// <summary>
+    /// Archive file name, used to pick a backup to restore or delete.
+    /// </summary>
+    public string FileName { get; set; } = "";
+
+    public string Path { get; set; } = "";
+
+    public string InstanceId { get; set; } = "";
+
+    public DateTime CreatedAt { get; set; }
+
+    public long SizeBytes { get; set; }
+}
@@ -0,0 +1,32 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Contents of an instance's <c>.hyprism.lock</c> file: who is using the instance and for what.
+/// </summary>
+public class InstanceLockInfo
+{
+    /// <summary>
+    /// "running", "installing", "modifying" or "backup".
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// Short description for logs, e.g. "installing a mod".
+    /// </summary>
+    public string Operation { get; set; } = "";
+
+    /// <summary>
+    /// The launcher holding the lock, or the game process once a launch hands the lock over.
+    /// </summary>
+    public int ProcessId { get; set; }
+
+    /// <summary>
+    /// Machine name of the holder; locks from other hosts are treated as stale.
+    /// </summary>
+    public string Host { get; set; } = "";
+
+    /// <summary>
+    /// When the holding process took the lock; a process with the same ID started later is not the holder.
+    /// </summary>
+    public DateTime Since { get; set; } = DateTime.UtcNow;
+}
@@ -45,6 +45,38 @@ public class InstanceMeta
     /// Notes or description for this instance.
     /// </summary>
     public string? Notes { get; set; }
+
+    /// <summary>
+    /// Java runtime this instance launches with. Null uses the shared Hytale JRE.
+    /// </summary>
+    public string? JavaRuntimeId { get; set; }
+
+    /// <summary>
+    /// Arguments appended to the client command line, split like a shell would (quotes group words).
+    /// </summary>
+    public string? ExtraClientArgs { get; set; }
+
+    /// <summary>
+    /// Display server the client uses on Linux: "wayland" or "x11". Null lets SDL choose.
+    /// </summary>
+    public string? DisplayServer { get; set; }
+
+    /// <summary>
+    /// Game version this instance runs, as CurseForge names it. Used to warn about mods whose files
+    /// do not list it; null skips the check.
+    /// </summary>
+    public string? GameVersion { get; set; }
+
+    /// <summary>
+    /// Icon of the instance: an image file in the instance folder (<c>logo.png</c>) or <c>bundled:{name}</c>
+    /// for launcher art. Null shows <c>logo.png</c> or <c>icon.png</c> if one exists.
+    /// </summary>
+    public string? Icon { get; set; }
+
+    /// <summary>
+    /// Banner of the instance, in the same form as <see cref="Icon"/> (<c>banner.png</c> when picked from a file).
+    /// </summary>
+    public string? Banner { get; set; }
 }
 
 /// <summary>
@@ -0,0 +1,85 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A Java runtime the launcher can start the game with.
+/// </summary>
+public class JavaRuntimeInfo
+{
+    /// <summary>
+    /// Runtime identifier: "default" for the shared Hytale JRE, "temurin-{feature}" for managed runtimes,
+    /// "system-{hash}" for Java installations found on the system.
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// Java feature (major) version, e.g. 25.
+    /// </summary>
+    public int FeatureVersion { get; set; }
+
+    /// <summary>
+    /// Full version string as published by the vendor, if known.
+    /// </summary>
+    public string? Version { get; set; }
+
+    public string Vendor { get; set; } = "";
+
+    /// <summary>
+    /// Runtime home directory (contains <c>bin/</c>).
+    /// </summary>
+    public string HomePath { get; set; } = "";
+
+    public string JavaPath { get; set; } = "";
+
+    /// <summary>
+    /// SHA-256 of the archive the runtime was installed from, verified at download time.
+    /// </summary>
+    public string? ArchiveSha256 { get; set; }
+
+    public string? SourceUrl { get; set; }
+
+    public DateTime? InstalledAt { get; set; }
+
+    public bool IsDefault { get; set; }
+
+    /// <summary>
+    /// True for Java installations detected on the system; the launcher never modifies or deletes them.
+    /// </summary>
+    public bool IsSystem { get; set; }
+
+    public bool IsInstalled { get; set; }
+
+    /// <summary>
+    /// Whether the feature version is new enough to run the game.
+    /// </summary>
+    public bool IsCompatible { get; set; } = true;
+}
+
+/// <summary>
+/// Managed runtimes persisted to <c>Runtimes/runtimes.json</c>.
+/// </summary>
+public class JavaRuntimeRegistry
+{
+    public List<JavaRuntimeInfo> Runtimes { get; set; } = new();
+}
+
+/// <summary>
+/// File checksums recorded right after a runtime is installed, stored as
+/// <c>.runtime_manifest.json</c> in the runtime home and checked before each launch.
+/// </summary>
+public class RuntimeManifest
+{
+    public DateTime CreatedAt { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// Entries keyed by path relative to the runtime home, using forward slashes.
+    /// </summary>
+    public Dictionary<string, RuntimeManifestEntry> Files { get; set; } = new();
+}
+
+public class RuntimeManifestEntry
+{
+    public long Size { get; set; }
+    public string Sha256 { get; set; } = "";
+}
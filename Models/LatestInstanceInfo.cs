@@ -8,5 +8,11 @@ namespace HyPrism.Services;
 public sealed class LatestInstanceInfo
 {
     public int Version { get; set; }
+
+    /// <summary>
+    /// Branch the record belongs to; missing in files written by older launchers.
+    /// </summary>
+    public string? Branch { get; set; }
+
     public DateTime UpdatedAt { get; set; }
 }
@@ -0,0 +1,26 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// The command a launch runs: what <c>GameLauncher</c> starts, and what the launch preview shows.
+/// </summary>
+public class LaunchCommand
+{
+    public string Executable { get; set; } = "";
+
+    public string WorkingDirectory { get; set; } = "";
+
+    /// <summary>
+    /// Client arguments in order, ending with the instance's extra client arguments.
+    /// </summary>
+    public List<string> Arguments { get; set; } = new();
+
+    /// <summary>
+    /// Variables set on top of the launcher's own environment.
+    /// </summary>
+    public Dictionary<string, string> Environment { get; set; } = new();
+
+    /// <summary>
+    /// The whole command as one shell line, for copying into a terminal.
+    /// </summary>
+    public string CommandLine { get; set; } = "";
+}
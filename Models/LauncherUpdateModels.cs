@@ -0,0 +1,45 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A newer launcher release for the selected update channel.
+/// </summary>
+public class LauncherUpdateInfo
+{
+    public string Version { get; set; } = "";
+
+    public string CurrentVersion { get; set; } = "";
+
+    /// <summary>
+    /// Download URL of the asset for this platform; empty if the release has none.
+    /// </summary>
+    public string DownloadUrl { get; set; } = "";
+
+    public string AssetName { get; set; } = "";
+
+    public string ReleaseUrl { get; set; } = "";
+
+    public bool IsBeta { get; set; }
+}
+
+/// <summary>
+/// Result of the last launcher update check, kept on disk so startup can show it without a network call.
+/// </summary>
+public class LauncherUpdateCheck
+{
+    public DateTime CheckedAtUtc { get; set; }
+
+    /// <summary>
+    /// Launcher version that ran the check; a result from another version is ignored.
+    /// </summary>
+    public string LauncherVersion { get; set; } = "";
+
+    /// <summary>
+    /// Update channel that was checked ("release" or "beta").
+    /// </summary>
+    public string Branch { get; set; } = "";
+
+    /// <summary>
+    /// The newer release, or <c>null</c> if the launcher was up to date.
+    /// </summary>
+    public LauncherUpdateInfo? Update { get; set; }
+}
@@ -0,0 +1,127 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A single log line captured from the launcher or the running game client.
+/// </summary>
+public class LogLine
+{
+    /// <summary>
+    /// Monotonically increasing sequence number, used as a pagination cursor.
+    /// </summary>
+    public long Seq { get; set; }
+
+    /// <summary>
+    /// Origin of the line: "launcher" or "game".
+    /// </summary>
+    public string Source { get; set; } = "launcher";
+
+    /// <summary>
+    /// Local time the line was captured.
+    /// </summary>
+    public DateTime Timestamp { get; set; } = DateTime.Now;
+
+    /// <summary>
+    /// Normalized level: DBG, INF, SUC, WRN or ERR.
+    /// </summary>
+    public string Level { get; set; } = "INF";
+
+    /// <summary>
+    /// Logger category or game subsystem that produced the line.
+    /// </summary>
+    public string Category { get; set; } = "";
+
+    /// <summary>
+    /// The log message text.
+    /// </summary>
+    public string Message { get; set; } = "";
+}
+
+/// <summary>
+/// Filter applied to log lines, shared by queries and live subscriptions.
+/// </summary>
+public class LogFilter
+{
+    /// <summary>
+    /// Restricts results to "launcher" or "game". Null includes both.
+    /// </summary>
+    public string? Source { get; set; }
+
+    /// <summary>
+    /// Minimum level to include (DBG, INF, SUC, WRN, ERR). Null includes all.
+    /// </summary>
+    public string? MinLevel { get; set; }
+
+    /// <summary>
+    /// Case-insensitive regular expression matched against category and message.
+    /// </summary>
+    public string? Pattern { get; set; }
+}
+
+/// <summary>
+/// A paginated log query. Pages are read backwards from the newest line
+/// unless <see cref="AfterSeq"/> is set.
+/// </summary>
+public class LogQuery : LogFilter
+{
+    /// <summary>
+    /// Only return lines older than this sequence number (scrolling back).
+    /// </summary>
+    public long? BeforeSeq { get; set; }
+
+    /// <summary>
+    /// Only return lines newer than this sequence number (catching up).
+    /// </summary>
+    public long? AfterSeq { get; set; }
+
+    /// <summary>
+    /// Maximum number of lines to return.
+    /// </summary>
+    public int Limit { get; set; } = 200;
+}
+
+/// <summary>
+/// A page of log lines returned by a <see cref="LogQuery"/>, ordered oldest first.
+/// </summary>
+public class LogPage
+{
+    /// <summary>
+    /// The matching lines, oldest first.
+    /// </summary>
+    public List<LogLine> Lines { get; set; } = new();
+
+    /// <summary>
+    /// Whether more matching lines exist beyond this page in the requested direction.
+    /// </summary>
+    public bool HasMore { get; set; }
+
+    /// <summary>
+    /// Sequence number of the newest line currently buffered.
+    /// </summary>
+    public long LatestSeq { get; set; }
+}
+
+/// <summary>
+/// A batch of new lines from the game's session log file, pushed while a log stream is active.
+/// </summary>
+public class GameLogChunk
+{
+    /// <summary>
+    /// The instance whose game wrote the lines.
+    /// </summary>
+    public string InstanceId { get; set; } = "";
+
+    /// <summary>
+    /// Name of the log file the lines came from; changes when the client starts a new file.
+    /// </summary>
+    public string? File { get; set; }
+
+    /// <summary>
+    /// The new lines, in file order, without line endings.
+    /// </summary>
+    public List<string> Lines { get; set; } = new();
+
+    /// <summary>
+    /// Set on the last chunk, once the game has exited or the stream was stopped.
+    /// </summary>
+    public bool Ended { get; set; }
+}
@@ -0,0 +1,26 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A download on a metered connection that waits for the user to allow it.
+/// </summary>
+public class DownloadConfirmation
+{
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// The <see cref="TrafficCategory"/> of the download.
+    /// </summary>
+    public string Category { get; set; } = "";
+
+    /// <summary>
+    /// File name of the download.
+    /// </summary>
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// Bytes still to download; a resumed download counts only the rest.
+    /// </summary>
+    public long Bytes { get; set; }
+
+    public DateTime RequestedAtUtc { get; set; }
+}
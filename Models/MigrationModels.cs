@@ -0,0 +1,87 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// What to move from one instance to another, e.g. from a pre-release instance to release.
+/// </summary>
+public class InstanceMigrationOptions
+{
+    public string SourceId { get; set; } = "";
+
+    public string TargetId { get; set; } = "";
+
+    public bool Worlds { get; set; } = true;
+
+    public bool Mods { get; set; } = true;
+
+    public bool Settings { get; set; } = true;
+
+    /// <summary>
+    /// Replace worlds that already exist in the target; they are skipped otherwise.
+    /// </summary>
+    public bool OverwriteWorlds { get; set; }
+
+    /// <summary>
+    /// CurseForge game version label of the target (as listed in a file's <c>gameVersions</c>).
+    /// When empty, mods are copied without a compatibility check.
+    /// </summary>
+    public string? GameVersion { get; set; }
+
+    /// <summary>
+    /// Only report what would happen; nothing is copied or downloaded.
+    /// </summary>
+    public bool DryRun { get; set; }
+}
+
+/// <summary>
+/// Outcome (or, for a dry run, plan) of an instance migration.
+/// </summary>
+public class InstanceMigrationReport
+{
+    public bool DryRun { get; set; }
+
+    public List<string> WorldsCopied { get; set; } = new();
+
+    /// <summary>
+    /// Worlds that already exist in the target and were left alone.
+    /// </summary>
+    public List<string> WorldsSkipped { get; set; } = new();
+
+    /// <summary>
+    /// Mods copied with the same file as in the source.
+    /// </summary>
+    public List<string> ModsCopied { get; set; } = new();
+
+    /// <summary>
+    /// Mods installed with a different CurseForge file that supports the target game version.
+    /// </summary>
+    public List<string> ModsSwitched { get; set; } = new();
+
+    /// <summary>
+    /// Mods already installed in the target.
+    /// </summary>
+    public List<string> ModsSkipped { get; set; } = new();
+
+    /// <summary>
+    /// Mods left out because no file for the target game version was found.
+    /// </summary>
+    public List<MigrationModIssue> IncompatibleMods { get; set; } = new();
+
+    /// <summary>
+    /// Whether mods were checked against <see cref="InstanceMigrationOptions.GameVersion"/>.
+    /// Local mods (no CurseForge ID) are never checked.
+    /// </summary>
+    public bool CompatibilityChecked { get; set; }
+
+    public List<string> SettingsFiles { get; set; } = new();
+
+    public List<string> Errors { get; set; } = new();
+}
+
+public class MigrationModIssue
+{
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    public string Reason { get; set; } = "";
+}
@@ -0,0 +1,59 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A guided search for the mod that makes an instance crash. Each step launches the game with half of
+/// the remaining suspects enabled and narrows the suspects down by the user's report.
+/// Stored as <c>mod-bisect.json</c> in the launcher data directory so it survives launcher restarts.
+/// </summary>
+public class ModBisectState
+{
+    public string InstanceId { get; set; } = "";
+
+    public string InstanceName { get; set; } = "";
+
+    /// <summary>
+    /// "testing" while suspects remain, "found" once a single mod is left.
+    /// </summary>
+    public string Status { get; set; } = "testing";
+
+    /// <summary>
+    /// 1-based number of the current step.
+    /// </summary>
+    public int Step { get; set; } = 1;
+
+    /// <summary>
+    /// Whether the current step was launched; a result can only be reported afterwards.
+    /// </summary>
+    public bool Launched { get; set; }
+
+    /// <summary>
+    /// Mods that may still cause the crash.
+    /// </summary>
+    public List<ModBisectMod> Suspects { get; set; } = new();
+
+    /// <summary>
+    /// Suspects enabled for the current step; every other mod is disabled while it runs.
+    /// </summary>
+    public List<ModBisectMod> Testing { get; set; } = new();
+
+    /// <summary>
+    /// Mods ruled out by earlier steps.
+    /// </summary>
+    public List<ModBisectMod> Cleared { get; set; } = new();
+
+    public ModBisectMod? Culprit { get; set; }
+
+    /// <summary>
+    /// Steps left in the worst case, including the current one.
+    /// </summary>
+    public int RemainingSteps => Suspects.Count <= 1 ? 0 : (int)Math.Ceiling(Math.Log2(Suspects.Count));
+
+    public DateTime StartedAt { get; set; } = DateTime.UtcNow;
+}
+
+public class ModBisectMod
+{
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+}
@@ -6,6 +6,29 @@ public class ModSearchResult
 {
     public List<ModInfo> Mods { get; set; } = new();
     public int TotalCount { get; set; }
+    
+    /// <summary>
+    /// Message ID explaining why the search failed (e.g. offline), when known.
+    /// </summary>
+    public string? MessageKey { get; set; }
+    public object[]? Args { get; set; }
+}
+
+/// <summary>
+/// One page of the Hytale projects of a CurseForge author.
+/// </summary>
+public class ModAuthorResult : ModSearchResult
+{
+    /// <summary>
+    /// The author's CurseForge ID, or null if no author by the given name was found.
+    /// </summary>
+    public int? AuthorId { get; set; }
+    public string AuthorName { get; set; } = "";
+
+    /// <summary>
+    /// The author's CurseForge profile page.
+    /// </summary>
+    public string AuthorUrl { get; set; } = "";
 }
 
 public class ModInfo
@@ -16,6 +39,11 @@ public class ModInfo
     public string Summary { get; set; } = "";
     public string Description { get; set; } = "";
     public string Author { get; set; } = "";
+
+    /// <summary>
+    /// CurseForge ID of <see cref="Author"/>, for listing their other projects.
+    /// </summary>
+    public int? AuthorId { get; set; }
     public int DownloadCount { get; set; }
     public string IconUrl { get; set; } = "";
     public string ThumbnailUrl { get; set; } = "";
@@ -50,6 +78,66 @@ public class ModCategory
     public int Id { get; set; }
     public string Name { get; set; } = "";
     public string Slug { get; set; } = "";
+
+    /// <summary>
+    /// Whether this is a top-level class (Mods, Worlds, Prefabs...) rather than a category within one.
+    /// </summary>
+    public bool IsClass { get; set; }
+
+    /// <summary>
+    /// Class the category belongs to; null for classes.
+    /// </summary>
+    public int? ClassId { get; set; }
+
+    /// <summary>
+    /// Parent class or category; null for classes.
+    /// </summary>
+    public int? ParentId { get; set; }
+
+    /// <summary>
+    /// Categories nested under this one, filled only in the category tree.
+    /// </summary>
+    public List<ModCategory> Children { get; set; } = new();
+}
+
+/// <summary>
+/// Filters for a CurseForge mod search.
+/// </summary>
+public class SearchModsParams
+{
+    public string Query { get; set; } = "";
+
+    /// <summary>
+    /// Page number (0-based).
+    /// </summary>
+    public int Page { get; set; }
+
+    public int PageSize { get; set; } = 20;
+
+    /// <summary>
+    /// Only projects by this CurseForge author.
+    /// </summary>
+    public int? AuthorId { get; set; }
+
+    /// <summary>
+    /// Class to search in (e.g. Mods or Worlds); null searches every class.
+    /// </summary>
+    public int? ClassId { get; set; }
+
+    /// <summary>
+    /// Categories a result must be in; CurseForge accepts up to 10.
+    /// </summary>
+    public List<int> CategoryIds { get; set; } = new();
+
+    /// <summary>
+    /// CurseForge sort field (1 = featured, 2 = popularity, 3 = last updated, 6 = total downloads...).
+    /// </summary>
+    public int SortField { get; set; } = 1;
+
+    /// <summary>
+    /// 0 = ascending, anything else descending.
+    /// </summary>
+    public int SortOrder { get; set; } = 1;
 }
 
 public class InstalledMod
@@ -88,6 +176,83 @@ public class InstalledMod
     /// Original file extension used before disabling (e.g. .jar or .zip).
     /// </summary>
     public string DisabledOriginalExtension { get; set; } = "";
+
+    /// <summary>
+    /// CurseForge IDs of the mods the installed file requires, or null if not recorded yet
+    /// (mods installed by older versions or from local files).
+    /// </summary>
+    public List<string>? Dependencies { get; set; }
+
+    /// <summary>
+    /// Game versions the installed file lists on CurseForge, or null if not recorded yet.
+    /// </summary>
+    public List<string>? GameVersions { get; set; }
+}
+
+/// <summary>
+/// Enabled mods of an instance whose files do not list the instance's game version.
+/// </summary>
+public class ModCompatibilityReport
+{
+    public string InstancePath { get; set; } = "";
+    public string GameVersion { get; set; } = "";
+    public List<InstalledMod> Mods { get; set; } = new();
+}
+
+/// <summary>
+/// Progress of enabling or disabling every mod of an instance, sent after each mod.
+/// </summary>
+public class ModBulkToggleProgress
+{
+    public string InstancePath { get; set; } = "";
+    public bool Enabled { get; set; }
+    public int Done { get; set; }
+    public int Total { get; set; }
+    public string ModName { get; set; } = "";
+}
+
+public class ModBulkToggleResult
+{
+    public int Changed { get; set; }
+
+    /// <summary>
+    /// Mods whose file could not be found or renamed; they keep their previous state.
+    /// </summary>
+    public int Failed { get; set; }
+}
+
+/// <summary>
+/// Outcome of installing a mod from a pasted CurseForge link, slug or <c>projectID:fileID</c>.
+/// </summary>
+public class ModResolveResult
+{
+    public bool Success { get; set; }
+    public string ModId { get; set; } = "";
+    public string FileId { get; set; } = "";
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// Message ID explaining why the input could not be resolved or installed.
+    /// </summary>
+    public string? MessageKey { get; set; }
+}
+
+/// <summary>
+/// What else is affected when a mod is removed from an instance.
+/// </summary>
+public class ModUninstallImpact
+{
+    public string ModId { get; set; } = "";
+
+    /// <summary>
+    /// Installed mods that require the mod and will likely stop working without it.
+    /// </summary>
+    public List<InstalledMod> Dependents { get; set; } = new();
+
+    /// <summary>
+    /// Dependencies of the mod that no other installed mod requires, and can be removed with it.
+    /// </summary>
+    public List<InstalledMod> Orphans { get; set; } = new();
 }
 
 /// <summary>
@@ -101,6 +266,21 @@ public class ModListEntry
     public string? Version { get; set; }
 }
 
+/// <summary>
+/// Incremental result of a mod update check, sent after each mod is checked.
+/// </summary>
+public class ModUpdateCheckProgress
+{
+    public string InstancePath { get; set; } = "";
+    public int Checked { get; set; }
+    public int Total { get; set; }
+    
+    /// <summary>
+    /// The mod just found to have an update, or null if the checked mod is up to date.
+    /// </summary>
+    public InstalledMod? Update { get; set; }
+}
+
 public class ModUpdate
 {
     public string ModId { get; set; } = "";
@@ -0,0 +1,37 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Timeouts and retry behaviour for launcher network requests, stored as <c>Network</c> in config.json.
+/// </summary>
+public class NetworkConfig
+{
+    /// <summary>
+    /// Timeout for API and index requests (version lists, announcements, release info).
+    /// </summary>
+    public int MetadataTimeoutSeconds { get; set; } = 20;
+
+    /// <summary>
+    /// Timeout for small downloads such as Butler, agents and thumbnails.
+    /// </summary>
+    public int SmallFileTimeoutSeconds { get; set; } = 300;
+
+    /// <summary>
+    /// Timeout for game archives, patches and Java runtimes.
+    /// </summary>
+    public int LargeDownloadTimeoutMinutes { get; set; } = 120;
+
+    /// <summary>
+    /// How many times a failed request is retried after the first attempt.
+    /// </summary>
+    public int MaxRetries { get; set; } = 3;
+
+    /// <summary>
+    /// Delay before the first retry; doubles with each further attempt.
+    /// </summary>
+    public int RetryBaseDelayMs { get; set; } = 1000;
+
+    /// <summary>
+    /// Upper bound for a single retry delay.
+    /// </summary>
+    public int RetryMaxDelayMs { get; set; } = 30000;
+}
@@ -0,0 +1,29 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Result of a connectivity probe.
+/// </summary>
+public class NetworkStatus
+{
+    /// <summary>
+    /// "ok", "offline", "captive_portal" or "cdn_blocked".
+    /// </summary>
+    public string State { get; set; } = "ok";
+
+    /// <summary>
+    /// Message ID describing the problem; null when the state is "ok".
+    /// </summary>
+    public string? MessageKey { get; set; }
+
+    /// <summary>
+    /// Service hosts that could not be reached while general internet access worked.
+    /// </summary>
+    public List<string> BlockedHosts { get; set; } = new();
+
+    /// <summary>
+    /// Whether requests went through a proxy from the launcher config.
+    /// </summary>
+    public bool ProxyConfigured { get; set; }
+
+    public DateTime CheckedAt { get; set; } = DateTime.UtcNow;
+}
@@ -0,0 +1,78 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// News items together with information about where they came from.
+/// </summary>
+public class NewsFeed
+{
+    public List<NewsItemResponse> Items { get; set; } = new();
+
+    /// <summary>
+    /// True when at least one source was served from the cache instead of the network.
+    /// </summary>
+    public bool FromCache { get; set; }
+
+    /// <summary>
+    /// True when a source could not be refreshed and older cached items past their TTL were returned.
+    /// </summary>
+    public bool Stale { get; set; }
+
+    /// <summary>
+    /// UTC time of the oldest fetch that contributed items, or null if nothing was available.
+    /// </summary>
+    public DateTime? FetchedAt { get; set; }
+}
+
+/// <summary>
+/// Cached items for one news source, persisted to <c>Cache/News/news.json</c>.
+/// </summary>
+public class NewsCacheEntry
+{
+    public DateTime FetchedAt { get; set; }
+
+    /// <summary>
+    /// Item limit the fetch was made with; a larger request needs a new fetch.
+    /// </summary>
+    public int Limit { get; set; }
+
+    public List<NewsItemResponse> Items { get; set; } = new();
+}
+
+/// <summary>
+/// A user-configured RSS or Atom feed.
+/// </summary>
+public class NewsFeedConfig
+{
+    public string Name { get; set; } = "";
+    public string Url { get; set; } = "";
+}
+
+/// <summary>
+/// A news source as listed in settings.
+/// </summary>
+public class NewsSourceInfo
+{
+    public string Id { get; set; } = "";
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// "hytale", "hyprism" or "feed".
+    /// </summary>
+    public string Type { get; set; } = "";
+
+    /// <summary>
+    /// Feed URL; null for built-in sources.
+    /// </summary>
+    public string? Url { get; set; }
+
+    public bool Enabled { get; set; } = true;
+}
+
+/// <summary>
+/// Payload of <c>hyprism:news:configureSources</c>. Null fields are left unchanged.
+/// </summary>
+public class NewsSourcesUpdate
+{
+    public List<NewsFeedConfig>? Feeds { get; set; }
+    public List<string>? DisabledSources { get; set; }
+}
@@ -25,6 +25,15 @@ public class NewsItemResponse
     [JsonPropertyName("imageUrl")]
     public string? ImageUrl { get; set; }
     
+    /// <summary>
+    /// file:// URL of the cached thumbnail, set once the image has been downloaded.
+    /// </summary>
+    [JsonPropertyName("localImageUrl")]
+    public string? LocalImageUrl { get; set; }
+    
     [JsonPropertyName("source")]
-    public string Source { get; set; } = "hytale"; // "hytale" or "hyprism"
+    public string Source { get; set; } = "hytale"; // "hytale", "hyprism" or "feed:<id>"
+    
+    [JsonPropertyName("sourceName")]
+    public string SourceName { get; set; } = "";
 }
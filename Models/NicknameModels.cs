@@ -0,0 +1,41 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Rules a player nickname must follow, stored as <c>NicknameRules</c> in config.json.
+/// </summary>
+public class NicknameRules
+{
+    public int MinLength { get; set; } = 1;
+
+    public int MaxLength { get; set; } = 16;
+
+    /// <summary>
+    /// Regular expression a single character must match. Empty allows any character except whitespace and control characters.
+    /// </summary>
+    public string AllowedCharacters { get; set; } = "[A-Za-z0-9_-]";
+
+    /// <summary>
+    /// Names that cannot be used, compared case-insensitively.
+    /// </summary>
+    public List<string> ReservedNames { get; set; } = new() { "Admin", "Console", "Server", "System", "Hytale", "Moderator" };
+}
+
+/// <summary>
+/// Result of checking a nickname against <see cref="NicknameRules"/>.
+/// </summary>
+public class NicknameValidationResult
+{
+    public bool Valid { get; set; }
+
+    /// <summary>
+    /// Message ID describing the first broken rule (see MessageCatalog).
+    /// </summary>
+    public string? MessageKey { get; set; }
+
+    public object[]? Args { get; set; }
+
+    /// <summary>
+    /// English text for <see cref="MessageKey"/>, for logs and untranslated clients.
+    /// </summary>
+    public string? Message { get; set; }
+}
@@ -271,3 +271,37 @@ public class CachedPatchStep
     /// </summary>
     public string? SigUrl { get; set; }
 }
+
+/// <summary>
+/// Patch notes of one game version, from the changelog feed or the Hytale blog.
+/// </summary>
+public class GameChangelog
+{
+    public string Branch { get; set; } = "";
+
+    public int Version { get; set; }
+
+    public string Title { get; set; } = "";
+
+    public string Date { get; set; } = "";
+
+    /// <summary>
+    /// Full patch notes page, if any.
+    /// </summary>
+    public string Url { get; set; } = "";
+
+    /// <summary>
+    /// Change lines; empty when only a summary is known.
+    /// </summary>
+    public List<string> Notes { get; set; } = new();
+
+    /// <summary>
+    /// Short text shown when there are no <see cref="Notes"/> (e.g. a blog excerpt).
+    /// </summary>
+    public string Summary { get; set; } = "";
+
+    /// <summary>
+    /// "feed" or "hytale".
+    /// </summary>
+    public string Source { get; set; } = "";
+}
@@ -0,0 +1,89 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Current first-run state and the values the setup flow should prefill.
+/// </summary>
+public class OnboardingState
+{
+    /// <summary>
+    /// True until the setup flow has been completed once.
+    /// </summary>
+    public bool IsFirstRun { get; set; }
+
+    public string Nickname { get; set; } = "";
+    public string Branch { get; set; } = "release";
+    public string Language { get; set; } = "en-US";
+
+    /// <summary>
+    /// Configured instance directory, or the default location when none is set.
+    /// </summary>
+    public string InstanceDirectory { get; set; } = "";
+
+    public string DefaultInstanceDirectory { get; set; } = "";
+}
+
+/// <summary>
+/// A candidate directory for game instances, with free-space information for its volume.
+/// </summary>
+public class InstallLocation
+{
+    public string Path { get; set; } = "";
+
+    /// <summary>
+    /// Where the candidate comes from: "default", "current", "home" or "drive".
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// Free bytes on the volume, or -1 if it could not be determined.
+    /// </summary>
+    public long FreeBytes { get; set; } = -1;
+
+    /// <summary>
+    /// Total bytes on the volume, or -1 if it could not be determined.
+    /// </summary>
+    public long TotalBytes { get; set; } = -1;
+
+    /// <summary>
+    /// Whether the volume has at least the recommended free space for one installation.
+    /// </summary>
+    public bool HasEnoughSpace { get; set; }
+
+    public bool IsDefault { get; set; }
+    public bool IsRecommended { get; set; }
+}
+
+/// <summary>
+/// Choices submitted by the setup flow. Null fields keep their current value.
+/// </summary>
+public class OnboardingRequest
+{
+    public string? Nickname { get; set; }
+    public string? Branch { get; set; }
+    public string? Language { get; set; }
+
+    /// <summary>
+    /// Directory for game instances. Empty string selects the default location.
+    /// </summary>
+    public string? InstanceDirectory { get; set; }
+}
+
+/// <summary>
+/// Outcome of completing the setup flow.
+/// </summary>
+public class OnboardingResult
+{
+    public bool Success { get; set; }
+
+    /// <summary>
+    /// Message ID describing why the request was rejected (see MessageCatalog).
+    /// </summary>
+    public string? MessageKey { get; set; }
+
+    public object[]? Args { get; set; }
+
+    /// <summary>
+    /// Resulting state after a successful completion.
+    /// </summary>
+    public OnboardingState? State { get; set; }
+}
@@ -0,0 +1,51 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Progress of one download or install, in the same shape for every component (game sessions, the JRE,
+/// Butler, mods, launcher updates). Speed and time left are computed by the launcher, not by each component.
+/// </summary>
+public class OperationProgress
+{
+    /// <summary>
+    /// Same for every update of one operation; game sessions use their background task ID.
+    /// </summary>
+    public string OperationId { get; set; } = "";
+
+    /// <summary>
+    /// "game", "jre", "butler", "mod" or "launcher-update".
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// What the operation is doing, e.g. "download", "extract", "install".
+    /// </summary>
+    public string Stage { get; set; } = "";
+
+    /// <summary>
+    /// "running", "completed", "failed" or "cancelled".
+    /// </summary>
+    public string State { get; set; } = "running";
+
+    /// <summary>
+    /// Percentage of the whole operation (0-100).
+    /// </summary>
+    public double Progress { get; set; }
+
+    public string? MessageKey { get; set; }
+
+    public object[]? Args { get; set; }
+
+    public long DownloadedBytes { get; set; }
+
+    public long TotalBytes { get; set; }
+
+    /// <summary>
+    /// Transfer speed of the current stage; 0 when nothing is transferred.
+    /// </summary>
+    public double BytesPerSecond { get; set; }
+
+    /// <summary>
+    /// Seconds left in the current transfer, or <c>null</c> when unknown.
+    /// </summary>
+    public double? EtaSeconds { get; set; }
+}
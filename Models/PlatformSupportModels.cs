@@ -0,0 +1,29 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Whether a downloaded component exists for this machine, and which build is used.
+/// </summary>
+public class PlatformComponentSupport
+{
+    /// <summary>
+    /// "game", "butler", "jre" or "temurin".
+    /// </summary>
+    public string Component { get; set; } = "";
+
+    /// <summary>
+    /// This machine as <c>{os}-{arch}</c>, e.g. <c>windows-arm64</c>.
+    /// </summary>
+    public string Platform { get; set; } = "";
+
+    /// <summary>
+    /// Architecture of the build that is downloaded, or <c>null</c> if there is none for this machine.
+    /// </summary>
+    public string? ArtifactArch { get; set; }
+
+    public bool Available => ArtifactArch != null;
+
+    /// <summary>
+    /// The build is for another architecture and runs through Rosetta 2 or Windows x64 emulation.
+    /// </summary>
+    public bool Emulated { get; set; }
+}
@@ -0,0 +1,52 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Optional parental playtime limits, enforced while the game runs.
+/// </summary>
+public class PlaytimeLimitConfig
+{
+    /// <summary>
+    /// Whether the limits are enforced at all.
+    /// </summary>
+    public bool Enabled { get; set; } = false;
+
+    /// <summary>
+    /// Minutes of play allowed per day; 0 means no daily cap.
+    /// </summary>
+    public int DailyLimitMinutes { get; set; } = 0;
+
+    /// <summary>
+    /// Start of the allowed hours as local "HH:mm". Empty together with <see cref="AllowedUntil"/> allows any time.
+    /// </summary>
+    public string AllowedFrom { get; set; } = "";
+
+    /// <summary>
+    /// End of the allowed hours as local "HH:mm"; may be earlier than <see cref="AllowedFrom"/> to span midnight.
+    /// </summary>
+    public string AllowedUntil { get; set; } = "";
+
+    /// <summary>
+    /// How many minutes before a limit the player is warned.
+    /// </summary>
+    public int WarnMinutes { get; set; } = 5;
+
+    /// <summary>
+    /// Write a PIN (4+ digits) here to set it; the launcher replaces it with <see cref="PinHash"/> on start.
+    /// </summary>
+    public string Pin { get; set; } = "";
+
+    /// <summary>
+    /// PBKDF2 hash of the override PIN as hex; empty when no PIN is set. Never stores the PIN itself.
+    /// </summary>
+    public string PinHash { get; set; } = "";
+
+    /// <summary>
+    /// Salt for <see cref="PinHash"/> as hex.
+    /// </summary>
+    public string PinSalt { get; set; } = "";
+
+    /// <summary>
+    /// Until when limits are lifted by a PIN override (UTC).
+    /// </summary>
+    public DateTime? OverrideUntil { get; set; }
+}
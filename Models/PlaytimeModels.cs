@@ -0,0 +1,58 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Playtime limit settings and today's usage.
+/// </summary>
+public class PlaytimeStatus
+{
+    public bool Enabled { get; set; }
+    public int DailyLimitMinutes { get; set; }
+    public string AllowedFrom { get; set; } = "";
+    public string AllowedUntil { get; set; } = "";
+    public int WarnMinutes { get; set; }
+
+    /// <summary>
+    /// Whether changing the limits needs the PIN.
+    /// </summary>
+    public bool PinSet { get; set; }
+
+    public int PlayedTodayMinutes { get; set; }
+
+    /// <summary>
+    /// Minutes left before a limit applies; <c>null</c> when limits are off, overridden or unbounded.
+    /// </summary>
+    public int? RemainingMinutes { get; set; }
+
+    public DateTime? OverrideUntil { get; set; }
+}
+
+/// <summary>
+/// New playtime limit settings; <see cref="Pin"/> must match when a PIN is set.
+/// </summary>
+public class PlaytimeLimitUpdate
+{
+    public bool Enabled { get; set; }
+    public int DailyLimitMinutes { get; set; }
+    public string AllowedFrom { get; set; } = "";
+    public string AllowedUntil { get; set; } = "";
+    public int WarnMinutes { get; set; } = 5;
+    public string? Pin { get; set; }
+}
+
+/// <summary>
+/// Sent when a playtime limit is close or reached.
+/// </summary>
+public class PlaytimeWarning
+{
+    /// <summary>
+    /// Which limit applies: "dailyLimit" or "allowedHours".
+    /// </summary>
+    public string Reason { get; set; } = "";
+
+    public int MinutesLeft { get; set; }
+
+    /// <summary>
+    /// Whether the game is being closed now.
+    /// </summary>
+    public bool Terminating { get; set; }
+}
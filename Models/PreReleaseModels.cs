@@ -0,0 +1,39 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Shown before the first pre-release launch: pre-release saves may not open in release again,
+/// so the player can back up release worlds first.
+/// </summary>
+public class PreReleaseNotice
+{
+    /// <summary>
+    /// Release instances that have worlds, i.e. what a backup would cover.
+    /// </summary>
+    public List<PreReleaseWorldSource> ReleaseInstances { get; set; } = new();
+}
+
+/// <summary>
+/// A release instance and how many worlds it holds.
+/// </summary>
+public class PreReleaseWorldSource
+{
+    public string InstanceId { get; set; } = "";
+    public string InstanceName { get; set; } = "";
+    public int WorldCount { get; set; }
+}
+
+/// <summary>
+/// Result of acknowledging the pre-release notice.
+/// </summary>
+public class PreReleaseAcknowledgeResult
+{
+    /// <summary>
+    /// Paths of the world backup archives that were written.
+    /// </summary>
+    public List<string> Backups { get; set; } = new();
+
+    /// <summary>
+    /// Instances whose worlds could not be backed up; the notice stays unacknowledged if any failed.
+    /// </summary>
+    public List<string> Errors { get; set; } = new();
+}
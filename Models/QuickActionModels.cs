@@ -0,0 +1,64 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Outcome of a quick action run from a hotkey, the automation API or the frontend.
+/// </summary>
+public class QuickActionResult
+{
+    public string Action { get; set; } = "";
+    public bool Success { get; set; }
+
+    /// <summary>
+    /// Why the action did nothing, e.g. the game is already running.
+    /// </summary>
+    public string? Message { get; set; }
+}
+
+/// <summary>
+/// A quick action and the global hotkey bound to it.
+/// </summary>
+public class QuickActionHotkey
+{
+    public string Action { get; set; } = "";
+
+    /// <summary>
+    /// Electron accelerator, e.g. <c>CommandOrControl+Alt+L</c>. Empty when unbound.
+    /// </summary>
+    public string Accelerator { get; set; } = "";
+
+    /// <summary>
+    /// Whether the hotkey is active; <c>false</c> for a bound hotkey means the OS or another app refused it.
+    /// </summary>
+    public bool Registered { get; set; }
+}
+
+/// <summary>
+/// What the launcher was asked to do on the command line, read by <c>LaunchArguments.Parse</c>.
+/// </summary>
+public class LaunchRequest
+{
+    /// <summary>
+    /// Instance to select: an instance ID, its name, or <c>branch/version</c> such as <c>release/3</c>.
+    /// </summary>
+    public string? Instance { get; set; }
+
+    /// <summary>
+    /// Profile to switch to: a profile ID or its name.
+    /// </summary>
+    public string? Profile { get; set; }
+
+    /// <summary>
+    /// Install if needed and launch the instance.
+    /// </summary>
+    public bool Launch { get; set; }
+
+    /// <summary>
+    /// Launch without opening the launcher window; the process exits when the game does.
+    /// </summary>
+    public bool Headless { get; set; }
+
+    /// <summary>
+    /// Whether the request asks for anything at all.
+    /// </summary>
+    public bool HasAction => Launch || Instance != null || Profile != null;
+}
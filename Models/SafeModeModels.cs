@@ -0,0 +1,42 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A launch with some or all mods of an instance temporarily disabled.
+/// Stored as <c>safe-mode.json</c> in the launcher data directory until the mods are enabled again,
+/// so they are restored even if the launcher is closed while the game runs.
+/// </summary>
+public class SafeModeSession
+{
+    public string InstanceId { get; set; } = "";
+
+    public string InstancePath { get; set; } = "";
+
+    /// <summary>
+    /// "safe-mode" when every mod was disabled, "subset" when some were kept enabled.
+    /// </summary>
+    public string Kind { get; set; } = "safe-mode";
+
+    public DateTime StartedAt { get; set; } = DateTime.UtcNow;
+
+    /// <summary>
+    /// Whether the game actually started; until then the mods are restored as soon as the launch ends.
+    /// </summary>
+    public bool Launched { get; set; }
+
+    /// <summary>
+    /// Mods that were enabled before the launch and were disabled for it.
+    /// </summary>
+    public List<SafeModeDisabledMod> DisabledMods { get; set; } = new();
+}
+
+public class SafeModeDisabledMod
+{
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// File name after disabling; used to find untracked local mods whose ID follows the file name.
+    /// </summary>
+    public string FileName { get; set; } = "";
+}
@@ -0,0 +1,59 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Kinds of <see cref="SearchResult"/>.
+/// </summary>
+public static class SearchResultKind
+{
+    public const string Instance = "instance";
+    public const string World = "world";
+    public const string InstalledMod = "installedMod";
+    public const string Mod = "mod";
+    public const string Setting = "setting";
+    public const string News = "news";
+}
+
+/// <summary>
+/// One hit of the launcher-wide search, carrying what the UI needs to open it.
+/// </summary>
+public class SearchResult
+{
+    /// <summary>
+    /// One of <see cref="SearchResultKind"/>.
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// Instance ID, world or mod ID, setting ID or news URL, depending on <see cref="Kind"/>.
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    public string Title { get; set; } = "";
+
+    /// <summary>
+    /// Translation key for the title of settings; <see cref="Title"/> holds the English text.
+    /// </summary>
+    public string? TitleKey { get; set; }
+
+    /// <summary>
+    /// Second line, e.g. the instance a world or mod belongs to.
+    /// </summary>
+    public string? Subtitle { get; set; }
+
+    /// <summary>
+    /// Instance of worlds and installed mods.
+    /// </summary>
+    public string? InstanceId { get; set; }
+
+    /// <summary>
+    /// Settings tab of settings; web page of news and CurseForge mods.
+    /// </summary>
+    public string? Target { get; set; }
+
+    public string? IconUrl { get; set; }
+
+    /// <summary>
+    /// Relevance; results are sorted by it, highest first.
+    /// </summary>
+    public double Score { get; set; }
+}
@@ -0,0 +1,22 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A skin a profile used before its saved skin was replaced.
+/// </summary>
+public class SkinHistoryEntry
+{
+    /// <summary>
+    /// Folder name of the entry under the profile's <c>SkinHistory</c> folder.
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// When the skin was replaced.
+    /// </summary>
+    public DateTime SavedAtUtc { get; set; }
+
+    /// <summary>
+    /// Avatar preview saved with the skin as a PNG data URL, or null if there was none.
+    /// </summary>
+    public string? Avatar { get; set; }
+}
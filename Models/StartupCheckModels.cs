@@ -0,0 +1,49 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Problems found with the folders the launcher writes to, checked on startup.
+/// </summary>
+public class StartupCheckReport
+{
+    public DateTime CheckedAt { get; set; } = DateTime.UtcNow;
+
+    public List<StartupCheckIssue> Issues { get; set; } = new();
+
+    /// <summary>
+    /// Whether an issue will make installs fail rather than just risk it.
+    /// </summary>
+    public bool HasErrors => Issues.Any(i => i.Severity == StartupCheckIssue.Error);
+}
+
+public class StartupCheckIssue
+{
+    public const string Error = "error";
+    public const string Warning = "warning";
+
+    /// <summary>
+    /// "notWritable", "readOnlyMount", "cloudSync", "cloudOnlyFiles", "lowSpace", "lowInodes" or "longPaths".
+    /// The frontend shows remediation guidance per code.
+    /// </summary>
+    public string Code { get; set; } = "";
+
+    /// <summary>
+    /// <see cref="Error"/> or <see cref="Warning"/>.
+    /// </summary>
+    public string Severity { get; set; } = Warning;
+
+    /// <summary>
+    /// The folder checked, or the volume for space and inode issues.
+    /// </summary>
+    public string Path { get; set; } = "";
+
+    /// <summary>
+    /// Technical detail for the log, e.g. the exception message.
+    /// </summary>
+    public string Detail { get; set; } = "";
+
+    /// <summary>
+    /// Free bytes or free inodes for "lowSpace" and "lowInodes", cloud-only files found for "cloudOnlyFiles",
+    /// the longest path found or expected for "longPaths".
+    /// </summary>
+    public long? Value { get; set; }
+}
@@ -0,0 +1,39 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// What the startup recovery found of the installs and downloads an earlier launcher run left unfinished.
+/// </summary>
+public class StartupRecoveryReport
+{
+    public DateTime CheckedAt { get; set; } = DateTime.UtcNow;
+
+    public List<RecoveredItem> Items { get; set; } = new();
+
+    /// <summary>
+    /// Disk space freed by removing leftovers.
+    /// </summary>
+    public long FreedBytes { get; set; }
+}
+
+public class RecoveredItem
+{
+    /// <summary>
+    /// "install" (interrupted first install), "update" (interrupted update of an installed game),
+    /// "staging" (Butler or Java runtime staging folder) or "download" (partial download).
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// "cleaned" when the leftover was removed, "resume" when it is kept so the next install continues
+    /// from it, "repair" when the instance needs a file repair.
+    /// </summary>
+    public string Action { get; set; } = "";
+
+    public string Path { get; set; } = "";
+
+    public string? InstanceId { get; set; }
+
+    public string? InstanceName { get; set; }
+
+    public long SizeBytes { get; set; }
+}
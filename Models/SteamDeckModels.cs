@@ -0,0 +1,57 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// What the launcher knows about running on a Steam Deck or inside Gamescope.
+/// </summary>
+public class SteamDeckStatus
+{
+    /// <summary>
+    /// Running on Steam Deck hardware, in Desktop or Game Mode.
+    /// </summary>
+    public bool IsSteamDeck { get; set; }
+
+    /// <summary>
+    /// Running inside a Gamescope session (Steam Deck Game Mode or Big Picture on a Gamescope desktop).
+    /// </summary>
+    public bool IsGamescope { get; set; }
+
+    /// <summary>
+    /// The <c>GamepadMode</c> setting: <c>auto</c>, <c>on</c> or <c>off</c>.
+    /// </summary>
+    public string GamepadModeSetting { get; set; } = "auto";
+
+    /// <summary>
+    /// Whether gamepad navigation is active, after resolving <c>auto</c>.
+    /// </summary>
+    public bool GamepadMode { get; set; }
+
+    /// <summary>
+    /// A Steam installation with at least one user was found, so a shortcut can be added.
+    /// </summary>
+    public bool SteamFound { get; set; }
+
+    /// <summary>
+    /// HyPrism is already a non-Steam game for at least one Steam user.
+    /// </summary>
+    public bool ShortcutAdded { get; set; }
+}
+
+/// <summary>
+/// Outcome of adding HyPrism to Steam as a non-Steam game.
+/// </summary>
+public class SteamShortcutResult
+{
+    public bool Success { get; set; }
+
+    /// <summary>
+    /// Steam users the shortcut was added for; users that already had it are not counted.
+    /// </summary>
+    public int UsersAdded { get; set; }
+
+    /// <summary>
+    /// Steam is running and only reads shortcuts on start, so it has to be restarted.
+    /// </summary>
+    public bool RestartSteam { get; set; }
+
+    public string? Error { get; set; }
+}
@@ -0,0 +1,45 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Snapshot of a background operation tracked by the task manager.
+/// </summary>
+public class BackgroundTaskInfo
+{
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// Operation type (e.g. "game-session", "mod-update-check", "instance-export").
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// Human-readable title shown in the task list.
+    /// </summary>
+    public string Title { get; set; } = "";
+
+    /// <summary>
+    /// One of: running, completed, failed, cancelled.
+    /// </summary>
+    public string State { get; set; } = "running";
+
+    /// <summary>
+    /// Progress percentage (0-100), or -1 when indeterminate.
+    /// </summary>
+    public double Progress { get; set; } = -1;
+
+    /// <summary>
+    /// Localization key describing the current step, if any.
+    /// </summary>
+    public string? MessageKey { get; set; }
+
+    public string? Error { get; set; }
+
+    /// <summary>
+    /// Whether <c>CancelTask</c> can stop this operation.
+    /// </summary>
+    public bool CanCancel { get; set; }
+
+    public DateTime StartedAt { get; set; } = DateTime.UtcNow;
+
+    public DateTime? FinishedAt { get; set; }
+}
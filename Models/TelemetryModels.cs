@@ -0,0 +1,65 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A single anonymous telemetry event. Events carry no paths, nicknames, UUIDs or free-form text.
+/// </summary>
+public class TelemetryEvent
+{
+    /// <summary>
+    /// Event name: "launcher_start", "game_launch" or "error".
+    /// </summary>
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// UTC time truncated to the hour.
+    /// </summary>
+    public DateTime Timestamp { get; set; }
+
+    /// <summary>
+    /// Error category for "error" events (e.g. "launch", or a message ID such as "errors.launchFailed").
+    /// </summary>
+    public string? Category { get; set; }
+}
+
+/// <summary>
+/// The exact payload POSTed to the telemetry endpoint.
+/// </summary>
+public class TelemetryBatch
+{
+    public int SchemaVersion { get; set; } = 1;
+
+    /// <summary>
+    /// Random ID generated when telemetry is enabled, unrelated to the player UUID.
+    /// A new one is generated every time telemetry is re-enabled.
+    /// </summary>
+    public string InstallId { get; set; } = "";
+
+    public string LauncherVersion { get; set; } = "";
+
+    /// <summary>
+    /// "windows", "linux" or "darwin".
+    /// </summary>
+    public string Os { get; set; } = "";
+
+    /// <summary>
+    /// OS architecture, "amd64" or "arm64".
+    /// </summary>
+    public string Arch { get; set; } = "";
+
+    public List<TelemetryEvent> Events { get; set; } = new();
+}
+
+/// <summary>
+/// Current telemetry settings and queue size.
+/// </summary>
+public class TelemetryStatus
+{
+    public bool Enabled { get; set; }
+
+    /// <summary>
+    /// Where batches are sent. Empty means events are only queued locally.
+    /// </summary>
+    public string Endpoint { get; set; } = "";
+
+    public int QueuedEvents { get; set; }
+}
@@ -0,0 +1,37 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A world or backup moved to the launcher's trash instead of being deleted. Entries are purged after
+/// <see cref="Config.TrashRetentionDays"/> days or when the trash is emptied.
+/// </summary>
+public class TrashEntry
+{
+    public const string World = "world";
+    public const string InstanceBackup = "instanceBackup";
+
+    /// <summary>
+    /// Folder name of the entry in the trash, used to restore or purge it.
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    /// <summary>
+    /// <see cref="World"/> or <see cref="InstanceBackup"/>.
+    /// </summary>
+    public string Kind { get; set; } = "";
+
+    /// <summary>
+    /// World folder or backup file name.
+    /// </summary>
+    public string Name { get; set; } = "";
+
+    public string InstanceId { get; set; } = "";
+
+    /// <summary>
+    /// Where the item was; it is restored there.
+    /// </summary>
+    public string OriginalPath { get; set; } = "";
+
+    public DateTime DeletedAt { get; set; }
+
+    public long SizeBytes { get; set; }
+}
@@ -0,0 +1,23 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// Last size and position of the launcher window, stored as <c>Window</c> in config.json.
+/// </summary>
+public class WindowState
+{
+    /// <summary>
+    /// Left edge of the window in screen coordinates; <c>null</c> centers the window.
+    /// </summary>
+    public int? X { get; set; }
+
+    public int? Y { get; set; }
+
+    public int Width { get; set; } = 1280;
+
+    public int Height { get; set; } = 800;
+
+    /// <summary>
+    /// Whether the window was maximized. <see cref="Width"/>/<see cref="Height"/> keep the size it restores to.
+    /// </summary>
+    public bool Maximized { get; set; }
+}
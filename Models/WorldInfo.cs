@@ -0,0 +1,36 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// A world (save folder in <c>UserData/Saves</c>) and the instance it belongs to.
+/// </summary>
+public class WorldInfo
+{
+    /// <summary>
+    /// Folder name of the world, unique within its instance.
+    /// </summary>
+    public string Name { get; set; } = "";
+
+    public string Path { get; set; } = "";
+
+    /// <summary>
+    /// <c>file://</c> URL of <c>preview.png</c>, if the world has one.
+    /// </summary>
+    public string? PreviewPath { get; set; }
+
+    public DateTime LastModified { get; set; }
+
+    public long SizeBytes { get; set; }
+
+    public string InstanceId { get; set; } = "";
+
+    public string InstanceName { get; set; } = "";
+
+    public string Branch { get; set; } = "";
+
+    public int Version { get; set; }
+
+    /// <summary>
+    /// Whether the mods the world was last played with were recorded.
+    /// </summary>
+    public bool HasModSnapshot { get; set; }
+}
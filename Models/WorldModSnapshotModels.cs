@@ -0,0 +1,82 @@
+namespace HyPrism.Models;
+
+/// <summary>
+/// The mods that were enabled the last time a world was played.
+/// Stored as <c>hyprism-mods.json</c> inside the world folder, so it travels with world backups.
+/// </summary>
+public class WorldModSnapshot
+{
+    public const string FileName = "hyprism-mods.json";
+
+    public DateTime PlayedAt { get; set; } = DateTime.UtcNow;
+
+    public List<WorldModEntry> Mods { get; set; } = new();
+}
+
+public class WorldModEntry
+{
+    /// <summary>
+    /// Manifest ID of the mod (<c>cf-123</c> for CurseForge mods, the file name for local ones).
+    /// </summary>
+    public string Id { get; set; } = "";
+
+    public string Name { get; set; } = "";
+
+    /// <summary>
+    /// CurseForge project ID; empty for local mods, which cannot be downloaded again.
+    /// </summary>
+    public string CurseForgeId { get; set; } = "";
+
+    public string FileId { get; set; } = "";
+
+    public string FileName { get; set; } = "";
+}
+
+/// <summary>
+/// How the current mods of an instance differ from the mods a world was last played with.
+/// </summary>
+public class WorldModSnapshotStatus
+{
+    public WorldModSnapshot Snapshot { get; set; } = new();
+
+    /// <summary>
+    /// Mods of the snapshot that are not installed, or installed but disabled.
+    /// </summary>
+    public List<WorldModEntry> Missing { get; set; } = new();
+
+    /// <summary>
+    /// Mods of the snapshot that are installed with a different file.
+    /// </summary>
+    public List<WorldModEntry> Changed { get; set; } = new();
+
+    /// <summary>
+    /// Enabled mods that are not in the snapshot; restoring disables them.
+    /// </summary>
+    public List<WorldModEntry> Extra { get; set; } = new();
+
+    /// <summary>
+    /// Whether the enabled mods already match the snapshot.
+    /// </summary>
+    public bool Matches => Missing.Count == 0 && Changed.Count == 0 && Extra.Count == 0;
+}
+
+public class WorldModRestoreResult
+{
+    public bool Success { get; set; }
+
+    public int Installed { get; set; }
+
+    public int Enabled { get; set; }
+
+    public int Disabled { get; set; }
+
+    /// <summary>
+    /// Names of the mods that could not be installed or toggled.
+    /// </summary>
+    public List<string> Failed { get; set; } = new();
+
+    /// <summary>
+    /// Translation key of the reason when nothing was restored.
+    /// </summary>
+    public string? MessageKey { get; set; }
+}
@@ -1,9 +1,14 @@
 ﻿using ElectronNET;
 using ElectronNET.API;
 using ElectronNET.API.Entities;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
 using HyPrism.Services.Core.Ipc;
+using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Mod;
 using HyPrism.Services.User;
 using Microsoft.Extensions.DependencyInjection;
 
@@ -17,12 +22,22 @@ class Program
 {
     static async Task Main(string[] args)
     {
+        if (LaunchArguments.IsHelp(args))
+        {
+            Console.WriteLine(LaunchArguments.Usage);
+            return;
+        }
+
+        // Read before Electron starts: a headless launch never opens a window
+        var launchRequest = LaunchArguments.Parse(args);
+
         // Memory optimization
         GCSettings.LargeObjectHeapCompactionMode = GCLargeObjectHeapCompactionMode.CompactOnce;
         GCSettings.LatencyMode = GCLatencyMode.Interactive;
 
         // Initialize Logger
         var appDir = UtilityService.GetEffectiveAppDir();
+        var appDirMigration = UtilityService.MigrateLegacyFlatpakAppDir(appDir);
         var logsDir = Path.Combine(appDir, "Logs");
         Directory.CreateDirectory(logsDir);
 
@@ -64,6 +79,17 @@ class Program
         Console.SetOut(new ElectronLogInterceptor(originalOut, isError: false));
         Console.SetError(new ElectronLogInterceptor(originalErr, isError: true));
 
+        // Only one launcher may run per data directory; a second invocation
+        // hands its arguments to the running instance and exits.
+        if (!SingleInstanceGuard.TryAcquire(appDir))
+        {
+            Logger.Info("Boot", "HyPrism is already running, forwarding arguments to the existing instance");
+            await SingleInstanceGuard.ForwardToPrimaryAsync(args);
+            Log.CloseAndFlush();
+            return;
+        }
+        SingleInstanceGuard.StartListening();
+
         // Now safe to access the runtime controller
         var runtimeController = ElectronNetRuntime.RuntimeController;
 
@@ -71,6 +97,7 @@ class Program
         {
             Logger.Info("Boot", "Starting HyPrism (Electron.NET)...");
             Logger.Info("Boot", $"App Directory: {appDir}");
+            if (appDirMigration != null) Logger.Info("Boot", appDirMigration);
 
             // Initialize DI container
             var services = Bootstrapper.Initialize();
@@ -78,6 +105,16 @@ class Program
             // Perform async initialization (fetch CurseForge key if needed)
             await Bootstrapper.InitializeAsync(services);
 
+            if (launchRequest?.Headless == true)
+            {
+                Logger.Info("Boot", "Headless launch, the launcher window will not open");
+                SingleInstanceGuard.ArgumentsReceived += (_) =>
+                    Logger.Warning("Boot", "HyPrism is running a headless launch; open it again after the game exits");
+                await PrepareDataAsync(services);
+                Environment.ExitCode = await services.GetRequiredService<IHeadlessLaunchService>().RunAsync(launchRequest);
+                return;
+            }
+
             // Start Electron runtime and wait for socket bridge
             Logger.Info("Boot", "Starting Electron runtime...");
             await runtimeController.Start();
@@ -85,7 +122,7 @@ class Program
             Logger.Info("Boot", "Electron runtime ready");
 
             // Create window & register IPC
-            await ElectronBootstrap(services);
+            await ElectronBootstrap(services, launchRequest);
 
             // Keep alive until Electron quits
             await runtimeController.WaitStoppedTask;
@@ -103,11 +140,38 @@ class Program
         }
         finally
         {
+            SingleInstanceGuard.Release();
             Log.CloseAndFlush();
         }
     }
 
-    private static async Task ElectronBootstrap(IServiceProvider services)
+    /// <summary>
+    /// Migrates and repairs launcher data left by older versions or an interrupted run.
+    /// Needed before anything installs or launches, with or without a window.
+    /// </summary>
+    private static async Task PrepareDataAsync(IServiceProvider services)
+    {
+        // Read-only, cloud-synced or full data folders make installs fail later with obscure errors
+        services.GetRequiredService<IStartupCheckService>().Run();
+
+        // Run instance migrations
+        var instanceService = services.GetRequiredService<IInstanceService>();
+        instanceService.MigrateLegacyData();
+        instanceService.MigrateVersionFoldersToIdFolders();
+
+        // Clean up or keep for resuming what an install interrupted by a crash or power loss left behind
+        services.GetRequiredService<IStartupRecoveryService>().Recover();
+
+        // Repair legacy profile mods symlink/junction if present and ensure
+        // mods are stored in instance-local UserData/Mods.
+        var profileManagementService = services.GetRequiredService<IProfileManagementService>();
+        profileManagementService.InitializeProfileModsSymlink();
+
+        // Enable mods left disabled by a safe mode launch the launcher did not see end
+        await services.GetRequiredService<ISafeModeService>().RestorePendingAsync();
+    }
+
+    private static async Task ElectronBootstrap(IServiceProvider services, LaunchRequest? launchRequest)
     {
         var wwwroot = Path.Combine(AppDomain.CurrentDomain.BaseDirectory, "wwwroot");
 
@@ -133,15 +197,18 @@ class Program
         var ipcService = services.GetRequiredService<IpcService>();
         ipcService.RegisterAll();
 
-        // Run instance migrations
-        var instanceService = services.GetRequiredService<IInstanceService>();
-        instanceService.MigrateLegacyData();
-        instanceService.MigrateVersionFoldersToIdFolders();
+        await PrepareDataAsync(services);
 
-        // Repair legacy profile mods symlink/junction if present and ensure
-        // mods are stored in instance-local UserData/Mods.
-        var profileManagementService = services.GetRequiredService<IProfileManagementService>();
-        profileManagementService.InitializeProfileModsSymlink();
+        // Opt-in only; a no-op unless the user enabled telemetry
+        services.GetRequiredService<ITelemetryService>().RecordLauncherStart();
+
+        // Also opt-in; sends crashes saved during the previous run
+        var errorReporting = services.GetRequiredService<IErrorReportingService>();
+        errorReporting.CaptureUnhandledExceptions();
+        _ = errorReporting.FlushAsync();
+
+        // Local automation API; a no-op unless the user enabled it
+        services.GetRequiredService<IAutomationApiService>().Start();
 
         // Resolve icon path for the window
         // On Windows/Linux, BrowserWindowOptions.Icon sets the window icon.
@@ -151,23 +218,35 @@ class Program
 
         #pragma warning disable 
 
+        var windowOptions = new BrowserWindowOptions
+        {
+            Width = 1280,
+            Height = 800,
+            MinWidth = 1024,
+            MinHeight = 700,
+            Frame = true,
+            Show = false,
+            Center = true,
+            Title = "HyPrism",
+            AutoHideMenuBar = true,
+            BackgroundColor = "#0D0D10",
+            Icon = iconPath ?? string.Empty
+        };
+
+        // Reopen where the user left the window; centered on first start or if that spot is off-screen
+        var windowState = services.GetRequiredService<IWindowStateService>();
+        var restoredPosition = await windowState.ApplySavedBoundsAsync(windowOptions);
+
+        // Gamescope only shows fullscreen windows well; keep the launcher open while the game runs there
+        var steamDeck = services.GetRequiredService<ISteamDeckService>();
+        steamDeck.ApplyDefaults();
+        if (steamDeck.IsGamescope) windowOptions.Fullscreen = true;
+
         var mainWindow = await Electron.WindowManager.CreateWindowAsync(
-            new BrowserWindowOptions
-            {
-                Width = 1280,
-                Height = 800,
-                MinWidth = 1024,
-                MinHeight = 700,
-                Frame = true,
-                Show = false,
-                Center = true,
-                Title = "HyPrism",
-                AutoHideMenuBar = true,
-                BackgroundColor = "#0D0D10",
-                Icon = iconPath ?? string.Empty
-            },
+            windowOptions,
             $"file://{Path.Combine(wwwroot, "index.html")}"
         );
+        windowState.Attach(mainWindow);
         
         #pragma warning restore
 
@@ -239,21 +318,34 @@ class Program
         {
             Electron.Menu.SetApplicationMenu([]);
         }
+        // Quick action hotkeys; they work while the window is hidden, so register them with the app, not the window
+        services.GetRequiredService<IGlobalHotkeyService>().RegisterAll();
+
         // Quit when all windows closed
         Electron.App.WindowAllClosed += () => Electron.App.Quit();
 
+        // Started from a desktop shortcut or with CLI flags: act once the UI can show progress
+        var pendingRequest = launchRequest;
+
         // Show after ready
         mainWindow.OnReadyToShow += () =>
         {
             try
             {
-                mainWindow.Center();
+                if (!restoredPosition) mainWindow.Center();
             }
             catch (Exception ex)
             {
                 Logger.Warning("Boot", $"Failed to center window on startup: {ex.Message}");
             }
             mainWindow.Show();
+
+            if (pendingRequest != null)
+            {
+                var request = pendingRequest;
+                pendingRequest = null;
+                services.GetRequiredService<IQuickActionService>().ApplyLaunchRequest(request);
+            }
         };
 
         Logger.Success("Boot", "Electron window created, IPC handlers registered");
@@ -0,0 +1,180 @@
+using System.Collections.Concurrent;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Launch;
+using HyPrism.Services.Game.Mod;
+using HyPrism.Services.Game.Version;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Builds <see cref="DashboardInfo"/> for the home screen in place of separate startup calls.
+/// </summary>
+/// <remarks>
+/// Network-backed parts (versions, news, announcements, mod updates) each get <see cref="PartTimeout"/>.
+/// Versions come from the last known list, which the version service refreshes in the background. A mod update check that runs past the timeout keeps
+/// going and its count is returned on the next call; counts are reused for <see cref="ModUpdateCacheDuration"/>.
+/// </remarks>
+public class DashboardService : IDashboardService
+{
+    private static readonly string[] DashboardBranches = ["release", "pre-release"];
+    private static readonly TimeSpan PartTimeout = TimeSpan.FromSeconds(5);
+    private static readonly TimeSpan ModUpdateCacheDuration = TimeSpan.FromMinutes(30);
+    private const int NewsCount = 5;
+
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IInstanceActivityService _activityService;
+    private readonly IVersionService _versionService;
+    private readonly IModService _modService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly INewsService _newsService;
+    private readonly IAnnouncementService _announcementService;
+    private readonly ConcurrentDictionary<string, (DateTime CheckedAt, int Count)> _modUpdates = new();
+    private readonly ConcurrentDictionary<string, Task<int>> _modUpdateChecks = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="DashboardService"/> class.
+    /// </summary>
+    /// <param name="configService">Provides the selected instance and the instance list.</param>
+    /// <param name="instanceService">Resolves instance folders and install state.</param>
+    /// <param name="activityService">Provides the last played instance.</param>
+    /// <param name="versionService">Provides the available versions per branch.</param>
+    /// <param name="modService">Checks the selected instance's mods for updates.</param>
+    /// <param name="gameProcessService">Tells whether the game is running.</param>
+    /// <param name="newsService">Provides the cached news feed.</param>
+    /// <param name="announcementService">Provides the active announcements.</param>
+    public DashboardService(IConfigService configService, IInstanceService instanceService, IInstanceActivityService activityService,
+        IVersionService versionService, IModService modService, IGameProcessService gameProcessService,
+        INewsService newsService, IAnnouncementService announcementService)
+    {
+        _configService = configService;
+        _instanceService = instanceService;
+        _activityService = activityService;
+        _versionService = versionService;
+        _modService = modService;
+        _gameProcessService = gameProcessService;
+        _newsService = newsService;
+        _announcementService = announcementService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<DashboardInfo> GetDashboardAsync(CancellationToken ct = default)
+    {
+        var selected = GetSelectedInstance();
+        var modUpdates = selected is { IsInstalled: true }
+            ? WithTimeout(GetPendingModUpdatesAsync(selected.Id), null, "mod updates", ct)
+            : Task.FromResult<int?>(null);
+        var branches = Task.WhenAll(DashboardBranches.Select(b => GetBranchAsync(b, ct)));
+        var news = WithTimeout(GetNewsAsync(), [], "news", ct);
+        var announcements = WithTimeout(_announcementService.GetActiveAnnouncementsAsync(), [], "announcements", ct);
+
+        var dashboard = new DashboardInfo
+        {
+            SelectedInstance = selected,
+            IsGameRunning = _gameProcessService.IsGameRunning()
+        };
+        try
+        {
+            dashboard.LastPlayed = _activityService.GetRecentInstances(1).FirstOrDefault();
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Dashboard", $"Could not read the launch history: {ex.Message}");
+        }
+
+        if (selected != null) selected.PendingModUpdates = await modUpdates;
+        dashboard.Branches = (await branches).ToList();
+        dashboard.News = await news;
+        dashboard.Announcements = await announcements;
+        return dashboard;
+    }
+
+    private DashboardInstance? GetSelectedInstance()
+    {
+        var instanceId = _configService.Configuration.SelectedInstanceId;
+        var instance = string.IsNullOrEmpty(instanceId) ? null : _instanceService.FindInstanceById(instanceId);
+        if (instance == null) return null;
+
+        var instancePath = _instanceService.GetInstancePathById(instance.Id);
+        return new DashboardInstance
+        {
+            Id = instance.Id,
+            Name = instance.Name,
+            Branch = instance.Branch,
+            Version = instance.Version,
+            IsInstalled = !string.IsNullOrEmpty(instancePath) && _instanceService.IsClientPresent(instancePath)
+        };
+    }
+
+    private async Task<DashboardBranch> GetBranchAsync(string branch, CancellationToken ct)
+    {
+        var installed = (_configService.Configuration.Instances ?? [])
+            .Where(i => i.Branch == branch && i.IsInstalled && i.Version > 0)
+            .Select(i => (int?)i.Version)
+            .Max();
+
+        if (!_versionService.TryGetLastKnownVersions(branch, out var versions))
+            versions = await WithTimeout(_versionService.GetVersionListAsync(branch, ct), [], $"{branch} versions", ct);
+
+        return new DashboardBranch
+        {
+            Branch = branch,
+            LatestVersion = versions.Count > 0 ? versions.Max() : null,
+            InstalledVersion = installed
+        };
+    }
+
+    private async Task<int?> GetPendingModUpdatesAsync(string instanceId)
+    {
+        if (_modUpdates.TryGetValue(instanceId, out var cached) && DateTime.UtcNow - cached.CheckedAt < ModUpdateCacheDuration)
+            return cached.Count;
+
+        // No automatic update checks on a metered connection; the last result is still shown
+        if (_configService.Configuration.MeteredConnection)
+            return _modUpdates.TryGetValue(instanceId, out cached) ? cached.Count : null;
+
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath)) return null;
+
+        // One check per instance at a time; a check that outlives the timeout fills the cache for the next call
+        var check = _modUpdateChecks.GetOrAdd(instanceId, id => Task.Run(async () =>
+        {
+            try
+            {
+                var count = (await _modService.CheckInstanceModUpdatesAsync(instancePath)).Count;
+                _modUpdates[instanceId] = (DateTime.UtcNow, count);
+                return count;
+            }
+            finally
+            {
+                _modUpdateChecks.TryRemove(instanceId, out _);
+            }
+        }));
+        return await check;
+    }
+
+    private async Task<List<NewsItemResponse>> GetNewsAsync() =>
+        (await _newsService.GetNewsFeedAsync(NewsCount)).Items;
+
+    /// <summary>
+    /// Waits at most <see cref="PartTimeout"/> for a part and falls back to <paramref name="fallback"/> after that.
+    /// </summary>
+    private static async Task<T> WithTimeout<T>(Task<T> task, T fallback, string part, CancellationToken ct)
+    {
+        try
+        {
+            var finished = await Task.WhenAny(task, Task.Delay(PartTimeout, ct));
+            if (finished == task) return await task;
+            Logger.Warning("Dashboard", $"Loading {part} took too long; left out");
+        }
+        catch (OperationCanceledException) { /* request was cancelled */ }
+        catch (Exception ex)
+        {
+            Logger.Warning("Dashboard", $"Could not load {part}: {ex.Message}");
+        }
+        return fallback;
+    }
+}
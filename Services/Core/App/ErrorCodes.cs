@@ -0,0 +1,168 @@
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Stable machine-readable codes sent with backend errors, each with a remediation hint.
+/// </summary>
+/// <remarks>
+/// Codes never change once released, so the frontend, bug reports and the troubleshooting guide
+/// (Docs/English/User/Troubleshooting.md, one section per code) can rely on them. Messages reported
+/// by ID get the code of that ID; exceptions are classified by <see cref="Describe"/>.
+/// </remarks>
+public static class ErrorCodes
+{
+    public const string PatchNotFound = "E_PATCH_404";
+    public const string DiskFull = "E_DISK_FULL";
+    public const string AccessDenied = "E_ACCESS_DENIED";
+    public const string AntivirusBlocked = "E_AV_BLOCKED";
+    public const string JavaMissing = "E_JAVA_MISSING";
+    public const string ClientMissing = "E_CLIENT_MISSING";
+    public const string FilesAltered = "E_FILES_ALTERED";
+    public const string InstallTimedOut = "E_INSTALL_TIMEOUT";
+    public const string ArchUnsupported = "E_ARCH_UNSUPPORTED";
+    public const string NoVersions = "E_NO_VERSIONS";
+    public const string NetworkOffline = "E_NETWORK_OFFLINE";
+    public const string CaptivePortal = "E_CAPTIVE_PORTAL";
+    public const string HostBlocked = "E_HOST_BLOCKED";
+    public const string InstanceLocked = "E_INSTANCE_LOCKED";
+    public const string InvalidNickname = "E_INVALID_NICKNAME";
+    public const string StartFailed = "E_START_FAILED";
+    public const string LaunchFailed = "E_LAUNCH_FAILED";
+    public const string Fatal = "E_FATAL";
+
+    private const string TroubleshootingUrl = "https://github.com/yyyumeniku/HyPrism/blob/main/Docs/English/User/Troubleshooting.md";
+
+    // Windows ERROR_HANDLE_DISK_FULL / ERROR_DISK_FULL as HRESULTs, and ENOSPC, which .NET uses as the HResult on Unix
+    private static readonly int[] DiskFullResults = [unchecked((int)0x80070027), unchecked((int)0x80070070), 28];
+
+    // Windows ERROR_VIRUS_INFECTED / ERROR_VIRUS_DELETED as HRESULTs, returned when Defender blocks or removes a file being opened
+    private static readonly int[] VirusResults = [unchecked((int)0x800700E1), unchecked((int)0x800700E2)];
+
+    private static readonly Dictionary<string, string> CodeByMessageKey = new()
+    {
+        [MessageCatalog.ErrorFatal] = Fatal,
+        [MessageCatalog.ErrorLaunchFailed] = LaunchFailed,
+        [MessageCatalog.ErrorStartFailed] = StartFailed,
+        [MessageCatalog.ErrorNoVersions] = NoVersions,
+        [MessageCatalog.ErrorInvalidNickname] = InvalidNickname,
+        [MessageCatalog.ErrorNicknameCharacters] = InvalidNickname,
+        [MessageCatalog.ErrorNicknameReserved] = InvalidNickname,
+        [MessageCatalog.ErrorDirectoryNotWritable] = AccessDenied,
+        [MessageCatalog.ErrorAccessDenied] = AccessDenied,
+        [MessageCatalog.ErrorAntivirusBlocked] = AntivirusBlocked,
+        [MessageCatalog.ErrorNetworkOffline] = NetworkOffline,
+        [MessageCatalog.ErrorCaptivePortal] = CaptivePortal,
+        [MessageCatalog.ErrorCdnBlocked] = HostBlocked,
+        [MessageCatalog.ErrorInstanceRunning] = InstanceLocked,
+        [MessageCatalog.ErrorInstanceInstalling] = InstanceLocked,
+        [MessageCatalog.ErrorInstanceModifying] = InstanceLocked,
+        [MessageCatalog.ErrorInstanceBackingUp] = InstanceLocked,
+        [MessageCatalog.ErrorPatchNotFound] = PatchNotFound,
+        [MessageCatalog.ErrorDiskFull] = DiskFull,
+        [MessageCatalog.ErrorJavaMissing] = JavaMissing,
+        [MessageCatalog.ErrorClientMissing] = ClientMissing,
+        [MessageCatalog.ErrorFilesAltered] = FilesAltered,
+        [MessageCatalog.ErrorInstallTimedOut] = InstallTimedOut,
+        [MessageCatalog.ErrorArchUnsupported] = ArchUnsupported,
+    };
+
+    private static readonly Dictionary<string, string> HintByCode = new()
+    {
+        [PatchNotFound] = MessageCatalog.HintPatchNotFound,
+        [DiskFull] = MessageCatalog.HintDiskFull,
+        [AccessDenied] = MessageCatalog.HintAccessDenied,
+        [AntivirusBlocked] = MessageCatalog.HintAntivirusBlocked,
+        [JavaMissing] = MessageCatalog.HintJavaMissing,
+        [ClientMissing] = MessageCatalog.HintClientMissing,
+        [FilesAltered] = MessageCatalog.HintFilesAltered,
+        [InstallTimedOut] = MessageCatalog.HintInstallTimedOut,
+        [ArchUnsupported] = MessageCatalog.HintArchUnsupported,
+        [NoVersions] = MessageCatalog.HintNoVersions,
+        [NetworkOffline] = MessageCatalog.HintNetworkOffline,
+        [CaptivePortal] = MessageCatalog.HintCaptivePortal,
+        [HostBlocked] = MessageCatalog.HintHostBlocked,
+        [InstanceLocked] = MessageCatalog.HintInstanceLocked,
+        [StartFailed] = MessageCatalog.HintStartFailed,
+        [LaunchFailed] = MessageCatalog.HintLaunchFailed,
+        [Fatal] = MessageCatalog.HintFatal,
+    };
+
+    /// <summary>
+    /// Gets the code of a message ID.
+    /// </summary>
+    /// <returns>The code, or <c>null</c> for messages that have none.</returns>
+    public static string? ForMessageKey(string messageKey) =>
+        CodeByMessageKey.GetValueOrDefault(messageKey);
+
+    /// <summary>
+    /// Gets the message ID of the remediation hint for a code.
+    /// </summary>
+    /// <returns>The hint ID, or <c>null</c> if the message explains itself.</returns>
+    public static string? GetHintKey(string code) => HintByCode.GetValueOrDefault(code);
+
+    /// <summary>
+    /// Gets the troubleshooting guide section for a code.
+    /// </summary>
+    public static string GetHelpUrl(string code) => $"{TroubleshootingUrl}#{code.ToLowerInvariant()}";
+
+    /// <summary>
+    /// Finds a known cause in an exception or its inner exceptions.
+    /// </summary>
+    /// <returns>The code, message ID and parameters of the cause, or <c>null</c> if none is known.</returns>
+    public static (string Code, string MessageKey, object[]? Args)? Describe(Exception ex)
+    {
+        for (var current = ex; current != null; current = current.InnerException)
+        {
+            switch (current)
+            {
+                case LauncherException launcher:
+                    return (launcher.Code, launcher.MessageKey, launcher.Args);
+                case IOException io when IsDiskFull(io):
+                    return (DiskFull, MessageCatalog.ErrorDiskFull, null);
+                case IOException io when VirusResults.Contains(io.HResult):
+                    return (AntivirusBlocked, MessageCatalog.ErrorAntivirusBlocked, null);
+                case UnauthorizedAccessException:
+                    return (AccessDenied, MessageCatalog.ErrorAccessDenied, null);
+                case AggregateException { InnerExceptions.Count: > 0 } aggregate:
+                    var inner = aggregate.InnerExceptions.Select(Describe).FirstOrDefault(d => d != null);
+                    if (inner != null) return inner;
+                    break;
+            }
+
+            // Tools such as butler only pass on the OS message
+            if (current.Message.Contains("No space left on device", StringComparison.OrdinalIgnoreCase)
+                || current.Message.Contains("not enough space on the disk", StringComparison.OrdinalIgnoreCase))
+                return (DiskFull, MessageCatalog.ErrorDiskFull, null);
+            if (current.Message.Contains("contains a virus", StringComparison.OrdinalIgnoreCase)
+                || current.Message.Contains("potentially unwanted software", StringComparison.OrdinalIgnoreCase))
+                return (AntivirusBlocked, MessageCatalog.ErrorAntivirusBlocked, null);
+        }
+
+        return null;
+    }
+
+    private static bool IsDiskFull(IOException ex) => DiskFullResults.Contains(ex.HResult);
+}
+
+/// <summary>
+/// A failure with a known cause, reported to the user by <see cref="ErrorCodes"/> code and message ID
+/// instead of its (English, technical) exception message.
+/// </summary>
+public class LauncherException : Exception
+{
+    public string Code { get; }
+    public string MessageKey { get; }
+    public object[]? Args { get; }
+
+    /// <param name="code">One of the <see cref="ErrorCodes"/> constants.</param>
+    /// <param name="messageKey">The <see cref="MessageCatalog"/> message ID shown to the user.</param>
+    /// <param name="args">Positional parameters for the message.</param>
+    /// <param name="message">Technical description for logs.</param>
+    /// <param name="innerException">The underlying failure, if any.</param>
+    public LauncherException(string code, string messageKey, object[]? args, string message, Exception? innerException = null)
+        : base(message, innerException)
+    {
+        Code = code;
+        MessageKey = messageKey;
+        Args = args;
+    }
+}
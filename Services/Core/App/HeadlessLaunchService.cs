@@ -0,0 +1,124 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game;
+using HyPrism.Services.Game.Launch;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Launches the game from the command line without starting Electron.
+/// </summary>
+/// <remarks>
+/// Goes through the same install and launch session as the Play button; only the window is missing, so
+/// progress and errors are logged instead of shown. The process keeps the single-instance lock until the
+/// game exits, because skin backup and playtime tracking run when it does.
+/// </remarks>
+public class HeadlessLaunchService : IHeadlessLaunchService
+{
+    private readonly IQuickActionService _quickActionService;
+    private readonly IGameSessionService _gameSessionService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly IProgressNotificationService _progressService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="HeadlessLaunchService"/> class.
+    /// </summary>
+    /// <param name="quickActionService">Selects the requested instance and profile.</param>
+    /// <param name="gameSessionService">Installs and launches the selected instance.</param>
+    /// <param name="gameProcessService">Tells whether the game is running.</param>
+    /// <param name="progressService">Reports install progress, errors and game start and exit.</param>
+    public HeadlessLaunchService(
+        IQuickActionService quickActionService,
+        IGameSessionService gameSessionService,
+        IGameProcessService gameProcessService,
+        IProgressNotificationService progressService)
+    {
+        _quickActionService = quickActionService;
+        _gameSessionService = gameSessionService;
+        _gameProcessService = gameProcessService;
+        _progressService = progressService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<int> RunAsync(LaunchRequest request)
+    {
+        // Select only; the launch below is awaited instead of fired off
+        var selected = _quickActionService.ApplyLaunchRequest(new LaunchRequest
+        {
+            Instance = request.Instance,
+            Profile = request.Profile
+        });
+        if (!selected.Success)
+        {
+            Logger.Error("Headless", selected.Message ?? "Could not apply the launch request");
+            return 1;
+        }
+        if (_gameProcessService.IsGameRunning())
+        {
+            Logger.Error("Headless", "The game is already running");
+            return 1;
+        }
+
+        var started = false;
+        var exited = new TaskCompletionSource(TaskCreationOptions.RunContinuationsAsynchronously);
+        var lastLogged = (State: "", Step: -1);
+
+        void OnProgress(ProgressUpdateMessage msg)
+        {
+            // One line per 10% is plenty for a terminal
+            var step = (int)msg.Progress / 10;
+            if (msg.State == lastLogged.State && step == lastLogged.Step) return;
+            lastLogged = (msg.State, step);
+            Logger.Info("Headless", $"{msg.State}: {(int)msg.Progress}%");
+        }
+
+        void OnError(GameErrorMessage error) =>
+            Logger.Error("Headless", string.IsNullOrEmpty(error.Technical) ? error.Message : $"{error.Message} ({error.Technical})");
+
+        void OnGameState(string state, int _)
+        {
+            if (state == "started")
+            {
+                started = true;
+                Logger.Success("Headless", "Game started, waiting for it to exit");
+            }
+            else if (state == "stopped")
+            {
+                exited.TrySetResult();
+            }
+        }
+
+        _progressService.DownloadProgressChanged += OnProgress;
+        _progressService.ErrorOccurred += OnError;
+        _progressService.GameStateChanged += OnGameState;
+        try
+        {
+            var result = await _gameSessionService.DownloadAndLaunchAsync();
+            if (!result.Success)
+            {
+                Logger.Error("Headless", result.Cancelled ? "Launch cancelled" : $"Launch failed: {result.Error ?? "unknown error"}");
+                return 1;
+            }
+            if (!started && !_gameProcessService.IsGameRunning())
+            {
+                Logger.Error("Headless", "The game did not start");
+                return 1;
+            }
+
+            await exited.Task;
+            Logger.Info("Headless", "Game exited");
+            return 0;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Headless", $"Launch failed: {ex.Message}");
+            return 1;
+        }
+        finally
+        {
+            _progressService.DownloadProgressChanged -= OnProgress;
+            _progressService.ErrorOccurred -= OnError;
+            _progressService.GameStateChanged -= OnGameState;
+        }
+    }
+}
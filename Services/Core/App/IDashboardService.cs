@@ -0,0 +1,16 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Gathers the home screen's data in one call.
+/// </summary>
+public interface IDashboardService
+{
+    /// <summary>
+    /// Loads install state, versions, mod updates, last played instance, news and announcements in parallel.
+    /// Parts that take too long are left empty instead of holding up the rest.
+    /// </summary>
+    /// <param name="ct">Cancels the request.</param>
+    Task<DashboardInfo> GetDashboardAsync(CancellationToken ct = default);
+}
@@ -0,0 +1,17 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Runs a <c>--launch --headless</c> request without the launcher window.
+/// </summary>
+public interface IHeadlessLaunchService
+{
+    /// <summary>
+    /// Applies the request, installs the instance if needed and launches it, writing progress to the log and
+    /// console, then waits until the game exits so the usual after-game cleanup runs.
+    /// </summary>
+    /// <param name="request">The launch request; <see cref="LaunchRequest.Launch"/> is implied.</param>
+    /// <returns>The process exit code: 0 once the game has run and exited, 1 if it could not be launched.</returns>
+    Task<int> RunAsync(LaunchRequest request);
+}
@@ -0,0 +1,31 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Backend for the first-run setup flow: reports whether setup is needed, suggests
+/// install locations, and persists the user's choices in a single config write.
+/// </summary>
+public interface IOnboardingService
+{
+    /// <summary>
+    /// Gets the first-run flag together with the values the setup flow should prefill.
+    /// </summary>
+    /// <returns>The current onboarding state.</returns>
+    OnboardingState GetState();
+
+    /// <summary>
+    /// Lists candidate directories for game instances with free space on each volume.
+    /// The default location is always included; exactly one entry is marked recommended.
+    /// </summary>
+    /// <returns>The candidate locations, default first.</returns>
+    List<InstallLocation> GetRecommendedInstallLocations();
+
+    /// <summary>
+    /// Validates the submitted choices, applies them and marks onboarding as completed.
+    /// Nothing is written unless every choice is valid.
+    /// </summary>
+    /// <param name="request">The choices made in the setup flow.</param>
+    /// <returns>The outcome, with a message ID when a choice was rejected.</returns>
+    OnboardingResult Complete(OnboardingRequest request);
+}
@@ -1,4 +1,5 @@
 using HyPrism.Models;
+using HyPrism.Services.Game.Download;
 
 namespace HyPrism.Services.Core.App;
 
@@ -21,7 +22,20 @@ public interface IProgressNotificationService
     /// <summary>
     /// Raised when an error occurs during game operations.
     /// </summary>
-    event Action<string, string, string?>? ErrorOccurred;
+    event Action<GameErrorMessage>? ErrorOccurred;
+    
+    /// <summary>
+    /// Raised when an operation started with <see cref="BeginOperation"/> reports progress or finishes.
+    /// </summary>
+    event Action<OperationProgress>? OperationProgressChanged;
+    
+    /// <summary>
+    /// Starts a download or install that reports on the shared progress channel. The caller finishes it
+    /// through the returned reporter; one disposed unfinished counts as failed.
+    /// </summary>
+    /// <param name="kind">"game", "jre", "butler", "mod" or "launcher-update".</param>
+    /// <param name="operationId">ID to use, e.g. the background task ID; a new one is generated if omitted.</param>
+    OperationProgressReporter BeginOperation(string kind, string? operationId = null);
     
     /// <summary>
     /// Reports download or update progress to subscribed listeners.
@@ -33,6 +47,16 @@ public interface IProgressNotificationService
     /// <param name="downloaded">The number of bytes downloaded so far.</param>
     /// <param name="total">The total number of bytes to download.</param>
     void ReportDownloadProgress(string stage, int progress, string messageKey, object[]? args = null, long downloaded = 0, long total = 0);
+
+    /// <summary>
+    /// Reports download progress with the speed and time left measured by <see cref="IDownloadService"/>.
+    /// </summary>
+    /// <param name="stage">The current operation stage identifier.</param>
+    /// <param name="progress">The progress percentage of the whole operation (0-100).</param>
+    /// <param name="messageKey">The localization key for the status message.</param>
+    /// <param name="args">Optional format arguments for the message.</param>
+    /// <param name="download">Bytes, speed and time left of the running download.</param>
+    void ReportDownloadProgress(string stage, int progress, string messageKey, object[]? args, TransferProgress download);
     
     /// <summary>
     /// Reports a game state change to subscribed listeners.
@@ -48,4 +72,23 @@ public interface IProgressNotificationService
     /// <param name="message">The user-friendly error message.</param>
     /// <param name="technical">Optional technical details for debugging purposes.</param>
     void ReportError(string type, string message, string? technical = null);
+
+    /// <summary>
+    /// Reports an error using a message ID from <see cref="MessageCatalog"/>, so the frontend
+    /// can show it in the user's language.
+    /// </summary>
+    /// <param name="type">The error type category (e.g., "download", "launch", "patch").</param>
+    /// <param name="messageKey">The message ID.</param>
+    /// <param name="args">Optional positional parameters for the message.</param>
+    /// <param name="technical">Optional technical details for debugging purposes.</param>
+    void ReportLocalizedError(string type, string messageKey, object[]? args = null, string? technical = null);
+
+    /// <summary>
+    /// Reports a failed operation. A known cause (see <see cref="ErrorCodes.Describe"/>) is reported
+    /// with its own code and message, anything else with <paramref name="fallbackMessageKey"/>.
+    /// </summary>
+    /// <param name="type">The error type category (e.g., "download", "launch", "patch").</param>
+    /// <param name="fallbackMessageKey">The message ID used when the cause is unknown.</param>
+    /// <param name="ex">The failure; its details are sent as technical information.</param>
+    void ReportFailure(string type, string fallbackMessageKey, Exception ex);
 }
@@ -0,0 +1,30 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Runs the quick actions listed in <see cref="QuickActions"/>, so hotkeys, Stream Deck buttons and
+/// the launcher UI all behave the same, even while the window is hidden.
+/// </summary>
+public interface IQuickActionService
+{
+    /// <summary>
+    /// Raised after <see cref="QuickActions.ToggleMusic"/> with the new music-enabled state.
+    /// </summary>
+    event Action<bool>? MusicToggled;
+
+    /// <summary>
+    /// Runs a quick action. Quick launch returns once the launch has started.
+    /// </summary>
+    /// <param name="action">One of the <see cref="QuickActions"/> names.</param>
+    /// <returns>Whether the action ran and, if not, why.</returns>
+    QuickActionResult Run(string action);
+
+    /// <summary>
+    /// Applies a command-line launch request: switches the profile, selects the instance and, if asked,
+    /// quick-launches it. Nothing is changed if the instance or profile cannot be found.
+    /// </summary>
+    /// <param name="request">The request read by <see cref="LaunchArguments.Parse"/>.</param>
+    /// <returns>Whether the request was applied and, if not, why.</returns>
+    QuickActionResult ApplyLaunchRequest(LaunchRequest request);
+}
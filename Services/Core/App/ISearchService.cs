@@ -0,0 +1,20 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Searches instances, worlds, mods, settings and news in one call, for a command palette.
+/// </summary>
+public interface ISearchService
+{
+    /// <summary>
+    /// Finds everything matching <paramref name="query"/>, best matches first.
+    /// </summary>
+    /// <param name="query">Text to search for; every word must match.</param>
+    /// <param name="limit">Maximum number of results.</param>
+    /// <param name="includeRemote">Whether to also search CurseForge. Results are cached per query, and a
+    /// slow or failed lookup is left out instead of holding up the rest.</param>
+    /// <param name="ct">Cancels the search.</param>
+    /// <returns>The results sorted by <see cref="SearchResult.Score"/>.</returns>
+    Task<List<SearchResult>> SearchAsync(string query, int limit = 20, bool includeRemote = true, CancellationToken ct = default);
+}
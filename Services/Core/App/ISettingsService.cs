@@ -88,6 +88,45 @@ public interface ISettingsService
     /// <returns><c>true</c> if the setting was successfully saved.</returns>
     bool SetCloseAfterLaunch(bool close);
     
+    /// <summary>
+    /// Gets whether the launcher window is minimized once the game has started.
+    /// </summary>
+    /// <returns><c>true</c> if the launcher minimizes on launch; otherwise, <c>false</c>.</returns>
+    bool GetMinimizeOnLaunch();
+    
+    /// <summary>
+    /// Sets whether the launcher window is minimized once the game has started.
+    /// </summary>
+    /// <param name="enabled">Whether to minimize on launch.</param>
+    /// <returns><c>true</c> if the setting was successfully saved.</returns>
+    bool SetMinimizeOnLaunch(bool enabled);
+    
+    /// <summary>
+    /// Gets whether a launcher minimized on launch is restored when the game exits.
+    /// </summary>
+    /// <returns><c>true</c> if the window is restored on game exit; otherwise, <c>false</c>.</returns>
+    bool GetRestoreOnGameExit();
+    
+    /// <summary>
+    /// Sets whether a launcher minimized on launch is restored when the game exits.
+    /// </summary>
+    /// <param name="enabled">Whether to restore the window on game exit.</param>
+    /// <returns><c>true</c> if the setting was successfully saved.</returns>
+    bool SetRestoreOnGameExit(bool enabled);
+    
+    /// <summary>
+    /// Gets whether critical game files are checked against the install manifest before each launch.
+    /// </summary>
+    /// <returns><c>true</c> if files are checked before launch; otherwise, <c>false</c>.</returns>
+    bool GetVerifyFilesBeforeLaunch();
+    
+    /// <summary>
+    /// Sets whether critical game files are checked against the install manifest before each launch.
+    /// </summary>
+    /// <param name="enabled">Whether to check files before launch.</param>
+    /// <returns><c>true</c> if the setting was successfully saved.</returns>
+    bool SetVerifyFilesBeforeLaunch(bool enabled);
+    
     /// <summary>
     /// Gets whether Discord announcement notifications are shown.
     /// </summary>
@@ -210,6 +249,43 @@ public interface ISettingsService
     /// </summary>
     /// <returns>The current GPU preference string.</returns>
     string GetGpuPreference();
+
+    /// <summary>
+    /// Gets the configured proxy URL with the password masked; empty when the system proxy is used.
+    /// </summary>
+    string GetProxyUrl();
+
+    /// <summary>
+    /// Sets the proxy for launcher HTTP traffic. Empty clears it.
+    /// </summary>
+    /// <param name="proxyUrl">http(s), socks4 or socks5 URL, optionally with credentials.</param>
+    /// <returns><c>true</c> if the URL was valid and saved.</returns>
+    bool SetProxyUrl(string proxyUrl);
+
+    /// <summary>
+    /// Gets whether the connection is treated as metered.
+    /// </summary>
+    bool GetMeteredConnection();
+
+    /// <summary>
+    /// Sets whether the connection is treated as metered, which defers background downloads and
+    /// asks before large ones.
+    /// </summary>
+    /// <param name="metered">Whether the connection is metered.</param>
+    /// <returns><c>true</c> if the setting was successfully saved.</returns>
+    bool SetMeteredConnection(bool metered);
+
+    /// <summary>
+    /// Gets the download size in MB from which a metered connection asks for confirmation.
+    /// </summary>
+    int GetMeteredConfirmThresholdMb();
+
+    /// <summary>
+    /// Sets the download size in MB from which a metered connection asks for confirmation.
+    /// </summary>
+    /// <param name="thresholdMb">Size in MB; 0 asks for every download.</param>
+    /// <returns><c>true</c> if the setting was successfully saved.</returns>
+    bool SetMeteredConfirmThresholdMb(int thresholdMb);
     
     /// <summary>
     /// Sets the GPU preference for game launch.
@@ -0,0 +1,47 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Registry of long-running background operations (installs, downloads, backups, update checks)
+/// with IDs, state, progress and cancellation.
+/// </summary>
+public interface ITaskManagerService
+{
+    /// <summary>
+    /// Raised whenever a task is created or its state/progress changes.
+    /// </summary>
+    event Action<BackgroundTaskInfo>? TaskUpdated;
+
+    /// <summary>
+    /// Registers a new running task. The caller must finish it through the returned handle.
+    /// </summary>
+    /// <param name="kind">Operation type identifier.</param>
+    /// <param name="title">Human-readable title.</param>
+    /// <param name="onCancel">Optional callback for operations that manage their own cancellation.</param>
+    /// <param name="cancellable">Whether the operation honours cancellation at all.</param>
+    /// <returns>A handle used to report progress and completion.</returns>
+    TaskHandle Begin(string kind, string title, Action? onCancel = null, bool cancellable = true);
+
+    /// <summary>
+    /// Runs <paramref name="work"/> as a tracked task, marking it completed, failed or cancelled
+    /// based on how it finishes. Exceptions are rethrown to the caller.
+    /// </summary>
+    /// <param name="kind">Operation type identifier.</param>
+    /// <param name="title">Human-readable title.</param>
+    /// <param name="work">The operation; should observe <see cref="TaskHandle.Token"/> when cancellable.</param>
+    /// <param name="cancellable">Whether the operation honours cancellation at all.</param>
+    Task<T> RunAsync<T>(string kind, string title, Func<TaskHandle, Task<T>> work, bool cancellable = true);
+
+    /// <summary>
+    /// Returns running tasks followed by recently finished ones, newest first.
+    /// </summary>
+    IReadOnlyList<BackgroundTaskInfo> ListTasks();
+
+    /// <summary>
+    /// Requests cancellation of a running task.
+    /// </summary>
+    /// <param name="taskId">The task ID.</param>
+    /// <returns><c>true</c> if the task was running and cancellable.</returns>
+    bool CancelTask(string taskId);
+}
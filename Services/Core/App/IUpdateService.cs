@@ -1,4 +1,5 @@
 using System.Text.Json;
+using HyPrism.Models;
 
 namespace HyPrism.Services.Core.App;
 
@@ -30,6 +31,13 @@ public interface IUpdateService
     /// </summary>
     /// <returns>A task representing the asynchronous check operation.</returns>
     Task CheckForLauncherUpdatesAsync();
+
+    /// <summary>
+    /// Returns the last stored update check for this launcher version and channel without a network call,
+    /// and starts a new check in the background when it is older than a few hours.
+    /// </summary>
+    /// <returns>The last check, or <c>null</c> if none was stored yet.</returns>
+    LauncherUpdateCheck? GetLastUpdateCheck();
     
     /// <summary>
     /// Downloads and installs an available update.
@@ -0,0 +1,107 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Command-line flags that start HyPrism straight into an action, e.g.
+/// <c>--launch --instance release/3 --profile Foo</c>. Desktop shortcuts use the same flags with IDs.
+/// </summary>
+/// <remarks>
+/// Read in <c>Program.Main</c> before Electron starts, so <c>--headless</c> can skip the window altogether,
+/// and again by the running launcher for arguments a second invocation forwards.
+/// </remarks>
+public static class LaunchArguments
+{
+    public const string Launch = "--launch";
+    public const string Instance = "--instance";
+    public const string Profile = "--profile";
+    public const string Headless = "--headless";
+    public const string Help = "--help";
+
+    /// <summary>
+    /// Usage text printed for <c>--help</c>.
+    /// </summary>
+    public const string Usage = """
+Usage: HyPrism [options]
+
+  --launch               Install if needed and launch the instance
+  --instance <instance>  Select an instance by ID, name, or branch/version (e.g. release/3)
+  --profile <profile>    Switch to a profile by ID or name
+  --headless             With --launch: launch without opening the launcher window,
+                         and exit when the game does
+  --help                 Show this help
+
+Without --launch, --instance and --profile only select the instance and profile.
+If HyPrism is already running, the flags are passed to it.
+""";
+
+    /// <summary>
+    /// Builds the arguments that quick-launch an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance to launch.</param>
+    /// <param name="profileId">The profile to switch to first; <c>null</c> keeps the active one.</param>
+    public static string[] Build(string instanceId, string? profileId)
+    {
+        return string.IsNullOrEmpty(profileId)
+            ? [Launch, Instance, instanceId]
+            : [Launch, Instance, instanceId, Profile, profileId];
+    }
+
+    /// <summary>
+    /// Gets whether the arguments ask for the usage text.
+    /// </summary>
+    public static bool IsHelp(string[] args) => args.Any(a => a is Help or "-h" or "/?");
+
+    /// <summary>
+    /// Reads a launch request from command-line arguments. Unknown arguments are ignored, since Electron and
+    /// the OS add their own.
+    /// </summary>
+    /// <param name="args">The process arguments, or those forwarded by a second invocation.</param>
+    /// <returns>The request, or <c>null</c> if the arguments ask for nothing.</returns>
+    public static LaunchRequest? Parse(string[] args)
+    {
+        var request = new LaunchRequest();
+
+        for (int i = 0; i < args.Length; i++)
+        {
+            var arg = args[i];
+            // Accept both "--instance id" and "--instance=id"
+            string? value = null;
+            var eq = arg.IndexOf('=');
+            if (arg.StartsWith("--") && eq > 0)
+            {
+                value = arg[(eq + 1)..];
+                arg = arg[..eq];
+            }
+
+            switch (arg)
+            {
+                case Launch:
+                    request.Launch = true;
+                    break;
+                case Headless:
+                    request.Headless = true;
+                    break;
+                case Instance:
+                    request.Instance = value ?? NextValue(args, ref i);
+                    break;
+                case Profile:
+                    request.Profile = value ?? NextValue(args, ref i);
+                    break;
+            }
+        }
+
+        if (string.IsNullOrWhiteSpace(request.Instance)) request.Instance = null;
+        if (string.IsNullOrWhiteSpace(request.Profile)) request.Profile = null;
+        // Headless without a launch would start and exit without doing anything
+        if (!request.Launch) request.Headless = false;
+
+        return request.HasAction ? request : null;
+    }
+
+    private static string? NextValue(string[] args, ref int i)
+    {
+        if (i + 1 >= args.Length || args[i + 1].StartsWith("--")) return null;
+        return args[++i];
+    }
+}
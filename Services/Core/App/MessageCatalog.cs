@@ -0,0 +1,132 @@
+using System.Text.RegularExpressions;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Message IDs for user-facing text that originates in the backend (errors, notices).
+/// </summary>
+/// <remarks>
+/// Translations live in the frontend locale files (Frontend/src/assets/locales/*.json) under the
+/// same keys, so the UI language setting applies. The backend sends the ID plus positional
+/// parameters and keeps English defaults here for logs and as a fallback when a key is missing.
+/// </remarks>
+public static class MessageCatalog
+{
+    public const string ErrorFatal = "errors.fatal";
+    public const string ErrorLaunchFailed = "errors.launchFailed";
+    public const string ErrorStartFailed = "errors.startFailed";
+    public const string ErrorNoVersions = "errors.noVersions";
+    public const string ErrorInvalidNickname = "errors.invalidNickname";
+    public const string ErrorNicknameCharacters = "errors.nicknameCharacters";
+    public const string ErrorNicknameReserved = "errors.nicknameReserved";
+    public const string ErrorInvalidBranch = "errors.invalidBranch";
+    public const string ErrorInvalidLanguage = "errors.invalidLanguage";
+    public const string ErrorDirectoryNotWritable = "errors.directoryNotWritable";
+    public const string ErrorSettingsSaveFailed = "errors.settingsSaveFailed";
+    public const string ErrorNetworkOffline = "errors.networkOffline";
+    public const string ErrorCaptivePortal = "errors.captivePortal";
+    public const string ErrorCdnBlocked = "errors.cdnBlocked";
+    public const string ErrorInstanceRunning = "errors.instanceRunning";
+    public const string ErrorInstanceInstalling = "errors.instanceInstalling";
+    public const string ErrorInstanceModifying = "errors.instanceModifying";
+    public const string ErrorInstanceBackingUp = "errors.instanceBackingUp";
+    public const string ErrorPatchNotFound = "errors.patchNotFound";
+    public const string ErrorDiskFull = "errors.diskFull";
+    public const string ErrorAccessDenied = "errors.accessDenied";
+    public const string ErrorAntivirusBlocked = "errors.antivirusBlocked";
+    public const string ErrorJavaMissing = "errors.javaMissing";
+    public const string ErrorClientMissing = "errors.clientMissing";
+    public const string ErrorFilesAltered = "errors.filesAltered";
+    public const string ErrorInstallTimedOut = "errors.installTimedOut";
+    public const string ErrorArchUnsupported = "errors.archUnsupported";
+
+    public const string HintPatchNotFound = "errors.hints.patchNotFound";
+    public const string HintDiskFull = "errors.hints.diskFull";
+    public const string HintAccessDenied = "errors.hints.accessDenied";
+    public const string HintAntivirusBlocked = "errors.hints.antivirusBlocked";
+    public const string HintJavaMissing = "errors.hints.javaMissing";
+    public const string HintClientMissing = "errors.hints.clientMissing";
+    public const string HintFilesAltered = "errors.hints.filesAltered";
+    public const string HintInstallTimedOut = "errors.hints.installTimedOut";
+    public const string HintArchUnsupported = "errors.hints.archUnsupported";
+    public const string HintNoVersions = "errors.hints.noVersions";
+    public const string HintNetworkOffline = "errors.hints.networkOffline";
+    public const string HintCaptivePortal = "errors.hints.captivePortal";
+    public const string HintHostBlocked = "errors.hints.hostBlocked";
+    public const string HintInstanceLocked = "errors.hints.instanceLocked";
+    public const string HintStartFailed = "errors.hints.startFailed";
+    public const string HintLaunchFailed = "errors.hints.launchFailed";
+    public const string HintFatal = "errors.hints.fatal";
+
+    private static readonly Dictionary<string, string> English = new()
+    {
+        [ErrorFatal] = "Fatal error",
+        [ErrorLaunchFailed] = "Failed to launch game",
+        [ErrorStartFailed] = "Failed to start game",
+        [ErrorNoVersions] = "No versions available for branch {0}",
+        [ErrorInvalidNickname] = "Nickname must be between {0} and {1} characters",
+        [ErrorNicknameCharacters] = "Nickname cannot contain \"{0}\"",
+        [ErrorNicknameReserved] = "\"{0}\" is a reserved name",
+        [ErrorInvalidBranch] = "Unknown game branch: {0}",
+        [ErrorInvalidLanguage] = "Unsupported language: {0}",
+        [ErrorDirectoryNotWritable] = "Cannot write to {0}",
+        [ErrorSettingsSaveFailed] = "Failed to save settings",
+        [ErrorNetworkOffline] = "No internet connection",
+        [ErrorCaptivePortal] = "This network requires signing in through a browser before it can be used",
+        [ErrorCdnBlocked] = "Connected to the internet, but {0} could not be reached. A firewall, DNS filter or antivirus may be blocking it",
+        [ErrorInstanceRunning] = "The game is running from this instance. Close it and try again",
+        [ErrorInstanceInstalling] = "This instance is being installed or updated. Try again when it finishes",
+        [ErrorInstanceModifying] = "Mods of this instance are being changed. Try again when it finishes",
+        [ErrorInstanceBackingUp] = "This instance is being backed up. Try again when it finishes",
+        [ErrorPatchNotFound] = "Game version {0} is not available from the official server or the mirror",
+        [ErrorDiskFull] = "There is not enough free disk space",
+        [ErrorAccessDenied] = "Access to a launcher or game file was denied",
+        [ErrorAntivirusBlocked] = "Antivirus software blocked or removed a launcher or game file",
+        [ErrorJavaMissing] = "No Java runtime is available to start the game",
+        [ErrorClientMissing] = "The game client is missing from this instance",
+        [ErrorFilesAltered] = "Game files of this instance were changed or are missing: {0}",
+        [ErrorInstallTimedOut] = "Installing the game stopped responding and was cancelled after {0} minutes",
+        [ErrorArchUnsupported] = "{0} is not available for {1}",
+        [HintPatchNotFound] = "The version may have been withdrawn. Refresh the version list and install the latest version, or try again later",
+        [HintDiskFull] = "Free up space on the drive that holds the launcher data or the instances folder, or move the instances folder to another drive in Settings",
+        [HintAccessDenied] = "Make sure the launcher data folder is not read-only and is not blocked by antivirus software",
+        [HintAntivirusBlocked] = "Check Protection history in Windows Security or your antivirus, restore the file, and exclude the HyPrism data folder from scanning",
+        [HintJavaMissing] = "Launch again to download the bundled Java runtime, and check that antivirus software did not remove it",
+        [HintClientMissing] = "Repair the instance to download the missing game files again",
+        [HintFilesAltered] = "Repair the instance to restore the original files. An unfinished install or antivirus software can cause this",
+        [HintInstallTimedOut] = "Try again. On a slow drive, raise ButlerTimeoutMinutes and ButlerStallMinutes in config.json, and check that antivirus software is not scanning the install",
+        [HintArchUnsupported] = "The game runs on Windows (x64, or ARM through emulation), Linux x64 and macOS. On other systems, play on a supported computer",
+        [HintNoVersions] = "Check your internet connection and refresh the version list",
+        [HintNetworkOffline] = "Check your internet connection and try again",
+        [HintCaptivePortal] = "Open a website in your browser to sign in to the network, then try again",
+        [HintHostBlocked] = "Allow the launcher in your firewall or antivirus, or set a proxy in Settings",
+        [HintInstanceLocked] = "Wait for the game or the running operation to finish",
+        [HintStartFailed] = "Repair the instance; if that does not help, check the game logs",
+        [HintLaunchFailed] = "Repair the instance; if that does not help, check the launcher logs",
+        [HintFatal] = "Try again. If it keeps happening, report the issue with the launcher logs",
+    };
+
+    private static readonly Regex Placeholder = new(@"\{(\d+)\}", RegexOptions.Compiled);
+
+    /// <summary>
+    /// Renders the English text for a message ID, substituting <c>{0}</c>, <c>{1}</c>… with <paramref name="args"/>.
+    /// Unknown IDs are returned unchanged.
+    /// </summary>
+    /// <param name="messageKey">The message ID.</param>
+    /// <param name="args">Positional parameters.</param>
+    /// <returns>The formatted English message.</returns>
+    public static string Format(string messageKey, object[]? args = null)
+    {
+        if (!English.TryGetValue(messageKey, out var template))
+            return messageKey;
+
+        if (args == null || args.Length == 0)
+            return template;
+
+        return Placeholder.Replace(template, m =>
+        {
+            int index = int.Parse(m.Groups[1].Value);
+            return index < args.Length ? args[index]?.ToString() ?? "" : m.Value;
+        });
+    }
+}
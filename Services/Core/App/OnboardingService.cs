@@ -0,0 +1,301 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.User;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Drives the first-run setup flow. All choices are validated up front and written
+/// with a single <see cref="IConfigService.TryUpdate"/> call, so the first
+/// install never starts from a half-applied configuration.
+/// </summary>
+public class OnboardingService : IOnboardingService
+{
+    /// <summary>
+    /// Free space recommended for one game installation plus its patch cache.
+    /// </summary>
+    private const long RecommendedFreeBytes = 8L * 1024 * 1024 * 1024;
+
+    private static readonly string[] Branches = ["release", "pre-release"];
+
+    private readonly string _appDir;
+    private readonly IConfigService _configService;
+    private readonly IProfileService _profileService;
+    private readonly LocalizationService _localizationService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="OnboardingService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory; the default instance root lives under it.</param>
+    /// <param name="configService">The configuration service the choices are persisted to.</param>
+    /// <param name="profileService">The profile service, used to rename an existing active profile.</param>
+    /// <param name="localizationService">The localization service, switched to the chosen language.</param>
+    public OnboardingService(
+        string appDir,
+        IConfigService configService,
+        IProfileService profileService,
+        LocalizationService localizationService)
+    {
+        _appDir = appDir;
+        _configService = configService;
+        _profileService = profileService;
+        _localizationService = localizationService;
+    }
+
+    private string DefaultInstanceDirectory => Path.Combine(_appDir, "Instances");
+
+    /// <inheritdoc/>
+    public OnboardingState GetState()
+    {
+        var config = _configService.Configuration;
+
+        #pragma warning disable CS0618 // VersionType still selects the branch used by the game session
+        var branch = UtilityService.NormalizeVersionType(config.VersionType);
+        #pragma warning restore CS0618
+
+        return new OnboardingState
+        {
+            IsFirstRun = !config.HasCompletedOnboarding,
+            Nickname = config.Nick,
+            Branch = branch,
+            Language = config.Language,
+            InstanceDirectory = string.IsNullOrWhiteSpace(config.InstanceDirectory)
+                ? DefaultInstanceDirectory
+                : config.InstanceDirectory,
+            DefaultInstanceDirectory = DefaultInstanceDirectory
+        };
+    }
+
+    /// <inheritdoc/>
+    public List<InstallLocation> GetRecommendedInstallLocations()
+    {
+        var drives = GetReadyDrives();
+        var locations = new List<InstallLocation>();
+        var seen = new HashSet<string>(OperatingSystem.IsWindows() ? StringComparer.OrdinalIgnoreCase : StringComparer.Ordinal);
+
+        void Add(string path, string kind)
+        {
+            var fullPath = Path.GetFullPath(path);
+            if (!seen.Add(fullPath)) return;
+
+            var location = new InstallLocation
+            {
+                Path = fullPath,
+                Kind = kind,
+                IsDefault = kind == "default"
+            };
+
+            var drive = FindDrive(drives, fullPath);
+            if (drive != null)
+            {
+                try
+                {
+                    location.FreeBytes = drive.AvailableFreeSpace;
+                    location.TotalBytes = drive.TotalSize;
+                }
+                catch (Exception ex)
+                {
+                    Logger.Warning("Onboarding", $"Could not read free space for {fullPath}: {ex.Message}");
+                }
+            }
+
+            location.HasEnoughSpace = location.FreeBytes >= RecommendedFreeBytes;
+            locations.Add(location);
+        }
+
+        Add(DefaultInstanceDirectory, "default");
+
+        var configured = _configService.Configuration.InstanceDirectory;
+        if (!string.IsNullOrWhiteSpace(configured))
+        {
+            Add(configured, "current");
+        }
+
+        if (OperatingSystem.IsWindows())
+        {
+            // Secondary fixed drives are often larger than the system drive
+            foreach (var drive in drives.Where(d => d.DriveType == DriveType.Fixed))
+            {
+                Add(Path.Combine(drive.RootDirectory.FullName, "HyPrism", "Instances"), "drive");
+            }
+        }
+        else
+        {
+            var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+            if (!string.IsNullOrEmpty(home))
+            {
+                Add(Path.Combine(home, "Games", "HyPrism"), "home");
+            }
+        }
+
+        var recommended = locations[0].HasEnoughSpace
+            ? locations[0]
+            : locations.Where(l => l.HasEnoughSpace).OrderByDescending(l => l.FreeBytes).FirstOrDefault() ?? locations[0];
+        recommended.IsRecommended = true;
+
+        return locations;
+    }
+
+    /// <inheritdoc/>
+    public OnboardingResult Complete(OnboardingRequest request)
+    {
+        var config = _configService.Configuration;
+
+        var nickname = request.Nickname?.Trim();
+        if (nickname != null)
+        {
+            var validation = _profileService.ValidateNick(nickname);
+            if (!validation.Valid)
+            {
+                return Reject(validation.MessageKey!, validation.Args ?? []);
+            }
+        }
+
+        string? branch = null;
+        if (request.Branch != null)
+        {
+            branch = UtilityService.NormalizeVersionType(request.Branch.Trim().ToLowerInvariant());
+            if (!Branches.Contains(branch))
+            {
+                return Reject(MessageCatalog.ErrorInvalidBranch, request.Branch);
+            }
+        }
+
+        if (request.Language != null && !LocalizationService.GetAvailableLanguages().ContainsKey(request.Language))
+        {
+            return Reject(MessageCatalog.ErrorInvalidLanguage, request.Language);
+        }
+
+        string? instanceDirectory = null;
+        if (request.InstanceDirectory != null)
+        {
+            var resolved = ResolveDirectory(request.InstanceDirectory);
+            if (!IsWritable(resolved))
+            {
+                return Reject(MessageCatalog.ErrorDirectoryNotWritable, resolved);
+            }
+
+            // Store the default location as empty so it follows the data directory if that moves
+            instanceDirectory = PathsEqual(resolved, DefaultInstanceDirectory) ? "" : resolved;
+        }
+
+        // An existing active profile is renamed through the profile service (it owns the profile folder)
+        bool hasActiveProfile = config.ActiveProfileIndex >= 0 && config.ActiveProfileIndex < config.Profiles.Count;
+
+        bool saved = _configService.TryUpdate(c =>
+        {
+            if (nickname != null && !hasActiveProfile) c.Nick = nickname;
+            if (request.Language != null) c.Language = request.Language;
+            if (instanceDirectory != null) c.InstanceDirectory = instanceDirectory;
+
+            #pragma warning disable CS0618 // VersionType still selects the branch used by the game session
+            if (branch != null) c.VersionType = branch;
+            #pragma warning restore CS0618
+
+            c.HasCompletedOnboarding = true;
+        });
+
+        if (!saved)
+        {
+            return Reject(MessageCatalog.ErrorSettingsSaveFailed);
+        }
+
+        if (nickname != null && hasActiveProfile)
+        {
+            _profileService.SetNick(nickname);
+        }
+
+        if (request.Language != null)
+        {
+            _localizationService.CurrentLanguage = request.Language;
+        }
+
+        Logger.Success("Onboarding", "Onboarding completed");
+        return new OnboardingResult { Success = true, State = GetState() };
+    }
+
+    private static OnboardingResult Reject(string messageKey, params object[] args)
+    {
+        Logger.Warning("Onboarding", MessageCatalog.Format(messageKey, args));
+        return new OnboardingResult { Success = false, MessageKey = messageKey, Args = args };
+    }
+
+    private string ResolveDirectory(string path)
+    {
+        if (string.IsNullOrWhiteSpace(path))
+            return DefaultInstanceDirectory;
+
+        var expanded = Environment.ExpandEnvironmentVariables(path.Trim());
+        if (!Path.IsPathRooted(expanded))
+        {
+            expanded = Path.Combine(_appDir, expanded);
+        }
+
+        return Path.GetFullPath(expanded);
+    }
+
+    private static bool IsWritable(string directory)
+    {
+        try
+        {
+            Directory.CreateDirectory(directory);
+            var probe = Path.Combine(directory, $".hyprism-write-test-{Guid.NewGuid():N}");
+            File.WriteAllText(probe, "");
+            File.Delete(probe);
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Onboarding", $"Directory {directory} is not writable: {ex.Message}");
+            return false;
+        }
+    }
+
+    private static bool PathsEqual(string a, string b)
+    {
+        var comparison = OperatingSystem.IsWindows() ? StringComparison.OrdinalIgnoreCase : StringComparison.Ordinal;
+        return string.Equals(
+            Path.GetFullPath(a).TrimEnd(Path.DirectorySeparatorChar, Path.AltDirectorySeparatorChar),
+            Path.GetFullPath(b).TrimEnd(Path.DirectorySeparatorChar, Path.AltDirectorySeparatorChar),
+            comparison);
+    }
+
+    internal static List<DriveInfo> GetReadyDrives()
+    {
+        try
+        {
+            return DriveInfo.GetDrives().Where(d =>
+            {
+                try { return d.IsReady; }
+                catch { return false; }
+            }).ToList();
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Onboarding", $"Could not enumerate drives: {ex.Message}");
+            return new List<DriveInfo>();
+        }
+    }
+
+    /// <summary>
+    /// Finds the volume a path lives on by longest mount-point prefix, so paths on
+    /// Linux/macOS mounts resolve to their own volume rather than "/".
+    /// </summary>
+    internal static DriveInfo? FindDrive(List<DriveInfo> drives, string path)
+    {
+        var comparison = OperatingSystem.IsWindows() ? StringComparison.OrdinalIgnoreCase : StringComparison.Ordinal;
+        return drives
+            .Where(d => IsUnder(path, d.RootDirectory.FullName, comparison))
+            .OrderByDescending(d => d.RootDirectory.FullName.Length)
+            .FirstOrDefault();
+    }
+
+    internal static bool IsUnder(string path, string root, StringComparison comparison)
+    {
+        if (!path.StartsWith(root, comparison)) return false;
+        if (path.Length == root.Length) return true;
+
+        // "/mnt/data" must not match "/mnt/database"
+        return root.EndsWith(Path.DirectorySeparatorChar) || path[root.Length] == Path.DirectorySeparatorChar;
+    }
+}
@@ -0,0 +1,157 @@
+using System.Diagnostics;
+using HyPrism.Models;
+using HyPrism.Services.Game.Download;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Handle given to the owner of a download or install for reporting its progress on the shared progress
+/// channel. Disposing a reporter that was never finished marks the operation as failed, so an exception on
+/// the way out is not shown as success; call <see cref="Complete"/> when the work is done.
+/// </summary>
+/// <remarks>
+/// Downloads made through <see cref="IDownloadService"/> pass the speed and time left it measured; for
+/// plain byte counts the reporter measures them itself with a <see cref="TransferRate"/>. Updates closer
+/// together than <see cref="MinInterval"/> are dropped unless the stage or message changes.
+/// </remarks>
+public sealed class OperationProgressReporter : IDisposable
+{
+    private static readonly TimeSpan MinInterval = TimeSpan.FromMilliseconds(100);
+
+    private readonly Action<OperationProgress> _publish;
+    private readonly Stopwatch _clock = Stopwatch.StartNew();
+    private readonly object _lock = new();
+    private readonly OperationProgress _current;
+    private readonly TransferRate _rate = new();
+
+    private TimeSpan _lastPublish = TimeSpan.MinValue;
+
+    /// <summary>
+    /// The operation identifier.
+    /// </summary>
+    public string Id => _current.OperationId;
+
+    internal OperationProgressReporter(string id, string kind, Action<OperationProgress> publish)
+    {
+        _current = new OperationProgress { OperationId = id, Kind = kind };
+        _publish = publish;
+    }
+
+    /// <summary>
+    /// Reports progress for the operation.
+    /// </summary>
+    /// <param name="stage">What the operation is doing, e.g. "download" or "extract".</param>
+    /// <param name="progress">Percentage of the whole operation (0-100).</param>
+    /// <param name="downloaded">Bytes transferred in this stage so far.</param>
+    /// <param name="total">Bytes to transfer in this stage, or 0 if unknown.</param>
+    /// <param name="messageKey">Optional localization key for the current step.</param>
+    /// <param name="args">Optional format arguments for the message.</param>
+    public void Report(string stage, double progress, long downloaded = 0, long total = 0, string? messageKey = null, object[]? args = null) =>
+        Report(stage, progress, downloaded, total, null, messageKey, args);
+
+    /// <summary>
+    /// Reports progress of a download made through <see cref="IDownloadService"/>, using the speed and
+    /// time left it measured.
+    /// </summary>
+    /// <param name="stage">What the operation is doing, e.g. "download".</param>
+    /// <param name="progress">Percentage of the whole operation (0-100).</param>
+    /// <param name="download">Bytes, speed and time left of the download.</param>
+    /// <param name="messageKey">Optional localization key for the current step.</param>
+    /// <param name="args">Optional format arguments for the message.</param>
+    public void Report(string stage, double progress, TransferProgress download, string? messageKey = null, object[]? args = null) =>
+        Report(stage, progress, download.DownloadedBytes, Math.Max(download.TotalBytes, 0), download, messageKey, args);
+
+    private void Report(string stage, double progress, long downloaded, long total, TransferProgress? measured, string? messageKey, object[]? args)
+    {
+        OperationProgress snapshot;
+        lock (_lock)
+        {
+            if (_current.State != "running") return;
+
+            var now = _clock.Elapsed;
+            bool changed = stage != _current.Stage || (messageKey != null && messageKey != _current.MessageKey);
+
+            if (measured is { } m)
+            {
+                _current.BytesPerSecond = m.BytesPerSecond;
+                _current.EtaSeconds = m.EtaSeconds;
+            }
+            else
+            {
+                // A new stage measures from scratch
+                if (changed) _rate.Reset();
+                _rate.Sample(downloaded, total);
+                _current.BytesPerSecond = _rate.BytesPerSecond;
+                _current.EtaSeconds = _rate.EtaSeconds;
+            }
+
+            _current.Stage = stage;
+            _current.Progress = Math.Clamp(progress, 0, 100);
+            _current.DownloadedBytes = downloaded;
+            _current.TotalBytes = total;
+            if (messageKey != null)
+            {
+                _current.MessageKey = messageKey;
+                _current.Args = args;
+            }
+
+            if (!changed && progress < 100 && now - _lastPublish < MinInterval) return;
+            _lastPublish = now;
+            snapshot = Snapshot();
+        }
+        _publish(snapshot);
+    }
+
+    /// <summary>
+    /// Marks the operation as successfully completed.
+    /// </summary>
+    public void Complete() => Finish("completed");
+
+    /// <summary>
+    /// Marks the operation as failed.
+    /// </summary>
+    public void Fail() => Finish("failed");
+
+    /// <summary>
+    /// Marks the operation as cancelled.
+    /// </summary>
+    public void MarkCancelled() => Finish("cancelled");
+
+    private void Finish(string state)
+    {
+        OperationProgress snapshot;
+        lock (_lock)
+        {
+            if (_current.State != "running") return;
+            _current.State = state;
+            _current.BytesPerSecond = 0;
+            _current.EtaSeconds = null;
+            if (state == "completed") _current.Progress = 100;
+            snapshot = Snapshot();
+        }
+        _publish(snapshot);
+    }
+
+    private OperationProgress Snapshot() => new()
+    {
+        OperationId = _current.OperationId,
+        Kind = _current.Kind,
+        Stage = _current.Stage,
+        State = _current.State,
+        Progress = _current.Progress,
+        MessageKey = _current.MessageKey,
+        Args = _current.Args,
+        DownloadedBytes = _current.DownloadedBytes,
+        TotalBytes = _current.TotalBytes,
+        BytesPerSecond = _current.BytesPerSecond,
+        EtaSeconds = _current.EtaSeconds
+    };
+
+    /// <inheritdoc/>
+    public void Dispose()
+    {
+        bool running;
+        lock (_lock) running = _current.State == "running";
+        if (running) Fail();
+    }
+}
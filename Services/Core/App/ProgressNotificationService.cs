@@ -1,5 +1,6 @@
 using HyPrism.Models;
 using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Game.Download;
 
 namespace HyPrism.Services.Core.App;
 
@@ -18,7 +19,10 @@ public class ProgressNotificationService : IProgressNotificationService
     public event Action<string, int>? GameStateChanged;
     
     /// <inheritdoc/>
-    public event Action<string, string, string?>? ErrorOccurred;
+    public event Action<GameErrorMessage>? ErrorOccurred;
+    
+    /// <inheritdoc/>
+    public event Action<OperationProgress>? OperationProgressChanged;
     
     /// <summary>
     /// Initializes a new instance of the <see cref="ProgressNotificationService"/> class.
@@ -30,7 +34,8 @@ public class ProgressNotificationService : IProgressNotificationService
     }
     
     /// <inheritdoc/>
-    public void SendProgress(string stage, int progress, string messageKey, object[]? args, long downloaded, long total)
+    public void SendProgress(string stage, int progress, string messageKey, object[]? args, long downloaded, long total,
+        double bytesPerSecond = 0, double? etaSeconds = null)
     {
         var msg = new ProgressUpdateMessage 
         { 
@@ -39,7 +44,9 @@ public class ProgressNotificationService : IProgressNotificationService
             MessageKey = messageKey, 
             Args = args,
             DownloadedBytes = downloaded,
-            TotalBytes = total
+            TotalBytes = total,
+            BytesPerSecond = bytesPerSecond,
+            EtaSeconds = etaSeconds
         };
         
         DownloadProgressChanged?.Invoke(msg);
@@ -52,9 +59,18 @@ public class ProgressNotificationService : IProgressNotificationService
         }
     }
 
+    /// <inheritdoc/>
+    public OperationProgressReporter BeginOperation(string kind, string? operationId = null) =>
+        new(operationId ?? $"{kind}-{Guid.NewGuid():N}", kind, progress => OperationProgressChanged?.Invoke(progress));
+
     /// <inheritdoc/>
     public void ReportDownloadProgress(string stage, int progress, string messageKey, object[]? args = null, long downloaded = 0, long total = 0) 
         => SendProgress(stage, progress, messageKey, args, downloaded, total);
+
+    /// <inheritdoc/>
+    public void ReportDownloadProgress(string stage, int progress, string messageKey, object[]? args, TransferProgress download)
+        => SendProgress(stage, progress, messageKey, args, download.DownloadedBytes, download.TotalBytes,
+            download.BytesPerSecond, download.EtaSeconds);
     /// Sends game state change notification.
     /// </summary>
     public void SendGameStateEvent(string state, int? exitCode = null)
@@ -83,9 +99,39 @@ public class ProgressNotificationService : IProgressNotificationService
 
     public void SendErrorEvent(string type, string message, string? technical = null)
     {
-        ErrorOccurred?.Invoke(type, message, technical);
+        ErrorOccurred?.Invoke(new GameErrorMessage { Type = type, Message = message, Technical = technical });
     }
     
     public void ReportError(string type, string message, string? technical = null) 
         => SendErrorEvent(type, message, technical);
+
+    /// <inheritdoc/>
+    public void ReportLocalizedError(string type, string messageKey, object[]? args = null, string? technical = null)
+        => SendCodedError(type, ErrorCodes.ForMessageKey(messageKey), messageKey, args, technical);
+
+    /// <inheritdoc/>
+    public void ReportFailure(string type, string fallbackMessageKey, Exception ex)
+    {
+        if (ErrorCodes.Describe(ex) is { } cause)
+            SendCodedError(type, cause.Code, cause.MessageKey, cause.Args, ex.ToString());
+        else
+            ReportLocalizedError(type, fallbackMessageKey, null, ex.ToString());
+    }
+
+    private void SendCodedError(string type, string? code, string messageKey, object[]? args, string? technical)
+    {
+        var hintKey = code != null ? ErrorCodes.GetHintKey(code) : null;
+        ErrorOccurred?.Invoke(new GameErrorMessage
+        {
+            Type = type,
+            Message = MessageCatalog.Format(messageKey, args),
+            MessageKey = messageKey,
+            Args = args,
+            Technical = technical,
+            Code = code,
+            HintKey = hintKey,
+            Hint = hintKey != null ? MessageCatalog.Format(hintKey) : null,
+            HelpUrl = code != null ? ErrorCodes.GetHelpUrl(code) : null
+        });
+    }
 }
@@ -0,0 +1,138 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Launch;
+using HyPrism.Services.User;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Runs quick actions against the selected instance and the running game.
+/// </summary>
+public class QuickActionService : IQuickActionService
+{
+    private readonly ISettingsService _settingsService;
+    private readonly IInstanceService _instanceService;
+    private readonly IGameSessionService _gameSessionService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly IProfileManagementService _profileManagementService;
+
+    /// <inheritdoc/>
+    public event Action<bool>? MusicToggled;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="QuickActionService"/> class.
+    /// </summary>
+    /// <param name="settingsService">Reads and stores the music setting.</param>
+    /// <param name="instanceService">Provides the selected instance.</param>
+    /// <param name="gameSessionService">Installs and launches the selected instance.</param>
+    /// <param name="gameProcessService">Tells whether the game runs and stops it.</param>
+    /// <param name="profileManagementService">Switches the profile a launch request names.</param>
+    public QuickActionService(
+        ISettingsService settingsService,
+        IInstanceService instanceService,
+        IGameSessionService gameSessionService,
+        IGameProcessService gameProcessService,
+        IProfileManagementService profileManagementService)
+    {
+        _settingsService = settingsService;
+        _instanceService = instanceService;
+        _gameSessionService = gameSessionService;
+        _gameProcessService = gameProcessService;
+        _profileManagementService = profileManagementService;
+    }
+
+    /// <inheritdoc/>
+    public QuickActionResult Run(string action)
+    {
+        Logger.Info("QuickAction", $"Running {action}");
+        return action switch
+        {
+            QuickActions.QuickLaunch => QuickLaunch(),
+            QuickActions.KillGame => KillGame(),
+            QuickActions.ToggleMusic => ToggleMusic(),
+            _ => Fail(action, $"Unknown quick action: {action}")
+        };
+    }
+
+    /// <inheritdoc/>
+    public QuickActionResult ApplyLaunchRequest(LaunchRequest request)
+    {
+        var action = request.Launch ? QuickActions.QuickLaunch : "select";
+        Logger.Info("QuickAction", $"Launch request: instance {request.Instance ?? "(selected)"}, profile {request.Profile ?? "(active)"}, launch {request.Launch}");
+
+        if (request.Launch && _gameProcessService.IsGameRunning())
+            return Fail(action, "The game is already running");
+
+        // Resolve both before changing anything, so a typo doesn't leave half the request applied
+        InstanceInfo? instance = null;
+        if (request.Instance != null)
+        {
+            instance = _instanceService.FindInstanceByReference(request.Instance);
+            if (instance == null)
+                return Fail(action, $"No single instance matches \"{request.Instance}\"");
+        }
+
+        int profileIndex = -1;
+        if (request.Profile != null)
+        {
+            var profiles = _profileManagementService.GetProfiles();
+            profileIndex = profiles.FindIndex(p => p.Id == request.Profile);
+            if (profileIndex < 0)
+                profileIndex = profiles.FindIndex(p => p.Name.Equals(request.Profile, StringComparison.OrdinalIgnoreCase));
+            if (profileIndex < 0)
+                return Fail(action, $"No profile matches \"{request.Profile}\"");
+        }
+
+        if (profileIndex >= 0 && profileIndex != _profileManagementService.GetActiveProfileIndex()
+            && !_profileManagementService.SwitchProfile(profileIndex))
+            return Fail(action, $"Could not switch to profile \"{request.Profile}\"");
+
+        if (instance != null) _instanceService.SetSelectedInstance(instance.Id);
+
+        return request.Launch ? QuickLaunch() : new QuickActionResult { Action = action, Success = true };
+    }
+
+    private QuickActionResult QuickLaunch()
+    {
+        if (_gameProcessService.IsGameRunning())
+            return Fail(QuickActions.QuickLaunch, "The game is already running");
+
+        var instance = _instanceService.GetSelectedInstance();
+        if (instance == null)
+            return Fail(QuickActions.QuickLaunch, "No instance is selected");
+
+        // Same path as the Play button; progress and errors reach the UI as usual
+        _ = Task.Run(async () =>
+        {
+            try { await _gameSessionService.DownloadAndLaunchAsync(); }
+            catch (Exception ex) { Logger.Error("QuickAction", $"Quick launch failed: {ex.Message}"); }
+        });
+        return new QuickActionResult { Action = QuickActions.QuickLaunch, Success = true };
+    }
+
+    private QuickActionResult KillGame()
+    {
+        if (!_gameProcessService.IsGameRunning())
+            return Fail(QuickActions.KillGame, "The game is not running");
+
+        return _gameProcessService.ExitGame()
+            ? new QuickActionResult { Action = QuickActions.KillGame, Success = true }
+            : Fail(QuickActions.KillGame, "The game could not be closed");
+    }
+
+    private QuickActionResult ToggleMusic()
+    {
+        var enabled = !_settingsService.GetMusicEnabled();
+        _settingsService.SetMusicEnabled(enabled);
+        MusicToggled?.Invoke(enabled);
+        return new QuickActionResult { Action = QuickActions.ToggleMusic, Success = true };
+    }
+
+    private static QuickActionResult Fail(string action, string message)
+    {
+        Logger.Warning("QuickAction", $"{action}: {message}");
+        return new QuickActionResult { Action = action, Success = false, Message = message };
+    }
+}
@@ -0,0 +1,21 @@
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Names of the quick actions shared by global hotkeys, the automation API and the frontend.
+/// </summary>
+public static class QuickActions
+{
+    /// <summary>Installs if needed and launches the selected instance.</summary>
+    public const string QuickLaunch = "quickLaunch";
+
+    /// <summary>Closes the running game.</summary>
+    public const string KillGame = "killGame";
+
+    /// <summary>Mutes or unmutes the launcher music.</summary>
+    public const string ToggleMusic = "toggleMusic";
+
+    /// <summary>
+    /// All quick actions in display order.
+    /// </summary>
+    public static readonly IReadOnlyList<string> All = [QuickLaunch, KillGame, ToggleMusic];
+}
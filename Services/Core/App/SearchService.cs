@@ -0,0 +1,280 @@
+using System.Collections.Concurrent;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Mod;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Launcher-wide search over local content and the cached remote feeds.
+/// </summary>
+/// <remarks>
+/// Every word of the query has to appear in a result's title or keywords. Exact and prefix matches on
+/// the title rank above matches further in, and local content ranks above CurseForge mods and news,
+/// which are weighted down. Settings are matched against their English labels and keywords, since
+/// translations only exist in the frontend.
+/// </remarks>
+public class SearchService : ISearchService
+{
+    private static readonly TimeSpan RemoteTimeout = TimeSpan.FromSeconds(4);
+    private static readonly TimeSpan RemoteCacheDuration = TimeSpan.FromMinutes(10);
+    private const int MaxCachedQueries = 50;
+    private const int RemotePageSize = 10;
+    private const int NewsCount = 30;
+
+    private const double ModWeight = 0.8;
+    private const double NewsWeight = 0.7;
+
+    private sealed record SettingEntry(string Id, string Tab, string TitleKey, string Title, string Keywords);
+
+    /// <summary>
+    /// Settings that can be found, with the tab of the settings page they are on.
+    /// </summary>
+    private static readonly SettingEntry[] Settings =
+    [
+        new("closeAfterLaunch", "general", "settings.generalSettings.closeLauncher", "Close after launch", "exit quit"),
+        new("minimizeOnLaunch", "general", "settings.generalSettings.minimizeLauncher", "Minimize on launch", "hide tray"),
+        new("restoreOnExit", "general", "settings.generalSettings.restoreLauncher", "Restore when game exits", "show window"),
+        new("updateChannel", "general", "settings.generalSettings.updateChannel", "Update channel", "beta stable experimental release"),
+        new("launcherStorage", "general", "settings.generalSettings.launcherStorage", "Launcher storage", "folder directory path"),
+        new("showAlphaMods", "general", "settings.generalSettings.showAlphaMods", "Show alpha mods", "curseforge release type"),
+        new("errorReporting", "general", "settings.generalSettings.errorReporting", "Send error reports", "crash telemetry privacy"),
+        new("globalHotkeys", "general", "settings.generalSettings.globalHotkeys", "Global hotkeys", "shortcut keyboard quick launch"),
+        new("verifyFiles", "general", "settings.generalSettings.verifyFilesBeforeLaunch", "Check game files before launch", "integrity verify repair"),
+        new("automationApi", "general", "settings.generalSettings.automationApi", "Automation API", "stream deck script token"),
+        new("gamepadMode", "general", "settings.generalSettings.gamepadMode", "Gamepad navigation", "controller steam deck"),
+        new("accentColor", "visual", "settings.visualSettings.accentColor", "Accent color", "theme colour"),
+        new("background", "visual", "settings.visualSettings.background", "Background", "wallpaper slideshow image"),
+        new("hideNews", "visual", "settings.visualSettings.hideNews", "Hide news", "panel"),
+        new("onlineMode", "network", "settings.networkSettings.onlineMode", "Online mode", "multiplayer offline"),
+        new("authServer", "network", "settings.networkSettings.authServer", "Auth server", "authentication login domain custom official"),
+        new("gpuPreference", "graphics", "settings.graphicsSettings.gpuPreference", "GPU preference", "graphics card dedicated integrated nvidia amd"),
+        new("optimizationMods", "graphics", "settings.graphicsSettings.optimizationMods", "Optimization mods", "performance fps"),
+        new("language", "language", "settings.languageSettings.interfaceLanguage", "Interface language", "locale translation"),
+        new("gameDirectory", "data", "settings.dataSettings.gameDirectory", "Game directory", "instances folder path move"),
+        new("launcherData", "data", "settings.dataSettings.launcherData", "Launcher data", "folder path delete reset"),
+    ];
+
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IWorldService _worldService;
+    private readonly IModService _modService;
+    private readonly INewsService _newsService;
+    private readonly ConcurrentDictionary<string, (DateTime FetchedAt, List<ModInfo> Mods)> _remoteMods = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="SearchService"/> class.
+    /// </summary>
+    /// <param name="configService">Lists the instances.</param>
+    /// <param name="instanceService">Resolves instance folders.</param>
+    /// <param name="worldService">Resolves the saves folders.</param>
+    /// <param name="modService">Reads installed mods and searches CurseForge.</param>
+    /// <param name="newsService">Provides the cached news feed.</param>
+    public SearchService(IConfigService configService, IInstanceService instanceService, IWorldService worldService,
+        IModService modService, INewsService newsService)
+    {
+        _configService = configService;
+        _instanceService = instanceService;
+        _worldService = worldService;
+        _modService = modService;
+        _newsService = newsService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<SearchResult>> SearchAsync(string query, int limit = 20, bool includeRemote = true, CancellationToken ct = default)
+    {
+        var words = query.Split(' ', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries);
+        if (words.Length == 0) return [];
+        query = string.Join(' ', words);
+
+        var remoteMods = includeRemote ? WithTimeout(SearchRemoteModsAsync(query, ct), ct) : Task.FromResult<List<ModInfo>>([]);
+        var news = WithTimeout(GetNewsAsync(), ct);
+
+        var results = new List<SearchResult>();
+        try
+        {
+            results.AddRange(SearchLocal(query, words));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Search", $"Local search failed: {ex.Message}");
+        }
+
+        foreach (var mod in await remoteMods)
+        {
+            var score = Score(query, words, mod.Name, $"{mod.Author} {mod.Slug} {string.Join(' ', mod.Categories)}") * ModWeight;
+            if (score <= 0) continue;
+            results.Add(new SearchResult
+            {
+                Kind = SearchResultKind.Mod,
+                Id = mod.Id,
+                Title = mod.Name,
+                Subtitle = mod.Author,
+                Target = string.IsNullOrEmpty(mod.Slug) ? null : $"https://www.curseforge.com/hytale/mods/{mod.Slug}",
+                IconUrl = mod.IconUrl,
+                Score = score
+            });
+        }
+
+        foreach (var item in await news)
+        {
+            var score = Score(query, words, item.Title, item.Excerpt) * NewsWeight;
+            if (score <= 0) continue;
+            results.Add(new SearchResult
+            {
+                Kind = SearchResultKind.News,
+                Id = item.Url,
+                Title = item.Title,
+                Subtitle = item.Date,
+                Target = item.Url,
+                IconUrl = item.ImageUrl,
+                Score = score
+            });
+        }
+
+        return results
+            .OrderByDescending(r => r.Score)
+            .ThenBy(r => r.Title, StringComparer.OrdinalIgnoreCase)
+            .Take(Math.Max(1, limit))
+            .ToList();
+    }
+
+    private IEnumerable<SearchResult> SearchLocal(string query, string[] words)
+    {
+        foreach (var setting in Settings)
+        {
+            var score = Score(query, words, setting.Title, $"{setting.Keywords} {setting.Tab}");
+            if (score > 0)
+                yield return new SearchResult
+                {
+                    Kind = SearchResultKind.Setting,
+                    Id = setting.Id,
+                    Title = setting.Title,
+                    TitleKey = setting.TitleKey,
+                    Target = setting.Tab,
+                    Score = score
+                };
+        }
+
+        foreach (var instance in _configService.Configuration.Instances ?? [])
+        {
+            var version = instance.Version > 0 ? $"v{instance.Version}" : "latest";
+            var score = Score(query, words, instance.Name, $"{instance.Branch} {version}");
+            if (score > 0)
+                yield return new SearchResult
+                {
+                    Kind = SearchResultKind.Instance,
+                    Id = instance.Id,
+                    Title = instance.Name,
+                    Subtitle = $"{instance.Branch} {version}",
+                    InstanceId = instance.Id,
+                    Score = score
+                };
+
+            // Folder names only: GetWorlds also sums up every world's size, which is too slow to run per keystroke
+            var savesDir = _worldService.GetSavesDir(instance.Id);
+            if (savesDir != null && Directory.Exists(savesDir))
+            {
+                foreach (var worldDir in Directory.EnumerateDirectories(savesDir))
+                {
+                    var name = Path.GetFileName(worldDir);
+                    score = Score(query, words, name, instance.Name);
+                    if (score > 0)
+                        yield return new SearchResult
+                        {
+                            Kind = SearchResultKind.World,
+                            Id = name,
+                            Title = name,
+                            Subtitle = instance.Name,
+                            InstanceId = instance.Id,
+                            Score = score
+                        };
+                }
+            }
+
+            var instancePath = _instanceService.GetInstancePathById(instance.Id);
+            if (string.IsNullOrEmpty(instancePath)) continue;
+            foreach (var mod in _modService.GetInstanceInstalledMods(instancePath))
+            {
+                score = Score(query, words, mod.Name, $"{mod.Author} {mod.Slug} {instance.Name}");
+                if (score > 0)
+                    yield return new SearchResult
+                    {
+                        Kind = SearchResultKind.InstalledMod,
+                        Id = mod.Id,
+                        Title = mod.Name,
+                        Subtitle = instance.Name,
+                        InstanceId = instance.Id,
+                        IconUrl = mod.IconUrl,
+                        Score = score
+                    };
+            }
+        }
+    }
+
+    private async Task<List<ModInfo>> SearchRemoteModsAsync(string query, CancellationToken ct)
+    {
+        var key = query.ToLowerInvariant();
+        if (_remoteMods.TryGetValue(key, out var cached) && DateTime.UtcNow - cached.FetchedAt < RemoteCacheDuration)
+            return cached.Mods;
+
+        var result = await _modService.SearchModsAsync(new SearchModsParams { Query = query, PageSize = RemotePageSize, SortField = 2 });
+        ct.ThrowIfCancellationRequested();
+        // Failed lookups are retried on the next search instead of being served from the cache
+        if (result.MessageKey != null) return result.Mods;
+
+        if (_remoteMods.Count >= MaxCachedQueries)
+        {
+            foreach (var oldest in _remoteMods.OrderBy(p => p.Value.FetchedAt).Take(_remoteMods.Count - MaxCachedQueries + 1))
+                _remoteMods.TryRemove(oldest.Key, out _);
+        }
+        _remoteMods[key] = (DateTime.UtcNow, result.Mods);
+        return result.Mods;
+    }
+
+    private async Task<List<NewsItemResponse>> GetNewsAsync() =>
+        (await _newsService.GetNewsFeedAsync(NewsCount)).Items;
+
+    /// <summary>
+    /// Waits at most <see cref="RemoteTimeout"/> for a lookup and gives up on it quietly after that.
+    /// </summary>
+    private static async Task<List<T>> WithTimeout<T>(Task<List<T>> task, CancellationToken ct)
+    {
+        try
+        {
+            var finished = await Task.WhenAny(task, Task.Delay(RemoteTimeout, ct));
+            if (finished == task) return await task;
+            Logger.Warning("Search", "A remote lookup took too long and was left out");
+        }
+        catch (OperationCanceledException) { /* search was cancelled */ }
+        catch (Exception ex)
+        {
+            Logger.Warning("Search", $"A remote lookup failed: {ex.Message}");
+        }
+        return [];
+    }
+
+    /// <summary>
+    /// Scores how well <paramref name="title"/> and <paramref name="keywords"/> match the query;
+    /// 0 when a query word appears in neither.
+    /// </summary>
+    private static double Score(string query, string[] words, string title, string? keywords)
+    {
+        if (string.IsNullOrEmpty(title)) return 0;
+        var haystack = $"{title} {keywords}";
+        if (!words.All(w => haystack.Contains(w, StringComparison.OrdinalIgnoreCase))) return 0;
+
+        if (title.Equals(query, StringComparison.OrdinalIgnoreCase)) return 100;
+        if (title.StartsWith(query, StringComparison.OrdinalIgnoreCase)) return 80;
+
+        var titleWords = title.Split([' ', '-', '_', '.'], StringSplitOptions.RemoveEmptyEntries);
+        if (words.All(w => titleWords.Any(t => t.StartsWith(w, StringComparison.OrdinalIgnoreCase)))) return 60;
+        if (title.Contains(query, StringComparison.OrdinalIgnoreCase)) return 50;
+        if (words.All(w => title.Contains(w, StringComparison.OrdinalIgnoreCase))) return 40;
+
+        // Some words only matched keywords
+        return 20;
+    }
+}
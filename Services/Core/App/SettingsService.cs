@@ -131,7 +131,7 @@ public class SettingsService : ISettingsService
     }
     #pragma warning restore CS0618
 
-    // ========== Close After Launch Setting ==========
+    // ========== Launch Behavior Settings ==========
     
     /// <inheritdoc/>
     public bool GetCloseAfterLaunch() => _configService.Configuration.CloseAfterLaunch;
@@ -144,6 +144,42 @@ public class SettingsService : ISettingsService
         Logger.Info("Config", $"Close after launch set to: {enabled}");
         return true;
     }
+    
+    /// <inheritdoc/>
+    public bool GetMinimizeOnLaunch() => _configService.Configuration.MinimizeOnLaunch;
+    
+    /// <inheritdoc/>
+    public bool SetMinimizeOnLaunch(bool enabled)
+    {
+        _configService.Configuration.MinimizeOnLaunch = enabled;
+        _configService.SaveConfig();
+        Logger.Info("Config", $"Minimize on launch set to: {enabled}");
+        return true;
+    }
+    
+    /// <inheritdoc/>
+    public bool GetRestoreOnGameExit() => _configService.Configuration.RestoreOnGameExit;
+    
+    /// <inheritdoc/>
+    public bool SetRestoreOnGameExit(bool enabled)
+    {
+        _configService.Configuration.RestoreOnGameExit = enabled;
+        _configService.SaveConfig();
+        Logger.Info("Config", $"Restore on game exit set to: {enabled}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public bool GetVerifyFilesBeforeLaunch() => _configService.Configuration.VerifyFilesBeforeLaunch;
+    
+    /// <inheritdoc/>
+    public bool SetVerifyFilesBeforeLaunch(bool enabled)
+    {
+        _configService.Configuration.VerifyFilesBeforeLaunch = enabled;
+        _configService.SaveConfig();
+        Logger.Info("Config", $"Verify files before launch set to: {enabled}");
+        return true;
+    }
 
     // ========== Discord Announcements Settings ==========
     
@@ -313,5 +349,56 @@ public class SettingsService : ISettingsService
         return true;
     }
 
+    /// <inheritdoc/>
+    public string GetProxyUrl() => HttpClientFactory.Redact(_configService.Configuration.ProxyUrl);
+
+    /// <inheritdoc/>
+    public bool SetProxyUrl(string proxyUrl)
+    {
+        proxyUrl = proxyUrl?.Trim() ?? "";
+        if (proxyUrl.Length > 0 && !HttpClientFactory.TryParseProxy(proxyUrl, out _))
+        {
+            Logger.Warning("Config", $"Rejected invalid proxy URL: {HttpClientFactory.Redact(proxyUrl)}");
+            return false;
+        }
+
+        // The settings UI echoes back the masked URL; keep the stored password in that case
+        if (proxyUrl.Contains(":***@") && HttpClientFactory.Redact(_configService.Configuration.ProxyUrl) == proxyUrl)
+        {
+            return true;
+        }
+
+        _configService.Configuration.ProxyUrl = proxyUrl;
+        _configService.SaveConfig();
+        Logger.Info("Config", proxyUrl.Length > 0 ? $"Proxy set to: {HttpClientFactory.Redact(proxyUrl)}" : "Proxy cleared, using system settings");
+        return true;
+    }
+
+    // ========== Metered Connection Settings ==========
+
+    /// <inheritdoc/>
+    public bool GetMeteredConnection() => _configService.Configuration.MeteredConnection;
+
+    /// <inheritdoc/>
+    public bool SetMeteredConnection(bool metered)
+    {
+        _configService.Configuration.MeteredConnection = metered;
+        _configService.SaveConfig();
+        Logger.Info("Config", $"Metered connection set to: {metered}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public int GetMeteredConfirmThresholdMb() => _configService.Configuration.MeteredConfirmThresholdMb;
+
+    /// <inheritdoc/>
+    public bool SetMeteredConfirmThresholdMb(int thresholdMb)
+    {
+        _configService.Configuration.MeteredConfirmThresholdMb = Math.Max(0, thresholdMb);
+        _configService.SaveConfig();
+        Logger.Info("Config", $"Metered download confirmation threshold set to: {_configService.Configuration.MeteredConfirmThresholdMb} MB");
+        return true;
+    }
+
     public string GetInstanceDirectory() => _configService.Configuration.InstanceDirectory;
 }
@@ -0,0 +1,130 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Handle given to the owner of a background task for reporting progress and completion.
+/// Disposing a handle that was never finished marks the task as completed.
+/// </summary>
+public sealed class TaskHandle : IDisposable
+{
+    private readonly CancellationTokenSource _cts = new();
+    private readonly Action<TaskHandle> _onChanged;
+    private readonly Action? _onCancel;
+    private readonly object _lock = new();
+
+    internal BackgroundTaskInfo Info { get; }
+
+    /// <summary>
+    /// The task identifier.
+    /// </summary>
+    public string Id => Info.Id;
+
+    /// <summary>
+    /// Cancelled when the user cancels the task through the task manager.
+    /// </summary>
+    public CancellationToken Token => _cts.Token;
+
+    /// <summary>
+    /// Whether the task has reached a terminal state.
+    /// </summary>
+    public bool IsFinished
+    {
+        get { lock (_lock) return Info.State != "running"; }
+    }
+
+    internal TaskHandle(BackgroundTaskInfo info, Action<TaskHandle> onChanged, Action? onCancel)
+    {
+        Info = info;
+        _onChanged = onChanged;
+        _onCancel = onCancel;
+    }
+
+    /// <summary>
+    /// Reports progress for the task.
+    /// </summary>
+    /// <param name="progress">Percentage (0-100), or -1 when indeterminate.</param>
+    /// <param name="messageKey">Optional localization key for the current step.</param>
+    public void Report(double progress, string? messageKey = null)
+    {
+        lock (_lock)
+        {
+            if (Info.State != "running") return;
+            Info.Progress = progress < 0 ? -1 : Math.Min(100, progress);
+            if (messageKey != null) Info.MessageKey = messageKey;
+        }
+        _onChanged(this);
+    }
+
+    /// <summary>
+    /// Marks the task as successfully completed.
+    /// </summary>
+    public void Complete() => Finish("completed", null);
+
+    /// <summary>
+    /// Marks the task as failed.
+    /// </summary>
+    /// <param name="error">Error description.</param>
+    public void Fail(string error) => Finish("failed", error);
+
+    /// <summary>
+    /// Marks the task as cancelled.
+    /// </summary>
+    public void MarkCancelled() => Finish("cancelled", null);
+
+    internal BackgroundTaskInfo Snapshot()
+    {
+        lock (_lock)
+        {
+            return new BackgroundTaskInfo
+            {
+                Id = Info.Id,
+                Kind = Info.Kind,
+                Title = Info.Title,
+                State = Info.State,
+                Progress = Info.Progress,
+                MessageKey = Info.MessageKey,
+                Error = Info.Error,
+                CanCancel = Info.CanCancel && Info.State == "running",
+                StartedAt = Info.StartedAt,
+                FinishedAt = Info.FinishedAt
+            };
+        }
+    }
+
+    internal bool RequestCancel()
+    {
+        lock (_lock)
+        {
+            if (Info.State != "running" || !Info.CanCancel) return false;
+        }
+
+        _cts.Cancel();
+        try { _onCancel?.Invoke(); } catch { /* owner handles its own cleanup */ }
+        return true;
+    }
+
+    private void Finish(string state, string? error)
+    {
+        lock (_lock)
+        {
+            if (Info.State != "running") return;
+            Info.State = state;
+            Info.Error = error;
+            Info.FinishedAt = DateTime.UtcNow;
+            if (state == "completed") Info.Progress = 100;
+        }
+        _onChanged(this);
+    }
+
+    /// <inheritdoc/>
+    public void Dispose()
+    {
+        if (!IsFinished)
+        {
+            if (_cts.IsCancellationRequested) MarkCancelled();
+            else Complete();
+        }
+        _cts.Dispose();
+    }
+}
@@ -0,0 +1,112 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.App;
+
+/// <summary>
+/// Tracks background operations so the frontend can list them, follow their progress
+/// through a single "task updated" stream and cancel them.
+/// </summary>
+public class TaskManagerService : ITaskManagerService
+{
+    private const int MaxFinishedTasks = 50;
+
+    private readonly object _lock = new();
+    private readonly List<TaskHandle> _tasks = new();
+
+    /// <inheritdoc/>
+    public event Action<BackgroundTaskInfo>? TaskUpdated;
+
+    /// <inheritdoc/>
+    public TaskHandle Begin(string kind, string title, Action? onCancel = null, bool cancellable = true)
+    {
+        var info = new BackgroundTaskInfo
+        {
+            Id = Guid.NewGuid().ToString("N")[..12],
+            Kind = kind,
+            Title = title,
+            CanCancel = cancellable
+        };
+
+        var handle = new TaskHandle(info, OnTaskChanged, onCancel);
+        lock (_lock)
+        {
+            _tasks.Add(handle);
+            PruneFinished();
+        }
+
+        Logger.Debug("Tasks", $"Started task {info.Id} ({kind}): {title}");
+        OnTaskChanged(handle);
+        return handle;
+    }
+
+    /// <inheritdoc/>
+    public async Task<T> RunAsync<T>(string kind, string title, Func<TaskHandle, Task<T>> work, bool cancellable = true)
+    {
+        using var handle = Begin(kind, title, cancellable: cancellable);
+        try
+        {
+            var result = await work(handle);
+            handle.Complete();
+            return result;
+        }
+        catch (OperationCanceledException)
+        {
+            handle.MarkCancelled();
+            throw;
+        }
+        catch (Exception ex)
+        {
+            handle.Fail(ex.Message);
+            throw;
+        }
+    }
+
+    /// <inheritdoc/>
+    public IReadOnlyList<BackgroundTaskInfo> ListTasks()
+    {
+        lock (_lock)
+        {
+            return _tasks
+                .Select(t => t.Snapshot())
+                .OrderBy(t => t.State == "running" ? 0 : 1)
+                .ThenByDescending(t => t.StartedAt)
+                .ToList();
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool CancelTask(string taskId)
+    {
+        TaskHandle? handle;
+        lock (_lock)
+        {
+            handle = _tasks.FirstOrDefault(t => t.Id == taskId);
+        }
+
+        if (handle == null || !handle.RequestCancel())
+        {
+            return false;
+        }
+
+        Logger.Info("Tasks", $"Cancellation requested for task {taskId}");
+        return true;
+    }
+
+    private void OnTaskChanged(TaskHandle handle)
+    {
+        try { TaskUpdated?.Invoke(handle.Snapshot()); }
+        catch (Exception ex) { Logger.Warning("Tasks", $"Task update listener failed: {ex.Message}"); }
+    }
+
+    private void PruneFinished()
+    {
+        var finished = _tasks.Where(t => t.IsFinished).ToList();
+        if (finished.Count <= MaxFinishedTasks) return;
+
+        foreach (var old in finished.OrderBy(t => t.Info.FinishedAt).Take(finished.Count - MaxFinishedTasks))
+        {
+            _tasks.Remove(old);
+        }
+    }
+}
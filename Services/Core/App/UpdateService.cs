@@ -7,6 +7,7 @@ using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
 using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Game;
+using HyPrism.Services.Game.Download;
 using HyPrism.Services.Game.Instance;
 using HyPrism.Services.Game.Version;
 
@@ -19,11 +20,14 @@ namespace HyPrism.Services.Core.App;
 /// <remarks>
 /// Checks GitHub releases API for new versions and handles the download,
 /// extraction, and restart process for self-updating.
+/// The result of each check is stored in <c>Cache/launcher-update.json</c> so startup can show it at once;
+/// <see cref="GetLastUpdateCheck"/> refreshes it in the background after <see cref="UpdateCheckMaxAge"/>.
 /// </remarks>
 public class UpdateService : IUpdateService
 {
     private const string GitHubApiUrl = "https://api.github.com/repos/yyyumeniku/HyPrism/releases";
     private const string ReleasesPageUrl = "https://github.com/yyyumeniku/HyPrism/releases/latest";
+    private static readonly TimeSpan UpdateCheckMaxAge = TimeSpan.FromHours(6);
     
     private static readonly Lazy<string> _launcherVersion = new(() =>
     {
@@ -41,12 +45,15 @@ public class UpdateService : IUpdateService
         return version != null ? $"{version.Major}.{version.Minor}.{version.Build}" : "0.0.0";
     });
     
+    private readonly string _appDir;
     private readonly HttpClient _httpClient;
+    private readonly IDownloadService _downloadService;
     private readonly ConfigService _configService;
     private readonly VersionService _versionService;
     private readonly InstanceService _instanceService;
     private readonly BrowserService _browserService;
     private readonly ProgressNotificationService _progressNotificationService;
+    private int _checkRunning;
     
     /// <summary>
     /// Raised when a launcher update is available.
@@ -56,21 +63,27 @@ public class UpdateService : IUpdateService
     /// <summary>
     /// Initializes a new instance of the <see cref="UpdateService"/> class.
     /// </summary>
+    /// <param name="appDir">The application data directory; the last check result is kept under <c>Cache</c>.</param>
     /// <param name="httpClient">The HTTP client for API requests.</param>
+    /// <param name="downloadService">The download service for verified update downloads.</param>
     /// <param name="configService">The configuration service.</param>
     /// <param name="versionService">The version service for version checks.</param>
     /// <param name="instanceService">The instance service for path management.</param>
     /// <param name="browserService">The browser service for opening URLs.</param>
     /// <param name="progressNotificationService">The progress notification service.</param>
     public UpdateService(
+        string appDir,
         HttpClient httpClient,
+        IDownloadService downloadService,
         ConfigService configService,
         VersionService versionService,
         InstanceService instanceService,
         BrowserService browserService,
         ProgressNotificationService progressNotificationService)
     {
+        _appDir = appDir;
         _httpClient = httpClient;
+        _downloadService = downloadService;
         _configService = configService;
         _versionService = versionService;
         _instanceService = instanceService;
@@ -81,21 +94,11 @@ public class UpdateService : IUpdateService
     private Config _config => _configService.Configuration;
 
     /// <summary>
-    /// Gets the path to the latest game instance for the current branch.
+    /// Gets the path to the latest game instance of a branch.
     /// </summary>
+    /// <param name="branch">The normalized game branch.</param>
     /// <returns>The path to the latest instance directory.</returns>
-    private string GetLatestInstancePath()
-    {
-        #pragma warning disable CS0618 // Backward compatibility: VersionType kept for migration
-        var branch = UtilityService.NormalizeVersionType(_config.VersionType);
-        #pragma warning restore CS0618
-        var info = _instanceService.LoadLatestInfo(branch);
-        if (info != null)
-        {
-            return _instanceService.ResolveInstancePath(branch, info.Version, true);
-        }
-        return _instanceService.ResolveInstancePath(branch, 0, true);
-    }
+    private string GetLatestInstancePath(string branch) => _instanceService.ResolveInstancePath(branch, 0, true);
 
     #region Public API
 
@@ -204,21 +207,23 @@ public class UpdateService : IUpdateService
                     }
                 }
 
-                var updateInfo = new
+                var updateInfo = new LauncherUpdateInfo
                 {
-                    version = bestVersion,
-                    currentVersion = currentVersion,
-                    downloadUrl = downloadUrl ?? "",
-                    assetName = assetName ?? "",
-                    releaseUrl = release.GetProperty("html_url").GetString() ?? "",
-                    isBeta = launcherBranch == "beta"
+                    Version = bestVersion,
+                    CurrentVersion = currentVersion,
+                    DownloadUrl = downloadUrl ?? "",
+                    AssetName = assetName ?? "",
+                    ReleaseUrl = release.GetProperty("html_url").GetString() ?? "",
+                    IsBeta = launcherBranch == "beta"
                 };
-                    
+
+                SaveLastUpdateCheck(currentVersion, launcherBranch, updateInfo);
                 LauncherUpdateAvailable?.Invoke(updateInfo);
             }
             else
             {
                 Logger.Info("Update", $"Launcher is up to date: {currentVersion} (channel: {launcherBranch})");
+                SaveLastUpdateCheck(currentVersion, launcherBranch, null);
             }
         }
         catch (Exception ex)
@@ -227,6 +232,66 @@ public class UpdateService : IUpdateService
         }
     }
 
+    /// <inheritdoc/>
+    public LauncherUpdateCheck? GetLastUpdateCheck()
+    {
+        var last = LoadLastUpdateCheck();
+        if (last != null && (last.LauncherVersion != GetLauncherVersion() || last.Branch != GetLauncherBranch()))
+        {
+            last = null;
+        }
+
+        if ((last == null || DateTime.UtcNow - last.CheckedAtUtc > UpdateCheckMaxAge)
+            && Interlocked.Exchange(ref _checkRunning, 1) == 0)
+        {
+            _ = Task.Run(async () =>
+            {
+                try { await CheckForLauncherUpdatesAsync(); }
+                finally { Interlocked.Exchange(ref _checkRunning, 0); }
+            });
+        }
+
+        return last;
+    }
+
+    private string GetUpdateCheckPath() => Path.Combine(_appDir, "Cache", "launcher-update.json");
+
+    private LauncherUpdateCheck? LoadLastUpdateCheck()
+    {
+        try
+        {
+            var path = GetUpdateCheckPath();
+            if (!File.Exists(path)) return null;
+            return JsonSerializer.Deserialize<LauncherUpdateCheck>(File.ReadAllText(path));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Update", $"Failed to read last update check: {ex.Message}");
+            return null;
+        }
+    }
+
+    private void SaveLastUpdateCheck(string currentVersion, string launcherBranch, LauncherUpdateInfo? update)
+    {
+        try
+        {
+            var path = GetUpdateCheckPath();
+            Directory.CreateDirectory(Path.GetDirectoryName(path)!);
+            var check = new LauncherUpdateCheck
+            {
+                CheckedAtUtc = DateTime.UtcNow,
+                LauncherVersion = currentVersion,
+                Branch = launcherBranch,
+                Update = update
+            };
+            File.WriteAllText(path, JsonSerializer.Serialize(check, new JsonSerializerOptions { WriteIndented = true }));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Update", $"Failed to save last update check: {ex.Message}");
+        }
+    }
+
     /// <summary>
     /// Скачивает и устанавливает обновление лаунчера.
     /// После успешной загрузки автоматически заменяет текущий файл и перезапускает лаунчер.
@@ -308,6 +373,7 @@ public class UpdateService : IUpdateService
 
             string? downloadUrl = null;
             string? assetName = null;
+            ExpectedHash? expectedHash = null;
             foreach (var asset in assets.EnumerateArray())
             {
                 var name = asset.GetProperty("name").GetString();
@@ -315,6 +381,11 @@ public class UpdateService : IUpdateService
                 {
                     downloadUrl = asset.GetProperty("browser_download_url").GetString();
                     assetName = name;
+                    // GitHub publishes "sha256:<hex>" for assets uploaded since mid-2025
+                    if (asset.TryGetProperty("digest", out var digest) && digest.ValueKind == JsonValueKind.String)
+                    {
+                        expectedHash = ExpectedHash.FromDigest(digest.GetString());
+                    }
                     break;
                 }
             }
@@ -331,17 +402,18 @@ public class UpdateService : IUpdateService
             var targetPath = Path.Combine(downloadsDir, assetName);
 
             Logger.Info("Update", $"Downloading latest launcher to {targetPath}");
-            using (var response = await _httpClient.GetAsync(downloadUrl, HttpCompletionOption.ResponseHeadersRead))
-            {
-                response.EnsureSuccessStatusCode();
-                await using var stream = await response.Content.ReadAsStreamAsync();
-                await using var file = new FileStream(targetPath, FileMode.Create, FileAccess.Write, FileShare.None);
-                var buffer = new byte[8192];
-                int read;
-                while ((read = await stream.ReadAsync(buffer)) > 0)
-                {
-                    await file.WriteAsync(buffer.AsMemory(0, read));
-                }
+            if (expectedHash == null)
+            {
+                Logger.Warning("Update", $"No digest published for {assetName}, skipping verification");
+            }
+            // An older file with the same name must not be resumed into
+            if (File.Exists(targetPath)) File.Delete(targetPath);
+            using (var operation = _progressNotificationService.BeginOperation("launcher-update"))
+            {
+                await _downloadService.DownloadFileAsync(downloadUrl, targetPath,
+                    p => operation.Report("download", p.Percent, p, "launch.detail.downloading_launcher_update"),
+                    expectedHash);
+                operation.Complete();
             }
 
             // Platform-specific installation
@@ -349,6 +421,11 @@ public class UpdateService : IUpdateService
             
             return true;
         }
+        catch (DownloadDeclinedException)
+        {
+            Logger.Info("Update", "Launcher update download declined");
+            return false;
+        }
         catch (Exception ex)
         {
             Logger.Error("Update", $"Update failed: {ex.Message}");
@@ -419,7 +496,7 @@ public class UpdateService : IUpdateService
             }
             
             var currentVersion = info.Version;
-            var latestPath = GetLatestInstancePath();
+            var latestPath = GetLatestInstancePath(normalizedBranch);
             
             if (!_instanceService.IsClientPresent(latestPath))
             {
@@ -439,7 +516,14 @@ public class UpdateService : IUpdateService
             
             // Copy the entire latest instance folder to versioned folder
             Logger.Info("Update", $"Duplicating latest (v{currentVersion}) to versioned instance...");
-            UtilityService.CopyDirectory(latestPath, versionedPath);
+            int lastPercent = -1;
+            await Task.Run(() => UtilityService.CopyDirectory(latestPath, versionedPath, true, (copied, total) =>
+            {
+                int percent = total > 0 ? (int)(copied * 100 / total) : 100;
+                if (percent == lastPercent) return;
+                lastPercent = percent;
+                Logger.Progress("Update", percent, $"Copying v{currentVersion}");
+            }));
             
             // Save version info for the duplicated instance
             var versionInfoPath = Path.Combine(versionedPath, "version.json");
@@ -0,0 +1,163 @@
+using System.Text.Json;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Keeps monthly download totals per category in <c>bandwidth.json</c>.
+/// </summary>
+/// <remarks>
+/// Bytes are fed in by <see cref="HttpClientFactory"/> as response bodies are read, so cancelled and
+/// failed downloads count for what was received. Compressed API responses count their decompressed
+/// size. Totals are written at most every <see cref="SaveInterval"/> and on exit; the last
+/// <see cref="KeptMonths"/> months are kept.
+/// </remarks>
+public class BandwidthService : IBandwidthService
+{
+    private const int KeptMonths = 12;
+    private static readonly TimeSpan SaveInterval = TimeSpan.FromSeconds(30);
+
+    private readonly string _path;
+    private readonly object _lock = new();
+    private readonly object _saveLock = new();
+    private readonly Dictionary<string, Dictionary<string, long>> _months;
+    private bool _dirty;
+    private DateTime _savedAt = DateTime.MinValue;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="BandwidthService"/> class.
+    /// </summary>
+    /// <param name="appDir">The application data directory holding <c>bandwidth.json</c>.</param>
+    public BandwidthService(string appDir)
+    {
+        _path = Path.Combine(appDir, "bandwidth.json");
+        _months = Load();
+        AppDomain.CurrentDomain.ProcessExit += (_, _) => Save();
+    }
+
+    /// <summary>
+    /// Picks the category of a request from its URL, before any redirect.
+    /// </summary>
+    /// <param name="uri">The request URL.</param>
+    /// <returns>A <see cref="TrafficCategory"/> value.</returns>
+    public static string Classify(Uri? uri)
+    {
+        if (uri == null) return TrafficCategory.Other;
+        var host = uri.Host.ToLowerInvariant();
+        var path = uri.AbsolutePath.ToLowerInvariant();
+
+        if (host.EndsWith("forgecdn.net") || host.EndsWith("curseforge.com"))
+            return TrafficCategory.Mods;
+        if (host == "api.adoptium.net" || path.Contains("/redist/jre/") || path.Contains("temurin"))
+            return TrafficCategory.Java;
+        if (host == "github.com" && path.Contains("/hyprism/releases/download/"))
+            return TrafficCategory.Updates;
+        if (path.EndsWith(".pwr") || host == "game-patches.hytale.com" || host == "broth.itch.zone")
+            return TrafficCategory.Game;
+        return TrafficCategory.Other;
+    }
+
+    /// <inheritdoc/>
+    public void Record(string category, long bytes)
+    {
+        if (bytes <= 0) return;
+
+        bool save;
+        lock (_lock)
+        {
+            var month = DateTime.Now.ToString("yyyy-MM");
+            if (!_months.TryGetValue(month, out var categories))
+            {
+                _months[month] = categories = new Dictionary<string, long>();
+                foreach (var old in _months.Keys.OrderByDescending(m => m, StringComparer.Ordinal).Skip(KeptMonths).ToList())
+                {
+                    _months.Remove(old);
+                }
+            }
+            categories[category] = categories.GetValueOrDefault(category) + bytes;
+            _dirty = true;
+            save = DateTime.UtcNow - _savedAt > SaveInterval;
+        }
+
+        if (save) Save();
+    }
+
+    /// <inheritdoc/>
+    public List<BandwidthMonth> GetBandwidthUsage(int months = 1)
+    {
+        var now = DateTime.Now;
+        var result = new List<BandwidthMonth>();
+        lock (_lock)
+        {
+            for (var i = 0; i < Math.Clamp(months, 1, KeptMonths); i++)
+            {
+                var month = now.AddMonths(-i).ToString("yyyy-MM");
+                var categories = _months.TryGetValue(month, out var recorded)
+                    ? new Dictionary<string, long>(recorded)
+                    : new Dictionary<string, long>();
+                result.Add(new BandwidthMonth
+                {
+                    Month = month,
+                    Categories = categories,
+                    TotalBytes = categories.Values.Sum()
+                });
+            }
+        }
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public void Reset()
+    {
+        lock (_lock)
+        {
+            _months.Clear();
+            _dirty = true;
+        }
+        Save();
+        Logger.Info("Bandwidth", "Usage statistics cleared");
+    }
+
+    private Dictionary<string, Dictionary<string, long>> Load()
+    {
+        try
+        {
+            if (File.Exists(_path))
+            {
+                return JsonSerializer.Deserialize<Dictionary<string, Dictionary<string, long>>>(File.ReadAllText(_path))
+                    ?? new Dictionary<string, Dictionary<string, long>>();
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Bandwidth", $"Failed to read usage statistics: {ex.Message}");
+        }
+        return new Dictionary<string, Dictionary<string, long>>();
+    }
+
+    private void Save()
+    {
+        lock (_saveLock)
+        {
+            string json;
+            lock (_lock)
+            {
+                if (!_dirty) return;
+                json = JsonSerializer.Serialize(_months, new JsonSerializerOptions { WriteIndented = true });
+                _dirty = false;
+                _savedAt = DateTime.UtcNow;
+            }
+
+            try
+            {
+                var tmp = _path + ".tmp";
+                File.WriteAllText(tmp, json);
+                File.Move(tmp, _path, overwrite: true);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Bandwidth", $"Failed to save usage statistics: {ex.Message}");
+            }
+        }
+    }
+}
@@ -8,14 +8,37 @@ namespace HyPrism.Services.Core.Infrastructure;
 /// Manages launcher configuration persistence including loading, saving, and automatic migrations.
 /// Configuration is stored as JSON in the application data directory.
 /// </summary>
+/// <remarks>
+/// Startup, settings changes, update checks and background services save from different threads. They all
+/// change the one live <see cref="Config"/> in place, and every write, <see cref="TryUpdate"/> included, holds
+/// <see cref="_writeLock"/> and goes through a temporary file that replaces config.json.
+/// After each successful load the file is copied to <c>config.json.bak</c>; an unreadable config.json is
+/// kept as <c>config.json.corrupt</c> and the backup is loaded instead of starting over with defaults.
+/// </remarks>
 public class ConfigService : IConfigService
 {
+    private const int SerializeAttempts = 3;
+
+    private static readonly JsonSerializerOptions WriteOptions = new()
+    {
+        WriteIndented = true,
+        Encoder = System.Text.Encodings.Web.JavaScriptEncoder.UnsafeRelaxedJsonEscaping
+    };
+
+    private static readonly System.Reflection.PropertyInfo[] ConfigProperties = typeof(Config).GetProperties()
+        .Where(p => p.CanRead && p.CanWrite && p.GetIndexParameters().Length == 0)
+        .ToArray();
+
     private readonly string _configPath;
-    private Config _config;
+    private readonly object _writeLock = new();
+    private volatile Config _config;
     
     /// <inheritdoc/>
     public Config Configuration => _config;
     
+    /// <inheritdoc/>
+    public event Action<Config>? Changed;
+    
     /// <summary>
     /// Initializes a new instance of the <see cref="ConfigService"/> class.
     /// Loads existing configuration or creates a new one with default values.
@@ -40,8 +63,7 @@ public class ConfigService : IConfigService
         {
             if (File.Exists(_configPath))
             {
-                var json = File.ReadAllText(_configPath);
-                config = JsonSerializer.Deserialize<Config>(json) ?? new Config();
+                config = ReadConfigFile();
                 
                 Logger.Info("Config", $"Loaded config - Language: '{config.Language}'");
                 
@@ -133,29 +155,165 @@ public class ConfigService : IConfigService
         return config;
     }
     
+    /// <summary>
+    /// Reads config.json, falling back to the backup of the last good load when it is unreadable.
+    /// </summary>
+    /// <returns>The configuration read from disk.</returns>
+    /// <exception cref="JsonException">Neither config.json nor its backup could be read.</exception>
+    private Config ReadConfigFile()
+    {
+        var backupPath = _configPath + ".bak";
+        try
+        {
+            var config = JsonSerializer.Deserialize<Config>(File.ReadAllText(_configPath)) ?? new Config();
+            TryCopy(_configPath, backupPath);
+            return config;
+        }
+        catch (JsonException ex)
+        {
+            Logger.Error("Config", $"config.json is damaged ({ex.Message}); keeping it as config.json.corrupt");
+            TryCopy(_configPath, _configPath + ".corrupt");
+            if (!File.Exists(backupPath)) throw;
+
+            var restored = JsonSerializer.Deserialize<Config>(File.ReadAllText(backupPath)) ?? new Config();
+            Logger.Warning("Config", "Restored config from config.json.bak");
+            return restored;
+        }
+    }
+
+    private static void TryCopy(string source, string destination)
+    {
+        try
+        {
+            File.Copy(source, destination, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Config", $"Could not copy {Path.GetFileName(source)} to {Path.GetFileName(destination)}: {ex.Message}");
+        }
+    }
+    
     /// <inheritdoc/>
     public void SaveConfig()
     {
+        Config saved;
         try
         {
-            var json = JsonSerializer.Serialize(_config, new JsonSerializerOptions
+            lock (_writeLock)
             {
-                WriteIndented = true,
-                Encoder = System.Text.Encodings.Web.JavaScriptEncoder.UnsafeRelaxedJsonEscaping
-            });
-            
-            File.WriteAllText(_configPath, json);
+                saved = _config;
+                WriteConfig(saved);
+            }
         }
         catch (Exception ex)
         {
-            Console.WriteLine($"Failed to save config: {ex.Message}");
+            Logger.Error("Config", $"Failed to save config: {ex.Message}");
+            return;
+        }
+        RaiseChanged(saved);
+    }
+    
+    /// <inheritdoc/>
+    public bool TryUpdate(Action<Config> apply)
+    {
+        Config config;
+        try
+        {
+            // Change the live configuration in place, like callers that change it and then call SaveConfig,
+            // so an update never replaces the object under them and drops what they changed meanwhile
+            lock (_writeLock)
+            {
+                config = _config;
+                var previous = JsonSerializer.Deserialize<Config>(Serialize(config)) ?? new Config();
+                try
+                {
+                    apply(config);
+                    WriteConfig(config);
+                }
+                catch
+                {
+                    Revert(config, previous);
+                    throw;
+                }
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Config", $"Failed to update config: {ex.Message}");
+            return false;
+        }
+        RaiseChanged(config);
+        return true;
+    }
+
+    /// <summary>
+    /// Restores the settings a failed update changed, leaving the others as they are.
+    /// </summary>
+    /// <param name="config">The live configuration.</param>
+    /// <param name="previous">A copy taken before the update.</param>
+    private static void Revert(Config config, Config previous)
+    {
+        foreach (var property in ConfigProperties)
+        {
+            var before = property.GetValue(previous);
+            if (JsonSerializer.Serialize(before) != JsonSerializer.Serialize(property.GetValue(config)))
+                property.SetValue(config, before);
+        }
+    }
+    
+    /// <summary>
+    /// Serializes the configuration to a temporary file and moves it over config.json,
+    /// so a crash mid-write never leaves a truncated config behind. Callers hold <see cref="_writeLock"/>.
+    /// </summary>
+    /// <param name="config">The configuration to write.</param>
+    private void WriteConfig(Config config)
+    {
+        var json = Serialize(config);
+        
+        var tempPath = _configPath + ".tmp";
+        File.WriteAllText(tempPath, json);
+        File.Move(tempPath, _configPath, true);
+    }
+
+    /// <summary>
+    /// Serializes the live configuration, retrying when another thread changes one of its lists meanwhile.
+    /// </summary>
+    private static string Serialize(Config config)
+    {
+        for (int attempt = 1; ; attempt++)
+        {
+            try
+            {
+                return JsonSerializer.Serialize(config, WriteOptions);
+            }
+            catch (InvalidOperationException) when (attempt < SerializeAttempts)
+            {
+                Thread.Sleep(10);
+            }
+        }
+    }
+
+    private void RaiseChanged(Config config)
+    {
+        try
+        {
+            Changed?.Invoke(config);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Config", $"Config change handler failed: {ex.Message}");
         }
     }
     
     /// <inheritdoc/>
     public void ResetConfig()
     {
-        _config = new Config();
+        var defaults = new Config();
+        lock (_writeLock)
+        {
+            foreach (var property in ConfigProperties)
+                property.SetValue(_config, property.GetValue(defaults));
+        }
         SaveConfig();
     }
 
@@ -0,0 +1,182 @@
+using System.Net.Http;
+using System.Net.Sockets;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Tells apart "no internet", "captive portal", "launcher services blocked" and "all fine".
+/// </summary>
+/// <remarks>
+/// Well-known connectivity check URLs (the same ones Android and macOS use) answer with a fixed
+/// response; anything else means a portal rewrote the request. When general access works, the
+/// Hytale and CurseForge hosts are probed as well: any HTTP answer counts as reachable, while
+/// DNS, connection and TLS failures mark the host as blocked.
+/// </remarks>
+public class ConnectivityService : IConnectivityService
+{
+    private static readonly TimeSpan ProbeTimeout = TimeSpan.FromSeconds(8);
+    private static readonly TimeSpan CacheDuration = TimeSpan.FromSeconds(30);
+
+    private static readonly (string Url, Func<HttpResponseMessage, string, bool> IsExpected)[] InternetProbes =
+    [
+        ("http://connectivitycheck.gstatic.com/generate_204", (r, _) => (int)r.StatusCode == 204),
+        ("http://captive.apple.com/hotspot-detect.html", (r, body) => r.IsSuccessStatusCode && body.Contains("Success"))
+    ];
+
+    private static readonly string[] ServiceHosts =
+    [
+        "launcher.hytale.com",
+        "account-data.hytale.com",
+        "api.curseforge.com"
+    ];
+
+    private readonly HttpClient _httpClient;
+    private readonly IConfigService _configService;
+    private readonly SemaphoreSlim _probeLock = new(1, 1);
+    private NetworkStatus? _lastStatus;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="ConnectivityService"/> class.
+    /// </summary>
+    /// <param name="httpClient">The shared proxy-aware HTTP client.</param>
+    /// <param name="configService">Config, to report whether a proxy is set.</param>
+    public ConnectivityService(HttpClient httpClient, IConfigService configService)
+    {
+        _httpClient = httpClient;
+        _configService = configService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<NetworkStatus> GetNetworkStatusAsync(bool refresh = false, CancellationToken ct = default)
+    {
+        var cached = _lastStatus;
+        if (!refresh && cached != null && DateTime.UtcNow - cached.CheckedAt < CacheDuration)
+            return cached;
+
+        await _probeLock.WaitAsync(ct);
+        try
+        {
+            // Another caller may have finished a probe while we waited
+            cached = _lastStatus;
+            if (cached != null && DateTime.UtcNow - cached.CheckedAt < TimeSpan.FromSeconds(2))
+                return cached;
+
+            var status = await ProbeAsync(ct);
+            _lastStatus = status;
+            Logger.Info("Network", $"Connectivity: {status.State}" +
+                (status.BlockedHosts.Count > 0 ? $" (unreachable: {string.Join(", ", status.BlockedHosts)})" : ""));
+            return status;
+        }
+        finally
+        {
+            _probeLock.Release();
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<NetworkStatus?> DescribeFailureAsync(Exception ex)
+    {
+        if (!IsNetworkFailure(ex)) return null;
+
+        try
+        {
+            var status = await GetNetworkStatusAsync(refresh: true);
+            return status.State == "ok" ? null : status;
+        }
+        catch (Exception probeEx)
+        {
+            Logger.Warning("Network", $"Connectivity probe failed: {probeEx.Message}");
+            return null;
+        }
+    }
+
+    private async Task<NetworkStatus> ProbeAsync(CancellationToken ct)
+    {
+        var internetTasks = InternetProbes.Select(p => ProbeInternetAsync(p.Url, p.IsExpected, ct)).ToArray();
+        var hostTasks = ServiceHosts.Select(h => ProbeHostAsync(h, ct)).ToArray();
+        var internet = await Task.WhenAll(internetTasks);
+        var hosts = await Task.WhenAll(hostTasks);
+
+        var status = new NetworkStatus
+        {
+            ProxyConfigured = !string.IsNullOrWhiteSpace(_configService.Configuration.ProxyUrl)
+        };
+        var blocked = ServiceHosts.Where((_, i) => !hosts[i]).ToList();
+
+        bool internetOk = internet.Contains(ProbeResult.Expected) || hosts.Any(h => h);
+        if (!internetOk)
+        {
+            // Check URLs answered with something else: a portal intercepted them
+            if (internet.Contains(ProbeResult.Unexpected))
+            {
+                status.State = "captive_portal";
+                status.MessageKey = MessageCatalog.ErrorCaptivePortal;
+            }
+            else
+            {
+                status.State = "offline";
+                status.MessageKey = MessageCatalog.ErrorNetworkOffline;
+            }
+            return status;
+        }
+
+        if (blocked.Count > 0)
+        {
+            status.State = "cdn_blocked";
+            status.MessageKey = MessageCatalog.ErrorCdnBlocked;
+            status.BlockedHosts = blocked;
+        }
+        return status;
+    }
+
+    private enum ProbeResult
+    {
+        Expected,
+        Unexpected,
+        Failed
+    }
+
+    private async Task<ProbeResult> ProbeInternetAsync(string url, Func<HttpResponseMessage, string, bool> isExpected, CancellationToken ct)
+    {
+        using var cts = CancellationTokenSource.CreateLinkedTokenSource(ct);
+        cts.CancelAfter(ProbeTimeout);
+        try
+        {
+            using var response = await _httpClient.GetAsync(url, cts.Token);
+            var body = await response.Content.ReadAsStringAsync(cts.Token);
+            return isExpected(response, body) ? ProbeResult.Expected : ProbeResult.Unexpected;
+        }
+        catch (Exception) when (!ct.IsCancellationRequested)
+        {
+            return ProbeResult.Failed;
+        }
+    }
+
+    private async Task<bool> ProbeHostAsync(string host, CancellationToken ct)
+    {
+        using var cts = CancellationTokenSource.CreateLinkedTokenSource(ct);
+        cts.CancelAfter(ProbeTimeout);
+        try
+        {
+            using var request = new HttpRequestMessage(HttpMethod.Head, $"https://{host}/");
+            using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, cts.Token);
+            return true;
+        }
+        catch (Exception) when (!ct.IsCancellationRequested)
+        {
+            return false;
+        }
+    }
+
+    private static bool IsNetworkFailure(Exception ex)
+    {
+        for (var current = ex; current != null; current = current.InnerException)
+        {
+            if (current is HttpRequestException or SocketException or TimeoutException)
+                return true;
+        }
+        return false;
+    }
+}
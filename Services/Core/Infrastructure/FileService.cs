@@ -1,5 +1,6 @@
 using System.Diagnostics;
 using System.Runtime.InteropServices;
+using ElectronNET.API;
 
 namespace HyPrism.Services.Core.Infrastructure;
 
@@ -7,6 +8,11 @@ namespace HyPrism.Services.Core.Infrastructure;
 /// Provides file system operations for opening folders in the native file explorer.
 /// Supports Windows Explorer, macOS Finder, and Linux xdg-open.
 /// </summary>
+/// <remarks>
+/// Inside Flatpak, xdg-open only forwards URIs to the OpenURI portal, which refuses plain folder paths.
+/// Folders are shown through the file manager's <c>org.freedesktop.FileManager1</c> D-Bus interface instead
+/// (the manifest grants talking to it), with Electron's shell as the fallback.
+/// </remarks>
 public class FileService : IFileService
 {
     private readonly string _appDir;
@@ -35,11 +41,15 @@ public class FileService : IFileService
     /// </summary>
     /// <param name="path">The absolute path to the folder to open.</param>
     /// <returns><c>true</c> if the explorer was launched successfully; otherwise, <c>false</c>.</returns>
-    private bool OpenFolderInExplorer(string path)
+    public static bool OpenFolderInExplorer(string path)
     {
         try
         {
-            if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+            if (UtilityService.IsFlatpak())
+            {
+                OpenFolderFromFlatpak(path);
+            }
+            else if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
             {
                 Process.Start("explorer.exe", $"\"{path}\"")?.Dispose();
             }
@@ -59,4 +69,28 @@ public class FileService : IFileService
             return false;
         }
     }
+
+    private static void OpenFolderFromFlatpak(string path)
+    {
+        var uri = new Uri(Path.GetFullPath(path)).AbsoluteUri.Replace("'", "%27");
+        var psi = new ProcessStartInfo("gdbus", [
+            "call", "--session",
+            "--dest", "org.freedesktop.FileManager1",
+            "--object-path", "/org/freedesktop/FileManager1",
+            "--method", "org.freedesktop.FileManager1.ShowFolders",
+            $"['{uri}']", ""
+        ])
+        {
+            UseShellExecute = false,
+            RedirectStandardOutput = true,
+            RedirectStandardError = true,
+            CreateNoWindow = true
+        };
+
+        using var process = Process.Start(psi);
+        if (process != null && process.WaitForExit(5000) && process.ExitCode == 0) return;
+
+        Logger.Warning("Files", "No file manager answered on D-Bus, opening the folder through Electron");
+        _ = Electron.Shell.OpenPathAsync(path);
+    }
 }
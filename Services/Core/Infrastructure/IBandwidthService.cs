@@ -0,0 +1,27 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Counts the bytes the launcher downloads per <see cref="TrafficCategory"/> and month.
+/// </summary>
+public interface IBandwidthService
+{
+    /// <summary>
+    /// Adds downloaded bytes to the current month.
+    /// </summary>
+    /// <param name="category">A <see cref="TrafficCategory"/> value.</param>
+    /// <param name="bytes">Bytes received.</param>
+    void Record(string category, long bytes);
+
+    /// <summary>
+    /// Gets the usage of recent months, newest first.
+    /// </summary>
+    /// <param name="months">How many months to return, including the current one.</param>
+    List<BandwidthMonth> GetBandwidthUsage(int months = 1);
+
+    /// <summary>
+    /// Clears all recorded usage.
+    /// </summary>
+    void Reset();
+}
@@ -5,6 +5,7 @@ namespace HyPrism.Services.Core.Infrastructure;
 /// <summary>
 /// Manages launcher configuration persistence including loading, saving, and modifying settings.
 /// Handles configuration migrations between versions automatically.
+/// Safe to use from several threads: writes are serialized and atomic.
 /// </summary>
 public interface IConfigService
 {
@@ -13,11 +14,26 @@ public interface IConfigService
     /// </summary>
     Config Configuration { get; }
     
+    /// <summary>
+    /// Raised after the configuration was written to disk, with the configuration now in effect.
+    /// Handlers run on the thread that saved it.
+    /// </summary>
+    event Action<Config>? Changed;
+    
     /// <summary>
     /// Persists the current configuration state to disk.
     /// </summary>
     void SaveConfig();
     
+    /// <summary>
+    /// Applies a set of changes to the configuration and persists it in one write, holding the same lock
+    /// as <see cref="SaveConfig"/>. If the file cannot be written, the settings the changes touched are
+    /// restored, so a failure leaves both memory and disk as they were.
+    /// </summary>
+    /// <param name="apply">Mutations to apply to the configuration.</param>
+    /// <returns><c>true</c> if the changes were persisted; otherwise, <c>false</c>.</returns>
+    bool TryUpdate(Action<Config> apply);
+    
     /// <summary>
     /// Resets configuration to default values while preserving essential user data (UUID, Profiles).
     /// </summary>
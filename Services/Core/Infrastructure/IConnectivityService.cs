@@ -0,0 +1,23 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Probes internet access and the launcher's service hosts to explain network failures.
+/// </summary>
+public interface IConnectivityService
+{
+    /// <summary>
+    /// Gets the current network status. Results are cached briefly unless <paramref name="refresh"/> is set.
+    /// </summary>
+    /// <param name="refresh">Whether to probe again instead of returning a recent result.</param>
+    /// <param name="ct">Cancellation token.</param>
+    Task<NetworkStatus> GetNetworkStatusAsync(bool refresh = false, CancellationToken ct = default);
+
+    /// <summary>
+    /// Explains a failed request: when <paramref name="ex"/> is a network failure, probes connectivity again.
+    /// </summary>
+    /// <param name="ex">The failure.</param>
+    /// <returns>The status when it explains the failure (offline, captive portal, blocked host); otherwise null.</returns>
+    Task<NetworkStatus?> DescribeFailureAsync(Exception ex);
+}
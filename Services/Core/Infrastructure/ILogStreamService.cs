@@ -0,0 +1,43 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Collects launcher and game log lines into a bounded buffer and exposes
+/// filtered, paginated queries plus a live stream of new lines.
+/// </summary>
+public interface ILogStreamService
+{
+    /// <summary>
+    /// Raised for every line appended to the buffer, from either source.
+    /// </summary>
+    event Action<LogLine>? LineAppended;
+
+    /// <summary>
+    /// Appends a raw line of game client output, parsing its level and category when possible.
+    /// </summary>
+    /// <param name="line">The raw stdout/stderr line.</param>
+    /// <param name="isError">Whether the line came from stderr.</param>
+    void AppendGameLine(string line, bool isError = false);
+
+    /// <summary>
+    /// Drops buffered game lines, typically when a new game session starts.
+    /// </summary>
+    void ClearGameLines();
+
+    /// <summary>
+    /// Returns a page of buffered lines matching the query.
+    /// </summary>
+    /// <param name="query">Filter and pagination options.</param>
+    /// <returns>The matching lines ordered oldest first.</returns>
+    /// <exception cref="ArgumentException">Thrown when <see cref="LogFilter.Pattern"/> is not a valid regular expression.</exception>
+    LogPage Query(LogQuery query);
+
+    /// <summary>
+    /// Checks whether a line passes the given filter.
+    /// </summary>
+    /// <param name="line">The line to test.</param>
+    /// <param name="filter">The filter to apply.</param>
+    /// <returns><c>true</c> if the line matches; otherwise, <c>false</c>.</returns>
+    bool Matches(LogLine line, LogFilter filter);
+}
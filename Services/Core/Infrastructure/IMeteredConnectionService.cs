@@ -0,0 +1,43 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Asks the user before large downloads while the connection is marked as metered.
+/// </summary>
+public interface IMeteredConnectionService
+{
+    /// <summary>
+    /// Raised when a download waits for <see cref="Respond"/>.
+    /// </summary>
+    event Action<DownloadConfirmation>? ConfirmationRequired;
+
+    /// <summary>
+    /// Whether the metered connection setting is on.
+    /// </summary>
+    bool IsMetered { get; }
+
+    /// <summary>
+    /// Waits until a download may start. Returns at once when the connection is not metered or the
+    /// download is below the threshold; otherwise raises <see cref="ConfirmationRequired"/>.
+    /// </summary>
+    /// <param name="url">The download URL.</param>
+    /// <param name="name">File name shown to the user.</param>
+    /// <param name="bytes">Bytes still to download, or -1 if unknown.</param>
+    /// <param name="ct">Cancels the wait, which declines the download.</param>
+    /// <returns><c>true</c> if the download may start.</returns>
+    Task<bool> ConfirmDownloadAsync(string url, string name, long bytes, CancellationToken ct = default);
+
+    /// <summary>
+    /// Answers a pending confirmation.
+    /// </summary>
+    /// <param name="id">The <see cref="DownloadConfirmation.Id"/>.</param>
+    /// <param name="allow">Whether the download may start.</param>
+    /// <returns><c>false</c> if no confirmation with that ID is pending.</returns>
+    bool Respond(string id, bool allow);
+
+    /// <summary>
+    /// Gets the confirmations still waiting for an answer, oldest first.
+    /// </summary>
+    List<DownloadConfirmation> GetPendingConfirmations();
+}
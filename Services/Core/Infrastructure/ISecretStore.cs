@@ -0,0 +1,34 @@
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Keeps tokens, API keys and credentials out of config.json, in the OS keychain where one is available.
+/// </summary>
+public interface ISecretStore
+{
+    /// <summary>
+    /// Gets where secrets end up: <c>keychain</c> (macOS), <c>secret-service</c> (Linux), <c>dpapi</c> (Windows)
+    /// or <c>encrypted-file</c> when no OS store could be used.
+    /// </summary>
+    string Backend { get; }
+
+    /// <summary>
+    /// Reads a secret.
+    /// </summary>
+    /// <param name="name">Secret name, e.g. <c>automation-api-token</c>.</param>
+    /// <returns>The value, or <c>null</c> if the secret is not stored.</returns>
+    string? Get(string name);
+
+    /// <summary>
+    /// Stores or replaces a secret.
+    /// </summary>
+    /// <param name="name">Secret name.</param>
+    /// <param name="value">Value to store.</param>
+    /// <returns><c>true</c> if the secret was stored.</returns>
+    bool Set(string name, string value);
+
+    /// <summary>
+    /// Removes a secret. Does nothing if it is not stored.
+    /// </summary>
+    /// <param name="name">Secret name.</param>
+    void Delete(string name);
+}
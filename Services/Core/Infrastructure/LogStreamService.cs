@@ -0,0 +1,203 @@
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Buffers launcher log entries (via <see cref="Logger.EntryAdded"/>) and game client output
+/// so the frontend can page through history and receive only new lines incrementally,
+/// instead of repeatedly re-reading whole log blobs.
+/// </summary>
+public class LogStreamService : ILogStreamService
+{
+    private const int MaxLines = 5000;
+    private const int MaxPageSize = 1000;
+
+    private static readonly string[] LevelOrder = ["DBG", "INF", "SUC", "WRN", "ERR"];
+
+    // Hytale client output: "2026-01-31 12:00:00.123|INFO|HytaleClient.Application.AppStartup|Interface loaded."
+    private static readonly Regex GameLinePattern = new(
+        @"^\d{4}-\d{2}-\d{2}[^|]*\|(?<level>[A-Za-z]+)\|(?<category>[^|]*)\|(?<message>.*)$",
+        RegexOptions.Compiled);
+
+    private readonly object _lock = new();
+    private readonly List<LogLine> _lines = new();
+    private readonly Dictionary<string, Regex> _patternCache = new();
+    private long _nextSeq = 1;
+
+    /// <inheritdoc/>
+    public event Action<LogLine>? LineAppended;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="LogStreamService"/> class,
+    /// seeding the buffer with the entries the <see cref="Logger"/> already holds.
+    /// </summary>
+    public LogStreamService()
+    {
+        foreach (var entry in Logger.GetRecentLogs(100))
+        {
+            // Buffer format: "HH:mm:ss | LVL | Category | Message"
+            var parts = entry.Split(" | ", 4);
+            if (parts.Length == 4)
+            {
+                Append(new LogLine { Source = "launcher", Level = parts[1], Category = parts[2], Message = parts[3] });
+            }
+        }
+
+        Logger.EntryAdded += OnLoggerEntryAdded;
+    }
+
+    private void OnLoggerEntryAdded(string level, string category, string message)
+    {
+        Append(new LogLine { Source = "launcher", Level = level, Category = category, Message = message });
+    }
+
+    /// <inheritdoc/>
+    public void AppendGameLine(string line, bool isError = false)
+    {
+        if (string.IsNullOrWhiteSpace(line)) return;
+
+        var entry = new LogLine { Source = "game", Level = isError ? "ERR" : "INF", Message = line };
+
+        var match = GameLinePattern.Match(line);
+        if (match.Success)
+        {
+            entry.Level = NormalizeGameLevel(match.Groups["level"].Value, entry.Level);
+            entry.Category = match.Groups["category"].Value.Trim();
+            entry.Message = match.Groups["message"].Value;
+        }
+
+        Append(entry);
+    }
+
+    /// <inheritdoc/>
+    public void ClearGameLines()
+    {
+        lock (_lock)
+        {
+            _lines.RemoveAll(l => l.Source == "game");
+        }
+    }
+
+    /// <inheritdoc/>
+    public LogPage Query(LogQuery query)
+    {
+        int limit = Math.Clamp(query.Limit, 1, MaxPageSize);
+        var page = new LogPage();
+
+        // Compile outside the lock so an invalid pattern fails fast
+        GetPattern(query.Pattern);
+
+        lock (_lock)
+        {
+            page.LatestSeq = _nextSeq - 1;
+
+            if (query.AfterSeq.HasValue)
+            {
+                foreach (var line in _lines)
+                {
+                    if (line.Seq <= query.AfterSeq.Value || !Matches(line, query)) continue;
+                    if (page.Lines.Count == limit) { page.HasMore = true; break; }
+                    page.Lines.Add(line);
+                }
+                return page;
+            }
+
+            for (int i = _lines.Count - 1; i >= 0; i--)
+            {
+                var line = _lines[i];
+                if (query.BeforeSeq.HasValue && line.Seq >= query.BeforeSeq.Value) continue;
+                if (!Matches(line, query)) continue;
+                if (page.Lines.Count == limit) { page.HasMore = true; break; }
+                page.Lines.Add(line);
+            }
+        }
+
+        page.Lines.Reverse();
+        return page;
+    }
+
+    /// <inheritdoc/>
+    public bool Matches(LogLine line, LogFilter filter)
+    {
+        if (!string.IsNullOrEmpty(filter.Source) &&
+            !string.Equals(line.Source, filter.Source, StringComparison.OrdinalIgnoreCase))
+            return false;
+
+        if (!string.IsNullOrEmpty(filter.MinLevel) && LevelRank(line.Level) < LevelRank(filter.MinLevel))
+            return false;
+
+        var pattern = GetPattern(filter.Pattern);
+        if (pattern != null)
+        {
+            try
+            {
+                return pattern.IsMatch(line.Message) || pattern.IsMatch(line.Category);
+            }
+            catch (RegexMatchTimeoutException)
+            {
+                return false;
+            }
+        }
+
+        return true;
+    }
+
+    private void Append(LogLine line)
+    {
+        lock (_lock)
+        {
+            line.Seq = _nextSeq++;
+            _lines.Add(line);
+            if (_lines.Count > MaxLines)
+            {
+                _lines.RemoveRange(0, _lines.Count - MaxLines);
+            }
+        }
+
+        try { LineAppended?.Invoke(line); }
+        catch { /* Subscribers must not break log capture */ }
+    }
+
+    private Regex? GetPattern(string? pattern)
+    {
+        if (string.IsNullOrEmpty(pattern)) return null;
+
+        lock (_patternCache)
+        {
+            if (_patternCache.TryGetValue(pattern, out var cached)) return cached;
+
+            Regex regex;
+            try
+            {
+                regex = new Regex(pattern, RegexOptions.IgnoreCase | RegexOptions.CultureInvariant, TimeSpan.FromMilliseconds(50));
+            }
+            catch (ArgumentException ex)
+            {
+                throw new ArgumentException($"Invalid log filter pattern: {ex.Message}", nameof(pattern), ex);
+            }
+
+            if (_patternCache.Count > 32) _patternCache.Clear();
+            _patternCache[pattern] = regex;
+            return regex;
+        }
+    }
+
+    private static int LevelRank(string level)
+    {
+        int index = Array.IndexOf(LevelOrder, level.ToUpperInvariant());
+        return index < 0 ? 1 : index;
+    }
+
+    private static string NormalizeGameLevel(string level, string fallback)
+    {
+        return level.ToUpperInvariant() switch
+        {
+            "TRACE" or "FINE" or "FINER" or "FINEST" or "DEBUG" => "DBG",
+            "INFO" or "CONFIG" => "INF",
+            "WARN" or "WARNING" => "WRN",
+            "ERROR" or "SEVERE" or "FATAL" or "CRITICAL" => "ERR",
+            _ => fallback
+        };
+    }
+}
@@ -11,6 +11,18 @@ public static class Logger
     private static readonly object _lock = new();
     private static readonly Queue<string> _logBuffer = new();
     private const int MaxLogEntries = 100;
+
+    /// <summary>
+    /// Raised after an entry is added to the in-memory buffer.
+    /// Arguments are the level abbreviation, category and message.
+    /// </summary>
+    public static event Action<string, string, string>? EntryAdded;
+
+    /// <summary>
+    /// Raised for every entry, including those kept out of the console and the buffer.
+    /// Arguments are the level abbreviation, category and message.
+    /// </summary>
+    public static event Action<string, string, string>? EntryWritten;
     
     /// <summary>
     /// The original stdout TextWriter, captured before Console.Out is replaced by
@@ -39,6 +51,7 @@ public static class Logger
     public static void Info(string category, string message, bool logToConsole = true)
     {
         Log.ForContext("SourceContext", category).Information(message);
+        RaiseWritten("INF", category, message);
         if (logToConsole)
         {
             WriteToConsole("INF", category, message, ConsoleColor.Gray);
@@ -55,6 +68,7 @@ public static class Logger
     public static void Success(string category, string message, bool logToConsole = true)
     {
         Log.ForContext("SourceContext", category).Information($"SUCCESS: {message}");
+        RaiseWritten("SUC", category, message);
         if (logToConsole)
         {
             WriteToConsole("SUC", category, message, ConsoleColor.Green);
@@ -71,6 +85,7 @@ public static class Logger
     public static void Warning(string category, string message, bool logToConsole = true)
     {
         Log.ForContext("SourceContext", category).Warning(message);
+        RaiseWritten("WRN", category, message);
         if (logToConsole)
         {
             WriteToConsole("WRN", category, message, ConsoleColor.Yellow);
@@ -87,6 +102,7 @@ public static class Logger
     public static void Error(string category, string message, bool logToConsole = true)
     {
         Log.ForContext("SourceContext", category).Error(message);
+        RaiseWritten("ERR", category, message);
         if (logToConsole)
         {
             WriteToConsole("ERR", category, message, ConsoleColor.Red);
@@ -103,6 +119,7 @@ public static class Logger
     {
 #if DEBUG
         Log.ForContext("SourceContext", category).Debug(message);
+        RaiseWritten("DBG", category, message);
         WriteToConsole("DBG", category, message, ConsoleColor.DarkGray);
         AddToBuffer("DBG", category, message);
 #endif
@@ -175,6 +192,15 @@ public static class Logger
                 _logBuffer.Dequeue();
             }
         }
+
+        try { EntryAdded?.Invoke(level, category, message); }
+        catch { /* Subscribers must never break logging */ }
+    }
+
+    private static void RaiseWritten(string level, string category, string message)
+    {
+        try { EntryWritten?.Invoke(level, category, message); }
+        catch { /* Subscribers must never break logging */ }
     }
 
     /// <summary>
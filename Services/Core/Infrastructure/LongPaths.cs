@@ -0,0 +1,117 @@
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Helpers for Windows paths of <see cref="MaxPath"/> characters or more, which deeply nested mod and game
+/// asset folders reach under a long instance folder.
+/// </summary>
+/// <remarks>
+/// .NET adds the <c>\\?\</c> extended-length prefix itself, so <c>System.IO</c> calls in the launcher work
+/// with long paths either way. Other programs do not: Windows refuses a working directory that long, and the
+/// game and tools that do not opt in fail unless the <c>LongPathsEnabled</c> policy is set. Paths handed to
+/// them go through <see cref="ToExtended"/>, and what the launcher wrote is checked with
+/// <see cref="WarnIfTooLong"/> so the log explains a later failure.
+/// </remarks>
+public static class LongPaths
+{
+    /// <summary>
+    /// Longest path, including the terminating null, Windows accepts without long path support.
+    /// </summary>
+    public const int MaxPath = 260;
+
+    // Directories must leave room for an 8.3 file name
+    private const int MaxDirectoryPath = 248;
+    private const string ExtendedPrefix = @"\\?\";
+    private const string ExtendedUncPrefix = @"\\?\UNC\";
+    private const int ScanLimit = 20_000;
+
+    private static readonly Lazy<bool> SystemEnabled = new(ReadSystemPolicy);
+
+    /// <summary>
+    /// Gets whether programs may use long paths without the prefix: always outside Windows, and on Windows when
+    /// the <c>LongPathsEnabled</c> policy is set. The game and most tools still need to opt in themselves.
+    /// </summary>
+    public static bool IsSystemEnabled => SystemEnabled.Value;
+
+    /// <summary>
+    /// Gets whether a path is too long for programs without long path support. Always <c>false</c> outside Windows.
+    /// </summary>
+    public static bool IsTooLong(string path) =>
+        OperatingSystem.IsWindows() && Path.GetFullPath(path).Length >= MaxDirectoryPath;
+
+    /// <summary>
+    /// Returns the path with the <c>\\?\</c> prefix on Windows with long paths turned off, for passing to
+    /// programs that understand the prefix. A short folder is prefixed too, since the files a program writes
+    /// below it can still pass the limit. Paths are returned unchanged outside Windows or with the policy set.
+    /// </summary>
+    public static string ToExtended(string path)
+    {
+        if (!OperatingSystem.IsWindows() || IsSystemEnabled || path.StartsWith(ExtendedPrefix, StringComparison.Ordinal))
+            return path;
+
+        var full = Path.GetFullPath(path);
+        return full.StartsWith(@"\\", StringComparison.Ordinal)
+            ? ExtendedUncPrefix + full[2..]
+            : ExtendedPrefix + full;
+    }
+
+    /// <summary>
+    /// Finds the longest path in a folder, or the path itself for a file.
+    /// </summary>
+    /// <returns>The path and its length, or <c>null</c> if the path does not exist.</returns>
+    public static (string Path, int Length)? FindLongest(string path)
+    {
+        if (File.Exists(path)) return (path, Path.GetFullPath(path).Length);
+        if (!Directory.Exists(path)) return null;
+
+        var longest = Path.GetFullPath(path);
+        foreach (var entry in Directory.EnumerateFileSystemEntries(path, "*", new EnumerationOptions
+                 {
+                     RecurseSubdirectories = true,
+                     IgnoreInaccessible = true,
+                     AttributesToSkip = FileAttributes.ReparsePoint
+                 }).Take(ScanLimit))
+        {
+            if (entry.Length > longest.Length) longest = entry;
+        }
+        return (longest, longest.Length);
+    }
+
+    /// <summary>
+    /// Logs a warning when Windows has long paths turned off and a file, or a file in a folder, has a path the
+    /// game may not be able to open. Does nothing outside Windows or with the policy set.
+    /// </summary>
+    /// <param name="path">The file or folder that was written.</param>
+    /// <param name="operation">What wrote it, for the log, e.g. "Game install".</param>
+    public static void WarnIfTooLong(string path, string operation)
+    {
+        if (!OperatingSystem.IsWindows() || IsSystemEnabled) return;
+
+        try
+        {
+            if (FindLongest(path) is not { } longest || longest.Length < MaxPath) return;
+            Logger.Warning("LongPaths",
+                $"{operation} wrote a path of {longest.Length} characters, over the Windows limit of {MaxPath}: {longest.Path}. " +
+                "Enable long paths in Windows or move the instance folder to a shorter path");
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            // Only a diagnostic
+        }
+    }
+
+    private static bool ReadSystemPolicy()
+    {
+        if (!OperatingSystem.IsWindows()) return true;
+
+        try
+        {
+            using var key = Microsoft.Win32.Registry.LocalMachine.OpenSubKey(@"SYSTEM\CurrentControlSet\Control\FileSystem");
+            return key?.GetValue("LongPathsEnabled") is int enabled && enabled == 1;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("LongPaths", $"Could not read the LongPathsEnabled policy: {ex.Message}");
+            return false;
+        }
+    }
+}
@@ -0,0 +1,122 @@
+using System.Collections.Concurrent;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Holds back downloads of at least <see cref="Config.MeteredConfirmThresholdMb"/> while
+/// <see cref="Config.MeteredConnection"/> is on, until the user allows them.
+/// </summary>
+/// <remarks>
+/// <see cref="Game.Download.DownloadService"/> asks once it knows the size. An allowed URL is remembered for
+/// <see cref="ApprovalLifetime"/>, so retries and resumes of the same download do not ask again.
+/// Downloads of unknown size always ask. A confirmation left unanswered for <see cref="ResponseTimeout"/>
+/// counts as declined.
+/// </remarks>
+public class MeteredConnectionService : IMeteredConnectionService
+{
+    private static readonly TimeSpan ApprovalLifetime = TimeSpan.FromHours(1);
+    private static readonly TimeSpan ResponseTimeout = TimeSpan.FromMinutes(10);
+
+    private readonly IConfigService _configService;
+    private readonly ConcurrentDictionary<string, (DateTime AllowedAt, long Bytes)> _approved = new();
+    private readonly ConcurrentDictionary<string, (DownloadConfirmation Confirmation, TaskCompletionSource<bool> Answer)> _pending = new();
+
+    /// <inheritdoc/>
+    public event Action<DownloadConfirmation>? ConfirmationRequired;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="MeteredConnectionService"/> class.
+    /// </summary>
+    /// <param name="configService">Provides the metered connection settings.</param>
+    public MeteredConnectionService(IConfigService configService)
+    {
+        _configService = configService;
+    }
+
+    /// <inheritdoc/>
+    public bool IsMetered => _configService.Configuration.MeteredConnection;
+
+    /// <inheritdoc/>
+    public async Task<bool> ConfirmDownloadAsync(string url, string name, long bytes, CancellationToken ct = default)
+    {
+        if (!IsMetered) return true;
+
+        long threshold = Math.Max(0, _configService.Configuration.MeteredConfirmThresholdMb) * 1024L * 1024L;
+        if (bytes >= 0 && bytes < threshold) return true;
+
+        if (_approved.TryGetValue(url, out var approval)
+            && DateTime.UtcNow - approval.AllowedAt < ApprovalLifetime
+            && bytes >= 0 && bytes <= approval.Bytes)
+        {
+            return true;
+        }
+
+        var confirmation = new DownloadConfirmation
+        {
+            Id = Guid.NewGuid().ToString("N"),
+            Category = BandwidthService.Classify(Uri.TryCreate(url, UriKind.Absolute, out var uri) ? uri : null),
+            Name = name,
+            Bytes = bytes,
+            RequestedAtUtc = DateTime.UtcNow
+        };
+        var answer = new TaskCompletionSource<bool>(TaskCreationOptions.RunContinuationsAsynchronously);
+        _pending[confirmation.Id] = (confirmation, answer);
+
+        Logger.Info("Network", $"Metered connection: waiting for confirmation to download {name} ({FormatSize(bytes)})");
+        ConfirmationRequired?.Invoke(confirmation);
+
+        bool allowed;
+        try
+        {
+            allowed = await answer.Task.WaitAsync(ResponseTimeout, ct);
+        }
+        catch (TimeoutException)
+        {
+            allowed = false;
+        }
+        catch (OperationCanceledException)
+        {
+            allowed = false;
+        }
+        finally
+        {
+            _pending.TryRemove(confirmation.Id, out _);
+        }
+
+        if (allowed)
+        {
+            _approved[url] = (DateTime.UtcNow, bytes);
+        }
+        Logger.Info("Network", $"Download of {name} {(allowed ? "allowed" : "declined")} on metered connection");
+        return allowed;
+    }
+
+    /// <inheritdoc/>
+    public bool Respond(string id, bool allow)
+    {
+        if (!_pending.TryGetValue(id, out var pending)) return false;
+        return pending.Answer.TrySetResult(allow);
+    }
+
+    /// <inheritdoc/>
+    public List<DownloadConfirmation> GetPendingConfirmations() =>
+        _pending.Values
+            .Select(p => p.Confirmation)
+            .OrderBy(c => c.RequestedAtUtc)
+            .ToList();
+
+    private static string FormatSize(long bytes) =>
+        bytes < 0 ? "unknown size" : $"{bytes / 1024.0 / 1024.0:0.#} MB";
+}
+
+/// <summary>
+/// Thrown by a download the user declined on a metered connection. Callers treat it as a cancellation.
+/// </summary>
+public class DownloadDeclinedException : OperationCanceledException
+{
+    public DownloadDeclinedException(string name)
+        : base($"Download of {name} was declined on a metered connection")
+    {
+    }
+}
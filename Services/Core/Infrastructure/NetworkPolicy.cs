@@ -0,0 +1,140 @@
+using System.Net;
+using System.Net.Http;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Kind of network operation, used to pick a timeout from <see cref="NetworkConfig"/>.
+/// </summary>
+public enum RequestClass
+{
+    /// <summary>API calls and small JSON documents.</summary>
+    Metadata,
+
+    /// <summary>Downloads of a few megabytes (Butler, agents, images).</summary>
+    SmallFile,
+
+    /// <summary>Game archives, patches and runtimes.</summary>
+    LargeDownload
+}
+
+/// <summary>
+/// Central timeout and retry policy for launcher network requests, read from <c>Config.Network</c>.
+/// </summary>
+/// <remarks>
+/// Retries use exponential backoff with full jitter: attempt <c>n</c> waits a random delay between
+/// zero and <c>min(RetryMaxDelayMs, RetryBaseDelayMs * 2^n)</c>. Only transient failures are retried
+/// (connection errors, timeouts, HTTP 408/429/5xx); cancellation by the caller never is.
+/// </remarks>
+public class NetworkPolicy
+{
+    private readonly IConfigService _configService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="NetworkPolicy"/> class.
+    /// </summary>
+    /// <param name="configService">Config holding the network settings.</param>
+    public NetworkPolicy(IConfigService configService)
+    {
+        _configService = configService;
+    }
+
+    private NetworkConfig Settings => _configService.Configuration.Network ?? new NetworkConfig();
+
+    /// <summary>
+    /// Gets the configured timeout for a request class.
+    /// </summary>
+    public TimeSpan GetTimeout(RequestClass requestClass) => requestClass switch
+    {
+        RequestClass.Metadata => TimeSpan.FromSeconds(Math.Max(1, Settings.MetadataTimeoutSeconds)),
+        RequestClass.SmallFile => TimeSpan.FromSeconds(Math.Max(1, Settings.SmallFileTimeoutSeconds)),
+        _ => TimeSpan.FromMinutes(Math.Max(1, Settings.LargeDownloadTimeoutMinutes))
+    };
+
+    /// <summary>
+    /// Creates a token source that cancels with <paramref name="ct"/> or after the class timeout.
+    /// </summary>
+    public CancellationTokenSource CreateTimeout(RequestClass requestClass, CancellationToken ct = default)
+    {
+        var cts = CancellationTokenSource.CreateLinkedTokenSource(ct);
+        cts.CancelAfter(GetTimeout(requestClass));
+        return cts;
+    }
+
+    /// <summary>
+    /// Gets the jittered backoff delay before retry number <paramref name="attempt"/> (0-based).
+    /// </summary>
+    public TimeSpan GetRetryDelay(int attempt)
+    {
+        var settings = Settings;
+        var ceiling = Math.Min(
+            Math.Max(0, settings.RetryMaxDelayMs),
+            Math.Max(0, settings.RetryBaseDelayMs) * Math.Pow(2, Math.Min(attempt, 16)));
+        return TimeSpan.FromMilliseconds(Random.Shared.NextDouble() * ceiling);
+    }
+
+    /// <summary>
+    /// Runs <paramref name="action"/> with the class timeout, retrying transient failures.
+    /// </summary>
+    /// <param name="requestClass">Timeout class for each attempt.</param>
+    /// <param name="operation">Short description for logs.</param>
+    /// <param name="action">The request; receives a token that combines <paramref name="ct"/> and the timeout.</param>
+    /// <param name="ct">Caller cancellation.</param>
+    public async Task<T> ExecuteAsync<T>(RequestClass requestClass, string operation,
+        Func<CancellationToken, Task<T>> action, CancellationToken ct = default)
+    {
+        int maxRetries = Math.Max(0, Settings.MaxRetries);
+        for (int attempt = 0; ; attempt++)
+        {
+            using var cts = CreateTimeout(requestClass, ct);
+            try
+            {
+                return await action(cts.Token);
+            }
+            catch (Exception ex) when (attempt < maxRetries && !ct.IsCancellationRequested && IsTransient(ex))
+            {
+                var delay = GetRetryDelay(attempt);
+                Logger.Warning("Network", $"{operation} failed ({Describe(ex)}), retry {attempt + 1}/{maxRetries} in {delay.TotalSeconds:0.0}s");
+                await Task.Delay(delay, ct);
+            }
+        }
+    }
+
+    /// <summary>
+    /// Runs <paramref name="action"/> with the class timeout, retrying transient failures.
+    /// </summary>
+    public Task ExecuteAsync(RequestClass requestClass, string operation,
+        Func<CancellationToken, Task> action, CancellationToken ct = default) =>
+        ExecuteAsync<bool>(requestClass, operation, async token =>
+        {
+            await action(token);
+            return true;
+        }, ct);
+
+    /// <summary>
+    /// Whether a failure is worth retrying.
+    /// </summary>
+    public static bool IsTransient(Exception ex) => ex switch
+    {
+        HttpRequestException { StatusCode: null } => true,
+        HttpRequestException { StatusCode: var status } => IsTransientStatus(status.Value),
+        DownloadDeclinedException => false,
+        // Timeout from our own token source or HttpClient.Timeout
+        OperationCanceledException => true,
+        IOException => true,
+        _ => false
+    };
+
+    private static bool IsTransientStatus(HttpStatusCode status) =>
+        status == HttpStatusCode.RequestTimeout ||
+        status == HttpStatusCode.TooManyRequests ||
+        (int)status >= 500;
+
+    private static string Describe(Exception ex) => ex switch
+    {
+        HttpRequestException { StatusCode: not null } http => $"HTTP {(int)http.StatusCode}",
+        OperationCanceledException => "timed out",
+        _ => ex.Message
+    };
+}
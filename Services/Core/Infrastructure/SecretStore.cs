@@ -0,0 +1,324 @@
+using System.Diagnostics;
+using System.Runtime.InteropServices;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Stores secrets in the macOS Keychain, the Linux Secret Service or, on Windows, in
+/// <c>secrets.dat</c> protected with DPAPI for the current user.
+/// </summary>
+/// <remarks>
+/// Keychain and Secret Service are driven through the <c>security</c> and <c>secret-tool</c> command line
+/// tools, so no native bindings are needed. When they are missing or not usable (no keyring daemon, headless
+/// session) secrets go to <c>secrets.dat</c> encrypted with AES-GCM under a random key in <c>secrets.key</c>,
+/// both readable by the current user only. That fallback keeps secrets out of config.json and backups of it,
+/// but anyone who can read the data directory can read them too.
+/// </remarks>
+public class SecretStore : ISecretStore
+{
+    private const string ServiceName = "HyPrism";
+    private const string ProbeName = "probe";
+    private const int ToolTimeoutMs = 10_000;
+    private const int KeySize = 32;
+    private const int NonceSize = 12;
+    private const int TagSize = 16;
+
+    private readonly string _filePath;
+    private readonly string _keyPath;
+    private readonly object _fileLock = new();
+    private readonly Lazy<string> _backend;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="SecretStore"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding the file fallback.</param>
+    public SecretStore(string appDir)
+    {
+        _filePath = Path.Combine(appDir, "secrets.dat");
+        _keyPath = Path.Combine(appDir, "secrets.key");
+        _backend = new Lazy<string>(DetectBackend);
+    }
+
+    /// <inheritdoc/>
+    public string Backend => _backend.Value;
+
+    /// <inheritdoc/>
+    public string? Get(string name)
+    {
+        try
+        {
+            return Backend switch
+            {
+                "keychain" => RunTool("security", ["find-generic-password", "-s", ServiceName, "-a", name, "-w"]) is (0, var output)
+                    ? output.TrimEnd('\n')
+                    : null,
+                "secret-service" => RunTool("secret-tool", ["lookup", "service", ServiceName, "account", name]) is (0, var output)
+                    ? output
+                    : null,
+                _ => GetFromFile(name)
+            };
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Secrets", $"Could not read secret '{name}': {ex.Message}");
+            return null;
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool Set(string name, string value)
+    {
+        try
+        {
+            var stored = Backend switch
+            {
+                // A trailing -w without a value makes security prompt for the secret (twice) instead of showing it to ps
+                "keychain" => RunTool("security", ["add-generic-password", "-U", "-s", ServiceName, "-a", name, "-w"], $"{value}\n{value}\n") is (0, _),
+                "secret-service" => RunTool("secret-tool", ["store", $"--label={ServiceName} {name}", "service", ServiceName, "account", name], value) is (0, _),
+                _ => SetInFile(name, value)
+            };
+            if (!stored) Logger.Warning("Secrets", $"Could not store secret '{name}' in {Backend}");
+            return stored;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Secrets", $"Could not store secret '{name}': {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <inheritdoc/>
+    public void Delete(string name)
+    {
+        try
+        {
+            switch (Backend)
+            {
+                case "keychain":
+                    RunTool("security", ["delete-generic-password", "-s", ServiceName, "-a", name]);
+                    break;
+                case "secret-service":
+                    RunTool("secret-tool", ["clear", "service", ServiceName, "account", name]);
+                    break;
+                default:
+                    lock (_fileLock)
+                    {
+                        var secrets = ReadFile();
+                        if (secrets.Remove(name)) WriteFile(secrets);
+                    }
+                    break;
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Secrets", $"Could not delete secret '{name}': {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Picks the OS store, checking on Linux that a Secret Service actually answers by storing a probe.
+    /// </summary>
+    private string DetectBackend()
+    {
+        string backend;
+        if (OperatingSystem.IsWindows())
+            backend = "dpapi";
+        else if (OperatingSystem.IsMacOS() && File.Exists("/usr/bin/security"))
+            backend = "keychain";
+        else if (OperatingSystem.IsLinux()
+                 && RunTool("secret-tool", ["store", $"--label={ServiceName} {ProbeName}", "service", ServiceName, "account", ProbeName], "1") is (0, _))
+        {
+            RunTool("secret-tool", ["clear", "service", ServiceName, "account", ProbeName]);
+            backend = "secret-service";
+        }
+        else
+            backend = "encrypted-file";
+
+        Logger.Info("Secrets", $"Using {backend} for secrets");
+        return backend;
+    }
+
+    /// <summary>
+    /// Runs a keychain tool and captures its output.
+    /// </summary>
+    /// <returns>Exit code and stdout, or <c>(-1, "")</c> if the tool is missing or hangs.</returns>
+    private static (int ExitCode, string Output) RunTool(string fileName, string[] args, string? stdin = null)
+    {
+        try
+        {
+            var psi = new ProcessStartInfo(fileName, args)
+            {
+                RedirectStandardInput = stdin != null,
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                UseShellExecute = false,
+                CreateNoWindow = true
+            };
+            using var process = Process.Start(psi);
+            if (process == null) return (-1, "");
+
+            if (stdin != null)
+            {
+                process.StandardInput.Write(stdin);
+                process.StandardInput.Close();
+            }
+            var output = process.StandardOutput.ReadToEndAsync();
+            _ = process.StandardError.ReadToEndAsync();
+            if (!process.WaitForExit(ToolTimeoutMs))
+            {
+                try { process.Kill(); } catch { /* already gone */ }
+                return (-1, "");
+            }
+            return (process.ExitCode, output.Result);
+        }
+        catch (Exception)
+        {
+            return (-1, "");
+        }
+    }
+
+    private string? GetFromFile(string name)
+    {
+        lock (_fileLock)
+        {
+            if (!ReadFile().TryGetValue(name, out var blob)) return null;
+            return Encoding.UTF8.GetString(Unprotect(Convert.FromBase64String(blob)));
+        }
+    }
+
+    private bool SetInFile(string name, string value)
+    {
+        lock (_fileLock)
+        {
+            var secrets = ReadFile();
+            secrets[name] = Convert.ToBase64String(Protect(Encoding.UTF8.GetBytes(value)));
+            WriteFile(secrets);
+            return true;
+        }
+    }
+
+    private Dictionary<string, string> ReadFile()
+    {
+        if (!File.Exists(_filePath)) return new();
+        return JsonSerializer.Deserialize<Dictionary<string, string>>(File.ReadAllText(_filePath)) ?? new();
+    }
+
+    private void WriteFile(Dictionary<string, string> secrets)
+    {
+        var tempPath = _filePath + ".tmp";
+        File.WriteAllText(tempPath, JsonSerializer.Serialize(secrets));
+        RestrictToUser(tempPath);
+        File.Move(tempPath, _filePath, true);
+    }
+
+    private byte[] Protect(byte[] data)
+    {
+        if (OperatingSystem.IsWindows()) return Dpapi(data, protect: true);
+
+        var nonce = RandomNumberGenerator.GetBytes(NonceSize);
+        var result = new byte[NonceSize + TagSize + data.Length];
+        using var aes = new AesGcm(GetOrCreateKey(), TagSize);
+        aes.Encrypt(nonce, data, result.AsSpan(NonceSize + TagSize), result.AsSpan(NonceSize, TagSize));
+        nonce.CopyTo(result, 0);
+        return result;
+    }
+
+    private byte[] Unprotect(byte[] blob)
+    {
+        if (OperatingSystem.IsWindows()) return Dpapi(blob, protect: false);
+
+        var data = new byte[blob.Length - NonceSize - TagSize];
+        using var aes = new AesGcm(GetOrCreateKey(), TagSize);
+        aes.Decrypt(blob.AsSpan(0, NonceSize), blob.AsSpan(NonceSize + TagSize), blob.AsSpan(NonceSize, TagSize), data);
+        return data;
+    }
+
+    private byte[] GetOrCreateKey()
+    {
+        if (File.Exists(_keyPath))
+        {
+            var existing = File.ReadAllBytes(_keyPath);
+            if (existing.Length == KeySize) return existing;
+
+            // Keep the damaged key for recovery rather than replacing it; the next use creates a new one
+            var corruptPath = _keyPath + ".corrupt";
+            File.Move(_keyPath, corruptPath, true);
+            Logger.Error("Secrets", $"secrets.key is damaged ({existing.Length} bytes); moved it to {corruptPath}. Stored secrets can no longer be read");
+            throw new CryptographicException("secrets.key is damaged");
+        }
+
+        var key = RandomNumberGenerator.GetBytes(KeySize);
+        // Created private, so the key is never readable by others, even briefly
+        var options = new FileStreamOptions { Mode = FileMode.CreateNew, Access = FileAccess.Write };
+        if (!OperatingSystem.IsWindows()) options.UnixCreateMode = UnixFileMode.UserRead | UnixFileMode.UserWrite;
+        using (var stream = new FileStream(_keyPath, options))
+        {
+            stream.Write(key);
+        }
+        return key;
+    }
+
+    private static void RestrictToUser(string path)
+    {
+        if (!OperatingSystem.IsWindows())
+            File.SetUnixFileMode(path, UnixFileMode.UserRead | UnixFileMode.UserWrite);
+    }
+
+    #region DPAPI
+
+    private const int CryptProtectUiForbidden = 0x1;
+
+    [StructLayout(LayoutKind.Sequential)]
+    private struct DataBlob
+    {
+        public int Size;
+        public IntPtr Data;
+    }
+
+    [DllImport("crypt32.dll", SetLastError = true, CharSet = CharSet.Unicode)]
+    private static extern bool CryptProtectData(ref DataBlob dataIn, string? description, IntPtr entropy,
+        IntPtr reserved, IntPtr prompt, int flags, out DataBlob dataOut);
+
+    [DllImport("crypt32.dll", SetLastError = true, CharSet = CharSet.Unicode)]
+    private static extern bool CryptUnprotectData(ref DataBlob dataIn, IntPtr description, IntPtr entropy,
+        IntPtr reserved, IntPtr prompt, int flags, out DataBlob dataOut);
+
+    [DllImport("kernel32.dll")]
+    private static extern IntPtr LocalFree(IntPtr handle);
+
+    /// <summary>
+    /// Encrypts or decrypts with the current Windows user's DPAPI key.
+    /// </summary>
+    private static byte[] Dpapi(byte[] input, bool protect)
+    {
+        var handle = GCHandle.Alloc(input, GCHandleType.Pinned);
+        try
+        {
+            var dataIn = new DataBlob { Size = input.Length, Data = handle.AddrOfPinnedObject() };
+            var ok = protect
+                ? CryptProtectData(ref dataIn, ServiceName, IntPtr.Zero, IntPtr.Zero, IntPtr.Zero, CryptProtectUiForbidden, out var dataOut)
+                : CryptUnprotectData(ref dataIn, IntPtr.Zero, IntPtr.Zero, IntPtr.Zero, IntPtr.Zero, CryptProtectUiForbidden, out dataOut);
+            if (!ok) throw new CryptographicException(Marshal.GetLastWin32Error());
+
+            try
+            {
+                var output = new byte[dataOut.Size];
+                Marshal.Copy(dataOut.Data, output, 0, dataOut.Size);
+                return output;
+            }
+            finally
+            {
+                LocalFree(dataOut.Data);
+            }
+        }
+        finally
+        {
+            handle.Free();
+        }
+    }
+
+    #endregion
+}
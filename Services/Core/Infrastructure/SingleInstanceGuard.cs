@@ -0,0 +1,207 @@
+using System.IO.Pipes;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+
+namespace HyPrism.Services.Core.Infrastructure;
+
+/// <summary>
+/// Ensures only one launcher process runs per app directory.
+/// The first process holds an exclusive lock file and listens on a local named pipe;
+/// later invocations forward their command-line arguments over the pipe and exit.
+/// </summary>
+/// <remarks>
+/// Static because it must run in <c>Program.Main</c> before the DI container is built —
+/// two processes running migrations or downloads against the same directory corrupt state.
+/// </remarks>
+public static class SingleInstanceGuard
+{
+    private const string LockFileName = "hyprism.lock";
+
+    private static FileStream? _lockStream;
+    private static CancellationTokenSource? _listenCts;
+    private static string _pipeName = "";
+
+    private static readonly object _handlerLock = new();
+    // Arguments forwarded while the launcher is still starting, before anything subscribed
+    private static readonly List<string[]> _pendingArguments = new();
+    private static Action<string[]>? _argumentsReceived;
+
+    /// <summary>
+    /// Raised on a background thread when a second invocation forwards its arguments.
+    /// Arguments that arrive before the first subscriber are kept and handed to it once it subscribes.
+    /// </summary>
+    public static event Action<string[]>? ArgumentsReceived
+    {
+        add
+        {
+            string[][] pending;
+            lock (_handlerLock)
+            {
+                _argumentsReceived += value;
+                pending = _pendingArguments.ToArray();
+                _pendingArguments.Clear();
+            }
+            if (pending.Length > 0 && value != null)
+            {
+                _ = Task.Run(() =>
+                {
+                    foreach (var args in pending) value(args);
+                });
+            }
+        }
+        remove
+        {
+            lock (_handlerLock) _argumentsReceived -= value;
+        }
+    }
+
+    /// <summary>
+    /// Attempts to become the primary instance for the given app directory.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory the lock is scoped to.</param>
+    /// <returns><c>true</c> if this process now holds the lock; <c>false</c> if another instance does.</returns>
+    public static bool TryAcquire(string appDir)
+    {
+        _pipeName = BuildPipeName(appDir);
+
+        try
+        {
+            Directory.CreateDirectory(appDir);
+            // FileShare.None maps to an exclusive flock on Unix and a sharing violation on Windows,
+            // and the OS releases it automatically if the process dies.
+            _lockStream = new FileStream(
+                Path.Combine(appDir, LockFileName),
+                FileMode.OpenOrCreate,
+                FileAccess.ReadWrite,
+                FileShare.None);
+
+            _lockStream.SetLength(0);
+            var pid = Encoding.UTF8.GetBytes(Environment.ProcessId.ToString());
+            _lockStream.Write(pid, 0, pid.Length);
+            _lockStream.Flush();
+            return true;
+        }
+        catch (IOException)
+        {
+            return false;
+        }
+        catch (UnauthorizedAccessException ex)
+        {
+            // Can't create the lock at all (read-only dir etc.) — don't block startup over it
+            Logger.Warning("SingleInstance", $"Could not create instance lock: {ex.Message}");
+            return true;
+        }
+    }
+
+    /// <summary>
+    /// Starts listening for arguments forwarded by later invocations.
+    /// Call only after <see cref="TryAcquire"/> returned <c>true</c>.
+    /// </summary>
+    public static void StartListening()
+    {
+        if (_listenCts != null) return;
+        _listenCts = new CancellationTokenSource();
+        var ct = _listenCts.Token;
+
+        _ = Task.Run(async () =>
+        {
+            while (!ct.IsCancellationRequested)
+            {
+                try
+                {
+                    await using var server = new NamedPipeServerStream(
+                        _pipeName,
+                        PipeDirection.In,
+                        1,
+                        PipeTransmissionMode.Byte,
+                        PipeOptions.Asynchronous | PipeOptions.CurrentUserOnly);
+
+                    await server.WaitForConnectionAsync(ct);
+
+                    using var reader = new StreamReader(server, Encoding.UTF8);
+                    var payload = await reader.ReadLineAsync(ct);
+                    var args = string.IsNullOrEmpty(payload)
+                        ? []
+                        : JsonSerializer.Deserialize<string[]>(payload) ?? [];
+
+                    Logger.Info("SingleInstance", $"Second instance started, received {args.Length} argument(s)");
+                    RaiseArgumentsReceived(args);
+                }
+                catch (OperationCanceledException)
+                {
+                    break;
+                }
+                catch (Exception ex)
+                {
+                    Logger.Warning("SingleInstance", $"Hand-off listener error: {ex.Message}");
+                    try { await Task.Delay(1000, ct); } catch (OperationCanceledException) { break; }
+                }
+            }
+        }, ct);
+    }
+
+    /// <summary>
+    /// Forwards arguments to the running primary instance.
+    /// </summary>
+    /// <param name="args">The command-line arguments of this invocation.</param>
+    /// <returns><c>true</c> if the primary instance received the arguments.</returns>
+    public static async Task<bool> ForwardToPrimaryAsync(string[] args)
+    {
+        try
+        {
+            await using var client = new NamedPipeClientStream(
+                ".",
+                _pipeName,
+                PipeDirection.Out,
+                PipeOptions.Asynchronous | PipeOptions.CurrentUserOnly);
+
+            using var timeout = new CancellationTokenSource(TimeSpan.FromSeconds(3));
+            await client.ConnectAsync(timeout.Token);
+
+            await using var writer = new StreamWriter(client, new UTF8Encoding(false));
+            await writer.WriteLineAsync(JsonSerializer.Serialize(args));
+            await writer.FlushAsync();
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("SingleInstance", $"Could not reach the running instance: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <summary>
+    /// Stops the listener and releases the lock file.
+    /// </summary>
+    public static void Release()
+    {
+        try { _listenCts?.Cancel(); } catch { /* ignore */ }
+        try { _lockStream?.Dispose(); } catch { /* ignore */ }
+        _lockStream = null;
+    }
+
+    private static void RaiseArgumentsReceived(string[] args)
+    {
+        Action<string[]>? handler;
+        lock (_handlerLock)
+        {
+            handler = _argumentsReceived;
+            if (handler == null)
+            {
+                _pendingArguments.Add(args);
+                return;
+            }
+        }
+        handler(args);
+    }
+
+    private static string BuildPipeName(string appDir)
+    {
+        // Scope the pipe to the data directory so portable installs don't collide
+        var normalized = Path.GetFullPath(appDir).TrimEnd(Path.DirectorySeparatorChar, Path.AltDirectorySeparatorChar);
+        if (OperatingSystem.IsWindows()) normalized = normalized.ToLowerInvariant();
+        var hash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(normalized)));
+        return $"HyPrism-{hash[..16]}";
+    }
+}
@@ -1,5 +1,7 @@
 using System.Diagnostics;
 using System.Runtime.InteropServices;
+using System.Text.Json;
+using System.Text.RegularExpressions;
 
 namespace HyPrism.Services.Core.Infrastructure;
 
@@ -76,6 +78,70 @@ public class UtilityService
         }
     }
 
+    /// <summary>
+    /// Gets whether the launcher runs inside a Flatpak sandbox.
+    /// </summary>
+    public static bool IsFlatpak() =>
+        File.Exists("/.flatpak-info") || !string.IsNullOrEmpty(Environment.GetEnvironmentVariable("FLATPAK_ID"));
+
+    /// <summary>
+    /// Moves data left by a non-Flatpak install in <c>~/.local/share/HyPrism</c> into the Flatpak's own data
+    /// directory (<c>~/.var/app/&lt;id&gt;/data/HyPrism</c>), leaving a link behind so absolute paths and links
+    /// into the old location keep working, and rewrites those paths in config.json.
+    /// </summary>
+    /// <remarks>
+    /// Runs before the logger is set up, so the outcome is returned for the caller to log. The old directory is
+    /// only visible when the user granted the Flatpak access to their home folder; without that there is
+    /// nothing to migrate. Data already in the Flatpak directory is never merged or replaced.
+    /// </remarks>
+    /// <param name="appDir">The data directory the launcher is about to use.</param>
+    /// <returns>What happened, or <c>null</c> if there was nothing to migrate.</returns>
+    public static string? MigrateLegacyFlatpakAppDir(string appDir)
+    {
+        if (!IsFlatpak()) return null;
+
+        var legacyDir = Path.Combine(Environment.GetFolderPath(Environment.SpecialFolder.UserProfile), ".local", "share", "HyPrism");
+        try
+        {
+            var legacy = new DirectoryInfo(legacyDir);
+            if (!legacy.Exists || legacy.LinkTarget != null
+                || string.Equals(Path.GetFullPath(legacyDir), Path.GetFullPath(appDir), StringComparison.Ordinal))
+                return null;
+
+            if (Directory.Exists(appDir) && Directory.EnumerateFileSystemEntries(appDir).Any())
+                return $"Found launcher data in {legacyDir} and {appDir}; using {appDir} and leaving the other untouched";
+
+            Directory.CreateDirectory(Path.GetDirectoryName(appDir)!);
+            if (Directory.Exists(appDir)) Directory.Delete(appDir);
+            Directory.Move(legacyDir, appDir);
+        }
+        catch (Exception ex)
+        {
+            // A move across file systems fails before anything is changed
+            return $"Could not move launcher data from {legacyDir} to {appDir}: {ex.Message}";
+        }
+
+        try
+        {
+            // Paths under the old directory (custom instance folder, last export folder, ...) move with it
+            var pathPattern = new Regex(Regex.Escape(JsonEncodedText.Encode(legacyDir).ToString()) + "(?=[/\"])");
+            foreach (var name in new[] { "config.json", "config.json.bak" })
+            {
+                var path = Path.Combine(appDir, name);
+                if (!File.Exists(path)) continue;
+                var json = File.ReadAllText(path);
+                File.WriteAllText(path, pathPattern.Replace(json, JsonEncodedText.Encode(appDir).ToString()));
+            }
+
+            Directory.CreateSymbolicLink(legacyDir, appDir);
+            return $"Moved launcher data from {legacyDir} to {appDir}";
+        }
+        catch (Exception ex)
+        {
+            return $"Moved launcher data from {legacyDir} to {appDir}, but could not update paths to it: {ex.Message}";
+        }
+    }
+
     /// <summary>
     /// Gets the current operating system identifier.
     /// </summary>
@@ -124,6 +190,35 @@ public class UtilityService
         }
     }
 
+    /// <summary>
+    /// Quotes an argument for a Windows command line the way programs split it (CommandLineToArgvW):
+    /// quoted when empty or containing whitespace or a quote, with embedded quotes written as <c>\"</c>
+    /// and the backslashes in front of them doubled. Does not protect against cmd.exe operators.
+    /// </summary>
+    /// <param name="value">The argument.</param>
+    /// <param name="alwaysQuote">Quote the argument even when programs would read it correctly without.</param>
+    public static string QuoteWindowsArgument(string value, bool alwaysQuote = false)
+    {
+        if (!alwaysQuote && value.Length > 0 && !value.Any(c => char.IsWhiteSpace(c) || c == '"')) return value;
+
+        var quoted = new System.Text.StringBuilder("\"");
+        int backslashes = 0;
+        foreach (var c in value)
+        {
+            if (c == '\\')
+            {
+                backslashes++;
+                continue;
+            }
+            // Backslashes only escape when a quote follows them
+            quoted.Append('\\', c == '"' ? backslashes * 2 + 1 : backslashes);
+            backslashes = 0;
+            quoted.Append(c);
+        }
+        // ...including the closing one
+        return quoted.Append('\\', backslashes * 2).Append('"').ToString();
+    }
+
     /// <summary>
     /// Clears macOS quarantine attributes from a file or directory (macOS only).
     /// </summary>
@@ -148,33 +243,29 @@ public class UtilityService
         var dir = new DirectoryInfo(sourceDir);
         if (!dir.Exists) return;
 
-        // Prevent copying into itself
-        var normalizedSource = Path.GetFullPath(sourceDir).TrimEnd(Path.DirectorySeparatorChar);
-        var normalizedDest = Path.GetFullPath(destinationDir).TrimEnd(Path.DirectorySeparatorChar);
-        
-        if (normalizedSource.Equals(normalizedDest, StringComparison.OrdinalIgnoreCase)) return;
-        if (normalizedDest.StartsWith(normalizedSource + Path.DirectorySeparatorChar, StringComparison.OrdinalIgnoreCase)) return;
-        if (normalizedSource.StartsWith(normalizedDest + Path.DirectorySeparatorChar, StringComparison.OrdinalIgnoreCase)) return;
-
-        Directory.CreateDirectory(destinationDir);
+        if (IsNestedCopy(sourceDir, destinationDir)) return;
 
-        foreach (var file in dir.GetFiles())
-        {
-            string targetFilePath = Path.Combine(destinationDir, file.Name);
-            file.CopyTo(targetFilePath, true);
-        }
-
-        foreach (var subDir in dir.GetDirectories())
-        {
-            string newDestinationDir = Path.Combine(destinationDir, subDir.Name);
-            CopyDirectory(subDir.FullName, newDestinationDir);
-        }
+        CopyDirectoryCore(dir, destinationDir, true, null, new CopyProgress(0));
     }
 
     /// <summary>
     /// Overload of CopyDirectory with overwrite parameter.
     /// </summary>
     public static void CopyDirectory(string sourceDir, string destDir, bool overwrite)
+    {
+        CopyDirectory(sourceDir, destDir, overwrite, null);
+    }
+
+    /// <summary>
+    /// Copies a directory tree file by file through a fixed-size buffer, recreating symbolic
+    /// links instead of following them. Links that cannot be created (e.g. Windows without
+    /// symlink rights) are replaced by a copy of their target.
+    /// </summary>
+    /// <param name="sourceDir">Directory to copy.</param>
+    /// <param name="destDir">Destination directory; created if missing.</param>
+    /// <param name="overwrite">Whether existing files are replaced.</param>
+    /// <param name="onProgress">Receives copied and total bytes after each chunk.</param>
+    public static void CopyDirectory(string sourceDir, string destDir, bool overwrite, Action<long, long>? onProgress)
     {
         var dir = new DirectoryInfo(sourceDir);
         if (!dir.Exists)
@@ -183,18 +274,144 @@ public class UtilityService
             return;
         }
 
+        if (IsNestedCopy(sourceDir, destDir))
+        {
+            Logger.Warning("Files", $"Refusing to copy {sourceDir} into itself ({destDir})");
+            return;
+        }
+
+        long total = onProgress != null ? GetCopySize(dir) : 0;
+        CopyDirectoryCore(dir, destDir, overwrite, onProgress, new CopyProgress(total));
+        onProgress?.Invoke(total, total);
+    }
+
+    private const int CopyBufferSize = 1024 * 1024;
+
+    private sealed class CopyProgress(long total)
+    {
+        public long Copied;
+        public readonly long Total = total;
+        // Shared by every file of one copy, allocated on the first streamed file
+        public byte[]? Buffer;
+    }
+
+    private static bool IsNestedCopy(string sourceDir, string destDir)
+    {
+        var normalizedSource = Path.GetFullPath(sourceDir).TrimEnd(Path.DirectorySeparatorChar);
+        var normalizedDest = Path.GetFullPath(destDir).TrimEnd(Path.DirectorySeparatorChar);
+
+        return normalizedSource.Equals(normalizedDest, StringComparison.OrdinalIgnoreCase)
+            || normalizedDest.StartsWith(normalizedSource + Path.DirectorySeparatorChar, StringComparison.OrdinalIgnoreCase)
+            || normalizedSource.StartsWith(normalizedDest + Path.DirectorySeparatorChar, StringComparison.OrdinalIgnoreCase);
+    }
+
+    private static long GetCopySize(DirectoryInfo dir)
+    {
+        long size = 0;
+        foreach (var entry in dir.EnumerateFileSystemInfos())
+        {
+            if (entry.LinkTarget != null) continue;
+            if (entry is FileInfo file) size += file.Length;
+            else if (entry is DirectoryInfo sub) size += GetCopySize(sub);
+        }
+        return size;
+    }
+
+    private static void CopyDirectoryCore(DirectoryInfo dir, string destDir, bool overwrite,
+        Action<long, long>? onProgress, CopyProgress progress)
+    {
         Directory.CreateDirectory(destDir);
 
-        foreach (var file in dir.GetFiles())
+        foreach (var entry in dir.EnumerateFileSystemInfos())
         {
-            var targetPath = Path.Combine(destDir, file.Name);
-            file.CopyTo(targetPath, overwrite);
+            var target = Path.Combine(destDir, entry.Name);
+
+            // Links are checked first: DirectoryInfo/FileInfo would otherwise follow them
+            if (entry.LinkTarget != null)
+            {
+                CopySymlink(entry, target, overwrite, onProgress, progress);
+            }
+            else if (entry is DirectoryInfo subDir)
+            {
+                CopyDirectoryCore(subDir, target, overwrite, onProgress, progress);
+            }
+            else if (entry is FileInfo file)
+            {
+                CopyFileStreamed(file, target, overwrite, onProgress, progress);
+            }
+        }
+    }
+
+    private static void CopySymlink(FileSystemInfo link, string target, bool overwrite,
+        Action<long, long>? onProgress, CopyProgress progress)
+    {
+        bool isDirectory = link is DirectoryInfo;
+        var existing = new FileInfo(target);
+        bool existingIsLink = existing.LinkTarget != null;
+        if (existingIsLink || existing.Exists || Directory.Exists(target))
+        {
+            if (!overwrite) throw new IOException($"The file '{target}' already exists.");
+            // Deleting a link never touches what it points to
+            if (Directory.Exists(target)) Directory.Delete(target, recursive: !existingIsLink);
+            else File.Delete(target);
+        }
+
+        try
+        {
+            // Keep the link text as-is so relative links (macOS framework bundles) stay relative
+            if (isDirectory) Directory.CreateSymbolicLink(target, link.LinkTarget!);
+            else File.CreateSymbolicLink(target, link.LinkTarget!);
+            return;
+        }
+        catch (Exception ex) when (ex is UnauthorizedAccessException or IOException or PlatformNotSupportedException)
+        {
+            Logger.Warning("Files", $"Cannot create symlink {target}, copying its target instead: {ex.Message}");
+        }
+
+        var resolved = link.ResolveLinkTarget(returnFinalTarget: true);
+        if (resolved == null || !resolved.Exists) return;
+
+        if (resolved is DirectoryInfo resolvedDir)
+        {
+            // A link pointing at one of its own ancestors would recurse forever
+            if (IsNestedCopy(resolvedDir.FullName, target)) return;
+            CopyDirectoryCore(resolvedDir, target, overwrite, onProgress, progress);
+        }
+        else if (resolved is FileInfo resolvedFile)
+        {
+            CopyFileStreamed(resolvedFile, target, overwrite, onProgress, progress);
+        }
+    }
+
+    private static void CopyFileStreamed(FileInfo file, string target, bool overwrite,
+        Action<long, long>? onProgress, CopyProgress progress)
+    {
+        if (onProgress == null)
+        {
+            // No progress wanted: let the OS copy (keeps permissions, uses copy-on-write where available)
+            file.CopyTo(target, overwrite);
+            return;
+        }
+
+        // The streams are unbuffered: every read and write already covers a whole chunk
+        using (var input = new FileStream(file.FullName, FileMode.Open, FileAccess.Read, FileShare.Read, bufferSize: 0))
+        using (var output = new FileStream(target, overwrite ? FileMode.Create : FileMode.CreateNew, FileAccess.Write, FileShare.None, bufferSize: 0))
+        {
+            var buffer = progress.Buffer ??= new byte[CopyBufferSize];
+            int read;
+            while ((read = input.Read(buffer, 0, buffer.Length)) > 0)
+            {
+                output.Write(buffer, 0, read);
+                progress.Copied += read;
+                onProgress(Math.Min(progress.Copied, progress.Total), progress.Total);
+            }
         }
 
-        foreach (var subDir in dir.GetDirectories())
+        File.SetLastWriteTimeUtc(target, file.LastWriteTimeUtc);
+        if (!OperatingSystem.IsWindows())
         {
-            var newDestDir = Path.Combine(destDir, subDir.Name);
-            CopyDirectory(subDir.FullName, newDestDir, overwrite);
+            // Executables inside app bundles must keep their mode bits
+            File.SetUnixFileMode(target, file.UnixFileMode);
         }
     }
 
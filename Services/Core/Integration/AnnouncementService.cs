@@ -0,0 +1,162 @@
+using System.Net.Http;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Fetches <c>announcements.json</c> from the HyPrism repository, caches it in memory for
+/// <see cref="CacheTtl"/> and filters entries by launcher version range, schedule and dismissal.
+/// </summary>
+public class AnnouncementService : IAnnouncementService
+{
+    private const string FeedUrl = "https://raw.githubusercontent.com/yyyumeniku/HyPrism/main/announcements.json";
+    private static readonly TimeSpan CacheTtl = TimeSpan.FromMinutes(15);
+
+    private static readonly string[] SeverityOrder = ["critical", "warning", "info"];
+
+    private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly ISettingsService _settingsService;
+    private readonly SemaphoreSlim _fetchLock = new(1, 1);
+
+    private List<Announcement>? _cached;
+    private DateTime _cachedAt = DateTime.MinValue;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="AnnouncementService"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for fetching the feed.</param>
+    /// <param name="networkPolicy">Timeout and retry policy for the feed request.</param>
+    /// <param name="settingsService">Settings holding the language and dismissed announcement IDs.</param>
+    public AnnouncementService(HttpClient httpClient, NetworkPolicy networkPolicy, ISettingsService settingsService)
+    {
+        _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
+        _settingsService = settingsService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<Announcement>> GetActiveAnnouncementsAsync(bool forceRefresh = false)
+    {
+        var all = await GetAllAsync(forceRefresh);
+        var version = UpdateService.GetCurrentVersion();
+        var language = _settingsService.GetLanguage();
+        var now = DateTime.UtcNow;
+
+        return all
+            .Where(a => !string.IsNullOrWhiteSpace(a.Id) && !string.IsNullOrWhiteSpace(a.Message))
+            .Where(a => a.StartsAt == null || a.StartsAt.Value.ToUniversalTime() <= now)
+            .Where(a => a.ExpiresAt == null || a.ExpiresAt.Value.ToUniversalTime() > now)
+            .Where(a => IsInVersionRange(version, a.MinLauncherVersion, a.MaxLauncherVersion))
+            .Where(a => !a.Dismissible || !_settingsService.IsAnnouncementDismissed(a.Id))
+            .OrderBy(a => SeverityRank(a.Severity))
+            .Select(a => Localize(a, language))
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public async Task<bool> DismissAsync(string id)
+    {
+        var announcement = (await GetAllAsync(false)).FirstOrDefault(a => a.Id == id);
+        if (announcement is { Dismissible: false })
+        {
+            Logger.Warning("Announcements", $"Announcement {id} cannot be dismissed");
+            return false;
+        }
+
+        return _settingsService.DismissAnnouncement(id);
+    }
+
+    private async Task<List<Announcement>> GetAllAsync(bool forceRefresh)
+    {
+        if (!forceRefresh && _cached != null && DateTime.UtcNow - _cachedAt < CacheTtl)
+            return _cached;
+
+        await _fetchLock.WaitAsync();
+        try
+        {
+            if (!forceRefresh && _cached != null && DateTime.UtcNow - _cachedAt < CacheTtl)
+                return _cached;
+
+            var json = await _networkPolicy.ExecuteAsync(RequestClass.Metadata, "Announcements fetch",
+                token => _httpClient.GetStringAsync(FeedUrl, token));
+            var feed = JsonSerializer.Deserialize<AnnouncementFeed>(json);
+
+            _cached = feed?.Announcements ?? new List<Announcement>();
+            _cachedAt = DateTime.UtcNow;
+            Logger.Info("Announcements", $"Loaded {_cached.Count} announcement(s)");
+            return _cached;
+        }
+        catch (HttpRequestException ex) when (ex.StatusCode == System.Net.HttpStatusCode.NotFound)
+        {
+            // No feed published means no announcements
+            _cached = new List<Announcement>();
+            _cachedAt = DateTime.UtcNow;
+            return _cached;
+        }
+        catch (Exception ex)
+        {
+            // Keep showing the last known announcements while offline
+            Logger.Warning("Announcements", $"Failed to fetch announcements: {ex.Message}");
+            return _cached ?? new List<Announcement>();
+        }
+        finally
+        {
+            _fetchLock.Release();
+        }
+    }
+
+    private static Announcement Localize(Announcement source, string language)
+    {
+        if (source.Translations == null || !source.Translations.TryGetValue(language, out var translated) ||
+            string.IsNullOrWhiteSpace(translated))
+            return source;
+
+        return new Announcement
+        {
+            Id = source.Id,
+            Severity = source.Severity,
+            Title = source.Title,
+            Message = translated,
+            Url = source.Url,
+            MinLauncherVersion = source.MinLauncherVersion,
+            MaxLauncherVersion = source.MaxLauncherVersion,
+            StartsAt = source.StartsAt,
+            ExpiresAt = source.ExpiresAt,
+            Dismissible = source.Dismissible
+        };
+    }
+
+    private static int SeverityRank(string severity)
+    {
+        var index = Array.IndexOf(SeverityOrder, severity.ToLowerInvariant());
+        return index < 0 ? SeverityOrder.Length : index;
+    }
+
+    private static bool IsInVersionRange(string current, string? min, string? max)
+    {
+        if (!string.IsNullOrWhiteSpace(min) && CompareVersions(current, min) < 0) return false;
+        if (!string.IsNullOrWhiteSpace(max) && CompareVersions(current, max) > 0) return false;
+        return true;
+    }
+
+    private static int CompareVersions(string a, string b)
+    {
+        // Same numeric comparison as update checks: "2.0.1" vs "2.1"; suffixes like "-beta" are ignored
+        static int[] Parse(string v) => v.TrimStart('v', 'V').Split('-', '+')[0].Split('.')
+            .Select(p => int.TryParse(p, out var n) ? n : 0).ToArray();
+
+        var left = Parse(a);
+        var right = Parse(b);
+        for (int i = 0; i < Math.Max(left.Length, right.Length); i++)
+        {
+            var l = i < left.Length ? left[i] : 0;
+            var r = i < right.Length ? right[i] : 0;
+            if (l != r) return l.CompareTo(r);
+        }
+        return 0;
+    }
+}
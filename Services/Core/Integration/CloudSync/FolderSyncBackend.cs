@@ -0,0 +1,60 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration.CloudSync;
+
+/// <summary>
+/// Syncs through a local folder that another program (Dropbox, OneDrive, Google Drive, Syncthing) keeps
+/// in sync between computers.
+/// </summary>
+public class FolderSyncBackend : ICloudSyncBackend
+{
+    public const string BackendName = "folder";
+
+    private readonly string _folder;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="FolderSyncBackend"/> class.
+    /// </summary>
+    /// <param name="folder">The synced folder; created on first upload.</param>
+    public FolderSyncBackend(string folder)
+    {
+        _folder = folder;
+    }
+
+    /// <inheritdoc/>
+    public string Name => BackendName;
+
+    /// <inheritdoc/>
+    public Task<List<CloudSyncRemoteFile>> ListAsync(CancellationToken ct = default)
+    {
+        if (!Directory.Exists(_folder))
+            throw new DirectoryNotFoundException($"Sync folder not found: {_folder}");
+
+        var files = new DirectoryInfo(_folder).EnumerateFiles()
+            .Where(f => !f.Name.EndsWith(".tmp", StringComparison.OrdinalIgnoreCase))
+            .Select(f => new CloudSyncRemoteFile { Name = f.Name, ModifiedUtc = f.LastWriteTimeUtc, Size = f.Length })
+            .ToList();
+        return Task.FromResult(files);
+    }
+
+    /// <inheritdoc/>
+    public async Task UploadAsync(string name, string localPath, CancellationToken ct = default)
+    {
+        Directory.CreateDirectory(_folder);
+        var target = Path.Combine(_folder, name);
+
+        // Copy under a temporary name so the sync client never picks up a half-written file
+        await using (var source = File.OpenRead(localPath))
+        await using (var dest = File.Create(target + ".tmp"))
+            await source.CopyToAsync(dest, ct);
+        File.Move(target + ".tmp", target, true);
+    }
+
+    /// <inheritdoc/>
+    public async Task DownloadAsync(string name, string localPath, CancellationToken ct = default)
+    {
+        await using var source = File.OpenRead(Path.Combine(_folder, name));
+        await using var dest = File.Create(localPath);
+        await source.CopyToAsync(dest, ct);
+    }
+}
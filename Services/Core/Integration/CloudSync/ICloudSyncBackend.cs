@@ -0,0 +1,41 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration.CloudSync;
+
+/// <summary>
+/// Storage the cloud sync reads and writes whole files to. Files live in one flat folder; names are plain
+/// file names without separators.
+/// </summary>
+/// <remarks>
+/// Modification times are whatever the storage reports; they are only compared with earlier readings
+/// from the same storage, never with local file times.
+/// </remarks>
+public interface ICloudSyncBackend
+{
+    /// <summary>
+    /// Backend name as used in <see cref="CloudSyncConfig.Backend"/>.
+    /// </summary>
+    string Name { get; }
+
+    /// <summary>
+    /// Lists the files in the sync folder. Network and I/O failures propagate to the caller.
+    /// </summary>
+    /// <param name="ct">Cancellation token.</param>
+    Task<List<CloudSyncRemoteFile>> ListAsync(CancellationToken ct = default);
+
+    /// <summary>
+    /// Writes a file, replacing any file of the same name.
+    /// </summary>
+    /// <param name="name">File name.</param>
+    /// <param name="localPath">Local file to upload.</param>
+    /// <param name="ct">Cancellation token.</param>
+    Task UploadAsync(string name, string localPath, CancellationToken ct = default);
+
+    /// <summary>
+    /// Reads a file into <paramref name="localPath"/>.
+    /// </summary>
+    /// <param name="name">File name.</param>
+    /// <param name="localPath">Where to write the file.</param>
+    /// <param name="ct">Cancellation token.</param>
+    Task DownloadAsync(string name, string localPath, CancellationToken ct = default);
+}
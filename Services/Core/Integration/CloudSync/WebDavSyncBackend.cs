@@ -0,0 +1,134 @@
+using System.Globalization;
+using System.Net;
+using System.Net.Http.Headers;
+using System.Text;
+using System.Xml.Linq;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration.CloudSync;
+
+/// <summary>
+/// Syncs with a WebDAV collection (Nextcloud, ownCloud, Apache <c>mod_dav</c>, most NAS systems).
+/// </summary>
+/// <remarks>
+/// Files are listed with a <c>PROPFIND</c> of depth 1 and transferred with <c>GET</c> and <c>PUT</c>.
+/// The collection is created with <c>MKCOL</c> on the first upload if it does not exist yet; parent
+/// collections must already exist.
+/// </remarks>
+public class WebDavSyncBackend : ICloudSyncBackend
+{
+    public const string BackendName = "webdav";
+
+    private static readonly XNamespace Dav = "DAV:";
+    private static readonly HttpMethod Propfind = new("PROPFIND");
+    private static readonly HttpMethod Mkcol = new("MKCOL");
+
+    private const string PropfindBody =
+        "<?xml version=\"1.0\" encoding=\"utf-8\"?>" +
+        "<d:propfind xmlns:d=\"DAV:\"><d:prop><d:getlastmodified/><d:getcontentlength/><d:resourcetype/></d:prop></d:propfind>";
+
+    private readonly HttpClient _httpClient;
+    private readonly Uri _baseUri;
+    private readonly AuthenticationHeaderValue? _auth;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="WebDavSyncBackend"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for requests.</param>
+    /// <param name="url">Collection URL; a trailing slash is added if missing.</param>
+    /// <param name="username">User name for basic authentication; empty for none.</param>
+    /// <param name="password">Password for basic authentication.</param>
+    /// <exception cref="ArgumentException">The URL is not an absolute HTTP(S) URL.</exception>
+    public WebDavSyncBackend(HttpClient httpClient, string url, string username, string? password)
+    {
+        if (!Uri.TryCreate(url.EndsWith('/') ? url : url + "/", UriKind.Absolute, out var uri) ||
+            (uri.Scheme != Uri.UriSchemeHttps && uri.Scheme != Uri.UriSchemeHttp))
+            throw new ArgumentException($"Not a WebDAV URL: {url}", nameof(url));
+
+        _httpClient = httpClient;
+        _baseUri = uri;
+        if (!string.IsNullOrEmpty(username))
+        {
+            var credentials = Convert.ToBase64String(Encoding.UTF8.GetBytes($"{username}:{password}"));
+            _auth = new AuthenticationHeaderValue("Basic", credentials);
+        }
+    }
+
+    /// <inheritdoc/>
+    public string Name => BackendName;
+
+    /// <inheritdoc/>
+    public async Task<List<CloudSyncRemoteFile>> ListAsync(CancellationToken ct = default)
+    {
+        using var request = CreateRequest(Propfind, _baseUri);
+        request.Headers.Add("Depth", "1");
+        request.Content = new StringContent(PropfindBody, Encoding.UTF8, "application/xml");
+
+        using var response = await _httpClient.SendAsync(request, ct);
+        if (response.StatusCode == HttpStatusCode.NotFound) return [];
+        response.EnsureSuccessStatusCode();
+
+        var doc = XDocument.Parse(await response.Content.ReadAsStringAsync(ct));
+        var files = new List<CloudSyncRemoteFile>();
+        foreach (var entry in doc.Descendants(Dav + "response"))
+        {
+            var href = entry.Element(Dav + "href")?.Value;
+            var prop = entry.Descendants(Dav + "prop").FirstOrDefault();
+            if (string.IsNullOrEmpty(href) || prop == null) continue;
+            // Collections, including the sync folder itself
+            if (prop.Element(Dav + "resourcetype")?.Element(Dav + "collection") != null) continue;
+
+            var name = Uri.UnescapeDataString(href.TrimEnd('/').Split('/').Last());
+            if (name.EndsWith(".tmp", StringComparison.OrdinalIgnoreCase)) continue;
+
+            DateTime.TryParse(prop.Element(Dav + "getlastmodified")?.Value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var modified);
+            long.TryParse(prop.Element(Dav + "getcontentlength")?.Value, out var size);
+            files.Add(new CloudSyncRemoteFile { Name = name, ModifiedUtc = modified, Size = size });
+        }
+        return files;
+    }
+
+    /// <inheritdoc/>
+    public async Task UploadAsync(string name, string localPath, CancellationToken ct = default)
+    {
+        await EnsureCollectionAsync(ct);
+
+        await using var stream = File.OpenRead(localPath);
+        using var request = CreateRequest(HttpMethod.Put, FileUri(name));
+        request.Content = new StreamContent(stream);
+        request.Content.Headers.ContentType = new MediaTypeHeaderValue("application/octet-stream");
+
+        using var response = await _httpClient.SendAsync(request, ct);
+        response.EnsureSuccessStatusCode();
+    }
+
+    /// <inheritdoc/>
+    public async Task DownloadAsync(string name, string localPath, CancellationToken ct = default)
+    {
+        using var request = CreateRequest(HttpMethod.Get, FileUri(name));
+        using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, ct);
+        response.EnsureSuccessStatusCode();
+
+        await using var source = await response.Content.ReadAsStreamAsync(ct);
+        await using var dest = File.Create(localPath);
+        await source.CopyToAsync(dest, ct);
+    }
+
+    private async Task EnsureCollectionAsync(CancellationToken ct)
+    {
+        using var request = CreateRequest(Mkcol, _baseUri);
+        using var response = await _httpClient.SendAsync(request, ct);
+        // 405 Method Not Allowed means the collection already exists
+        if (response.StatusCode != HttpStatusCode.MethodNotAllowed) response.EnsureSuccessStatusCode();
+    }
+
+    private Uri FileUri(string name) => new(_baseUri, Uri.EscapeDataString(name));
+
+    private HttpRequestMessage CreateRequest(HttpMethod method, Uri uri)
+    {
+        var request = new HttpRequestMessage(method, uri);
+        request.Headers.Authorization = _auth;
+        return request;
+    }
+}
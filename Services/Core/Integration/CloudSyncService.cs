@@ -0,0 +1,484 @@
+using System.IO.Compression;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration.CloudSync;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Syncs selected worlds, the saved profile skins and launcher preferences through an <see cref="ICloudSyncBackend"/>.
+/// </summary>
+/// <remarks>
+/// Each item is one remote file: <c>world-{name}.zip</c>, <c>skins.zip</c> and <c>settings.json</c>.
+/// <c>cloud-sync-state.json</c> remembers, per item, the local and remote modification times seen at the
+/// last sync (a content hash for the settings). An item changed on one side since then is copied to the
+/// other; one changed on both sides, or found on both sides before it was ever synced, becomes a conflict
+/// and is left alone until <see cref="ResolveConflictAsync"/> picks a side.
+/// </remarks>
+public class CloudSyncService : ICloudSyncService
+{
+    private const string WebDavPasswordSecret = "cloud-sync-webdav";
+    private const string SkinsKey = "skins";
+    private const string SettingsKey = "settings";
+    private const string WorldKeyPrefix = "world:";
+
+    private static readonly JsonSerializerOptions StateJsonOptions = new() { WriteIndented = true };
+
+    private readonly string _appDir;
+    private readonly string _statePath;
+    private readonly IConfigService _configService;
+    private readonly ISecretStore _secretStore;
+    private readonly IInstanceService _instanceService;
+    private readonly IWorldService _worldService;
+    private readonly HttpClient _httpClient;
+    private readonly SemaphoreSlim _gate = new(1, 1);
+    private readonly object _lock = new();
+    private SyncState? _state;
+    // The WebDAV password when the secret store could not keep it, for this launcher session only
+    private volatile string? _sessionPassword;
+
+    private sealed class SyncState
+    {
+        public Dictionary<string, CloudSyncItemState> Items { get; set; } = new();
+        public List<CloudSyncConflict> Conflicts { get; set; } = new();
+        public CloudSyncResult? LastResult { get; set; }
+    }
+
+    private sealed record SyncItem(string Key, string Kind, string Name, string RemoteName, string? InstanceId = null);
+
+    /// <summary>
+    /// Launcher preferences that follow the user between computers. Accounts, tokens, paths and
+    /// per-machine choices (GPU, window state) are left out.
+    /// </summary>
+    private sealed class SyncedSettings
+    {
+        public string Language { get; set; } = "";
+        public string AccentColor { get; set; } = "";
+        public string BackgroundMode { get; set; } = "";
+        public bool MusicEnabled { get; set; }
+        public bool CloseAfterLaunch { get; set; }
+        public bool MinimizeOnLaunch { get; set; }
+        public bool RestoreOnGameExit { get; set; }
+        public bool ShowDiscordAnnouncements { get; set; }
+        public bool DisableNews { get; set; }
+        public bool ShowAlphaMods { get; set; }
+
+        public static SyncedSettings From(Config c) => new()
+        {
+            Language = c.Language,
+            AccentColor = c.AccentColor,
+            BackgroundMode = c.BackgroundMode,
+            MusicEnabled = c.MusicEnabled,
+            CloseAfterLaunch = c.CloseAfterLaunch,
+            MinimizeOnLaunch = c.MinimizeOnLaunch,
+            RestoreOnGameExit = c.RestoreOnGameExit,
+            ShowDiscordAnnouncements = c.ShowDiscordAnnouncements,
+            DisableNews = c.DisableNews,
+            ShowAlphaMods = c.ShowAlphaMods
+        };
+
+        public void ApplyTo(Config c)
+        {
+            if (!string.IsNullOrEmpty(Language)) c.Language = Language;
+            if (!string.IsNullOrEmpty(AccentColor)) c.AccentColor = AccentColor;
+            if (!string.IsNullOrEmpty(BackgroundMode)) c.BackgroundMode = BackgroundMode;
+            c.MusicEnabled = MusicEnabled;
+            c.CloseAfterLaunch = CloseAfterLaunch;
+            c.MinimizeOnLaunch = MinimizeOnLaunch;
+            c.RestoreOnGameExit = RestoreOnGameExit;
+            c.ShowDiscordAnnouncements = ShowDiscordAnnouncements;
+            c.DisableNews = DisableNews;
+            c.ShowAlphaMods = ShowAlphaMods;
+        }
+    }
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="CloudSyncService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding the sync state and <c>Profiles</c>.</param>
+    /// <param name="configService">Holds the sync settings and the synced preferences.</param>
+    /// <param name="secretStore">Keeps the WebDAV password.</param>
+    /// <param name="instanceService">Resolves instance folders.</param>
+    /// <param name="worldService">Resolves world folders.</param>
+    /// <param name="httpClient">The HTTP client for the WebDAV backend.</param>
+    /// <param name="progressService">Raises the game state changes that trigger a sync after playing.</param>
+    public CloudSyncService(string appDir, IConfigService configService, ISecretStore secretStore,
+        IInstanceService instanceService, IWorldService worldService, HttpClient httpClient,
+        IProgressNotificationService progressService)
+    {
+        _appDir = appDir;
+        _statePath = Path.Combine(appDir, "cloud-sync-state.json");
+        _configService = configService;
+        _secretStore = secretStore;
+        _instanceService = instanceService;
+        _worldService = worldService;
+        _httpClient = httpClient;
+
+        progressService.GameStateChanged += (state, exitCode) =>
+        {
+            var config = _configService.Configuration.CloudSync;
+            if (state == "stopped" && config.Enabled && config.SyncAfterPlaying) _ = SyncAsync();
+        };
+    }
+
+    /// <inheritdoc/>
+    public CloudSyncConfig GetConfig() => _configService.Configuration.CloudSync;
+
+    /// <inheritdoc/>
+    public bool SetConfig(CloudSyncConfig config, string? webDavPassword = null)
+    {
+        if (config.Backend is not (FolderSyncBackend.BackendName or WebDavSyncBackend.BackendName)) return false;
+
+        if (webDavPassword == "")
+        {
+            _secretStore.Delete(WebDavPasswordSecret);
+            _sessionPassword = null;
+        }
+        else if (webDavPassword != null)
+        {
+            if (_secretStore.Set(WebDavPasswordSecret, webDavPassword))
+            {
+                _sessionPassword = null;
+            }
+            else
+            {
+                _sessionPassword = webDavPassword;
+                Logger.Warning("CloudSync", "Could not store the WebDAV password; it is only valid until the launcher closes");
+            }
+        }
+
+        return _configService.TryUpdate(c => c.CloudSync = config);
+    }
+
+    /// <inheritdoc/>
+    public bool HasWebDavPassword() => !string.IsNullOrEmpty(GetWebDavPassword());
+
+    private string? GetWebDavPassword() => _sessionPassword ?? _secretStore.Get(WebDavPasswordSecret);
+
+    /// <inheritdoc/>
+    public async Task<string?> TestAsync(CancellationToken ct = default)
+    {
+        try
+        {
+            await CreateBackend(GetConfig()).ListAsync(ct);
+            return null;
+        }
+        catch (Exception ex)
+        {
+            return ex.Message;
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<CloudSyncResult> SyncAsync(CancellationToken ct = default)
+    {
+        var result = new CloudSyncResult();
+        if (!await _gate.WaitAsync(0, ct))
+        {
+            result.Error = "A sync is already running";
+            return result;
+        }
+
+        try
+        {
+            var config = GetConfig();
+            var backend = CreateBackend(config);
+            var remote = (await backend.ListAsync(ct)).ToDictionary(f => f.Name, StringComparer.OrdinalIgnoreCase);
+            var state = LoadState();
+            var conflicts = new List<CloudSyncConflict>();
+
+            foreach (var item in GetItems(config))
+            {
+                ct.ThrowIfCancellationRequested();
+                try
+                {
+                    remote.TryGetValue(item.RemoteName, out var remoteFile);
+                    state.Items.TryGetValue(item.Key, out var seen);
+                    var local = ReadLocal(item);
+
+                    var localChanged = local.Exists &&
+                        (seen == null || (local.Hash != null ? local.Hash != seen.Hash : local.ModifiedUtc > seen.LocalModifiedUtc));
+                    var remoteChanged = remoteFile != null && (seen == null || remoteFile.ModifiedUtc > seen.RemoteModifiedUtc);
+
+                    if (localChanged && remoteChanged)
+                    {
+                        conflicts.Add(new CloudSyncConflict
+                        {
+                            Key = item.Key,
+                            Kind = item.Kind,
+                            Name = item.Name,
+                            LocalModifiedUtc = local.ModifiedUtc,
+                            RemoteModifiedUtc = remoteFile!.ModifiedUtc
+                        });
+                    }
+                    else if (localChanged)
+                    {
+                        await UploadAsync(backend, item, ct);
+                        result.Uploaded.Add(item.Key);
+                    }
+                    else if (remoteChanged)
+                    {
+                        await DownloadAsync(backend, item, ct);
+                        result.Downloaded.Add(item.Key);
+                    }
+                }
+                catch (Exception ex) when (ex is not OperationCanceledException)
+                {
+                    Logger.Warning("CloudSync", $"Failed to sync {item.Key}: {ex.Message}");
+                    result.Failed[item.Key] = ex.Message;
+                }
+            }
+
+            var transferred = result.Uploaded.Concat(result.Downloaded).ToList();
+            if (transferred.Count > 0) await RecordAsync(backend, state, GetItems(config).Where(i => transferred.Contains(i.Key)), ct);
+
+            result.Conflicts = conflicts;
+            result.Success = result.Failed.Count == 0;
+            state.Conflicts = conflicts;
+            Logger.Info("CloudSync", $"Sync finished: {result.Uploaded.Count} uploaded, {result.Downloaded.Count} downloaded, " +
+                $"{conflicts.Count} conflict(s), {result.Failed.Count} failed");
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("CloudSync", $"Sync failed: {ex.Message}");
+            result.Error = ex.Message;
+        }
+        finally
+        {
+            result.FinishedAt = DateTime.UtcNow;
+            var state = LoadState();
+            state.LastResult = result;
+            SaveState(state);
+            _gate.Release();
+        }
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public CloudSyncResult? GetLastResult() => LoadState().LastResult;
+
+    /// <inheritdoc/>
+    public List<CloudSyncConflict> GetConflicts() => LoadState().Conflicts.ToList();
+
+    /// <inheritdoc/>
+    public async Task<string?> ResolveConflictAsync(string key, bool keepLocal, CancellationToken ct = default)
+    {
+        await _gate.WaitAsync(ct);
+        try
+        {
+            var config = GetConfig();
+            var item = GetItems(config).FirstOrDefault(i => i.Key == key);
+            if (item == null) return "Item is no longer synced";
+
+            var backend = CreateBackend(config);
+            if (keepLocal) await UploadAsync(backend, item, ct);
+            else await DownloadAsync(backend, item, ct);
+
+            var state = LoadState();
+            await RecordAsync(backend, state, [item], ct);
+            state.Conflicts.RemoveAll(c => c.Key == key);
+            SaveState(state);
+
+            Logger.Info("CloudSync", $"Resolved {key} by keeping the {(keepLocal ? "local" : "remote")} copy");
+            return null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("CloudSync", $"Failed to resolve {key}: {ex.Message}");
+            return ex.Message;
+        }
+        finally
+        {
+            _gate.Release();
+        }
+    }
+
+    private ICloudSyncBackend CreateBackend(CloudSyncConfig config) => config.Backend switch
+    {
+        WebDavSyncBackend.BackendName => new WebDavSyncBackend(_httpClient, config.WebDavUrl, config.WebDavUsername,
+            GetWebDavPassword()),
+        _ when string.IsNullOrWhiteSpace(config.FolderPath) => throw new InvalidOperationException("No sync folder is set"),
+        _ => new FolderSyncBackend(config.FolderPath)
+    };
+
+    private IEnumerable<SyncItem> GetItems(CloudSyncConfig config)
+    {
+        foreach (var world in config.Worlds)
+        {
+            if (string.IsNullOrWhiteSpace(world.WorldName) || world.WorldName.IndexOfAny(['/', '\\']) >= 0) continue;
+            yield return new SyncItem(WorldKeyPrefix + world.WorldName, "world", world.WorldName,
+                $"world-{world.WorldName}.zip", world.InstanceId);
+        }
+        if (config.SyncSkins) yield return new SyncItem(SkinsKey, "skins", "Skins", "skins.zip");
+        if (config.SyncSettings) yield return new SyncItem(SettingsKey, "settings", "Settings", "settings.json");
+    }
+
+    private (bool Exists, DateTime? ModifiedUtc, string? Hash) ReadLocal(SyncItem item)
+    {
+        if (item.Kind == "settings")
+        {
+            var json = JsonSerializer.Serialize(SyncedSettings.From(_configService.Configuration));
+            return (true, null, Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(json))));
+        }
+
+        var dir = GetLocalDir(item);
+        if (dir == null || !Directory.Exists(dir)) return (false, null, null);
+        var files = new DirectoryInfo(dir).EnumerateFiles("*", SearchOption.AllDirectories).ToList();
+        return files.Count == 0 ? (false, null, null) : (true, files.Max(f => f.LastWriteTimeUtc), null);
+    }
+
+    private string? GetLocalDir(SyncItem item)
+    {
+        if (item.Kind == "skins") return Path.Combine(_appDir, "Profiles");
+        if (item.Kind != "world" || item.InstanceId == null) return null;
+        var savesDir = _worldService.GetSavesDir(item.InstanceId);
+        return savesDir == null ? null : Path.Combine(savesDir, item.Name);
+    }
+
+    private async Task UploadAsync(ICloudSyncBackend backend, SyncItem item, CancellationToken ct)
+    {
+        var tempPath = Path.Combine(Path.GetTempPath(), $"hyprism-sync-{Guid.NewGuid():N}");
+        try
+        {
+            if (item.Kind == "settings")
+            {
+                await File.WriteAllTextAsync(tempPath, JsonSerializer.Serialize(SyncedSettings.From(_configService.Configuration)), ct);
+            }
+            else
+            {
+                var dir = GetLocalDir(item);
+                if (dir == null || !Directory.Exists(dir)) throw new DirectoryNotFoundException($"{item.Name} not found");
+                ZipFile.CreateFromDirectory(dir, tempPath, CompressionLevel.Optimal, includeBaseDirectory: false);
+            }
+
+            await backend.UploadAsync(item.RemoteName, tempPath, ct);
+            Logger.Info("CloudSync", $"Uploaded {item.Key}");
+        }
+        finally
+        {
+            try { File.Delete(tempPath); } catch { /* ignore */ }
+        }
+    }
+
+    private async Task DownloadAsync(ICloudSyncBackend backend, SyncItem item, CancellationToken ct)
+    {
+        var tempPath = Path.Combine(Path.GetTempPath(), $"hyprism-sync-{Guid.NewGuid():N}");
+        try
+        {
+            await backend.DownloadAsync(item.RemoteName, tempPath, ct);
+
+            if (item.Kind == "settings")
+            {
+                var settings = JsonSerializer.Deserialize<SyncedSettings>(await File.ReadAllTextAsync(tempPath, ct))
+                    ?? throw new InvalidDataException("Empty settings file");
+                if (!_configService.TryUpdate(settings.ApplyTo)) throw new IOException("Could not save the settings");
+            }
+            else if (item.Kind == "skins")
+            {
+                var dir = GetLocalDir(item)!;
+                Directory.CreateDirectory(dir);
+                ZipFile.ExtractToDirectory(tempPath, dir, overwriteFiles: true);
+            }
+            else
+            {
+                ReplaceWorld(item, tempPath);
+            }
+            Logger.Info("CloudSync", $"Downloaded {item.Key}");
+        }
+        finally
+        {
+            try { File.Delete(tempPath); } catch { /* ignore */ }
+        }
+    }
+
+    /// <summary>
+    /// Swaps a world folder for the downloaded one, putting the old folder back if the swap fails.
+    /// </summary>
+    private void ReplaceWorld(SyncItem item, string archivePath)
+    {
+        var instancePath = item.InstanceId == null ? null : _instanceService.GetInstancePathById(item.InstanceId);
+        var worldDir = GetLocalDir(item);
+        if (instancePath == null || worldDir == null) throw new DirectoryNotFoundException($"Instance of {item.Name} not found");
+
+        // Never swap a world while the game may have it open
+        InstanceLock.ThrowIfLocked(instancePath);
+
+        var incoming = worldDir + ".sync-new";
+        var previous = worldDir + ".sync-old";
+        if (Directory.Exists(incoming)) Directory.Delete(incoming, true);
+        ZipFile.ExtractToDirectory(archivePath, incoming);
+
+        if (Directory.Exists(previous)) Directory.Delete(previous, true);
+        if (Directory.Exists(worldDir)) Directory.Move(worldDir, previous);
+        try
+        {
+            Directory.Move(incoming, worldDir);
+        }
+        catch
+        {
+            if (Directory.Exists(previous) && !Directory.Exists(worldDir)) Directory.Move(previous, worldDir);
+            throw;
+        }
+        try { if (Directory.Exists(previous)) Directory.Delete(previous, true); } catch { /* ignore */ }
+    }
+
+    /// <summary>
+    /// Stores what both sides look like now for items that were just copied, so they count as unchanged.
+    /// </summary>
+    private async Task RecordAsync(ICloudSyncBackend backend, SyncState state, IEnumerable<SyncItem> items, CancellationToken ct)
+    {
+        var remote = (await backend.ListAsync(ct)).ToDictionary(f => f.Name, StringComparer.OrdinalIgnoreCase);
+        foreach (var item in items)
+        {
+            var local = ReadLocal(item);
+            state.Items[item.Key] = new CloudSyncItemState
+            {
+                LocalModifiedUtc = local.ModifiedUtc,
+                RemoteModifiedUtc = remote.TryGetValue(item.RemoteName, out var file) ? file.ModifiedUtc : null,
+                Hash = local.Hash
+            };
+        }
+    }
+
+    private SyncState LoadState()
+    {
+        lock (_lock)
+        {
+            if (_state != null) return _state;
+            try
+            {
+                _state = File.Exists(_statePath)
+                    ? JsonSerializer.Deserialize<SyncState>(File.ReadAllText(_statePath)) ?? new SyncState()
+                    : new SyncState();
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("CloudSync", $"Could not read the sync state, starting over: {ex.Message}");
+                _state = new SyncState();
+            }
+            return _state;
+        }
+    }
+
+    private void SaveState(SyncState state)
+    {
+        lock (_lock)
+        {
+            _state = state;
+            try
+            {
+                File.WriteAllText(_statePath + ".tmp", JsonSerializer.Serialize(state, StateJsonOptions));
+                File.Move(_statePath + ".tmp", _statePath, true);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("CloudSync", $"Could not save the sync state: {ex.Message}");
+            }
+        }
+    }
+}
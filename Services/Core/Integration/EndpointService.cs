@@ -0,0 +1,166 @@
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Fetches <c>endpoints.json</c> from the HyPrism repository and keeps the last valid copy in
+/// <c>Cache/endpoints.json</c>.
+/// </summary>
+/// <remarks>
+/// Each template is checked on its own: one that is missing, not an HTTPS URL or lacks a required
+/// placeholder keeps its built-in default while the rest of the file applies. The published file is
+/// checked again after <see cref="RefreshInterval"/>; while offline the cached copy is used.
+/// </remarks>
+public class EndpointService : IEndpointService
+{
+    private const string FeedUrl = "https://raw.githubusercontent.com/yyyumeniku/HyPrism/main/endpoints.json";
+    private static readonly TimeSpan RefreshInterval = TimeSpan.FromHours(6);
+
+    private readonly string _cachePath;
+    private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly SemaphoreSlim _fetchLock = new(1, 1);
+
+    private EndpointConfig _current;
+    private DateTime _checkedAt = DateTime.MinValue;
+    private int _refreshRunning;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="EndpointService"/> class.
+    /// </summary>
+    /// <param name="appDir">The application data directory; the cached copy is kept under <c>Cache</c>.</param>
+    /// <param name="httpClient">The HTTP client for fetching the published file.</param>
+    /// <param name="networkPolicy">Timeout and retry policy for the request.</param>
+    public EndpointService(string appDir, HttpClient httpClient, NetworkPolicy networkPolicy)
+    {
+        _cachePath = Path.Combine(appDir, "Cache", "endpoints.json");
+        _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
+        _current = LoadCached() ?? new EndpointConfig();
+    }
+
+    /// <inheritdoc/>
+    public EndpointConfig Current
+    {
+        get
+        {
+            if (DateTime.UtcNow - _checkedAt > RefreshInterval && Interlocked.Exchange(ref _refreshRunning, 1) == 0)
+            {
+                _ = Task.Run(async () =>
+                {
+                    try { await RefreshAsync(); }
+                    finally { Interlocked.Exchange(ref _refreshRunning, 0); }
+                });
+            }
+            return _current;
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<bool> RefreshAsync(CancellationToken ct = default)
+    {
+        await _fetchLock.WaitAsync(ct);
+        try
+        {
+            var json = await _networkPolicy.ExecuteAsync(RequestClass.Metadata, "Endpoints fetch",
+                token => _httpClient.GetStringAsync(FeedUrl, token), ct);
+            _checkedAt = DateTime.UtcNow;
+
+            var published = Parse(json);
+            if (published == null) return false;
+
+            _current = published;
+            SaveCached(json);
+            Logger.Info("Endpoints", "Applied published endpoints");
+            return true;
+        }
+        catch (Exception ex) when (!ct.IsCancellationRequested)
+        {
+            // Retry on the next interval; until then the cached copy or the defaults stay in effect
+            _checkedAt = DateTime.UtcNow;
+            Logger.Warning("Endpoints", $"Failed to fetch endpoints: {ex.Message}");
+            return false;
+        }
+        finally
+        {
+            _fetchLock.Release();
+        }
+    }
+
+    /// <summary>
+    /// Reads a published file and replaces invalid templates with the defaults.
+    /// </summary>
+    /// <returns>The endpoints, or <c>null</c> if the file is unreadable or uses a newer schema.</returns>
+    private static EndpointConfig? Parse(string json)
+    {
+        EndpointConfig? config;
+        try
+        {
+            config = JsonSerializer.Deserialize<EndpointConfig>(json, new JsonSerializerOptions { PropertyNameCaseInsensitive = true });
+        }
+        catch (JsonException ex)
+        {
+            Logger.Warning("Endpoints", $"Published endpoints are not valid JSON: {ex.Message}");
+            return null;
+        }
+
+        if (config == null) return null;
+        if (config.Schema > EndpointConfig.CurrentSchema)
+        {
+            Logger.Warning("Endpoints", $"Published endpoints use schema {config.Schema}; this launcher reads up to {EndpointConfig.CurrentSchema}");
+            return null;
+        }
+
+        var defaults = new EndpointConfig();
+        config.PatchesApi = ValidUrl(config.PatchesApi, defaults.PatchesApi, nameof(config.PatchesApi), "os", "arch", "branch", "fromBuild");
+        config.MirrorIndex = ValidUrl(config.MirrorIndex, defaults.MirrorIndex, nameof(config.MirrorIndex));
+        config.JreManifest = ValidUrl(config.JreManifest, defaults.JreManifest, nameof(config.JreManifest));
+        config.JreDownload = ValidUrl(config.JreDownload, defaults.JreDownload, nameof(config.JreDownload), "os", "arch");
+        if (string.IsNullOrWhiteSpace(config.OfficialPatchHost) || Uri.CheckHostName(config.OfficialPatchHost) != UriHostNameType.Dns)
+        {
+            Logger.Warning("Endpoints", $"Ignoring invalid {nameof(config.OfficialPatchHost)}: {config.OfficialPatchHost}");
+            config.OfficialPatchHost = defaults.OfficialPatchHost;
+        }
+        return config;
+    }
+
+    private static string ValidUrl(string? template, string fallback, string name, params string[] requiredPlaceholders)
+    {
+        var missing = requiredPlaceholders.FirstOrDefault(p => template?.Contains("{" + p + "}") != true);
+        var sample = template == null ? null : Regex.Replace(template, @"\{\w+\}", "x");
+        if (missing == null && Uri.TryCreate(sample, UriKind.Absolute, out var uri) && uri.Scheme == Uri.UriSchemeHttps)
+            return template!;
+
+        Logger.Warning("Endpoints", $"Ignoring invalid {name}: {template}");
+        return fallback;
+    }
+
+    private EndpointConfig? LoadCached()
+    {
+        try
+        {
+            return File.Exists(_cachePath) ? Parse(File.ReadAllText(_cachePath)) : null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Endpoints", $"Failed to read cached endpoints: {ex.Message}");
+            return null;
+        }
+    }
+
+    private void SaveCached(string json)
+    {
+        try
+        {
+            Directory.CreateDirectory(Path.GetDirectoryName(_cachePath)!);
+            File.WriteAllText(_cachePath, json);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Endpoints", $"Failed to save endpoints cache: {ex.Message}");
+        }
+    }
+}
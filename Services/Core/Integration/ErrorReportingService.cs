@@ -0,0 +1,353 @@
+using System.Net.Http;
+using System.Runtime.InteropServices;
+using System.Text;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Sends error reports, with the user's consent, to a self-hosted endpoint or Sentry.
+/// </summary>
+/// <remarks>
+/// <c>Config.ErrorReportingEndpoint</c> is either a URL that receives <see cref="ErrorReport"/> JSON,
+/// or a Sentry DSN (<c>https://key@host/project</c>), in which case reports go to Sentry's store API.
+/// Reports are kept in <c>{appDir}/ErrorReports/pending.json</c> until sent, so a crash saved while the
+/// launcher dies goes out on the next start. Text is cleaned by <see cref="PrivacyFilter"/>, and the
+/// same error is reported once per session (at most <see cref="MaxReportsPerSession"/> in total).
+/// </remarks>
+public class ErrorReportingService : IErrorReportingService, IDisposable
+{
+    private const int MaxReportsPerSession = 10;
+    private const int MaxPendingReports = 20;
+    private const int MaxTechnicalLength = 8000;
+
+    private static readonly JsonSerializerOptions PayloadOptions = new()
+    {
+        PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+        DefaultIgnoreCondition = System.Text.Json.Serialization.JsonIgnoreCondition.WhenWritingNull,
+        WriteIndented = true
+    };
+
+    private readonly string _pendingPath;
+    private readonly IConfigService _configService;
+    private readonly HttpClient _httpClient;
+    private readonly object _lock = new();
+    private readonly SemaphoreSlim _flushLock = new(1, 1);
+    private readonly HashSet<string> _reportedThisSession = new();
+    private List<ErrorReport> _pending;
+    private GameErrorMessage? _lastError;
+    private bool _crashHandlersInstalled;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="ErrorReportingService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory.</param>
+    /// <param name="configService">The configuration service holding the consent flag and endpoint.</param>
+    /// <param name="httpClient">The HTTP client used to send reports.</param>
+    /// <param name="progressService">Source of the errors shown to the user.</param>
+    public ErrorReportingService(
+        string appDir,
+        IConfigService configService,
+        HttpClient httpClient,
+        IProgressNotificationService progressService)
+    {
+        _pendingPath = Path.Combine(appDir, "ErrorReports", "pending.json");
+        _configService = configService;
+        _httpClient = httpClient;
+        _pending = LoadPending();
+
+        progressService.ErrorOccurred += error =>
+        {
+            lock (_lock) _lastError = error;
+            Capture(BuildReport("error", error.Type, error.Code, error.MessageKey, error.Message, error.Technical), send: true);
+        };
+    }
+
+    private bool Enabled => _configService.Configuration.ErrorReportingEnabled;
+
+    /// <inheritdoc/>
+    public ErrorReportingStatus GetStatus()
+    {
+        lock (_lock)
+        {
+            return new ErrorReportingStatus
+            {
+                Enabled = Enabled,
+                Endpoint = _configService.Configuration.ErrorReportingEndpoint,
+                PendingReports = _pending.Count
+            };
+        }
+    }
+
+    /// <inheritdoc/>
+    public ErrorReportingStatus SetEnabled(bool enabled)
+    {
+        var config = _configService.Configuration;
+        if (config.ErrorReportingEnabled != enabled)
+        {
+            config.ErrorReportingEnabled = enabled;
+            _configService.SaveConfig();
+
+            if (!enabled)
+            {
+                lock (_lock)
+                {
+                    _pending.Clear();
+                    SavePending();
+                }
+            }
+
+            Logger.Info("ErrorReporting", enabled ? "Error reporting enabled" : "Error reporting disabled, pending reports dropped");
+        }
+
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public ErrorReportingStatus SetEndpoint(string endpoint)
+    {
+        endpoint = endpoint.Trim();
+        if (endpoint.Length > 0 && !(Uri.TryCreate(endpoint, UriKind.Absolute, out var uri)
+            && (uri.Scheme == Uri.UriSchemeHttps || uri.Scheme == Uri.UriSchemeHttp)))
+            throw new ArgumentException($"Not an http(s) URL: {endpoint}", nameof(endpoint));
+
+        _configService.Configuration.ErrorReportingEndpoint = endpoint;
+        _configService.SaveConfig();
+        Logger.Info("ErrorReporting", endpoint.Length > 0 ? "Error reporting endpoint changed" : "Error reporting endpoint cleared");
+
+        _ = FlushAsync();
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public ErrorReport GetPreview()
+    {
+        GameErrorMessage? error;
+        lock (_lock) error = _lastError;
+
+        return error != null
+            ? BuildReport("error", error.Type, error.Code, error.MessageKey, error.Message, error.Technical)
+            : BuildReport("error", "launch", ErrorCodes.LaunchFailed, MessageCatalog.ErrorLaunchFailed,
+                MessageCatalog.Format(MessageCatalog.ErrorLaunchFailed), "System.Exception: <stack trace of the failure>");
+    }
+
+    /// <inheritdoc/>
+    public void CaptureUnhandledExceptions()
+    {
+        if (_crashHandlersInstalled) return;
+        _crashHandlersInstalled = true;
+
+        // The process may be going down, so crashes are only saved here and sent on the next start
+        AppDomain.CurrentDomain.UnhandledException += (_, e) =>
+        {
+            if (e.ExceptionObject is Exception ex)
+                Capture(BuildReport("crash", "unhandled", ErrorCodes.Describe(ex)?.Code, null, ex.Message, ex.ToString()), send: false);
+        };
+        TaskScheduler.UnobservedTaskException += (_, e) =>
+            ReportException("background-task", e.Exception.Flatten());
+    }
+
+    /// <inheritdoc/>
+    public void ReportException(string operation, Exception ex)
+    {
+        var cause = ErrorCodes.Describe(ex);
+        Capture(BuildReport("error", operation, cause?.Code, cause?.MessageKey, ex.Message, ex.ToString()), send: true);
+    }
+
+    /// <inheritdoc/>
+    public async Task FlushAsync()
+    {
+        var endpoint = _configService.Configuration.ErrorReportingEndpoint;
+        if (!Enabled || string.IsNullOrWhiteSpace(endpoint)) return;
+        if (!await _flushLock.WaitAsync(0)) return;
+
+        try
+        {
+            List<ErrorReport> batch;
+            lock (_lock) batch = _pending.ToList();
+
+            foreach (var report in batch)
+            {
+                if (!await SendAsync(endpoint.Trim(), report)) return;
+
+                lock (_lock)
+                {
+                    _pending.RemoveAll(r => r.Id == report.Id);
+                    SavePending();
+                }
+            }
+
+            if (batch.Count > 0) Logger.Debug("ErrorReporting", $"Sent {batch.Count} report(s)");
+        }
+        finally
+        {
+            _flushLock.Release();
+        }
+    }
+
+    private void Capture(ErrorReport report, bool send)
+    {
+        if (!Enabled) return;
+
+        lock (_lock)
+        {
+            var fingerprint = $"{report.Kind}|{report.Operation}|{report.Code ?? report.MessageKey ?? report.Message}";
+            if (_reportedThisSession.Count >= MaxReportsPerSession || !_reportedThisSession.Add(fingerprint)) return;
+
+            _pending.Add(report);
+            if (_pending.Count > MaxPendingReports)
+            {
+                _pending.RemoveRange(0, _pending.Count - MaxPendingReports);
+            }
+            SavePending();
+        }
+
+        if (send) _ = FlushAsync();
+    }
+
+    private async Task<bool> SendAsync(string endpoint, ErrorReport report)
+    {
+        try
+        {
+            using var request = TryParseSentryDsn(endpoint, out var storeUrl, out var publicKey)
+                ? BuildSentryRequest(storeUrl, publicKey, report)
+                : new HttpRequestMessage(HttpMethod.Post, endpoint)
+                {
+                    Content = new StringContent(JsonSerializer.Serialize(report, PayloadOptions), Encoding.UTF8, "application/json")
+                };
+            using var cts = new CancellationTokenSource(TimeSpan.FromSeconds(15));
+            using var response = await _httpClient.SendAsync(request, cts.Token);
+
+            if (response.IsSuccessStatusCode) return true;
+            Logger.Debug("ErrorReporting", $"Report rejected with HTTP {(int)response.StatusCode}, keeping it");
+            return false;
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("ErrorReporting", $"Sending failed, keeping reports: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <summary>
+    /// Recognizes a Sentry DSN, <c>{scheme}://{publicKey}@{host}/{projectId}</c>.
+    /// </summary>
+    private static bool TryParseSentryDsn(string endpoint, out string storeUrl, out string publicKey)
+    {
+        storeUrl = "";
+        publicKey = "";
+        if (!Uri.TryCreate(endpoint, UriKind.Absolute, out var uri) || string.IsNullOrEmpty(uri.UserInfo)) return false;
+
+        var projectId = uri.AbsolutePath.Trim('/');
+        if (projectId.Length == 0 || !projectId.All(char.IsAsciiDigit)) return false;
+
+        publicKey = uri.UserInfo.Split(':')[0];
+        storeUrl = $"{uri.Scheme}://{uri.Authority}/api/{projectId}/store/";
+        return true;
+    }
+
+    private static HttpRequestMessage BuildSentryRequest(string storeUrl, string publicKey, ErrorReport report)
+    {
+        var sentryEvent = new Dictionary<string, object?>
+        {
+            ["event_id"] = report.Id,
+            ["timestamp"] = report.Timestamp.ToString("o"),
+            ["platform"] = "csharp",
+            ["level"] = report.Kind == "crash" ? "fatal" : "error",
+            ["release"] = $"hyprism@{report.LauncherVersion}",
+            ["message"] = new Dictionary<string, string> { ["formatted"] = report.Message },
+            ["fingerprint"] = new[] { report.Operation, report.Code ?? report.MessageKey ?? report.Message },
+            ["tags"] = new Dictionary<string, string?>
+            {
+                ["kind"] = report.Kind,
+                ["operation"] = report.Operation,
+                ["code"] = report.Code,
+                ["os"] = report.Os,
+                ["arch"] = report.Arch
+            },
+            ["contexts"] = new Dictionary<string, object>
+            {
+                ["os"] = new Dictionary<string, string> { ["name"] = report.Os, ["raw_description"] = report.OsVersion }
+            },
+            ["extra"] = new Dictionary<string, string?>
+            {
+                ["messageKey"] = report.MessageKey,
+                ["technical"] = report.Technical
+            }
+        };
+
+        var request = new HttpRequestMessage(HttpMethod.Post, storeUrl)
+        {
+            Content = new StringContent(JsonSerializer.Serialize(sentryEvent), Encoding.UTF8, "application/json")
+        };
+        request.Headers.TryAddWithoutValidation("X-Sentry-Auth",
+            $"Sentry sentry_version=7, sentry_key={publicKey}, sentry_client=hyprism/{report.LauncherVersion}");
+        return request;
+    }
+
+    private ErrorReport BuildReport(string kind, string operation, string? code, string? messageKey, string message, string? technical)
+    {
+        if (technical?.Length > MaxTechnicalLength)
+            technical = technical[..MaxTechnicalLength] + "\n…";
+
+        return new ErrorReport
+        {
+            Id = Guid.NewGuid().ToString("N"),
+            Timestamp = DateTime.UtcNow,
+            Kind = kind,
+            LauncherVersion = UpdateService.GetCurrentVersion(),
+            Os = UtilityService.GetOS(),
+            Arch = UtilityService.GetArch(),
+            OsVersion = RuntimeInformation.OSDescription,
+            Operation = operation,
+            Code = code,
+            MessageKey = messageKey,
+            Message = PrivacyFilter.Sanitize(message, _configService.Configuration.Nick),
+            Technical = technical != null ? PrivacyFilter.Sanitize(technical, _configService.Configuration.Nick) : null
+        };
+    }
+
+    private List<ErrorReport> LoadPending()
+    {
+        try
+        {
+            if (File.Exists(_pendingPath))
+            {
+                return JsonSerializer.Deserialize<List<ErrorReport>>(File.ReadAllText(_pendingPath), PayloadOptions) ?? new();
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("ErrorReporting", $"Discarding unreadable pending reports: {ex.Message}");
+        }
+        return new();
+    }
+
+    private void SavePending()
+    {
+        try
+        {
+            if (_pending.Count == 0)
+            {
+                if (File.Exists(_pendingPath)) File.Delete(_pendingPath);
+                return;
+            }
+
+            Directory.CreateDirectory(Path.GetDirectoryName(_pendingPath)!);
+            File.WriteAllText(_pendingPath, JsonSerializer.Serialize(_pending, PayloadOptions));
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("ErrorReporting", $"Failed to persist pending reports: {ex.Message}");
+        }
+    }
+
+    /// <inheritdoc/>
+    public void Dispose()
+    {
+        _flushLock.Dispose();
+    }
+}
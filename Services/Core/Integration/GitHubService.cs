@@ -57,11 +57,6 @@ public class GitHubService : IGitHubService
     public GitHubService(HttpClient httpClient)
     {
         _httpClient = httpClient;
-        // Ensure User-Agent is set (required for GitHub API)
-        if (!_httpClient.DefaultRequestHeaders.Contains("User-Agent"))
-        {
-            _httpClient.DefaultRequestHeaders.Add("User-Agent", "HyPrism-Launcher");
-        }
     }
 
     /// <inheritdoc/>
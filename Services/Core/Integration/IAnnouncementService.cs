@@ -0,0 +1,25 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Reads maintainer announcements (broken game versions, emergency fixes) from a JSON feed
+/// in the HyPrism repository and filters them for the running launcher.
+/// </summary>
+public interface IAnnouncementService
+{
+    /// <summary>
+    /// Gets announcements that apply to the current launcher version and time and have not been dismissed.
+    /// The message is already resolved to the current UI language when a translation exists.
+    /// </summary>
+    /// <param name="forceRefresh">Whether to bypass the in-memory cache.</param>
+    /// <returns>Active announcements, most severe first.</returns>
+    Task<List<Announcement>> GetActiveAnnouncementsAsync(bool forceRefresh = false);
+
+    /// <summary>
+    /// Hides an announcement permanently. Non-dismissible announcements are left visible.
+    /// </summary>
+    /// <param name="id">The announcement ID.</param>
+    /// <returns><c>true</c> if the announcement is now dismissed.</returns>
+    Task<bool> DismissAsync(string id);
+}
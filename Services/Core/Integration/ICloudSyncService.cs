@@ -0,0 +1,60 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Syncs selected worlds, profile skins and launcher preferences with a folder or WebDAV server, and keeps
+/// items that changed on both sides aside until the user picks a side.
+/// </summary>
+public interface ICloudSyncService
+{
+    /// <summary>
+    /// Gets the sync settings.
+    /// </summary>
+    CloudSyncConfig GetConfig();
+
+    /// <summary>
+    /// Saves the sync settings.
+    /// </summary>
+    /// <param name="config">The new settings.</param>
+    /// <param name="webDavPassword">New WebDAV password; <c>null</c> keeps the stored one, empty removes it.</param>
+    /// <returns><c>true</c> if the settings were saved.</returns>
+    bool SetConfig(CloudSyncConfig config, string? webDavPassword = null);
+
+    /// <summary>
+    /// Whether a WebDAV password is stored.
+    /// </summary>
+    bool HasWebDavPassword();
+
+    /// <summary>
+    /// Checks that the configured backend can be listed.
+    /// </summary>
+    /// <returns><c>null</c> on success, otherwise the error.</returns>
+    Task<string?> TestAsync(CancellationToken ct = default);
+
+    /// <summary>
+    /// Uploads items that changed locally and downloads items that changed remotely since the last sync.
+    /// Items that changed on both sides are reported as conflicts and left untouched.
+    /// </summary>
+    /// <param name="ct">Cancellation token.</param>
+    Task<CloudSyncResult> SyncAsync(CancellationToken ct = default);
+
+    /// <summary>
+    /// Gets the result of the last sync, or <c>null</c> if none ran.
+    /// </summary>
+    CloudSyncResult? GetLastResult();
+
+    /// <summary>
+    /// Gets the unresolved conflicts.
+    /// </summary>
+    List<CloudSyncConflict> GetConflicts();
+
+    /// <summary>
+    /// Resolves a conflict by uploading the local copy or downloading the remote one.
+    /// </summary>
+    /// <param name="key">Conflict key (<see cref="CloudSyncConflict.Key"/>).</param>
+    /// <param name="keepLocal"><c>true</c> to overwrite the remote copy, <c>false</c> to overwrite the local one.</param>
+    /// <param name="ct">Cancellation token.</param>
+    /// <returns><c>null</c> on success, otherwise the error.</returns>
+    Task<string?> ResolveConflictAsync(string key, bool keepLocal, CancellationToken ct = default);
+}
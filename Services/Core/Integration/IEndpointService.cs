@@ -0,0 +1,23 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Provides the game download endpoint templates, updated from the HyPrism repository so a changed
+/// patch layout can be fixed without a launcher release.
+/// </summary>
+public interface IEndpointService
+{
+    /// <summary>
+    /// Gets the endpoints in effect: the last valid published file, or the built-in defaults.
+    /// Reading it starts a background refresh when the published file was not checked for a while.
+    /// </summary>
+    EndpointConfig Current { get; }
+
+    /// <summary>
+    /// Fetches the published endpoints now and applies them if they are valid.
+    /// </summary>
+    /// <param name="ct">Cancels the request.</param>
+    /// <returns><c>true</c> if a published file was fetched and applied.</returns>
+    Task<bool> RefreshAsync(CancellationToken ct = default);
+}
@@ -0,0 +1,52 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Optional error reporting: sends errors shown to the user and unhandled exceptions to a
+/// configurable endpoint (plain JSON or Sentry). Strictly opt-in, like <see cref="ITelemetryService"/>.
+/// </summary>
+public interface IErrorReportingService
+{
+    /// <summary>
+    /// Gets whether error reporting is enabled, where reports go and how many are pending.
+    /// </summary>
+    ErrorReportingStatus GetStatus();
+
+    /// <summary>
+    /// Enables or disables error reporting. Disabling drops pending reports.
+    /// </summary>
+    /// <param name="enabled">Whether the user has consented.</param>
+    /// <returns>The updated status.</returns>
+    ErrorReportingStatus SetEnabled(bool enabled);
+
+    /// <summary>
+    /// Sets where reports are sent.
+    /// </summary>
+    /// <param name="endpoint">An http(s) URL receiving report JSON, a Sentry DSN, or empty to keep reports local.</param>
+    /// <returns>The updated status.</returns>
+    /// <exception cref="ArgumentException">The endpoint is not an http(s) URL.</exception>
+    ErrorReportingStatus SetEndpoint(string endpoint);
+
+    /// <summary>
+    /// Builds a report from the most recent error (or a sample), exactly as it would be sent.
+    /// </summary>
+    ErrorReport GetPreview();
+
+    /// <summary>
+    /// Hooks unhandled and unobserved exceptions. Crashes are saved and sent on the next start.
+    /// </summary>
+    void CaptureUnhandledExceptions();
+
+    /// <summary>
+    /// Reports an exception caught at the top of an operation. No-op while disabled.
+    /// </summary>
+    /// <param name="operation">What was running, e.g. "startup".</param>
+    /// <param name="ex">The exception.</param>
+    void ReportException(string operation, Exception ex);
+
+    /// <summary>
+    /// Sends pending reports. Reports stay pending if sending fails.
+    /// </summary>
+    Task FlushAsync();
+}
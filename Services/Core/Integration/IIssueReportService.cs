@@ -0,0 +1,26 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Turns a failure into a prefilled GitHub issue, so bug reports arrive with the details maintainers need.
+/// </summary>
+public interface IIssueReportService
+{
+    /// <summary>
+    /// Builds the new-issue URL for an error: title, error details, environment block and a sanitized
+    /// excerpt of the recent launcher log.
+    /// </summary>
+    /// <param name="error">The error shown to the user.</param>
+    /// <param name="occurredAt">When the error occurred; defaults to now.</param>
+    /// <returns>A github.com new-issue URL short enough for browsers.</returns>
+    string BuildIssueUrl(GameErrorMessage error, DateTime? occurredAt = null);
+
+    /// <summary>
+    /// Opens the prefilled issue for an error in the default browser.
+    /// </summary>
+    /// <param name="error">The error shown to the user.</param>
+    /// <param name="occurredAt">When the error occurred; defaults to now.</param>
+    /// <returns>The URL that was opened.</returns>
+    string ReportIssue(GameErrorMessage error, DateTime? occurredAt = null);
+}
@@ -3,7 +3,7 @@ using HyPrism.Models;
 namespace HyPrism.Services.Core.Integration;
 
 /// <summary>
-/// Provides news aggregation from multiple sources including Hytale official news and HyPrism announcements.
+/// Provides news aggregation from multiple sources including Hytale official news, HyPrism announcements and RSS/Atom feeds.
 /// </summary>
 public interface INewsService
 {
@@ -14,4 +14,30 @@ public interface INewsService
     /// <param name="source">The news source filter. Use <see cref="NewsSource.All"/> for aggregated results.</param>
     /// <returns>A list of <see cref="NewsItemResponse"/> objects sorted by date descending.</returns>
     Task<List<NewsItemResponse>> GetNewsAsync(int count = 10, NewsSource source = NewsSource.All);
+
+    /// <summary>
+    /// Retrieves news items along with whether they were served from the on-disk cache.
+    /// Sources are only refetched once their cache is older than the TTL; if a source is
+    /// unreachable, its last cached items are returned and the feed is flagged as stale.
+    /// </summary>
+    /// <param name="count">The maximum number of news items to retrieve. Defaults to 10.</param>
+    /// <param name="source">The news source filter. Use <see cref="NewsSource.All"/> for aggregated results.</param>
+    /// <param name="forceRefresh">Whether to bypass the TTL and refetch every source.</param>
+    /// <returns>The merged feed sorted by date descending.</returns>
+    Task<NewsFeed> GetNewsFeedAsync(int count = 10, NewsSource source = NewsSource.All, bool forceRefresh = false);
+
+    /// <summary>
+    /// Lists the built-in sources and configured feeds with their enabled state.
+    /// </summary>
+    /// <returns>All known news sources.</returns>
+    List<NewsSourceInfo> GetSources();
+
+    /// <summary>
+    /// Replaces the configured feed list and/or the set of disabled sources.
+    /// Feeds with invalid or duplicate URLs are dropped.
+    /// </summary>
+    /// <param name="feeds">The new feed list, or <c>null</c> to keep the current one.</param>
+    /// <param name="disabledSources">IDs of sources to turn off, or <c>null</c> to keep the current set.</param>
+    /// <returns>The updated source list.</returns>
+    List<NewsSourceInfo> ConfigureSources(List<NewsFeedConfig>? feeds, List<string>? disabledSources);
 }
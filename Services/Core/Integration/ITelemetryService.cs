@@ -0,0 +1,41 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Optional anonymous usage telemetry (launch counts, OS/arch, launcher version, error categories).
+/// Strictly opt-in: nothing is recorded, queued or sent until the user enables it.
+/// </summary>
+public interface ITelemetryService
+{
+    /// <summary>
+    /// Gets whether telemetry is enabled, where it is sent and how many events are queued.
+    /// </summary>
+    /// <returns>The current telemetry status.</returns>
+    TelemetryStatus GetStatus();
+
+    /// <summary>
+    /// Enables or disables telemetry. Disabling drops the local queue and the install ID.
+    /// </summary>
+    /// <param name="enabled">Whether the user has opted in.</param>
+    /// <returns>The updated status.</returns>
+    TelemetryStatus SetEnabled(bool enabled);
+
+    /// <summary>
+    /// Builds the batch that would be sent next, so the user can inspect it before opting in.
+    /// When the queue is empty, a sample event is included to show the shape.
+    /// </summary>
+    /// <returns>The payload exactly as it would be serialized.</returns>
+    TelemetryBatch GetPreview();
+
+    /// <summary>
+    /// Records a launcher start. No-op while telemetry is disabled.
+    /// </summary>
+    void RecordLauncherStart();
+
+    /// <summary>
+    /// Sends queued events to the configured endpoint. Events stay queued if sending fails.
+    /// </summary>
+    /// <returns>A task that completes when the flush attempt has finished.</returns>
+    Task FlushAsync();
+}
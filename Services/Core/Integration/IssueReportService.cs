@@ -0,0 +1,128 @@
+using System.Runtime.InteropServices;
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Builds prefilled GitHub new-issue URLs from errors and opens them.
+/// </summary>
+/// <remarks>
+/// Everything goes into the URL, so nothing is sent until the user submits the issue on GitHub and
+/// can edit it first. The log excerpt and technical details are cleaned by <see cref="PrivacyFilter"/>
+/// and shortened until the URL fits <see cref="MaxUrlLength"/>, which browsers and GitHub accept.
+/// </remarks>
+public class IssueReportService : IIssueReportService
+{
+    private const string NewIssueUrl = "https://github.com/yyyumeniku/HyPrism/issues/new";
+    private const int MaxUrlLength = 7500;
+    private const int MaxTitleLength = 120;
+    private const int LogLines = 40;
+
+    private readonly IConfigService _configService;
+    private readonly IBrowserService _browserService;
+    private readonly IGraphicsDiagnosticsService _graphicsDiagnostics;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="IssueReportService"/> class.
+    /// </summary>
+    /// <param name="configService">Provides the update channel and the nickname to remove.</param>
+    /// <param name="browserService">Opens the issue page.</param>
+    /// <param name="graphicsDiagnostics">Provides the last graphics check for the environment section.</param>
+    public IssueReportService(IConfigService configService, IBrowserService browserService,
+        IGraphicsDiagnosticsService graphicsDiagnostics)
+    {
+        _configService = configService;
+        _browserService = browserService;
+        _graphicsDiagnostics = graphicsDiagnostics;
+    }
+
+    /// <inheritdoc/>
+    public string BuildIssueUrl(GameErrorMessage error, DateTime? occurredAt = null)
+    {
+        var nick = _configService.Configuration.Nick;
+        var title = $"[Bug] {error.Code ?? error.Type}: {PrivacyFilter.Sanitize(error.Message, nick)}";
+        if (title.Length > MaxTitleLength) title = title[..(MaxTitleLength - 1)] + "…";
+
+        var technical = error.Technical != null ? PrivacyFilter.Sanitize(error.Technical, nick) : null;
+        var log = Logger.GetRecentLogs(LogLines).Select(line => PrivacyFilter.Sanitize(line, nick)).ToList();
+
+        // Drop the oldest log lines first, then shorten the technical details, until the URL fits
+        while (true)
+        {
+            var url = $"{NewIssueUrl}?labels=bug&title={Uri.EscapeDataString(title)}" +
+                      $"&body={Uri.EscapeDataString(BuildBody(error, occurredAt ?? DateTime.UtcNow, technical, log))}";
+            if (url.Length <= MaxUrlLength) return url;
+
+            if (log.Count > 0)
+                log.RemoveAt(0);
+            else if (technical?.Length > 200)
+                technical = technical[..(technical.Length * 2 / 3)] + "\n…";
+            else
+                return url;
+        }
+    }
+
+    /// <inheritdoc/>
+    public string ReportIssue(GameErrorMessage error, DateTime? occurredAt = null)
+    {
+        var url = BuildIssueUrl(error, occurredAt);
+        Logger.Info("Issue", $"Opening issue draft for {error.Code ?? error.Type}");
+        _browserService.OpenURL(url);
+        return url;
+    }
+
+    private string BuildBody(GameErrorMessage error, DateTime occurredAt, string? technical, List<string> log)
+    {
+        var channel = string.IsNullOrWhiteSpace(_configService.Configuration.LauncherBranch)
+            ? "release"
+            : _configService.Configuration.LauncherBranch;
+
+        var body = new StringBuilder();
+        body.AppendLine("## Description");
+        body.AppendLine("<!-- What were you doing when the error occurred? -->");
+        body.AppendLine();
+        body.AppendLine("## Error");
+        body.AppendLine($"- **Type:** {error.Type}");
+        body.AppendLine($"- **Code:** {error.Code ?? "N/A"}");
+        body.AppendLine($"- **Message:** {PrivacyFilter.Sanitize(error.Message, _configService.Configuration.Nick)}");
+        body.AppendLine($"- **Occurred:** {occurredAt.ToUniversalTime():yyyy-MM-dd HH:mm:ss} UTC");
+        if (!string.IsNullOrEmpty(technical))
+        {
+            body.AppendLine();
+            body.AppendLine("<details><summary>Technical details</summary>");
+            body.AppendLine();
+            body.AppendLine("```");
+            body.AppendLine(technical);
+            body.AppendLine("```");
+            body.AppendLine("</details>");
+        }
+        body.AppendLine();
+        body.AppendLine("## Environment");
+        body.AppendLine($"- **Launcher:** {UpdateService.GetCurrentVersion()} ({channel} channel)");
+        body.AppendLine($"- **OS:** {UtilityService.GetOS()} {UtilityService.GetArch()} ({RuntimeInformation.OSDescription})");
+        body.AppendLine($"- **Runtime:** {RuntimeInformation.FrameworkDescription}");
+        var graphics = _graphicsDiagnostics.GetLast();
+        if (graphics != null)
+        {
+            body.AppendLine($"- **Graphics:** {graphics.Summary.Replace("Graphics: ", "")}");
+            foreach (var warning in graphics.Warnings.Where(w => w.Severity != "info"))
+                body.AppendLine($"  - {warning.Severity}: {warning.Message}");
+        }
+        if (log.Count > 0)
+        {
+            body.AppendLine();
+            body.AppendLine("## Log excerpt");
+            body.AppendLine("```");
+            foreach (var line in log) body.AppendLine(line);
+            body.AppendLine("```");
+        }
+        body.AppendLine();
+        body.AppendLine("## Steps to Reproduce");
+        body.AppendLine("1. ");
+        return body.ToString();
+    }
+}
@@ -0,0 +1,135 @@
+using System.Globalization;
+using System.Net.Http;
+using System.Security.Cryptography;
+using System.Text;
+using System.Xml;
+using System.Xml.Linq;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Integration.News;
+
+/// <summary>
+/// Items from a user-configured RSS 2.0 or Atom feed.
+/// </summary>
+public class FeedNewsSource : INewsSource
+{
+    private static readonly XNamespace Atom = "http://www.w3.org/2005/Atom";
+    private static readonly XNamespace Media = "http://search.yahoo.com/mrss/";
+
+    private readonly HttpClient _httpClient;
+    private readonly NewsFeedConfig _feed;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="FeedNewsSource"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for fetching the feed.</param>
+    /// <param name="feed">The configured feed.</param>
+    public FeedNewsSource(HttpClient httpClient, NewsFeedConfig feed)
+    {
+        _httpClient = httpClient;
+        _feed = feed;
+        SourceId = GetSourceId(feed.Url);
+    }
+
+    /// <inheritdoc/>
+    public string SourceId { get; }
+
+    /// <inheritdoc/>
+    public string DisplayName => string.IsNullOrWhiteSpace(_feed.Name) ? new Uri(_feed.Url).Host : _feed.Name;
+
+    /// <inheritdoc/>
+    public NewsSourceType Type => NewsSourceType.Feed;
+
+    /// <inheritdoc/>
+    public int Priority => 10;
+
+    /// <summary>
+    /// Builds the stable source ID for a feed URL, so cache entries survive renames.
+    /// </summary>
+    /// <param name="url">The feed URL.</param>
+    /// <returns>An ID of the form <c>feed:xxxxxxxxxxxx</c>.</returns>
+    public static string GetSourceId(string url)
+    {
+        var hash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(url.Trim().ToLowerInvariant())));
+        return $"feed:{hash[..12].ToLowerInvariant()}";
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<NewsItemResponse>> FetchNewsAsync(int maxItems = 20, CancellationToken ct = default)
+    {
+        var xml = await _httpClient.GetStringAsync(_feed.Url, ct);
+
+        // DTDs are never needed for feeds and are an entity-expansion risk
+        using var reader = XmlReader.Create(new StringReader(xml), new XmlReaderSettings { DtdProcessing = DtdProcessing.Ignore });
+        var doc = XDocument.Load(reader);
+        var root = doc.Root ?? throw new InvalidDataException("Feed has no root element");
+
+        var items = root.Name == Atom + "feed"
+            ? ParseAtom(root)
+            : ParseRss(root);
+
+        return items.Take(maxItems).ToList();
+    }
+
+    private IEnumerable<NewsItemResponse> ParseRss(XElement root)
+    {
+        var channel = root.Element("channel") ?? root;
+        foreach (var item in channel.Elements("item"))
+        {
+            var title = item.Element("title")?.Value ?? "";
+            var imageUrl = item.Element("enclosure") is { } enclosure &&
+                           (enclosure.Attribute("type")?.Value ?? "").StartsWith("image/", StringComparison.OrdinalIgnoreCase)
+                ? enclosure.Attribute("url")?.Value
+                : item.Element(Media + "thumbnail")?.Attribute("url")?.Value
+                  ?? item.Element(Media + "content")?.Attribute("url")?.Value;
+
+            yield return new NewsItemResponse
+            {
+                Title = title.Trim(),
+                Excerpt = Truncate(NewsService.CleanNewsExcerpt(item.Element("description")?.Value, title)),
+                Url = item.Element("link")?.Value.Trim() ?? "",
+                Date = NormalizeDate(item.Element("pubDate")?.Value),
+                Author = item.Element("author")?.Value ?? DisplayName,
+                ImageUrl = imageUrl,
+                Source = SourceId,
+                SourceName = DisplayName
+            };
+        }
+    }
+
+    private IEnumerable<NewsItemResponse> ParseAtom(XElement root)
+    {
+        foreach (var entry in root.Elements(Atom + "entry"))
+        {
+            var title = entry.Element(Atom + "title")?.Value ?? "";
+            var link = entry.Elements(Atom + "link")
+                .FirstOrDefault(l => (l.Attribute("rel")?.Value ?? "alternate") == "alternate")
+                ?.Attribute("href")?.Value;
+
+            yield return new NewsItemResponse
+            {
+                Title = title.Trim(),
+                Excerpt = Truncate(NewsService.CleanNewsExcerpt(
+                    entry.Element(Atom + "summary")?.Value ?? entry.Element(Atom + "content")?.Value, title)),
+                Url = link ?? "",
+                Date = NormalizeDate(entry.Element(Atom + "published")?.Value ?? entry.Element(Atom + "updated")?.Value),
+                Author = entry.Element(Atom + "author")?.Element(Atom + "name")?.Value ?? DisplayName,
+                ImageUrl = entry.Element(Media + "thumbnail")?.Attribute("url")?.Value,
+                Source = SourceId,
+                SourceName = DisplayName
+            };
+        }
+    }
+
+    private static string NormalizeDate(string? value)
+    {
+        if (string.IsNullOrWhiteSpace(value)) return "";
+        // RFC 822 dates ("Sat, 31 Jan 2026 12:00:00 GMT") parse with the invariant culture
+        return DateTimeOffset.TryParse(value, CultureInfo.InvariantCulture, DateTimeStyles.AssumeUniversal, out var date)
+            ? date.ToString("o")
+            : value.Trim();
+    }
+
+    private static string Truncate(string text) =>
+        text.Length > 200 ? text[..197] + "..." : text;
+}
@@ -0,0 +1,101 @@
+using System.Net.Http;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration.News;
+
+/// <summary>
+/// Launcher announcements, published as HyPrism GitHub releases.
+/// </summary>
+public class HyPrismNewsSource : INewsSource
+{
+    private const string HyPrismReleasesUrl = "https://api.github.com/repos/yyyumeniku/HyPrism/releases";
+
+    private readonly HttpClient _httpClient;
+    private readonly string _appIconPath = "";
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="HyPrismNewsSource"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for fetching releases.</param>
+    public HyPrismNewsSource(HttpClient httpClient)
+    {
+        _httpClient = httpClient;
+    }
+
+    /// <inheritdoc/>
+    public string SourceId => "hyprism";
+
+    /// <inheritdoc/>
+    public string DisplayName => "HyPrism";
+
+    /// <inheritdoc/>
+    public NewsSourceType Type => NewsSourceType.HyPrism;
+
+    /// <inheritdoc/>
+    public int Priority => 1;
+
+    /// <inheritdoc/>
+    public async Task<List<NewsItemResponse>> FetchNewsAsync(int maxItems = 20, CancellationToken ct = default)
+    {
+        Logger.Info("News", "Fetching news from HyPrism GitHub...");
+        var response = await _httpClient.GetStringAsync(HyPrismReleasesUrl, ct);
+        
+        using var jsonDoc = JsonDocument.Parse(response);
+        var releases = jsonDoc.RootElement;
+        var news = new List<NewsItemResponse>();
+        
+        var itemCount = 0;
+        foreach (var release in releases.EnumerateArray())
+        {
+            if (itemCount >= maxItems) break;
+            
+            try
+            {
+                var name = release.TryGetProperty("name", out var nameProp) ? nameProp.GetString() : null;
+                var tagName = release.TryGetProperty("tag_name", out var tagProp) ? tagProp.GetString() : null;
+                var body = release.TryGetProperty("body", out var bodyProp) ? bodyProp.GetString() : null;
+                var htmlUrl = release.TryGetProperty("html_url", out var urlProp) ? urlProp.GetString() : null;
+                var publishedAt = release.TryGetProperty("published_at", out var pubProp) ? pubProp.GetString() : null;
+                
+                var title = !string.IsNullOrEmpty(name) ? name : tagName ?? "HyPrism Release";
+                title = title.Replace("(", "").Replace(")", "").Trim();
+                
+                var excerpt = !string.IsNullOrEmpty(body) 
+                    ? body.Split('\n').FirstOrDefault()?.Trim() ?? "Click to see changelog."
+                    : "Click to see changelog.";
+                
+                // Remove markdown formatting from excerpt
+                excerpt = Regex.Replace(excerpt, @"[#*_`\[\]]", "");
+                if (excerpt.Length > 100)
+                {
+                    excerpt = excerpt.Substring(0, 97) + "...";
+                }
+                
+                news.Add(new NewsItemResponse
+                {
+                    Title = $"HyPrism {title} release",
+                    Excerpt = excerpt,
+                    Url = htmlUrl ?? "https://github.com/yyyumeniku/HyPrism/releases",
+                    Date = publishedAt ?? DateTime.Now.ToString("o"),
+                    Author = "HyPrism",
+                    ImageUrl = _appIconPath,
+                    Source = SourceId
+                });
+                
+                itemCount++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("News", $"Failed to parse HyPrism release: {ex.Message}");
+                continue;
+            }
+        }
+        
+        Logger.Success("News", "Successfully fetched HyPrism news");
+        
+        return news;
+    }
+}
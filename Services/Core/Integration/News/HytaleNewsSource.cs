@@ -0,0 +1,161 @@
+using System.Net.Http;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration.News;
+
+/// <summary>
+/// Posts from the official Hytale blog API.
+/// </summary>
+public class HytaleNewsSource : INewsSource
+{
+    private const string HytaleNewsUrl = "https://hytale.com/api/blog/post/published";
+
+    private readonly HttpClient _httpClient;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="HytaleNewsSource"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for fetching news.</param>
+    public HytaleNewsSource(HttpClient httpClient)
+    {
+        _httpClient = httpClient;
+    }
+
+    /// <inheritdoc/>
+    public string SourceId => "hytale";
+
+    /// <inheritdoc/>
+    public string DisplayName => "Hytale";
+
+    /// <inheritdoc/>
+    public NewsSourceType Type => NewsSourceType.Hytale;
+
+    /// <inheritdoc/>
+    public int Priority => 0;
+
+    /// <inheritdoc/>
+    public async Task<List<NewsItemResponse>> FetchNewsAsync(int maxItems = 20, CancellationToken ct = default)
+    {
+        Logger.Info("News", "Fetching news from Hytale API...");
+        var response = await _httpClient.GetStringAsync(HytaleNewsUrl, ct);
+        
+        using var jsonDoc = JsonDocument.Parse(response);
+        
+        JsonElement posts;
+        if (jsonDoc.RootElement.ValueKind == JsonValueKind.Array)
+        {
+            posts = jsonDoc.RootElement;
+        }
+        else if (jsonDoc.RootElement.TryGetProperty("data", out var dataProp))
+        {
+            posts = dataProp;
+        }
+        else
+        {
+            Logger.Warning("News", "Unexpected JSON structure from Hytale API");
+            return new List<NewsItemResponse>();
+        }
+        
+        var news = new List<NewsItemResponse>();
+        
+        var itemCount = 0;
+        foreach (var post in posts.EnumerateArray())
+        {
+            if (itemCount >= maxItems) break;
+            
+            try
+            {
+                var title = post.TryGetProperty("title", out var titleProp) ? titleProp.GetString() : null;
+                var excerpt = post.TryGetProperty("bodyExcerpt", out var excerptProp) ? excerptProp.GetString() : null;
+                
+                if (string.IsNullOrEmpty(excerpt))
+                {
+                    excerpt = post.TryGetProperty("excerpt", out var excerptProp2) ? excerptProp2.GetString() : null;
+                }
+                var slug = post.TryGetProperty("slug", out var slugProp) ? slugProp.GetString() : null;
+                var publishedAt = post.TryGetProperty("publishedAt", out var pubProp) ? pubProp.GetString() : null;
+                
+                string? imageUrl = null;
+                if (post.TryGetProperty("coverImage", out var img))
+                {
+                    try
+                    {
+                        if (img.ValueKind == JsonValueKind.Object)
+                        {
+                            // New API uses s3Key to build CDN URL
+                            if (img.TryGetProperty("s3Key", out var s3KeyProp))
+                            {
+                                var s3Key = s3KeyProp.GetString();
+                                if (!string.IsNullOrEmpty(s3Key))
+                                {
+                                    imageUrl = $"https://cdn.hytale.com/{s3Key}";
+                                }
+                            }
+                            // Fallback: try direct url property (very old API structure)
+                            else if (img.TryGetProperty("url", out var urlProp))
+                            {
+                                imageUrl = urlProp.GetString();
+                            }
+                        }
+                        else if (img.ValueKind == JsonValueKind.String)
+                        {
+                            // If coverImage is just a string, treat it as s3Key
+                            var s3Key = img.GetString();
+                            if (!string.IsNullOrEmpty(s3Key))
+                            {
+                                imageUrl = $"https://cdn.hytale.com/{s3Key}";
+                            }
+                        }
+                    }
+                    catch (Exception imgEx)
+                    {
+                        Logger.Warning("News", $"Failed to parse coverImage: {imgEx.Message}");
+                    }
+                }
+                
+                // Build the correct URL format: hytale.com/news/YYYY/M/slug
+                string newsUrl = "";
+                if (!string.IsNullOrEmpty(slug) && !string.IsNullOrEmpty(publishedAt))
+                {
+                    // Parse publishedAt to extract year and month
+                    if (DateTime.TryParse(publishedAt, out var pubDate))
+                    {
+                        newsUrl = $"https://hytale.com/news/{pubDate.Year}/{pubDate.Month}/{slug}";
+                    }
+                    else
+                    {
+                        // Fallback if date parsing fails
+                        newsUrl = $"https://hytale.com/news/{slug}";
+                    }
+                }
+                
+                news.Add(new NewsItemResponse
+                {
+                    Title = title ?? "",
+                    Excerpt = NewsService.CleanNewsExcerpt(excerpt, title),
+                    Url = newsUrl,
+                    Date = publishedAt ?? "",
+                    Author = "Hytale Team",
+                    ImageUrl = imageUrl,
+                    Source = SourceId
+                });
+                
+                itemCount++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("News", $"Failed to parse news item: {ex.Message}");
+                continue;
+            }
+        }
+        
+        if (news.Count > 0)
+        {
+            Logger.Success("News", "Successfully fetched Hytale news");
+        }
+
+        return news;
+    }
+}
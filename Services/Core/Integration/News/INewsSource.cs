@@ -11,41 +11,46 @@ public enum NewsSourceType
     Hytale,
 
     /// <summary>HyPrism GitHub releases.</summary>
-    HyPrism
+    HyPrism,
+
+    /// <summary>User-configured RSS or Atom feed.</summary>
+    Feed
 }
 
 /// <summary>
 /// Unified interface for news data sources.
-/// Both Hytale blog and HyPrism releases implement this interface,
-/// allowing the NewsService to treat them uniformly.
+/// The Hytale blog, HyPrism releases and RSS/Atom feeds all implement this interface,
+/// allowing the NewsService to fetch, cache and merge them uniformly.
 /// </summary>
 public interface INewsSource
 {
     /// <summary>
-    /// Unique identifier for this source.
+    /// Unique identifier for this source. Used as the cache key and as <see cref="NewsItemResponse.Source"/>.
     /// </summary>
     string SourceId { get; }
 
+    /// <summary>
+    /// Human-readable name shown next to items from this source.
+    /// </summary>
+    string DisplayName { get; }
+
     /// <summary>
     /// Type of news source.
     /// </summary>
     NewsSourceType Type { get; }
 
     /// <summary>
-    /// Priority for merging news (lower = higher priority).
+    /// Priority for merging news (lower = higher priority). When two sources publish
+    /// the same URL, the item from the higher-priority source is kept.
     /// </summary>
     int Priority { get; }
 
     /// <summary>
-    /// Fetches news items from this source.
+    /// Fetches news items from this source. Network and parse failures propagate to the caller,
+    /// which decides whether to fall back to cached items.
     /// </summary>
     /// <param name="maxItems">Maximum number of items to return.</param>
-    /// <param name="forceRefresh">Whether to bypass cache.</param>
+    /// <param name="ct">Cancellation token.</param>
     /// <returns>List of news items.</returns>
-    Task<List<NewsItemResponse>> FetchNewsAsync(int maxItems = 20, bool forceRefresh = false);
-
-    /// <summary>
-    /// Clears the cached news data.
-    /// </summary>
-    void ClearCache();
+    Task<List<NewsItemResponse>> FetchNewsAsync(int maxItems = 20, CancellationToken ct = default);
 }
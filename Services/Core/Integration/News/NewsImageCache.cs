@@ -0,0 +1,169 @@
+using System.Net.Http;
+using System.Security.Cryptography;
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration.News;
+
+/// <summary>
+/// Keeps news thumbnails on disk under <c>Cache/News/Images</c> so the news panel can render
+/// them from <c>file://</c> URLs instantly and while offline.
+/// </summary>
+/// <remarks>
+/// Missing images are downloaded in the background; the request that triggered the download
+/// still gets the remote URL, and later requests pick up the local copy.
+/// </remarks>
+public class NewsImageCache
+{
+    private const long MaxImageBytes = 5 * 1024 * 1024;
+    private const int MaxParallelDownloads = 4;
+    private static readonly TimeSpan UnusedImageLifetime = TimeSpan.FromDays(30);
+
+    private static readonly HashSet<string> KnownExtensions = new(StringComparer.OrdinalIgnoreCase)
+    {
+        ".png", ".jpg", ".jpeg", ".webp", ".gif", ".avif", ".svg"
+    };
+
+    private readonly HttpClient _httpClient;
+    private readonly string _imageDir;
+    private readonly HashSet<string> _inFlight = new();
+    private readonly SemaphoreSlim _downloadSlots = new(MaxParallelDownloads, MaxParallelDownloads);
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="NewsImageCache"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for downloading images.</param>
+    /// <param name="imageDir">Directory the images are stored in.</param>
+    public NewsImageCache(HttpClient httpClient, string imageDir)
+    {
+        _httpClient = httpClient;
+        _imageDir = imageDir;
+    }
+
+    /// <summary>
+    /// Sets <see cref="NewsItemResponse.LocalImageUrl"/> for items whose image is already cached
+    /// and starts background downloads for the rest.
+    /// </summary>
+    /// <param name="items">The items about to be returned to the frontend.</param>
+    /// <param name="downloadMissing">Whether to download missing images; off on a metered connection.</param>
+    public void Apply(IEnumerable<NewsItemResponse> items, bool downloadMissing = true)
+    {
+        var missing = new List<(string url, string path)>();
+
+        foreach (var item in items)
+        {
+            item.LocalImageUrl = null;
+            if (!IsRemote(item.ImageUrl)) continue;
+
+            var path = GetLocalPath(item.ImageUrl!);
+            if (File.Exists(path))
+            {
+                item.LocalImageUrl = ToFileUrl(path);
+                // Touch so pruning keeps images that are still shown
+                try { File.SetLastWriteTimeUtc(path, DateTime.UtcNow); } catch { /* ignore */ }
+            }
+            else if (downloadMissing)
+            {
+                missing.Add((item.ImageUrl!, path));
+            }
+        }
+
+        foreach (var (url, path) in missing)
+        {
+            lock (_inFlight)
+            {
+                if (!_inFlight.Add(path)) continue;
+            }
+            _ = DownloadAsync(url, path);
+        }
+
+        _ = Task.Run(Prune);
+    }
+
+    private async Task DownloadAsync(string url, string path)
+    {
+        await _downloadSlots.WaitAsync();
+        try
+        {
+            using var cts = new CancellationTokenSource(TimeSpan.FromSeconds(20));
+            using var response = await _httpClient.GetAsync(url, HttpCompletionOption.ResponseHeadersRead, cts.Token);
+            response.EnsureSuccessStatusCode();
+
+            var mediaType = response.Content.Headers.ContentType?.MediaType ?? "";
+            if (!mediaType.StartsWith("image/", StringComparison.OrdinalIgnoreCase))
+            {
+                Logger.Debug("News", $"Skipping non-image thumbnail ({mediaType}): {url}");
+                return;
+            }
+            if (response.Content.Headers.ContentLength > MaxImageBytes)
+            {
+                Logger.Debug("News", $"Skipping oversized thumbnail: {url}");
+                return;
+            }
+
+            Directory.CreateDirectory(_imageDir);
+            var tempPath = path + ".part";
+            await using (var source = await response.Content.ReadAsStreamAsync(cts.Token))
+            await using (var target = File.Create(tempPath))
+            {
+                var buffer = new byte[81920];
+                long total = 0;
+                int read;
+                while ((read = await source.ReadAsync(buffer, cts.Token)) > 0)
+                {
+                    total += read;
+                    if (total > MaxImageBytes) throw new InvalidDataException("Thumbnail exceeds size limit");
+                    await target.WriteAsync(buffer.AsMemory(0, read), cts.Token);
+                }
+            }
+            File.Move(tempPath, path, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("News", $"Failed to cache thumbnail {url}: {ex.Message}");
+            try { File.Delete(path + ".part"); } catch { /* ignore */ }
+        }
+        finally
+        {
+            _downloadSlots.Release();
+            lock (_inFlight) _inFlight.Remove(path);
+        }
+    }
+
+    private void Prune()
+    {
+        try
+        {
+            if (!Directory.Exists(_imageDir)) return;
+
+            var cutoff = DateTime.UtcNow - UnusedImageLifetime;
+            foreach (var file in Directory.EnumerateFiles(_imageDir))
+            {
+                if (File.GetLastWriteTimeUtc(file) < cutoff)
+                {
+                    File.Delete(file);
+                }
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("News", $"Thumbnail cache cleanup failed: {ex.Message}");
+        }
+    }
+
+    private string GetLocalPath(string url)
+    {
+        var hash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(url)))[..24].ToLowerInvariant();
+        var ext = Uri.TryCreate(url, UriKind.Absolute, out var uri) ? Path.GetExtension(uri.AbsolutePath) : "";
+        if (!KnownExtensions.Contains(ext)) ext = ".img";
+        return Path.Combine(_imageDir, hash + ext);
+    }
+
+    private static bool IsRemote(string? url) =>
+        !string.IsNullOrWhiteSpace(url) &&
+        (url.StartsWith("https://", StringComparison.OrdinalIgnoreCase) ||
+         url.StartsWith("http://", StringComparison.OrdinalIgnoreCase));
+
+    private static string ToFileUrl(string path) => $"file://{path.Replace("\\", "/")}";
+}
@@ -1,4 +1,5 @@
 using System;
+using System.Collections.Concurrent;
 using System.Collections.Generic;
 using System.Linq;
 using System.Net.Http;
@@ -8,6 +9,7 @@ using System.Threading.Tasks;
 using System.Web;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration.News;
 
 namespace HyPrism.Services.Core.Integration;
 
@@ -21,364 +23,309 @@ public enum NewsSource
     /// <summary>Fetch news from Hytale official blog only.</summary>
     Hytale,
     /// <summary>Fetch news from HyPrism GitHub releases only.</summary>
-    HyPrism
+    HyPrism,
+    /// <summary>Fetch news from user-configured RSS/Atom feeds only.</summary>
+    Feeds
 }
 
 /// <summary>
-/// Fetches and aggregates news from Hytale's official blog API and HyPrism GitHub Releases.
-/// Implements caching to reduce API calls and handle rate limits.
+/// Fetches and aggregates news from the Hytale blog, HyPrism GitHub releases and any
+/// configured RSS/Atom feeds, deduplicating items that several sources publish.
+/// Fetched items are cached on disk per source and only refreshed after <see cref="CacheTtl"/>;
+/// when a source cannot be reached, its last cached items are served instead.
+/// Thumbnails are cached alongside (see <see cref="NewsImageCache"/>).
 /// </summary>
 public class NewsService : INewsService
 {
     private readonly HttpClient _httpClient;
-    private readonly string _appIconPath = "";
+    private readonly IConfigService _configService;
+    private readonly string _cachePath;
+    private readonly List<INewsSource> _builtInSources;
+    private readonly NewsImageCache _imageCache;
 
     /// <summary>
     /// Initializes a new instance of the <see cref="NewsService"/> class.
     /// </summary>
     /// <param name="httpClient">The HTTP client for fetching news.</param>
-    public NewsService(HttpClient httpClient)
+    /// <param name="configService">The configuration service holding feed and source settings.</param>
+    /// <param name="appDir">The launcher data directory; the news cache lives under <c>Cache/News</c>.</param>
+    public NewsService(HttpClient httpClient, IConfigService configService, string appDir)
     {
         _httpClient = httpClient;
-        
-        // Ensure headers are set if they aren't already
-        if (!_httpClient.DefaultRequestHeaders.Contains("User-Agent"))
-        {
-            _httpClient.DefaultRequestHeaders.Add("User-Agent", "HyPrism/1.0");
-        }
+        _configService = configService;
+        _cachePath = Path.Combine(appDir, "Cache", "News", "news.json");
+        _cache = LoadCache();
+        _builtInSources = [new HytaleNewsSource(httpClient), new HyPrismNewsSource(httpClient)];
+        _imageCache = new NewsImageCache(httpClient, Path.Combine(appDir, "Cache", "News", "Images"));
     }
-    private const string HytaleNewsUrl = "https://hytale.com/api/blog/post/published";
-    private const string HyPrismReleasesUrl = "https://api.github.com/repos/yyyumeniku/HyPrism/releases";
-    
-    // Cache for HyPrism news to avoid GitHub API rate limits
-    private List<NewsItemResponse>? _hyprismNewsCache;
-    private DateTime _hyprismCacheTime = DateTime.MinValue;
-    private static readonly SemaphoreSlim _hyprismLock = new(1, 1);
-    
-    // Cache for Hytale news
-    private List<NewsItemResponse>? _hytaleNewsCache;
-    private DateTime _hytaleCacheTime = DateTime.MinValue;
-    private static readonly SemaphoreSlim _hytaleLock = new(1, 1);
     
-    private const int CacheExpirationMinutes = 30;
+    // Per-source cache, mirrored to disk so news survives restarts and offline starts
+    private readonly Dictionary<string, NewsCacheEntry> _cache;
+    private readonly object _cacheLock = new();
+    private readonly ConcurrentDictionary<string, SemaphoreSlim> _sourceLocks = new();
     
-    // Legacy constructor removed in favor of DI
+    private static readonly TimeSpan CacheTtl = TimeSpan.FromMinutes(30);
     
+    /// <inheritdoc/>
     public async Task<List<NewsItemResponse>> GetNewsAsync(int count = 10, NewsSource source = NewsSource.All)
     {
+        var feed = await GetNewsFeedAsync(count, source);
+        return feed.Items;
+    }
+
+    /// <inheritdoc/>
+    public async Task<NewsFeed> GetNewsFeedAsync(int count = 10, NewsSource source = NewsSource.All, bool forceRefresh = false)
+    {
+        var feed = new NewsFeed();
         try
         {
-            var allNews = new List<(NewsItemResponse item, DateTime dateTime)>();
-            var tasks = new List<Task<List<NewsItemResponse>>>();
-
-            Task<List<NewsItemResponse>>? hytaleTask = null;
-            if (source == NewsSource.All || source == NewsSource.Hytale)
-            {
-                hytaleTask = GetHytaleNewsAsync(count);
-                tasks.Add(hytaleTask);
-            }
-
-            Task<List<NewsItemResponse>>? hyprismTask = null;
-            if (source == NewsSource.All || source == NewsSource.HyPrism)
-            {
-                hyprismTask = GetHyPrismNewsAsync(count);
-                tasks.Add(hyprismTask);
-            }
-
-            await Task.WhenAll(tasks);
+            var disabled = _configService.Configuration.DisabledNewsSources;
+            var sources = GetAllSources()
+                .Where(s => !disabled.Contains(s.SourceId) && MatchesFilter(s, source))
+                .ToList();
 
-            if (hytaleTask != null)
-                allNews.AddRange((await hytaleTask).Select(n => (n, ParseDate(n.Date))));
-            
-            if (hyprismTask != null)
-                allNews.AddRange((await hyprismTask).Select(n => (n, ParseDate(n.Date))));
+            var results = await Task.WhenAll(sources.Select(s => GetSourceNewsAsync(s, count, forceRefresh)));
 
-            var sortedNews = allNews
+            feed.Items = Deduplicate(sources, results)
+                .Select(n => (item: n, dateTime: ParseDate(n.Date)))
                 .OrderByDescending(x => x.dateTime)
                 .Take(count)
                 .Select(x => x.item)
                 .ToList();
 
-            return sortedNews;
+            _imageCache.Apply(feed.Items, !_configService.Configuration.MeteredConnection);
+
+            feed.FromCache = results.Any(r => r.FromCache);
+            feed.Stale = results.Any(r => r.Stale);
+            feed.FetchedAt = results.Where(r => r.FetchedAt.HasValue).Select(r => r.FetchedAt).Min();
         }
         catch (Exception ex)
         {
             Logger.Error("News", $"Failed to fetch news: {ex.Message}");
-            return new List<NewsItemResponse>();
         }
+
+        return feed;
     }
 
-    private async Task<List<NewsItemResponse>> GetHytaleNewsAsync(int count)
+    /// <inheritdoc/>
+    public List<NewsSourceInfo> GetSources()
     {
-        if (_hytaleNewsCache != null && _hytaleNewsCache.Count >= count && (DateTime.Now - _hytaleCacheTime).TotalMinutes < CacheExpirationMinutes)
-            return _hytaleNewsCache.Take(count).ToList();
+        var disabled = _configService.Configuration.DisabledNewsSources;
+        var feeds = _configService.Configuration.NewsFeeds;
 
-        await _hytaleLock.WaitAsync();
-        try
+        return GetAllSources().Select(s => new NewsSourceInfo
         {
-            if (_hytaleNewsCache != null && _hytaleNewsCache.Count >= count && (DateTime.Now - _hytaleCacheTime).TotalMinutes < CacheExpirationMinutes)
-                return _hytaleNewsCache.Take(count).ToList();
+            Id = s.SourceId,
+            Name = s.DisplayName,
+            Type = s.Type.ToString().ToLowerInvariant(),
+            Url = s.Type == NewsSourceType.Feed
+                ? feeds.FirstOrDefault(f => FeedNewsSource.GetSourceId(f.Url) == s.SourceId)?.Url
+                : null,
+            Enabled = !disabled.Contains(s.SourceId)
+        }).ToList();
+    }
 
-            return await GetHytaleNewsInternalAsync(count);
+    /// <inheritdoc/>
+    public List<NewsSourceInfo> ConfigureSources(List<NewsFeedConfig>? feeds, List<string>? disabledSources)
+    {
+        var config = _configService.Configuration;
+
+        if (feeds != null)
+        {
+            var valid = new List<NewsFeedConfig>();
+            foreach (var feed in feeds)
+            {
+                var url = feed.Url?.Trim() ?? "";
+                if (!Uri.TryCreate(url, UriKind.Absolute, out var uri) ||
+                    (uri.Scheme != Uri.UriSchemeHttps && uri.Scheme != Uri.UriSchemeHttp))
+                {
+                    Logger.Warning("News", $"Ignoring invalid feed URL: {url}");
+                    continue;
+                }
+                if (valid.Any(f => FeedNewsSource.GetSourceId(f.Url) == FeedNewsSource.GetSourceId(url))) continue;
+                valid.Add(new NewsFeedConfig { Name = feed.Name?.Trim() ?? "", Url = url });
+            }
+            config.NewsFeeds = valid;
         }
-        finally
+
+        if (disabledSources != null)
         {
-            _hytaleLock.Release();
+            config.DisabledNewsSources = disabledSources.Distinct().ToList();
         }
+
+        _configService.SaveConfig();
+        return GetSources();
     }
 
-    private async Task<List<NewsItemResponse>> GetHytaleNewsInternalAsync(int count)
+    private List<INewsSource> GetAllSources()
     {
-        try
+        // Feed sources are rebuilt per call so settings changes apply without a restart
+        var sources = new List<INewsSource>(_builtInSources);
+        foreach (var feed in _configService.Configuration.NewsFeeds)
         {
-            // Check cache
-            if (_hytaleNewsCache != null && 
-                _hytaleNewsCache.Count >= count && 
-                (DateTime.Now - _hytaleCacheTime).TotalMinutes < CacheExpirationMinutes)
-            {
-                return _hytaleNewsCache.Take(count).ToList();
-            }
+            if (Uri.TryCreate(feed.Url, UriKind.Absolute, out _))
+                sources.Add(new FeedNewsSource(_httpClient, feed));
+        }
+        return sources;
+    }
 
-            Logger.Info("News", "Fetching news from Hytale API...");
-            var response = await _httpClient.GetStringAsync(HytaleNewsUrl);
-            
-            using var jsonDoc = JsonDocument.Parse(response);
-            
-            JsonElement posts;
-            if (jsonDoc.RootElement.ValueKind == JsonValueKind.Array)
+    private static bool MatchesFilter(INewsSource source, NewsSource filter) => filter switch
+    {
+        NewsSource.Hytale => source.Type == NewsSourceType.Hytale,
+        NewsSource.HyPrism => source.Type == NewsSourceType.HyPrism,
+        NewsSource.Feeds => source.Type == NewsSourceType.Feed,
+        _ => true
+    };
+
+    /// <summary>
+    /// Merges items from all sources, keeping one item per URL. When several sources
+    /// publish the same article, the one with the lowest <see cref="INewsSource.Priority"/> wins.
+    /// </summary>
+    private static List<NewsItemResponse> Deduplicate(List<INewsSource> sources, SourceResult[] results)
+    {
+        var seen = new HashSet<string>();
+        var merged = new List<NewsItemResponse>();
+
+        foreach (var (source, result) in sources.Zip(results).OrderBy(x => x.First.Priority))
+        {
+            foreach (var item in result.Items)
             {
-                posts = jsonDoc.RootElement;
+                if (string.IsNullOrEmpty(item.SourceName)) item.SourceName = source.DisplayName;
+
+                var key = NormalizeUrl(item.Url) ?? $"{source.SourceId}|{item.Title}";
+                if (seen.Add(key)) merged.Add(item);
             }
-            else if (jsonDoc.RootElement.TryGetProperty("data", out var dataProp))
+        }
+
+        return merged;
+    }
+
+    private static string? NormalizeUrl(string? url)
+    {
+        if (string.IsNullOrWhiteSpace(url) || !Uri.TryCreate(url.Trim(), UriKind.Absolute, out var uri))
+            return null;
+
+        // Scheme, "www." and trailing slashes vary between feeds for the same article
+        var host = uri.Host.ToLowerInvariant();
+        if (host.StartsWith("www.")) host = host[4..];
+        return $"{host}{uri.AbsolutePath.TrimEnd('/')}{uri.Query}";
+    }
+
+    private record SourceResult(List<NewsItemResponse> Items, bool FromCache, bool Stale, DateTime? FetchedAt);
+
+    /// <summary>
+    /// Returns a source's items from cache while they are within the TTL, otherwise fetches them.
+    /// A failed or empty fetch falls back to whatever is cached, however old.
+    /// </summary>
+    private async Task<SourceResult> GetSourceNewsAsync(INewsSource source, int count, bool forceRefresh)
+    {
+        var sourceId = source.SourceId;
+        if (!forceRefresh && TryGetFresh(sourceId, count, out var fresh))
+            return fresh;
+
+        var sourceLock = _sourceLocks.GetOrAdd(sourceId, _ => new SemaphoreSlim(1, 1));
+        await sourceLock.WaitAsync();
+        try
+        {
+            if (!forceRefresh && TryGetFresh(sourceId, count, out fresh))
+                return fresh;
+
+            List<NewsItemResponse> items;
+            try
             {
-                posts = dataProp;
+                items = await source.FetchNewsAsync(count);
             }
-            else
+            catch (HttpRequestException ex) when (ex.StatusCode == System.Net.HttpStatusCode.Forbidden || ex.Message.Contains("403"))
             {
-                Logger.Warning("News", "Unexpected JSON structure from Hytale API");
-                return new List<NewsItemResponse>();
+                Logger.Warning("News", $"Failed to fetch {sourceId} news: Code 403 (rate limit exceeded)");
+                return Fallback(sourceId, count);
             }
-            
-            var news = new List<NewsItemResponse>();
-            
-            var itemCount = 0;
-            foreach (var post in posts.EnumerateArray())
+            catch (Exception ex)
             {
-                if (itemCount >= count) break;
-                
-                try
-                {
-                    var title = post.TryGetProperty("title", out var titleProp) ? titleProp.GetString() : null;
-                    var excerpt = post.TryGetProperty("bodyExcerpt", out var excerptProp) ? excerptProp.GetString() : null;
-                    
-                    if (string.IsNullOrEmpty(excerpt))
-                    {
-                        excerpt = post.TryGetProperty("excerpt", out var excerptProp2) ? excerptProp2.GetString() : null;
-                    }
-                    var slug = post.TryGetProperty("slug", out var slugProp) ? slugProp.GetString() : null;
-                    var publishedAt = post.TryGetProperty("publishedAt", out var pubProp) ? pubProp.GetString() : null;
-                    
-                    string? imageUrl = null;
-                    if (post.TryGetProperty("coverImage", out var img))
-                    {
-                        try
-                        {
-                            if (img.ValueKind == JsonValueKind.Object)
-                            {
-                                // New API uses s3Key to build CDN URL
-                                if (img.TryGetProperty("s3Key", out var s3KeyProp))
-                                {
-                                    var s3Key = s3KeyProp.GetString();
-                                    if (!string.IsNullOrEmpty(s3Key))
-                                    {
-                                        imageUrl = $"https://cdn.hytale.com/{s3Key}";
-                                    }
-                                }
-                                // Fallback: try direct url property (very old API structure)
-                                else if (img.TryGetProperty("url", out var urlProp))
-                                {
-                                    imageUrl = urlProp.GetString();
-                                }
-                            }
-                            else if (img.ValueKind == JsonValueKind.String)
-                            {
-                                // If coverImage is just a string, treat it as s3Key
-                                var s3Key = img.GetString();
-                                if (!string.IsNullOrEmpty(s3Key))
-                                {
-                                    imageUrl = $"https://cdn.hytale.com/{s3Key}";
-                                }
-                            }
-                        }
-                        catch (Exception imgEx)
-                        {
-                            Logger.Warning("News", $"Failed to parse coverImage: {imgEx.Message}");
-                        }
-                    }
-                    
-                    // Build the correct URL format: hytale.com/news/YYYY/M/slug
-                    string newsUrl = "";
-                    if (!string.IsNullOrEmpty(slug) && !string.IsNullOrEmpty(publishedAt))
-                    {
-                        // Parse publishedAt to extract year and month
-                        if (DateTime.TryParse(publishedAt, out var pubDate))
-                        {
-                            newsUrl = $"https://hytale.com/news/{pubDate.Year}/{pubDate.Month}/{slug}";
-                        }
-                        else
-                        {
-                            // Fallback if date parsing fails
-                            newsUrl = $"https://hytale.com/news/{slug}";
-                        }
-                    }
-                    
-                    news.Add(new NewsItemResponse
-                    {
-                        Title = title ?? "",
-                        Excerpt = CleanNewsExcerpt(excerpt, title),
-                        Url = newsUrl,
-                        Date = publishedAt ?? "",
-                        Author = "Hytale Team",
-                        ImageUrl = imageUrl,
-                        Source = "hytale"
-                    });
-                    
-                    itemCount++;
-                }
-                catch (Exception ex)
-                {
-                    Logger.Warning("News", $"Failed to parse news item: {ex.Message}");
-                    continue;
-                }
+                Logger.Warning("News", $"Failed to fetch {sourceId} news: {ex.Message}");
+                return Fallback(sourceId, count);
             }
-            
-            // Update Cache (only if we fetched enough to be useful for caching, e.g. at least 5)
-            if (news.Count > 0)
+
+            if (items.Count == 0)
+                return Fallback(sourceId, count);
+
+            var fetchedAt = DateTime.UtcNow;
+            lock (_cacheLock)
             {
-               _hytaleNewsCache = news;
-               _hytaleCacheTime = DateTime.Now;
-               Logger.Success("News", "Successfully fetched Hytale news");
+                _cache[sourceId] = new NewsCacheEntry { FetchedAt = fetchedAt, Limit = count, Items = items };
+                SaveCache();
             }
 
-            return news;
+            return new SourceResult(items, false, false, fetchedAt);
         }
-        catch (Exception ex)
+        finally
         {
-            Logger.Warning("News", $"Failed to fetch Hytale news: {ex.Message}");
-            return new List<NewsItemResponse>();
+            sourceLock.Release();
         }
     }
 
-    private async Task<List<NewsItemResponse>> GetHyPrismNewsAsync(int count)
+    private bool TryGetFresh(string sourceId, int count, out SourceResult result)
     {
-        if (_hyprismNewsCache != null && (DateTime.Now - _hyprismCacheTime).TotalMinutes < CacheExpirationMinutes)
-            return _hyprismNewsCache.Take(count).ToList();
-            
-        await _hyprismLock.WaitAsync();
-        try
-        {
-            if (_hyprismNewsCache != null && (DateTime.Now - _hyprismCacheTime).TotalMinutes < CacheExpirationMinutes)
-                return _hyprismNewsCache.Take(count).ToList();
-                
-            return await GetHyPrismNewsInternalAsync(count);
-        }
-        finally
+        lock (_cacheLock)
         {
-            _hyprismLock.Release();
+            if (_cache.TryGetValue(sourceId, out var entry) &&
+                entry.Limit >= count &&
+                DateTime.UtcNow - entry.FetchedAt < CacheTtl)
+            {
+                result = new SourceResult(entry.Items.Take(count).ToList(), true, false, entry.FetchedAt);
+                return true;
+            }
         }
+
+        result = null!;
+        return false;
     }
 
-    private async Task<List<NewsItemResponse>> GetHyPrismNewsInternalAsync(int count)
+    private SourceResult Fallback(string sourceId, int count)
     {
-        // Check cache first
-        if (_hyprismNewsCache != null && (DateTime.Now - _hyprismCacheTime).TotalMinutes < CacheExpirationMinutes)
+        lock (_cacheLock)
         {
-            Logger.Info("News", "Using cached HyPrism news");
-            return _hyprismNewsCache.Take(count).ToList();
+            if (_cache.TryGetValue(sourceId, out var entry) && entry.Items.Count > 0)
+            {
+                Logger.Info("News", $"Serving cached {sourceId} news from {entry.FetchedAt.ToLocalTime():g}");
+                bool stale = DateTime.UtcNow - entry.FetchedAt >= CacheTtl;
+                return new SourceResult(entry.Items.Take(count).ToList(), true, stale, entry.FetchedAt);
+            }
         }
-        
+
+        return new SourceResult(new List<NewsItemResponse>(), false, false, null);
+    }
+
+    private Dictionary<string, NewsCacheEntry> LoadCache()
+    {
         try
         {
-            Logger.Info("News", "Fetching news from HyPrism GitHub...");
-            var response = await _httpClient.GetStringAsync(HyPrismReleasesUrl);
-            
-            using var jsonDoc = JsonDocument.Parse(response);
-            var releases = jsonDoc.RootElement;
-            var news = new List<NewsItemResponse>();
-            
-            var itemCount = 0;
-            foreach (var release in releases.EnumerateArray())
+            if (File.Exists(_cachePath))
             {
-                if (itemCount >= count) break;
-                
-                try
-                {
-                    var name = release.TryGetProperty("name", out var nameProp) ? nameProp.GetString() : null;
-                    var tagName = release.TryGetProperty("tag_name", out var tagProp) ? tagProp.GetString() : null;
-                    var body = release.TryGetProperty("body", out var bodyProp) ? bodyProp.GetString() : null;
-                    var htmlUrl = release.TryGetProperty("html_url", out var urlProp) ? urlProp.GetString() : null;
-                    var publishedAt = release.TryGetProperty("published_at", out var pubProp) ? pubProp.GetString() : null;
-                    
-                    var title = !string.IsNullOrEmpty(name) ? name : tagName ?? "HyPrism Release";
-                    title = title.Replace("(", "").Replace(")", "").Trim();
-                    
-                    var excerpt = !string.IsNullOrEmpty(body) 
-                        ? body.Split('\n').FirstOrDefault()?.Trim() ?? "Click to see changelog."
-                        : "Click to see changelog.";
-                    
-                    // Remove markdown formatting from excerpt
-                    excerpt = Regex.Replace(excerpt, @"[#*_`\[\]]", "");
-                    if (excerpt.Length > 100)
-                    {
-                        excerpt = excerpt.Substring(0, 97) + "...";
-                    }
-                    
-                    news.Add(new NewsItemResponse
-                    {
-                        Title = $"HyPrism {title} release",
-                        Excerpt = excerpt,
-                        Url = htmlUrl ?? "https://github.com/yyyumeniku/HyPrism/releases",
-                        Date = publishedAt ?? DateTime.Now.ToString("o"),
-                        Author = "HyPrism",
-                        ImageUrl = _appIconPath,
-                        Source = "hyprism"
-                    });
-                    
-                    itemCount++;
-                }
-                catch (Exception ex)
-                {
-                    Logger.Warning("News", $"Failed to parse HyPrism release: {ex.Message}");
-                    continue;
-                }
+                var json = File.ReadAllText(_cachePath);
+                return JsonSerializer.Deserialize<Dictionary<string, NewsCacheEntry>>(json) ?? new();
             }
-            
-            // Update cache
-            _hyprismNewsCache = news;
-            _hyprismCacheTime = DateTime.Now;
-            Logger.Success("News", "Successfully fetched HyPrism news");
-            
-            return news;
         }
-        catch (HttpRequestException ex)
+        catch (Exception ex)
         {
-             if (ex.StatusCode == System.Net.HttpStatusCode.Forbidden || ex.Message.Contains("403"))
-             {
-                 Logger.Warning("News", "Failed to fetch HyPrism news: Code 403 (rate limit exceeded)");
-             }
-             else
-             {
-                 Logger.Warning("News", $"Failed to fetch HyPrism news: {ex.Message}");
-             }
-             return new List<NewsItemResponse>();
+            Logger.Warning("News", $"Failed to read news cache: {ex.Message}");
+        }
+        return new();
+    }
+
+    private void SaveCache()
+    {
+        try
+        {
+            Directory.CreateDirectory(Path.GetDirectoryName(_cachePath)!);
+            File.WriteAllText(_cachePath, JsonSerializer.Serialize(_cache));
         }
         catch (Exception ex)
         {
-            Logger.Warning("News", $"Failed to fetch HyPrism news: {ex.Message}");
-            return new List<NewsItemResponse>();
+            Logger.Warning("News", $"Failed to write news cache: {ex.Message}");
         }
     }
-    
+
     private static DateTime ParseDate(string? dateString)
     {
         if (string.IsNullOrEmpty(dateString))
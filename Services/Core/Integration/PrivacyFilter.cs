@@ -0,0 +1,35 @@
+using System.Text.RegularExpressions;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Removes what identifies the user from text that leaves the machine (error reports, issue drafts).
+/// </summary>
+public static class PrivacyFilter
+{
+    private static readonly Regex UrlQuery = new(@"(https?://[^\s?""']+)\?[^\s""']*", RegexOptions.Compiled);
+
+    /// <summary>
+    /// Replaces the home folder with <c>~</c>, the OS user name and the given names with <c>&lt;user&gt;</c>,
+    /// and cuts URLs before their query string, which may carry tokens.
+    /// </summary>
+    /// <param name="text">The text to clean.</param>
+    /// <param name="names">Other names to remove, such as the player's nickname.</param>
+    /// <returns>The cleaned text.</returns>
+    public static string Sanitize(string text, params string?[] names)
+    {
+        text = UrlQuery.Replace(text, "$1?<redacted>");
+
+        var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+        if (!string.IsNullOrEmpty(home))
+            text = text.Replace(home, "~", StringComparison.OrdinalIgnoreCase);
+
+        // Short names, and the default nickname, would also match unrelated text such as namespaces
+        foreach (var name in names.Prepend(Environment.UserName))
+        {
+            if (!string.IsNullOrEmpty(name) && name.Length >= 3 && !name.Equals("HyPrism", StringComparison.OrdinalIgnoreCase))
+                text = Regex.Replace(text, $@"\b{Regex.Escape(name)}\b", "<user>", RegexOptions.IgnoreCase);
+        }
+        return text;
+    }
+}
@@ -0,0 +1,248 @@
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Integration;
+
+/// <summary>
+/// Records anonymous usage events into a local queue and sends them in batches.
+/// </summary>
+/// <remarks>
+/// The queue is persisted to <c>{appDir}/Telemetry/queue.json</c> so events survive restarts
+/// and users can read exactly what is pending. Batches are sent once <see cref="BatchSize"/>
+/// events are queued or every <see cref="FlushInterval"/>, and only if an endpoint is configured.
+/// </remarks>
+public class TelemetryService : ITelemetryService, IDisposable
+{
+    private const int BatchSize = 20;
+    private const int MaxQueuedEvents = 500;
+    private static readonly TimeSpan FlushInterval = TimeSpan.FromMinutes(30);
+
+    private static readonly JsonSerializerOptions PayloadOptions = new()
+    {
+        PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+        DefaultIgnoreCondition = System.Text.Json.Serialization.JsonIgnoreCondition.WhenWritingNull,
+        WriteIndented = true
+    };
+
+    private readonly string _queuePath;
+    private readonly IConfigService _configService;
+    private readonly HttpClient _httpClient;
+    private readonly object _lock = new();
+    private readonly SemaphoreSlim _flushLock = new(1, 1);
+    private readonly Timer _flushTimer;
+    private List<TelemetryEvent> _queue;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="TelemetryService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory.</param>
+    /// <param name="configService">The configuration service holding the opt-in flag.</param>
+    /// <param name="httpClient">The HTTP client used to send batches.</param>
+    /// <param name="progressService">Source of game start and error notifications.</param>
+    public TelemetryService(
+        string appDir,
+        IConfigService configService,
+        HttpClient httpClient,
+        IProgressNotificationService progressService)
+    {
+        _queuePath = Path.Combine(appDir, "Telemetry", "queue.json");
+        _configService = configService;
+        _httpClient = httpClient;
+        _queue = LoadQueue();
+
+        progressService.GameStateChanged += (state, _) =>
+        {
+            if (state == "started") Record("game_launch");
+        };
+        progressService.ErrorOccurred += error =>
+        {
+            // Only the category leaves the machine, never the message text
+            Record("error", error.MessageKey ?? error.Type);
+        };
+
+        _flushTimer = new Timer(_ => _ = FlushAsync(), null, FlushInterval, FlushInterval);
+    }
+
+    private bool Enabled => _configService.Configuration.TelemetryEnabled;
+
+    /// <inheritdoc/>
+    public TelemetryStatus GetStatus()
+    {
+        lock (_lock)
+        {
+            return new TelemetryStatus
+            {
+                Enabled = Enabled,
+                Endpoint = _configService.Configuration.TelemetryEndpoint,
+                QueuedEvents = _queue.Count
+            };
+        }
+    }
+
+    /// <inheritdoc/>
+    public TelemetryStatus SetEnabled(bool enabled)
+    {
+        var config = _configService.Configuration;
+        if (config.TelemetryEnabled != enabled)
+        {
+            config.TelemetryEnabled = enabled;
+            config.TelemetryId = enabled ? Guid.NewGuid().ToString("N") : "";
+            _configService.SaveConfig();
+
+            if (!enabled)
+            {
+                lock (_lock)
+                {
+                    _queue.Clear();
+                    SaveQueue();
+                }
+            }
+
+            Logger.Info("Telemetry", enabled ? "Telemetry enabled" : "Telemetry disabled, local queue cleared");
+        }
+
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public TelemetryBatch GetPreview()
+    {
+        var batch = BuildBatch();
+        if (batch.Events.Count == 0)
+        {
+            batch.Events.Add(new TelemetryEvent { Name = "launcher_start", Timestamp = TruncateToHour(DateTime.UtcNow) });
+        }
+        if (string.IsNullOrEmpty(batch.InstallId))
+        {
+            batch.InstallId = "<generated when enabled>";
+        }
+        return batch;
+    }
+
+    /// <inheritdoc/>
+    public void RecordLauncherStart() => Record("launcher_start");
+
+    /// <inheritdoc/>
+    public async Task FlushAsync()
+    {
+        var endpoint = _configService.Configuration.TelemetryEndpoint;
+        if (!Enabled || string.IsNullOrWhiteSpace(endpoint)) return;
+        if (!await _flushLock.WaitAsync(0)) return;
+
+        try
+        {
+            var batch = BuildBatch();
+            if (batch.Events.Count == 0) return;
+
+            var json = JsonSerializer.Serialize(batch, PayloadOptions);
+            using var content = new StringContent(json, Encoding.UTF8, "application/json");
+            using var cts = new CancellationTokenSource(TimeSpan.FromSeconds(15));
+            using var response = await _httpClient.PostAsync(endpoint, content, cts.Token);
+
+            if (!response.IsSuccessStatusCode)
+            {
+                Logger.Debug("Telemetry", $"Batch rejected with HTTP {(int)response.StatusCode}, keeping queue");
+                return;
+            }
+
+            lock (_lock)
+            {
+                // Events recorded while the request was in flight stay queued
+                _queue.RemoveRange(0, Math.Min(batch.Events.Count, _queue.Count));
+                SaveQueue();
+            }
+            Logger.Debug("Telemetry", $"Sent {batch.Events.Count} event(s)");
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("Telemetry", $"Flush failed, keeping queue: {ex.Message}");
+        }
+        finally
+        {
+            _flushLock.Release();
+        }
+    }
+
+    private void Record(string name, string? category = null)
+    {
+        if (!Enabled) return;
+
+        bool flush;
+        lock (_lock)
+        {
+            _queue.Add(new TelemetryEvent { Name = name, Category = category, Timestamp = TruncateToHour(DateTime.UtcNow) });
+            if (_queue.Count > MaxQueuedEvents)
+            {
+                _queue.RemoveRange(0, _queue.Count - MaxQueuedEvents);
+            }
+            SaveQueue();
+            flush = _queue.Count >= BatchSize;
+        }
+
+        if (flush) _ = FlushAsync();
+    }
+
+    private TelemetryBatch BuildBatch()
+    {
+        lock (_lock)
+        {
+            return new TelemetryBatch
+            {
+                InstallId = _configService.Configuration.TelemetryId,
+                LauncherVersion = UpdateService.GetCurrentVersion(),
+                Os = UtilityService.GetOS(),
+                Arch = UtilityService.GetArch(),
+                Events = _queue.Take(BatchSize).ToList()
+            };
+        }
+    }
+
+    private static DateTime TruncateToHour(DateTime time) =>
+        new(time.Year, time.Month, time.Day, time.Hour, 0, 0, DateTimeKind.Utc);
+
+    private List<TelemetryEvent> LoadQueue()
+    {
+        try
+        {
+            if (File.Exists(_queuePath))
+            {
+                return JsonSerializer.Deserialize<List<TelemetryEvent>>(File.ReadAllText(_queuePath), PayloadOptions) ?? new();
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Telemetry", $"Discarding unreadable queue: {ex.Message}");
+        }
+        return new();
+    }
+
+    private void SaveQueue()
+    {
+        try
+        {
+            if (_queue.Count == 0)
+            {
+                if (File.Exists(_queuePath)) File.Delete(_queuePath);
+                return;
+            }
+
+            Directory.CreateDirectory(Path.GetDirectoryName(_queuePath)!);
+            File.WriteAllText(_queuePath, JsonSerializer.Serialize(_queue, PayloadOptions));
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("Telemetry", $"Failed to persist queue: {ex.Message}");
+        }
+    }
+
+    /// <inheritdoc/>
+    public void Dispose()
+    {
+        _flushTimer.Dispose();
+        _flushLock.Dispose();
+    }
+}
@@ -0,0 +1,439 @@
+using System.Net;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Launch;
+using HyPrism.Services.Game.Mod;
+
+namespace HyPrism.Services.Core.Ipc;
+
+/// <summary>
+/// Serves the automation API on <c>http://localhost:{port}/api/v1/</c>.
+/// </summary>
+/// <remarks>
+/// Every request needs <c>Authorization: Bearer {token}</c>; the token is generated when the API is
+/// enabled and kept in the <see cref="ISecretStore"/>, so a web page cannot drive the launcher just
+/// because it can reach localhost. The listener uses the <c>localhost</c> prefix, which Windows lets an
+/// unelevated process bind without a URL reservation. Windows matches that prefix by the Host header, not
+/// by where the connection comes from, so requests from other machines are refused with <c>403</c>. Launch and install return <c>202</c> right away and run like a
+/// launch from the GUI (progress and errors show up there). Routes:
+/// <list type="bullet">
+/// <item><c>GET status</c>, <c>GET instances</c>, <c>POST game/stop</c></item>
+/// <item><c>GET actions</c>, <c>POST actions/{action}</c>: the quick actions also bound to global hotkeys,
+/// so companion tools such as Stream Deck need one button per action and no request body</item>
+/// <item><c>POST instances/{id}/launch</c>, <c>POST instances/{id}/install</c></item>
+/// <item><c>GET instances/{id}/mods</c>, <c>POST instances/{id}/mods</c> (<c>{ modId, fileId }</c>),
+/// <c>POST instances/{id}/mods/{modId}/toggle</c>, <c>DELETE instances/{id}/mods/{modId}</c></item>
+/// </list>
+/// </remarks>
+public class AutomationApiService : IAutomationApiService, IDisposable
+{
+    private const string BasePath = "/api/v1/";
+    private const int MaxBodyBytes = 64 * 1024;
+    private const string TokenSecretName = "automation-api-token";
+
+    private static readonly JsonSerializerOptions JsonOpts = new()
+    {
+        PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+        DefaultIgnoreCondition = JsonIgnoreCondition.WhenWritingNull,
+        Converters = { new JsonStringEnumConverter() }
+    };
+
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IGameSessionService _gameSessionService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly IModService _modService;
+    private readonly IQuickActionService _quickActionService;
+    private readonly ISecretStore _secretStore;
+    private readonly object _lock = new();
+    private string? _token;
+    private HttpListener? _listener;
+    private CancellationTokenSource? _listenCts;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="AutomationApiService"/> class.
+    /// </summary>
+    /// <param name="configService">Holds the opt-in flag, port and token.</param>
+    /// <param name="instanceService">Lists and resolves instances.</param>
+    /// <param name="gameSessionService">Installs and launches instances.</param>
+    /// <param name="gameProcessService">Tells whether the game runs and stops it.</param>
+    /// <param name="modService">Lists, installs, toggles and removes instance mods.</param>
+    /// <param name="quickActionService">Runs the quick actions behind <c>actions/{action}</c>.</param>
+    /// <param name="secretStore">Keeps the token.</param>
+    public AutomationApiService(
+        IConfigService configService,
+        IInstanceService instanceService,
+        IGameSessionService gameSessionService,
+        IGameProcessService gameProcessService,
+        IModService modService,
+        IQuickActionService quickActionService,
+        ISecretStore secretStore)
+    {
+        _configService = configService;
+        _instanceService = instanceService;
+        _gameSessionService = gameSessionService;
+        _gameProcessService = gameProcessService;
+        _modService = modService;
+        _quickActionService = quickActionService;
+        _secretStore = secretStore;
+    }
+
+    private Config Config => _configService.Configuration;
+
+    /// <summary>
+    /// Gets the token, read once from the secret store. A token left in config.json by older versions
+    /// is moved to the store on first use.
+    /// </summary>
+    private string Token
+    {
+        get
+        {
+            lock (_lock)
+            {
+                if (_token != null) return _token;
+
+                var legacy = Config.AutomationApiToken;
+                if (!string.IsNullOrEmpty(legacy))
+                {
+                    _token = legacy;
+                    if (_secretStore.Set(TokenSecretName, legacy))
+                        _configService.TryUpdate(c => c.AutomationApiToken = "");
+                    return _token;
+                }
+
+                return _token = _secretStore.Get(TokenSecretName) ?? "";
+            }
+        }
+    }
+
+    private void SetToken(string token)
+    {
+        lock (_lock)
+        {
+            _token = token;
+            if (!_secretStore.Set(TokenSecretName, token))
+                Logger.Warning("Automation", "Could not store the automation API token; it is only valid until the launcher closes");
+        }
+    }
+
+    /// <inheritdoc/>
+    public AutomationApiStatus GetStatus()
+    {
+        lock (_lock)
+        {
+            return new AutomationApiStatus
+            {
+                Enabled = Config.AutomationApiEnabled,
+                Running = _listener?.IsListening == true,
+                Url = $"http://localhost:{Config.AutomationApiPort}{BasePath}",
+                Token = Config.AutomationApiEnabled ? Token : ""
+            };
+        }
+    }
+
+    /// <inheritdoc/>
+    public AutomationApiStatus SetEnabled(bool enabled)
+    {
+        if (Config.AutomationApiEnabled != enabled)
+        {
+            Config.AutomationApiEnabled = enabled;
+            _configService.SaveConfig();
+            Logger.Info("Automation", enabled ? "Automation API enabled" : "Automation API disabled");
+        }
+
+        if (enabled) Start();
+        else StopListener();
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public AutomationApiStatus RegenerateToken()
+    {
+        SetToken(GenerateToken());
+        Logger.Info("Automation", "Automation API token regenerated");
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public void Start()
+    {
+        if (!Config.AutomationApiEnabled) return;
+
+        lock (_lock)
+        {
+            if (_listener?.IsListening == true) return;
+
+            if (string.IsNullOrEmpty(Token))
+                SetToken(GenerateToken());
+
+            var listener = new HttpListener();
+            listener.Prefixes.Add($"http://localhost:{Config.AutomationApiPort}/");
+            try
+            {
+                listener.Start();
+            }
+            catch (Exception ex) when (ex is HttpListenerException or PlatformNotSupportedException)
+            {
+                Logger.Warning("Automation", $"Could not listen on port {Config.AutomationApiPort}: {ex.Message}");
+                listener.Close();
+                return;
+            }
+
+            _listener = listener;
+            _listenCts = new CancellationTokenSource();
+            var ct = _listenCts.Token;
+            _ = Task.Run(() => ListenAsync(listener, ct));
+            Logger.Success("Automation", $"Automation API listening on http://localhost:{Config.AutomationApiPort}{BasePath}");
+        }
+    }
+
+    private void StopListener()
+    {
+        lock (_lock)
+        {
+            _listenCts?.Cancel();
+            _listenCts?.Dispose();
+            _listenCts = null;
+            try { _listener?.Close(); } catch { /* already closed */ }
+            _listener = null;
+        }
+    }
+
+    private async Task ListenAsync(HttpListener listener, CancellationToken ct)
+    {
+        while (!ct.IsCancellationRequested)
+        {
+            HttpListenerContext context;
+            try
+            {
+                context = await listener.GetContextAsync();
+            }
+            catch (Exception) when (ct.IsCancellationRequested || !listener.IsListening)
+            {
+                break;
+            }
+            catch (HttpListenerException ex)
+            {
+                Logger.Debug("Automation", $"Accept failed: {ex.Message}");
+                continue;
+            }
+
+            _ = Task.Run(() => HandleAsync(context));
+        }
+    }
+
+    private async Task HandleAsync(HttpListenerContext context)
+    {
+        var request = context.Request;
+        var response = context.Response;
+        try
+        {
+            if (request.RemoteEndPoint is not { } remote || !IPAddress.IsLoopback(remote.Address))
+            {
+                await WriteAsync(response, HttpStatusCode.Forbidden, new { error = "Only local connections are accepted" });
+                return;
+            }
+
+            if (!IsAuthorized(request))
+            {
+                await WriteAsync(response, HttpStatusCode.Unauthorized, new { error = "Missing or invalid bearer token" });
+                return;
+            }
+
+            var path = request.Url?.AbsolutePath ?? "";
+            if (!path.StartsWith(BasePath, StringComparison.Ordinal))
+            {
+                await WriteAsync(response, HttpStatusCode.NotFound, new { error = "Unknown route" });
+                return;
+            }
+
+            var segments = path[BasePath.Length..].Split('/', StringSplitOptions.RemoveEmptyEntries)
+                .Select(Uri.UnescapeDataString)
+                .ToArray();
+            var (status, body) = await RouteAsync(request.HttpMethod, segments, request);
+            await WriteAsync(response, status, body);
+        }
+        catch (JsonException ex)
+        {
+            await WriteAsync(response, HttpStatusCode.BadRequest, new { error = $"Invalid JSON: {ex.Message}" });
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Automation", $"{request.HttpMethod} {request.Url?.AbsolutePath} failed: {ex.Message}");
+            await WriteAsync(response, HttpStatusCode.InternalServerError, new { error = ex.Message });
+        }
+    }
+
+    private async Task<(HttpStatusCode Status, object Body)> RouteAsync(string method, string[] segments, HttpListenerRequest request)
+    {
+        switch (method, segments)
+        {
+            case ("GET", ["status"]):
+                return (HttpStatusCode.OK, new
+                {
+                    launcherVersion = UpdateService.GetCurrentVersion(),
+                    gameRunning = _gameProcessService.IsGameRunning(),
+                    selectedInstanceId = _instanceService.GetSelectedInstance()?.Id,
+                    installQueue = _gameSessionService.GetInstallQueue()
+                });
+
+            case ("GET", ["instances"]):
+                _instanceService.SyncInstancesWithConfig();
+                return (HttpStatusCode.OK, (Config.Instances ?? new List<InstanceInfo>()).Select(i => new
+                {
+                    id = i.Id,
+                    name = i.Name,
+                    branch = i.Branch,
+                    version = i.Version,
+                    isInstalled = _instanceService.GetInstancePathById(i.Id) is { } p && _instanceService.IsClientPresent(p)
+                }).ToList());
+
+            case ("POST", ["game", "stop"]):
+                return (HttpStatusCode.OK, new { stopped = _gameProcessService.ExitGame() });
+
+            case ("GET", ["actions"]):
+                return (HttpStatusCode.OK, QuickActions.All);
+
+            case ("POST", ["actions", var action]):
+            {
+                if (!QuickActions.All.Contains(action))
+                    return (HttpStatusCode.NotFound, new { error = $"Unknown quick action: {action}" });
+                var result = _quickActionService.Run(action);
+                return (result.Success ? HttpStatusCode.OK : HttpStatusCode.Conflict, result);
+            }
+
+            case ("POST", ["instances", var id, "launch"]):
+                return StartSession(id, launch: true);
+
+            case ("POST", ["instances", var id, "install"]):
+                return StartSession(id, launch: false);
+
+            case ("GET", ["instances", var id, "mods"]):
+                return ResolveInstance(id, out var listPath) is { } listError
+                    ? listError
+                    : (HttpStatusCode.OK, _modService.GetInstanceInstalledMods(listPath));
+
+            case ("POST", ["instances", var id, "mods"]):
+            {
+                if (ResolveInstance(id, out var instancePath) is { } error) return error;
+
+                using var doc = JsonDocument.Parse(await ReadBodyAsync(request));
+                var modId = doc.RootElement.TryGetProperty("modId", out var m) ? m.ToString() : "";
+                var fileId = doc.RootElement.TryGetProperty("fileId", out var f) ? f.ToString() : "";
+                if (modId.Length == 0 || fileId.Length == 0)
+                    return (HttpStatusCode.BadRequest, new { error = "modId and fileId are required" });
+
+                var installed = await _modService.InstallModFileToInstanceAsync(modId, fileId, instancePath);
+                return (installed ? HttpStatusCode.OK : HttpStatusCode.Conflict, new { success = installed });
+            }
+
+            case ("POST", ["instances", var id, "mods", var modId, "toggle"]):
+            {
+                if (ResolveInstance(id, out var instancePath) is { } error) return error;
+                var toggled = await _modService.ToggleInstanceModAsync(instancePath, modId);
+                return (toggled ? HttpStatusCode.OK : HttpStatusCode.Conflict, new { success = toggled });
+            }
+
+            case ("DELETE", ["instances", var id, "mods", var modId]):
+            {
+                if (ResolveInstance(id, out var instancePath) is { } error) return error;
+                var removed = await _modService.UninstallInstanceModAsync(instancePath, modId);
+                return (removed ? HttpStatusCode.OK : HttpStatusCode.Conflict, new { success = removed });
+            }
+
+            default:
+                return (HttpStatusCode.NotFound, new { error = $"Unknown route: {method} {string.Join('/', segments)}" });
+        }
+    }
+
+    /// <summary>
+    /// Selects the instance and installs it, launching afterwards if asked; runs in the background.
+    /// </summary>
+    private (HttpStatusCode, object) StartSession(string instanceId, bool launch)
+    {
+        var info = _instanceService.FindInstanceById(instanceId);
+        if (info == null) return (HttpStatusCode.NotFound, new { error = $"Instance {instanceId} not found" });
+        if (launch && _gameProcessService.IsGameRunning())
+            return (HttpStatusCode.Conflict, new { error = "The game is already running" });
+
+        Logger.Info("Automation", $"{(launch ? "Launch" : "Install")} of {instanceId} requested");
+        _ = Task.Run(async () =>
+        {
+            var result = await _gameSessionService.LaunchInstanceAsync(instanceId, launch ? null : () => false);
+            if (!string.IsNullOrEmpty(result.Error))
+                Logger.Warning("Automation", $"{(launch ? "Launch" : "Install")} of {instanceId} ended: {result.Error}");
+        });
+        return (HttpStatusCode.Accepted, new { accepted = true });
+    }
+
+    private (HttpStatusCode, object)? ResolveInstance(string instanceId, out string instancePath)
+    {
+        instancePath = _instanceService.GetInstancePathById(instanceId) ?? "";
+        return string.IsNullOrEmpty(instancePath)
+            ? (HttpStatusCode.NotFound, new { error = $"Instance {instanceId} not found" })
+            : null;
+    }
+
+    private bool IsAuthorized(HttpListenerRequest request)
+    {
+        var token = Token;
+        var header = request.Headers["Authorization"];
+        if (string.IsNullOrEmpty(token) || header == null || !header.StartsWith("Bearer ", StringComparison.OrdinalIgnoreCase))
+            return false;
+
+        return CryptographicOperations.FixedTimeEquals(
+            Encoding.UTF8.GetBytes(header["Bearer ".Length..].Trim()),
+            Encoding.UTF8.GetBytes(token));
+    }
+
+    private static async Task<string> ReadBodyAsync(HttpListenerRequest request)
+    {
+        if (request.ContentLength64 > MaxBodyBytes)
+            throw new JsonException($"Body larger than {MaxBodyBytes} bytes");
+
+        // Chunked requests have no length up front, so the limit is enforced while reading
+        using var body = new MemoryStream();
+        var buffer = new byte[8192];
+        int read;
+        while ((read = await request.InputStream.ReadAsync(buffer)) > 0)
+        {
+            if (body.Length + read > MaxBodyBytes)
+                throw new JsonException($"Body larger than {MaxBodyBytes} bytes");
+            body.Write(buffer, 0, read);
+        }
+
+        return body.Length == 0 ? "{}" : (request.ContentEncoding ?? Encoding.UTF8).GetString(body.GetBuffer(), 0, (int)body.Length);
+    }
+
+    private static async Task WriteAsync(HttpListenerResponse response, HttpStatusCode status, object body)
+    {
+        try
+        {
+            var bytes = JsonSerializer.SerializeToUtf8Bytes(body, JsonOpts);
+            response.StatusCode = (int)status;
+            response.ContentType = "application/json; charset=utf-8";
+            response.ContentLength64 = bytes.Length;
+            await response.OutputStream.WriteAsync(bytes);
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("Automation", $"Failed to write response: {ex.Message}");
+        }
+        finally
+        {
+            response.Close();
+        }
+    }
+
+    private static string GenerateToken() => Convert.ToHexString(RandomNumberGenerator.GetBytes(24)).ToLowerInvariant();
+
+    /// <inheritdoc/>
+    public void Dispose() => StopListener();
+}
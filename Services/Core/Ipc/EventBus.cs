@@ -0,0 +1,77 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Ipc;
+
+/// <summary>
+/// Records events pushed to the frontend and keeps a replay buffer per channel,
+/// sized by <see cref="IpcEvents.ReplayCapacity"/>.
+/// </summary>
+public class EventBus : IEventBus
+{
+    // Must match IpcService serialization so replayed payloads look identical to live ones
+    private static readonly JsonSerializerOptions JsonOpts = new()
+    {
+        PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+        DefaultIgnoreCondition = JsonIgnoreCondition.WhenWritingNull,
+        Converters = { new JsonStringEnumConverter() }
+    };
+
+    private readonly object _lock = new();
+    private readonly Dictionary<string, Queue<BusEvent>> _buffers = new();
+    private long _seq;
+
+    /// <inheritdoc/>
+    public event Action<BusEvent>? Published;
+
+    /// <inheritdoc/>
+    public long CurrentSeq => Interlocked.Read(ref _seq);
+
+    /// <inheritdoc/>
+    public long Publish(string channel, object? payload)
+    {
+        var evt = new BusEvent
+        {
+            Seq = Interlocked.Increment(ref _seq),
+            Channel = channel,
+            Payload = JsonSerializer.SerializeToElement(payload, JsonOpts)
+        };
+
+        int capacity = IpcEvents.ReplayCapacity.TryGetValue(channel, out var c) ? c : 0;
+        if (capacity > 0)
+        {
+            lock (_lock)
+            {
+                if (!_buffers.TryGetValue(channel, out var queue))
+                {
+                    queue = new Queue<BusEvent>();
+                    _buffers[channel] = queue;
+                }
+
+                queue.Enqueue(evt);
+                while (queue.Count > capacity) queue.Dequeue();
+            }
+        }
+
+        // Don't log delivery failures: log lines are themselves events and would recurse
+        try { Published?.Invoke(evt); }
+        catch { /* swallow */ }
+
+        return evt.Seq;
+    }
+
+    /// <inheritdoc/>
+    public IReadOnlyList<BusEvent> Replay(long sinceSeq, IReadOnlyCollection<string>? channels = null)
+    {
+        lock (_lock)
+        {
+            return _buffers
+                .Where(kv => channels == null || channels.Count == 0 || channels.Contains(kv.Key))
+                .SelectMany(kv => kv.Value)
+                .Where(e => e.Seq > sinceSeq)
+                .OrderBy(e => e.Seq)
+                .ToList();
+        }
+    }
+}
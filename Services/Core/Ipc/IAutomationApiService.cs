@@ -0,0 +1,33 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Ipc;
+
+/// <summary>
+/// Local HTTP API that lets scripts and tools (e.g. Stream Deck) drive the launcher without the GUI.
+/// Opt-in, bound to localhost and authenticated with a bearer token.
+/// </summary>
+public interface IAutomationApiService
+{
+    /// <summary>
+    /// Gets whether the API is enabled and running, its URL and token.
+    /// </summary>
+    AutomationApiStatus GetStatus();
+
+    /// <summary>
+    /// Enables or disables the API and starts or stops the listener. Enabling generates a token if there is none.
+    /// </summary>
+    /// <param name="enabled">Whether the API should accept requests.</param>
+    /// <returns>The updated status.</returns>
+    AutomationApiStatus SetEnabled(bool enabled);
+
+    /// <summary>
+    /// Replaces the token; clients using the old one are rejected from now on.
+    /// </summary>
+    /// <returns>The updated status.</returns>
+    AutomationApiStatus RegenerateToken();
+
+    /// <summary>
+    /// Starts the listener if the API is enabled. Called once at startup.
+    /// </summary>
+    void Start();
+}
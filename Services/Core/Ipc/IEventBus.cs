@@ -0,0 +1,36 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Ipc;
+
+/// <summary>
+/// Single path for pushing events to the frontend. Every published event gets a
+/// sequence number and is kept in a bounded per-channel buffer so views that were
+/// not mounted when it fired can replay it.
+/// </summary>
+public interface IEventBus
+{
+    /// <summary>
+    /// Raised after an event is recorded; the IPC layer forwards it to the renderer.
+    /// </summary>
+    event Action<BusEvent>? Published;
+
+    /// <summary>
+    /// Publishes an event on the given channel.
+    /// </summary>
+    /// <param name="channel">One of the <see cref="IpcEvents"/> channel names.</param>
+    /// <param name="payload">The event payload.</param>
+    /// <returns>The sequence number assigned to the event.</returns>
+    long Publish(string channel, object? payload);
+
+    /// <summary>
+    /// Returns buffered events newer than <paramref name="sinceSeq"/>, oldest first.
+    /// </summary>
+    /// <param name="sinceSeq">Only events with a greater sequence number are returned.</param>
+    /// <param name="channels">Optional channel filter; null returns all replayable channels.</param>
+    IReadOnlyList<BusEvent> Replay(long sinceSeq, IReadOnlyCollection<string>? channels = null);
+
+    /// <summary>
+    /// Sequence number of the most recently published event.
+    /// </summary>
+    long CurrentSeq { get; }
+}
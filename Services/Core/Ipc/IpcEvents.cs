@@ -0,0 +1,103 @@
+namespace HyPrism.Services.Core.Ipc;
+
+/// <summary>
+/// Names of every event channel pushed from .NET to the frontend.
+/// Payload shapes are documented by the matching <c>@ipc event</c> annotations in <see cref="IpcService"/>.
+/// </summary>
+public static class IpcEvents
+{
+    /// <summary>Download/install/launch progress. Payload: <c>ProgressUpdate</c>.</summary>
+    public const string GameProgress = "hyprism:game:progress";
+
+    /// <summary>Game process state changes. Payload: <c>GameState</c>.</summary>
+    public const string GameState = "hyprism:game:state";
+
+    /// <summary>Errors raised during game operations. Payload: <c>GameError</c>.</summary>
+    public const string GameError = "hyprism:game:error";
+
+    /// <summary>New lines from the followed game log file. Payload: <c>GameLogChunk</c>.</summary>
+    public const string GameLogStream = "hyprism:game:logStream";
+
+    /// <summary>Resource usage reading of the running game. Payload: <c>GameStatsSample</c>.</summary>
+    public const string GameStats = "hyprism:game:stats";
+
+    /// <summary>Install queue changed. Payload: <c>InstallQueueEntry[]</c>.</summary>
+    public const string InstallQueueChanged = "hyprism:game:installQueueChanged";
+
+    /// <summary>Progress of any download or install (game, JRE, Butler, mods, launcher update). Payload: <c>OperationProgress</c>.</summary>
+    public const string OperationProgress = "hyprism:progress:update";
+
+    /// <summary>Background task created or changed. Payload: <c>BackgroundTask</c>.</summary>
+    public const string TasksUpdated = "hyprism:tasks:updated";
+
+    /// <summary>New log line matching the active subscription. Payload: <c>LogLine</c>.</summary>
+    public const string LogsLine = "hyprism:logs:line";
+
+    /// <summary>A mod was checked during a mod update check. Payload: <c>ModUpdateCheckProgress</c>.</summary>
+    public const string ModsUpdateCheck = "hyprism:mods:updateCheck";
+
+    /// <summary>A mod was switched while enabling or disabling all mods. Payload: <c>ModBulkToggleProgress</c>.</summary>
+    public const string ModsBulkToggleProgress = "hyprism:mods:bulkToggleProgress";
+
+    /// <summary>Enabled mods do not list the instance's game version. Payload: <c>ModCompatibilityReport</c>.</summary>
+    public const string ModsCompatibilityWarning = "hyprism:mods:compatibilityWarning";
+
+    /// <summary>A second launcher invocation forwarded its arguments. Payload: <c>SecondInstanceArgs</c>.</summary>
+    public const string AppSecondInstance = "hyprism:app:secondInstance";
+
+    /// <summary>The pre-launch graphics check found a likely driver problem. Payload: <c>GraphicsDiagnostics</c>.</summary>
+    public const string GameGraphicsWarning = "hyprism:game:graphicsWarning";
+
+    /// <summary>Music was muted or unmuted by a quick action. Payload: <c>{ musicEnabled }</c>.</summary>
+    public const string QuickActionsMusicToggled = "hyprism:quickActions:musicToggled";
+
+    /// <summary>A playtime limit is close or reached. Payload: <c>PlaytimeWarning</c>.</summary>
+    public const string PlaytimeWarning = "hyprism:playtime:warning";
+
+    /// <summary>A pre-release launch was held back until the warning is confirmed. Payload: <c>PreReleaseNotice</c>.</summary>
+    public const string PreReleaseNoticeRequired = "hyprism:prerelease:noticeRequired";
+
+    /// <summary>A background refresh changed a branch's version list. Payload: <c>{ branch, versions }</c>.</summary>
+    public const string GameVersionsChanged = "hyprism:game:versionsChanged";
+
+    /// <summary>A newer launcher release was found. Payload: <c>LauncherUpdateInfo</c>.</summary>
+    public const string UpdateAvailable = "hyprism:update:available";
+
+    /// <summary>A download on a metered connection waits for the user. Payload: <c>DownloadConfirmation</c>.</summary>
+    public const string MeteredConfirmationRequired = "hyprism:metered:confirmationRequired";
+
+    /// <summary>
+    /// How many recent events each channel keeps for replay. Channels that only
+    /// describe current state keep the latest event; unlisted channels are not replayed.
+    /// </summary>
+    public static readonly IReadOnlyDictionary<string, int> ReplayCapacity = new Dictionary<string, int>
+    {
+        [GameProgress] = 1,
+        [GameState] = 5,
+        [GameError] = 10,
+        [InstallQueueChanged] = 1,
+        [GameStats] = 1,
+        [TasksUpdated] = 50,
+        // Enough for the parallel first-install downloads to each show their latest state
+        [OperationProgress] = 10,
+        [AppSecondInstance] = 5,
+        [QuickActionsMusicToggled] = 1,
+        [PlaytimeWarning] = 1,
+        [GameGraphicsWarning] = 1,
+        [ModsCompatibilityWarning] = 1,
+        [UpdateAvailable] = 1,
+        // One event per branch, release and pre-release
+        [GameVersionsChanged] = 2,
+        // Only meaningful while a check is running; the invoke reply carries the full list
+        [ModsUpdateCheck] = 0,
+        [ModsBulkToggleProgress] = 0,
+        // Log lines are not replayed; use hyprism:logs:query with afterSeq instead
+        [LogsLine] = 0,
+        // Lines already sent are not resent; restart the stream to get the recent backlog
+        [GameLogStream] = 0,
+        // Tied to the launch click that raised it; hyprism:prerelease:notice returns it on demand
+        [PreReleaseNoticeRequired] = 0,
+        // Answered ones are stale; hyprism:metered:pending returns those still waiting
+        [MeteredConfirmationRequired] = 0,
+    };
+}
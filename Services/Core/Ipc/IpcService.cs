@@ -3,20 +3,21 @@ using System.IO;
 using System.IO.Compression;
 using System.Linq;
 using System.Text.Json;
+using System.Text.Json.Nodes;
 using System.Text.Json.Serialization;
 using ElectronNET.API;
 using Microsoft.Extensions.DependencyInjection;
-using SixLabors.ImageSharp;
-using SixLabors.ImageSharp.Processing;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
 using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Integration;
 using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Game;
+using HyPrism.Services.Game.Download;
 using HyPrism.Services.Game.Instance;
 using HyPrism.Services.Game.Launch;
 using HyPrism.Services.Game.Mod;
+using HyPrism.Services.Game.Settings;
 using HyPrism.Services.Game.Version;
 using HyPrism.Services.User;
 
@@ -35,33 +36,119 @@ namespace HyPrism.Services.Core.Ipc;
 /// consumed by the codegen script.
 /// </summary>
 /// 
-/// @type ProgressUpdate { state: string; progress: number; messageKey: string; args?: unknown[]; downloadedBytes: number; totalBytes: number; }
+/// @type ProgressUpdate { state: string; progress: number; messageKey: string; args?: unknown[]; downloadedBytes: number; totalBytes: number; bytesPerSecond: number; etaSeconds?: number; }
 /// @type GameState { state: 'starting' | 'started' | 'running' | 'stopped'; exitCode: number; }
-/// @type GameError { type: string; message: string; technical?: string; }
-/// @type NewsItem { title: string; excerpt?: string; url?: string; date?: string; publishedAt?: string; author?: string; imageUrl?: string; source?: string; }
+/// @type GameError { type: string; message: string; messageKey?: string; args?: unknown[]; technical?: string; code?: string; hintKey?: string; hint?: string; helpUrl?: string; }
+/// @type JavaRuntimeInfo { id: string; name: string; featureVersion: number; version?: string; vendor: string; homePath: string; javaPath: string; archiveSha256?: string; sourceUrl?: string; installedAt?: string; isDefault: boolean; isSystem: boolean; isInstalled: boolean; isCompatible: boolean; }
+/// @type NewsItem { title: string; excerpt?: string; url?: string; date?: string; publishedAt?: string; author?: string; imageUrl?: string; localImageUrl?: string; source?: string; sourceName?: string; }
+/// @type NewsFeed { items: NewsItem[]; fromCache: boolean; stale: boolean; fetchedAt?: string; }
+/// @type Announcement { id: string; severity: 'info' | 'warning' | 'critical'; title?: string; message: string; url?: string; minLauncherVersion?: string; maxLauncherVersion?: string; startsAt?: string; expiresAt?: string; dismissible: boolean; }
+/// @type NewsSourceInfo { id: string; name: string; type: 'hytale' | 'hyprism' | 'feed'; url?: string; enabled: boolean; }
 /// @type Profile { id: string; name: string; uuid?: string; isOfficial?: boolean; avatar?: string; folderName?: string; }
 /// @type HytaleAuthStatus { loggedIn: boolean; username?: string; uuid?: string; error?: string; errorType?: string; }
 /// @type ProfileSnapshot { nick: string; uuid: string; avatarPath?: string; }
-/// @type SettingsSnapshot { language: string; musicEnabled: boolean; launcherBranch: string; closeAfterLaunch: boolean; showDiscordAnnouncements: boolean; disableNews: boolean; backgroundMode: string; availableBackgrounds: string[]; accentColor: string; hasCompletedOnboarding: boolean; onlineMode: boolean; authDomain: string; dataDirectory: string; instanceDirectory: string; gpuPreference?: string; launchOnStartup?: boolean; minimizeToTray?: boolean; animations?: boolean; transparency?: boolean; resolution?: string; ramMb?: number; sound?: boolean; closeOnLaunch?: boolean; developerMode?: boolean; verboseLogging?: boolean; preRelease?: boolean; [key: string]: unknown; }
+/// @type NicknameValidationResult { valid: boolean; messageKey?: string; args?: unknown[]; message?: string; }
+/// @type SettingsSnapshot { language: string; musicEnabled: boolean; launcherBranch: string; closeAfterLaunch: boolean; minimizeOnLaunch: boolean; restoreOnGameExit: boolean; verifyFilesBeforeLaunch: boolean; showDiscordAnnouncements: boolean; disableNews: boolean; backgroundMode: string; availableBackgrounds: string[]; accentColor: string; hasCompletedOnboarding: boolean; onlineMode: boolean; authDomain: string; dataDirectory: string; instanceDirectory: string; gpuPreference?: string; proxyUrl?: string; meteredConnection?: boolean; meteredConfirmThresholdMb?: number; launchOnStartup?: boolean; minimizeToTray?: boolean; animations?: boolean; transparency?: boolean; resolution?: string; ramMb?: number; sound?: boolean; closeOnLaunch?: boolean; developerMode?: boolean; verboseLogging?: boolean; preRelease?: boolean; [key: string]: unknown; }
 /// @type ModScreenshot { id: number; title: string; thumbnailUrl: string; url: string; }
-/// @type ModInfo { id: string; name: string; slug: string; summary: string; author: string; downloadCount: number; iconUrl: string; thumbnailUrl: string; categories: string[]; dateUpdated: string; latestFileId: string; screenshots: ModScreenshot[]; }
-/// @type ModSearchResult { mods: ModInfo[]; totalCount: number; }
+/// @type ModInfo { id: string; name: string; slug: string; summary: string; author: string; authorId?: number | null; downloadCount: number; iconUrl: string; thumbnailUrl: string; categories: string[]; dateUpdated: string; latestFileId: string; screenshots: ModScreenshot[]; }
+/// @type ModSearchResult { mods: ModInfo[]; totalCount: number; messageKey?: string; args?: unknown[]; }
+/// @type ModAuthorResult { mods: ModInfo[]; totalCount: number; messageKey?: string; args?: unknown[]; authorId?: number | null; authorName: string; authorUrl: string; }
 /// @type ModFileInfo { id: string; modId: string; fileName: string; displayName: string; downloadUrl: string; fileLength: number; fileDate: string; releaseType: number; gameVersions: string[]; downloadCount: number; }
 /// @type ModFilesResult { files: ModFileInfo[]; totalCount: number; }
-/// @type ModCategory { id: number; name: string; slug: string; }
-/// @type InstalledMod { id: string; name: string; slug?: string; version?: string; fileId?: string; fileName?: string; enabled: boolean; author?: string; description?: string; iconUrl?: string; curseForgeId?: string; fileDate?: string; releaseType?: number; latestFileId?: string; latestVersion?: string; screenshots?: ModScreenshot[]; }
-/// @type SaveInfo { name: string; previewPath?: string; lastModified?: string; sizeBytes?: number; }
+/// @type ModCategory { id: number; name: string; slug: string; isClass: boolean; classId?: number | null; parentId?: number | null; children: ModCategory[]; }
+/// @type InstalledMod { id: string; name: string; slug?: string; version?: string; fileId?: string; fileName?: string; enabled: boolean; author?: string; description?: string; iconUrl?: string; curseForgeId?: string; fileDate?: string; releaseType?: number; latestFileId?: string; latestVersion?: string; screenshots?: ModScreenshot[]; dependencies?: string[] | null; gameVersions?: string[] | null; }
+/// @type ModBulkToggleProgress { instancePath: string; enabled: boolean; done: number; total: number; modName: string; }
+/// @type ModBulkToggleResult { changed: number; failed: number; }
+/// @type ModResolveResult { success: boolean; modId: string; fileId: string; name: string; messageKey?: string | null; }
+/// @type ModCompatibilityReport { instancePath: string; gameVersion: string; mods: InstalledMod[]; }
+/// @type ModUninstallImpact { modId: string; dependents: InstalledMod[]; orphans: InstalledMod[]; }
+/// @type ModUpdateCheckProgress { instancePath: string; checked: number; total: number; update?: InstalledMod; }
+/// @type GameSettings { files: string[]; renderDistance?: number; fieldOfView?: number; fullscreen?: boolean; vSync?: boolean; masterVolume?: number; musicVolume?: number; sfxVolume?: number; keybinds: Record<string, string>; }
+/// @type SettingsSyncConfig { enabled: boolean; sourceInstanceId: string; include: string[]; exclude: string[]; }
+/// @type DisplayServerSettings { mode: 'auto' | 'wayland' | 'x11'; supported: boolean; session?: 'wayland' | 'x11' | null; }
+/// @type LaunchCommand { executable: string; workingDirectory: string; arguments: string[]; environment: Record<string, string>; commandLine: string; }
+/// @type SaveInfo { name: string; path: string; previewPath?: string; lastModified?: string; sizeBytes?: number; instanceId: string; instanceName: string; branch: string; version: number; hasModSnapshot?: boolean; }
+/// @type CloudSyncWorld { instanceId: string; worldName: string; }
+/// @type CloudSyncConfig { enabled: boolean; backend: 'folder' | 'webdav'; folderPath: string; webDavUrl: string; webDavUsername: string; worlds: CloudSyncWorld[]; syncSkins: boolean; syncSettings: boolean; syncAfterPlaying: boolean; }
+/// @type CloudSyncConflict { key: string; kind: 'world' | 'skins' | 'settings'; name: string; localModifiedUtc?: string | null; remoteModifiedUtc?: string | null; }
+/// @type CloudSyncResult { success: boolean; finishedAt: string; uploaded: string[]; downloaded: string[]; conflicts: CloudSyncConflict[]; failed: Record<string, string>; error?: string | null; }
+/// @type InstanceBackupInfo { fileName: string; path: string; instanceId: string; createdAt: string; sizeBytes: number; }
+/// @type SearchResult { kind: 'instance' | 'world' | 'installedMod' | 'mod' | 'setting' | 'news'; id: string; title: string; titleKey?: string | null; subtitle?: string | null; instanceId?: string | null; target?: string | null; iconUrl?: string | null; score: number; }
+/// @type LauncherUpdateInfo { version: string; currentVersion: string; downloadUrl: string; assetName: string; releaseUrl: string; isBeta: boolean; }
+/// @type LauncherUpdateCheck { checkedAtUtc: string; launcherVersion: string; branch: string; update?: LauncherUpdateInfo | null; }
+/// @type BandwidthMonth { month: string; categories: Record<string, number>; totalBytes: number; }
+/// @type DownloadConfirmation { id: string; category: string; name: string; bytes: number; requestedAtUtc: string; }
+/// @type InstanceArtwork { icon?: string | null; banner?: string | null; }
+/// @type InstanceActivity { instanceId: string; name: string; branch: string; version: number; isFavorite: boolean; lastPlayedAt?: string | null; launchCount: number; }
+/// @type DashboardInstance { id: string; name: string; branch: string; version: number; isInstalled: boolean; pendingModUpdates?: number | null; }
+/// @type DashboardBranch { branch: string; latestVersion?: number | null; installedVersion?: number | null; }
+/// @type DashboardInfo { selectedInstance?: DashboardInstance | null; lastPlayed?: InstanceActivity | null; branches: DashboardBranch[]; isGameRunning: boolean; news: NewsItem[]; announcements: Announcement[]; }
+/// @type TrashEntry { id: string; kind: 'world' | 'instanceBackup'; name: string; instanceId: string; originalPath: string; deletedAt: string; sizeBytes: number; }
+/// @type WorldModEntry { id: string; name: string; curseForgeId: string; fileId: string; fileName: string; }
+/// @type WorldModSnapshotStatus { snapshot: { playedAt: string; mods: WorldModEntry[]; }; missing: WorldModEntry[]; changed: WorldModEntry[]; extra: WorldModEntry[]; matches: boolean; }
+/// @type WorldModRestoreResult { success: boolean; installed: number; enabled: number; disabled: number; failed: string[]; messageKey?: string | null; }
 /// @type AppConfig { language: string; dataDirectory: string; [key: string]: unknown; }
 /// @type InstanceValidationDetails { hasExecutable: boolean; hasAssets: boolean; hasLibraries: boolean; hasConfig: boolean; missingComponents: string[]; errorMessage?: string; }
-/// @type InstalledInstance { id: string; branch: string; version: number; path: string; hasUserData: boolean; userDataSize: number; totalSize: number; isValid: boolean; validationStatus?: 'Valid' | 'NotInstalled' | 'Corrupted' | 'Unknown'; validationDetails?: InstanceValidationDetails; customName?: string; }
-/// @type InstanceInfo { id: string; name: string; branch: string; version: number; isInstalled: boolean; }
+/// @type InstalledInstance { id: string; branch: string; version: number; path: string; hasUserData: boolean; userDataSize: number; totalSize: number; isValid: boolean; validationStatus?: 'Valid' | 'NotInstalled' | 'Corrupted' | 'Unknown'; validationDetails?: InstanceValidationDetails; customName?: string; icon?: string | null; banner?: string | null; }
+/// @type InstanceInfo { id: string; name: string; branch: string; version: number; isInstalled: boolean; icon?: string | null; banner?: string | null; }
 /// @type LanguageInfo { code: string; name: string; }
 /// @type GpuAdapterInfo { name: string; vendor: string; type: string; }
+/// @type PlatformComponentSupport { component: 'game' | 'butler' | 'jre' | 'temurin'; platform: string; artifactArch?: string; available: boolean; emulated: boolean; }
+/// @type SteamDeckStatus { isSteamDeck: boolean; isGamescope: boolean; gamepadModeSetting: 'auto' | 'on' | 'off'; gamepadMode: boolean; steamFound: boolean; shortcutAdded: boolean; }
+/// @type SkinHistoryEntry { id: string; savedAtUtc: string; avatar?: string; }
+/// @type DesktopShortcutResult { success: boolean; path?: string; error?: string; }
+/// @type SteamShortcutResult { success: boolean; usersAdded: number; restartSteam: boolean; error?: string; }
+/// @type NetworkStatus { state: 'ok' | 'offline' | 'captive_portal' | 'cdn_blocked'; messageKey?: string; blockedHosts: string[]; proxyConfigured: boolean; checkedAt: string; }
 /// @type VersionInfo { version: number; source: 'Official' | 'Mirror'; isLatest: boolean; }
 /// @type VersionListResponse { versions: VersionInfo[]; hasOfficialAccount: boolean; officialSourceAvailable: boolean; }
+/// @type OperationProgress { operationId: string; kind: string; stage: string; state: 'running' | 'completed' | 'failed' | 'cancelled'; progress: number; messageKey?: string; args?: unknown[]; downloadedBytes: number; totalBytes: number; bytesPerSecond: number; etaSeconds?: number; }
+/// @type BackgroundTask { id: string; kind: string; title: string; state: 'running' | 'completed' | 'failed' | 'cancelled'; progress: number; messageKey?: string; error?: string; canCancel: boolean; startedAt: string; finishedAt?: string; }
+/// @type ReplayedEvent { seq: number; channel: string; timestamp: string; payload: unknown; }
+/// @type SecondInstanceArgs { args: string[]; }
+/// @type LogLine { seq: number; source: 'launcher' | 'game'; timestamp: string; level: string; category: string; message: string; }
+/// @type CrashIssue { id: string; severity: 'error' | 'warning'; title: string; description: string; suggestions: string[]; evidence: string[]; }
+/// @type GraphicsWarning { id: 'no_driver' | 'software_renderer' | 'only_32bit' | 'vulkan_no_driver' | 'dx_feature_level' | 'tools_missing'; severity: 'error' | 'warning' | 'info'; message: string; }
+/// @type GraphicsDiagnostics { checkedAt: string; platform: string; vulkanAvailable?: boolean | null; vulkanVersion?: string | null; vulkanDevices: string[]; openGlAvailable?: boolean | null; openGlVersion?: string | null; openGlRenderer?: string | null; directXFeatureLevel?: string | null; warnings: GraphicsWarning[]; summary: string; }
+/// @type CrashDiagnosis { hasLog: boolean; source: 'session' | 'file'; logPath?: string; issues: CrashIssue[]; excerpt: string[]; graphics?: GraphicsDiagnostics | null; analyzedAt: string; }
+/// @type InstallQueueEntry { id: string; kind: 'install' | 'repair'; instanceId?: string; branch: string; version: number; state: 'running' | 'queued'; position: number; queuedAt: string; }
+/// @type GameFilesReport { hasManifest: boolean; version: number; deep: boolean; checkedFiles: number; missing: string[]; modified: string[]; patched: string[]; isIntact: boolean; }
+/// @type InstanceMigrationReport { dryRun: boolean; worldsCopied: string[]; worldsSkipped: string[]; modsCopied: string[]; modsSwitched: string[]; modsSkipped: string[]; incompatibleMods: { id: string; name: string; reason: string }[]; compatibilityChecked: boolean; settingsFiles: string[]; errors: string[]; }
+/// @type GameChangelog { branch: string; version: number; title: string; date: string; url: string; notes: string[]; summary: string; source: 'feed' | 'hytale'; }
+/// @type GameLogChunk { instanceId: string; file?: string; lines: string[]; ended: boolean; }
+/// @type GameStatsSample { instanceId?: string; pid: number; timestamp: string; cpuPercent: number; rssBytes: number; gpuPercent?: number; gpuMemoryBytes?: number; }
+/// @type GameSessionStats { instanceId?: string; instanceName?: string; pid: number; startedAt: string; endedAt?: string; sampleCount: number; averageCpuPercent: number; peakCpuPercent: number; peakRssBytes: number; peakGpuPercent?: number; peakGpuMemoryBytes?: number; enabledMods: string[]; }
+/// @type SafeModeDisabledMod { id: string; name: string; fileName: string; }
+/// @type SafeModeSession { instanceId: string; instancePath: string; kind: 'safe-mode' | 'subset'; startedAt: string; launched: boolean; disabledMods: SafeModeDisabledMod[]; }
+/// @type ModBisectMod { id: string; name: string; }
+/// @type ModBisectState { instanceId: string; instanceName: string; status: 'testing' | 'found'; step: number; launched: boolean; suspects: ModBisectMod[]; testing: ModBisectMod[]; cleared: ModBisectMod[]; culprit?: ModBisectMod; remainingSteps: number; startedAt: string; }
+/// @type LogPage { lines: LogLine[]; hasMore: boolean; latestSeq: number; }
+/// @type OnboardingState { isFirstRun: boolean; nickname: string; branch: string; language: string; instanceDirectory: string; defaultInstanceDirectory: string; }
+/// @type InstallLocation { path: string; kind: 'default' | 'current' | 'home' | 'drive'; freeBytes: number; totalBytes: number; hasEnoughSpace: boolean; isDefault: boolean; isRecommended: boolean; }
+/// @type TelemetryEvent { name: 'launcher_start' | 'game_launch' | 'error'; timestamp: string; category?: string; }
+/// @type TelemetryBatch { schemaVersion: number; installId: string; launcherVersion: string; os: string; arch: string; events: TelemetryEvent[]; }
+/// @type TelemetryStatus { enabled: boolean; endpoint: string; queuedEvents: number; }
+/// @type ErrorReport { schemaVersion: number; id: string; timestamp: string; kind: 'error' | 'crash'; launcherVersion: string; os: string; arch: string; osVersion: string; operation: string; code?: string; messageKey?: string; message: string; technical?: string; }
+/// @type ErrorReportingStatus { enabled: boolean; endpoint: string; pendingReports: number; }
+/// @type AutomationApiStatus { enabled: boolean; running: boolean; url: string; token: string; }
+/// @type QuickActionResult { action: string; success: boolean; message?: string; }
+/// @type QuickActionHotkey { action: string; accelerator: string; registered: boolean; }
+/// @type PlaytimeStatus { enabled: boolean; dailyLimitMinutes: number; allowedFrom: string; allowedUntil: string; warnMinutes: number; pinSet: boolean; playedTodayMinutes: number; remainingMinutes?: number | null; overrideUntil?: string | null; }
+/// @type InstallLogInfo { name: string; kind: string; branch: string; version: number; startedAt: string; sizeBytes: number; outcome: '' | 'success' | 'failed' | 'cancelled'; }
+/// @type PreReleaseWorldSource { instanceId: string; instanceName: string; worldCount: number; }
+/// @type PreReleaseNotice { releaseInstances: PreReleaseWorldSource[]; }
+/// @type PreReleaseAcknowledgeResult { backups: string[]; errors: string[]; }
+/// @type PlaytimeWarning { reason: 'dailyLimit' | 'allowedHours'; minutesLeft: number; terminating: boolean; }
+/// @type RecoveredItem { kind: 'install' | 'update' | 'staging' | 'download'; action: 'cleaned' | 'resume' | 'repair'; path: string; instanceId?: string; instanceName?: string; sizeBytes: number; }
+/// @type StartupRecoveryReport { checkedAt: string; items: RecoveredItem[]; freedBytes: number; }
+/// @type StartupCheckIssue { code: 'notWritable' | 'readOnlyMount' | 'cloudSync' | 'cloudOnlyFiles' | 'lowSpace' | 'lowInodes' | 'longPaths'; severity: 'error' | 'warning'; path: string; detail: string; value?: number; }
+/// @type StartupCheckReport { checkedAt: string; issues: StartupCheckIssue[]; hasErrors: boolean; }
+/// @type DefenderDetection { threatName: string; path: string; detectedAt?: string; action: 'quarantined' | 'removed' | 'blocked' | 'detected'; }
+/// @type DefenderReport { supported: boolean; defenderAvailable: boolean; realTimeProtection: boolean; controlledFolderAccess: boolean; detections: DefenderDetection[]; exclusionPaths: string[]; exclusionCommand: string; }
+/// @type OnboardingResult { success: boolean; messageKey?: string; args?: unknown[]; state?: OnboardingState; }
 public class IpcService
 {
     private readonly IServiceProvider _services;
+    private readonly IEventBus _events;
 
     private static readonly JsonSerializerOptions JsonOpts = new()
     {
@@ -70,9 +157,10 @@ public class IpcService
         Converters = { new JsonStringEnumConverter() }
     };
 
-    public IpcService(IServiceProvider services)
+    public IpcService(IServiceProvider services, IEventBus events)
     {
         _services = services;
+        _events = events;
     }
 
     private static BrowserWindow? GetMainWindow()
@@ -112,6 +200,15 @@ public class IpcService
         return raw;
     }
 
+    /// <summary>
+    /// Decodes an uploaded image, with or without a <c>data:image/...;base64,</c> prefix.
+    /// </summary>
+    private static byte[] DecodeImage(string base64)
+    {
+        var comma = base64.IndexOf(',');
+        return Convert.FromBase64String(comma >= 0 ? base64[(comma + 1)..] : base64);
+    }
+
     private static void Reply(string channel, object? data)
     {
         var win = GetMainWindow();
@@ -130,17 +227,46 @@ public class IpcService
     {
         Logger.Info("IPC", "Registering IPC handlers...");
 
+        // Every .NET → React event goes through the event bus so it can be replayed
+        _events.Published += (evt) =>
+        {
+            try { Reply(evt.Channel, evt.Payload); } catch { /* swallow */ }
+        };
+
         RegisterConfigHandlers();
         RegisterGameHandlers();
         RegisterInstanceHandlers();
+        RegisterJavaHandlers();
+        RegisterGameSettingsHandlers();
         RegisterNewsHandlers();
+        RegisterAnnouncementHandlers();
         RegisterProfileHandlers();
         RegisterAuthHandlers();
         RegisterSettingsHandlers();
+        RegisterOnboardingHandlers();
         RegisterLocalizationHandlers();
         RegisterWindowHandlers();
         RegisterModHandlers();
+        RegisterModBisectHandlers();
         RegisterSystemHandlers();
+        RegisterTaskHandlers();
+        RegisterProgressHandlers();
+        RegisterTelemetryHandlers();
+        RegisterErrorReportingHandlers();
+        RegisterAutomationHandlers();
+        RegisterCloudSyncHandlers();
+        RegisterTrashHandlers();
+        RegisterSearchHandlers();
+        RegisterDashboardHandlers();
+        RegisterStartupCheckHandlers();
+        RegisterLauncherUpdateHandlers();
+        RegisterMeteredConnectionHandlers();
+        RegisterQuickActionHandlers();
+        RegisterPlaytimeHandlers();
+        RegisterInstallLogHandlers();
+        RegisterPreReleaseHandlers();
+        RegisterSteamDeckHandlers();
+        RegisterEventHandlers();
         RegisterConsoleHandlers();
         RegisterFileDialogHandlers();
 
@@ -179,7 +305,13 @@ public class IpcService
 
     // #region Game Session
     // @ipc send hyprism:game:launch
+    // @ipc send hyprism:game:launchSafeMode
+    // @ipc invoke hyprism:game:safeModeSession -> SafeModeSession | null
     // @ipc send hyprism:game:cancel
+    // @ipc invoke hyprism:game:cancelInstall -> boolean
+    // @ipc invoke hyprism:game:installQueue -> InstallQueueEntry[]
+    // @ipc invoke hyprism:game:installQueueCancel -> boolean
+    // @ipc invoke hyprism:game:installQueueMove -> boolean
     // @ipc invoke hyprism:game:stop -> boolean
     // @ipc invoke hyprism:game:instances -> InstalledInstance[]
     // @ipc invoke hyprism:game:isRunning -> boolean
@@ -187,6 +319,15 @@ public class IpcService
     // @ipc event hyprism:game:progress -> ProgressUpdate
     // @ipc event hyprism:game:state -> GameState
     // @ipc event hyprism:game:error -> GameError
+    // @ipc invoke hyprism:game:analyzeCrash -> CrashDiagnosis
+    // @ipc invoke hyprism:game:startLogStream -> boolean
+    // @ipc send hyprism:game:stopLogStream
+    // @ipc event hyprism:game:logStream -> GameLogChunk
+    // @ipc event hyprism:game:installQueueChanged -> InstallQueueEntry[]
+    // @ipc event hyprism:game:stats -> GameStatsSample
+    // @ipc event hyprism:game:versionsChanged -> { branch: string; versions: number[] }
+    // @ipc invoke hyprism:game:sessionStats -> GameSessionStats | null
+    // @ipc invoke hyprism:game:statsHistory -> GameSessionStats[]
 
     private void RegisterGameHandlers()
     {
@@ -196,22 +337,47 @@ public class IpcService
         var gameProcessService = _services.GetRequiredService<IGameProcessService>();
         var versionService = _services.GetRequiredService<IVersionService>();
         var configService = _services.GetRequiredService<IConfigService>();
+        var crashAnalyzer = _services.GetRequiredService<ICrashAnalyzerService>();
+        var gameLogStream = _services.GetRequiredService<IGameLogStreamService>();
+        var changelogService = _services.GetRequiredService<IChangelogService>();
+        var gameStats = _services.GetRequiredService<IGameStatsService>();
+        var safeMode = _services.GetRequiredService<ISafeModeService>();
 
         // Push events from .NET → React
         progressService.DownloadProgressChanged += (msg) =>
         {
-            try { Reply("hyprism:game:progress", msg); } catch { /* swallow */ }
+            _events.Publish(IpcEvents.GameProgress, msg);
         };
 
         progressService.GameStateChanged += (state, exitCode) =>
         {
             Logger.Info("IPC", $"Sending game-state event: state={state}, exitCode={exitCode}");
-            try { Reply("hyprism:game:state", new { state, exitCode }); } catch { /* swallow */ }
+            _events.Publish(IpcEvents.GameState, new { state, exitCode });
+        };
+
+        progressService.ErrorOccurred += (error) =>
+        {
+            _events.Publish(IpcEvents.GameError, error);
+        };
+
+        versionService.VersionsChanged += (branch, versions) =>
+        {
+            _events.Publish(IpcEvents.GameVersionsChanged, new { branch, versions });
+        };
+
+        gameLogStream.ChunkReceived += (chunk) =>
+        {
+            _events.Publish(IpcEvents.GameLogStream, chunk);
+        };
+
+        gameSession.InstallQueueChanged += (queue) =>
+        {
+            _events.Publish(IpcEvents.InstallQueueChanged, queue);
         };
 
-        progressService.ErrorOccurred += (type, message, technical) =>
+        gameStats.SampleTaken += (sample) =>
         {
-            try { Reply("hyprism:game:error", new { type, message, technical }); } catch { /* swallow */ }
+            _events.Publish(IpcEvents.GameStats, sample);
         };
 
         Electron.IpcMain.On("hyprism:game:launch", async (args) =>
@@ -223,7 +389,8 @@ public class IpcService
                 return;
             }
             
-            // Optionally accept branch and version to launch a specific instance
+            // Optionally accept an instance ID, or a branch and version, to launch a specific instance
+            string? instanceId = null;
             if (args != null)
             {
                 try
@@ -232,36 +399,132 @@ public class IpcService
                     var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
                     if (data != null)
                     {
-                        if (data.TryGetValue("branch", out var branchEl))
+                        if (data.TryGetValue("instanceId", out var idEl))
+                            instanceId = idEl.GetString();
+
+                        if (string.IsNullOrEmpty(instanceId) && data.TryGetValue("branch", out var branchEl))
                         {
                             var branchValue = branchEl.GetString() ?? "release";
-                            #pragma warning disable CS0618 // Backward compatibility: VersionType kept for migration
-                            configService.Configuration.VersionType = branchValue;
-                            #pragma warning restore CS0618
+                            var versionValue = data.TryGetValue("version", out var versionEl) ? versionEl.GetInt32() : 0;
                             configService.Configuration.LauncherBranch = branchValue;
-                        }
-                        if (data.TryGetValue("version", out var versionEl))
-                        {
-                            #pragma warning disable CS0618 // Backward compatibility: SelectedVersion kept for migration
-                            configService.Configuration.SelectedVersion = versionEl.GetInt32();
-                            #pragma warning restore CS0618
+
+                            // The instance installed for that branch and version, if there is one
+                            var path = instanceService.FindExistingInstancePath(branchValue, versionValue);
+                            instanceId = path != null ? instanceService.GetInstanceMeta(path)?.Id : null;
+                            if (string.IsNullOrEmpty(instanceId))
+                            {
+                                #pragma warning disable CS0618 // Backward compatibility: VersionType and SelectedVersion kept for migration
+                                configService.Configuration.VersionType = branchValue;
+                                configService.Configuration.SelectedVersion = versionValue;
+                                #pragma warning restore CS0618
+                                instanceService.SetSelectedInstance("");
+                            }
                         }
                     }
                 }
                 catch { /* ignore parsing errors, use current config */ }
             }
             
-            Logger.Info("IPC", "Game launch requested");
-            try { await gameSession.DownloadAndLaunchAsync(); }
+            Logger.Info("IPC", string.IsNullOrEmpty(instanceId) ? "Game launch requested" : $"Game launch requested for {instanceId}");
+            try
+            {
+                if (string.IsNullOrEmpty(instanceId)) await gameSession.DownloadAndLaunchAsync();
+                else await gameSession.LaunchInstanceAsync(instanceId);
+            }
             catch (Exception ex) { Logger.Error("IPC", $"Game launch failed: {ex.Message}"); }
         });
 
+        Electron.IpcMain.On("hyprism:game:launchSafeMode", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
+                var instanceId = data != null && data.TryGetValue("instanceId", out var idEl) ? idEl.GetString() ?? "" : "";
+                if (string.IsNullOrEmpty(instanceId))
+                {
+                    Logger.Warning("IPC", "Safe mode launch request without an instance ID");
+                    return;
+                }
+
+                Logger.Info("IPC", $"Safe mode launch requested for {instanceId}");
+                var result = await safeMode.LaunchSafeModeAsync(instanceId);
+                if (!string.IsNullOrEmpty(result.Error))
+                    Logger.Warning("IPC", $"Safe mode launch ended: {result.Error}");
+            }
+            catch (Exception ex) { Logger.Error("IPC", $"Safe mode launch failed: {ex.Message}"); }
+        });
+
+        Electron.IpcMain.On("hyprism:game:safeModeSession", (_) =>
+        {
+            Reply("hyprism:game:safeModeSession:reply", safeMode.GetActiveSession());
+        });
+
         Electron.IpcMain.On("hyprism:game:cancel", (_) =>
         {
             Logger.Info("IPC", "Game download cancel requested");
             gameSession.CancelDownload();
         });
 
+        Electron.IpcMain.On("hyprism:game:cancelInstall", (_) =>
+        {
+            try
+            {
+                var cancelled = gameSession.CancelInstall();
+                Logger.Info("IPC", cancelled ? "Game install cancel requested" : "Game install cancel requested but nothing is installing");
+                Reply("hyprism:game:cancelInstall:reply", cancelled);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to cancel install: {ex.Message}");
+                Reply("hyprism:game:cancelInstall:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:game:installQueue", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:game:installQueue:reply", gameSession.GetInstallQueue());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get install queue: {ex.Message}");
+                Reply("hyprism:game:installQueue:reply", new List<InstallQueueEntry>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:game:installQueueCancel", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var id = doc.RootElement.GetProperty("id").GetString() ?? "";
+                Reply("hyprism:game:installQueueCancel:reply", gameSession.CancelQueuedInstall(id));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to cancel queued install: {ex.Message}");
+                Reply("hyprism:game:installQueueCancel:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:game:installQueueMove", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var id = doc.RootElement.GetProperty("id").GetString() ?? "";
+                var position = doc.RootElement.GetProperty("position").GetInt32();
+                Reply("hyprism:game:installQueueMove:reply", gameSession.MoveQueuedInstall(id, position));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to move queued install: {ex.Message}");
+                Reply("hyprism:game:installQueueMove:reply", false);
+            }
+        });
+
         Electron.IpcMain.On("hyprism:game:stop", (_) =>
         {
             try
@@ -277,6 +540,63 @@ public class IpcService
             }
         });
 
+        Electron.IpcMain.On("hyprism:game:analyzeCrash", (_) =>
+        {
+            try
+            {
+                var diagnosis = crashAnalyzer.AnalyzeLastCrash();
+                Reply("hyprism:game:analyzeCrash:reply", diagnosis);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Crash analysis failed: {ex.Message}");
+                Reply("hyprism:game:analyzeCrash:reply", new CrashDiagnosis());
+            }
+        });
+
+        // Follow the instance's session log until the game exits; lines arrive as hyprism:game:logStream
+        Electron.IpcMain.On("hyprism:game:startLogStream", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.TryGetProperty("instanceId", out var id) ? id.GetString() : null;
+                if (string.IsNullOrEmpty(instanceId))
+                {
+                    instanceId = instanceService.GetSelectedInstance()?.Id;
+                }
+                Reply("hyprism:game:startLogStream:reply", !string.IsNullOrEmpty(instanceId) && gameLogStream.Start(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to start game log stream: {ex.Message}");
+                Reply("hyprism:game:startLogStream:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:game:stopLogStream", (_) =>
+        {
+            gameLogStream.Stop();
+        });
+
+        Electron.IpcMain.On("hyprism:game:sessionStats", (_) =>
+        {
+            Reply("hyprism:game:sessionStats:reply", gameStats.GetCurrentSession());
+        });
+
+        Electron.IpcMain.On("hyprism:game:statsHistory", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:game:statsHistory:reply", gameStats.GetSessionHistory());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read game stats history: {ex.Message}");
+                Reply("hyprism:game:statsHistory:reply", Array.Empty<GameSessionStats>());
+            }
+        });
+
         Electron.IpcMain.On("hyprism:game:instances", (_) =>
         {
             try
@@ -305,6 +625,7 @@ public class IpcService
             }
         });
 
+        // { branch?, cached? }: cached returns the last known list at once and refreshes it in the background
         Electron.IpcMain.On("hyprism:game:versions", async (args) =>
         {
             try
@@ -312,16 +633,27 @@ public class IpcService
                 #pragma warning disable CS0618 // Backward compatibility: VersionType kept for migration
                 string branch = configService.Configuration.VersionType ?? "release";
                 #pragma warning restore CS0618
+                var cached = false;
                 if (args != null)
                 {
-                    var json = ArgsToJson(args);
-                    var data = JsonSerializer.Deserialize<Dictionary<string, string>>(json, JsonOpts);
-                    if (data != null && data.TryGetValue("branch", out var b) && !string.IsNullOrEmpty(b))
+                    using var doc = JsonDocument.Parse(ArgsToJson(args));
+                    var root = doc.RootElement;
+                    if (root.ValueKind == JsonValueKind.Object)
                     {
-                        branch = b;
+                        if (root.TryGetProperty("branch", out var b) && b.ValueKind == JsonValueKind.String && !string.IsNullOrEmpty(b.GetString()))
+                        {
+                            branch = b.GetString()!;
+                        }
+                        cached = root.TryGetProperty("cached", out var c) && c.ValueKind == JsonValueKind.True;
                     }
                 }
-                
+
+                if (cached && versionService.TryGetLastKnownVersions(branch, out var lastKnown))
+                {
+                    Reply("hyprism:game:versions:reply", lastKnown);
+                    return;
+                }
+
                 var versions = await versionService.GetVersionListAsync(branch);
                 Logger.Info("IPC", $"Returning {versions.Count} available versions for branch {branch}");
                 Reply("hyprism:game:versions:reply", versions);
@@ -362,6 +694,55 @@ public class IpcService
                 Reply("hyprism:game:versionsWithSources:reply", new { versions = new List<object>(), hasOfficialAccount = false, officialSourceAvailable = false });
             }
         });
+
+        // Drop cached version lists for a branch and query every source again
+        // @ipc invoke hyprism:game:refreshVersions -> VersionListResponse 30000
+        Electron.IpcMain.On("hyprism:game:refreshVersions", async (args) =>
+        {
+            try
+            {
+                #pragma warning disable CS0618 // Backward compatibility: VersionType kept for migration
+                string branch = configService.Configuration.VersionType ?? "release";
+                #pragma warning restore CS0618
+                if (args != null)
+                {
+                    var json = ArgsToJson(args);
+                    var data = JsonSerializer.Deserialize<Dictionary<string, string>>(json, JsonOpts);
+                    if (data != null && data.TryGetValue("branch", out var b) && !string.IsNullOrEmpty(b))
+                    {
+                        branch = b;
+                    }
+                }
+
+                await versionService.ForceRefreshCacheAsync(branch);
+                var response = await versionService.GetVersionListWithSourcesAsync(branch);
+                Logger.Info("IPC", $"Refreshed versions for branch {branch}: {response.Versions.Count} available");
+                Reply("hyprism:game:refreshVersions:reply", response);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to refresh versions: {ex.Message}");
+                Reply("hyprism:game:refreshVersions:reply", new { versions = new List<object>(), hasOfficialAccount = false, officialSourceAvailable = false });
+            }
+        });
+
+        // Patch notes of one version, for the version picker ({ branch, version })
+        // @ipc invoke hyprism:game:changelog -> GameChangelog | null 20000
+        Electron.IpcMain.On("hyprism:game:changelog", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var branch = doc.RootElement.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
+                var version = doc.RootElement.GetProperty("version").GetInt32();
+                Reply("hyprism:game:changelog:reply", await changelogService.GetGameChangelogAsync(branch, version));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get changelog: {ex.Message}");
+                Reply("hyprism:game:changelog:reply", null);
+            }
+        });
     }
     // #endregion
 
@@ -373,16 +754,68 @@ public class IpcService
     // @ipc invoke hyprism:instance:export -> string
     // @ipc invoke hyprism:instance:import -> boolean
     // @ipc invoke hyprism:instance:saves -> SaveInfo[]
+    // @ipc invoke hyprism:instance:allSaves -> SaveInfo[]
     // @ipc send hyprism:instance:openSaveFolder
+    // @ipc invoke hyprism:instance:deleteSave -> boolean
+    // @ipc invoke hyprism:instance:duplicateSave -> string | null 600000
+    // @ipc invoke hyprism:instance:officialSaves -> { dirs: string[], saves: SaveInfo[] }
+    // @ipc invoke hyprism:instance:importSaves -> string[] 600000
+    // @ipc invoke hyprism:instance:backup -> InstanceBackupInfo | null 600000
+    // @ipc invoke hyprism:instance:backups -> InstanceBackupInfo[]
+    // @ipc invoke hyprism:instance:restoreBackup -> boolean 600000
+    // @ipc invoke hyprism:instance:deleteBackup -> boolean
+    // @ipc invoke hyprism:instance:saveModSnapshot -> WorldModSnapshotStatus | null
+    // @ipc invoke hyprism:instance:restoreSaveMods -> WorldModRestoreResult 300000
+    // @ipc invoke hyprism:instance:recent -> InstanceActivity[]
+    // @ipc invoke hyprism:instance:favorites -> InstanceActivity[]
+    // @ipc invoke hyprism:instance:setFavorite -> boolean
     // @ipc invoke hyprism:instance:getIcon -> string | null
+    // @ipc invoke hyprism:instance:getArtwork -> InstanceArtwork
+    // @ipc invoke hyprism:instance:setArtwork -> boolean
+    // @ipc invoke hyprism:instance:bundledArtwork -> string[]
     // @ipc invoke hyprism:instance:select -> boolean
     // @ipc invoke hyprism:instance:getSelected -> InstanceInfo | null
     // @ipc invoke hyprism:instance:list -> InstanceInfo[]
+    // @ipc invoke hyprism:instance:verifyFiles -> GameFilesReport | null 600000
+    // @ipc invoke hyprism:instance:repairFiles -> boolean
+    // @ipc invoke hyprism:instance:migrate -> InstanceMigrationReport | null 600000
+    // @ipc invoke hyprism:instance:adopt -> { success: boolean, instance?: InstanceInfo, error?: string } 600000
+    // @ipc invoke hyprism:instance:getExtraArgs -> string
+    // @ipc invoke hyprism:instance:setExtraArgs -> boolean
+    // @ipc invoke hyprism:instance:getDisplayServer -> DisplayServerSettings
+    // @ipc invoke hyprism:instance:setDisplayServer -> { success: boolean, error?: string }
+    // @ipc invoke hyprism:instance:getGameVersion -> string | null
+    // @ipc invoke hyprism:instance:setGameVersion -> boolean
+    // @ipc invoke hyprism:instance:launchPreview -> LaunchCommand | null
+    // @ipc invoke hyprism:instance:exportLaunchScript -> { path?: string, error?: string }
+    // @ipc invoke hyprism:instance:createShortcut -> DesktopShortcutResult
+    // @ipc invoke hyprism:instance:recoveryReport -> StartupRecoveryReport
 
     private void RegisterInstanceHandlers()
     {
         var instanceService = _services.GetRequiredService<IInstanceService>();
+        var gameSession = _services.GetRequiredService<IGameSessionService>();
+        var gameSettings = _services.GetRequiredService<IGameSettingsService>();
+        var migrationService = _services.GetRequiredService<IInstanceMigrationService>();
         var fileService = _services.GetRequiredService<IFileService>();
+        var taskManager = _services.GetRequiredService<ITaskManagerService>();
+        var progressService = _services.GetRequiredService<IProgressNotificationService>();
+        var worldService = _services.GetRequiredService<IWorldService>();
+        var worldMods = _services.GetRequiredService<IWorldModSnapshotService>();
+        var instanceBackups = _services.GetRequiredService<IInstanceBackupService>();
+        var activity = _services.GetRequiredService<IInstanceActivityService>();
+        var gameLauncher = _services.GetRequiredService<IGameLauncher>();
+        var artworkService = _services.GetRequiredService<IInstanceArtworkService>();
+
+        // World handlers take { instanceId }; { branch, version } from older callers maps to the first matching instance
+        string? WorldInstanceId(Dictionary<string, JsonElement>? data)
+        {
+            if (data?.TryGetValue("instanceId", out var id) == true && !string.IsNullOrEmpty(id.GetString()))
+                return id.GetString();
+            if (data?.TryGetValue("branch", out var b) != true || data.TryGetValue("version", out var v) != true)
+                return null;
+            return instanceService.FindInstanceByBranchAndVersion(b.GetString() ?? "release", v.GetInt32())?.Id;
+        }
 
         // Create an instance with generated ID
         Electron.IpcMain.On("hyprism:instance:create", (args) =>
@@ -398,6 +831,14 @@ public class IpcService
 
                 // Create the instance with generated ID
                 var meta = instanceService.CreateInstanceMeta(branch, version, customName, isLatest);
+                try
+                {
+                    gameSettings.ApplySettingsSync(meta.Id);
+                }
+                catch (Exception syncEx)
+                {
+                    Logger.Warning("IPC", $"Settings sync failed for new instance {meta.Id}: {syncEx.Message}");
+                }
                 
                 Logger.Success("IPC", $"Created instance {meta.Id} ({meta.Name})");
                 Reply("hyprism:instance:create:reply", new {
@@ -472,16 +913,20 @@ public class IpcService
                     // Check installation status for each instance
                     var instancePath = instanceService.GetInstancePathById(i.Id);
                     bool isInstalled = false;
+                    var artwork = new InstanceArtwork();
                     if (!string.IsNullOrEmpty(instancePath))
                     {
                         isInstalled = instanceService.IsClientPresent(instancePath);
+                        artwork = InstanceArtworkService.Resolve(instancePath, instanceService.GetInstanceMeta(instancePath));
                     }
                     return (object)new {
                         id = i.Id,
                         name = i.Name,
                         branch = i.Branch,
                         version = i.Version,
-                        isInstalled = isInstalled
+                        isInstalled = isInstalled,
+                        icon = artwork.Icon,
+                        banner = artwork.Banner
                     };
                 }).ToList() ?? new List<object>();
                 
@@ -494,6 +939,112 @@ public class IpcService
             }
         });
 
+        // Check game files against the manifest recorded at install
+        Electron.IpcMain.On("hyprism:instance:verifyFiles", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                var deep = doc.RootElement.TryGetProperty("deep", out var deepEl) && deepEl.ValueKind == JsonValueKind.True;
+                var instancePath = instanceService.GetInstancePathById(instanceId);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Reply("hyprism:instance:verifyFiles:reply", null);
+                    return;
+                }
+
+                var report = await Task.Run(() => GameFilesIntegrity.Verify(instancePath, deep));
+                Logger.Info("IPC", $"Verified {report.CheckedFiles} game files of {instanceId}: {report.Missing.Count} missing, {report.Modified.Count} modified");
+                Reply("hyprism:instance:verifyFiles:reply", report);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to verify game files: {ex.Message}");
+                Reply("hyprism:instance:verifyFiles:reply", null);
+            }
+        });
+
+        // Register a game copy extracted elsewhere ({ path, branch, version, mode: 'move' | 'copy' | 'link', name? })
+        Electron.IpcMain.On("hyprism:instance:adopt", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var path = root.GetProperty("path").GetString() ?? "";
+                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
+                var version = root.TryGetProperty("version", out var v) && v.ValueKind == JsonValueKind.Number ? v.GetInt32() : 0;
+                var mode = root.TryGetProperty("mode", out var m) ? m.GetString() ?? "move" : "move";
+                var name = root.TryGetProperty("name", out var n) ? n.GetString() : null;
+
+                var meta = await Task.Run(() => instanceService.AdoptExistingInstall(path, branch, version, mode, name));
+                Reply("hyprism:instance:adopt:reply", new
+                {
+                    success = true,
+                    instance = new { id = meta.Id, name = meta.Name, branch = meta.Branch, version = meta.Version, isInstalled = true }
+                });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to adopt game install: {ex.Message}");
+                Reply("hyprism:instance:adopt:reply", new { success = false, error = ex.Message });
+            }
+        });
+
+        // Copy worlds, mods and settings to another instance ({ sourceId, targetId, worlds?, mods?, settings?, overwriteWorlds?, gameVersion?, dryRun? })
+        Electron.IpcMain.On("hyprism:instance:migrate", async (args) =>
+        {
+            try
+            {
+                var options = JsonSerializer.Deserialize<InstanceMigrationOptions>(ArgsToJson(args), JsonOpts);
+                if (options == null)
+                {
+                    Reply("hyprism:instance:migrate:reply", null);
+                    return;
+                }
+                Reply("hyprism:instance:migrate:reply", await migrationService.MigrateAsync(options));
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Migration not started: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:migrate:reply", null);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to migrate instance: {ex.Message}");
+                Reply("hyprism:instance:migrate:reply", null);
+            }
+        });
+
+        // Queue a repair; progress arrives on hyprism:game:progress like an install
+        Electron.IpcMain.On("hyprism:instance:repairFiles", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                if (string.IsNullOrEmpty(instanceService.GetInstancePathById(instanceId)))
+                {
+                    Reply("hyprism:instance:repairFiles:reply", false);
+                    return;
+                }
+
+                _ = Task.Run(async () =>
+                {
+                    var result = await gameSession.RepairInstanceAsync(instanceId);
+                    Logger.Info("IPC", $"Repair of {instanceId} finished: {(result.Success ? "ok" : result.Error)}");
+                });
+                Reply("hyprism:instance:repairFiles:reply", true);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to start repair: {ex.Message}");
+                Reply("hyprism:instance:repairFiles:reply", false);
+            }
+        });
+
         // Delete an instance
         Electron.IpcMain.On("hyprism:instance:delete", (args) =>
         {
@@ -508,6 +1059,12 @@ public class IpcService
                 Logger.Info("IPC", $"Deleted instance {branch}/{version}: {result}");
                 Reply("hyprism:instance:delete:reply", result);
             }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Instance not deleted: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:delete:reply", false);
+            }
             catch (Exception ex)
             {
                 Logger.Error("IPC", $"Failed to delete instance: {ex.Message}");
@@ -645,13 +1202,26 @@ public class IpcService
                 if (!savePath.EndsWith(".zip", StringComparison.OrdinalIgnoreCase))
                     savePath += ".zip";
 
-                // Create zip
+                // Create zip; the lock keeps installs and mod changes out while it is written
+                using var backupLock = InstanceLock.Acquire(instancePath, InstanceLock.Backup, "exporting the instance");
                 if (File.Exists(savePath)) File.Delete(savePath);
-                ZipFile.CreateFromDirectory(instancePath, savePath, CompressionLevel.Optimal, false);
+                await taskManager.RunAsync("instance-export", $"Export {Path.GetFileName(savePath)}", _ => Task.Run(() =>
+                {
+                    ZipFile.CreateFromDirectory(instancePath, savePath, CompressionLevel.Optimal, false);
+                    using (var zip = ZipFile.Open(savePath, ZipArchiveMode.Update))
+                        zip.GetEntry(InstanceLock.FileName)?.Delete();
+                    return true;
+                }), cancellable: false);
                 
                 Logger.Success("IPC", $"Exported instance to: {savePath}");
                 Reply("hyprism:instance:export:reply", savePath);
             }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Instance not exported: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:export:reply", "");
+            }
             catch (Exception ex)
             {
                 Logger.Error("IPC", $"Failed to export instance: {ex.Message}");
@@ -738,6 +1308,7 @@ public class IpcService
                 try { Directory.Delete(tempDir, true); } catch { /* ignore */ }
                 
                 Logger.Success("IPC", $"Imported instance to: {targetPath}");
+                LongPaths.WarnIfTooLong(targetPath, "Instance import");
                 Reply("hyprism:instance:import:reply", true);
             }
             catch (Exception ex)
@@ -752,43 +1323,11 @@ public class IpcService
         {
             try
             {
-                var json = ArgsToJson(args);
-                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
-                var branch = data?["branch"].GetString() ?? "release";
-                var version = data?["version"].GetInt32() ?? 0;
-                
-                var instancePath = instanceService.GetInstancePath(branch, version);
-                var savesPath = Path.Combine(instancePath, "UserData", "Saves");
-                
-                var saves = new List<object>();
-                
-                if (Directory.Exists(savesPath))
-                {
-                    foreach (var saveDir in Directory.GetDirectories(savesPath))
-                    {
-                        var dirInfo = new DirectoryInfo(saveDir);
-                        var previewPath = Path.Combine(saveDir, "preview.png");
-                        
-                        // Calculate total size
-                        long sizeBytes = 0;
-                        try
-                        {
-                            sizeBytes = dirInfo.EnumerateFiles("*", SearchOption.AllDirectories).Sum(f => f.Length);
-                        }
-                        catch { /* ignore */ }
-
-                        saves.Add(new
-                        {
-                            name = dirInfo.Name,
-                            path = saveDir,
-                            previewPath = File.Exists(previewPath) ? $"file://{previewPath.Replace("\\", "/")}" : null,
-                            lastModified = dirInfo.LastWriteTime.ToString("o"),
-                            sizeBytes
-                        });
-                    }
-                }
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var saves = instanceId == null ? [] : worldService.GetWorlds(instanceId);
                 
-                Logger.Info("IPC", $"Found {saves.Count} saves for {branch}/{version}");
+                Logger.Info("IPC", $"Found {saves.Count} saves for instance {instanceId}");
                 Reply("hyprism:instance:saves:reply", saves);
             }
             catch (Exception ex)
@@ -798,21 +1337,31 @@ public class IpcService
             }
         });
 
-        // Open save folder
-        Electron.IpcMain.On("hyprism:instance:openSaveFolder", (args) =>
+        // Get saves of every instance, tagged with their instance
+        Electron.IpcMain.On("hyprism:instance:allSaves", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
-                var branch = data?["branch"].GetString() ?? "release";
-                var version = data?["version"].GetInt32() ?? 0;
-                var saveName = data?["saveName"].GetString() ?? "";
-                
-                var instancePath = instanceService.GetInstancePath(branch, version);
-                var savePath = Path.Combine(instancePath, "UserData", "Saves", saveName);
+                Reply("hyprism:instance:allSaves:reply", worldService.GetAllWorlds());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get saves: {ex.Message}");
+                Reply("hyprism:instance:allSaves:reply", new List<object>());
+            }
+        });
+
+        // Open save folder
+        Electron.IpcMain.On("hyprism:instance:openSaveFolder", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var saveName = data?["saveName"].GetString() ?? "";
                 
-                if (Directory.Exists(savePath))
+                var savePath = instanceId == null ? null : worldService.GetWorldPath(instanceId, saveName);
+                if (savePath != null)
                 {
                     fileService.OpenFolder(savePath);
                     Logger.Info("IPC", $"Opened save folder: {savePath}");
@@ -829,47 +1378,281 @@ public class IpcService
         {
             try
             {
-                var json = ArgsToJson(args);
-                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
-                var branch = data?["branch"].GetString() ?? "release";
-                var version = data?["version"].GetInt32() ?? 0;
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
                 var saveName = data?["saveName"].GetString() ?? "";
 
-                if (string.IsNullOrWhiteSpace(saveName))
-                {
-                    Reply("hyprism:instance:deleteSave:reply", false);
-                    return;
-                }
+                Reply("hyprism:instance:deleteSave:reply", instanceId != null && worldService.DeleteWorld(instanceId, saveName));
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"World not deleted: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:deleteSave:reply", false);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to delete save folder: {ex.Message}");
+                Reply("hyprism:instance:deleteSave:reply", false);
+            }
+        });
 
-                var instancePath = instanceService.GetInstancePath(branch, version);
-                var savesPath = Path.GetFullPath(Path.Combine(instancePath, "UserData", "Saves"));
-                var targetSavePath = Path.GetFullPath(Path.Combine(savesPath, saveName));
+        // Copy a world within its instance
+        Electron.IpcMain.On("hyprism:instance:duplicateSave", async (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var saveName = data?["saveName"].GetString() ?? "";
+                var newName = data?["newName"].GetString() ?? "";
 
-                if (!targetSavePath.StartsWith(savesPath, StringComparison.OrdinalIgnoreCase))
-                {
-                    Logger.Warning("IPC", $"Blocked save delete outside saves directory: {targetSavePath}");
-                    Reply("hyprism:instance:deleteSave:reply", false);
-                    return;
-                }
+                var copy = instanceId == null
+                    ? null
+                    : await taskManager.RunAsync("world-duplicate", $"Duplicate {saveName}",
+                        _ => Task.Run(() => worldService.DuplicateWorld(instanceId, saveName, newName)), cancellable: false);
+                Reply("hyprism:instance:duplicateSave:reply", copy);
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"World not duplicated: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:duplicateSave:reply", null);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to duplicate world: {ex.Message}");
+                Reply("hyprism:instance:duplicateSave:reply", null);
+            }
+        });
 
-                if (!Directory.Exists(targetSavePath))
+        // Find worlds of the official launcher, or of a chosen saves folder
+        Electron.IpcMain.On("hyprism:instance:officialSaves", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var savesDir = data != null && data.TryGetValue("savesDir", out var d) && d.ValueKind == JsonValueKind.String
+                    ? d.GetString()
+                    : null;
+                Reply("hyprism:instance:officialSaves:reply", new
                 {
-                    Reply("hyprism:instance:deleteSave:reply", false);
-                    return;
-                }
+                    dirs = string.IsNullOrWhiteSpace(savesDir) ? worldService.GetOfficialSavesDirs() : [savesDir],
+                    saves = worldService.FindOfficialWorlds(savesDir)
+                });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to find official launcher saves: {ex.Message}");
+                Reply("hyprism:instance:officialSaves:reply", new { dirs = new List<string>(), saves = new List<object>() });
+            }
+        });
 
-                Directory.Delete(targetSavePath, true);
-                Logger.Info("IPC", $"Deleted save folder: {targetSavePath}");
-                Reply("hyprism:instance:deleteSave:reply", true);
+        // Copy worlds from another saves folder into an instance
+        Electron.IpcMain.On("hyprism:instance:importSaves", async (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var sourceDir = data?["sourceDir"].GetString() ?? "";
+                var saveNames = data != null && data.TryGetValue("saveNames", out var n) && n.ValueKind == JsonValueKind.Array
+                    ? n.EnumerateArray().Select(e => e.GetString() ?? "").ToList()
+                    : [];
+
+                var imported = instanceId == null
+                    ? []
+                    : await taskManager.RunAsync("world-import", "Import worlds",
+                        _ => Task.Run(() => worldService.ImportWorlds(instanceId, sourceDir, saveNames)), cancellable: false);
+                Reply("hyprism:instance:importSaves:reply", imported);
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Worlds not imported: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:importSaves:reply", new List<string>());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Failed to delete save folder: {ex.Message}");
-                Reply("hyprism:instance:deleteSave:reply", false);
+                Logger.Error("IPC", $"Failed to import worlds: {ex.Message}");
+                Reply("hyprism:instance:importSaves:reply", new List<string>());
+            }
+        });
+
+        // Back up worlds, mods and settings of an instance in one archive
+        Electron.IpcMain.On("hyprism:instance:backup", async (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var backup = instanceId == null
+                    ? null
+                    : await taskManager.RunAsync("instance-backup", "Back up instance",
+                        _ => instanceBackups.BackupInstanceAsync(instanceId), cancellable: false);
+                Reply("hyprism:instance:backup:reply", backup);
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Instance not backed up: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:backup:reply", null);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to back up instance: {ex.Message}");
+                Reply("hyprism:instance:backup:reply", null);
+            }
+        });
+
+        // List full backups of an instance
+        Electron.IpcMain.On("hyprism:instance:backups", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                Reply("hyprism:instance:backups:reply", instanceId == null ? [] : instanceBackups.GetInstanceBackups(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list instance backups: {ex.Message}");
+                Reply("hyprism:instance:backups:reply", new List<object>());
+            }
+        });
+
+        // Restore a full backup over the instance's UserData and settings
+        Electron.IpcMain.On("hyprism:instance:restoreBackup", async (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var fileName = data?["fileName"].GetString() ?? "";
+
+                var ok = instanceId != null && await taskManager.RunAsync("instance-restore", $"Restore {fileName}",
+                    _ => instanceBackups.RestoreInstanceBackupAsync(instanceId, fileName), cancellable: false);
+                Reply("hyprism:instance:restoreBackup:reply", ok);
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Backup not restored: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:instance:restoreBackup:reply", false);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to restore instance backup: {ex.Message}");
+                Reply("hyprism:instance:restoreBackup:reply", false);
+            }
+        });
+
+        // Delete a full backup
+        Electron.IpcMain.On("hyprism:instance:deleteBackup", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var fileName = data?["fileName"].GetString() ?? "";
+                Reply("hyprism:instance:deleteBackup:reply", instanceId != null && instanceBackups.DeleteInstanceBackup(instanceId, fileName));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to delete instance backup: {ex.Message}");
+                Reply("hyprism:instance:deleteBackup:reply", false);
+            }
+        });
+
+        // Most recently launched instances; { count? }
+        Electron.IpcMain.On("hyprism:instance:recent", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var count = doc.RootElement.ValueKind == JsonValueKind.Object &&
+                            doc.RootElement.TryGetProperty("count", out var c) && c.ValueKind == JsonValueKind.Number
+                    ? c.GetInt32()
+                    : 5;
+                Reply("hyprism:instance:recent:reply", activity.GetRecentInstances(count));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get recent instances: {ex.Message}");
+                Reply("hyprism:instance:recent:reply", new List<object>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:favorites", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:instance:favorites:reply", activity.GetFavoriteInstances());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get favorite instances: {ex.Message}");
+                Reply("hyprism:instance:favorites:reply", new List<object>());
+            }
+        });
+
+        // { instanceId, favorite }
+        Electron.IpcMain.On("hyprism:instance:setFavorite", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = data?["instanceId"].GetString() ?? "";
+                var favorite = data?.TryGetValue("favorite", out var f) == true && f.ValueKind == JsonValueKind.True;
+                Reply("hyprism:instance:setFavorite:reply", activity.SetFavorite(instanceId, favorite));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to set favorite instance: {ex.Message}");
+                Reply("hyprism:instance:setFavorite:reply", false);
+            }
+        });
+
+        // Compare the current mods with the mods a world was last played with
+        Electron.IpcMain.On("hyprism:instance:saveModSnapshot", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var saveName = data?["saveName"].GetString() ?? "";
+
+                Reply("hyprism:instance:saveModSnapshot:reply", instanceId == null ? null : worldMods.GetStatus(instanceId, saveName));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read world mods: {ex.Message}");
+                Reply("hyprism:instance:saveModSnapshot:reply", null);
+            }
+        });
+
+        // Put back the mods a world was last played with
+        Electron.IpcMain.On("hyprism:instance:restoreSaveMods", async (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var instanceId = WorldInstanceId(data);
+                var saveName = data?["saveName"].GetString() ?? "";
+
+                var result = instanceId == null
+                    ? new WorldModRestoreResult { MessageKey = "instances.modSnapshot.notFound" }
+                    : await worldMods.RestoreAsync(instanceId, saveName);
+                Reply("hyprism:instance:restoreSaveMods:reply", result);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to restore world mods: {ex.Message}");
+                Reply("hyprism:instance:restoreSaveMods:reply", new WorldModRestoreResult { Failed = [ex.Message] });
             }
         });
 
-        // Get instance icon
+        // Get instance icon: file:// URL or bundled:{name}
         Electron.IpcMain.On("hyprism:instance:getIcon", (args) =>
         {
             try
@@ -884,27 +1667,7 @@ public class IpcService
                     return;
                 }
                 
-                var instancePath = instanceService.GetInstancePathById(instanceId);
-                if (string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath))
-                {
-                    Reply("hyprism:instance:getIcon:reply", null);
-                    return;
-                }
-                
-                // Check for logo.png first (new format), then icon.png (legacy)
-                var logoPath = Path.Combine(instancePath, "logo.png");
-                var iconPath = Path.Combine(instancePath, "icon.png");
-                
-                var foundPath = File.Exists(logoPath) ? logoPath : (File.Exists(iconPath) ? iconPath : null);
-                
-                if (foundPath != null)
-                {
-                    Reply("hyprism:instance:getIcon:reply", $"file://{foundPath.Replace("\\", "/")}");
-                }
-                else
-                {
-                    Reply("hyprism:instance:getIcon:reply", null);
-                }
+                Reply("hyprism:instance:getIcon:reply", artworkService.GetArtwork(instanceId).Icon);
             }
             catch (Exception ex)
             {
@@ -913,7 +1676,7 @@ public class IpcService
             }
         });
 
-        // Set instance icon
+        // Set instance icon from an uploaded image
         Electron.IpcMain.On("hyprism:instance:setIcon", async (args) =>
         {
             try
@@ -923,52 +1686,15 @@ public class IpcService
                 var instanceId = data?["instanceId"].GetString();
                 var iconBase64 = data?["iconBase64"].GetString();
                 
-                if (string.IsNullOrEmpty(instanceId))
-                {
-                    Logger.Warning("IPC", "Set icon failed: no instanceId provided");
-                    Reply("hyprism:instance:setIcon:reply", false);
-                    return;
-                }
-                
-                var instancePath = instanceService.GetInstancePathById(instanceId);
-                if (string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath))
+                if (string.IsNullOrEmpty(instanceId) || string.IsNullOrEmpty(iconBase64))
                 {
-                    Logger.Warning("IPC", $"Set icon failed: instance not found by ID: {instanceId}");
+                    Logger.Warning("IPC", "Set icon failed: no instanceId or icon data provided");
                     Reply("hyprism:instance:setIcon:reply", false);
                     return;
                 }
                 
-                var targetIconPath = Path.Combine(instancePath, "logo.png");
-                
-                if (!string.IsNullOrEmpty(iconBase64))
-                {
-                    // Remove data URL prefix if present (e.g., "data:image/png;base64,")
-                    var base64Data = iconBase64.Contains(",") 
-                        ? iconBase64.Substring(iconBase64.IndexOf(",") + 1) 
-                        : iconBase64;
-                    
-                    var imageBytes = Convert.FromBase64String(base64Data);
-                    
-                    // Resize to 256x256 using ImageSharp
-                    using var inputStream = new MemoryStream(imageBytes);
-                    using var image = await SixLabors.ImageSharp.Image.LoadAsync(inputStream);
-                    
-                    image.Mutate(x => x.Resize(new SixLabors.ImageSharp.Processing.ResizeOptions
-                    {
-                        Size = new SixLabors.ImageSharp.Size(256, 256),
-                        Mode = SixLabors.ImageSharp.Processing.ResizeMode.Crop
-                    }));
-                    
-                    await image.SaveAsPngAsync(targetIconPath);
-                    
-                    Reply("hyprism:instance:setIcon:reply", true);
-                    Logger.Info("IPC", $"Set icon for instance {instanceId} (256x256)");
-                }
-                else
-                {
-                    // Icon data not provided
-                    Reply("hyprism:instance:setIcon:reply", false);
-                }
+                Reply("hyprism:instance:setIcon:reply",
+                    await artworkService.SetCustomArtworkAsync(instanceId, ArtworkKind.Icon, DecodeImage(iconBase64)));
             }
             catch (Exception ex)
             {
@@ -977,6 +1703,63 @@ public class IpcService
             }
         });
 
+        Electron.IpcMain.On("hyprism:instance:getArtwork", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:instance:getArtwork:reply", artworkService.GetArtwork(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get instance artwork: {ex.Message}");
+                Reply("hyprism:instance:getArtwork:reply", new InstanceArtwork());
+            }
+        });
+
+        // { instanceId, kind: 'icon' | 'banner', imageBase64? | bundled? } — neither resets to the default
+        Electron.IpcMain.On("hyprism:instance:setArtwork", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var instanceId = root.GetProperty("instanceId").GetString() ?? "";
+                var kind = root.TryGetProperty("kind", out var k) ? k.GetString() ?? "" : "";
+                var imageBase64 = root.TryGetProperty("imageBase64", out var img) && img.ValueKind == JsonValueKind.String ? img.GetString() : null;
+                var bundled = root.TryGetProperty("bundled", out var b) && b.ValueKind == JsonValueKind.String ? b.GetString() : null;
+
+                bool result;
+                if (!string.IsNullOrEmpty(imageBase64))
+                    result = await artworkService.SetCustomArtworkAsync(instanceId, kind, DecodeImage(imageBase64));
+                else if (!string.IsNullOrEmpty(bundled))
+                    result = artworkService.SetBundledArtwork(instanceId, kind, bundled);
+                else
+                    result = artworkService.ClearArtwork(instanceId, kind);
+
+                Reply("hyprism:instance:setArtwork:reply", result);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to set instance artwork: {ex.Message}");
+                Reply("hyprism:instance:setArtwork:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:bundledArtwork", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:instance:bundledArtwork:reply", artworkService.GetBundledArtwork());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list bundled artwork: {ex.Message}");
+                Reply("hyprism:instance:bundledArtwork:reply", new List<string>());
+            }
+        });
+
         // Rename instance (set custom name)
         Electron.IpcMain.On("hyprism:instance:rename", (args) =>
         {
@@ -1004,38 +1787,621 @@ public class IpcService
                 Reply("hyprism:instance:rename:reply", false);
             }
         });
-    }
-    // #endregion
 
-    // #region News
-    // @ipc invoke hyprism:news:get -> NewsItem[]
+        Electron.IpcMain.On("hyprism:instance:getExtraArgs", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:instance:getExtraArgs:reply", instanceService.GetExtraClientArgs(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get extra client arguments: {ex.Message}");
+                Reply("hyprism:instance:getExtraArgs:reply", "");
+            }
+        });
 
-    private void RegisterNewsHandlers()
-    {
-        var newsService = _services.GetRequiredService<INewsService>();
+        Electron.IpcMain.On("hyprism:instance:setExtraArgs", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var instanceId = root.GetProperty("instanceId").GetString() ?? "";
+                var extraArgs = root.TryGetProperty("extraArgs", out var a) ? a.GetString() : null;
+                Reply("hyprism:instance:setExtraArgs:reply", instanceService.SetExtraClientArgs(instanceId, extraArgs));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to set extra client arguments: {ex.Message}");
+                Reply("hyprism:instance:setExtraArgs:reply", false);
+            }
+        });
 
-        Electron.IpcMain.On("hyprism:news:get", async (_) =>
+        // supported is false off Linux, where the frontend hides the option
+        Electron.IpcMain.On("hyprism:instance:getDisplayServer", (args) =>
         {
+            var settings = new DisplayServerSettings
+            {
+                Supported = DisplayServerEnvironment.IsSupported,
+                Session = DisplayServerEnvironment.DetectSession()
+            };
             try
             {
-                var news = await newsService.GetNewsAsync();
-                Reply("hyprism:news:get:reply", news);
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                settings.Mode = instanceService.GetDisplayServer(instanceId);
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"News fetch failed: {ex.Message}");
-                Reply("hyprism:news:get:reply", new { error = ex.Message });
+                Logger.Error("IPC", $"Failed to get display server: {ex.Message}");
             }
+            Reply("hyprism:instance:getDisplayServer:reply", settings);
         });
-    }
 
-    // #endregion
+        Electron.IpcMain.On("hyprism:instance:setDisplayServer", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var instanceId = root.GetProperty("instanceId").GetString() ?? "";
+                var mode = root.TryGetProperty("mode", out var m) ? m.GetString() ?? "" : "";
+                Reply("hyprism:instance:setDisplayServer:reply", new { success = instanceService.SetDisplayServer(instanceId, mode) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("IPC", $"Failed to set display server: {ex.Message}");
+                Reply("hyprism:instance:setDisplayServer:reply", new { success = false, error = ex.Message });
+            }
+        });
 
-    // #region Profiles
-    // @ipc invoke hyprism:profile:get -> ProfileSnapshot
+        Electron.IpcMain.On("hyprism:instance:getGameVersion", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:instance:getGameVersion:reply", instanceService.GetGameVersion(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get game version: {ex.Message}");
+                Reply("hyprism:instance:getGameVersion:reply", null);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:setGameVersion", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var instanceId = root.GetProperty("instanceId").GetString() ?? "";
+                var gameVersion = root.TryGetProperty("gameVersion", out var v) && v.ValueKind == JsonValueKind.String ? v.GetString() : null;
+                Reply("hyprism:instance:setGameVersion:reply", instanceService.SetGameVersion(instanceId, gameVersion));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to set game version: {ex.Message}");
+                Reply("hyprism:instance:setGameVersion:reply", false);
+            }
+        });
+
+        // What a launch of the instance would run, for debugging launch problems
+        Electron.IpcMain.On("hyprism:instance:launchPreview", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:instance:launchPreview:reply", await gameLauncher.GetLaunchCommandPreviewAsync(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to build launch preview: {ex.Message}");
+                Reply("hyprism:instance:launchPreview:reply", null);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:exportLaunchScript", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                var extension = OperatingSystem.IsWindows() ? ".bat" : ".sh";
+                var name = instanceService.FindInstanceById(instanceId)?.Name ?? "instance";
+                var safeName = string.Concat(name.Select(c => Path.GetInvalidFileNameChars().Contains(c) || c == ' ' ? '_' : c));
+
+                var fileDialog = _services.GetRequiredService<IFileDialogService>();
+                var desktop = Environment.GetFolderPath(Environment.SpecialFolder.Desktop);
+                var savePath = await fileDialog.SaveFileAsync($"HyPrism-{safeName}{extension}",
+                    OperatingSystem.IsWindows() ? "Batch files|*.bat" : "Shell scripts|*.sh", desktop);
+                if (string.IsNullOrEmpty(savePath))
+                {
+                    // User cancelled
+                    Reply("hyprism:instance:exportLaunchScript:reply", new { });
+                    return;
+                }
+                if (!savePath.EndsWith(extension, StringComparison.OrdinalIgnoreCase))
+                    savePath += extension;
+
+                var path = await gameLauncher.ExportLaunchScriptAsync(instanceId, savePath);
+                Reply("hyprism:instance:exportLaunchScript:reply", path == null ? new { error = "Instance not found" } : new { path });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to export launch script: {ex.Message}");
+                Reply("hyprism:instance:exportLaunchScript:reply", new { error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:createShortcut", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                var profileId = doc.RootElement.TryGetProperty("profileId", out var p) && p.ValueKind == JsonValueKind.String
+                    ? p.GetString()
+                    : null;
+                var shortcuts = _services.GetRequiredService<IDesktopShortcutService>();
+                Reply("hyprism:instance:createShortcut:reply", shortcuts.CreateDesktopShortcut(instanceId, profileId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to create desktop shortcut: {ex.Message}");
+                Reply("hyprism:instance:createShortcut:reply", new DesktopShortcutResult { Error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:instance:recoveryReport", (_) =>
+        {
+            try
+            {
+                var recovery = _services.GetRequiredService<IStartupRecoveryService>();
+                Reply("hyprism:instance:recoveryReport:reply", recovery.GetLastReport());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get recovery report: {ex.Message}");
+                Reply("hyprism:instance:recoveryReport:reply", new StartupRecoveryReport());
+            }
+        });
+    }
+    // #endregion
+
+    // #region Java Runtimes
+    // @ipc invoke hyprism:java:list -> JavaRuntimeInfo[]
+    // @ipc invoke hyprism:java:available -> JavaRuntimeInfo[]
+    // @ipc invoke hyprism:java:detect -> JavaRuntimeInfo[]
+    // @ipc invoke hyprism:java:install -> JavaRuntimeInfo | null
+    // @ipc invoke hyprism:java:verify -> boolean
+    // @ipc invoke hyprism:java:repair -> JavaRuntimeInfo | null
+    // @ipc invoke hyprism:java:remove -> boolean
+    // @ipc invoke hyprism:java:getInstanceRuntime -> string
+    // @ipc invoke hyprism:java:setInstanceRuntime -> boolean
+
+    private void RegisterJavaHandlers()
+    {
+        var javaRuntimes = _services.GetRequiredService<IJavaRuntimeService>();
+        var taskManager = _services.GetRequiredService<ITaskManagerService>();
+
+        static string ReadRuntimeId(object? args)
+        {
+            using var doc = JsonDocument.Parse(ArgsToJson(args));
+            return doc.RootElement.TryGetProperty("id", out var id) ? id.GetString() ?? "" : "";
+        }
+
+        Electron.IpcMain.On("hyprism:java:list", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:java:list:reply", javaRuntimes.GetInstalledRuntimes());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list Java runtimes: {ex.Message}");
+                Reply("hyprism:java:list:reply", new List<JavaRuntimeInfo>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:available", async (_) =>
+        {
+            try
+            {
+                Reply("hyprism:java:available:reply", await javaRuntimes.GetAvailableRuntimesAsync());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list available Java runtimes: {ex.Message}");
+                Reply("hyprism:java:available:reply", new List<JavaRuntimeInfo>());
+            }
+        });
+
+        // System Java scan; pass { refresh: true } to rescan after installing a JDK
+        Electron.IpcMain.On("hyprism:java:detect", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var refresh = doc.RootElement.ValueKind == JsonValueKind.Object &&
+                              doc.RootElement.TryGetProperty("refresh", out var r) && r.GetBoolean();
+                Reply("hyprism:java:detect:reply", await javaRuntimes.DetectSystemRuntimesAsync(refresh));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to detect system Java: {ex.Message}");
+                Reply("hyprism:java:detect:reply", new List<JavaRuntimeInfo>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:install", async (args) =>
+        {
+            try
+            {
+                var id = ReadRuntimeId(args);
+                var runtime = await taskManager.RunAsync("java-install", $"Install Java runtime {id}",
+                    task => javaRuntimes.InstallRuntimeAsync(id, (progress, _) => task.Report(progress), task.Token));
+                Reply("hyprism:java:install:reply", runtime);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to install Java runtime: {ex.Message}");
+                Reply("hyprism:java:install:reply", null);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:verify", async (args) =>
+        {
+            try
+            {
+                Reply("hyprism:java:verify:reply", await javaRuntimes.VerifyRuntimeAsync(ReadRuntimeId(args)));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to verify Java runtime: {ex.Message}");
+                Reply("hyprism:java:verify:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:repair", async (args) =>
+        {
+            try
+            {
+                var id = ReadRuntimeId(args);
+                var runtime = await taskManager.RunAsync("java-repair", $"Repair Java runtime {id}",
+                    task => javaRuntimes.RepairRuntimeAsync(id, (progress, _) => task.Report(progress), task.Token));
+                Reply("hyprism:java:repair:reply", runtime);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to repair Java runtime: {ex.Message}");
+                Reply("hyprism:java:repair:reply", null);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:remove", (args) =>
+        {
+            try
+            {
+                Reply("hyprism:java:remove:reply", javaRuntimes.RemoveRuntime(ReadRuntimeId(args)));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to remove Java runtime: {ex.Message}");
+                Reply("hyprism:java:remove:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:getInstanceRuntime", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:java:getInstanceRuntime:reply", javaRuntimes.GetInstanceRuntimeId(instanceId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get instance Java runtime: {ex.Message}");
+                Reply("hyprism:java:getInstanceRuntime:reply", JavaRuntimeService.DefaultRuntimeId);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:java:setInstanceRuntime", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var instanceId = root.GetProperty("instanceId").GetString() ?? "";
+                var runtimeId = root.TryGetProperty("runtimeId", out var rid) ? rid.GetString() : null;
+                Reply("hyprism:java:setInstanceRuntime:reply", await javaRuntimes.SetInstanceRuntimeAsync(instanceId, runtimeId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to set instance Java runtime: {ex.Message}");
+                Reply("hyprism:java:setInstanceRuntime:reply", false);
+            }
+        });
+    }
+    // #endregion
+
+    // #region Game Settings
+    // @ipc invoke hyprism:gameSettings:load -> GameSettings
+    // @ipc invoke hyprism:gameSettings:save -> boolean
+    // @ipc invoke hyprism:gameSettings:getValue -> unknown
+    // @ipc invoke hyprism:gameSettings:setValue -> boolean
+    // @ipc invoke hyprism:gameSettings:copy -> number
+    // @ipc invoke hyprism:gameSettings:getSync -> SettingsSyncConfig
+    // @ipc invoke hyprism:gameSettings:setSync -> boolean
+
+    private void RegisterGameSettingsHandlers()
+    {
+        var gameSettings = _services.GetRequiredService<IGameSettingsService>();
+        var instanceService = _services.GetRequiredService<IInstanceService>();
+
+        string ResolveInstancePath(JsonElement root, string property = "instanceId")
+        {
+            var instanceId = root.TryGetProperty(property, out var id) ? id.GetString() : null;
+            if (string.IsNullOrEmpty(instanceId))
+                throw new ArgumentException($"{property} is required");
+            return instanceService.GetInstancePathById(instanceId)
+                ?? throw new DirectoryNotFoundException($"Instance not found: {instanceId}");
+        }
+
+        Electron.IpcMain.On("hyprism:gameSettings:load", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                Reply("hyprism:gameSettings:load:reply", gameSettings.GetSettings(ResolveInstancePath(doc.RootElement)));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read game settings: {ex.Message}");
+                Reply("hyprism:gameSettings:load:reply", new GameSettings());
+            }
+        });
+
+        // { instanceId, settings: GameSettingsUpdate }
+        Electron.IpcMain.On("hyprism:gameSettings:save", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var update = root.TryGetProperty("settings", out var s)
+                    ? s.Deserialize<GameSettingsUpdate>(JsonOpts) ?? new GameSettingsUpdate()
+                    : new GameSettingsUpdate();
+                Reply("hyprism:gameSettings:save:reply", gameSettings.UpdateSettings(ResolveInstancePath(root), update));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to save game settings: {ex.Message}");
+                Reply("hyprism:gameSettings:save:reply", false);
+            }
+        });
+
+        // { instanceId, file?, path }; file defaults to Settings.json
+        Electron.IpcMain.On("hyprism:gameSettings:getValue", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var file = root.TryGetProperty("file", out var f) ? f.GetString() ?? GameSettingsService.PrimaryFile : GameSettingsService.PrimaryFile;
+                var path = root.GetProperty("path").GetString() ?? "";
+                Reply("hyprism:gameSettings:getValue:reply", gameSettings.GetValue(ResolveInstancePath(root), file, path));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read game setting: {ex.Message}");
+                Reply("hyprism:gameSettings:getValue:reply", null);
+            }
+        });
+
+        // { instanceId, file?, path, value }
+        Electron.IpcMain.On("hyprism:gameSettings:setValue", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var file = root.TryGetProperty("file", out var f) ? f.GetString() ?? GameSettingsService.PrimaryFile : GameSettingsService.PrimaryFile;
+                var path = root.GetProperty("path").GetString() ?? "";
+                var value = root.TryGetProperty("value", out var v) ? JsonNode.Parse(v.GetRawText()) : null;
+                Reply("hyprism:gameSettings:setValue:reply", gameSettings.SetValue(ResolveInstancePath(root), file, path, value));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to write game setting: {ex.Message}");
+                Reply("hyprism:gameSettings:setValue:reply", false);
+            }
+        });
+
+        // { sourceId, targetId, files? }
+        Electron.IpcMain.On("hyprism:gameSettings:copy", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                List<string>? files = root.TryGetProperty("files", out var f) && f.ValueKind == JsonValueKind.Array
+                    ? f.EnumerateArray().Select(e => e.GetString()).OfType<string>().ToList()
+                    : null;
+                var copied = gameSettings.CopySettings(
+                    ResolveInstancePath(root, "sourceId"), ResolveInstancePath(root, "targetId"), files);
+                Reply("hyprism:gameSettings:copy:reply", copied);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to copy game settings: {ex.Message}");
+                Reply("hyprism:gameSettings:copy:reply", 0);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:gameSettings:getSync", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:gameSettings:getSync:reply", gameSettings.GetSyncConfig());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read settings sync config: {ex.Message}");
+                Reply("hyprism:gameSettings:getSync:reply", new SettingsSyncConfig());
+            }
+        });
+
+        // SettingsSyncConfig
+        Electron.IpcMain.On("hyprism:gameSettings:setSync", (args) =>
+        {
+            try
+            {
+                var config = JsonSerializer.Deserialize<SettingsSyncConfig>(ArgsToJson(args), JsonOpts) ?? new SettingsSyncConfig();
+                gameSettings.SetSyncConfig(config);
+                Reply("hyprism:gameSettings:setSync:reply", true);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to save settings sync config: {ex.Message}");
+                Reply("hyprism:gameSettings:setSync:reply", false);
+            }
+        });
+    }
+    // #endregion
+
+    // #region News
+    // @ipc invoke hyprism:news:get -> NewsItem[]
+    // @ipc invoke hyprism:news:feed -> NewsFeed
+    // @ipc invoke hyprism:news:sources -> NewsSourceInfo[]
+    // @ipc invoke hyprism:news:configureSources -> NewsSourceInfo[]
+
+    private void RegisterNewsHandlers()
+    {
+        var newsService = _services.GetRequiredService<INewsService>();
+
+        Electron.IpcMain.On("hyprism:news:get", async (_) =>
+        {
+            try
+            {
+                var news = await newsService.GetNewsAsync();
+                Reply("hyprism:news:get:reply", news);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"News fetch failed: {ex.Message}");
+                Reply("hyprism:news:get:reply", new { error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:news:feed", async (args) =>
+        {
+            try
+            {
+                bool forceRefresh = false;
+                var json = ArgsToJson(args);
+                if (!string.IsNullOrWhiteSpace(json) && json.TrimStart().StartsWith('{'))
+                {
+                    using var doc = JsonDocument.Parse(json);
+                    forceRefresh = doc.RootElement.TryGetProperty("forceRefresh", out var fr) && fr.ValueKind == JsonValueKind.True;
+                }
+
+                Reply("hyprism:news:feed:reply", await newsService.GetNewsFeedAsync(forceRefresh: forceRefresh));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"News feed fetch failed: {ex.Message}");
+                Reply("hyprism:news:feed:reply", new NewsFeed());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:news:sources", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:news:sources:reply", newsService.GetSources());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"News sources failed: {ex.Message}");
+                Reply("hyprism:news:sources:reply", new List<NewsSourceInfo>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:news:configureSources", (args) =>
+        {
+            try
+            {
+                var data = JsonSerializer.Deserialize<NewsSourcesUpdate>(ArgsToJson(args), JsonOpts) ?? new NewsSourcesUpdate();
+                Reply("hyprism:news:configureSources:reply", newsService.ConfigureSources(data.Feeds, data.DisabledSources));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"News source update failed: {ex.Message}");
+                Reply("hyprism:news:configureSources:reply", newsService.GetSources());
+            }
+        });
+    }
+
+    // #endregion
+
+    // #region Announcements
+    // @ipc invoke hyprism:announcements:get -> Announcement[]
+    // @ipc invoke hyprism:announcements:dismiss -> boolean
+
+    private void RegisterAnnouncementHandlers()
+    {
+        var announcements = _services.GetRequiredService<IAnnouncementService>();
+
+        Electron.IpcMain.On("hyprism:announcements:get", async (args) =>
+        {
+            try
+            {
+                bool forceRefresh = false;
+                var json = ArgsToJson(args);
+                if (json.TrimStart().StartsWith('{'))
+                {
+                    using var doc = JsonDocument.Parse(json);
+                    forceRefresh = doc.RootElement.TryGetProperty("forceRefresh", out var fr) && fr.ValueKind == JsonValueKind.True;
+                }
+
+                Reply("hyprism:announcements:get:reply", await announcements.GetActiveAnnouncementsAsync(forceRefresh));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Announcements fetch failed: {ex.Message}");
+                Reply("hyprism:announcements:get:reply", new List<Announcement>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:announcements:dismiss", async (args) =>
+        {
+            try
+            {
+                Reply("hyprism:announcements:dismiss:reply", await announcements.DismissAsync(ArgsToString(args)));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Announcement dismiss failed: {ex.Message}");
+                Reply("hyprism:announcements:dismiss:reply", false);
+            }
+        });
+    }
+
+    // #endregion
+
+    // #region Profiles
+    // @ipc invoke hyprism:profile:get -> ProfileSnapshot
     // @ipc invoke hyprism:profile:list -> Profile[]
     // @ipc invoke hyprism:profile:switch -> { success: boolean }
-    // @ipc invoke hyprism:profile:setNick -> { success: boolean }
+    // @ipc invoke hyprism:profile:setNick -> { success: boolean; messageKey?: string; args?: unknown[] }
+    // @ipc invoke hyprism:profile:validateNick -> NicknameValidationResult
     // @ipc invoke hyprism:profile:setUuid -> { success: boolean }
     // @ipc invoke hyprism:profile:create -> Profile
     // @ipc invoke hyprism:profile:delete -> { success: boolean }
@@ -1044,854 +2410,2125 @@ public class IpcService
     // @ipc invoke hyprism:profile:duplicate -> Profile
     // @ipc send hyprism:profile:openFolder
     // @ipc invoke hyprism:profile:avatarForUuid -> string
+    // @ipc invoke hyprism:profile:skinHistory -> SkinHistoryEntry[]
+    // @ipc invoke hyprism:profile:undoSkinChange -> { success: boolean; error?: string }
+
+    private void RegisterProfileHandlers()
+    {
+        var profileService = _services.GetRequiredService<IProfileService>();
+        var profileMgmt = _services.GetRequiredService<IProfileManagementService>();
+        var skinService = _services.GetRequiredService<ISkinService>();
+
+        Electron.IpcMain.On("hyprism:profile:get", (_) =>
+        {
+            Reply("hyprism:profile:get:reply", new
+            {
+                nick = profileService.GetNick(),
+                uuid = profileService.GetUUID(),
+                avatarPath = profileService.GetAvatarPreview()
+            });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:list", (_) =>
+        {
+            Reply("hyprism:profile:list:reply", profileMgmt.GetProfiles());
+        });
+
+        Electron.IpcMain.On("hyprism:profile:switch", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var index = doc.RootElement.GetProperty("index").GetInt32();
+                Reply("hyprism:profile:switch:reply", new { success = profileMgmt.SwitchProfile(index) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Profile switch failed: {ex.Message}");
+                Reply("hyprism:profile:switch:reply", new { success = false });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:profile:setNick", (args) =>
+        {
+            var nick = ArgsToString(args);
+            var validation = profileService.ValidateNick(nick);
+            var success = validation.Valid && profileService.SetNick(nick);
+            Reply("hyprism:profile:setNick:reply", new { success, messageKey = validation.MessageKey, args = validation.Args });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:validateNick", (args) =>
+        {
+            try
+            {
+                var nick = ArgsToString(args);
+                Reply("hyprism:profile:validateNick:reply", profileService.ValidateNick(nick));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Nickname validation failed: {ex.Message}");
+                Reply("hyprism:profile:validateNick:reply", new NicknameValidationResult { Valid = false, Message = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:profile:setUuid", (args) =>
+        {
+            var uuid = ArgsToString(args);
+            var success = profileService.SetUUID(uuid);
+            Reply("hyprism:profile:setUuid:reply", new { success });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:create", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var name = doc.RootElement.GetProperty("name").GetString() ?? "";
+                var uuid = doc.RootElement.GetProperty("uuid").GetString() ?? "";
+                var isOfficial = doc.RootElement.TryGetProperty("isOfficial", out var officialProp) && officialProp.GetBoolean();
+                
+                var profile = profileMgmt.CreateProfile(name, uuid);
+                if (profile != null)
+                {
+                    profile.IsOfficial = isOfficial;
+                    _services.GetRequiredService<ConfigService>().SaveConfig();
+                }
+                Reply("hyprism:profile:create:reply", profile != null ? (object)profile : new { error = "Failed to create profile" });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Profile create failed: {ex.Message}");
+                Reply("hyprism:profile:create:reply", new { error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:profile:delete", (args) =>
+        {
+            var id = ArgsToString(args);
+            var success = profileMgmt.DeleteProfile(id);
+            Reply("hyprism:profile:delete:reply", new { success });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:activeIndex", (_) =>
+        {
+            Reply("hyprism:profile:activeIndex:reply", profileMgmt.GetActiveProfileIndex());
+        });
+
+        Electron.IpcMain.On("hyprism:profile:save", (_) =>
+        {
+            var profile = profileMgmt.SaveCurrentAsProfile();
+            Reply("hyprism:profile:save:reply", new { success = profile != null });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:duplicate", (args) =>
+        {
+            var id = ArgsToString(args);
+            var profile = profileMgmt.DuplicateProfileWithoutData(id);
+            Reply("hyprism:profile:duplicate:reply", profile != null ? (object)profile : new { error = "Failed to duplicate" });
+        });
+
+        Electron.IpcMain.On("hyprism:profile:openFolder", (_) =>
+        {
+            profileMgmt.OpenCurrentProfileFolder();
+        });
+
+        Electron.IpcMain.On("hyprism:profile:avatarForUuid", (args) =>
+        {
+            var uuid = ArgsToString(args);
+            var path = profileService.GetAvatarPreviewForUUID(uuid);
+            Reply("hyprism:profile:avatarForUuid:reply", path ?? "");
+        });
+
+        Electron.IpcMain.On("hyprism:profile:skinHistory", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var profileId = doc.RootElement.GetProperty("profileId").GetString() ?? "";
+                Reply("hyprism:profile:skinHistory:reply", skinService.GetSkinHistory(profileId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get skin history: {ex.Message}");
+                Reply("hyprism:profile:skinHistory:reply", new List<SkinHistoryEntry>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:profile:undoSkinChange", (args) =>
+        {
+            try
+            {
+                // The skin file is guarded while the game runs and would be put back
+                if (_services.GetRequiredService<IGameProcessService>().IsGameRunning())
+                {
+                    Reply("hyprism:profile:undoSkinChange:reply", new { success = false, error = "The game is running" });
+                    return;
+                }
+
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var profileId = doc.RootElement.GetProperty("profileId").GetString() ?? "";
+                var entryId = doc.RootElement.TryGetProperty("entryId", out var e) && e.ValueKind == JsonValueKind.String
+                    ? e.GetString()
+                    : null;
+                Reply("hyprism:profile:undoSkinChange:reply", new { success = skinService.UndoSkinChange(profileId, entryId) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to undo skin change: {ex.Message}");
+                Reply("hyprism:profile:undoSkinChange:reply", new { success = false, error = ex.Message });
+            }
+        });
+    }
+
+    // #endregion
+
+    // #region Hytale Auth
+    // @ipc invoke hyprism:auth:status -> HytaleAuthStatus
+    // @ipc invoke hyprism:auth:login -> HytaleAuthStatus
+    // @ipc invoke hyprism:auth:logout -> { success: boolean }
+
+    private void RegisterAuthHandlers()
+    {
+        var authService = _services.GetRequiredService<HytaleAuthService>();
+
+        Electron.IpcMain.On("hyprism:auth:status", (_) =>
+        {
+            Reply("hyprism:auth:status:reply", authService.GetAuthStatus());
+        });
+
+        Electron.IpcMain.On("hyprism:auth:login", async (_) =>
+        {
+            try
+            {
+                var session = await authService.LoginAsync();
+                Reply("hyprism:auth:login:reply", authService.GetAuthStatus());
+            }
+            catch (HytaleNoProfileException)
+            {
+                Logger.Warning("IPC", "Auth login: no Hytale game profile found");
+                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = "no_profile", error = "No game profiles found in this Hytale account" });
+            }
+            catch (HytaleAuthException ex)
+            {
+                Logger.Error("IPC", $"Auth login error ({ex.ErrorType}): {ex.Message}");
+                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = ex.ErrorType, error = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Auth login failed: {ex.Message}");
+                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = "unknown", error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:auth:logout", (_) =>
+        {
+            authService.Logout();
+            Reply("hyprism:auth:logout:reply", new { success = true });
+        });
+    }
+
+    // #endregion
+
+    // #region Settings
+    // @ipc invoke hyprism:settings:get -> SettingsSnapshot
+    // @ipc invoke hyprism:settings:update -> { success: boolean }
+
+    private void RegisterSettingsHandlers()
+    {
+        var settings = _services.GetRequiredService<ISettingsService>();
+        var appPath = _services.GetRequiredService<AppPathConfiguration>();
+
+        Electron.IpcMain.On("hyprism:settings:get", (_) =>
+        {
+            var lang = settings.GetLanguage();
+            Reply("hyprism:settings:get:reply", new
+            {
+                language = lang,
+                musicEnabled = settings.GetMusicEnabled(),
+                launcherBranch = settings.GetLauncherBranch(),
+                versionType = settings.GetVersionType(),
+                selectedVersion = settings.GetSelectedVersion(),
+                closeAfterLaunch = settings.GetCloseAfterLaunch(),
+                minimizeOnLaunch = settings.GetMinimizeOnLaunch(),
+                restoreOnGameExit = settings.GetRestoreOnGameExit(),
+                verifyFilesBeforeLaunch = settings.GetVerifyFilesBeforeLaunch(),
+                showDiscordAnnouncements = settings.GetShowDiscordAnnouncements(),
+                disableNews = settings.GetDisableNews(),
+                backgroundMode = settings.GetBackgroundMode(),
+                availableBackgrounds = settings.GetAvailableBackgrounds(),
+                accentColor = settings.GetAccentColor(),
+                hasCompletedOnboarding = settings.GetHasCompletedOnboarding(),
+                onlineMode = settings.GetOnlineMode(),
+                authDomain = settings.GetAuthDomain(),
+                dataDirectory = appPath.AppDir,
+                instanceDirectory = settings.GetInstanceDirectory(),
+                gpuPreference = settings.GetGpuPreference(),
+                proxyUrl = settings.GetProxyUrl(),
+                meteredConnection = settings.GetMeteredConnection(),
+                meteredConfirmThresholdMb = settings.GetMeteredConfirmThresholdMb(),
+                launcherVersion = UpdateService.GetCurrentVersion()
+            });
+        });
+
+        Electron.IpcMain.On("hyprism:settings:update", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                var updates = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json);
+                if (updates != null)
+                    foreach (var (key, value) in updates)
+                        ApplySetting(settings, key, value);
+
+                Reply("hyprism:settings:update:reply", new { success = true });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Settings update failed: {ex.Message}");
+                Reply("hyprism:settings:update:reply", new { success = false, error = ex.Message });
+            }
+        });
+    }
+
+    private static void ApplySetting(ISettingsService s, string key, JsonElement val)
+    {
+        switch (key)
+        {
+            case "language": s.SetLanguage(val.GetString() ?? "en-US"); break;
+            case "musicEnabled": s.SetMusicEnabled(val.GetBoolean()); break;
+            case "launcherBranch": s.SetLauncherBranch(val.GetString() ?? "release"); break;
+            case "versionType": s.SetVersionType(val.GetString() ?? "release"); break;
+            case "selectedVersion": s.SetSelectedVersion(val.ValueKind == JsonValueKind.Number ? val.GetInt32() : 0); break;
+            case "closeAfterLaunch": s.SetCloseAfterLaunch(val.GetBoolean()); break;
+            case "minimizeOnLaunch": s.SetMinimizeOnLaunch(val.GetBoolean()); break;
+            case "restoreOnGameExit": s.SetRestoreOnGameExit(val.GetBoolean()); break;
+            case "verifyFilesBeforeLaunch": s.SetVerifyFilesBeforeLaunch(val.GetBoolean()); break;
+            case "showDiscordAnnouncements": s.SetShowDiscordAnnouncements(val.GetBoolean()); break;
+            case "disableNews": s.SetDisableNews(val.GetBoolean()); break;
+            case "backgroundMode": s.SetBackgroundMode(val.GetString() ?? "default"); break;
+            case "accentColor": s.SetAccentColor(val.GetString() ?? "#7C5CFC"); break;
+            case "onlineMode": s.SetOnlineMode(val.GetBoolean()); break;
+            case "authDomain": s.SetAuthDomain(val.GetString() ?? ""); break;
+            case "gpuPreference": s.SetGpuPreference(val.GetString() ?? "dedicated"); break;
+            case "proxyUrl": s.SetProxyUrl(val.GetString() ?? ""); break;
+            case "meteredConnection": s.SetMeteredConnection(val.GetBoolean()); break;
+            case "meteredConfirmThresholdMb": s.SetMeteredConfirmThresholdMb(val.ValueKind == JsonValueKind.Number ? val.GetInt32() : 100); break;
+            case "hasCompletedOnboarding": s.SetHasCompletedOnboarding(val.GetBoolean()); break;
+            default: Logger.Warning("IPC", $"Unknown setting key: {key}"); break;
+        }
+    }
+    
+    // #endregion
+
+    // #region Onboarding
+    // @ipc invoke hyprism:onboarding:state -> OnboardingState
+    // @ipc invoke hyprism:onboarding:locations -> InstallLocation[]
+    // @ipc invoke hyprism:onboarding:complete -> OnboardingResult
+
+    private void RegisterOnboardingHandlers()
+    {
+        var onboarding = _services.GetRequiredService<IOnboardingService>();
+
+        Electron.IpcMain.On("hyprism:onboarding:state", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:onboarding:state:reply", onboarding.GetState());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Onboarding state failed: {ex.Message}");
+                Reply("hyprism:onboarding:state:reply", new { isFirstRun = true });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:onboarding:locations", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:onboarding:locations:reply", onboarding.GetRecommendedInstallLocations());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Onboarding locations failed: {ex.Message}");
+                Reply("hyprism:onboarding:locations:reply", Array.Empty<object>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:onboarding:complete", (args) =>
+        {
+            try
+            {
+                var request = JsonSerializer.Deserialize<OnboardingRequest>(ArgsToJson(args), JsonOpts) ?? new OnboardingRequest();
+                Reply("hyprism:onboarding:complete:reply", onboarding.Complete(request));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Onboarding complete failed: {ex.Message}");
+                Reply("hyprism:onboarding:complete:reply", new OnboardingResult { Success = false, MessageKey = MessageCatalog.ErrorSettingsSaveFailed });
+            }
+        });
+    }
+
+    // #endregion
+
+    // #region Localization
+    // @ipc invoke hyprism:i18n:get -> Record<string, string>
+    // @ipc invoke hyprism:i18n:current -> string
+    // @ipc invoke hyprism:i18n:set -> { success: boolean, language: string }
+    // @ipc invoke hyprism:i18n:languages -> LanguageInfo[]
+
+    private void RegisterLocalizationHandlers()
+    {
+        var localization = _services.GetRequiredService<LocalizationService>();
+        var settings = _services.GetRequiredService<ISettingsService>();
+
+        Electron.IpcMain.On("hyprism:i18n:current", (_) =>
+        {
+            Reply("hyprism:i18n:current:reply", localization.CurrentLanguage);
+        });
+
+        Electron.IpcMain.On("hyprism:i18n:set", (args) =>
+        {
+            var lang = ArgsToString(args);
+            if (string.IsNullOrEmpty(lang)) lang = "en-US";
+            Logger.Info("IPC", $"Language change requested: {lang}");
+            // Use SettingsService.SetLanguage which persists to config file
+            var success = settings.SetLanguage(lang);
+            Reply("hyprism:i18n:set:reply", new { success, language = success ? lang : localization.CurrentLanguage });
+        });
+
+        Electron.IpcMain.On("hyprism:i18n:languages", (_) =>
+        {
+            Reply("hyprism:i18n:languages:reply", LocalizationService.GetAvailableLanguages());
+        });
+    }
+    
+    // #endregion
+
+    // #region Window Controls
+    // @ipc send hyprism:window:minimize
+    // @ipc send hyprism:window:maximize
+    // @ipc send hyprism:window:close
+    // @ipc send hyprism:window:restart
+    // @ipc send hyprism:browser:open
+    // @ipc invoke hyprism:app:reportIssue -> string
+    // @ipc event hyprism:app:secondInstance -> SecondInstanceArgs
+
+    private void RegisterWindowHandlers()
+    {
+        var issueReport = _services.GetRequiredService<IIssueReportService>();
+
+        // A second launch forwarded its arguments — bring our window forward
+        // and let the frontend act on deep links / CLI flags.
+        SingleInstanceGuard.ArgumentsReceived += (args) =>
+        {
+            try
+            {
+                var win = GetMainWindow();
+                if (win != null)
+                {
+                    win.Restore();
+                    win.Show();
+                    win.Focus();
+                }
+                // Desktop shortcuts and CLI flags used while we were open; applied first
+                // so the frontend reloads the instance and profile they switched to
+                var request = LaunchArguments.Parse(args);
+                if (request != null)
+                    _services.GetRequiredService<IQuickActionService>().ApplyLaunchRequest(request);
+
+                _events.Publish(IpcEvents.AppSecondInstance, new { args });
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("IPC", $"Failed to handle second instance: {ex.Message}");
+            }
+        };
+
+        Electron.IpcMain.On("hyprism:window:minimize", (_) => GetMainWindow()?.Minimize());
+
+        Electron.IpcMain.On("hyprism:window:maximize", async (_) =>
+        {
+            var win = GetMainWindow();
+            if (win == null) return;
+            if (await win.IsMaximizedAsync()) win.Unmaximize();
+            else win.Maximize();
+        });
+
+        Electron.IpcMain.On("hyprism:window:close", (_) => GetMainWindow()?.Close());
+
+        Electron.IpcMain.On("hyprism:window:restart", (_) =>
+        {
+            try
+            {
+                Electron.App.Exit();
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to restart app: {ex.Message}");
+                GetMainWindow()?.Close();
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:browser:open", (args) =>
+        {
+            var url = ArgsToString(args);
+            if (!string.IsNullOrEmpty(url))
+                Electron.Shell.OpenExternalAsync(url);
+        });
+
+        // Takes a GameError (plus optional timestamp) and opens a prefilled GitHub issue; replies with its URL
+        Electron.IpcMain.On("hyprism:app:reportIssue", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                var error = JsonSerializer.Deserialize<GameErrorMessage>(json, JsonOpts) ?? new GameErrorMessage();
+                using var doc = JsonDocument.Parse(json);
+                DateTime? occurredAt = doc.RootElement.TryGetProperty("timestamp", out var ts)
+                    && ts.ValueKind == JsonValueKind.String && ts.TryGetDateTime(out var parsed) ? parsed : null;
+                Reply("hyprism:app:reportIssue:reply", issueReport.ReportIssue(error, occurredAt));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to open issue draft: {ex.Message}");
+                Reply("hyprism:app:reportIssue:reply", "");
+            }
+        });
+    }
+    
+    // #endregion
+
+    // #region Mods
+    // @ipc invoke hyprism:mods:list -> InstalledMod[]
+    // Every instance-bound call takes { instanceId }; { branch, version } still works but is deprecated
+    // @ipc invoke hyprism:mods:search -> ModSearchResult 15000
+    // @ipc invoke hyprism:mods:installed -> InstalledMod[]
+    // @ipc invoke hyprism:mods:uninstall -> boolean
+    // @ipc invoke hyprism:mods:uninstallImpact -> ModUninstallImpact | null 15000
+    // @ipc invoke hyprism:mods:checkUpdates -> InstalledMod[] 120000
+    // @ipc event hyprism:mods:updateCheck -> ModUpdateCheckProgress
+    // @ipc invoke hyprism:mods:install -> boolean 30000
+    // @ipc invoke hyprism:mods:resolveInstall -> ModResolveResult 60000
+    // @ipc invoke hyprism:mods:files -> ModFilesResult
+    // @ipc invoke hyprism:mods:categories -> ModCategory[]
+    // @ipc invoke hyprism:mods:categoryTree -> ModCategory[]
+    // @ipc invoke hyprism:mods:byAuthor -> ModAuthorResult 15000
+    // @ipc invoke hyprism:mods:cacheImages -> Record<string, string> 60000
+    // @ipc invoke hyprism:mods:installLocal -> boolean
+    // @ipc invoke hyprism:mods:installBase64 -> boolean
+    // @ipc send hyprism:mods:openFolder
+    // @ipc invoke hyprism:mods:toggle -> boolean
+    // @ipc invoke hyprism:mods:setAllEnabled -> ModBulkToggleResult 60000
+    // @ipc event hyprism:mods:bulkToggleProgress -> ModBulkToggleProgress
+    // @ipc invoke hyprism:mods:gameVersions -> string[] 15000
+    // @ipc event hyprism:mods:compatibilityWarning -> ModCompatibilityReport
+
+    private void RegisterModHandlers()
+    {
+        var modService = _services.GetRequiredService<IModService>();
+        var instanceService = _services.GetRequiredService<IInstanceService>();
+        var config = _services.GetRequiredService<IConfigService>();
+        var taskManager = _services.GetRequiredService<ITaskManagerService>();
+
+        bool warnedLegacyTarget = false;
+
+        modService.IncompatibleModsFound += (report) =>
+        {
+            _events.Publish(IpcEvents.ModsCompatibilityWarning, report);
+        };
+
+        // Game versions to pick an instance's version from
+        Electron.IpcMain.On("hyprism:mods:gameVersions", async (_) =>
+        {
+            try
+            {
+                Reply("hyprism:mods:gameVersions:reply", await modService.GetGameVersionsAsync());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods game versions failed: {ex.Message}");
+                Reply("hyprism:mods:gameVersions:reply", new List<string>());
+            }
+        });
+
+        // Mods always live in an instance: instanceId picks it, then the deprecated branch/version
+        // pair (older callers), then the selected instance
+        string? ResolveModInstancePath(JsonElement root)
+        {
+            var isObject = root.ValueKind == JsonValueKind.Object;
+            var instanceId = isObject && root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
+            if (!string.IsNullOrWhiteSpace(instanceId))
+            {
+                var byId = instanceService.GetInstancePathById(instanceId);
+                if (!string.IsNullOrWhiteSpace(byId))
+                    return byId;
+            }
+
+            if (isObject && root.TryGetProperty("branch", out var b) && root.TryGetProperty("version", out var v)
+                && v.ValueKind == JsonValueKind.Number)
+            {
+                if (!warnedLegacyTarget && string.IsNullOrWhiteSpace(instanceId))
+                {
+                    warnedLegacyTarget = true;
+                    Logger.Warning("IPC", "Mod requests by branch/version are deprecated; pass instanceId instead");
+                }
+
+                var existing = instanceService.FindExistingInstancePath(b.GetString() ?? "release", v.GetInt32());
+                if (!string.IsNullOrEmpty(existing))
+                    return existing;
+            }
+
+            var selected = instanceService.GetSelectedInstance();
+            if (selected != null)
+            {
+                var byId = instanceService.GetInstancePathById(selected.Id);
+                if (!string.IsNullOrEmpty(byId))
+                    return byId;
+
+                var selectedExisting = instanceService.FindExistingInstancePath(selected.Branch, selected.Version);
+                if (!string.IsNullOrEmpty(selectedExisting))
+                    return selectedExisting;
+            }
+
+            return null;
+        }
+
+        // Deprecated: same as hyprism:mods:installed, kept for older callers
+        Electron.IpcMain.On("hyprism:mods:list", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instancePath = ResolveModInstancePath(doc.RootElement);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Reply("hyprism:mods:list:reply", new List<object>());
+                    return;
+                }
+
+                Reply("hyprism:mods:list:reply", modService.GetInstanceInstalledMods(instancePath));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods list failed: {ex.Message}");
+                Reply("hyprism:mods:list:reply", new List<object>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:mods:search", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                
+                var query = root.TryGetProperty("query", out var q) ? q.GetString() ?? "" : "";
+                var page = root.TryGetProperty("page", out var p) ? p.GetInt32() : 0;
+                var pageSize = root.TryGetProperty("pageSize", out var ps) ? ps.GetInt32() : 20;
+                var sortField = root.TryGetProperty("sortField", out var sf) ? sf.GetInt32() : 1;
+                var sortOrder = root.TryGetProperty("sortOrder", out var so) ? so.GetInt32() : 1;
+                
+                var parameters = new SearchModsParams
+                {
+                    Query = query,
+                    Page = page,
+                    PageSize = pageSize,
+                    SortField = sortField,
+                    SortOrder = sortOrder
+                };
+
+                if (root.TryGetProperty("classId", out var cls) && cls.ValueKind == JsonValueKind.Number && cls.TryGetInt32(out var classId) && classId > 0)
+                    parameters.ClassId = classId;
+
+                // categoryIds are numbers; the older categories field sends them as strings
+                if (root.TryGetProperty("categoryIds", out var ids) && ids.ValueKind == JsonValueKind.Array)
+                {
+                    parameters.CategoryIds.AddRange(ids.EnumerateArray()
+                        .Where(c => c.ValueKind == JsonValueKind.Number)
+                        .Select(c => c.GetInt32()));
+                }
+                if (root.TryGetProperty("categories", out var cats) && cats.ValueKind == JsonValueKind.Array)
+                {
+                    parameters.CategoryIds.AddRange(cats.EnumerateArray()
+                        .Select(c => int.TryParse(c.GetString(), out var id) ? id : 0)
+                        .Where(id => id > 0));
+                }
+                
+                var result = await modService.SearchModsAsync(parameters);
+                Reply("hyprism:mods:search:reply", result);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods search failed: {ex.Message}");
+                Reply("hyprism:mods:search:reply", new { mods = new List<object>(), totalCount = 0 });
+            }
+        });
+
+        // Get installed mods for an instance
+        Electron.IpcMain.On("hyprism:mods:installed", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods installed skipped: no target instance found");
+                    Reply("hyprism:mods:installed:reply", new List<object>());
+                    return;
+                }
+                
+                var mods = modService.GetInstanceInstalledMods(instancePath);
+                Reply("hyprism:mods:installed:reply", mods);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods installed failed: {ex.Message}");
+                Reply("hyprism:mods:installed:reply", new List<object>());
+            }
+        });
+
+        // Uninstall a mod from an instance
+        Electron.IpcMain.On("hyprism:mods:uninstall", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var modId = root.GetProperty("modId").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods uninstall skipped: no target instance found");
+                    Reply("hyprism:mods:uninstall:reply", false);
+                    return;
+                }
+                
+                var removeOrphans = root.TryGetProperty("removeOrphans", out var ro) && ro.ValueKind == JsonValueKind.True;
+                Reply("hyprism:mods:uninstall:reply", await modService.UninstallInstanceModAsync(instancePath, modId, removeOrphans));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods uninstall failed: {ex.Message}");
+                Reply("hyprism:mods:uninstall:reply", false);
+            }
+        });
+
+        // Mods that depend on a mod, and dependencies left unused without it
+        Electron.IpcMain.On("hyprism:mods:uninstallImpact", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var modId = root.GetProperty("modId").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Reply("hyprism:mods:uninstallImpact:reply", null);
+                    return;
+                }
+
+                Reply("hyprism:mods:uninstallImpact:reply", await modService.GetUninstallImpactAsync(instancePath, modId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods uninstall impact failed: {ex.Message}");
+                Reply("hyprism:mods:uninstallImpact:reply", null);
+            }
+        });
+
+        // Check for mod updates (returns mods that have updates available)
+        Electron.IpcMain.On("hyprism:mods:checkUpdates", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods check updates skipped: no target instance found");
+                    Reply("hyprism:mods:checkUpdates:reply", new List<object>());
+                    return;
+                }
+                
+                var updates = await taskManager.RunAsync("mod-update-check", "Check mod updates",
+                    task => modService.CheckInstanceModUpdatesAsync(instancePath, progress =>
+                    {
+                        task.Report(progress.Total > 0 ? progress.Checked * 100.0 / progress.Total : 100);
+                        _events.Publish(IpcEvents.ModsUpdateCheck, progress);
+                    }, task.Token));
+                Reply("hyprism:mods:checkUpdates:reply", updates);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods check updates failed: {ex.Message}");
+                Reply("hyprism:mods:checkUpdates:reply", new List<object>());
+            }
+        });
+        
+        // Install a mod from CurseForge by modId and fileId
+        Electron.IpcMain.On("hyprism:mods:install", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var modId = root.GetProperty("modId").GetString() ?? "";
+                var fileId = root.GetProperty("fileId").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods install failed: no target instance selected");
+                    Reply("hyprism:mods:install:reply", false);
+                    return;
+                }
+                
+                var success = await modService.InstallModFileToInstanceAsync(modId, fileId, instancePath);
+                Reply("hyprism:mods:install:reply", success);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods install failed: {ex.Message}");
+                Reply("hyprism:mods:install:reply", false);
+            }
+        });
+        
+        // Install a mod from a pasted CurseForge link, slug or projectID:fileID
+        Electron.IpcMain.On("hyprism:mods:resolveInstall", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var input = root.TryGetProperty("input", out var i) ? i.GetString() ?? "" : "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods resolve install failed: no target instance selected");
+                    Reply("hyprism:mods:resolveInstall:reply", new ModResolveResult { MessageKey = "modManager.resolve.installFailed" });
+                    return;
+                }
+
+                Reply("hyprism:mods:resolveInstall:reply", await modService.ResolveAndInstallAsync(input, instancePath));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods resolve install failed: {ex.Message}");
+                Reply("hyprism:mods:resolveInstall:reply", new ModResolveResult { MessageKey = "modManager.resolve.installFailed" });
+            }
+        });
+
+        // Get available files for a mod
+        Electron.IpcMain.On("hyprism:mods:files", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var modId = root.GetProperty("modId").GetString() ?? "";
+                var page = root.TryGetProperty("page", out var p) ? p.GetInt32() : 0;
+                var pageSize = root.TryGetProperty("pageSize", out var ps) ? ps.GetInt32() : 20;
+                
+                var result = await modService.GetModFilesAsync(modId, page, pageSize);
+                Reply("hyprism:mods:files:reply", result);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods files failed: {ex.Message}");
+                Reply("hyprism:mods:files:reply", new { files = new List<object>(), totalCount = 0 });
+            }
+        });
+        
+        // Get mod categories
+        Electron.IpcMain.On("hyprism:mods:categories", async (_) =>
+        {
+            try
+            {
+                var categories = await modService.GetModCategoriesAsync();
+                Reply("hyprism:mods:categories:reply", categories);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods categories failed: {ex.Message}");
+                Reply("hyprism:mods:categories:reply", new List<object>());
+            }
+        });
+
+        // { authorId?, authorName?, page?, pageSize? } — the name is looked up when the ID is unknown
+        Electron.IpcMain.On("hyprism:mods:byAuthor", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                int? authorId = root.TryGetProperty("authorId", out var a) && a.ValueKind == JsonValueKind.Number && a.TryGetInt32(out var id) ? id : null;
+                var authorName = root.TryGetProperty("authorName", out var n) ? n.GetString() : null;
+                var page = root.TryGetProperty("page", out var p) ? p.GetInt32() : 0;
+                var pageSize = root.TryGetProperty("pageSize", out var ps) ? ps.GetInt32() : 20;
+                Reply("hyprism:mods:byAuthor:reply", await modService.GetModsByAuthorAsync(authorId, authorName, page, pageSize));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods by author failed: {ex.Message}");
+                Reply("hyprism:mods:byAuthor:reply", new ModAuthorResult());
+            }
+        });
 
-    private void RegisterProfileHandlers()
+        // { urls: string[] } — screenshots of the opened mod; URLs that failed are missing from the reply
+        Electron.IpcMain.On("hyprism:mods:cacheImages", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var urls = doc.RootElement.TryGetProperty("urls", out var u) && u.ValueKind == JsonValueKind.Array
+                    ? u.EnumerateArray().Select(x => x.GetString() ?? "").Where(x => x.Length > 0).ToList()
+                    : new List<string>();
+                Reply("hyprism:mods:cacheImages:reply", await modService.GetCachedImagesAsync(urls));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Caching mod images failed: {ex.Message}");
+                Reply("hyprism:mods:cacheImages:reply", new Dictionary<string, string>());
+            }
+        });
+
+        // Classes with their categories nested, for the nested browse filters
+        Electron.IpcMain.On("hyprism:mods:categoryTree", async (_) =>
+        {
+            try
+            {
+                Reply("hyprism:mods:categoryTree:reply", await modService.GetModCategoryTreeAsync());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods category tree failed: {ex.Message}");
+                Reply("hyprism:mods:categoryTree:reply", new List<object>());
+            }
+        });
+        
+        // Install mod from local file path
+        Electron.IpcMain.On("hyprism:mods:installLocal", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var sourcePath = root.GetProperty("sourcePath").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods install local failed: no target instance selected");
+                    Reply("hyprism:mods:installLocal:reply", false);
+                    return;
+                }
+                
+                var success = await modService.InstallLocalModFile(sourcePath, instancePath);
+                Reply("hyprism:mods:installLocal:reply", success);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods install local failed: {ex.Message}");
+                Reply("hyprism:mods:installLocal:reply", false);
+            }
+        });
+        
+        // Install mod from base64-encoded content
+        Electron.IpcMain.On("hyprism:mods:installBase64", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var fileName = root.GetProperty("fileName").GetString() ?? "";
+                var base64Content = root.GetProperty("base64Content").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods install base64 failed: no target instance selected");
+                    Reply("hyprism:mods:installBase64:reply", false);
+                    return;
+                }
+                
+                var success = await modService.InstallModFromBase64(fileName, base64Content, instancePath);
+                Reply("hyprism:mods:installBase64:reply", success);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods install base64 failed: {ex.Message}");
+                Reply("hyprism:mods:installBase64:reply", false);
+            }
+        });
+        
+        // Open the mods folder for an instance
+        Electron.IpcMain.On("hyprism:mods:openFolder", (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Open mods folder skipped: no target instance selected");
+                    return;
+                }
+                
+                var modsPath = Path.Combine(instancePath, "UserData", "Mods");
+                Directory.CreateDirectory(modsPath);
+                Electron.Shell.OpenPathAsync(modsPath);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Open mods folder failed: {ex.Message}");
+            }
+        });
+        
+        // Toggle mod enabled/disabled (renames .jar <-> .jar.disabled)
+        Electron.IpcMain.On("hyprism:mods:toggle", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var modId = root.GetProperty("modId").GetString() ?? "";
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods toggle skipped: no target instance found");
+                    Reply("hyprism:mods:toggle:reply", false);
+                    return;
+                }
+                
+                Reply("hyprism:mods:toggle:reply", await modService.ToggleInstanceModAsync(instancePath, modId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods toggle failed: {ex.Message}");
+                Reply("hyprism:mods:toggle:reply", false);
+            }
+        });
+
+        // Enable or disable every mod of an instance
+        Electron.IpcMain.On("hyprism:mods:setAllEnabled", async (args) =>
+        {
+            try
+            {
+                var json = ArgsToJson(args);
+                using var doc = JsonDocument.Parse(json);
+                var root = doc.RootElement;
+                var enabled = root.TryGetProperty("enabled", out var e) && e.ValueKind == JsonValueKind.True;
+                var instancePath = ResolveModInstancePath(root);
+                if (string.IsNullOrEmpty(instancePath))
+                {
+                    Logger.Warning("IPC", "Mods set all enabled skipped: no target instance found");
+                    Reply("hyprism:mods:setAllEnabled:reply", new ModBulkToggleResult());
+                    return;
+                }
+
+                Reply("hyprism:mods:setAllEnabled:reply", await modService.SetAllInstanceModsEnabledAsync(instancePath, enabled,
+                    progress => _events.Publish(IpcEvents.ModsBulkToggleProgress, progress)));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mods set all enabled failed: {ex.Message}");
+                Reply("hyprism:mods:setAllEnabled:reply", new ModBulkToggleResult());
+            }
+        });
+    }
+
+    // #region Mod Bisect
+    // @ipc invoke hyprism:mods:bisectStart -> { state: ModBisectState | null, error?: string }
+    // @ipc invoke hyprism:mods:bisectState -> ModBisectState | null
+    // @ipc send hyprism:mods:bisectLaunch
+    // @ipc invoke hyprism:mods:bisectReport -> { state: ModBisectState | null, error?: string }
+    // @ipc invoke hyprism:mods:bisectCancel -> boolean
+
+    private void RegisterModBisectHandlers()
     {
-        var profileService = _services.GetRequiredService<IProfileService>();
-        var profileMgmt = _services.GetRequiredService<IProfileManagementService>();
+        var bisect = _services.GetRequiredService<IModBisectService>();
+
+        Electron.IpcMain.On("hyprism:mods:bisectStart", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var instanceId = doc.RootElement.GetProperty("instanceId").GetString() ?? "";
+                Reply("hyprism:mods:bisectStart:reply", new { state = bisect.Start(instanceId) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to start mod bisect: {ex.Message}");
+                Reply("hyprism:mods:bisectStart:reply", new { state = (ModBisectState?)null, error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:mods:bisectState", (_) =>
+        {
+            Reply("hyprism:mods:bisectState:reply", bisect.GetState());
+        });
+
+        // Progress arrives on hyprism:game:progress like a normal launch
+        Electron.IpcMain.On("hyprism:mods:bisectLaunch", async (_) =>
+        {
+            try
+            {
+                var result = await bisect.LaunchStepAsync();
+                if (!string.IsNullOrEmpty(result.Error))
+                    Logger.Warning("IPC", $"Mod bisect step ended: {result.Error}");
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Mod bisect launch failed: {ex.Message}");
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:mods:bisectReport", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var crashed = doc.RootElement.GetProperty("crashed").GetBoolean();
+                Reply("hyprism:mods:bisectReport:reply", new { state = bisect.Report(crashed) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to record mod bisect result: {ex.Message}");
+                Reply("hyprism:mods:bisectReport:reply", new { state = bisect.GetState(), error = ex.Message });
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:mods:bisectCancel", (_) =>
+        {
+            Reply("hyprism:mods:bisectCancel:reply", bisect.Cancel());
+        });
+    }
+
+    // #endregion
+
+    // #region System Info
+    // @ipc invoke hyprism:system:gpuAdapters -> GpuAdapterInfo[]
+    // @ipc invoke hyprism:system:networkStatus -> NetworkStatus 20000
+    // @ipc invoke hyprism:system:bandwidthUsage -> BandwidthMonth[]
+    // @ipc invoke hyprism:system:resetBandwidthUsage -> boolean
+    // @ipc invoke hyprism:system:platformSupport -> PlatformComponentSupport[]
+    // @ipc invoke hyprism:system:graphicsCheck -> GraphicsDiagnostics 30000
+    // @ipc invoke hyprism:system:defenderCheck -> DefenderReport 30000
+    // @ipc event hyprism:game:graphicsWarning -> GraphicsDiagnostics
+
+    private void RegisterSystemHandlers()
+    {
+        var gpuService = _services.GetRequiredService<GpuDetectionService>();
+        var connectivityService = _services.GetRequiredService<IConnectivityService>();
+        var bandwidth = _services.GetRequiredService<IBandwidthService>();
+        var graphicsDiagnostics = _services.GetRequiredService<IGraphicsDiagnosticsService>();
+        var defender = _services.GetRequiredService<IDefenderService>();
+
+        graphicsDiagnostics.ProblemsFound += (diagnostics) =>
+        {
+            _events.Publish(IpcEvents.GameGraphicsWarning, diagnostics);
+        };
+
+        Electron.IpcMain.On("hyprism:system:gpuAdapters", (_) =>
+        {
+            try
+            {
+                var adapters = gpuService.GetAdapters();
+                Reply("hyprism:system:gpuAdapters:reply", adapters);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get GPU adapters: {ex.Message}");
+                Reply("hyprism:system:gpuAdapters:reply", new List<object>());
+            }
+        });
+
+        // { refresh?: boolean } — probes again instead of returning the last result
+        Electron.IpcMain.On("hyprism:system:networkStatus", async (args) =>
+        {
+            try
+            {
+                bool refresh = false;
+                if (args != null)
+                {
+                    using var doc = JsonDocument.Parse(ArgsToJson(args));
+                    if (doc.RootElement.ValueKind == JsonValueKind.Object &&
+                        doc.RootElement.TryGetProperty("refresh", out var r) && r.ValueKind == JsonValueKind.True)
+                    {
+                        refresh = true;
+                    }
+                }
+
+                Reply("hyprism:system:networkStatus:reply", await connectivityService.GetNetworkStatusAsync(refresh));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Network status check failed: {ex.Message}");
+                Reply("hyprism:system:networkStatus:reply", new NetworkStatus());
+            }
+        });
+
+        // { months? } — the current month and the ones before it, newest first
+        Electron.IpcMain.On("hyprism:system:bandwidthUsage", (args) =>
+        {
+            try
+            {
+                var months = 1;
+                if (args != null)
+                {
+                    using var doc = JsonDocument.Parse(ArgsToJson(args));
+                    if (doc.RootElement.ValueKind == JsonValueKind.Object &&
+                        doc.RootElement.TryGetProperty("months", out var m) && m.ValueKind == JsonValueKind.Number)
+                    {
+                        months = m.GetInt32();
+                    }
+                }
+
+                Reply("hyprism:system:bandwidthUsage:reply", bandwidth.GetBandwidthUsage(months));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read bandwidth usage: {ex.Message}");
+                Reply("hyprism:system:bandwidthUsage:reply", new List<object>());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:system:resetBandwidthUsage", (_) =>
+        {
+            try
+            {
+                bandwidth.Reset();
+                Reply("hyprism:system:resetBandwidthUsage:reply", true);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to reset bandwidth usage: {ex.Message}");
+                Reply("hyprism:system:resetBandwidthUsage:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:system:platformSupport", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:system:platformSupport:reply", PlatformSupport.GetMatrix());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Platform support check failed: {ex.Message}");
+                Reply("hyprism:system:platformSupport:reply", new List<PlatformComponentSupport>());
+            }
+        });
 
-        Electron.IpcMain.On("hyprism:profile:get", (_) =>
+        // { refresh?: boolean } — checks again instead of returning the result of the last launch
+        Electron.IpcMain.On("hyprism:system:graphicsCheck", async (args) =>
         {
-            Reply("hyprism:profile:get:reply", new
+            try
             {
-                nick = profileService.GetNick(),
-                uuid = profileService.GetUUID(),
-                avatarPath = profileService.GetAvatarPreview()
-            });
+                bool refresh = false;
+                if (args != null)
+                {
+                    using var doc = JsonDocument.Parse(ArgsToJson(args));
+                    if (doc.RootElement.ValueKind == JsonValueKind.Object &&
+                        doc.RootElement.TryGetProperty("refresh", out var r) && r.ValueKind == JsonValueKind.True)
+                    {
+                        refresh = true;
+                    }
+                }
+
+                Reply("hyprism:system:graphicsCheck:reply", await graphicsDiagnostics.RunAsync(refresh));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Graphics check failed: {ex.Message}");
+                Reply("hyprism:system:graphicsCheck:reply", new GraphicsDiagnostics { Platform = UtilityService.GetOS() });
+            }
         });
 
-        Electron.IpcMain.On("hyprism:profile:list", (_) =>
+        // Offered with access-denied and missing-file errors; Windows only
+        Electron.IpcMain.On("hyprism:system:defenderCheck", async (_) =>
         {
-            Reply("hyprism:profile:list:reply", profileMgmt.GetProfiles());
+            try
+            {
+                Reply("hyprism:system:defenderCheck:reply", await defender.CheckAsync());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Defender check failed: {ex.Message}");
+                Reply("hyprism:system:defenderCheck:reply", new DefenderReport());
+            }
         });
+    }
 
-        Electron.IpcMain.On("hyprism:profile:switch", (args) =>
+    // #endregion
+
+    // #region Background Tasks
+    // @ipc invoke hyprism:tasks:list -> BackgroundTask[]
+    // @ipc invoke hyprism:tasks:cancel -> boolean
+    // @ipc event hyprism:tasks:updated -> BackgroundTask
+
+    private void RegisterTaskHandlers()
+    {
+        var taskManager = _services.GetRequiredService<ITaskManagerService>();
+
+        taskManager.TaskUpdated += (task) =>
+        {
+            _events.Publish(IpcEvents.TasksUpdated, task);
+        };
+
+        Electron.IpcMain.On("hyprism:tasks:list", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var index = doc.RootElement.GetProperty("index").GetInt32();
-                Reply("hyprism:profile:switch:reply", new { success = profileMgmt.SwitchProfile(index) });
+                Reply("hyprism:tasks:list:reply", taskManager.ListTasks());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Profile switch failed: {ex.Message}");
-                Reply("hyprism:profile:switch:reply", new { success = false });
+                Logger.Error("IPC", $"Failed to list tasks: {ex.Message}");
+                Reply("hyprism:tasks:list:reply", new List<object>());
             }
         });
 
-        Electron.IpcMain.On("hyprism:profile:setNick", (args) =>
+        Electron.IpcMain.On("hyprism:tasks:cancel", (args) =>
         {
-            var nick = ArgsToString(args);
-            var success = profileService.SetNick(nick);
-            Reply("hyprism:profile:setNick:reply", new { success });
+            try
+            {
+                var taskId = ArgsToString(args);
+                Reply("hyprism:tasks:cancel:reply", taskManager.CancelTask(taskId));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to cancel task: {ex.Message}");
+                Reply("hyprism:tasks:cancel:reply", false);
+            }
         });
+    }
 
-        Electron.IpcMain.On("hyprism:profile:setUuid", (args) =>
+    // #endregion
+
+    // #region Operation Progress
+    // @ipc event hyprism:progress:update -> OperationProgress
+
+    private void RegisterProgressHandlers()
+    {
+        var progressService = _services.GetRequiredService<IProgressNotificationService>();
+
+        progressService.OperationProgressChanged += (progress) =>
         {
-            var uuid = ArgsToString(args);
-            var success = profileService.SetUUID(uuid);
-            Reply("hyprism:profile:setUuid:reply", new { success });
+            _events.Publish(IpcEvents.OperationProgress, progress);
+        };
+    }
+
+    // #endregion
+
+    // #region Telemetry
+    // @ipc invoke hyprism:telemetry:status -> TelemetryStatus
+    // @ipc invoke hyprism:telemetry:setEnabled -> TelemetryStatus
+    // @ipc invoke hyprism:telemetry:preview -> TelemetryBatch
+
+    private void RegisterTelemetryHandlers()
+    {
+        var telemetry = _services.GetRequiredService<ITelemetryService>();
+
+        Electron.IpcMain.On("hyprism:telemetry:status", (_) =>
+        {
+            Reply("hyprism:telemetry:status:reply", telemetry.GetStatus());
         });
 
-        Electron.IpcMain.On("hyprism:profile:create", (args) =>
+        Electron.IpcMain.On("hyprism:telemetry:setEnabled", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var name = doc.RootElement.GetProperty("name").GetString() ?? "";
-                var uuid = doc.RootElement.GetProperty("uuid").GetString() ?? "";
-                var isOfficial = doc.RootElement.TryGetProperty("isOfficial", out var officialProp) && officialProp.GetBoolean();
-                
-                var profile = profileMgmt.CreateProfile(name, uuid);
-                if (profile != null)
-                {
-                    profile.IsOfficial = isOfficial;
-                    _services.GetRequiredService<ConfigService>().SaveConfig();
-                }
-                Reply("hyprism:profile:create:reply", profile != null ? (object)profile : new { error = "Failed to create profile" });
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var enabled = doc.RootElement.TryGetProperty("enabled", out var prop) && prop.GetBoolean();
+                Reply("hyprism:telemetry:setEnabled:reply", telemetry.SetEnabled(enabled));
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Profile create failed: {ex.Message}");
-                Reply("hyprism:profile:create:reply", new { error = ex.Message });
+                Logger.Error("IPC", $"Failed to change telemetry setting: {ex.Message}");
+                Reply("hyprism:telemetry:setEnabled:reply", telemetry.GetStatus());
             }
         });
 
-        Electron.IpcMain.On("hyprism:profile:delete", (args) =>
+        // Shown in settings before opting in, so the user sees exactly what would be sent
+        Electron.IpcMain.On("hyprism:telemetry:preview", (_) =>
         {
-            var id = ArgsToString(args);
-            var success = profileMgmt.DeleteProfile(id);
-            Reply("hyprism:profile:delete:reply", new { success });
+            Reply("hyprism:telemetry:preview:reply", telemetry.GetPreview());
         });
+    }
 
-        Electron.IpcMain.On("hyprism:profile:activeIndex", (_) =>
+    // #endregion
+
+    // #region Error Reporting
+    // @ipc invoke hyprism:errorReporting:status -> ErrorReportingStatus
+    // @ipc invoke hyprism:errorReporting:setEnabled -> ErrorReportingStatus
+    // @ipc invoke hyprism:errorReporting:setEndpoint -> { status: ErrorReportingStatus, error?: string }
+    // @ipc invoke hyprism:errorReporting:preview -> ErrorReport
+
+    private void RegisterErrorReportingHandlers()
+    {
+        var errorReporting = _services.GetRequiredService<IErrorReportingService>();
+
+        Electron.IpcMain.On("hyprism:errorReporting:status", (_) =>
         {
-            Reply("hyprism:profile:activeIndex:reply", profileMgmt.GetActiveProfileIndex());
+            Reply("hyprism:errorReporting:status:reply", errorReporting.GetStatus());
         });
 
-        Electron.IpcMain.On("hyprism:profile:save", (_) =>
+        Electron.IpcMain.On("hyprism:errorReporting:setEnabled", (args) =>
         {
-            var profile = profileMgmt.SaveCurrentAsProfile();
-            Reply("hyprism:profile:save:reply", new { success = profile != null });
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var enabled = doc.RootElement.TryGetProperty("enabled", out var prop) && prop.GetBoolean();
+                Reply("hyprism:errorReporting:setEnabled:reply", errorReporting.SetEnabled(enabled));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to change error reporting setting: {ex.Message}");
+                Reply("hyprism:errorReporting:setEnabled:reply", errorReporting.GetStatus());
+            }
         });
 
-        Electron.IpcMain.On("hyprism:profile:duplicate", (args) =>
+        Electron.IpcMain.On("hyprism:errorReporting:setEndpoint", (args) =>
         {
-            var id = ArgsToString(args);
-            var profile = profileMgmt.DuplicateProfileWithoutData(id);
-            Reply("hyprism:profile:duplicate:reply", profile != null ? (object)profile : new { error = "Failed to duplicate" });
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var endpoint = doc.RootElement.TryGetProperty("endpoint", out var prop) ? prop.GetString() ?? "" : "";
+                Reply("hyprism:errorReporting:setEndpoint:reply", new { status = errorReporting.SetEndpoint(endpoint) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("IPC", $"Failed to set error reporting endpoint: {ex.Message}");
+                Reply("hyprism:errorReporting:setEndpoint:reply", new { status = errorReporting.GetStatus(), error = ex.Message });
+            }
         });
 
-        Electron.IpcMain.On("hyprism:profile:openFolder", (_) =>
+        // Lets the user see exactly what a report contains before consenting
+        Electron.IpcMain.On("hyprism:errorReporting:preview", (_) =>
         {
-            profileMgmt.OpenCurrentProfileFolder();
+            Reply("hyprism:errorReporting:preview:reply", errorReporting.GetPreview());
         });
+    }
 
-        Electron.IpcMain.On("hyprism:profile:avatarForUuid", (args) =>
+    // #endregion
+
+    // #region Automation API
+    // @ipc invoke hyprism:automation:status -> AutomationApiStatus
+    // @ipc invoke hyprism:automation:setEnabled -> AutomationApiStatus
+    // @ipc invoke hyprism:automation:regenerateToken -> AutomationApiStatus
+
+    private void RegisterAutomationHandlers()
+    {
+        var automation = _services.GetRequiredService<IAutomationApiService>();
+
+        Electron.IpcMain.On("hyprism:automation:status", (_) =>
         {
-            var uuid = ArgsToString(args);
-            var path = profileService.GetAvatarPreviewForUUID(uuid);
-            Reply("hyprism:profile:avatarForUuid:reply", path ?? "");
+            Reply("hyprism:automation:status:reply", automation.GetStatus());
+        });
+
+        Electron.IpcMain.On("hyprism:automation:setEnabled", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var enabled = doc.RootElement.TryGetProperty("enabled", out var prop) && prop.GetBoolean();
+                Reply("hyprism:automation:setEnabled:reply", automation.SetEnabled(enabled));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to change automation API setting: {ex.Message}");
+                Reply("hyprism:automation:setEnabled:reply", automation.GetStatus());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:automation:regenerateToken", (_) =>
+        {
+            try
+            {
+                Reply("hyprism:automation:regenerateToken:reply", automation.RegenerateToken());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to regenerate automation API token: {ex.Message}");
+                Reply("hyprism:automation:regenerateToken:reply", automation.GetStatus());
+            }
         });
     }
 
     // #endregion
 
-    // #region Hytale Auth
-    // @ipc invoke hyprism:auth:status -> HytaleAuthStatus
-    // @ipc invoke hyprism:auth:login -> HytaleAuthStatus
-    // @ipc invoke hyprism:auth:logout -> { success: boolean }
+    // #region Search
+    // @ipc invoke hyprism:search:query -> SearchResult[] 15000
 
-    private void RegisterAuthHandlers()
+    private void RegisterSearchHandlers()
     {
-        var authService = _services.GetRequiredService<HytaleAuthService>();
+        var search = _services.GetRequiredService<ISearchService>();
 
-        Electron.IpcMain.On("hyprism:auth:status", (_) =>
+        // { query, limit?, includeRemote? }
+        Electron.IpcMain.On("hyprism:search:query", async (args) =>
         {
-            Reply("hyprism:auth:status:reply", authService.GetAuthStatus());
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var query = root.TryGetProperty("query", out var q) && q.ValueKind == JsonValueKind.String ? q.GetString() ?? "" : "";
+                var limit = root.TryGetProperty("limit", out var l) && l.ValueKind == JsonValueKind.Number ? l.GetInt32() : 20;
+                var includeRemote = !root.TryGetProperty("includeRemote", out var r) || r.ValueKind != JsonValueKind.False;
+
+                Reply("hyprism:search:query:reply", await search.SearchAsync(query, limit, includeRemote));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Search failed: {ex.Message}");
+                Reply("hyprism:search:query:reply", new List<object>());
+            }
         });
+    }
 
-        Electron.IpcMain.On("hyprism:auth:login", async (_) =>
+    // #endregion
+
+    // #region Dashboard
+    // @ipc invoke hyprism:app:dashboard -> DashboardInfo 15000
+
+    private void RegisterDashboardHandlers()
+    {
+        var dashboard = _services.GetRequiredService<IDashboardService>();
+
+        Electron.IpcMain.On("hyprism:app:dashboard", async (_) =>
         {
             try
             {
-                var session = await authService.LoginAsync();
-                Reply("hyprism:auth:login:reply", authService.GetAuthStatus());
+                Reply("hyprism:app:dashboard:reply", await dashboard.GetDashboardAsync());
             }
-            catch (HytaleNoProfileException)
+            catch (Exception ex)
             {
-                Logger.Warning("IPC", "Auth login: no Hytale game profile found");
-                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = "no_profile", error = "No game profiles found in this Hytale account" });
+                Logger.Error("IPC", $"Dashboard failed: {ex.Message}");
+                Reply("hyprism:app:dashboard:reply", new DashboardInfo());
             }
-            catch (HytaleAuthException ex)
+        });
+    }
+
+    // #endregion
+
+    // #region Startup Checks
+    // @ipc invoke hyprism:app:startupChecks -> StartupCheckReport
+    // @ipc invoke hyprism:app:recheckStartup -> StartupCheckReport 30000
+
+    private void RegisterStartupCheckHandlers()
+    {
+        var startupChecks = _services.GetRequiredService<IStartupCheckService>();
+
+        Electron.IpcMain.On("hyprism:app:startupChecks", (_) =>
+        {
+            try
             {
-                Logger.Error("IPC", $"Auth login error ({ex.ErrorType}): {ex.Message}");
-                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = ex.ErrorType, error = ex.Message });
+                Reply("hyprism:app:startupChecks:reply", startupChecks.GetLastReport());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Auth login failed: {ex.Message}");
-                Reply("hyprism:auth:login:reply", new { loggedIn = false, errorType = "unknown", error = ex.Message });
+                Logger.Error("IPC", $"Failed to get startup checks: {ex.Message}");
+                Reply("hyprism:app:startupChecks:reply", new StartupCheckReport());
             }
         });
 
-        Electron.IpcMain.On("hyprism:auth:logout", (_) =>
+        // After the user moved the data folder or freed space
+        Electron.IpcMain.On("hyprism:app:recheckStartup", async (_) =>
         {
-            authService.Logout();
-            Reply("hyprism:auth:logout:reply", new { success = true });
+            try
+            {
+                Reply("hyprism:app:recheckStartup:reply", await Task.Run(startupChecks.Run));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Startup checks failed: {ex.Message}");
+                Reply("hyprism:app:recheckStartup:reply", new StartupCheckReport());
+            }
         });
     }
 
     // #endregion
 
-    // #region Settings
-    // @ipc invoke hyprism:settings:get -> SettingsSnapshot
-    // @ipc invoke hyprism:settings:update -> { success: boolean }
+    // #region Launcher Update
+    // @ipc invoke hyprism:update:status -> LauncherUpdateCheck | null
+    // @ipc event hyprism:update:available -> LauncherUpdateInfo
 
-    private void RegisterSettingsHandlers()
+    private void RegisterLauncherUpdateHandlers()
     {
-        var settings = _services.GetRequiredService<ISettingsService>();
-        var appPath = _services.GetRequiredService<AppPathConfiguration>();
+        var updateService = _services.GetRequiredService<IUpdateService>();
 
-        Electron.IpcMain.On("hyprism:settings:get", (_) =>
+        updateService.LauncherUpdateAvailable += (info) =>
         {
-            var lang = settings.GetLanguage();
-            Reply("hyprism:settings:get:reply", new
+            _events.Publish(IpcEvents.UpdateAvailable, info);
+        };
+
+        Electron.IpcMain.On("hyprism:update:status", (_) =>
+        {
+            try
             {
-                language = lang,
-                musicEnabled = settings.GetMusicEnabled(),
-                launcherBranch = settings.GetLauncherBranch(),
-                versionType = settings.GetVersionType(),
-                selectedVersion = settings.GetSelectedVersion(),
-                closeAfterLaunch = settings.GetCloseAfterLaunch(),
-                showDiscordAnnouncements = settings.GetShowDiscordAnnouncements(),
-                disableNews = settings.GetDisableNews(),
-                backgroundMode = settings.GetBackgroundMode(),
-                availableBackgrounds = settings.GetAvailableBackgrounds(),
-                accentColor = settings.GetAccentColor(),
-                hasCompletedOnboarding = settings.GetHasCompletedOnboarding(),
-                onlineMode = settings.GetOnlineMode(),
-                authDomain = settings.GetAuthDomain(),
-                dataDirectory = appPath.AppDir,
-                instanceDirectory = settings.GetInstanceDirectory(),
-                gpuPreference = settings.GetGpuPreference(),
-                launcherVersion = UpdateService.GetCurrentVersion()
-            });
+                Reply("hyprism:update:status:reply", updateService.GetLastUpdateCheck());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to read update status: {ex.Message}");
+                Reply("hyprism:update:status:reply", null);
+            }
         });
+    }
 
-        Electron.IpcMain.On("hyprism:settings:update", (args) =>
+    // #endregion
+
+    // #region Metered Connection
+    // @ipc invoke hyprism:metered:pending -> DownloadConfirmation[]
+    // @ipc invoke hyprism:metered:respond -> boolean
+    // @ipc event hyprism:metered:confirmationRequired -> DownloadConfirmation
+
+    private void RegisterMeteredConnectionHandlers()
+    {
+        var metered = _services.GetRequiredService<IMeteredConnectionService>();
+
+        metered.ConfirmationRequired += (confirmation) =>
+        {
+            _events.Publish(IpcEvents.MeteredConfirmationRequired, confirmation);
+        };
+
+        Electron.IpcMain.On("hyprism:metered:pending", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                var updates = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json);
-                if (updates != null)
-                    foreach (var (key, value) in updates)
-                        ApplySetting(settings, key, value);
+                Reply("hyprism:metered:pending:reply", metered.GetPendingConfirmations());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list pending downloads: {ex.Message}");
+                Reply("hyprism:metered:pending:reply", new List<object>());
+            }
+        });
 
-                Reply("hyprism:settings:update:reply", new { success = true });
+        // { id, allow }
+        Electron.IpcMain.On("hyprism:metered:respond", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var id = root.TryGetProperty("id", out var i) ? i.GetString() ?? "" : "";
+                var allow = root.TryGetProperty("allow", out var a) && a.ValueKind == JsonValueKind.True;
+                Reply("hyprism:metered:respond:reply", metered.Respond(id, allow));
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Settings update failed: {ex.Message}");
-                Reply("hyprism:settings:update:reply", new { success = false, error = ex.Message });
+                Logger.Error("IPC", $"Failed to answer download confirmation: {ex.Message}");
+                Reply("hyprism:metered:respond:reply", false);
             }
         });
     }
 
-    private static void ApplySetting(ISettingsService s, string key, JsonElement val)
-    {
-        switch (key)
-        {
-            case "language": s.SetLanguage(val.GetString() ?? "en-US"); break;
-            case "musicEnabled": s.SetMusicEnabled(val.GetBoolean()); break;
-            case "launcherBranch": s.SetLauncherBranch(val.GetString() ?? "release"); break;
-            case "versionType": s.SetVersionType(val.GetString() ?? "release"); break;
-            case "selectedVersion": s.SetSelectedVersion(val.ValueKind == JsonValueKind.Number ? val.GetInt32() : 0); break;
-            case "closeAfterLaunch": s.SetCloseAfterLaunch(val.GetBoolean()); break;
-            case "showDiscordAnnouncements": s.SetShowDiscordAnnouncements(val.GetBoolean()); break;
-            case "disableNews": s.SetDisableNews(val.GetBoolean()); break;
-            case "backgroundMode": s.SetBackgroundMode(val.GetString() ?? "default"); break;
-            case "accentColor": s.SetAccentColor(val.GetString() ?? "#7C5CFC"); break;
-            case "onlineMode": s.SetOnlineMode(val.GetBoolean()); break;
-            case "authDomain": s.SetAuthDomain(val.GetString() ?? ""); break;
-            case "gpuPreference": s.SetGpuPreference(val.GetString() ?? "dedicated"); break;
-            case "hasCompletedOnboarding": s.SetHasCompletedOnboarding(val.GetBoolean()); break;
-            default: Logger.Warning("IPC", $"Unknown setting key: {key}"); break;
-        }
-    }
-    
     // #endregion
 
-    // #region Localization
-    // @ipc invoke hyprism:i18n:get -> Record<string, string>
-    // @ipc invoke hyprism:i18n:current -> string
-    // @ipc invoke hyprism:i18n:set -> { success: boolean, language: string }
-    // @ipc invoke hyprism:i18n:languages -> LanguageInfo[]
+    // #region Trash
+    // @ipc invoke hyprism:trash:list -> TrashEntry[]
+    // @ipc invoke hyprism:trash:restore -> boolean 600000
+    // @ipc invoke hyprism:trash:delete -> boolean
+    // @ipc invoke hyprism:trash:empty -> number 600000
 
-    private void RegisterLocalizationHandlers()
+    private void RegisterTrashHandlers()
     {
-        var localization = _services.GetRequiredService<LocalizationService>();
-        var settings = _services.GetRequiredService<ISettingsService>();
+        var trash = _services.GetRequiredService<ITrashService>();
+        var progressService = _services.GetRequiredService<IProgressNotificationService>();
 
-        Electron.IpcMain.On("hyprism:i18n:current", (_) =>
+        Electron.IpcMain.On("hyprism:trash:list", (_) =>
         {
-            Reply("hyprism:i18n:current:reply", localization.CurrentLanguage);
+            try
+            {
+                Reply("hyprism:trash:list:reply", trash.GetEntries());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to list the trash: {ex.Message}");
+                Reply("hyprism:trash:list:reply", new List<object>());
+            }
         });
 
-        Electron.IpcMain.On("hyprism:i18n:set", (args) =>
+        // { id }
+        Electron.IpcMain.On("hyprism:trash:restore", async (args) =>
         {
-            var lang = ArgsToString(args);
-            if (string.IsNullOrEmpty(lang)) lang = "en-US";
-            Logger.Info("IPC", $"Language change requested: {lang}");
-            // Use SettingsService.SetLanguage which persists to config file
-            var success = settings.SetLanguage(lang);
-            Reply("hyprism:i18n:set:reply", new { success, language = success ? lang : localization.CurrentLanguage });
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                var id = data?["id"].GetString() ?? "";
+                Reply("hyprism:trash:restore:reply", await Task.Run(() => trash.RestoreFromTrash(id)));
+            }
+            catch (InstanceLockedException ex)
+            {
+                Logger.Warning("IPC", $"Not restored from the trash: {ex.Message}");
+                progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+                Reply("hyprism:trash:restore:reply", false);
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to restore from the trash: {ex.Message}");
+                Reply("hyprism:trash:restore:reply", false);
+            }
         });
 
-        Electron.IpcMain.On("hyprism:i18n:languages", (_) =>
+        // { id }
+        Electron.IpcMain.On("hyprism:trash:delete", (args) =>
         {
-            Reply("hyprism:i18n:languages:reply", LocalizationService.GetAvailableLanguages());
+            try
+            {
+                var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(ArgsToJson(args), JsonOpts);
+                Reply("hyprism:trash:delete:reply", trash.DeleteFromTrash(data?["id"].GetString() ?? ""));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to delete from the trash: {ex.Message}");
+                Reply("hyprism:trash:delete:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:trash:empty", async (_) =>
+        {
+            try
+            {
+                Reply("hyprism:trash:empty:reply", await Task.Run(trash.EmptyTrash));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to empty the trash: {ex.Message}");
+                Reply("hyprism:trash:empty:reply", 0);
+            }
         });
     }
-    
+
     // #endregion
 
-    // #region Window Controls
-    // @ipc send hyprism:window:minimize
-    // @ipc send hyprism:window:maximize
-    // @ipc send hyprism:window:close
-    // @ipc send hyprism:window:restart
-    // @ipc send hyprism:browser:open
+    // #region Cloud Sync
+    // @ipc invoke hyprism:sync:getConfig -> { config: CloudSyncConfig, hasPassword: boolean }
+    // @ipc invoke hyprism:sync:setConfig -> boolean
+    // @ipc invoke hyprism:sync:test -> { error?: string } 60000
+    // @ipc invoke hyprism:sync:run -> CloudSyncResult 600000
+    // @ipc invoke hyprism:sync:status -> { lastResult: CloudSyncResult | null, conflicts: CloudSyncConflict[] }
+    // @ipc invoke hyprism:sync:resolve -> { error?: string } 600000
 
-    private void RegisterWindowHandlers()
+    private void RegisterCloudSyncHandlers()
     {
-        Electron.IpcMain.On("hyprism:window:minimize", (_) => GetMainWindow()?.Minimize());
+        var cloudSync = _services.GetRequiredService<ICloudSyncService>();
+        var taskManager = _services.GetRequiredService<ITaskManagerService>();
 
-        Electron.IpcMain.On("hyprism:window:maximize", async (_) =>
+        Electron.IpcMain.On("hyprism:sync:getConfig", (_) =>
         {
-            var win = GetMainWindow();
-            if (win == null) return;
-            if (await win.IsMaximizedAsync()) win.Unmaximize();
-            else win.Maximize();
+            Reply("hyprism:sync:getConfig:reply", new { config = cloudSync.GetConfig(), hasPassword = cloudSync.HasWebDavPassword() });
         });
 
-        Electron.IpcMain.On("hyprism:window:close", (_) => GetMainWindow()?.Close());
+        // { config: CloudSyncConfig, password?: string }; an empty password removes the stored one
+        Electron.IpcMain.On("hyprism:sync:setConfig", (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var config = root.TryGetProperty("config", out var c) && c.ValueKind == JsonValueKind.Object
+                    ? JsonSerializer.Deserialize<CloudSyncConfig>(c.GetRawText(), JsonOpts)
+                    : null;
+                var password = root.TryGetProperty("password", out var p) && p.ValueKind == JsonValueKind.String ? p.GetString() : null;
 
-        Electron.IpcMain.On("hyprism:window:restart", (_) =>
+                Reply("hyprism:sync:setConfig:reply", config != null && cloudSync.SetConfig(config, password));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to save cloud sync settings: {ex.Message}");
+                Reply("hyprism:sync:setConfig:reply", false);
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:sync:test", async (_) =>
+        {
+            Reply("hyprism:sync:test:reply", new { error = await cloudSync.TestAsync() });
+        });
+
+        Electron.IpcMain.On("hyprism:sync:run", async (_) =>
         {
             try
             {
-                Electron.App.Exit();
+                var result = await taskManager.RunAsync("cloud-sync", "Cloud sync", handle => cloudSync.SyncAsync(handle.Token));
+                Reply("hyprism:sync:run:reply", result);
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Failed to restart app: {ex.Message}");
-                GetMainWindow()?.Close();
+                Logger.Error("IPC", $"Cloud sync failed: {ex.Message}");
+                Reply("hyprism:sync:run:reply", new CloudSyncResult { Error = ex.Message });
             }
         });
 
-        Electron.IpcMain.On("hyprism:browser:open", (args) =>
+        Electron.IpcMain.On("hyprism:sync:status", (_) =>
         {
-            var url = ArgsToString(args);
-            if (!string.IsNullOrEmpty(url))
-                Electron.Shell.OpenExternalAsync(url);
+            Reply("hyprism:sync:status:reply", new { lastResult = cloudSync.GetLastResult(), conflicts = cloudSync.GetConflicts() });
+        });
+
+        // { key, keepLocal }
+        Electron.IpcMain.On("hyprism:sync:resolve", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                var key = root.TryGetProperty("key", out var k) && k.ValueKind == JsonValueKind.String ? k.GetString() ?? "" : "";
+                var keepLocal = root.TryGetProperty("keepLocal", out var l) && l.ValueKind == JsonValueKind.True;
+
+                Reply("hyprism:sync:resolve:reply", new { error = await cloudSync.ResolveConflictAsync(key, keepLocal) });
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to resolve sync conflict: {ex.Message}");
+                Reply("hyprism:sync:resolve:reply", new { error = ex.Message });
+            }
         });
     }
-    
+
     // #endregion
 
-    // #region Mods
-    // @ipc invoke hyprism:mods:list -> InstalledMod[]
-    // @ipc invoke hyprism:mods:search -> ModSearchResult 15000
-    // @ipc invoke hyprism:mods:installed -> InstalledMod[]
-    // @ipc invoke hyprism:mods:uninstall -> boolean
-    // @ipc invoke hyprism:mods:checkUpdates -> InstalledMod[] 30000
-    // @ipc invoke hyprism:mods:install -> boolean 30000
-    // @ipc invoke hyprism:mods:files -> ModFilesResult
-    // @ipc invoke hyprism:mods:categories -> ModCategory[]
-    // @ipc invoke hyprism:mods:installLocal -> boolean
-    // @ipc invoke hyprism:mods:installBase64 -> boolean
-    // @ipc send hyprism:mods:openFolder
-    // @ipc invoke hyprism:mods:toggle -> boolean
+    // #region Quick Actions
+    // @ipc invoke hyprism:quickActions:run -> QuickActionResult
+    // @ipc invoke hyprism:quickActions:hotkeys -> QuickActionHotkey[]
+    // @ipc invoke hyprism:quickActions:setHotkey -> { hotkeys: QuickActionHotkey[], error?: string }
+    // @ipc event hyprism:quickActions:musicToggled -> { musicEnabled: boolean }
 
-    private void RegisterModHandlers()
+    private void RegisterQuickActionHandlers()
     {
-        var modService = _services.GetRequiredService<IModService>();
-        var instanceService = _services.GetRequiredService<IInstanceService>();
-        var config = _services.GetRequiredService<IConfigService>();
+        var quickActions = _services.GetRequiredService<IQuickActionService>();
+        var hotkeys = _services.GetRequiredService<IGlobalHotkeyService>();
 
-        string? ResolveModInstancePath(string branch, int version, string? instanceId = null)
+        // Music plays in the renderer, so a hotkey or API toggle has to reach it
+        quickActions.MusicToggled += (enabled) =>
         {
-            if (!string.IsNullOrWhiteSpace(instanceId))
+            _events.Publish(IpcEvents.QuickActionsMusicToggled, new { musicEnabled = enabled });
+        };
+
+        Electron.IpcMain.On("hyprism:quickActions:run", (args) =>
+        {
+            var action = "";
+            try
             {
-                var byId = instanceService.GetInstancePathById(instanceId);
-                if (!string.IsNullOrWhiteSpace(byId))
-                    return byId;
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                action = doc.RootElement.TryGetProperty("action", out var prop) ? prop.GetString() ?? "" : "";
+                Reply("hyprism:quickActions:run:reply", quickActions.Run(action));
             }
-
-            var existing = instanceService.FindExistingInstancePath(branch, version);
-            if (!string.IsNullOrEmpty(existing))
-                return existing;
-
-            var selected = instanceService.GetSelectedInstance();
-            if (selected != null)
+            catch (Exception ex)
             {
-                var byId = instanceService.GetInstancePathById(selected.Id);
-                if (!string.IsNullOrEmpty(byId))
-                    return byId;
-
-                var selectedExisting = instanceService.FindExistingInstancePath(selected.Branch, selected.Version);
-                if (!string.IsNullOrEmpty(selectedExisting))
-                    return selectedExisting;
+                Logger.Error("IPC", $"Quick action {action} failed: {ex.Message}");
+                Reply("hyprism:quickActions:run:reply", new QuickActionResult { Action = action, Message = ex.Message });
             }
+        });
 
-            return null;
-        }
-
-        Electron.IpcMain.On("hyprism:mods:list", (_) =>
+        Electron.IpcMain.On("hyprism:quickActions:hotkeys", async (_) =>
         {
             try
             {
-                var branch = config.Configuration.LauncherBranch ?? "release";
-                var instancePath = ResolveModInstancePath(branch, 0);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Reply("hyprism:mods:list:reply", new List<object>());
-                    return;
-                }
+                Reply("hyprism:quickActions:hotkeys:reply", await hotkeys.GetHotkeysAsync());
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Failed to get hotkeys: {ex.Message}");
+                Reply("hyprism:quickActions:hotkeys:reply", new List<QuickActionHotkey>());
+            }
+        });
 
-                Reply("hyprism:mods:list:reply", modService.GetInstanceInstalledMods(instancePath));
+        Electron.IpcMain.On("hyprism:quickActions:setHotkey", async (args) =>
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var action = doc.RootElement.TryGetProperty("action", out var a) ? a.GetString() ?? "" : "";
+                var accelerator = doc.RootElement.TryGetProperty("accelerator", out var acc) ? acc.GetString() ?? "" : "";
+                Reply("hyprism:quickActions:setHotkey:reply", new { hotkeys = await hotkeys.SetHotkeyAsync(action, accelerator) });
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods list failed: {ex.Message}");
+                Logger.Warning("IPC", $"Failed to set hotkey: {ex.Message}");
+                Reply("hyprism:quickActions:setHotkey:reply", new { hotkeys = await hotkeys.GetHotkeysAsync(), error = ex.Message });
             }
         });
+    }
 
-        Electron.IpcMain.On("hyprism:mods:search", async (args) =>
+    // #endregion
+
+    // #region Playtime Limits
+    // @ipc invoke hyprism:playtime:status -> PlaytimeStatus
+    // @ipc invoke hyprism:playtime:setLimits -> { status: PlaytimeStatus, error?: string }
+    // @ipc invoke hyprism:playtime:setPin -> { success: boolean, error?: string }
+    // @ipc invoke hyprism:playtime:override -> { status: PlaytimeStatus, error?: string }
+    // @ipc event hyprism:playtime:warning -> PlaytimeWarning
+
+    private void RegisterPlaytimeHandlers()
+    {
+        var playtime = _services.GetRequiredService<IPlaytimeLimitService>();
+
+        playtime.Warning += (warning) =>
+        {
+            _events.Publish(IpcEvents.PlaytimeWarning, warning);
+        };
+
+        Electron.IpcMain.On("hyprism:playtime:status", (_) =>
+        {
+            Reply("hyprism:playtime:status:reply", playtime.GetStatus());
+        });
+
+        Electron.IpcMain.On("hyprism:playtime:setLimits", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                
-                var query = root.TryGetProperty("query", out var q) ? q.GetString() ?? "" : "";
-                var page = root.TryGetProperty("page", out var p) ? p.GetInt32() : 0;
-                var pageSize = root.TryGetProperty("pageSize", out var ps) ? ps.GetInt32() : 20;
-                var sortField = root.TryGetProperty("sortField", out var sf) ? sf.GetInt32() : 1;
-                var sortOrder = root.TryGetProperty("sortOrder", out var so) ? so.GetInt32() : 1;
-                
-                var categories = Array.Empty<string>();
-                if (root.TryGetProperty("categories", out var cats) && cats.ValueKind == JsonValueKind.Array)
-                {
-                    categories = cats.EnumerateArray()
-                        .Select(c => c.GetString() ?? "")
-                        .Where(c => !string.IsNullOrEmpty(c))
-                        .ToArray();
-                }
-                
-                var result = await modService.SearchModsAsync(query, page, pageSize, categories, sortField, sortOrder);
-                Reply("hyprism:mods:search:reply", result);
+                var update = JsonSerializer.Deserialize<PlaytimeLimitUpdate>(ArgsToJson(args), JsonOpts)
+                    ?? throw new ArgumentException("Missing playtime limits");
+                Reply("hyprism:playtime:setLimits:reply", new { status = playtime.UpdateLimits(update) });
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods search failed: {ex.Message}");
-                Reply("hyprism:mods:search:reply", new { mods = new List<object>(), totalCount = 0 });
+                Logger.Warning("IPC", $"Failed to update playtime limits: {ex.Message}");
+                Reply("hyprism:playtime:setLimits:reply", new { status = playtime.GetStatus(), error = ex.Message });
             }
         });
 
-        // Get installed mods for a specific instance (by branch and version)
-        Electron.IpcMain.On("hyprism:mods:installed", (args) =>
+        Electron.IpcMain.On("hyprism:playtime:setPin", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", $"Mods installed skipped: no target instance found for {branch}/{version}");
-                    Reply("hyprism:mods:installed:reply", new List<object>());
-                    return;
-                }
-                
-                var mods = modService.GetInstanceInstalledMods(instancePath);
-                Reply("hyprism:mods:installed:reply", mods);
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var currentPin = doc.RootElement.TryGetProperty("currentPin", out var c) ? c.GetString() : null;
+                var newPin = doc.RootElement.TryGetProperty("newPin", out var n) ? n.GetString() ?? "" : "";
+                playtime.SetPin(currentPin, newPin);
+                Reply("hyprism:playtime:setPin:reply", new { success = true });
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods installed failed: {ex.Message}");
-                Reply("hyprism:mods:installed:reply", new List<object>());
+                Logger.Warning("IPC", $"Failed to set playtime PIN: {ex.Message}");
+                Reply("hyprism:playtime:setPin:reply", new { success = false, error = ex.Message });
             }
         });
 
-        // Uninstall a mod from an instance
-        Electron.IpcMain.On("hyprism:mods:uninstall", async (args) =>
+        Electron.IpcMain.On("hyprism:playtime:override", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var modId = root.GetProperty("modId").GetString() ?? "";
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", $"Mods uninstall skipped: no target instance found for {branch}/{version}");
-                    Reply("hyprism:mods:uninstall:reply", false);
-                    return;
-                }
-                
-                // Get current mods, remove the one with matching ID, save back
-                var mods = modService.GetInstanceInstalledMods(instancePath);
-                var modToRemove = mods.FirstOrDefault(m => m.Id == modId || m.Name == modId);
-                if (modToRemove != null)
-                {
-                    mods.Remove(modToRemove);
-                    
-                    // Delete the actual mod file if it exists
-                    if (!string.IsNullOrEmpty(modToRemove.FileName))
-                    {
-                        var modFilePath = Path.Combine(instancePath, "UserData", "Mods", modToRemove.FileName);
-                        if (File.Exists(modFilePath))
-                        {
-                            try { File.Delete(modFilePath); }
-                            catch (Exception ex) { Logger.Warning("IPC", $"Failed to delete mod file: {ex.Message}"); }
-                        }
-                    }
-                    
-                    await modService.SaveInstanceModsAsync(instancePath, mods);
-                    Reply("hyprism:mods:uninstall:reply", true);
-                }
-                else
-                {
-                    Reply("hyprism:mods:uninstall:reply", false);
-                }
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var pin = doc.RootElement.TryGetProperty("pin", out var p) ? p.GetString() ?? "" : "";
+                var minutes = doc.RootElement.TryGetProperty("minutes", out var m) && m.TryGetInt32(out var mv) ? mv : 30;
+                Reply("hyprism:playtime:override:reply", new { status = playtime.GrantOverride(pin, minutes) });
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods uninstall failed: {ex.Message}");
-                Reply("hyprism:mods:uninstall:reply", false);
+                Logger.Warning("IPC", $"Playtime override refused: {ex.Message}");
+                Reply("hyprism:playtime:override:reply", new { status = playtime.GetStatus(), error = ex.Message });
             }
         });
+    }
+
+    // #endregion
 
-        // Check for mod updates (returns mods that have updates available)
-        Electron.IpcMain.On("hyprism:mods:checkUpdates", async (args) =>
+    // #region Install Logs
+    // @ipc invoke hyprism:installLogs:list -> InstallLogInfo[]
+    // @ipc invoke hyprism:installLogs:get -> string | null
+    // @ipc send hyprism:installLogs:openFolder
+
+    private void RegisterInstallLogHandlers()
+    {
+        var installLogs = _services.GetRequiredService<IInstallLogService>();
+        var fileService = _services.GetRequiredService<IFileService>();
+
+        Electron.IpcMain.On("hyprism:installLogs:list", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", $"Mods check updates skipped: no target instance found for {branch}/{version}");
-                    Reply("hyprism:mods:checkUpdates:reply", new List<object>());
-                    return;
-                }
-                
-                var updates = await modService.CheckInstanceModUpdatesAsync(instancePath);
-                Reply("hyprism:mods:checkUpdates:reply", updates);
+                Reply("hyprism:installLogs:list:reply", installLogs.GetLogs());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods check updates failed: {ex.Message}");
-                Reply("hyprism:mods:checkUpdates:reply", new List<object>());
+                Logger.Error("IPC", $"Failed to list install logs: {ex.Message}");
+                Reply("hyprism:installLogs:list:reply", new List<object>());
             }
         });
-        
-        // Install a mod from CurseForge by modId and fileId
-        Electron.IpcMain.On("hyprism:mods:install", async (args) =>
+
+        Electron.IpcMain.On("hyprism:installLogs:get", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var modId = root.GetProperty("modId").GetString() ?? "";
-                var fileId = root.GetProperty("fileId").GetString() ?? "";
-                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
-                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", "Mods install failed: no target instance selected");
-                    Reply("hyprism:mods:install:reply", false);
-                    return;
-                }
-                
-                var success = await modService.InstallModFileToInstanceAsync(modId, fileId, instancePath);
-                Reply("hyprism:mods:install:reply", success);
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var name = doc.RootElement.TryGetProperty("name", out var n) ? n.GetString() ?? "" : "";
+                Reply("hyprism:installLogs:get:reply", installLogs.ReadLog(name));
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods install failed: {ex.Message}");
-                Reply("hyprism:mods:install:reply", false);
+                Logger.Error("IPC", $"Failed to read install log: {ex.Message}");
+                Reply("hyprism:installLogs:get:reply", null);
             }
         });
-        
-        // Get available files for a mod
-        Electron.IpcMain.On("hyprism:mods:files", async (args) =>
+
+        Electron.IpcMain.On("hyprism:installLogs:openFolder", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var modId = root.GetProperty("modId").GetString() ?? "";
-                var page = root.TryGetProperty("page", out var p) ? p.GetInt32() : 0;
-                var pageSize = root.TryGetProperty("pageSize", out var ps) ? ps.GetInt32() : 20;
-                
-                var result = await modService.GetModFilesAsync(modId, page, pageSize);
-                Reply("hyprism:mods:files:reply", result);
+                var dir = installLogs.GetLogsDir();
+                Directory.CreateDirectory(dir);
+                fileService.OpenFolder(dir);
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods files failed: {ex.Message}");
-                Reply("hyprism:mods:files:reply", new { files = new List<object>(), totalCount = 0 });
+                Logger.Error("IPC", $"Failed to open install logs folder: {ex.Message}");
             }
         });
-        
-        // Get mod categories
-        Electron.IpcMain.On("hyprism:mods:categories", async (_) =>
+    }
+    // #endregion
+
+    // #region Pre-release
+    // @ipc invoke hyprism:prerelease:notice -> PreReleaseNotice | null
+    // @ipc invoke hyprism:prerelease:acknowledge -> PreReleaseAcknowledgeResult
+    // @ipc event hyprism:prerelease:noticeRequired -> PreReleaseNotice
+
+    private void RegisterPreReleaseHandlers()
+    {
+        var preRelease = _services.GetRequiredService<IPreReleaseService>();
+
+        preRelease.NoticeRequired += (notice) =>
+        {
+            _events.Publish(IpcEvents.PreReleaseNoticeRequired, notice);
+        };
+
+        Electron.IpcMain.On("hyprism:prerelease:notice", (_) =>
         {
             try
             {
-                var categories = await modService.GetModCategoriesAsync();
-                Reply("hyprism:mods:categories:reply", categories);
+                Reply("hyprism:prerelease:notice:reply", preRelease.GetPendingNotice());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods categories failed: {ex.Message}");
-                Reply("hyprism:mods:categories:reply", new List<object>());
+                Logger.Error("IPC", $"Failed to get pre-release notice: {ex.Message}");
+                Reply("hyprism:prerelease:notice:reply", null);
             }
         });
-        
-        // Install mod from local file path
-        Electron.IpcMain.On("hyprism:mods:installLocal", async (args) =>
+
+        Electron.IpcMain.On("hyprism:prerelease:acknowledge", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var sourcePath = root.GetProperty("sourcePath").GetString() ?? "";
-                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
-                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", "Mods install local failed: no target instance selected");
-                    Reply("hyprism:mods:installLocal:reply", false);
-                    return;
-                }
-                
-                var success = await modService.InstallLocalModFile(sourcePath, instancePath);
-                Reply("hyprism:mods:installLocal:reply", success);
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var backupWorlds = doc.RootElement.TryGetProperty("backupWorlds", out var b) && b.ValueKind == JsonValueKind.True;
+                Reply("hyprism:prerelease:acknowledge:reply", preRelease.Acknowledge(backupWorlds));
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods install local failed: {ex.Message}");
-                Reply("hyprism:mods:installLocal:reply", false);
+                Logger.Error("IPC", $"Failed to acknowledge pre-release notice: {ex.Message}");
+                Reply("hyprism:prerelease:acknowledge:reply", new PreReleaseAcknowledgeResult { Errors = [ex.Message] });
             }
         });
-        
-        // Install mod from base64-encoded content
-        Electron.IpcMain.On("hyprism:mods:installBase64", async (args) =>
+    }
+    // #endregion
+
+    // #region Steam Deck
+    // @ipc invoke hyprism:steamDeck:status -> SteamDeckStatus
+    // @ipc invoke hyprism:steamDeck:setGamepadMode -> SteamDeckStatus
+    // @ipc invoke hyprism:steamDeck:addShortcut -> SteamShortcutResult
+
+    private void RegisterSteamDeckHandlers()
+    {
+        var steamDeck = _services.GetRequiredService<ISteamDeckService>();
+
+        Electron.IpcMain.On("hyprism:steamDeck:status", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var fileName = root.GetProperty("fileName").GetString() ?? "";
-                var base64Content = root.GetProperty("base64Content").GetString() ?? "";
-                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
-                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", "Mods install base64 failed: no target instance selected");
-                    Reply("hyprism:mods:installBase64:reply", false);
-                    return;
-                }
-                
-                var success = await modService.InstallModFromBase64(fileName, base64Content, instancePath);
-                Reply("hyprism:mods:installBase64:reply", success);
+                Reply("hyprism:steamDeck:status:reply", steamDeck.GetStatus());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods install base64 failed: {ex.Message}");
-                Reply("hyprism:mods:installBase64:reply", false);
+                Logger.Error("IPC", $"Failed to get Steam Deck status: {ex.Message}");
+                Reply("hyprism:steamDeck:status:reply", new SteamDeckStatus());
             }
         });
-        
-        // Open the mods folder for an instance
-        Electron.IpcMain.On("hyprism:mods:openFolder", (args) =>
+
+        Electron.IpcMain.On("hyprism:steamDeck:setGamepadMode", (args) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
-                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", "Open mods folder skipped: no target instance selected");
-                    return;
-                }
-                
-                var modsPath = Path.Combine(instancePath, "UserData", "Mods");
-                Directory.CreateDirectory(modsPath);
-                Electron.Shell.OpenPathAsync(modsPath);
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var mode = doc.RootElement.TryGetProperty("mode", out var m) ? m.GetString() ?? "auto" : "auto";
+                steamDeck.SetGamepadMode(mode);
+                Reply("hyprism:steamDeck:setGamepadMode:reply", steamDeck.GetStatus());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Open mods folder failed: {ex.Message}");
+                Logger.Error("IPC", $"Failed to set gamepad mode: {ex.Message}");
+                Reply("hyprism:steamDeck:setGamepadMode:reply", new SteamDeckStatus());
             }
         });
-        
-        // Toggle mod enabled/disabled (renames .jar <-> .jar.disabled)
-        Electron.IpcMain.On("hyprism:mods:toggle", async (args) =>
+
+        Electron.IpcMain.On("hyprism:steamDeck:addShortcut", (_) =>
         {
             try
             {
-                var json = ArgsToJson(args);
-                using var doc = JsonDocument.Parse(json);
-                var root = doc.RootElement;
-                var modId = root.GetProperty("modId").GetString() ?? "";
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
-                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
-                var instancePath = ResolveModInstancePath(branch, version, instanceId);
-                if (string.IsNullOrEmpty(instancePath))
-                {
-                    Logger.Warning("IPC", $"Mods toggle skipped: no target instance found for {branch}/{version}");
-                    Reply("hyprism:mods:toggle:reply", false);
-                    return;
-                }
-                
-                var mods = modService.GetInstanceInstalledMods(instancePath);
-                var mod = mods.FirstOrDefault(m => m.Id == modId || m.Name == modId);
-                if (mod == null || string.IsNullOrEmpty(mod.FileName))
-                {
-                    Reply("hyprism:mods:toggle:reply", false);
-                    return;
-                }
-                
-                var modsDir = Path.Combine(instancePath, "UserData", "Mods");
-                var currentPath = Path.Combine(modsDir, mod.FileName);
-                var fileName = mod.FileName;
-                var sourceExists = File.Exists(currentPath);
-
-                if (!sourceExists)
-                {
-                    // Recover from stale manifest filenames by probing likely variants
-                    var stem = Path.GetFileNameWithoutExtension(fileName);
-                    var candidates = new[]
-                    {
-                        Path.Combine(modsDir, fileName),
-                        Path.Combine(modsDir, $"{stem}.jar"),
-                        Path.Combine(modsDir, $"{stem}.zip"),
-                        Path.Combine(modsDir, $"{stem}.disabled"),
-                        Path.Combine(modsDir, $"{stem}.jar.disabled"),
-                        Path.Combine(modsDir, $"{stem}.zip.disabled"),
-                    };
-
-                    var found = candidates.FirstOrDefault(File.Exists);
-                    if (!string.IsNullOrEmpty(found))
-                    {
-                        currentPath = found;
-                        fileName = Path.GetFileName(found);
-                        mod.FileName = fileName;
-                        sourceExists = true;
-                    }
-                }
-
-                if (!sourceExists)
-                {
-                    Reply("hyprism:mods:toggle:reply", false);
-                    return;
-                }
-                
-                if (mod.Enabled)
-                {
-                    // Disable: rename file.jar/file.zip -> file.disabled
-                    var currentFileName = Path.GetFileName(currentPath);
-                    var baseName = Path.GetFileNameWithoutExtension(currentFileName);
-                    var ext = Path.GetExtension(currentFileName).ToLowerInvariant();
-
-                    if (currentFileName.EndsWith(".disabled", StringComparison.OrdinalIgnoreCase))
-                    {
-                        mod.Enabled = false;
-                        mod.FileName = currentFileName;
-                    }
-                    else
-                    {
-                        if (ext is ".jar" or ".zip")
-                        {
-                            mod.DisabledOriginalExtension = ext;
-                        }
-
-                        var disabledFileName = $"{baseName}.disabled";
-                        var disabledPath = Path.Combine(modsDir, disabledFileName);
-                        File.Move(currentPath, disabledPath, true);
-                        mod.FileName = disabledFileName;
-                        mod.Enabled = false;
-                        Logger.Info("IPC", $"Disabled mod: {mod.Name}");
-                    }
-                }
-                else
-                {
-                    // Enable: rename *.disabled -> *.jar or *.zip (restored)
-                    var currentFileName = Path.GetFileName(currentPath);
-                    var stem = currentFileName.EndsWith(".disabled", StringComparison.OrdinalIgnoreCase)
-                        ? currentFileName[..^".disabled".Length]
-                        : Path.GetFileNameWithoutExtension(currentFileName);
-
-                    string restoreExtension;
-                    if (!string.IsNullOrWhiteSpace(mod.DisabledOriginalExtension))
-                    {
-                        restoreExtension = mod.DisabledOriginalExtension.StartsWith('.')
-                            ? mod.DisabledOriginalExtension
-                            : $".{mod.DisabledOriginalExtension}";
-                    }
-                    else if (stem.EndsWith(".jar", StringComparison.OrdinalIgnoreCase) || stem.EndsWith(".zip", StringComparison.OrdinalIgnoreCase))
-                    {
-                        restoreExtension = "";
-                    }
-                    else
-                    {
-                        restoreExtension = ".jar";
-                    }
-
-                    var enabledFileName = string.IsNullOrEmpty(restoreExtension)
-                        ? stem
-                        : $"{stem}{restoreExtension}";
-                    var enabledPath = Path.Combine(modsDir, enabledFileName);
-
-                    File.Move(currentPath, enabledPath, true);
-                    mod.FileName = enabledFileName;
-                    mod.Enabled = true;
-                    mod.DisabledOriginalExtension = "";
-                    Logger.Info("IPC", $"Enabled mod: {mod.Name}");
-                }
-                
-                await modService.SaveInstanceModsAsync(instancePath, mods);
-                Reply("hyprism:mods:toggle:reply", true);
+                Reply("hyprism:steamDeck:addShortcut:reply", steamDeck.AddSteamShortcut());
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Mods toggle failed: {ex.Message}");
-                Reply("hyprism:mods:toggle:reply", false);
+                Logger.Error("IPC", $"Failed to add Steam shortcut: {ex.Message}");
+                Reply("hyprism:steamDeck:addShortcut:reply", new SteamShortcutResult { Error = ex.Message });
             }
         });
     }
+    // #endregion
 
-    // #region System Info
-    // @ipc invoke hyprism:system:gpuAdapters -> GpuAdapterInfo[]
+    // #region Event Replay
+    // @ipc invoke hyprism:events:cursor -> number
+    // @ipc invoke hyprism:events:replay -> ReplayedEvent[]
 
-    private void RegisterSystemHandlers()
+    private void RegisterEventHandlers()
     {
-        var gpuService = _services.GetRequiredService<GpuDetectionService>();
+        // Frontend records the cursor before a view unmounts and replays from it on return
+        Electron.IpcMain.On("hyprism:events:cursor", (_) =>
+        {
+            Reply("hyprism:events:cursor:reply", _events.CurrentSeq);
+        });
 
-        Electron.IpcMain.On("hyprism:system:gpuAdapters", (_) =>
+        Electron.IpcMain.On("hyprism:events:replay", (args) =>
         {
             try
             {
-                var adapters = gpuService.GetAdapters();
-                Reply("hyprism:system:gpuAdapters:reply", adapters);
+                long since = 0;
+                List<string>? channels = null;
+
+                using var doc = JsonDocument.Parse(ArgsToJson(args));
+                var root = doc.RootElement;
+                if (root.ValueKind == JsonValueKind.Object)
+                {
+                    if (root.TryGetProperty("since", out var sinceEl) && sinceEl.ValueKind == JsonValueKind.Number)
+                        since = sinceEl.GetInt64();
+                    if (root.TryGetProperty("channels", out var chEl) && chEl.ValueKind == JsonValueKind.Array)
+                        channels = chEl.EnumerateArray().Select(c => c.GetString() ?? "").Where(c => c.Length > 0).ToList();
+                }
+
+                Reply("hyprism:events:replay:reply", _events.Replay(since, channels));
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Failed to get GPU adapters: {ex.Message}");
-                Reply("hyprism:system:gpuAdapters:reply", new List<object>());
+                Logger.Error("IPC", $"Event replay failed: {ex.Message}");
+                Reply("hyprism:events:replay:reply", new List<object>());
             }
         });
     }
@@ -1902,10 +4539,24 @@ public class IpcService
     // @ipc send hyprism:console:log
     // @ipc send hyprism:console:warn
     // @ipc send hyprism:console:error
-    // @ipc invoke hyprism:logs:get -> string[]
+    // @ipc invoke hyprism:logs:query -> LogPage
+    // @ipc send hyprism:logs:subscribe
+    // @ipc send hyprism:logs:unsubscribe
+    // @ipc event hyprism:logs:line -> LogLine
 
     private void RegisterConsoleHandlers()
     {
+        var logStream = _services.GetRequiredService<ILogStreamService>();
+        LogFilter? liveFilter = null;
+
+        // Push new log lines to the renderer while a subscription is active
+        logStream.LineAppended += (line) =>
+        {
+            var filter = liveFilter;
+            if (filter == null || !logStream.Matches(line, filter)) return;
+            _events.Publish(IpcEvents.LogsLine, line);
+        };
+
         Electron.IpcMain.On("hyprism:console:log", (args) =>
             Logger.Info("Renderer", ArgsToString(args)));
 
@@ -1915,34 +4566,40 @@ public class IpcService
         Electron.IpcMain.On("hyprism:console:error", (args) =>
             Logger.Error("Renderer", ArgsToString(args)));
 
-        // Get recent logs from in-memory buffer
-        Electron.IpcMain.On("hyprism:logs:get", (args) =>
+        // Filtered, paginated view over launcher + game log lines
+        Electron.IpcMain.On("hyprism:logs:query", (args) =>
         {
             try
             {
-                int count = 100; // Default to max buffer size
-                if (args != null)
-                {
-                    try
-                    {
-                        var json = ArgsToJson(args);
-                        var data = JsonSerializer.Deserialize<Dictionary<string, JsonElement>>(json, JsonOpts);
-                        if (data != null && data.TryGetValue("count", out var countEl))
-                        {
-                            count = countEl.GetInt32();
-                        }
-                    }
-                    catch { /* use default */ }
-                }
-                var logs = Logger.GetRecentLogs(count);
-                Reply("hyprism:logs:get:reply", logs);
+                var query = JsonSerializer.Deserialize<LogQuery>(ArgsToJson(args), JsonOpts) ?? new LogQuery();
+                Reply("hyprism:logs:query:reply", logStream.Query(query));
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("IPC", $"Log query failed: {ex.Message}");
+                Reply("hyprism:logs:query:reply", new LogPage());
+            }
+        });
+
+        Electron.IpcMain.On("hyprism:logs:subscribe", (args) =>
+        {
+            try
+            {
+                var filter = JsonSerializer.Deserialize<LogFilter>(ArgsToJson(args), JsonOpts) ?? new LogFilter();
+                // Validate the pattern up front so a bad regex doesn't silently drop every line
+                logStream.Query(new LogQuery { Source = filter.Source, MinLevel = filter.MinLevel, Pattern = filter.Pattern, Limit = 1 });
+                liveFilter = filter;
             }
             catch (Exception ex)
             {
-                Logger.Error("IPC", $"Failed to get logs: {ex.Message}");
-                Reply("hyprism:logs:get:reply", new List<string>());
+                Logger.Error("IPC", $"Log subscribe failed: {ex.Message}");
             }
         });
+
+        Electron.IpcMain.On("hyprism:logs:unsubscribe", (_) =>
+        {
+            liveFilter = null;
+        });
     }
 
     // #endregion
@@ -1987,8 +4644,9 @@ public class IpcService
                 var json = ArgsToJson(args);
                 using var doc = JsonDocument.Parse(json);
                 var root = doc.RootElement;
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
+                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
+                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
+                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
                 var exportPath = root.GetProperty("exportPath").GetString() ?? "";
                 var exportType = root.TryGetProperty("exportType", out var et) ? et.GetString() ?? "modlist" : "modlist";
 
@@ -1998,7 +4656,8 @@ public class IpcService
                     return;
                 }
 
-                var instancePath = instanceService.GetInstancePath(branch, version);
+                var instancePath = (string.IsNullOrEmpty(instanceId) ? null : instanceService.GetInstancePathById(instanceId))
+                    ?? instanceService.GetInstancePath(branch, version);
                 var mods = modService.GetInstanceInstalledMods(instancePath);
 
                 if (mods.Count == 0)
@@ -2071,8 +4730,9 @@ public class IpcService
                 using var doc = JsonDocument.Parse(json);
                 var root = doc.RootElement;
                 var filePath = root.GetProperty("filePath").GetString() ?? "";
-                var branch = root.GetProperty("branch").GetString() ?? "release";
-                var version = root.GetProperty("version").GetInt32();
+                var instanceId = root.TryGetProperty("instanceId", out var iid) ? iid.GetString() : null;
+                var branch = root.TryGetProperty("branch", out var b) ? b.GetString() ?? "release" : "release";
+                var version = root.TryGetProperty("version", out var v) ? v.GetInt32() : 0;
 
                 if (string.IsNullOrEmpty(filePath) || !File.Exists(filePath))
                 {
@@ -2080,7 +4740,8 @@ public class IpcService
                     return;
                 }
 
-                var instancePath = instanceService.GetInstancePath(branch, version);
+                var instancePath = (string.IsNullOrEmpty(instanceId) ? null : instanceService.GetInstancePathById(instanceId))
+                    ?? instanceService.GetInstancePath(branch, version);
                 var content = await File.ReadAllTextAsync(filePath);
                 var modList = System.Text.Json.JsonSerializer.Deserialize<List<ModListEntry>>(content) ?? new();
                 var successCount = 0;
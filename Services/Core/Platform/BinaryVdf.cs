@@ -0,0 +1,131 @@
+using System.Text;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Reads and writes Steam's binary KeyValues format, used by <c>shortcuts.vdf</c>.
+/// </summary>
+/// <remarks>
+/// A node is a list of key/value pairs in file order; values are <see cref="string"/>, <see cref="int"/>,
+/// <see cref="float"/>, <see cref="ulong"/> or a nested node. Keys are kept in order and as written, so a
+/// file read and written back is unchanged.
+/// </remarks>
+internal static class BinaryVdf
+{
+    private const byte TypeMap = 0x00;
+    private const byte TypeString = 0x01;
+    private const byte TypeInt32 = 0x02;
+    private const byte TypeFloat = 0x03;
+    private const byte TypeUInt64 = 0x07;
+    private const byte TypeEnd = 0x08;
+
+    /// <summary>
+    /// Reads a binary VDF document.
+    /// </summary>
+    /// <exception cref="InvalidDataException">The data is not binary VDF or uses an unknown value type.</exception>
+    public static List<KeyValuePair<string, object>> Read(byte[] data)
+    {
+        using var reader = new BinaryReader(new MemoryStream(data), Encoding.UTF8);
+        return ReadNode(reader);
+    }
+
+    /// <summary>
+    /// Writes a node as a binary VDF document.
+    /// </summary>
+    public static byte[] Write(List<KeyValuePair<string, object>> root)
+    {
+        using var stream = new MemoryStream();
+        using (var writer = new BinaryWriter(stream, Encoding.UTF8, leaveOpen: true))
+        {
+            WriteNode(writer, root);
+        }
+        return stream.ToArray();
+    }
+
+    /// <summary>
+    /// Gets the nested node stored under <paramref name="key"/>, or <c>null</c>.
+    /// </summary>
+    public static List<KeyValuePair<string, object>>? GetNode(List<KeyValuePair<string, object>> node, string key) =>
+        node.FirstOrDefault(p => string.Equals(p.Key, key, StringComparison.OrdinalIgnoreCase)).Value as List<KeyValuePair<string, object>>;
+
+    /// <summary>
+    /// Gets the string stored under <paramref name="key"/>, or <c>null</c>.
+    /// </summary>
+    public static string? GetString(List<KeyValuePair<string, object>> node, string key) =>
+        node.FirstOrDefault(p => string.Equals(p.Key, key, StringComparison.OrdinalIgnoreCase)).Value as string;
+
+    private static List<KeyValuePair<string, object>> ReadNode(BinaryReader reader)
+    {
+        var node = new List<KeyValuePair<string, object>>();
+        while (reader.BaseStream.Position < reader.BaseStream.Length)
+        {
+            var type = reader.ReadByte();
+            if (type == TypeEnd) return node;
+
+            var key = ReadString(reader);
+            object value = type switch
+            {
+                TypeMap => ReadNode(reader),
+                TypeString => ReadString(reader),
+                TypeInt32 => reader.ReadInt32(),
+                TypeFloat => reader.ReadSingle(),
+                TypeUInt64 => reader.ReadUInt64(),
+                _ => throw new InvalidDataException($"Unknown binary VDF type 0x{type:X2} at '{key}'")
+            };
+            node.Add(new(key, value));
+        }
+        return node;
+    }
+
+    private static void WriteNode(BinaryWriter writer, List<KeyValuePair<string, object>> node)
+    {
+        foreach (var (key, value) in node)
+        {
+            switch (value)
+            {
+                case List<KeyValuePair<string, object>> child:
+                    writer.Write(TypeMap);
+                    WriteString(writer, key);
+                    WriteNode(writer, child);
+                    break;
+                case string s:
+                    writer.Write(TypeString);
+                    WriteString(writer, key);
+                    WriteString(writer, s);
+                    break;
+                case int i:
+                    writer.Write(TypeInt32);
+                    WriteString(writer, key);
+                    writer.Write(i);
+                    break;
+                case float f:
+                    writer.Write(TypeFloat);
+                    WriteString(writer, key);
+                    writer.Write(f);
+                    break;
+                case ulong u:
+                    writer.Write(TypeUInt64);
+                    WriteString(writer, key);
+                    writer.Write(u);
+                    break;
+                default:
+                    throw new ArgumentException($"Unsupported binary VDF value for '{key}': {value.GetType().Name}");
+            }
+        }
+        writer.Write(TypeEnd);
+    }
+
+    private static string ReadString(BinaryReader reader)
+    {
+        var bytes = new List<byte>();
+        byte b;
+        while ((b = reader.ReadByte()) != 0) bytes.Add(b);
+        return Encoding.UTF8.GetString(bytes.ToArray());
+    }
+
+    private static void WriteString(BinaryWriter writer, string value)
+    {
+        writer.Write(Encoding.UTF8.GetBytes(value));
+        writer.Write((byte)0);
+    }
+}
@@ -0,0 +1,212 @@
+using System.Diagnostics;
+using System.Text;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Reads Microsoft Defender's status and detections through its PowerShell module.
+/// </summary>
+/// <remarks>
+/// Defender quarantines files Butler just extracted, or denies opening them while it scans, so installs fail
+/// with access-denied or missing-file errors that look like permission problems. <c>Get-MpThreatDetection</c>
+/// lists what it acted on without administrator rights; the exclusions themselves cannot be read without
+/// them, so the exclusion command is offered whether or not it was already applied.
+/// </remarks>
+public class DefenderService : IDefenderService
+{
+    private static readonly TimeSpan CommandTimeout = TimeSpan.FromSeconds(20);
+    private const int MaxDetections = 20;
+
+    // Resources are reported as "file:_C:\path"; processes and registry keys use other prefixes
+    private static readonly string[] FileResourcePrefixes = ["file:_", "containerfile:_"];
+
+    private const string StatusScript = """
+$ErrorActionPreference = 'SilentlyContinue'
+$status = Get-MpComputerStatus
+$pref = Get-MpPreference
+$names = @{}
+Get-MpThreat | ForEach-Object { $names[[string]$_.ThreatID] = $_.ThreatName }
+$detections = @(Get-MpThreatDetection | ForEach-Object {
+    $d = $_
+    foreach ($r in $d.Resources) {
+        [pscustomobject]@{
+            threat = $names[[string]$d.ThreatID]
+            resource = [string]$r
+            time = if ($d.InitialDetectionTime) { $d.InitialDetectionTime.ToUniversalTime().ToString('o') } else { $null }
+            action = [int]$d.CleaningActionID
+        }
+    }
+})
+[pscustomobject]@{
+    available = [bool]$status
+    realTime = [bool]$status.RealTimeProtectionEnabled
+    controlledFolderAccess = [int]$pref.EnableControlledFolderAccess
+    detections = $detections
+} | ConvertTo-Json -Depth 4 -Compress
+""";
+
+    private readonly string _appDir;
+    private readonly IInstanceService _instanceService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="DefenderService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory.</param>
+    /// <param name="instanceService">Provides the instance folder, which may be elsewhere.</param>
+    public DefenderService(string appDir, IInstanceService instanceService)
+    {
+        _appDir = appDir;
+        _instanceService = instanceService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<DefenderReport> CheckAsync()
+    {
+        if (!OperatingSystem.IsWindows()) return new DefenderReport();
+
+        var folders = GetFolders();
+        var report = new DefenderReport
+        {
+            Supported = true,
+            ExclusionPaths = folders,
+            ExclusionCommand = BuildExclusionCommand(folders)
+        };
+
+        var output = await RunPowerShellAsync(StatusScript);
+        if (string.IsNullOrWhiteSpace(output)) return report;
+
+        try
+        {
+            using var doc = JsonDocument.Parse(output);
+            var root = doc.RootElement;
+            report.DefenderAvailable = root.TryGetProperty("available", out var available) && available.ValueKind == JsonValueKind.True;
+            if (!report.DefenderAvailable) return report;
+
+            report.RealTimeProtection = root.TryGetProperty("realTime", out var realTime) && realTime.ValueKind == JsonValueKind.True;
+            // 1 is on; 2 is audit mode, which only logs
+            report.ControlledFolderAccess = root.TryGetProperty("controlledFolderAccess", out var cfa)
+                && cfa.ValueKind == JsonValueKind.Number && cfa.GetInt32() == 1;
+
+            if (root.TryGetProperty("detections", out var detections))
+            {
+                // A single item may come through as an object rather than an array
+                var items = detections.ValueKind == JsonValueKind.Array ? detections.EnumerateArray().ToList()
+                    : detections.ValueKind == JsonValueKind.Object ? [detections] : [];
+                report.Detections = items
+                    .Select(item => ReadDetection(item, folders))
+                    .OfType<DefenderDetection>()
+                    .OrderByDescending(d => d.DetectedAt ?? DateTime.MinValue)
+                    .Take(MaxDetections)
+                    .ToList();
+            }
+        }
+        catch (JsonException ex)
+        {
+            Logger.Warning("Defender", $"Could not read Defender status: {ex.Message}");
+            return report;
+        }
+
+        if (report.Detections.Count > 0)
+            Logger.Warning("Defender", $"Defender acted on {report.Detections.Count} launcher file(s), latest {report.Detections[0].Path}");
+        return report;
+    }
+
+    /// <inheritdoc/>
+    public string BuildExclusionCommand() => BuildExclusionCommand(GetFolders());
+
+    private static string BuildExclusionCommand(List<string> folders) =>
+        "Add-MpPreference -ExclusionPath " + string.Join(", ", folders.Select(f => "'" + f.Replace("'", "''") + "'"));
+
+    /// <summary>
+    /// Gets the data folder and, when it is not inside it, the instance folder.
+    /// </summary>
+    private List<string> GetFolders()
+    {
+        var folders = new List<string> { Path.GetFullPath(_appDir).TrimEnd(Path.DirectorySeparatorChar) };
+        try
+        {
+            var instanceRoot = Path.GetFullPath(_instanceService.GetInstanceRoot()).TrimEnd(Path.DirectorySeparatorChar);
+            if (!OnboardingService.IsUnder(instanceRoot, folders[0], StringComparison.OrdinalIgnoreCase)) folders.Add(instanceRoot);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Defender", $"Could not resolve the instance folder: {ex.Message}");
+        }
+        return folders;
+    }
+
+    /// <summary>
+    /// Reads a detection, if it concerns a file in one of the folders.
+    /// </summary>
+    private static DefenderDetection? ReadDetection(JsonElement item, List<string> folders)
+    {
+        var resource = item.TryGetProperty("resource", out var r) ? r.GetString() : null;
+        var prefix = resource == null ? null : FileResourcePrefixes.FirstOrDefault(p => resource.StartsWith(p, StringComparison.OrdinalIgnoreCase));
+        if (resource == null || prefix == null) return null;
+
+        var path = resource[prefix.Length..];
+        if (!folders.Any(f => OnboardingService.IsUnder(path, f, StringComparison.OrdinalIgnoreCase))) return null;
+
+        DateTime? detectedAt = item.TryGetProperty("time", out var time) && time.ValueKind == JsonValueKind.String
+            && time.TryGetDateTime(out var parsed) ? parsed : null;
+        var actionId = item.TryGetProperty("action", out var action) && action.ValueKind == JsonValueKind.Number ? action.GetInt32() : 0;
+
+        return new DefenderDetection
+        {
+            ThreatName = item.TryGetProperty("threat", out var threat) ? threat.GetString() ?? "" : "",
+            Path = path,
+            DetectedAt = detectedAt,
+            // CleaningActionID values of MSFT_MpThreatDetection
+            Action = actionId switch
+            {
+                2 => "quarantined",
+                3 => "removed",
+                10 => "blocked",
+                _ => "detected"
+            }
+        };
+    }
+
+    private static async Task<string?> RunPowerShellAsync(string script)
+    {
+        try
+        {
+            var encoded = Convert.ToBase64String(Encoding.Unicode.GetBytes(script));
+            using var process = Process.Start(new ProcessStartInfo
+            {
+                FileName = "powershell",
+                Arguments = $"-NoProfile -NonInteractive -EncodedCommand {encoded}",
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                UseShellExecute = false,
+                CreateNoWindow = true
+            });
+            if (process == null) return null;
+
+            using var cts = new CancellationTokenSource(CommandTimeout);
+            var outputTask = process.StandardOutput.ReadToEndAsync(cts.Token);
+            _ = process.StandardError.ReadToEndAsync(cts.Token);
+            try
+            {
+                await process.WaitForExitAsync(cts.Token);
+            }
+            catch (OperationCanceledException)
+            {
+                try { process.Kill(); } catch { /* ignore */ }
+                Logger.Warning("Defender", "PowerShell did not answer in time");
+                return null;
+            }
+            return await outputTask;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Defender", $"Could not query Defender: {ex.Message}");
+            return null;
+        }
+    }
+}
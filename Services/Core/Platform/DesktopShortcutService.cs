@@ -0,0 +1,296 @@
+using System.Diagnostics;
+using System.Security;
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.User;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Writes desktop shortcuts that start HyPrism with <see cref="LaunchArguments"/> for one instance.
+/// </summary>
+/// <remarks>
+/// A shortcut started while HyPrism is open hands its arguments to the running launcher through the
+/// single-instance pipe, so it works either way. Windows has no API for <c>.lnk</c> files in .NET, so they are
+/// written through the WScript.Shell COM object in PowerShell. On macOS an alias cannot carry arguments, so
+/// the shortcut is a minimal <c>.app</c> bundle whose executable is a shell script.
+/// </remarks>
+public class DesktopShortcutService : IDesktopShortcutService
+{
+    private readonly IInstanceService _instanceService;
+    private readonly IInstanceArtworkService _artworkService;
+    private readonly IProfileService _profileService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="DesktopShortcutService"/> class.
+    /// </summary>
+    /// <param name="instanceService">Looks up the instance the shortcut launches.</param>
+    /// <param name="artworkService">Provides the instance icon used for Linux shortcuts.</param>
+    /// <param name="profileService">Looks up the profile named in the shortcut.</param>
+    public DesktopShortcutService(
+        IInstanceService instanceService,
+        IInstanceArtworkService artworkService,
+        IProfileService profileService)
+    {
+        _instanceService = instanceService;
+        _artworkService = artworkService;
+        _profileService = profileService;
+    }
+
+    /// <inheritdoc/>
+    public DesktopShortcutResult CreateDesktopShortcut(string instanceId, string? profileId)
+    {
+        var instance = _instanceService.FindInstanceById(instanceId);
+        if (instance == null)
+            return new DesktopShortcutResult { Error = $"Instance {instanceId} does not exist" };
+
+        var title = $"HyPrism - {(string.IsNullOrWhiteSpace(instance.Name) ? $"{instance.Branch} {instance.Version}" : instance.Name)}";
+        if (profileId != null)
+        {
+            var profile = _profileService.GetProfiles().FirstOrDefault(p => p.Id == profileId);
+            if (profile == null)
+                return new DesktopShortcutResult { Error = $"Profile {profileId} does not exist" };
+            title += $" ({profile.Name})";
+        }
+
+        var executable = GetLauncherExecutable();
+        if (executable == null)
+            return new DesktopShortcutResult { Error = "Could not determine the HyPrism executable" };
+
+        try
+        {
+            var desktop = GetDesktopDirectory();
+            Directory.CreateDirectory(desktop);
+            var fileName = UtilityService.SanitizeFileName(title);
+            var args = LaunchArguments.Build(instanceId, profileId);
+
+            string path;
+            if (OperatingSystem.IsWindows())
+            {
+                path = Path.Combine(desktop, fileName + ".lnk");
+                CreateWindowsShortcut(path, executable, args, title);
+            }
+            else if (OperatingSystem.IsMacOS())
+            {
+                path = Path.Combine(desktop, fileName + ".app");
+                CreateMacAppStub(path, executable, args, title);
+            }
+            else
+            {
+                path = Path.Combine(desktop, fileName + ".desktop");
+                CreateLinuxDesktopEntry(path, executable, args, title, GetIconPath(instanceId));
+            }
+
+            Logger.Success("Shortcut", $"Created desktop shortcut {path}");
+            return new DesktopShortcutResult { Success = true, Path = path };
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Shortcut", $"Could not create desktop shortcut for {instanceId}: {ex.Message}");
+            return new DesktopShortcutResult { Error = ex.Message };
+        }
+    }
+
+    /// <summary>
+    /// Gets what the shortcut should start: the AppImage when running from one, otherwise the launcher executable.
+    /// </summary>
+    private static string? GetLauncherExecutable()
+    {
+        var exe = Environment.GetEnvironmentVariable("APPIMAGE");
+        if (string.IsNullOrEmpty(exe)) exe = Environment.ProcessPath;
+        return string.IsNullOrEmpty(exe) ? null : exe;
+    }
+
+    private static string GetDesktopDirectory()
+    {
+        // Honors XDG_DESKTOP_DIR on Linux and a redirected Desktop on Windows
+        var desktop = Environment.GetFolderPath(Environment.SpecialFolder.DesktopDirectory);
+        return string.IsNullOrEmpty(desktop)
+            ? Path.Combine(Environment.GetFolderPath(Environment.SpecialFolder.UserProfile), "Desktop")
+            : desktop;
+    }
+
+    private string? GetIconPath(string instanceId)
+    {
+        var icon = _artworkService.GetArtwork(instanceId).Icon;
+        if (icon != null && icon.StartsWith("file://", StringComparison.Ordinal)) return icon["file://".Length..];
+
+        var appIcon = Path.Combine(AppContext.BaseDirectory, "Build", "icon.png");
+        return File.Exists(appIcon) ? appIcon : null;
+    }
+
+    private static void CreateLinuxDesktopEntry(string path, string executable, string[] args, string title, string? iconPath)
+    {
+        // Inside a Flatpak the process path only exists in the sandbox
+        var command = UtilityService.IsFlatpak() && Environment.GetEnvironmentVariable("FLATPAK_ID") is { Length: > 0 } flatpakId
+            ? new[] { "flatpak", "run", flatpakId }.Concat(args)
+            : new[] { executable }.Concat(args);
+
+        var entry = new StringBuilder()
+            .AppendLine("[Desktop Entry]")
+            .AppendLine("Type=Application")
+            .AppendLine("Version=1.0")
+            .AppendLine($"Name={EscapeDesktopValue(title)}")
+            .AppendLine("Comment=Launch this instance with HyPrism")
+            .AppendLine($"Exec={string.Join(' ', command.Select(QuoteDesktopExecArg))}")
+            .AppendLine("Terminal=false")
+            .AppendLine("Categories=Game;");
+        if (iconPath != null) entry.AppendLine($"Icon={EscapeDesktopValue(iconPath)}");
+
+        File.WriteAllText(path, entry.ToString());
+        File.SetUnixFileMode(path, UnixFileMode.UserRead | UnixFileMode.UserWrite | UnixFileMode.UserExecute
+            | UnixFileMode.GroupRead | UnixFileMode.GroupExecute | UnixFileMode.OtherRead | UnixFileMode.OtherExecute);
+
+        // GNOME only runs desktop files marked trusted; other desktops ignore this
+        RunQuietly("gio", ["set", path, "metadata::trusted", "true"]);
+    }
+
+    private static void CreateWindowsShortcut(string path, string executable, string[] args, string title)
+    {
+        static string Literal(string value) => "'" + value.Replace("'", "''") + "'";
+
+        var arguments = string.Join(' ', args.Select(a => UtilityService.QuoteWindowsArgument(a)));
+        var script = string.Join("; ",
+            "$s = (New-Object -ComObject WScript.Shell).CreateShortcut(" + Literal(path) + ")",
+            "$s.TargetPath = " + Literal(executable),
+            "$s.Arguments = " + Literal(arguments),
+            "$s.WorkingDirectory = " + Literal(Path.GetDirectoryName(executable) ?? ""),
+            "$s.IconLocation = " + Literal(executable + ",0"),
+            "$s.Description = " + Literal(title),
+            "$s.Save()");
+
+        // Encoded so paths with quotes survive the command line
+        var encoded = Convert.ToBase64String(Encoding.Unicode.GetBytes(script));
+        using var process = Process.Start(new ProcessStartInfo
+        {
+            FileName = "powershell",
+            Arguments = $"-NoProfile -NonInteractive -EncodedCommand {encoded}",
+            RedirectStandardError = true,
+            UseShellExecute = false,
+            CreateNoWindow = true
+        }) ?? throw new InvalidOperationException("Could not start PowerShell");
+
+        // Read while waiting, so a full stderr pipe cannot keep PowerShell from exiting
+        var errorTask = process.StandardError.ReadToEndAsync();
+        if (!process.WaitForExit(15000))
+        {
+            try { process.Kill(); } catch { /* ignore */ }
+            throw new TimeoutException("PowerShell did not finish creating the shortcut");
+        }
+        var error = errorTask.GetAwaiter().GetResult();
+        if (process.ExitCode != 0 || !File.Exists(path))
+            throw new InvalidOperationException(string.IsNullOrWhiteSpace(error) ? "PowerShell could not create the shortcut" : error.Trim());
+    }
+
+    private static void CreateMacAppStub(string path, string executable, string[] args, string title)
+    {
+        if (Directory.Exists(path)) Directory.Delete(path, recursive: true);
+        var contents = Path.Combine(path, "Contents");
+        var macOs = Path.Combine(contents, "MacOS");
+        var resources = Path.Combine(contents, "Resources");
+        Directory.CreateDirectory(macOs);
+        Directory.CreateDirectory(resources);
+
+        // Start through the HyPrism bundle when there is one so macOS treats it as the same app
+        var bundle = FindAppBundle(executable);
+        var quotedArgs = string.Join(' ', args.Select(QuoteShellArg));
+        var command = bundle != null
+            ? $"exec /usr/bin/open -n -a {QuoteShellArg(bundle)} --args {quotedArgs}"
+            : $"exec {QuoteShellArg(executable)} {quotedArgs}";
+
+        var script = Path.Combine(macOs, "launch");
+        File.WriteAllText(script, $"#!/bin/sh\n{command}\n");
+        File.SetUnixFileMode(script, UnixFileMode.UserRead | UnixFileMode.UserWrite | UnixFileMode.UserExecute
+            | UnixFileMode.GroupRead | UnixFileMode.GroupExecute | UnixFileMode.OtherRead | UnixFileMode.OtherExecute);
+
+        var iconEntry = "";
+        var icon = bundle == null ? null : Directory.EnumerateFiles(Path.Combine(bundle, "Contents", "Resources"), "*.icns").FirstOrDefault();
+        if (icon != null)
+        {
+            File.Copy(icon, Path.Combine(resources, "icon.icns"), overwrite: true);
+            iconEntry = "  <key>CFBundleIconFile</key>\n  <string>icon</string>\n";
+        }
+
+        var bundleId = "com.hyprism.shortcut." + Convert.ToHexString(System.Security.Cryptography.SHA256.HashData(Encoding.UTF8.GetBytes(path)))[..12].ToLowerInvariant();
+        File.WriteAllText(Path.Combine(contents, "Info.plist"), $"""
+<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+  <key>CFBundleName</key>
+  <string>{SecurityElement.Escape(title)}</string>
+  <key>CFBundleIdentifier</key>
+  <string>{bundleId}</string>
+  <key>CFBundleExecutable</key>
+  <string>launch</string>
+  <key>CFBundlePackageType</key>
+  <string>APPL</string>
+{iconEntry}  <key>LSUIElement</key>
+  <true/>
+</dict>
+</plist>
+
+""");
+    }
+
+    /// <summary>
+    /// Gets the <c>.app</c> folder the executable runs from, if any.
+    /// </summary>
+    private static string? FindAppBundle(string executable)
+    {
+        for (var dir = Path.GetDirectoryName(executable); !string.IsNullOrEmpty(dir); dir = Path.GetDirectoryName(dir))
+        {
+            if (dir.EndsWith(".app", StringComparison.OrdinalIgnoreCase)) return dir;
+        }
+        return null;
+    }
+
+    /// <summary>
+    /// Quotes an argument for the <c>Exec</c> key of a desktop entry; <c>%</c> would otherwise be a field code.
+    /// </summary>
+    private static string QuoteDesktopExecArg(string arg)
+    {
+        var escaped = arg
+            .Replace("\\", "\\\\\\\\")
+            .Replace("\"", "\\\"")
+            .Replace("`", "\\`")
+            .Replace("$", "\\$")
+            .Replace("%", "%%");
+        return $"\"{escaped}\"";
+    }
+
+    /// <summary>
+    /// Makes a value safe for a desktop entry line: a line break in an instance name would otherwise end the
+    /// key and let the rest of the name be read as further keys.
+    /// </summary>
+    private static string EscapeDesktopValue(string value) =>
+        new string(value.Select(c => char.IsControl(c) ? ' ' : c).ToArray()).Replace("\\", "\\\\");
+
+    private static string QuoteShellArg(string arg) => "'" + arg.Replace("'", "'\\''") + "'";
+
+    private static void RunQuietly(string fileName, string[] arguments)
+    {
+        try
+        {
+            var psi = new ProcessStartInfo
+            {
+                FileName = fileName,
+                UseShellExecute = false,
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                CreateNoWindow = true
+            };
+            foreach (var arg in arguments) psi.ArgumentList.Add(arg);
+
+            using var process = Process.Start(psi);
+            process?.WaitForExit(5000);
+        }
+        catch
+        {
+            // Optional tool missing
+        }
+    }
+}
@@ -0,0 +1,69 @@
+using System.Runtime.InteropServices;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Picks the display server the game client uses on Linux.
+/// </summary>
+/// <remarks>
+/// SDL prefers Wayland when the session offers it, and some client bugs only show up there (missing
+/// window decorations, broken cursor capture). Forcing X11 runs the client under Xwayland instead. Both
+/// the SDL2 (<c>SDL_VIDEODRIVER</c>) and SDL3 (<c>SDL_VIDEO_DRIVER</c>) variable names are set.
+/// </remarks>
+public static class DisplayServerEnvironment
+{
+    public const string Auto = "auto";
+    public const string Wayland = "wayland";
+    public const string X11 = "x11";
+
+    /// <summary>
+    /// Whether the preference has any effect on this OS.
+    /// </summary>
+    public static bool IsSupported => RuntimeInformation.IsOSPlatform(OSPlatform.Linux);
+
+    /// <summary>
+    /// Whether <paramref name="mode"/> is one of <see cref="Auto"/>, <see cref="Wayland"/> or <see cref="X11"/>.
+    /// </summary>
+    public static bool IsValid(string? mode) => mode is Auto or Wayland or X11;
+
+    /// <summary>
+    /// Gets the display server of the current session, or null when it cannot be told (or not on Linux).
+    /// </summary>
+    public static string? DetectSession()
+    {
+        if (!IsSupported) return null;
+
+        var sessionType = Environment.GetEnvironmentVariable("XDG_SESSION_TYPE")?.ToLowerInvariant();
+        if (sessionType is Wayland or X11) return sessionType;
+        if (!string.IsNullOrEmpty(Environment.GetEnvironmentVariable("WAYLAND_DISPLAY"))) return Wayland;
+        if (!string.IsNullOrEmpty(Environment.GetEnvironmentVariable("DISPLAY"))) return X11;
+        return null;
+    }
+
+    /// <summary>
+    /// Gets the client variables for a display server preference; empty for <see cref="Auto"/> and off Linux.
+    /// </summary>
+    /// <param name="mode">The instance's preference; null is treated as <see cref="Auto"/>.</param>
+    public static Dictionary<string, string> GetClientEnvironment(string? mode)
+    {
+        var env = new Dictionary<string, string>();
+        if (!IsSupported || mode is null or Auto || !IsValid(mode)) return env;
+
+        SetVideoDriver(env, mode);
+
+        if (mode == X11 && string.IsNullOrEmpty(Environment.GetEnvironmentVariable("DISPLAY")))
+            Logger.Warning("Game", "X11 was chosen for this instance but DISPLAY is not set; is Xwayland running?");
+        else if (mode == Wayland && string.IsNullOrEmpty(Environment.GetEnvironmentVariable("WAYLAND_DISPLAY")))
+            Logger.Warning("Game", "Wayland was chosen for this instance but WAYLAND_DISPLAY is not set; the client may fail to open a window");
+
+        Logger.Info("Game", $"Display server: {mode} (session: {DetectSession() ?? "unknown"})");
+        return env;
+    }
+
+    private static void SetVideoDriver(Dictionary<string, string> env, string driver)
+    {
+        env["SDL_VIDEODRIVER"] = driver;
+        env["SDL_VIDEO_DRIVER"] = driver;
+    }
+}
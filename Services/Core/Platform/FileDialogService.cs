@@ -1,6 +1,8 @@
 using System.Diagnostics;
 using System.Runtime.InteropServices;
 using System.Text;
+using ElectronNET.API;
+using ElectronNET.API.Entities;
 using HyPrism.Services.Core.Infrastructure;
 
 namespace HyPrism.Services.Core.Platform;
@@ -9,6 +11,11 @@ namespace HyPrism.Services.Core.Platform;
 /// Handles native file dialog interactions across different operating systems.
 /// Provides cross-platform file browsing capabilities using OS-specific dialogs.
 /// </summary>
+/// <remarks>
+/// Inside Flatpak, zenity and kdialog are not in the runtime and could not reach files outside the sandbox
+/// anyway, so dialogs go through Electron, which uses the XDG FileChooser portal there. Files picked through
+/// the portal are made available to the sandbox by the document portal.
+/// </remarks>
 public class FileDialogService : IFileDialogService
 {
     /// <summary>
@@ -18,6 +25,17 @@ public class FileDialogService : IFileDialogService
     {
         try
         {
+            if (UtilityService.IsFlatpak() && GetWindow() is { } window)
+            {
+                var folders = await Electron.Dialog.ShowOpenDialogAsync(window, new OpenDialogOptions
+                {
+                    Title = "Select Folder",
+                    DefaultPath = initialPath ?? "",
+                    Properties = [OpenDialogProperty.openDirectory, OpenDialogProperty.createDirectory]
+                });
+                return folders.FirstOrDefault();
+            }
+
             if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
             {
                 var script = $@"Add-Type -AssemblyName System.Windows.Forms; $dialog = New-Object System.Windows.Forms.FolderBrowserDialog; ";
@@ -172,6 +190,16 @@ public class FileDialogService : IFileDialogService
     {
         try
         {
+            if (UtilityService.IsFlatpak() && GetWindow() is { } window)
+            {
+                return await Electron.Dialog.ShowOpenDialogAsync(window, new OpenDialogOptions
+                {
+                    Title = "Select Mod Files",
+                    Filters = [new FileFilter { Name = "Mod files", Extensions = ["jar", "zip", "hmod", "litemod", "json"] }],
+                    Properties = [OpenDialogProperty.openFile, OpenDialogProperty.multiSelections]
+                });
+            }
+
             if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
             {
                 return await BrowseFilesMacOSAsync();
@@ -308,6 +336,17 @@ public class FileDialogService : IFileDialogService
     {
         try
         {
+            if (UtilityService.IsFlatpak() && GetWindow() is { } window)
+            {
+                var path = await Electron.Dialog.ShowSaveDialogAsync(window, new SaveDialogOptions
+                {
+                    Title = "Save As",
+                    DefaultPath = string.IsNullOrEmpty(initialPath) ? defaultFileName : Path.Combine(initialPath, defaultFileName),
+                    Filters = ToFileFilters(filter)
+                });
+                return string.IsNullOrEmpty(path) ? null : path;
+            }
+
             if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
             {
                 return await SaveFileWindowsAsync(defaultFileName, filter, initialPath);
@@ -425,6 +464,17 @@ public class FileDialogService : IFileDialogService
     {
         try
         {
+            if (UtilityService.IsFlatpak() && GetWindow() is { } window)
+            {
+                var files = await Electron.Dialog.ShowOpenDialogAsync(window, new OpenDialogOptions
+                {
+                    Title = "Select Instance Archive",
+                    Filters = [new FileFilter { Name = "Zip files", Extensions = ["zip"] }],
+                    Properties = [OpenDialogProperty.openFile]
+                });
+                return files.FirstOrDefault();
+            }
+
             if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
             {
                 return await BrowseZipFileWindowsAsync();
@@ -515,4 +565,17 @@ public class FileDialogService : IFileDialogService
 
         return process.ExitCode == 0 && !string.IsNullOrWhiteSpace(output) ? output.Trim() : null;
     }
+
+    private static BrowserWindow? GetWindow() => Electron.WindowManager.BrowserWindows.FirstOrDefault();
+
+    /// <summary>
+    /// Converts a <c>"Zip files|*.zip"</c> filter into Electron file filters.
+    /// </summary>
+    private static FileFilter[] ToFileFilters(string filter)
+    {
+        var parts = filter.Split('|');
+        if (parts.Length < 2) return [];
+        var extensions = parts[1].Split(';').Select(p => p.Trim().TrimStart('*', '.')).Where(p => p.Length > 0).ToArray();
+        return extensions.Length == 0 ? [] : [new FileFilter { Name = parts[0], Extensions = extensions }];
+    }
 }
@@ -0,0 +1,119 @@
+using ElectronNET.API;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Registers quick action hotkeys through Electron's <c>globalShortcut</c> API.
+/// </summary>
+/// <remarks>
+/// Hotkeys are handled in the main process, so they keep working while the window is hidden in the
+/// tray or the game has focus. A hotkey another application already owns fails to register; it stays
+/// in the config and is reported with <see cref="QuickActionHotkey.Registered"/> set to <c>false</c>.
+/// </remarks>
+public class GlobalHotkeyService : IGlobalHotkeyService
+{
+    private readonly IConfigService _configService;
+    private readonly IQuickActionService _quickActionService;
+    private readonly object _lock = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GlobalHotkeyService"/> class.
+    /// </summary>
+    /// <param name="configService">Stores the hotkeys.</param>
+    /// <param name="quickActionService">Runs the action behind a hotkey.</param>
+    public GlobalHotkeyService(IConfigService configService, IQuickActionService quickActionService)
+    {
+        _configService = configService;
+        _quickActionService = quickActionService;
+    }
+
+    private Dictionary<string, string> Hotkeys => _configService.Configuration.GlobalHotkeys ??= new();
+
+    /// <inheritdoc/>
+    public void RegisterAll()
+    {
+        lock (_lock)
+        {
+            foreach (var (action, accelerator) in Hotkeys)
+            {
+                if (QuickActions.All.Contains(action) && !string.IsNullOrWhiteSpace(accelerator))
+                    Register(action, accelerator);
+            }
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<QuickActionHotkey>> GetHotkeysAsync()
+    {
+        var result = new List<QuickActionHotkey>();
+        foreach (var action in QuickActions.All)
+        {
+            var accelerator = Hotkeys.GetValueOrDefault(action) ?? "";
+            result.Add(new QuickActionHotkey
+            {
+                Action = action,
+                Accelerator = accelerator,
+                Registered = accelerator.Length > 0 && await IsRegisteredAsync(accelerator)
+            });
+        }
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public Task<List<QuickActionHotkey>> SetHotkeyAsync(string action, string accelerator)
+    {
+        if (!QuickActions.All.Contains(action))
+            throw new ArgumentException($"Unknown quick action: {action}", nameof(action));
+
+        accelerator = accelerator.Trim();
+        lock (_lock)
+        {
+            var taken = Hotkeys.FirstOrDefault(h => h.Key != action &&
+                string.Equals(h.Value, accelerator, StringComparison.OrdinalIgnoreCase)).Key;
+            if (accelerator.Length > 0 && taken != null)
+                throw new ArgumentException($"{accelerator} is already bound to {taken}", nameof(accelerator));
+
+            if (Hotkeys.TryGetValue(action, out var previous) && !string.IsNullOrWhiteSpace(previous))
+                Electron.GlobalShortcut.Unregister(previous);
+
+            if (accelerator.Length == 0)
+            {
+                Hotkeys.Remove(action);
+            }
+            else
+            {
+                Hotkeys[action] = accelerator;
+                Register(action, accelerator);
+            }
+            _configService.SaveConfig();
+        }
+
+        Logger.Info("Hotkeys", accelerator.Length == 0 ? $"Cleared hotkey of {action}" : $"Bound {accelerator} to {action}");
+        return GetHotkeysAsync();
+    }
+
+    private void Register(string action, string accelerator)
+    {
+        try
+        {
+            Electron.GlobalShortcut.Register(accelerator, () =>
+            {
+                try { _quickActionService.Run(action); }
+                catch (Exception ex) { Logger.Error("Hotkeys", $"{action} failed: {ex.Message}"); }
+            });
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Hotkeys", $"Could not register {accelerator} for {action}: {ex.Message}");
+        }
+    }
+
+    private static async Task<bool> IsRegisteredAsync(string accelerator)
+    {
+        try { return await Electron.GlobalShortcut.IsRegisteredAsync(accelerator); }
+        catch { return false; }
+    }
+}
@@ -0,0 +1,396 @@
+using System.ComponentModel;
+using System.Diagnostics;
+using System.Runtime.InteropServices;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Finds missing or software-only graphics drivers before the game tries to start with them.
+/// </summary>
+/// <remarks>
+/// On Linux <c>vulkaninfo --summary</c> and <c>glxinfo -B</c> are used when installed; either way the
+/// OpenGL and Vulkan libraries are looked up in the usual library folders and their ELF class read, which
+/// tells a 64-bit driver from a 32-bit-only one. On Windows a Direct3D 11 device is created without a
+/// window to read the feature level. macOS always has Metal and is not checked.
+/// </remarks>
+public class GraphicsDiagnosticsService : IGraphicsDiagnosticsService
+{
+    private static readonly TimeSpan ToolTimeout = TimeSpan.FromSeconds(5);
+
+    private static readonly string[] LibraryDirs =
+    [
+        "/usr/lib", "/usr/lib64", "/usr/lib32", "/lib", "/lib64", "/lib32",
+        "/usr/lib/x86_64-linux-gnu", "/usr/lib/i386-linux-gnu",
+        "/usr/lib/aarch64-linux-gnu", "/usr/lib/arm-linux-gnueabihf",
+        "/run/opengl-driver/lib", "/run/opengl-driver-32/lib"
+    ];
+
+    private static readonly string[] VulkanIcdDirs =
+    [
+        "/usr/share/vulkan/icd.d", "/usr/local/share/vulkan/icd.d", "/etc/vulkan/icd.d",
+        "/run/opengl-driver/share/vulkan/icd.d"
+    ];
+
+    private static readonly Regex SoftwareRenderer = new(@"llvmpipe|softpipe|lavapipe|Software Rasterizer|\bSWR\b", RegexOptions.IgnoreCase | RegexOptions.Compiled);
+
+    private const byte ElfClass32 = 1;
+    private const byte ElfClass64 = 2;
+
+    // D3D_FEATURE_LEVEL values, highest first
+    private static readonly int[] FeatureLevels = [0xc200, 0xc100, 0xc000, 0xb100, 0xb000, 0xa100, 0xa000, 0x9300, 0x9200, 0x9100];
+    private static readonly int[] LegacyFeatureLevels = [0xb000, 0xa100, 0xa000, 0x9300, 0x9200, 0x9100];
+    private const int D3DDriverTypeHardware = 1;
+    private const uint D3D11SdkVersion = 7;
+    private const int EInvalidArg = unchecked((int)0x80070057);
+
+    private readonly GpuDetectionService _gpuDetection;
+    private readonly SemaphoreSlim _gate = new(1, 1);
+    private GraphicsDiagnostics? _last;
+
+    /// <inheritdoc/>
+    public event Action<GraphicsDiagnostics>? ProblemsFound;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GraphicsDiagnosticsService"/> class.
+    /// </summary>
+    /// <param name="gpuDetection">Lists the GPU adapters, used to spot the Windows fallback display driver.</param>
+    public GraphicsDiagnosticsService(GpuDetectionService gpuDetection)
+    {
+        _gpuDetection = gpuDetection;
+    }
+
+    /// <inheritdoc/>
+    public GraphicsDiagnostics? GetLast() => _last;
+
+    /// <inheritdoc/>
+    public async Task<GraphicsDiagnostics> RunAsync(bool refresh = false, CancellationToken ct = default)
+    {
+        await _gate.WaitAsync(ct);
+        try
+        {
+            if (_last != null && !refresh) return _last;
+
+            var result = new GraphicsDiagnostics { Platform = UtilityService.GetOS() };
+            if (RuntimeInformation.IsOSPlatform(OSPlatform.Linux))
+                await CheckLinuxAsync(result, ct);
+            else if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+                CheckWindows(result);
+
+            result.Warnings = result.Warnings
+                .OrderBy(w => w.Severity switch { "error" => 0, "warning" => 1, _ => 2 })
+                .ToList();
+            result.Summary = Summarize(result);
+
+            Logger.Info("Graphics", result.Summary);
+            foreach (var warning in result.Warnings)
+                Logger.Warning("Graphics", $"[{warning.Id}] {warning.Message}");
+
+            _last = result;
+            if (result.Warnings.Any(w => w.Severity != "info"))
+                ProblemsFound?.Invoke(result);
+            return result;
+        }
+        finally
+        {
+            _gate.Release();
+        }
+    }
+
+    private static async Task CheckLinuxAsync(GraphicsDiagnostics result, CancellationToken ct)
+    {
+        var missingTools = new List<string>();
+
+        var (vulkanFound, vulkanOutput) = await RunToolAsync("vulkaninfo", "--summary", ct);
+        if (!vulkanFound) missingTools.Add("vulkaninfo");
+        else ParseVulkanInfo(result, vulkanOutput);
+
+        var (glFound, glOutput) = await RunToolAsync("glxinfo", "-B", ct);
+        if (!glFound) missingTools.Add("glxinfo");
+        else ParseGlxInfo(result, glOutput);
+
+        var (gl64, gl32) = FindLibrary("libGL.so.1");
+        var (vk64, vk32) = FindLibrary("libvulkan.so.1");
+
+        if (!gl64 && gl32)
+        {
+            Add(result, "only_32bit", "error",
+                "Only 32-bit OpenGL libraries are installed. The game needs the 64-bit graphics driver libraries.");
+        }
+        else if (!gl64 && !gl32 && result.OpenGlAvailable != true)
+        {
+            Add(result, "no_driver", "error", "No OpenGL driver libraries were found. Install Mesa or your GPU vendor's driver.");
+        }
+        else if (!vk64 && vk32)
+        {
+            Add(result, "only_32bit", "warning",
+                "Only the 32-bit Vulkan loader is installed. Install the 64-bit Vulkan loader and driver.");
+        }
+
+        bool glSoftware = result.OpenGlRenderer != null && SoftwareRenderer.IsMatch(result.OpenGlRenderer);
+        bool vulkanSoftwareOnly = result.VulkanAvailable == false && result.VulkanDevices.Count > 0;
+        if (glSoftware || vulkanSoftwareOnly)
+        {
+            var renderer = glSoftware ? result.OpenGlRenderer : string.Join(", ", result.VulkanDevices);
+            Add(result, "software_renderer", "error",
+                $"Graphics run on the CPU ({renderer}). The GPU driver is missing or not loaded, so the game will be very slow or fail to start.");
+        }
+
+        // Without vulkaninfo, a loader with no driver manifests is the best available sign of a missing driver
+        if (!vulkanFound && vk64)
+        {
+            bool hasIcd = !string.IsNullOrEmpty(Environment.GetEnvironmentVariable("VK_DRIVER_FILES"))
+                || !string.IsNullOrEmpty(Environment.GetEnvironmentVariable("VK_ICD_FILENAMES"))
+                || VulkanIcdDirs.Any(d => Directory.Exists(d) && Directory.EnumerateFiles(d, "*.json").Any());
+            result.VulkanAvailable = hasIcd ? null : false;
+            if (!hasIcd)
+                Add(result, "vulkan_no_driver", "warning", "The Vulkan loader is installed but no Vulkan driver is registered.");
+        }
+        else if (!vulkanFound && !vk64)
+        {
+            result.VulkanAvailable = false;
+        }
+
+        if (missingTools.Count > 0)
+        {
+            Add(result, "tools_missing", "info",
+                $"{string.Join(" and ", missingTools)} not found; install vulkan-tools and mesa-utils (glxinfo) for a more precise check.");
+        }
+    }
+
+    private static void ParseVulkanInfo(GraphicsDiagnostics result, string? output)
+    {
+        if (string.IsNullOrWhiteSpace(output))
+        {
+            // vulkaninfo fails when the loader finds no usable driver
+            result.VulkanAvailable = false;
+            return;
+        }
+
+        Version? best = null;
+        bool hardware = false;
+        string? type = null;
+        string? apiVersion = null;
+        foreach (var raw in output.Split('\n'))
+        {
+            var line = raw.Trim();
+            if (TryValue(line, "apiVersion", out var api))
+                apiVersion = Regex.Match(api, @"\d+\.\d+\.\d+").Value;
+            else if (TryValue(line, "deviceType", out var t))
+                type = t;
+            else if (TryValue(line, "deviceName", out var name))
+            {
+                result.VulkanDevices.Add(name);
+                bool cpu = type?.Contains("CPU", StringComparison.OrdinalIgnoreCase) == true || SoftwareRenderer.IsMatch(name);
+                if (!cpu)
+                {
+                    hardware = true;
+                    if (Version.TryParse(apiVersion, out var v) && (best == null || v > best)) best = v;
+                }
+                type = null;
+                apiVersion = null;
+            }
+        }
+
+        result.VulkanAvailable = hardware;
+        result.VulkanVersion = best?.ToString();
+    }
+
+    private static void ParseGlxInfo(GraphicsDiagnostics result, string? output)
+    {
+        // glxinfo fails without an X display (e.g. a pure Wayland session); leave OpenGL undetermined
+        if (string.IsNullOrWhiteSpace(output)) return;
+
+        bool direct = false;
+        string? coreVersion = null;
+        string? version = null;
+        foreach (var raw in output.Split('\n'))
+        {
+            var line = raw.Trim();
+            if (line.StartsWith("direct rendering:", StringComparison.OrdinalIgnoreCase))
+                direct = line.EndsWith("Yes", StringComparison.OrdinalIgnoreCase);
+            else if (line.StartsWith("OpenGL renderer string:", StringComparison.OrdinalIgnoreCase))
+                result.OpenGlRenderer = line["OpenGL renderer string:".Length..].Trim();
+            else if (line.StartsWith("OpenGL core profile version string:", StringComparison.OrdinalIgnoreCase))
+                coreVersion = line["OpenGL core profile version string:".Length..].Trim();
+            else if (line.StartsWith("OpenGL version string:", StringComparison.OrdinalIgnoreCase))
+                version = line["OpenGL version string:".Length..].Trim();
+        }
+
+        result.OpenGlVersion = coreVersion ?? version;
+        result.OpenGlAvailable = direct && result.OpenGlRenderer != null && !SoftwareRenderer.IsMatch(result.OpenGlRenderer);
+    }
+
+    private void CheckWindows(GraphicsDiagnostics result)
+    {
+        var adapters = _gpuDetection.GetAdapters();
+        if (adapters.Count > 0 && adapters.All(a => a.Name.Contains("Microsoft Basic", StringComparison.OrdinalIgnoreCase)))
+        {
+            Add(result, "no_driver", "error",
+                "Windows is using the Microsoft Basic Display Adapter. Install the driver from your GPU vendor.");
+        }
+
+        var level = GetDirect3DFeatureLevel();
+        if (level == null)
+        {
+            Add(result, "no_driver", "error", "No Direct3D 11 hardware device could be created. The GPU driver may be missing or broken.");
+        }
+        else
+        {
+            result.DirectXFeatureLevel = $"{level.Value >> 12 & 0xf}_{level.Value >> 8 & 0xf}";
+            if (level.Value < 0xb000)
+            {
+                Add(result, "dx_feature_level", "warning",
+                    $"The GPU supports Direct3D feature level {result.DirectXFeatureLevel}, below 11_0. The GPU or its driver may be too old for the game.");
+            }
+        }
+
+        result.VulkanAvailable = NativeLibrary.TryLoad("vulkan-1.dll", out var vulkan);
+        if (result.VulkanAvailable == true) NativeLibrary.Free(vulkan);
+        result.OpenGlAvailable = level != null && !result.Warnings.Any(w => w.Id == "no_driver") ? true : null;
+    }
+
+    /// <summary>
+    /// Creates a throwaway Direct3D 11 device on the default adapter and returns its feature level.
+    /// </summary>
+    private static int? GetDirect3DFeatureLevel()
+    {
+        try
+        {
+            int hr = D3D11CreateDevice(IntPtr.Zero, D3DDriverTypeHardware, IntPtr.Zero, 0,
+                FeatureLevels, (uint)FeatureLevels.Length, D3D11SdkVersion, IntPtr.Zero, out int level, IntPtr.Zero);
+            // Runtimes before Windows 8 reject feature levels they don't know
+            if (hr == EInvalidArg)
+            {
+                hr = D3D11CreateDevice(IntPtr.Zero, D3DDriverTypeHardware, IntPtr.Zero, 0,
+                    LegacyFeatureLevels, (uint)LegacyFeatureLevels.Length, D3D11SdkVersion, IntPtr.Zero, out level, IntPtr.Zero);
+            }
+            return hr >= 0 ? level : null;
+        }
+        catch (Exception ex) when (ex is DllNotFoundException or EntryPointNotFoundException)
+        {
+            return null;
+        }
+    }
+
+    [DllImport("d3d11.dll")]
+    private static extern int D3D11CreateDevice(IntPtr adapter, int driverType, IntPtr software, uint flags,
+        int[] featureLevels, uint featureLevelCount, uint sdkVersion, IntPtr device, out int featureLevel, IntPtr immediateContext);
+
+    /// <summary>
+    /// Looks for a library in the usual folders and tells whether a 64-bit and a 32-bit build exist.
+    /// </summary>
+    private static (bool Has64, bool Has32) FindLibrary(string name)
+    {
+        bool has64 = false, has32 = false;
+        foreach (var dir in LibraryDirs)
+        {
+            var path = Path.Combine(dir, name);
+            if (!File.Exists(path)) continue;
+            switch (ReadElfClass(path))
+            {
+                case ElfClass64: has64 = true; break;
+                case ElfClass32: has32 = true; break;
+            }
+        }
+        return (has64, has32);
+    }
+
+    private static byte ReadElfClass(string path)
+    {
+        try
+        {
+            using var stream = File.OpenRead(path);
+            var header = new byte[5];
+            if (stream.Read(header, 0, header.Length) < header.Length) return 0;
+            return header[0] == 0x7f && header[1] == 'E' && header[2] == 'L' && header[3] == 'F' ? header[4] : (byte)0;
+        }
+        catch (Exception)
+        {
+            return 0;
+        }
+    }
+
+    /// <summary>
+    /// Runs a diagnostic tool and returns whether it is installed and, if it succeeded, its output.
+    /// </summary>
+    private static async Task<(bool Found, string? Output)> RunToolAsync(string fileName, string arguments, CancellationToken ct)
+    {
+        Process? process;
+        try
+        {
+            process = Process.Start(new ProcessStartInfo
+            {
+                FileName = fileName,
+                Arguments = arguments,
+                UseShellExecute = false,
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                CreateNoWindow = true
+            });
+        }
+        catch (Win32Exception)
+        {
+            return (false, null);
+        }
+        if (process == null) return (false, null);
+
+        using (process)
+        {
+            using var timeout = CancellationTokenSource.CreateLinkedTokenSource(ct);
+            timeout.CancelAfter(ToolTimeout);
+            try
+            {
+                var output = process.StandardOutput.ReadToEndAsync(timeout.Token);
+                var errors = process.StandardError.ReadToEndAsync(timeout.Token);
+                await process.WaitForExitAsync(timeout.Token);
+                var text = await output;
+                await errors;
+                return (true, process.ExitCode == 0 ? text : null);
+            }
+            catch (OperationCanceledException) when (!ct.IsCancellationRequested)
+            {
+                try { process.Kill(entireProcessTree: true); } catch { /* Already gone */ }
+                Logger.Warning("Graphics", $"{fileName} did not finish within {ToolTimeout.TotalSeconds:0}s");
+                return (true, null);
+            }
+        }
+    }
+
+    private static bool TryValue(string line, string key, out string value)
+    {
+        value = "";
+        if (!line.StartsWith(key, StringComparison.Ordinal)) return false;
+        int eq = line.IndexOf('=');
+        if (eq < 0 || line[key.Length..eq].Trim().Length > 0) return false;
+        value = line[(eq + 1)..].Trim();
+        return true;
+    }
+
+    private static void Add(GraphicsDiagnostics result, string id, string severity, string message) =>
+        result.Warnings.Add(new GraphicsWarning { Id = id, Severity = severity, Message = message });
+
+    private static string Summarize(GraphicsDiagnostics result)
+    {
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX)) return "Graphics: Metal (not checked)";
+
+        var parts = new List<string>();
+        if (result.DirectXFeatureLevel != null) parts.Add($"Direct3D feature level {result.DirectXFeatureLevel}");
+        parts.Add(result.OpenGlVersion != null
+            ? $"OpenGL {result.OpenGlVersion}" + (result.OpenGlRenderer != null ? $" ({result.OpenGlRenderer})" : "")
+            : $"OpenGL {Describe(result.OpenGlAvailable)}");
+        parts.Add(result.VulkanVersion != null
+            ? $"Vulkan {result.VulkanVersion}" + (result.VulkanDevices.Count > 0 ? $" ({string.Join(", ", result.VulkanDevices)})" : "")
+            : $"Vulkan {Describe(result.VulkanAvailable)}");
+        return "Graphics: " + string.Join("; ", parts);
+    }
+
+    private static string Describe(bool? available) => available switch
+    {
+        true => "available",
+        false => "unavailable",
+        null => "unknown"
+    };
+}
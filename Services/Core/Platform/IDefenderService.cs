@@ -0,0 +1,23 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Finds out whether Microsoft Defender quarantined or blocked launcher files, which often breaks Butler and
+/// game extraction with access-denied or missing-file errors.
+/// </summary>
+public interface IDefenderService
+{
+    /// <summary>
+    /// Reads Defender's status and its detections of files in the launcher data and instance folders.
+    /// Does not need administrator rights.
+    /// </summary>
+    /// <returns>The report; <see cref="DefenderReport.Supported"/> is <c>false</c> outside Windows.</returns>
+    Task<DefenderReport> CheckAsync();
+
+    /// <summary>
+    /// Builds the PowerShell command that excludes the launcher data and instance folders from Defender scans.
+    /// It is only shown to the user, never run, since it needs administrator rights and lowers protection.
+    /// </summary>
+    string BuildExclusionCommand();
+}
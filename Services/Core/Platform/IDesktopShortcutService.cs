@@ -0,0 +1,18 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Creates desktop shortcuts that start HyPrism straight into a game launch.
+/// </summary>
+public interface IDesktopShortcutService
+{
+    /// <summary>
+    /// Creates a shortcut on the desktop that quick-launches an instance: a <c>.desktop</c> file on Linux,
+    /// a <c>.lnk</c> on Windows and a small <c>.app</c> on macOS. An existing shortcut of the same name is replaced.
+    /// </summary>
+    /// <param name="instanceId">The instance to launch.</param>
+    /// <param name="profileId">The profile to switch to first; <c>null</c> uses whichever profile is active.</param>
+    /// <returns>Where the shortcut was created, or why it could not be.</returns>
+    DesktopShortcutResult CreateDesktopShortcut(string instanceId, string? profileId);
+}
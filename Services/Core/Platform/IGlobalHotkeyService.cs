@@ -0,0 +1,28 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Binds quick actions to system-wide hotkeys that work while the launcher window is hidden or unfocused.
+/// </summary>
+public interface IGlobalHotkeyService
+{
+    /// <summary>
+    /// Registers every hotkey from <c>Config.GlobalHotkeys</c>. Called once Electron is ready.
+    /// </summary>
+    void RegisterAll();
+
+    /// <summary>
+    /// Gets every quick action with its hotkey and whether it is active.
+    /// </summary>
+    Task<List<QuickActionHotkey>> GetHotkeysAsync();
+
+    /// <summary>
+    /// Binds, rebinds or clears the hotkey of a quick action and saves it.
+    /// </summary>
+    /// <param name="action">One of the quick action names.</param>
+    /// <param name="accelerator">Electron accelerator, or empty to clear the hotkey.</param>
+    /// <returns>All hotkeys after the change.</returns>
+    /// <exception cref="ArgumentException">The action is unknown or the hotkey is already bound to another action.</exception>
+    Task<List<QuickActionHotkey>> SetHotkeyAsync(string action, string accelerator);
+}
@@ -0,0 +1,29 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Checks before a launch that the system has working graphics drivers for the game.
+/// </summary>
+public interface IGraphicsDiagnosticsService
+{
+    /// <summary>
+    /// Raised after a check that found warnings or errors (not only info), so the user can be told
+    /// before the game fails to start.
+    /// </summary>
+    event Action<GraphicsDiagnostics>? ProblemsFound;
+
+    /// <summary>
+    /// Runs the check: <c>vulkaninfo</c>, <c>glxinfo</c> and the installed driver libraries on Linux,
+    /// the Direct3D feature level and the Vulkan loader on Windows. The result is kept for the rest of
+    /// the session, since drivers rarely change while the launcher runs.
+    /// </summary>
+    /// <param name="refresh">Runs the check again instead of returning the kept result.</param>
+    /// <param name="ct">Token to cancel the check.</param>
+    Task<GraphicsDiagnostics> RunAsync(bool refresh = false, CancellationToken ct = default);
+
+    /// <summary>
+    /// Gets the result of the last check, or null if none ran this session.
+    /// </summary>
+    GraphicsDiagnostics? GetLast();
+}
@@ -0,0 +1,22 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Checks that the launcher data and instance folders can hold an install, since most failed installs come
+/// down to a read-only, cloud-synced or full folder rather than to the download itself.
+/// </summary>
+public interface IStartupCheckService
+{
+    /// <summary>
+    /// Checks the launcher data folder and the instance folder: writable, not on a read-only mount, not
+    /// synced by OneDrive or iCloud with files only in the cloud, and with enough free space and inodes.
+    /// </summary>
+    /// <returns>The problems found, which are also logged.</returns>
+    StartupCheckReport Run();
+
+    /// <summary>
+    /// Gets the result of the last <see cref="Run"/>; empty before the first.
+    /// </summary>
+    StartupCheckReport GetLastReport();
+}
@@ -0,0 +1,52 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Detects Steam Deck hardware and Gamescope sessions and adapts the launcher and the game client to them.
+/// </summary>
+public interface ISteamDeckService
+{
+    /// <summary>
+    /// Gets whether the launcher runs on Steam Deck hardware.
+    /// </summary>
+    bool IsSteamDeck { get; }
+
+    /// <summary>
+    /// Gets whether the launcher runs inside a Gamescope session, such as Steam Deck Game Mode.
+    /// </summary>
+    bool IsGamescope { get; }
+
+    /// <summary>
+    /// Gets whether gamepad navigation is active, resolving the <c>auto</c> setting.
+    /// </summary>
+    bool IsGamepadMode { get; }
+
+    /// <summary>
+    /// Gets the detection results, the gamepad mode and whether HyPrism is in Steam.
+    /// </summary>
+    SteamDeckStatus GetStatus();
+
+    /// <summary>
+    /// Sets the gamepad mode.
+    /// </summary>
+    /// <param name="mode"><c>auto</c>, <c>on</c> or <c>off</c>; anything else is treated as <c>auto</c>.</param>
+    void SetGamepadMode(string mode);
+
+    /// <summary>
+    /// On the first start on a Steam Deck or in Gamescope, keeps the launcher window open while the game runs.
+    /// Does nothing elsewhere or once applied.
+    /// </summary>
+    void ApplyDefaults();
+
+    /// <summary>
+    /// Gets the SDL variables the client needs in a Gamescope session or on a Steam Deck. Variables already
+    /// set in the launcher's environment are left out so users can override them.
+    /// </summary>
+    Dictionary<string, string> GetClientEnvironment();
+
+    /// <summary>
+    /// Adds HyPrism as a non-Steam game for every Steam user on this computer.
+    /// </summary>
+    SteamShortcutResult AddSteamShortcut();
+}
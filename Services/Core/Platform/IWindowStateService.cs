@@ -0,0 +1,26 @@
+using ElectronNET.API;
+using ElectronNET.API.Entities;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Remembers the launcher window's size and position, and minimizes, closes or restores
+/// the window around game sessions according to the launch behavior settings.
+/// </summary>
+public interface IWindowStateService
+{
+    /// <summary>
+    /// Copies the saved window size and position into <paramref name="options"/>.
+    /// A position that is no longer on any display is dropped.
+    /// </summary>
+    /// <param name="options">Options of the window about to be created.</param>
+    /// <returns><c>true</c> if a saved position was applied; <c>false</c> if the window should be centered.</returns>
+    Task<bool> ApplySavedBoundsAsync(BrowserWindowOptions options);
+
+    /// <summary>
+    /// Starts tracking <paramref name="window"/>: saves its bounds as it moves and resizes,
+    /// and applies the launch behavior when the game starts and exits.
+    /// </summary>
+    /// <param name="window">The main launcher window.</param>
+    void Attach(BrowserWindow window);
+}
@@ -0,0 +1,115 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Which build of each downloaded component is used on each OS and CPU architecture.
+/// </summary>
+/// <remarks>
+/// Hytale ships the client for Windows x64, Linux x64 and macOS; Windows on ARM runs the x64 client through
+/// the OS emulation, so the x64 patches and Java runtime are used there as well. Butler only exists for x64
+/// and runs through Rosetta 2 on Apple Silicon. Linux on ARM has no client build. Code that downloads one of
+/// these components asks here for the architecture instead of using <see cref="UtilityService.GetArch"/>.
+/// </remarks>
+public static class PlatformSupport
+{
+    public const string GameClient = "game";
+    public const string Butler = "butler";
+    public const string HytaleJre = "jre";
+    public const string Temurin = "temurin";
+
+    private static readonly Dictionary<string, Dictionary<string, string>> Artifacts = new()
+    {
+        [GameClient] = new()
+        {
+            ["windows-amd64"] = "amd64",
+            ["windows-arm64"] = "amd64",
+            ["linux-amd64"] = "amd64",
+            ["darwin-amd64"] = "amd64",
+            ["darwin-arm64"] = "arm64",
+        },
+        [Butler] = new()
+        {
+            ["windows-amd64"] = "amd64",
+            ["windows-arm64"] = "amd64",
+            ["linux-amd64"] = "amd64",
+            ["darwin-amd64"] = "amd64",
+            ["darwin-arm64"] = "amd64",
+        },
+        [HytaleJre] = new()
+        {
+            ["windows-amd64"] = "amd64",
+            ["windows-arm64"] = "amd64",
+            ["linux-amd64"] = "amd64",
+            ["darwin-amd64"] = "amd64",
+            ["darwin-arm64"] = "arm64",
+        },
+        // Temurin has native builds everywhere; on Windows on ARM the emulated client starts it as a separate process
+        [Temurin] = new()
+        {
+            ["windows-amd64"] = "amd64",
+            ["windows-arm64"] = "arm64",
+            ["linux-amd64"] = "amd64",
+            ["linux-arm64"] = "arm64",
+            ["darwin-amd64"] = "amd64",
+            ["darwin-arm64"] = "arm64",
+        },
+    };
+
+    private static readonly Dictionary<string, string> ComponentNames = new()
+    {
+        [GameClient] = "The Hytale game client",
+        [Butler] = "Butler, the game installer,",
+        [HytaleJre] = "The Hytale Java runtime",
+        [Temurin] = "Eclipse Temurin",
+    };
+
+    /// <summary>
+    /// Gets this machine as <c>{os}-{arch}</c>.
+    /// </summary>
+    public static string CurrentPlatform => $"{UtilityService.GetOS()}-{UtilityService.GetArch()}";
+
+    /// <summary>
+    /// Gets the architecture of the build of <paramref name="component"/> used on this machine.
+    /// </summary>
+    /// <returns><c>amd64</c> or <c>arm64</c>, or <c>null</c> if the component is not available here.</returns>
+    public static string? GetArtifactArch(string component) =>
+        Artifacts.TryGetValue(component, out var builds) ? builds.GetValueOrDefault(CurrentPlatform) : null;
+
+    /// <summary>
+    /// Gets the architecture of the build of <paramref name="component"/> used on this machine.
+    /// </summary>
+    /// <exception cref="LauncherException"><see cref="ErrorCodes.ArchUnsupported"/> if there is no build for this machine.</exception>
+    public static string RequireArtifactArch(string component)
+    {
+        var arch = GetArtifactArch(component);
+        if (arch != null) return arch;
+
+        var platform = CurrentPlatform;
+        throw new LauncherException(ErrorCodes.ArchUnsupported, MessageCatalog.ErrorArchUnsupported,
+            [ComponentNames.GetValueOrDefault(component, component), platform],
+            $"No {component} build for {platform}");
+    }
+
+    /// <summary>
+    /// Gets the support of every component on this machine.
+    /// </summary>
+    public static List<PlatformComponentSupport> GetMatrix()
+    {
+        var platform = CurrentPlatform;
+        var hostArch = UtilityService.GetArch();
+        return Artifacts.Keys.Select(component =>
+        {
+            var arch = GetArtifactArch(component);
+            return new PlatformComponentSupport
+            {
+                Component = component,
+                Platform = platform,
+                ArtifactArch = arch,
+                Emulated = arch != null && arch != hostArch
+            };
+        }).ToList();
+    }
+}
@@ -0,0 +1,366 @@
+using System.Diagnostics;
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Checks the launcher data and instance folders on startup and reports what will make installs fail.
+/// </summary>
+/// <remarks>
+/// Writability is tested by creating a file, since ACLs, sandboxes and read-only mounts all show up the same
+/// way there. On Linux a failed test is put down to a read-only mount when <c>/proc/self/mounts</c> says so.
+/// OneDrive's Files On-Demand leaves placeholders that are downloaded on first access, which Butler and
+/// the game do not wait for; they carry the cloud-file attributes checked here. Free inodes are only
+/// checked outside Windows, where small or nearly full ext4 volumes run out of them before space. With the
+/// Windows long path policy off, an instance folder that leaves less than <see cref="InstancePathReserve"/>
+/// characters below <see cref="LongPaths.MaxPath"/> is reported before the game trips over it.
+/// </remarks>
+public class StartupCheckService : IStartupCheckService
+{
+    private const long MinFreeBytes = 1L * 1024 * 1024 * 1024;
+    private const long RecommendedFreeBytes = 8L * 1024 * 1024 * 1024;
+    private const long MinFreeInodes = 20_000;
+    private const int CloudFileScanLimit = 5000;
+    // Room kept below an instance folder for the deepest game asset, mod and world paths
+    private const int InstancePathReserve = 140;
+
+    // Cloud-file attributes missing from FileAttributes
+    private const FileAttributes RecallOnOpen = (FileAttributes)0x40000;
+    private const FileAttributes RecallOnDataAccess = (FileAttributes)0x400000;
+    private const FileAttributes CloudOnlyAttributes = FileAttributes.Offline | RecallOnOpen | RecallOnDataAccess;
+
+    private readonly string _appDir;
+    private readonly IInstanceService _instanceService;
+    private StartupCheckReport _lastReport = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="StartupCheckService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory.</param>
+    /// <param name="instanceService">Provides the instance folder, which may be elsewhere.</param>
+    public StartupCheckService(string appDir, IInstanceService instanceService)
+    {
+        _appDir = appDir;
+        _instanceService = instanceService;
+    }
+
+    /// <inheritdoc/>
+    public StartupCheckReport GetLastReport() => _lastReport;
+
+    /// <inheritdoc/>
+    public StartupCheckReport Run()
+    {
+        var report = new StartupCheckReport();
+        var drives = OnboardingService.GetReadyDrives();
+
+        foreach (var folder in GetFolders())
+        {
+            try
+            {
+                CheckWritable(folder, report);
+                CheckCloudSync(folder, report);
+                CheckSpace(folder, drives, report);
+                if (!OperatingSystem.IsWindows()) CheckInodes(folder, drives, report);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("StartupCheck", $"Could not check {folder}: {ex.Message}");
+            }
+        }
+
+        if (OperatingSystem.IsWindows() && !LongPaths.IsSystemEnabled) CheckLongPaths(report);
+
+        foreach (var issue in report.Issues)
+            Logger.Warning("StartupCheck", $"{issue.Code} ({issue.Severity}) at {issue.Path}: {issue.Detail}");
+
+        _lastReport = report;
+        return report;
+    }
+
+    /// <summary>
+    /// Gets the data folder and, when it is not inside it, the instance folder.
+    /// </summary>
+    private List<string> GetFolders()
+    {
+        var folders = new List<string> { Path.GetFullPath(_appDir) };
+        try
+        {
+            var instanceRoot = Path.GetFullPath(_instanceService.GetInstanceRoot());
+            var comparison = OperatingSystem.IsWindows() ? StringComparison.OrdinalIgnoreCase : StringComparison.Ordinal;
+            if (!OnboardingService.IsUnder(instanceRoot, folders[0], comparison)) folders.Add(instanceRoot);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("StartupCheck", $"Could not resolve the instance folder: {ex.Message}");
+        }
+        return folders;
+    }
+
+    private static void CheckWritable(string folder, StartupCheckReport report)
+    {
+        try
+        {
+            Directory.CreateDirectory(folder);
+            var probe = Path.Combine(folder, $".hyprism-write-test-{Guid.NewGuid():N}");
+            using (new FileStream(probe, FileMode.CreateNew, FileAccess.Write, FileShare.None, 1, FileOptions.DeleteOnClose))
+            {
+            }
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            var readOnlyMount = OperatingSystem.IsLinux() ? FindReadOnlyMount(folder) : null;
+            AddIssue(report, new StartupCheckIssue
+            {
+                Code = readOnlyMount != null ? "readOnlyMount" : "notWritable",
+                Severity = StartupCheckIssue.Error,
+                Path = folder,
+                Detail = readOnlyMount != null ? $"{readOnlyMount} is mounted read-only" : ex.Message
+            });
+        }
+    }
+
+    /// <summary>
+    /// Gets the mount point holding the path if it is mounted read-only.
+    /// </summary>
+    private static string? FindReadOnlyMount(string path)
+    {
+        try
+        {
+            string? mountPoint = null;
+            var readOnly = false;
+            foreach (var line in File.ReadLines("/proc/self/mounts"))
+            {
+                var fields = line.Split(' ');
+                if (fields.Length < 4) continue;
+
+                var point = UnescapeMountField(fields[1]);
+                if (!OnboardingService.IsUnder(path, point, StringComparison.Ordinal)) continue;
+                // Later entries for the same point are mounted over earlier ones
+                if (mountPoint != null && point.Length < mountPoint.Length) continue;
+
+                mountPoint = point;
+                readOnly = fields[3].Split(',').Contains("ro");
+            }
+            return readOnly ? mountPoint : null;
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Decodes the octal escapes (<c>\040</c> for a space) the kernel uses in mount tables.
+    /// </summary>
+    private static string UnescapeMountField(string field)
+    {
+        if (!field.Contains('\\')) return field;
+
+        var sb = new StringBuilder(field.Length);
+        for (int i = 0; i < field.Length; i++)
+        {
+            if (field[i] == '\\' && i + 3 < field.Length
+                && field.Substring(i + 1, 3).All(c => c is >= '0' and <= '7'))
+            {
+                sb.Append((char)Convert.ToInt32(field.Substring(i + 1, 3), 8));
+                i += 3;
+            }
+            else
+            {
+                sb.Append(field[i]);
+            }
+        }
+        return sb.ToString();
+    }
+
+    private static void CheckCloudSync(string folder, StartupCheckReport report)
+    {
+        var comparison = OperatingSystem.IsWindows() ? StringComparison.OrdinalIgnoreCase : StringComparison.Ordinal;
+        var syncRoot = GetCloudSyncRoots().FirstOrDefault(root => OnboardingService.IsUnder(folder, root, comparison));
+        if (syncRoot != null)
+        {
+            AddIssue(report, new StartupCheckIssue
+            {
+                Code = "cloudSync",
+                Severity = StartupCheckIssue.Warning,
+                Path = folder,
+                Detail = $"Inside the synced folder {syncRoot}"
+            });
+        }
+
+        if (!OperatingSystem.IsWindows() || !Directory.Exists(folder)) return;
+
+        // Attributes come with the directory listing, so this does not download anything
+        var cloudOnly = new DirectoryInfo(folder)
+            .EnumerateFileSystemInfos("*", new EnumerationOptions
+            {
+                RecurseSubdirectories = true,
+                IgnoreInaccessible = true,
+                AttributesToSkip = 0
+            })
+            .Take(CloudFileScanLimit)
+            .Count(entry => (entry.Attributes & CloudOnlyAttributes) != 0);
+
+        if (cloudOnly > 0)
+        {
+            AddIssue(report, new StartupCheckIssue
+            {
+                Code = "cloudOnlyFiles",
+                Severity = StartupCheckIssue.Error,
+                Path = folder,
+                Detail = $"{cloudOnly} file(s) are only stored in the cloud",
+                Value = cloudOnly
+            });
+        }
+    }
+
+    /// <summary>
+    /// Gets the folders OneDrive and macOS file providers (iCloud Drive, Dropbox, Google Drive) sync.
+    /// </summary>
+    private static IEnumerable<string> GetCloudSyncRoots()
+    {
+        if (OperatingSystem.IsWindows())
+        {
+            foreach (var variable in new[] { "OneDrive", "OneDriveConsumer", "OneDriveCommercial" })
+            {
+                var root = Environment.GetEnvironmentVariable(variable);
+                if (!string.IsNullOrWhiteSpace(root)) yield return Path.GetFullPath(root);
+            }
+        }
+        else if (OperatingSystem.IsMacOS())
+        {
+            var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+            yield return Path.Combine(home, "Library", "Mobile Documents");
+            yield return Path.Combine(home, "Library", "CloudStorage");
+        }
+    }
+
+    private void CheckLongPaths(StartupCheckReport report)
+    {
+        string instanceRoot;
+        try
+        {
+            instanceRoot = Path.GetFullPath(_instanceService.GetInstanceRoot());
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("StartupCheck", $"Could not resolve the instance folder: {ex.Message}");
+            return;
+        }
+
+        // Existing installs show the real depth; a new or empty folder is judged by its own length
+        var expected = instanceRoot.Length + InstancePathReserve;
+        var longest = LongPaths.FindLongest(instanceRoot);
+        var length = Math.Max(expected, longest?.Length ?? 0);
+        if (length < LongPaths.MaxPath) return;
+
+        AddIssue(report, new StartupCheckIssue
+        {
+            Code = "longPaths",
+            Severity = StartupCheckIssue.Warning,
+            Path = instanceRoot,
+            Detail = longest is { Length: >= LongPaths.MaxPath } found
+                ? $"Long paths are disabled and {found.Path} is {found.Length} characters long"
+                : $"Long paths are disabled and the instance folder leaves less than {InstancePathReserve} characters for game files",
+            Value = length
+        });
+    }
+
+    private static void CheckSpace(string folder, List<DriveInfo> drives, StartupCheckReport report)
+    {
+        var drive = OnboardingService.FindDrive(drives, folder);
+        if (drive == null) return;
+
+        long free;
+        try
+        {
+            free = drive.AvailableFreeSpace;
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            return;
+        }
+        if (free >= RecommendedFreeBytes) return;
+
+        AddIssue(report, new StartupCheckIssue
+        {
+            Code = "lowSpace",
+            Severity = free < MinFreeBytes ? StartupCheckIssue.Error : StartupCheckIssue.Warning,
+            Path = drive.RootDirectory.FullName,
+            Detail = $"{free / 1024 / 1024} MB free",
+            Value = free
+        });
+    }
+
+    private static void CheckInodes(string folder, List<DriveInfo> drives, StartupCheckReport report)
+    {
+        var free = GetFreeInodes(folder);
+        if (free == null || free >= MinFreeInodes) return;
+
+        AddIssue(report, new StartupCheckIssue
+        {
+            Code = "lowInodes",
+            Severity = free == 0 ? StartupCheckIssue.Error : StartupCheckIssue.Warning,
+            Path = OnboardingService.FindDrive(drives, folder)?.RootDirectory.FullName ?? folder,
+            Detail = $"{free} inodes free",
+            Value = free
+        });
+    }
+
+    /// <summary>
+    /// Reads the free inodes of the volume holding the path from <c>df</c>.
+    /// </summary>
+    /// <returns>The free inodes, or <c>null</c> if unknown or the file system has no fixed inode count (btrfs, APFS).</returns>
+    private static long? GetFreeInodes(string path)
+    {
+        try
+        {
+            var psi = new ProcessStartInfo
+            {
+                FileName = "df",
+                UseShellExecute = false,
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                CreateNoWindow = true
+            };
+            // -P keeps long device names on one line on Linux; macOS df has no inode columns in POSIX mode
+            psi.ArgumentList.Add(OperatingSystem.IsMacOS() ? "-i" : "-Pi");
+            psi.ArgumentList.Add(path);
+
+            using var process = Process.Start(psi);
+            if (process == null) return null;
+            var output = process.StandardOutput.ReadToEnd();
+            if (!process.WaitForExit(5000) || process.ExitCode != 0) return null;
+
+            var lines = output.Split('\n', StringSplitOptions.RemoveEmptyEntries);
+            if (lines.Length < 2) return null;
+
+            var header = lines[0].Split(' ', StringSplitOptions.RemoveEmptyEntries);
+            var values = lines[^1].Split(' ', StringSplitOptions.RemoveEmptyEntries);
+            var freeIndex = Array.FindIndex(header, h => h.Equals("IFree", StringComparison.OrdinalIgnoreCase));
+            var usedIndex = Array.FindIndex(header, h => h.Equals("IUsed", StringComparison.OrdinalIgnoreCase));
+            if (freeIndex < 0 || usedIndex < 0 || freeIndex >= values.Length || usedIndex >= values.Length) return null;
+
+            if (!long.TryParse(values[freeIndex], out var free) || !long.TryParse(values[usedIndex], out var used)) return null;
+            return free + used == 0 ? null : free;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("StartupCheck", $"Could not read free inodes: {ex.Message}");
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Adds an issue unless the same problem was already found, e.g. for two folders on one volume.
+    /// </summary>
+    private static void AddIssue(StartupCheckReport report, StartupCheckIssue issue)
+    {
+        if (report.Issues.Any(i => i.Code == issue.Code && i.Path == issue.Path)) return;
+        report.Issues.Add(issue);
+    }
+}
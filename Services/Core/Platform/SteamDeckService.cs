@@ -0,0 +1,336 @@
+using System.Diagnostics;
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Steam Deck and Gamescope support: detection, gamepad mode, client SDL variables and the
+/// non-Steam game shortcut.
+/// </summary>
+/// <remarks>
+/// Gamescope shows one fullscreen window at a time and only through its Xwayland server, so in a Gamescope
+/// session the launcher opens fullscreen, stays open while the game runs and starts the client on SDL's X11
+/// backend. The shortcut is written straight into each user's <c>userdata/&lt;id&gt;/config/shortcuts.vdf</c>;
+/// Steam reads that file on start and rewrites it on exit, so a running Steam has to be restarted to pick
+/// it up, and the previous file is kept as <c>shortcuts.vdf.bak</c>.
+/// </remarks>
+public class SteamDeckService : ISteamDeckService
+{
+    private const string ShortcutName = "HyPrism";
+
+    private readonly IConfigService _configService;
+    private readonly Lazy<bool> _isSteamDeck = new(DetectSteamDeck);
+    private readonly Lazy<bool> _isGamescope = new(DetectGamescope);
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="SteamDeckService"/> class.
+    /// </summary>
+    /// <param name="configService">Holds the gamepad mode and launch behavior settings.</param>
+    public SteamDeckService(IConfigService configService)
+    {
+        _configService = configService;
+    }
+
+    /// <inheritdoc/>
+    public bool IsSteamDeck => _isSteamDeck.Value;
+
+    /// <inheritdoc/>
+    public bool IsGamescope => _isGamescope.Value;
+
+    /// <inheritdoc/>
+    public bool IsGamepadMode => _configService.Configuration.GamepadMode switch
+    {
+        "on" => true,
+        "off" => false,
+        _ => IsSteamDeck || IsGamescope
+    };
+
+    /// <inheritdoc/>
+    public SteamDeckStatus GetStatus()
+    {
+        var configs = FindShortcutFiles();
+        var executable = GetLauncherExecutable();
+        return new SteamDeckStatus
+        {
+            IsSteamDeck = IsSteamDeck,
+            IsGamescope = IsGamescope,
+            GamepadModeSetting = _configService.Configuration.GamepadMode,
+            GamepadMode = IsGamepadMode,
+            SteamFound = configs.Count > 0,
+            ShortcutAdded = executable != null && configs.Any(path => HasShortcut(path, executable))
+        };
+    }
+
+    /// <inheritdoc/>
+    public void SetGamepadMode(string mode)
+    {
+        var normalized = mode is "on" or "off" ? mode : "auto";
+        _configService.TryUpdate(c => c.GamepadMode = normalized);
+        Logger.Info("SteamDeck", $"Gamepad mode set to {normalized}");
+    }
+
+    /// <inheritdoc/>
+    public void ApplyDefaults()
+    {
+        if (!IsGamescope || _configService.Configuration.SteamDeckDefaultsApplied) return;
+
+        // Closing or minimizing the launcher in Game Mode leaves Steam with nothing to show once the game exits
+        _configService.TryUpdate(c =>
+        {
+            c.CloseAfterLaunch = false;
+            c.MinimizeOnLaunch = false;
+            c.SteamDeckDefaultsApplied = true;
+        });
+        Logger.Info("SteamDeck", "Gamescope session detected; launcher stays open while the game runs");
+    }
+
+    /// <inheritdoc/>
+    public Dictionary<string, string> GetClientEnvironment()
+    {
+        var env = new Dictionary<string, string>();
+        if (!IsSteamDeck && !IsGamescope) return env;
+
+        // Let SDL use the virtual controller Steam Input creates instead of hiding it
+        env["SDL_GAMECONTROLLER_ALLOW_STEAM_VIRTUAL_GAMEPAD"] = "1";
+        env["SDL_VIDEO_MINIMIZE_ON_FOCUS_LOSS"] = "0";
+        if (IsGamescope)
+        {
+            // Gamescope presents Xwayland windows; its own Wayland socket lacks the protocols SDL needs
+            env["SDL_VIDEODRIVER"] = "x11";
+        }
+
+        foreach (var key in env.Keys.Where(k => Environment.GetEnvironmentVariable(k) != null).ToList())
+            env.Remove(key);
+
+        if (env.Count > 0)
+            Logger.Info("SteamDeck", $"Client environment: {string.Join(", ", env.Select(e => $"{e.Key}={e.Value}"))}");
+        return env;
+    }
+
+    /// <inheritdoc/>
+    public SteamShortcutResult AddSteamShortcut()
+    {
+        var exe = GetLauncherExecutable();
+        if (exe == null)
+            return new SteamShortcutResult { Error = "Could not determine the launcher executable" };
+
+        var files = FindShortcutFiles();
+        if (files.Count == 0)
+            return new SteamShortcutResult { Error = "Steam was not found or no Steam user has signed in yet" };
+
+        int added = 0;
+        foreach (var path in files)
+        {
+            try
+            {
+                if (HasShortcut(path, exe)) continue;
+                AddShortcut(path, exe);
+                added++;
+                Logger.Success("SteamDeck", $"Added non-Steam shortcut to {path}");
+            }
+            catch (Exception ex)
+            {
+                Logger.Error("SteamDeck", $"Could not add shortcut to {path}: {ex.Message}");
+                return new SteamShortcutResult { UsersAdded = added, Error = ex.Message };
+            }
+        }
+
+        return new SteamShortcutResult
+        {
+            Success = true,
+            UsersAdded = added,
+            RestartSteam = added > 0 && IsSteamRunning()
+        };
+    }
+
+    private static bool DetectSteamDeck()
+    {
+        if (!OperatingSystem.IsLinux()) return false;
+        if (Environment.GetEnvironmentVariable("SteamDeck") == "1") return true;
+
+        try
+        {
+            // LCD model is "Jupiter", OLED model is "Galileo"
+            var vendor = File.ReadAllText("/sys/class/dmi/id/board_vendor").Trim();
+            var product = File.ReadAllText("/sys/class/dmi/id/product_name").Trim();
+            return vendor == "Valve" && (product is "Jupiter" or "Galileo");
+        }
+        catch (Exception)
+        {
+            return false;
+        }
+    }
+
+    private static bool DetectGamescope()
+    {
+        if (!OperatingSystem.IsLinux()) return false;
+        return !string.IsNullOrEmpty(Environment.GetEnvironmentVariable("GAMESCOPE_WAYLAND_DISPLAY"))
+               || string.Equals(Environment.GetEnvironmentVariable("XDG_CURRENT_DESKTOP"), "gamescope", StringComparison.OrdinalIgnoreCase);
+    }
+
+    /// <summary>
+    /// Gets what Steam should start: the AppImage when running from one, otherwise the launcher executable.
+    /// </summary>
+    private static string? GetLauncherExecutable()
+    {
+        var exe = Environment.GetEnvironmentVariable("APPIMAGE");
+        if (string.IsNullOrEmpty(exe)) exe = Environment.ProcessPath;
+        return string.IsNullOrEmpty(exe) ? null : exe;
+    }
+
+    /// <summary>
+    /// Finds <c>shortcuts.vdf</c> (existing or not) for every Steam user that has signed in on this computer.
+    /// </summary>
+    private static List<string> FindShortcutFiles()
+    {
+        var files = new List<string>();
+        foreach (var steamDir in FindSteamDirectories())
+        {
+            var userData = Path.Combine(steamDir, "userdata");
+            if (!Directory.Exists(userData)) continue;
+
+            foreach (var userDir in Directory.EnumerateDirectories(userData))
+            {
+                // "0" and "anonymous" are not real accounts
+                var id = Path.GetFileName(userDir);
+                if (!ulong.TryParse(id, out var accountId) || accountId == 0) continue;
+                files.Add(Path.Combine(userDir, "config", "shortcuts.vdf"));
+            }
+        }
+        return files.Distinct(StringComparer.Ordinal).ToList();
+    }
+
+    private static IEnumerable<string> FindSteamDirectories()
+    {
+        var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+        var candidates = new List<string>();
+
+        if (OperatingSystem.IsWindows())
+        {
+            try
+            {
+                using var key = Microsoft.Win32.Registry.CurrentUser.OpenSubKey(@"Software\Valve\Steam");
+                if (key?.GetValue("SteamPath") is string steamPath) candidates.Add(steamPath.Replace('/', '\\'));
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("SteamDeck", $"Could not read the Steam path from the registry: {ex.Message}");
+            }
+            candidates.Add(Path.Combine(Environment.GetFolderPath(Environment.SpecialFolder.ProgramFilesX86), "Steam"));
+        }
+        else if (OperatingSystem.IsMacOS())
+        {
+            candidates.Add(Path.Combine(home, "Library", "Application Support", "Steam"));
+        }
+        else
+        {
+            candidates.Add(Path.Combine(home, ".local", "share", "Steam"));
+            candidates.Add(Path.Combine(home, ".steam", "steam"));
+            candidates.Add(Path.Combine(home, ".var", "app", "com.valvesoftware.Steam", ".local", "share", "Steam"));
+        }
+
+        // ~/.steam/steam is usually a link to ~/.local/share/Steam
+        return candidates
+            .Where(Directory.Exists)
+            .Select(dir => new DirectoryInfo(dir).ResolveLinkTarget(returnFinalTarget: true)?.FullName ?? Path.GetFullPath(dir))
+            .Distinct(StringComparer.Ordinal);
+    }
+
+    private static bool HasShortcut(string path, string exe)
+    {
+        if (!File.Exists(path)) return false;
+        try
+        {
+            var shortcuts = BinaryVdf.GetNode(BinaryVdf.Read(File.ReadAllBytes(path)), "shortcuts");
+            return shortcuts != null && shortcuts.Any(s =>
+                s.Value is List<KeyValuePair<string, object>> entry
+                && string.Equals(BinaryVdf.GetString(entry, "Exe")?.Trim('"'), exe, StringComparison.Ordinal));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("SteamDeck", $"Could not read {path}: {ex.Message}");
+            return false;
+        }
+    }
+
+    private static void AddShortcut(string path, string exe)
+    {
+        List<KeyValuePair<string, object>> root;
+        if (File.Exists(path))
+        {
+            root = BinaryVdf.Read(File.ReadAllBytes(path));
+            File.Copy(path, path + ".bak", overwrite: true);
+        }
+        else
+        {
+            Directory.CreateDirectory(Path.GetDirectoryName(path)!);
+            root = [];
+        }
+
+        var shortcuts = BinaryVdf.GetNode(root, "shortcuts");
+        if (shortcuts == null)
+        {
+            shortcuts = [];
+            root.Add(new("shortcuts", shortcuts));
+        }
+
+        var quotedExe = $"\"{exe}\"";
+        shortcuts.Add(new(shortcuts.Count.ToString(), new List<KeyValuePair<string, object>>
+        {
+            new("appid", GetShortcutAppId(quotedExe, ShortcutName)),
+            new("AppName", ShortcutName),
+            new("Exe", quotedExe),
+            new("StartDir", $"\"{Path.GetDirectoryName(exe)}\""),
+            new("icon", ""),
+            new("ShortcutPath", ""),
+            new("LaunchOptions", ""),
+            new("IsHidden", 0),
+            new("AllowDesktopConfig", 1),
+            new("AllowOverlay", 1),
+            new("OpenVR", 0),
+            new("Devkit", 0),
+            new("DevkitGameID", ""),
+            new("DevkitOverrideAppID", 0),
+            new("LastPlayTime", 0),
+            new("FlatpakAppID", ""),
+            new("tags", new List<KeyValuePair<string, object>>())
+        }));
+
+        var tempPath = path + ".tmp";
+        File.WriteAllBytes(tempPath, BinaryVdf.Write(root));
+        File.Move(tempPath, path, overwrite: true);
+    }
+
+    /// <summary>
+    /// Gets the ID Steam itself gives a non-Steam game: CRC32 of the quoted executable and the name, with the
+    /// top bit set. Using the same ID keeps artwork and controller layouts tied to the shortcut.
+    /// </summary>
+    private static int GetShortcutAppId(string quotedExe, string name)
+    {
+        uint crc = 0xFFFFFFFF;
+        foreach (var b in Encoding.UTF8.GetBytes(quotedExe + name))
+        {
+            crc ^= b;
+            for (int i = 0; i < 8; i++)
+                crc = (crc & 1) != 0 ? (crc >> 1) ^ 0xEDB88320 : crc >> 1;
+        }
+        return unchecked((int)(~crc | 0x80000000));
+    }
+
+    private static bool IsSteamRunning()
+    {
+        try
+        {
+            var name = OperatingSystem.IsMacOS() ? "steam_osx" : "steam";
+            var processes = Process.GetProcessesByName(name);
+            foreach (var process in processes) process.Dispose();
+            return processes.Length > 0;
+        }
+        catch (Exception)
+        {
+            return false;
+        }
+    }
+}
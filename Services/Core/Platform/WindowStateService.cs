@@ -0,0 +1,212 @@
+using ElectronNET.API;
+using ElectronNET.API.Entities;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Core.Platform;
+
+/// <summary>
+/// Persists the launcher window state in <see cref="Config.Window"/> and applies
+/// <see cref="Config.CloseAfterLaunch"/>, <see cref="Config.MinimizeOnLaunch"/> and
+/// <see cref="Config.RestoreOnGameExit"/> on game state changes.
+/// </summary>
+/// <remarks>
+/// Moves and resizes arrive in bursts while the user drags, so bounds are written once they settle
+/// for <see cref="SaveDelay"/>. Only a window this service minimized is restored when the game exits;
+/// one the user minimized or left open is not touched.
+/// </remarks>
+public class WindowStateService : IWindowStateService
+{
+    private const int MinWidth = 1024;
+    private const int MinHeight = 700;
+
+    /// <summary>
+    /// How much of a saved window must overlap a display's work area for its position to be kept.
+    /// </summary>
+    private const int MinVisiblePixels = 100;
+
+    private static readonly TimeSpan SaveDelay = TimeSpan.FromMilliseconds(500);
+
+    private readonly IConfigService _configService;
+    private readonly object _lock = new();
+    private BrowserWindow? _window;
+    private CancellationTokenSource? _pendingSave;
+    private bool _minimizedForGame;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="WindowStateService"/> class.
+    /// </summary>
+    /// <param name="configService">Holds the window state and launch behavior settings.</param>
+    /// <param name="progressService">Raises the game state changes the launch behavior reacts to.</param>
+    public WindowStateService(IConfigService configService, IProgressNotificationService progressService)
+    {
+        _configService = configService;
+        progressService.GameStateChanged += OnGameStateChanged;
+    }
+
+    private Config _config => _configService.Configuration;
+
+    /// <inheritdoc/>
+    public async Task<bool> ApplySavedBoundsAsync(BrowserWindowOptions options)
+    {
+        var saved = _config.Window;
+        options.Width = Math.Max(MinWidth, saved.Width);
+        options.Height = Math.Max(MinHeight, saved.Height);
+
+        if (saved.X is not int x || saved.Y is not int y) return false;
+
+        try
+        {
+            var displays = await Electron.Screen.GetAllDisplaysAsync();
+            bool visible = displays.Any(d => OverlapsEnough(d.WorkArea, x, y, options.Width, options.Height));
+            if (!visible)
+            {
+                Logger.Info("Window", $"Saved window position {x},{y} is off-screen; centering instead");
+                return false;
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Window", $"Could not read displays, centering window: {ex.Message}");
+            return false;
+        }
+
+        options.X = x;
+        options.Y = y;
+        options.Center = false;
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public void Attach(BrowserWindow window)
+    {
+        _window = window;
+
+        window.OnReadyToShow += () =>
+        {
+            if (_config.Window.Maximized) window.Maximize();
+        };
+        window.OnResize += ScheduleSave;
+        window.OnMove += ScheduleSave;
+        window.OnMaximize += ScheduleSave;
+        window.OnUnmaximize += ScheduleSave;
+        window.OnRestore += () => _minimizedForGame = false;
+    }
+
+    private void OnGameStateChanged(string state, int exitCode)
+    {
+        var window = _window;
+        if (window == null) return;
+
+        try
+        {
+            switch (state)
+            {
+                case "started":
+                    if (_config.CloseAfterLaunch)
+                    {
+                        Logger.Info("Window", "Game started, closing launcher (CloseAfterLaunch)");
+                        _ = SaveAndCloseAsync(window);
+                    }
+                    else if (_config.MinimizeOnLaunch)
+                    {
+                        Logger.Info("Window", "Game started, minimizing launcher");
+                        window.Minimize();
+                        _minimizedForGame = true;
+                    }
+                    break;
+
+                case "stopped":
+                    if (_minimizedForGame && _config.RestoreOnGameExit)
+                    {
+                        Logger.Info("Window", $"Game exited (code {exitCode}), restoring launcher");
+                        window.Restore();
+                        window.Show();
+                        window.Focus();
+                    }
+                    _minimizedForGame = false;
+                    break;
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Window", $"Failed to apply launch behavior for '{state}': {ex.Message}");
+        }
+    }
+
+    private void ScheduleSave()
+    {
+        CancellationTokenSource cts;
+        lock (_lock)
+        {
+            _pendingSave?.Cancel();
+            _pendingSave = cts = new CancellationTokenSource();
+        }
+
+        _ = Task.Delay(SaveDelay, cts.Token).ContinueWith(t =>
+        {
+            if (!t.IsCanceled) _ = SaveAsync();
+        }, TaskScheduler.Default);
+    }
+
+    private async Task SaveAndCloseAsync(BrowserWindow window)
+    {
+        lock (_lock)
+        {
+            _pendingSave?.Cancel();
+            _pendingSave = null;
+        }
+        await SaveAsync();
+        window.Close();
+    }
+
+    private async Task SaveAsync()
+    {
+        var window = _window;
+        if (window == null) return;
+
+        try
+        {
+            // A minimized window reports bounds that are meaningless to restore
+            if (await window.IsMinimizedAsync()) return;
+
+            var state = new WindowState
+            {
+                X = _config.Window.X,
+                Y = _config.Window.Y,
+                Width = _config.Window.Width,
+                Height = _config.Window.Height,
+                Maximized = await window.IsMaximizedAsync()
+            };
+
+            // Keep the normal bounds while maximized so un-maximizing after a restart returns to them
+            if (!state.Maximized)
+            {
+                var bounds = await window.GetBoundsAsync();
+                state.X = bounds.X;
+                state.Y = bounds.Y;
+                state.Width = bounds.Width;
+                state.Height = bounds.Height;
+            }
+
+            var current = _config.Window;
+            if (current.X == state.X && current.Y == state.Y && current.Width == state.Width
+                && current.Height == state.Height && current.Maximized == state.Maximized) return;
+
+            if (!_configService.TryUpdate(c => c.Window = state))
+                Logger.Warning("Window", "Failed to save window state");
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Window", $"Failed to read window state: {ex.Message}");
+        }
+    }
+
+    private static bool OverlapsEnough(Rectangle area, int x, int y, int width, int height)
+    {
+        int overlapWidth = Math.Min(x + width, area.X + area.Width) - Math.Max(x, area.X);
+        int overlapHeight = Math.Min(y + height, area.Y + area.Height) - Math.Max(y, area.Y);
+        return overlapWidth >= MinVisiblePixels && overlapHeight >= MinVisiblePixels;
+    }
+}
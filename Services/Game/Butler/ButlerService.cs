@@ -1,7 +1,13 @@
 using System.Diagnostics;
+using System.Globalization;
 using System.IO.Compression;
 using System.Runtime.InteropServices;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
+using HyPrism.Services.Game.Download;
 
 namespace HyPrism.Services.Game.Butler;
 
@@ -12,23 +18,45 @@ namespace HyPrism.Services.Game.Butler;
 /// <remarks>
 /// Butler is automatically downloaded from itch.io's broth distribution system
 /// when needed. On macOS, the amd64 version is used via Rosetta 2.
+/// A watchdog kills <c>butler apply</c> when it exceeds <c>Config.ButlerTimeoutMinutes</c> or prints
+/// nothing for <c>Config.ButlerStallMinutes</c>, cleans the staging folder and reports
+/// <see cref="ErrorCodes.InstallTimedOut"/>.
 /// </remarks>
 public class ButlerService : IButlerService
 {
     private const string ButlerVersion = "15.21.0";
     private const string BrothUrlTemplate = "https://broth.itch.zone/butler/{0}-{1}/LATEST/archive/default";
+    private const int OutputTailLines = 50;
+    private static readonly TimeSpan WatchdogInterval = TimeSpan.FromSeconds(5);
+    private static readonly Regex ProgressPercent = new(@"(\d+(?:\.\d+)?)%", RegexOptions.Compiled);
     
     private readonly string _butlerDir;
     private readonly string _cacheDir;
-    private static readonly HttpClient HttpClient = new() { Timeout = TimeSpan.FromMinutes(5) };
+    private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly IConfigService _configService;
+    private readonly IInstallLogService _installLogs;
+    private readonly IProgressNotificationService _progressService;
+    private readonly SemaphoreSlim _installGate = new(1, 1);
 
     /// <summary>
     /// Initializes a new instance of the <see cref="ButlerService"/> class.
     /// Creates the Butler and Cache directories if they don't exist.
     /// </summary>
     /// <param name="appDir">The application data directory path.</param>
-    public ButlerService(string appDir)
+    /// <param name="httpClient">The shared HTTP client for downloading Butler.</param>
+    /// <param name="networkPolicy">Timeout policy for the download.</param>
+    /// <param name="configService">Holds the apply timeout and stall limit.</param>
+    /// <param name="installLogs">Receives the full butler output of each apply.</param>
+    /// <param name="progressService">Receives the Butler download progress.</param>
+    public ButlerService(string appDir, HttpClient httpClient, NetworkPolicy networkPolicy, IConfigService configService,
+        IInstallLogService installLogs, IProgressNotificationService progressService)
     {
+        _progressService = progressService;
+        _configService = configService;
+        _installLogs = installLogs;
+        _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
         _butlerDir = Path.Combine(appDir, "Butler");
         _cacheDir = Path.Combine(appDir, "Cache");
         Directory.CreateDirectory(_butlerDir);
@@ -51,6 +79,20 @@ public class ButlerService : IButlerService
 
     /// <inheritdoc/>
     public async Task<string> EnsureButlerInstalledAsync(Action<int, string>? progressCallback = null)
+    {
+        // An install may prepare Butler next to the game download while a patch asks for it too
+        await _installGate.WaitAsync();
+        try
+        {
+            return await InstallButlerIfNeededAsync(progressCallback);
+        }
+        finally
+        {
+            _installGate.Release();
+        }
+    }
+
+    private async Task<string> InstallButlerIfNeededAsync(Action<int, string>? progressCallback)
     {
         // Recreate directories if they were removed externally
         Directory.CreateDirectory(_butlerDir);
@@ -92,35 +134,30 @@ public class ButlerService : IButlerService
 
         // Determine OS and architecture
         string osName = UtilityService.GetOS();
-        string arch = UtilityService.GetArch();
-
-        // Butler only provides darwin-amd64 (no arm64), so on macOS always use amd64
-        // which runs through Rosetta 2 on Apple Silicon
-        if (osName == "darwin")
-        {
-            arch = "amd64";
-        }
+        string arch = PlatformSupport.RequireArtifactArch(PlatformSupport.Butler);
 
         string url = string.Format(BrothUrlTemplate, osName, arch);
         Logger.Info("Butler", $"Downloading from: {url}");
 
         string archivePath = Path.Combine(_cacheDir, "butler.zip");
+        using var operation = _progressService.BeginOperation("butler");
 
         try
         {
             // Download butler archive
-            using var response = await HttpClient.GetAsync(url, HttpCompletionOption.ResponseHeadersRead);
+            using var cts = _networkPolicy.CreateTimeout(RequestClass.SmallFile);
+            using var response = await _httpClient.GetAsync(url, HttpCompletionOption.ResponseHeadersRead, cts.Token);
             response.EnsureSuccessStatusCode();
 
             var totalBytes = response.Content.Headers.ContentLength ?? -1;
-            using var stream = await response.Content.ReadAsStreamAsync();
+            using var stream = await response.Content.ReadAsStreamAsync(cts.Token);
             using var fileStream = new FileStream(archivePath, FileMode.Create, FileAccess.Write, FileShare.None, 8192);
 
             var buffer = new byte[8192];
             long totalRead = 0;
             int bytesRead;
 
-            while ((bytesRead = await stream.ReadAsync(buffer)) > 0)
+            while ((bytesRead = await stream.ReadAsync(buffer, cts.Token)) > 0)
             {
                 await fileStream.WriteAsync(buffer.AsMemory(0, bytesRead));
                 totalRead += bytesRead;
@@ -130,6 +167,8 @@ public class ButlerService : IButlerService
                     int progress = (int)((totalRead * 80) / totalBytes); // 0-80% for download
                     progressCallback?.Invoke(progress, "launch.detail.downloading_butler");
                 }
+                operation.Report("download", totalBytes > 0 ? (int)(totalRead * 80 / totalBytes) : 0, totalRead,
+                    Math.Max(totalBytes, 0), "launch.detail.downloading_butler");
             }
         }
         catch (Exception ex)
@@ -139,6 +178,7 @@ public class ButlerService : IButlerService
         }
 
         progressCallback?.Invoke(85, "Extracting Butler...");
+        operation.Report("extract", 85, messageKey: "launch.detail.butler_extract");
 
         try
         {
@@ -199,6 +239,7 @@ public class ButlerService : IButlerService
         }
 
         progressCallback?.Invoke(100, "launch.detail.butler_ready");
+        operation.Complete();
         return butlerPath;
     }
 
@@ -257,9 +298,11 @@ public class ButlerService : IButlerService
 
         Logger.Info("Butler", $"Applying PWR: {pwrFile} -> {targetDir}");
 
+        // --json makes butler report progress as JSON lines even without a terminal, which also feeds the stall watchdog.
+        // Without the long paths policy, Windows paths are passed with the \\?\ prefix, which butler keeps for the files it writes below them
         var args = RuntimeInformation.IsOSPlatform(OSPlatform.Windows)
-            ? $"apply --staging-dir \"{stagingDir}\" --save-interval=60 \"{pwrFile}\" \"{targetDir}\""
-            : $"apply --staging-dir \"{stagingDir}\" \"{pwrFile}\" \"{targetDir}\"";
+            ? $"--json apply --staging-dir \"{LongPaths.ToExtended(stagingDir)}\" --save-interval=60 \"{LongPaths.ToExtended(pwrFile)}\" \"{LongPaths.ToExtended(targetDir)}\""
+            : $"--json apply --staging-dir \"{stagingDir}\" \"{pwrFile}\" \"{targetDir}\"";
 
         var psi = new ProcessStartInfo
         {
@@ -269,7 +312,8 @@ public class ButlerService : IButlerService
             RedirectStandardOutput = true,
             RedirectStandardError = true,
             CreateNoWindow = true,
-            WorkingDirectory = targetDir
+            // Windows cannot start a process in a directory of MAX_PATH or more; butler only gets absolute paths
+            WorkingDirectory = LongPaths.IsTooLong(targetDir) ? Path.GetDirectoryName(butlerPath)! : targetDir
         };
 
         using var process = Process.Start(psi);
@@ -278,10 +322,11 @@ public class ButlerService : IButlerService
             throw new Exception("Failed to start Butler process");
         }
 
-        // Use a timeout for the entire operation (8 minutes max) combined with external cancellation
-        using var timeoutCts = new System.Threading.CancellationTokenSource(TimeSpan.FromMinutes(8));
-        using var linkedCts = CancellationTokenSource.CreateLinkedTokenSource(timeoutCts.Token, externalCancellationToken);
-        var cts = linkedCts;
+        var config = _configService.Configuration;
+        var timeout = TimeSpan.FromMinutes(Math.Max(1, config.ButlerTimeoutMinutes));
+        var stallTimeout = TimeSpan.FromMinutes(Math.Max(1, config.ButlerStallMinutes));
+        var started = DateTime.UtcNow;
+        long lastActivityTicks = started.Ticks;
         
         // Track progress with a simulated progress if butler doesn't report
         int lastProgress = 10;
@@ -297,100 +342,88 @@ public class ButlerService : IButlerService
         };
         progressTimer.Start();
 
-        string output = "";
-        string error = "";
+        // Only the last lines are kept for the error message; the full output goes to the install log as it arrives
+        var outputTail = new Queue<string>();
+        var loggedProgressStep = -1;
 
-        try
+        void OnLine(string line, bool isError)
         {
-            // Read output and error concurrently but with proper handling
-            var outputBuilder = new System.Text.StringBuilder();
-            var errorBuilder = new System.Text.StringBuilder();
+            Interlocked.Exchange(ref lastActivityTicks, DateTime.UtcNow.Ticks);
+            lock (outputTail)
+            {
+                outputTail.Enqueue(line);
+                if (outputTail.Count > OutputTailLines) outputTail.Dequeue();
+            }
 
-            var outputTask = Task.Run(async () =>
+            var pct = ParseProgress(line);
+            if (pct == null)
             {
-                try
-                {
-                    char[] buffer = new char[1024];
-                    while (true)
-                    {
-                        int read = await process.StandardOutput.ReadAsync(buffer, cts.Token);
-                        if (read == 0) break;
-                        
-                        string chunk = new string(buffer, 0, read);
-                        outputBuilder.Append(chunk);
-                        
-                        // Parse progress from butler output if available
-                        if (chunk.Contains("%"))
-                        {
-                            // Butler outputs progress like "patching 45.2%"
-                            var match = System.Text.RegularExpressions.Regex.Match(chunk, @"(\d+(?:\.\d+)?)%");
-                            if (match.Success && double.TryParse(match.Groups[1].Value, 
-                                System.Globalization.NumberStyles.Any, 
-                                System.Globalization.CultureInfo.InvariantCulture, 
-                                out double pct))
-                            {
-                                // Map butler progress (0-100) to our range (10-95)
-                                int mappedProgress = 10 + (int)(pct * 0.85);
-                                if (mappedProgress > lastProgress)
-                                {
-                                    lastProgress = mappedProgress;
-                                    progressCallback?.Invoke(mappedProgress, "launch.detail.installing_game");
-                                }
-                            }
-                        }
-                    }
-                }
-                catch (OperationCanceledException) { }
-                catch (Exception ex) 
-                { 
-                    Logger.Warning("Butler", $"Output read error: {ex.Message}");
-                }
-            }, cts.Token);
+                // Warnings reach the install log through the logger
+                if (isError) Logger.Warning("Butler", line);
+                else _installLogs.Append("butler", line);
+                return;
+            }
 
-            var errorTask = Task.Run(async () =>
+            // Progress arrives many times a second; the install log gets every tenth percent
+            var step = (int)(pct.Value / 10);
+            if (step > loggedProgressStep)
             {
-                try
-                {
-                    errorBuilder.Append(await process.StandardError.ReadToEndAsync(cts.Token));
-                }
-                catch (OperationCanceledException) { }
-                catch { }
-            }, cts.Token);
+                loggedProgressStep = step;
+                _installLogs.Append("butler", $"{pct.Value:0.#}%");
+            }
 
-            // Wait for process to exit with timeout
-            try
+            // Map butler progress (0-100) to our range (10-95)
+            int mappedProgress = 10 + (int)(pct.Value * 0.85);
+            if (mappedProgress > lastProgress)
             {
-                await process.WaitForExitAsync(cts.Token);
+                lastProgress = mappedProgress;
+                progressCallback?.Invoke(mappedProgress, "launch.detail.installing_game");
             }
-            catch (OperationCanceledException)
+        }
+
+        var readers = Task.WhenAll(
+            PumpLinesAsync(process.StandardOutput, line => OnLine(line, false)),
+            PumpLinesAsync(process.StandardError, line => OnLine(line, true)));
+
+        try
+        {
+            // Watchdog: stop on cancellation, when the whole apply takes too long, or when butler goes silent
+            string? hangReason = null;
+            while (!process.HasExited)
             {
-                if (externalCancellationToken.IsCancellationRequested)
+                try
+                {
+                    await process.WaitForExitAsync(externalCancellationToken).WaitAsync(WatchdogInterval, externalCancellationToken);
+                }
+                catch (TimeoutException) { }
+                catch (OperationCanceledException)
                 {
                     Logger.Info("Butler", "Butler process cancelled by user");
-                    try { process.Kill(); } catch { }
+                    KillProcessTree(process);
                     CleanStagingDirectory(targetDir);
                     throw new OperationCanceledException("Download cancelled by user.");
                 }
-                else
+
+                var now = DateTime.UtcNow;
+                if (now - started > timeout)
+                    hangReason = $"did not finish within {timeout.TotalMinutes:0} minutes";
+                else if (now - new DateTime(Interlocked.Read(ref lastActivityTicks), DateTimeKind.Utc) > stallTimeout)
+                    hangReason = $"produced no output for {stallTimeout.TotalMinutes:0} minutes";
+
+                if (hangReason != null && !process.HasExited)
                 {
-                    Logger.Error("Butler", "Butler process timed out after 8 minutes");
-                    try { process.Kill(); } catch { }
-                    throw new Exception("Installation timed out. Please try again.");
+                    Logger.Error("Butler", $"Butler {hangReason}; killing it");
+                    KillProcessTree(process);
+                    await Task.WhenAny(readers, Task.Delay(TimeSpan.FromSeconds(5)));
+                    CleanStagingDirectory(targetDir);
+                    throw new LauncherException(ErrorCodes.InstallTimedOut, MessageCatalog.ErrorInstallTimedOut,
+                        [(int)(now - started).TotalMinutes], $"Butler apply {hangReason}");
                 }
             }
 
-            // Give output tasks a short time to finish after process exits
-            try
-            {
-                await Task.WhenAll(outputTask, errorTask).WaitAsync(TimeSpan.FromSeconds(5));
-            }
-            catch (TimeoutException)
-            {
-                Logger.Warning("Butler", "Output tasks did not finish in time");
-            }
-
-            output = outputBuilder.ToString();
-            error = errorBuilder.ToString();
+            // Give output readers a short time to drain after the process exits
+            if (await Task.WhenAny(readers, Task.Delay(TimeSpan.FromSeconds(5))) != readers)
+                Logger.Warning("Butler", "Output readers did not finish in time");
         }
         finally
         {
@@ -400,15 +433,16 @@ public class ButlerService : IButlerService
 
         if (process.ExitCode != 0)
         {
-            Logger.Error("Butler", $"Error output: {error}");
+            string tail;
+            lock (outputTail) tail = string.Join("\n", outputTail);
+            Logger.Error("Butler", $"Error output: {tail}");
             CleanStagingDirectory(targetDir);
-            throw new Exception($"Butler apply failed (exit code {process.ExitCode}): {error}");
+            throw new Exception($"Butler apply failed (exit code {process.ExitCode}): {tail}");
         }
 
-        Logger.Debug("Butler", $"Output: {output}");
-
         // Clean up staging directory
         CleanStagingDirectory(targetDir);
+        LongPaths.WarnIfTooLong(targetDir, "Game install");
 
         progressCallback?.Invoke(98, "Setting permissions...");
 
@@ -440,6 +474,65 @@ public class ButlerService : IButlerService
         Logger.Success("Butler", "Installation complete");
     }
 
+    /// <summary>
+    /// Reads a stream line by line until it ends, so output is handled as it arrives instead of being buffered.
+    /// </summary>
+    private static async Task PumpLinesAsync(StreamReader reader, Action<string> onLine)
+    {
+        try
+        {
+            while (await reader.ReadLineAsync() is { } line)
+            {
+                if (line.Length > 0) onLine(line);
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Butler", $"Output read error: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Gets the percentage from a butler JSON progress line (<c>{"type":"progress","progress":0.45}</c>)
+    /// or from plain output such as <c>patching 45.2%</c>.
+    /// </summary>
+    private static double? ParseProgress(string line)
+    {
+        if (line.StartsWith('{'))
+        {
+            try
+            {
+                using var doc = JsonDocument.Parse(line);
+                var root = doc.RootElement;
+                if (root.TryGetProperty("type", out var type) && type.GetString() == "progress"
+                    && root.TryGetProperty("progress", out var progress) && progress.TryGetDouble(out var fraction))
+                    return fraction * 100;
+                return null;
+            }
+            catch (JsonException)
+            {
+                return null;
+            }
+        }
+
+        var match = ProgressPercent.Match(line);
+        return match.Success && double.TryParse(match.Groups[1].Value, NumberStyles.Any, CultureInfo.InvariantCulture, out var pct)
+            ? pct
+            : null;
+    }
+
+    private static void KillProcessTree(Process process)
+    {
+        try
+        {
+            process.Kill(entireProcessTree: true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Butler", $"Could not kill butler: {ex.Message}");
+        }
+    }
+
     private void CleanStagingDirectory(string gameDir)
     {
         string stagingDir = Path.Combine(gameDir, "staging-temp");
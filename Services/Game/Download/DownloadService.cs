@@ -1,3 +1,4 @@
+using System.Security.Cryptography;
 using HyPrism.Services.Core.Infrastructure;
 
 namespace HyPrism.Services.Game.Download;
@@ -6,25 +7,74 @@ namespace HyPrism.Services.Game.Download;
 /// Provides file download functionality with progress tracking and resume support.
 /// Used for downloading game files, patches, and other assets.
 /// </summary>
+/// <remarks>
+/// Transient failures are retried according to <see cref="NetworkPolicy"/>; each retry resumes
+/// from the bytes already on disk. Speed and time left are measured once per download with a
+/// <see cref="TransferRate"/>, so a retry keeps the smoothed speed. On a metered connection, large
+/// downloads wait for <see cref="IMeteredConnectionService"/> and throw <see cref="DownloadDeclinedException"/>
+/// when the user declines.
+/// </remarks>
 public class DownloadService : IDownloadService
 {
     private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly IMeteredConnectionService _meteredConnection;
 
     /// <summary>
     /// Initializes a new instance of the <see cref="DownloadService"/> class.
     /// </summary>
     /// <param name="httpClient">The HTTP client for downloading files.</param>
-    public DownloadService(HttpClient httpClient)
+    /// <param name="networkPolicy">Timeout and retry policy.</param>
+    /// <param name="meteredConnection">Confirms large downloads on a metered connection.</param>
+    public DownloadService(HttpClient httpClient, NetworkPolicy networkPolicy, IMeteredConnectionService meteredConnection)
     {
         _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
+        _meteredConnection = meteredConnection;
     }
 
     /// <inheritdoc/>
-    public async Task DownloadFileAsync(
+    public Task DownloadFileAsync(
         string url, 
         string destinationPath, 
         Action<int, long, long> progressCallback, 
         CancellationToken cancellationToken = default)
+    {
+        return DownloadFileAsync(url, destinationPath, progressCallback, null, cancellationToken);
+    }
+
+    /// <inheritdoc/>
+    public Task DownloadFileAsync(
+        string url, 
+        string destinationPath, 
+        Action<int, long, long> progressCallback, 
+        ExpectedHash? expectedHash,
+        CancellationToken cancellationToken = default)
+    {
+        return DownloadFileAsync(url, destinationPath,
+            p => progressCallback?.Invoke(p.Percent, p.DownloadedBytes, p.TotalBytes), expectedHash, cancellationToken);
+    }
+
+    /// <inheritdoc/>
+    public Task DownloadFileAsync(
+        string url, 
+        string destinationPath, 
+        Action<TransferProgress> progressCallback, 
+        ExpectedHash? expectedHash,
+        CancellationToken cancellationToken = default)
+    {
+        var rate = new TransferRate();
+        return _networkPolicy.ExecuteAsync(RequestClass.LargeDownload, $"Download of {Path.GetFileName(destinationPath)}",
+            token => DownloadOnceAsync(url, destinationPath, progressCallback, rate, expectedHash, token), cancellationToken);
+    }
+
+    private async Task DownloadOnceAsync(
+        string url, 
+        string destinationPath, 
+        Action<TransferProgress> progressCallback, 
+        TransferRate rate,
+        ExpectedHash? expectedHash,
+        CancellationToken cancellationToken)
     {
         long existingLength = 0;
         if (File.Exists(destinationPath))
@@ -53,8 +103,24 @@ public class DownloadService : IDownloadService
         {
              // Already done?
              Logger.Info("Download", "File already downloaded fully.");
-             progressCallback?.Invoke(100, totalBytes, totalBytes);
-             return;
+             if (expectedHash == null || await ExistingFileMatchesAsync(destinationPath, expectedHash, cancellationToken))
+             {
+                 progressCallback?.Invoke(new TransferProgress(100, totalBytes, totalBytes, 0, null));
+                 return;
+             }
+
+             // Stale or corrupt leftover, fetch it again from scratch
+             Logger.Warning("Download", $"Existing {Path.GetFileName(destinationPath)} failed {expectedHash.Kind} check, downloading again");
+             File.Delete(destinationPath);
+             existingLength = 0;
+        }
+
+        // Ask before the GET so no connection is held open while the user decides
+        var fileName = Path.GetFileName(destinationPath);
+        if (fileName.EndsWith(".part", StringComparison.OrdinalIgnoreCase)) fileName = fileName[..^5];
+        if (!await _meteredConnection.ConfirmDownloadAsync(url, fileName, canResume ? totalBytes - existingLength : totalBytes, cancellationToken))
+        {
+            throw new DownloadDeclinedException(fileName);
         }
 
         using var request = new HttpRequestMessage(HttpMethod.Get, url);
@@ -82,7 +148,7 @@ public class DownloadService : IDownloadService
         {
             var errorBody = await response.Content.ReadAsStringAsync(cancellationToken);
             Logger.Error("Download", $"Download failed from {url}: HTTP {(int)response.StatusCode} {response.StatusCode}. Response: {errorBody?.Substring(0, Math.Min(500, errorBody?.Length ?? 0))}");
-            throw new HttpRequestException($"Download failed: HTTP {(int)response.StatusCode} {response.StatusCode}");
+            throw new HttpRequestException($"Download failed: HTTP {(int)response.StatusCode} {response.StatusCode}", null, response.StatusCode);
         }
         
         // If we didn't get totalBytes from HEAD earlier (e.g. -1), try getting it from response
@@ -95,6 +161,13 @@ public class DownloadService : IDownloadService
 
         // File Mode
         FileMode fileMode = canResume ? FileMode.Append : FileMode.Create;
+
+        // Hash while streaming; a resumed download first hashes the bytes already on disk
+        using var hasher = expectedHash?.CreateHasher();
+        if (hasher != null && canResume)
+        {
+            await HashExistingAsync(hasher, destinationPath, existingLength, cancellationToken);
+        }
         
         using var contentStream = await response.Content.ReadAsStreamAsync(cancellationToken);
         using var fileStream = new FileStream(destinationPath, fileMode, FileAccess.Write, FileShare.None, 8192, true);
@@ -106,18 +179,67 @@ public class DownloadService : IDownloadService
         while ((bytesRead = await contentStream.ReadAsync(buffer, 0, buffer.Length, cancellationToken)) > 0)
         {
             await fileStream.WriteAsync(buffer, 0, bytesRead, cancellationToken);
+            hasher?.AppendData(buffer, 0, bytesRead);
             totalRead += bytesRead;
             
             if (totalBytes > 0)
             {
                 var progress = (int)((totalRead * 100) / totalBytes);
-                progressCallback?.Invoke(progress, totalRead, totalBytes);
+                rate.Sample(totalRead, totalBytes);
+                progressCallback?.Invoke(new TransferProgress(progress, totalRead, totalBytes, rate.BytesPerSecond, rate.EtaSeconds));
             }
         }
         
+        await fileStream.FlushAsync(cancellationToken);
+        fileStream.Close();
+
+        if (hasher != null)
+        {
+            VerifyHash(hasher, expectedHash!, destinationPath);
+        }
+        
         Logger.Info("Download", $"Download finished. {totalRead / 1024 / 1024} MB to {destinationPath}");
     }
 
+    private static async Task HashExistingAsync(IncrementalHash hasher, string path, long length, CancellationToken ct)
+    {
+        await using var existing = new FileStream(path, FileMode.Open, FileAccess.Read, FileShare.Read, 81920, true);
+        var buffer = new byte[81920];
+        long remaining = length;
+        int read;
+        while (remaining > 0 && (read = await existing.ReadAsync(buffer.AsMemory(0, (int)Math.Min(buffer.Length, remaining)), ct)) > 0)
+        {
+            hasher.AppendData(buffer, 0, read);
+            remaining -= read;
+        }
+    }
+
+    private static async Task<bool> ExistingFileMatchesAsync(string path, ExpectedHash expected, CancellationToken ct)
+    {
+        using var hasher = expected.CreateHasher();
+        await HashExistingAsync(hasher, path, new FileInfo(path).Length, ct);
+        return Convert.ToHexString(hasher.GetHashAndReset()).Equals(expected.Value, StringComparison.OrdinalIgnoreCase);
+    }
+
+    /// <summary>
+    /// Compares the finished hash with the expected one; on mismatch the file is deleted so the
+    /// next attempt starts from scratch instead of resuming corrupt data.
+    /// </summary>
+    private static void VerifyHash(IncrementalHash hasher, ExpectedHash expected, string path)
+    {
+        var actual = Convert.ToHexString(hasher.GetHashAndReset());
+        if (actual.Equals(expected.Value, StringComparison.OrdinalIgnoreCase))
+        {
+            Logger.Info("Download", $"{expected.Kind} verified for {Path.GetFileName(path)}");
+            return;
+        }
+
+        try { File.Delete(path); } catch { /* ignore */ }
+        var mismatch = new HashMismatchException(path, expected.Kind, expected.Value, actual);
+        Logger.Error("Download", mismatch.Message);
+        throw mismatch;
+    }
+
     /// <summary>
     /// Check file size without downloading.
     /// </summary>
@@ -125,8 +247,9 @@ public class DownloadService : IDownloadService
     {
         try
         {
+            using var cts = _networkPolicy.CreateTimeout(RequestClass.Metadata, cancellationToken);
             using var request = new HttpRequestMessage(HttpMethod.Head, url);
-            using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, cancellationToken);
+            using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, cts.Token);
             
             if (!response.IsSuccessStatusCode)
             {
@@ -148,8 +271,9 @@ public class DownloadService : IDownloadService
     {
         try
         {
+            using var cts = _networkPolicy.CreateTimeout(RequestClass.Metadata, cancellationToken);
             using var request = new HttpRequestMessage(HttpMethod.Head, url);
-            using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, cancellationToken);
+            using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead, cts.Token);
             return response.IsSuccessStatusCode;
         }
         catch
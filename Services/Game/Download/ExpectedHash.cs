@@ -0,0 +1,69 @@
+using System.Security.Cryptography;
+
+namespace HyPrism.Services.Game.Download;
+
+/// <summary>
+/// Hash algorithms <see cref="IDownloadService"/> can verify while streaming.
+/// </summary>
+public enum HashKind
+{
+    Sha1,
+    Sha256
+}
+
+/// <summary>
+/// A published checksum a download must match.
+/// </summary>
+/// <param name="Kind">The hash algorithm.</param>
+/// <param name="Value">Hex-encoded digest (case-insensitive).</param>
+public sealed record ExpectedHash(HashKind Kind, string Value)
+{
+    public static ExpectedHash Sha1(string value) => new(HashKind.Sha1, value.Trim());
+
+    public static ExpectedHash Sha256(string value) => new(HashKind.Sha256, value.Trim());
+
+    /// <summary>
+    /// Parses GitHub-style digests such as <c>sha256:ab12…</c>.
+    /// </summary>
+    /// <returns>The hash, or null for empty or unsupported digests.</returns>
+    public static ExpectedHash? FromDigest(string? digest)
+    {
+        if (string.IsNullOrWhiteSpace(digest)) return null;
+
+        var parts = digest.Split(':', 2);
+        if (parts.Length != 2 || string.IsNullOrWhiteSpace(parts[1])) return null;
+
+        return parts[0].Trim().ToLowerInvariant() switch
+        {
+            "sha256" => Sha256(parts[1]),
+            "sha1" => Sha1(parts[1]),
+            _ => null
+        };
+    }
+
+    internal IncrementalHash CreateHasher() => IncrementalHash.CreateHash(
+        Kind == HashKind.Sha1 ? HashAlgorithmName.SHA1 : HashAlgorithmName.SHA256);
+
+    public override string ToString() => $"{Kind.ToString().ToLowerInvariant()}:{Value.ToLowerInvariant()}";
+}
+
+/// <summary>
+/// Thrown when a downloaded file does not match its expected checksum.
+/// The file is deleted before this is thrown.
+/// </summary>
+public class HashMismatchException : Exception
+{
+    public HashKind Kind { get; }
+    public string Expected { get; }
+    public string Actual { get; }
+    public string FilePath { get; }
+
+    public HashMismatchException(string filePath, HashKind kind, string expected, string actual)
+        : base($"{Path.GetFileName(filePath)}: {kind.ToString().ToUpperInvariant()} mismatch (expected {expected.ToLowerInvariant()}, got {actual.ToLowerInvariant()})")
+    {
+        FilePath = filePath;
+        Kind = kind;
+        Expected = expected;
+        Actual = actual;
+    }
+}
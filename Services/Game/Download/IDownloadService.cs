@@ -14,6 +14,29 @@ public interface IDownloadService
     /// <param name="ct">Token to cancel the download.</param>
     Task DownloadFileAsync(string url, string destinationPath, Action<int, long, long> progressCallback, CancellationToken ct = default);
 
+    /// <summary>
+    /// Downloads a file and verifies it against <paramref name="expectedHash"/> while streaming.
+    /// </summary>
+    /// <param name="url">The URL of the file to download.</param>
+    /// <param name="destinationPath">The local path where the file will be saved.</param>
+    /// <param name="progressCallback">Callback for reporting progress (percentage, bytes downloaded, total bytes).</param>
+    /// <param name="expectedHash">Checksum the file must match, or null to skip verification.</param>
+    /// <param name="ct">Token to cancel the download.</param>
+    /// <exception cref="HashMismatchException">The file did not match; it has been deleted.</exception>
+    Task DownloadFileAsync(string url, string destinationPath, Action<int, long, long> progressCallback, ExpectedHash? expectedHash, CancellationToken ct = default);
+
+    /// <summary>
+    /// Downloads a file and verifies it against <paramref name="expectedHash"/> while streaming, reporting
+    /// the smoothed speed and time left along with the progress.
+    /// </summary>
+    /// <param name="url">The URL of the file to download.</param>
+    /// <param name="destinationPath">The local path where the file will be saved.</param>
+    /// <param name="progressCallback">Callback for reporting progress, speed and time left.</param>
+    /// <param name="expectedHash">Checksum the file must match, or null to skip verification.</param>
+    /// <param name="ct">Token to cancel the download.</param>
+    /// <exception cref="HashMismatchException">The file did not match; it has been deleted.</exception>
+    Task DownloadFileAsync(string url, string destinationPath, Action<TransferProgress> progressCallback, ExpectedHash? expectedHash, CancellationToken ct = default);
+
     /// <summary>
     /// Gets the size of a remote file without downloading it.
     /// </summary>
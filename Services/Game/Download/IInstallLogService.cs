@@ -0,0 +1,48 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Download;
+
+/// <summary>
+/// Writes one log file per install, update or repair session to <c>Logs/Installs</c>, so the full
+/// Butler output and download details of a failed patch can be shared.
+/// </summary>
+public interface IInstallLogService
+{
+    /// <summary>
+    /// Starts the log of a session. Until <see cref="End"/>, every launcher log entry and every
+    /// <see cref="Append"/>ed line goes into it.
+    /// </summary>
+    /// <param name="entry">The queued session.</param>
+    void Begin(InstallQueueEntry entry);
+
+    /// <summary>
+    /// Adds raw tool output to the running session's log. Does nothing when no session runs.
+    /// </summary>
+    /// <param name="source">Where the line came from, e.g. <c>butler</c>.</param>
+    /// <param name="line">The output line.</param>
+    void Append(string source, string line);
+
+    /// <summary>
+    /// Records the outcome and closes the log.
+    /// </summary>
+    /// <param name="outcome">"success", "failed" or "cancelled".</param>
+    /// <param name="detail">Error message of a failed session.</param>
+    void End(string outcome, string? detail = null);
+
+    /// <summary>
+    /// Lists the kept logs, newest first.
+    /// </summary>
+    List<InstallLogInfo> GetLogs();
+
+    /// <summary>
+    /// Reads a log.
+    /// </summary>
+    /// <param name="name">File name from <see cref="GetLogs"/>.</param>
+    /// <returns>The log text, or <c>null</c> if there is no such log.</returns>
+    string? ReadLog(string name);
+
+    /// <summary>
+    /// Gets the folder holding the logs.
+    /// </summary>
+    string GetLogsDir();
+}
@@ -0,0 +1,179 @@
+using System.Globalization;
+using System.Runtime.InteropServices;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Download;
+
+/// <summary>
+/// Keeps a log per install session in <c>Logs/Installs</c>, named
+/// <c>{yyyy-MM-dd_HH-mm-ss}_{kind}_{branch}_{version}.log</c>, and keeps the newest <see cref="MaxLogs"/>.
+/// </summary>
+/// <remarks>
+/// Sessions run one at a time (see the install queue), so a single log is open at once. While it is open it
+/// records every launcher log entry, including those kept out of the console, so download URLs, mirror
+/// fallbacks and retries show up next to the Butler output.
+/// </remarks>
+public class InstallLogService : IInstallLogService
+{
+    private const int MaxLogs = 30;
+    private const string ResultPrefix = "Result: ";
+    private const string NameDateFormat = "yyyy-MM-dd_HH-mm-ss";
+
+    private readonly string _logsDir;
+    private readonly object _lock = new();
+    private StreamWriter? _writer;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="InstallLogService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory; logs go to its <c>Logs/Installs</c> folder.</param>
+    public InstallLogService(string appDir)
+    {
+        _logsDir = Path.Combine(appDir, "Logs", "Installs");
+    }
+
+    /// <inheritdoc/>
+    public string GetLogsDir() => _logsDir;
+
+    /// <inheritdoc/>
+    public void Begin(InstallQueueEntry entry)
+    {
+        lock (_lock)
+        {
+            CloseWriter();
+            try
+            {
+                Directory.CreateDirectory(_logsDir);
+                var name = $"{DateTime.Now.ToString(NameDateFormat, CultureInfo.InvariantCulture)}_{entry.Kind}_{entry.Branch}_{entry.Version}.log";
+                var stream = new FileStream(Path.Combine(_logsDir, name), FileMode.Create, FileAccess.Write, FileShare.ReadWrite);
+                _writer = new StreamWriter(stream) { AutoFlush = true };
+                _writer.WriteLine($"HyPrism {entry.Kind} session {entry.Id}");
+                _writer.WriteLine($"Branch: {entry.Branch}, version: {(entry.Version == 0 ? "latest" : entry.Version)}, instance: {entry.InstanceId ?? "-"}");
+                _writer.WriteLine($"OS: {RuntimeInformation.OSDescription} ({RuntimeInformation.OSArchitecture})");
+                _writer.WriteLine();
+                Logger.EntryWritten += OnEntryWritten;
+            }
+            catch (Exception ex)
+            {
+                _writer = null;
+                Logger.Warning("InstallLog", $"Could not create install log: {ex.Message}");
+            }
+        }
+        Prune();
+    }
+
+    /// <inheritdoc/>
+    public void Append(string source, string line) => Write($"[{source}] {line}");
+
+    /// <inheritdoc/>
+    public void End(string outcome, string? detail = null)
+    {
+        lock (_lock)
+        {
+            if (_writer == null) return;
+            try
+            {
+                _writer.WriteLine();
+                if (!string.IsNullOrEmpty(detail)) _writer.WriteLine($"Error: {detail}");
+                _writer.WriteLine($"{ResultPrefix}{outcome}");
+            }
+            catch { /* The log is best effort */ }
+            CloseWriter();
+        }
+    }
+
+    /// <inheritdoc/>
+    public List<InstallLogInfo> GetLogs()
+    {
+        if (!Directory.Exists(_logsDir)) return [];
+
+        var logs = new List<InstallLogInfo>();
+        foreach (var file in new DirectoryInfo(_logsDir).EnumerateFiles("*.log"))
+        {
+            var parts = Path.GetFileNameWithoutExtension(file.Name).Split('_');
+            if (parts.Length != 5
+                || !DateTime.TryParseExact($"{parts[0]}_{parts[1]}", NameDateFormat, CultureInfo.InvariantCulture, DateTimeStyles.None, out var startedAt))
+                continue;
+
+            var last = ReadShared(file.FullName)?.TrimEnd().Split('\n').LastOrDefault()?.Trim() ?? "";
+            logs.Add(new InstallLogInfo
+            {
+                Name = file.Name,
+                Kind = parts[2],
+                Branch = parts[3],
+                Version = int.TryParse(parts[4], out var version) ? version : 0,
+                StartedAt = startedAt,
+                SizeBytes = file.Length,
+                Outcome = last.StartsWith(ResultPrefix) ? last[ResultPrefix.Length..] : ""
+            });
+        }
+        return logs.OrderByDescending(l => l.StartedAt).ToList();
+    }
+
+    /// <inheritdoc/>
+    public string? ReadLog(string name)
+    {
+        if (string.IsNullOrWhiteSpace(name) || Path.GetFileName(name) != name || !name.EndsWith(".log", StringComparison.OrdinalIgnoreCase))
+            return null;
+        return ReadShared(Path.Combine(_logsDir, name));
+    }
+
+    private void OnEntryWritten(string level, string category, string message) => Write($"[{level}] [{category}] {message}");
+
+    private void Write(string text)
+    {
+        lock (_lock)
+        {
+            if (_writer == null) return;
+            try
+            {
+                _writer.WriteLine($"{DateTime.Now:HH:mm:ss.fff} {text}");
+            }
+            catch { /* The log is best effort */ }
+        }
+    }
+
+    private void CloseWriter()
+    {
+        if (_writer == null) return;
+        Logger.EntryWritten -= OnEntryWritten;
+        try { _writer.Dispose(); } catch { /* Ignore */ }
+        _writer = null;
+    }
+
+    /// <summary>
+    /// Reads a log that may still be open for writing.
+    /// </summary>
+    private static string? ReadShared(string path)
+    {
+        try
+        {
+            using var stream = new FileStream(path, FileMode.Open, FileAccess.Read, FileShare.ReadWrite);
+            using var reader = new StreamReader(stream);
+            return reader.ReadToEnd();
+        }
+        catch (Exception)
+        {
+            return null;
+        }
+    }
+
+    private void Prune()
+    {
+        try
+        {
+            var stale = new DirectoryInfo(_logsDir).EnumerateFiles("*.log")
+                .OrderByDescending(f => f.Name, StringComparer.Ordinal)
+                .Skip(MaxLogs);
+            foreach (var file in stale)
+            {
+                try { file.Delete(); } catch { /* In use or already gone */ }
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("InstallLog", $"Could not prune install logs: {ex.Message}");
+        }
+    }
+}
@@ -1,4 +1,5 @@
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Core.App;
 using HyPrism.Services.Game.Butler;
 using HyPrism.Services.Game.Instance;
@@ -63,7 +64,7 @@ public class PatchManager : IPatchManager
         bool officialDown = _versionService.IsOfficialServerDown(branch);
         var normalizedBranch = UtilityService.NormalizeVersionType(branch);
         var os = UtilityService.GetOS();
-        var arch = UtilityService.GetArch();
+        var arch = PlatformSupport.RequireArtifactArch(PlatformSupport.GameClient);
 
         Logger.Info("Download", $"Differential update: v{installedVersion} -> v{latestVersion} (official={!officialDown})");
         _progressService.ReportDownloadProgress("update", 0, $"Updating game from v{installedVersion} to v{latestVersion}...", null, 0, 0);
@@ -160,7 +161,8 @@ public class PatchManager : IPatchManager
     {
         var mirrorUrl = await _versionService.GetMirrorDownloadUrlAsync(os, arch, branch, version, ct);
         if (mirrorUrl == null)
-            throw new Exception($"Mirror does not have release v{version} for {os}/{arch}");
+            throw new LauncherException(ErrorCodes.PatchNotFound, MessageCatalog.ErrorPatchNotFound, [version],
+                $"Mirror does not have release v{version} for {os}/{arch}");
 
         string pwrPath = Path.Combine(_appDir, "Cache", $"{branch}_mirror_full_{version}.pwr");
         Directory.CreateDirectory(Path.GetDirectoryName(pwrPath)!);
@@ -168,11 +170,11 @@ public class PatchManager : IPatchManager
         Logger.Info("Download", $"Downloading full copy from mirror: {mirrorUrl}");
         _progressService.ReportDownloadProgress("update", 5, "launch.detail.downloading_mirror", null, 0, 0);
 
-        await _downloadService.DownloadFileAsync(mirrorUrl, pwrPath, (progress, dl, total) =>
+        await _downloadService.DownloadFileAsync(mirrorUrl, pwrPath, p =>
         {
-            int mappedProgress = 5 + (int)(progress * 0.45);
-            _progressService.ReportDownloadProgress("update", mappedProgress, "launch.detail.downloading_mirror", [progress], dl, total);
-        }, ct);
+            int mappedProgress = 5 + (int)(p.Percent * 0.45);
+            _progressService.ReportDownloadProgress("update", mappedProgress, "launch.detail.downloading_mirror", [p.Percent], p);
+        }, null, ct);
 
         Logger.Success("Download", $"Full copy v{version} downloaded from mirror");
 
@@ -204,18 +206,19 @@ public class PatchManager : IPatchManager
     {
         var mirrorUrl = await _versionService.GetMirrorDiffUrlAsync(os, arch, branch, fromVersion, toVersion, ct);
         if (mirrorUrl == null)
-            throw new Exception($"Mirror does not have diff v{fromVersion}~{toVersion} for {os}/{arch}/{branch}");
+            throw new LauncherException(ErrorCodes.PatchNotFound, MessageCatalog.ErrorPatchNotFound, [toVersion],
+                $"Mirror does not have diff v{fromVersion}~{toVersion} for {os}/{arch}/{branch}");
 
         Logger.Info("Download", $"Downloading diff v{fromVersion}~{toVersion} from mirror: {mirrorUrl}");
         _progressService.ReportDownloadProgress("update", baseProgress,
             $"Downloading patch {patchIndex + 1}/{totalPatches} from mirror (v{fromVersion}→v{toVersion})...", null, 0, 0);
 
-        await _downloadService.DownloadFileAsync(mirrorUrl, destPath, (progress, dl, total) =>
+        await _downloadService.DownloadFileAsync(mirrorUrl, destPath, p =>
         {
-            int mappedProgress = baseProgress + (int)(progress * 0.5 * progressPerPatch / 100);
+            int mappedProgress = baseProgress + (int)(p.Percent * 0.5 * progressPerPatch / 100);
             _progressService.ReportDownloadProgress("update", mappedProgress,
-                $"Downloading patch {patchIndex + 1}/{totalPatches} (mirror)... {progress}%", null, dl, total);
-        }, ct);
+                $"Downloading patch {patchIndex + 1}/{totalPatches} (mirror)... {p.Percent}%", null, p);
+        }, null, ct);
 
         Logger.Success("Download", $"Diff v{fromVersion}~{toVersion} downloaded from mirror");
     }
@@ -233,21 +236,23 @@ public class PatchManager : IPatchManager
         CancellationToken ct)
     {
         bool downloaded = false;
+        bool notFound = false;
 
         // Try official URL first
         try
         {
-            await _downloadService.DownloadFileAsync(officialUrl, destPath, (progress, dl, total) =>
+            await _downloadService.DownloadFileAsync(officialUrl, destPath, p =>
             {
-                int mappedProgress = baseProgress + (int)(progress * 0.5 * progressPerPatch / 100);
+                int mappedProgress = baseProgress + (int)(p.Percent * 0.5 * progressPerPatch / 100);
                 _progressService.ReportDownloadProgress("update", mappedProgress,
-                    $"Downloading patch {patchIndex + 1}/{totalPatches}... {progress}%", null, dl, total);
-            }, ct);
+                    $"Downloading patch {patchIndex + 1}/{totalPatches}... {p.Percent}%", null, p);
+            }, null, ct);
             downloaded = true;
         }
         catch (OperationCanceledException) { throw; }
         catch (Exception ex)
         {
+            notFound = ex is HttpRequestException { StatusCode: System.Net.HttpStatusCode.NotFound };
             Logger.Warning("Download", $"Official patch download failed: {ex.Message}");
             if (File.Exists(destPath)) try { File.Delete(destPath); } catch { }
         }
@@ -270,18 +275,19 @@ public class PatchManager : IPatchManager
                     _progressService.ReportDownloadProgress("update", baseProgress,
                         $"Downloading patch {patchIndex + 1}/{totalPatches} from mirror...", null, 0, 0);
 
-                    await _downloadService.DownloadFileAsync(mirrorUrl, destPath, (progress, dl, total) =>
+                    await _downloadService.DownloadFileAsync(mirrorUrl, destPath, p =>
                     {
-                        int mappedProgress = baseProgress + (int)(progress * 0.5 * progressPerPatch / 100);
+                        int mappedProgress = baseProgress + (int)(p.Percent * 0.5 * progressPerPatch / 100);
                         _progressService.ReportDownloadProgress("update", mappedProgress,
-                            $"Downloading patch {patchIndex + 1}/{totalPatches} (mirror)... {progress}%", null, dl, total);
-                    }, ct);
+                            $"Downloading patch {patchIndex + 1}/{totalPatches} (mirror)... {p.Percent}%", null, p);
+                    }, null, ct);
                     downloaded = true;
                     Logger.Success("Download", $"Patch v{patchVersion} downloaded from mirror");
                 }
                 catch (OperationCanceledException) { throw; }
                 catch (Exception mirrorEx)
                 {
+                    notFound &= mirrorEx is HttpRequestException { StatusCode: System.Net.HttpStatusCode.NotFound };
                     Logger.Error("Download", $"Mirror patch download also failed: {mirrorEx.Message}");
                 }
             }
@@ -291,6 +297,10 @@ public class PatchManager : IPatchManager
             }
         }
 
+        // Missing everywhere (rather than unreachable): the version itself is unavailable
+        if (!downloaded && notFound)
+            throw new LauncherException(ErrorCodes.PatchNotFound, MessageCatalog.ErrorPatchNotFound, [patchVersion],
+                $"Patch v{patchVersion} not found on the official server or the mirror");
         if (!downloaded)
             throw new Exception($"Failed to download patch v{patchVersion} from both official server and mirror");
     }
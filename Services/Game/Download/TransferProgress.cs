@@ -0,0 +1,83 @@
+using System.Diagnostics;
+
+namespace HyPrism.Services.Game.Download;
+
+/// <summary>
+/// Progress of a single download as reported by <see cref="IDownloadService"/>.
+/// </summary>
+/// <param name="Percent">Percentage of the file downloaded (0-100).</param>
+/// <param name="DownloadedBytes">Bytes on disk so far, including a resumed part.</param>
+/// <param name="TotalBytes">Size of the file, or -1 if unknown.</param>
+/// <param name="BytesPerSecond">Smoothed transfer speed, 0 until the first measurement.</param>
+/// <param name="EtaSeconds">Estimated seconds left, or null while the speed or size is unknown.</param>
+public readonly record struct TransferProgress(int Percent, long DownloadedBytes, long TotalBytes, double BytesPerSecond, double? EtaSeconds);
+
+/// <summary>
+/// Measures the speed of a transfer as an exponential moving average, so a single slow or fast read
+/// does not make the shown speed and time left jump.
+/// </summary>
+/// <remarks>
+/// Samples closer together than <see cref="MinInterval"/> only add to the next measurement. A byte count
+/// lower than the previous one (a restarted transfer) starts measuring from scratch.
+/// </remarks>
+public sealed class TransferRate
+{
+    private static readonly TimeSpan MinInterval = TimeSpan.FromMilliseconds(250);
+
+    /// <summary>
+    /// Weight of the newest measurement in the average.
+    /// </summary>
+    private const double Smoothing = 0.3;
+
+    private readonly Stopwatch _clock = Stopwatch.StartNew();
+    private TimeSpan _sampleTime;
+    private long _sampleBytes = -1;
+
+    /// <summary>
+    /// The smoothed speed in bytes per second, 0 until the first measurement.
+    /// </summary>
+    public double BytesPerSecond { get; private set; }
+
+    /// <summary>
+    /// Seconds left at the smoothed speed, or null while the speed or size is unknown.
+    /// </summary>
+    public double? EtaSeconds { get; private set; }
+
+    /// <summary>
+    /// Records the bytes transferred so far and updates the speed and time left.
+    /// </summary>
+    /// <param name="downloaded">Bytes transferred so far.</param>
+    /// <param name="total">Bytes to transfer, or 0 or less if unknown.</param>
+    public void Sample(long downloaded, long total)
+    {
+        var now = _clock.Elapsed;
+        if (_sampleBytes < 0 || downloaded < _sampleBytes)
+        {
+            Reset(downloaded, now);
+            return;
+        }
+
+        var elapsed = now - _sampleTime;
+        if (elapsed < MinInterval) return;
+
+        double current = (downloaded - _sampleBytes) / elapsed.TotalSeconds;
+        BytesPerSecond = BytesPerSecond <= 0 ? current : BytesPerSecond + Smoothing * (current - BytesPerSecond);
+        _sampleBytes = downloaded;
+        _sampleTime = now;
+
+        EtaSeconds = total > downloaded && BytesPerSecond > 0 ? (total - downloaded) / BytesPerSecond : null;
+    }
+
+    /// <summary>
+    /// Forgets the measured speed, e.g. when a new stage starts.
+    /// </summary>
+    public void Reset() => Reset(-1, _clock.Elapsed);
+
+    private void Reset(long bytes, TimeSpan now)
+    {
+        _sampleBytes = bytes;
+        _sampleTime = now;
+        BytesPerSecond = 0;
+        EtaSeconds = null;
+    }
+}
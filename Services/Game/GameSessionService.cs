@@ -1,12 +1,15 @@
 using System.Runtime.InteropServices;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Core.App;
 using HyPrism.Services.Game.Butler;
 using HyPrism.Services.Game.Download;
 using HyPrism.Services.Game.Instance;
 using HyPrism.Services.Game.Launch;
 using HyPrism.Services.Game.Version;
+using HyPrism.Services.User;
 
 namespace HyPrism.Services.Game;
 
@@ -29,6 +32,11 @@ public class GameSessionService : IGameSessionService
     private readonly IProgressNotificationService _progressService;
     private readonly IPatchManager _patchManager;
     private readonly IGameLauncher _gameLauncher;
+    private readonly ITaskManagerService _taskManager;
+    private readonly IConnectivityService _connectivityService;
+    private readonly IInstallLogService _installLogs;
+    private readonly IPreReleaseService _preRelease;
+    private readonly IEndpointService _endpoints;
     private readonly HttpClient _httpClient;
     private readonly string _appDir;
     
@@ -36,6 +44,22 @@ public class GameSessionService : IGameSessionService
     private CancellationTokenSource? _downloadCts;
     private readonly object _ctsLock = new();
 
+    private readonly object _queueLock = new();
+    private InstallQueueEntry? _runningJob;
+    private readonly List<QueuedInstall> _waitingJobs = new();
+
+    /// <inheritdoc/>
+    public event Action<List<InstallQueueEntry>>? InstallQueueChanged;
+
+    /// <summary>
+    /// A session waiting for its turn; <see cref="Turn"/> completes with <c>false</c> if it was cancelled.
+    /// </summary>
+    private sealed class QueuedInstall
+    {
+        public required InstallQueueEntry Entry { get; init; }
+        public TaskCompletionSource<bool> Turn { get; } = new(TaskCreationOptions.RunContinuationsAsynchronously);
+    }
+
     /// <summary>
     /// Initializes a new instance of the <see cref="GameSessionService"/> class.
     /// </summary>
@@ -48,6 +72,11 @@ public class GameSessionService : IGameSessionService
     /// <param name="progressService">Service for progress notifications.</param>
     /// <param name="patchManager">Manager for differential updates.</param>
     /// <param name="gameLauncher">Launcher for the game process.</param>
+    /// <param name="taskManager">Registry the session is tracked in as a background task.</param>
+    /// <param name="connectivityService">Prober used to explain network failures.</param>
+    /// <param name="installLogs">Writes the per-session install log.</param>
+    /// <param name="preRelease">Holds back pre-release sessions until the warning is acknowledged.</param>
+    /// <param name="endpoints">Tells official patch downloads from mirror downloads.</param>
     /// <param name="httpClient">HTTP client for network requests.</param>
     /// <param name="appPath">Application path configuration.</param>
     public GameSessionService(
@@ -60,6 +89,11 @@ public class GameSessionService : IGameSessionService
         IProgressNotificationService progressService,
         IPatchManager patchManager,
         IGameLauncher gameLauncher,
+        ITaskManagerService taskManager,
+        IConnectivityService connectivityService,
+        IInstallLogService installLogs,
+        IPreReleaseService preRelease,
+        IEndpointService endpoints,
         HttpClient httpClient,
         AppPathConfiguration appPath)
     {
@@ -72,6 +106,11 @@ public class GameSessionService : IGameSessionService
         _progressService = progressService;
         _patchManager = patchManager;
         _gameLauncher = gameLauncher;
+        _taskManager = taskManager;
+        _connectivityService = connectivityService;
+        _installLogs = installLogs;
+        _preRelease = preRelease;
+        _endpoints = endpoints;
         _httpClient = httpClient;
         _appDir = appPath.AppDir;
     }
@@ -80,6 +119,159 @@ public class GameSessionService : IGameSessionService
 
     /// <inheritdoc/>
     public async Task<DownloadProgress> DownloadAndLaunchAsync(Func<bool>? launchAfterDownloadProvider = null)
+    {
+        var selectedId = _config.SelectedInstanceId;
+        if (!string.IsNullOrEmpty(selectedId) && _instanceService.FindInstanceById(selectedId) != null)
+            return await LaunchInstanceAsync(selectedId, launchAfterDownloadProvider);
+
+        // Capture the target now: the config may point at another branch by the time a queued job runs
+        #pragma warning disable CS0618 // Backward compatibility: VersionType and SelectedVersion kept for migration
+        var entry = new InstallQueueEntry
+        {
+            Id = Guid.NewGuid().ToString("N"),
+            Branch = UtilityService.NormalizeVersionType(_config.VersionType),
+            Version = _config.SelectedVersion,
+            QueuedAt = DateTime.UtcNow
+        };
+        #pragma warning restore CS0618
+
+        return await StartSessionAsync(entry, null, launchAfterDownloadProvider);
+    }
+
+    /// <inheritdoc/>
+    public async Task<DownloadProgress> LaunchInstanceAsync(string instanceId, Func<bool>? launchAfterDownloadProvider = null)
+    {
+        var info = _instanceService.FindInstanceById(instanceId);
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (info == null || string.IsNullOrEmpty(instancePath))
+            return new DownloadProgress { Error = "Instance not found" };
+
+        // A latest instance follows the branch; any other stays on the version it was created for
+        bool isLatest = _instanceService.GetInstanceMeta(instancePath)?.IsLatest == true;
+        var entry = new InstallQueueEntry
+        {
+            Id = Guid.NewGuid().ToString("N"),
+            InstanceId = instanceId,
+            Branch = UtilityService.NormalizeVersionType(info.Branch),
+            Version = isLatest ? 0 : info.Version,
+            QueuedAt = DateTime.UtcNow
+        };
+
+        // Keep the legacy selection in step so anything still reading it sees what actually runs
+        #pragma warning disable CS0618 // Backward compatibility: VersionType and SelectedVersion kept for migration
+        _config.VersionType = entry.Branch;
+        _config.SelectedVersion = entry.Version;
+        #pragma warning restore CS0618
+        _instanceService.SetSelectedInstance(instanceId);
+
+        return await StartSessionAsync(entry, instancePath, launchAfterDownloadProvider);
+    }
+
+    /// <summary>
+    /// Checks that the session may start, then queues it.
+    /// </summary>
+    /// <param name="instancePath">The instance folder to use, or null to resolve it from the branch and version.</param>
+    private async Task<DownloadProgress> StartSessionAsync(InstallQueueEntry entry, string? instancePath, Func<bool>? launchAfterDownloadProvider)
+    {
+        // The nickname may predate the current rules or come from a hand-edited config
+        var nickname = NicknameValidator.Validate(_config.Nick, _config.NicknameRules);
+        if (!nickname.Valid)
+        {
+            Logger.Warning("Game", $"Launch refused, nickname '{_config.Nick}' is invalid: {nickname.Message}");
+            _progressService.ReportLocalizedError("validation", nickname.MessageKey!, nickname.Args);
+            return new DownloadProgress { Error = nickname.Message };
+        }
+
+        // Pre-release saves may not load in release; the frontend shows the warning and launches again once confirmed
+        if (!_preRelease.CheckBeforeLaunch(entry.Branch))
+            return new DownloadProgress { Error = "Pre-release notice not acknowledged" };
+
+        return await RunQueuedAsync(entry, "Install and launch game", (cts, waited) =>
+            // A queued job only installs; launching would collide with the session that ran before it
+            RunSessionAsync(cts, entry.Branch, entry.Version, waited ? () => false : launchAfterDownloadProvider, instancePath));
+    }
+
+    /// <inheritdoc/>
+    public async Task<DownloadProgress> RepairInstanceAsync(string instanceId)
+    {
+        var info = _instanceService.FindInstanceById(instanceId);
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (info == null || string.IsNullOrEmpty(instancePath))
+            return new DownloadProgress { Error = "Instance not found" };
+
+        var entry = new InstallQueueEntry
+        {
+            Id = Guid.NewGuid().ToString("N"),
+            Kind = "repair",
+            InstanceId = instanceId,
+            Branch = UtilityService.NormalizeVersionType(info.Branch),
+            Version = info.Version,
+            QueuedAt = DateTime.UtcNow
+        };
+
+        return await RunQueuedAsync(entry, "Repair game files", (cts, _) =>
+            RunRepairAsync(instancePath, entry.Branch, info.Version, cts.Token));
+    }
+
+    /// <summary>
+    /// Waits for <paramref name="entry"/>'s turn in the install queue, runs it, then hands over to the next entry.
+    /// </summary>
+    /// <param name="work">The session; the flag tells whether it had to wait behind another one.</param>
+    private async Task<DownloadProgress> RunQueuedAsync(
+        InstallQueueEntry entry, string title, Func<CancellationTokenSource, bool, Task<DownloadProgress>> work)
+    {
+        var job = new QueuedInstall { Entry = entry };
+        bool mustWait;
+        lock (_queueLock)
+        {
+            mustWait = _runningJob != null;
+            if (mustWait) _waitingJobs.Add(job);
+            else _runningJob = entry;
+        }
+        PublishInstallQueue();
+
+        if (mustWait)
+        {
+            Logger.Info("Download", $"Session for {entry.Branch} queued behind the running one");
+            if (!await job.Turn.Task)
+                return new DownloadProgress { Cancelled = true };
+        }
+
+        _installLogs.Begin(entry);
+        DownloadProgress? result = null;
+        string? failure = null;
+        try
+        {
+            result = await RunJobAsync(title, cts => work(cts, mustWait));
+            return result;
+        }
+        catch (Exception ex)
+        {
+            failure = ex.Message;
+            throw;
+        }
+        finally
+        {
+            if (result?.Cancelled == true || result?.Error == "Cancelled") _installLogs.End("cancelled");
+            else if (result == null || !string.IsNullOrEmpty(result.Error)) _installLogs.End("failed", result?.Error ?? failure);
+            else _installLogs.End("success");
+
+            QueuedInstall? next = null;
+            lock (_queueLock)
+            {
+                if (_waitingJobs.Count > 0)
+                {
+                    next = _waitingJobs[0];
+                    _waitingJobs.RemoveAt(0);
+                }
+                _runningJob = next?.Entry;
+            }
+            PublishInstallQueue();
+            next?.Turn.TrySetResult(true);
+        }
+    }
+
+    private async Task<DownloadProgress> RunJobAsync(string title, Func<CancellationTokenSource, Task<DownloadProgress>> work)
     {
         CancellationTokenSource cts;
         lock (_ctsLock)
@@ -93,27 +285,159 @@ public class GameSessionService : IGameSessionService
             _downloadCts = cts;
         }
 
+        using var task = _taskManager.Begin("game-session", title, CancelDownload);
+        using var operation = _progressService.BeginOperation("game", task.Id);
+        void OnProgress(ProgressUpdateMessage msg)
+        {
+            task.Report(msg.Progress, msg.MessageKey);
+            operation.Report(msg.State, msg.Progress,
+                new TransferProgress((int)msg.Progress, msg.DownloadedBytes, msg.TotalBytes, msg.BytesPerSecond, msg.EtaSeconds),
+                msg.MessageKey, msg.Args);
+        }
+        _progressService.DownloadProgressChanged += OnProgress;
+
+        try
+        {
+            var result = await work(cts);
+            if (result.Cancelled || result.Error == "Cancelled")
+            {
+                task.MarkCancelled();
+                operation.MarkCancelled();
+            }
+            else if (!string.IsNullOrEmpty(result.Error))
+            {
+                task.Fail(result.Error);
+                operation.Fail();
+            }
+            else
+            {
+                task.Complete();
+                operation.Complete();
+            }
+            return result;
+        }
+        finally
+        {
+            _progressService.DownloadProgressChanged -= OnProgress;
+            lock (_ctsLock)
+            {
+                _downloadCts = null;
+                _cancelRequested = false;
+            }
+            cts.Dispose();
+        }
+    }
+
+    /// <inheritdoc/>
+    public List<InstallQueueEntry> GetInstallQueue()
+    {
+        lock (_queueLock)
+        {
+            var queue = new List<InstallQueueEntry>();
+            if (_runningJob != null)
+            {
+                _runningJob.State = "running";
+                _runningJob.Position = 0;
+                queue.Add(_runningJob);
+            }
+            for (int i = 0; i < _waitingJobs.Count; i++)
+            {
+                var entry = _waitingJobs[i].Entry;
+                entry.State = "queued";
+                entry.Position = i + 1;
+                queue.Add(entry);
+            }
+            return queue.Select(e => new InstallQueueEntry
+            {
+                Id = e.Id,
+                Kind = e.Kind,
+                InstanceId = e.InstanceId,
+                Branch = e.Branch,
+                Version = e.Version,
+                State = e.State,
+                Position = e.Position,
+                QueuedAt = e.QueuedAt
+            }).ToList();
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool CancelQueuedInstall(string id)
+    {
+        QueuedInstall? removed = null;
+        lock (_queueLock)
+        {
+            if (_runningJob?.Id == id)
+            {
+                return CancelInstall();
+            }
+
+            var index = _waitingJobs.FindIndex(j => j.Entry.Id == id);
+            if (index < 0) return false;
+            removed = _waitingJobs[index];
+            _waitingJobs.RemoveAt(index);
+        }
+
+        Logger.Info("Download", $"Removed queued session for {removed.Entry.Branch}");
+        removed.Turn.TrySetResult(false);
+        PublishInstallQueue();
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public bool MoveQueuedInstall(string id, int position)
+    {
+        lock (_queueLock)
+        {
+            var index = _waitingJobs.FindIndex(j => j.Entry.Id == id);
+            if (index < 0) return false;
+
+            var job = _waitingJobs[index];
+            _waitingJobs.RemoveAt(index);
+            _waitingJobs.Insert(Math.Clamp(position - 1, 0, _waitingJobs.Count), job);
+        }
+
+        PublishInstallQueue();
+        return true;
+    }
+
+    private void PublishInstallQueue()
+    {
+        InstallQueueChanged?.Invoke(GetInstallQueue());
+    }
+
+    private async Task<DownloadProgress> RunSessionAsync(
+        CancellationTokenSource cts, string branch, int selectedVersion, Func<bool>? launchAfterDownloadProvider, string? instancePath = null)
+    {
+        string? freshInstallPath = null;
+        HashSet<string>? preexistingEntries = null;
+
         try
         {
             _progressService.ReportDownloadProgress("preparing", 0, "launch.detail.preparing_session", null, 0, 0);
 
-            #pragma warning disable CS0618 // Backward compatibility: VersionType and SelectedVersion kept for migration
-            string branch = UtilityService.NormalizeVersionType(_config.VersionType);
             _progressService.ReportDownloadProgress("preparing", 1, "launch.detail.checking_versions", null, 0, 0);
             var versions = await _versionService.GetVersionListAsync(branch, cts.Token);
             cts.Token.ThrowIfCancellationRequested();
 
             if (versions.Count == 0)
+            {
+                _progressService.ReportLocalizedError("download", MessageCatalog.ErrorNoVersions, [branch]);
                 return new DownloadProgress { Error = "No versions available for this branch" };
+            }
 
-            bool isLatestInstance = _config.SelectedVersion == 0;
-            int targetVersion = _config.SelectedVersion > 0 ? _config.SelectedVersion : versions[0];
-            #pragma warning restore CS0618
+            bool isLatestInstance = selectedVersion == 0;
+            int targetVersion = selectedVersion > 0 ? selectedVersion : versions[0];
             if (!versions.Contains(targetVersion))
                 targetVersion = versions[0];
 
-            string versionPath = _instanceService.ResolveInstancePath(branch, isLatestInstance ? 0 : targetVersion, preferExisting: true);
+            string versionPath = instancePath
+                ?? _instanceService.ResolveInstancePath(branch, isLatestInstance ? 0 : targetVersion, preferExisting: true);
             Directory.CreateDirectory(versionPath);
+            if (branch == "pre-release") _preRelease.EnsureIsolated(versionPath);
+
+            // Released right before the launch, which locks the instance for the game instead
+            using var installLock = InstanceLock.Acquire(versionPath, InstanceLock.Installing, "installing the game");
 
             bool gameIsInstalled = _instanceService.IsClientPresent(versionPath);
 
@@ -125,32 +449,180 @@ public class GameSessionService : IGameSessionService
 
             if (gameIsInstalled)
             {
-                return await HandleInstalledGameAsync(versionPath, branch, isLatestInstance, versions, cts.Token);
+                return await HandleInstalledGameAsync(versionPath, branch, isLatestInstance, versions, installLock, cts.Token);
             }
 
-            return await HandleFreshInstallAsync(versionPath, branch, isLatestInstance, targetVersion, launchAfterDownloadProvider, cts.Token);
+            // Remember what was there before so a cancelled install only removes what it created
+            freshInstallPath = versionPath;
+            preexistingEntries = Directory.EnumerateFileSystemEntries(versionPath)
+                .Select(Path.GetFileName)
+                .OfType<string>()
+                .ToHashSet(StringComparer.OrdinalIgnoreCase);
+
+            if (instancePath != null && !isLatestInstance && targetVersion != selectedVersion)
+                RecordInstanceVersion(instancePath, branch, selectedVersion, targetVersion);
+
+            return await HandleFreshInstallAsync(versionPath, branch, isLatestInstance, targetVersion, launchAfterDownloadProvider, installLock, cts.Token);
+        }
+        catch (InstanceLockedException ex)
+        {
+            return ReportInstanceLocked(ex);
         }
         catch (OperationCanceledException)
         {
             Logger.Warning("Download", "Operation cancelled");
+            CleanupCancelledInstall(branch, freshInstallPath, preexistingEntries);
             return new DownloadProgress { Error = "Cancelled" };
         }
         catch (Exception ex)
         {
             Logger.Error("Download", $"Fatal error: {ex.Message}");
             Logger.Error("Download", ex.ToString());
-            _progressService.ReportError("fatal", "Fatal error", ex.ToString());
+            if (!await ReportNetworkFailureAsync(ex))
+                _progressService.ReportFailure("fatal", MessageCatalog.ErrorFatal, ex);
             return new DownloadProgress { Error = $"Fatal error: {ex.Message}" };
         }
-        finally
+    }
+
+    /// <summary>
+    /// Records the version actually installed into an instance whose own version is no longer offered,
+    /// so the instance and the config describe what runs.
+    /// </summary>
+    private void RecordInstanceVersion(string instancePath, string branch, int requestedVersion, int installedVersion)
+    {
+        Logger.Warning("Download", $"Version {requestedVersion} of {branch} is not available, installing {installedVersion} instead");
+        var meta = _instanceService.GetInstanceMeta(instancePath);
+        if (meta != null)
         {
-            lock (_ctsLock)
+            meta.Version = installedVersion;
+            _instanceService.SaveInstanceMeta(instancePath, meta);
+        }
+
+        #pragma warning disable CS0618 // Backward compatibility: SelectedVersion kept for migration
+        _config.SelectedVersion = installedVersion;
+        #pragma warning restore CS0618
+        _configService.SaveConfig();
+    }
+
+    /// <summary>
+    /// Verifies an instance's game files and reinstalls its version over them only when something is broken.
+    /// </summary>
+    private async Task<DownloadProgress> RunRepairAsync(string instancePath, string branch, int instanceVersion, CancellationToken ct)
+    {
+        try
+        {
+            using var repairLock = InstanceLock.Acquire(instancePath, InstanceLock.Installing, "repairing the game");
+            _progressService.ReportDownloadProgress("verify", 0, "launch.detail.verifying_files", null, 0, 0);
+            var report = await Task.Run(() => GameFilesIntegrity.Verify(instancePath, deep: true, ct), ct);
+
+            if (report.IsIntact)
             {
-                _downloadCts = null;
-                _cancelRequested = false;
+                Logger.Success("Integrity", $"All {report.CheckedFiles} game files intact in {instancePath}");
+                _progressService.ReportDownloadProgress("complete", 100, "launch.detail.files_intact", null, 0, 0);
+                return new DownloadProgress { Success = true, Progress = 100 };
             }
-            cts.Dispose();
+
+            if (report.HasManifest)
+            {
+                Logger.Warning("Integrity", $"{report.Missing.Count} missing and {report.Modified.Count} modified game file(s) in {instancePath}");
+                GameFilesIntegrity.DeleteBrokenFiles(instancePath, report);
+            }
+            else
+            {
+                Logger.Info("Integrity", $"No game manifest in {instancePath}, reinstalling the whole package");
+            }
+
+            bool isLatestInstance = instanceVersion == 0;
+            int version = report.Version > 0 ? report.Version
+                : instanceVersion > 0 ? instanceVersion
+                : _instanceService.LoadLatestInfo(branch)?.Version ?? 0;
+            if (version <= 0)
+                return new DownloadProgress { Error = "Installed game version is unknown" };
+
+            return await HandleFreshInstallAsync(instancePath, branch, isLatestInstance, version, () => false, repairLock, ct);
+        }
+        catch (InstanceLockedException ex)
+        {
+            return ReportInstanceLocked(ex);
         }
+        catch (OperationCanceledException)
+        {
+            Logger.Warning("Integrity", "Repair cancelled");
+            CleanupCancelledInstall(branch, null, null);
+            return new DownloadProgress { Error = "Cancelled" };
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Integrity", $"Repair failed: {ex.Message}");
+            if (!await ReportNetworkFailureAsync(ex))
+                _progressService.ReportFailure("fatal", MessageCatalog.ErrorFatal, ex);
+            return new DownloadProgress { Error = $"Repair failed: {ex.Message}" };
+        }
+    }
+
+    private DownloadProgress ReportInstanceLocked(InstanceLockedException ex)
+    {
+        Logger.Warning("Download", $"Instance {ex.InstancePath} is locked: {ex.Holder.Operation} (process {ex.Holder.ProcessId})");
+        _progressService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+        return new DownloadProgress { Error = ex.Message };
+    }
+
+    /// <summary>
+    /// Reports a connectivity problem (offline, captive portal, blocked host) instead of the raw
+    /// HTTP failure when one explains <paramref name="ex"/>.
+    /// </summary>
+    /// <returns><c>true</c> if an error was reported.</returns>
+    private async Task<bool> ReportNetworkFailureAsync(Exception ex)
+    {
+        var status = await _connectivityService.DescribeFailureAsync(ex);
+        if (status?.MessageKey == null) return false;
+
+        _progressService.ReportLocalizedError("network", status.MessageKey,
+            [string.Join(", ", status.BlockedHosts)], ex.ToString());
+        return true;
+    }
+
+    /// <summary>
+    /// Removes what a cancelled session left behind: partial and patch downloads of the branch,
+    /// and, for a fresh install, every entry the install added to the instance folder.
+    /// UserData and files that existed before the install are kept.
+    /// </summary>
+    private void CleanupCancelledInstall(string branch, string? freshInstallPath, HashSet<string>? preexistingEntries)
+    {
+        var cacheDir = Path.Combine(_appDir, "Cache");
+        if (Directory.Exists(cacheDir))
+        {
+            foreach (var pattern in new[] { $"{branch}_*.part", $"{branch}_patch_*.pwr" })
+            {
+                foreach (var file in Directory.GetFiles(cacheDir, pattern))
+                    try { File.Delete(file); } catch { }
+            }
+        }
+
+        if (freshInstallPath == null || preexistingEntries == null || !Directory.Exists(freshInstallPath)) return;
+        if (_instanceService.IsClientPresent(freshInstallPath)) return;
+
+        int removed = 0;
+        foreach (var entry in Directory.EnumerateFileSystemEntries(freshInstallPath).ToList())
+        {
+            var name = Path.GetFileName(entry);
+            if (preexistingEntries.Contains(name) || name.Equals("UserData", StringComparison.OrdinalIgnoreCase))
+                continue;
+
+            try
+            {
+                if (Directory.Exists(entry)) Directory.Delete(entry, true);
+                else File.Delete(entry);
+                removed++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Download", $"Could not remove {name} after cancelled install: {ex.Message}");
+            }
+        }
+
+        if (removed > 0)
+            Logger.Info("Download", $"Removed {removed} partial item(s) from cancelled install at {freshInstallPath}");
     }
 
     public void CancelDownload()
@@ -162,6 +634,17 @@ public class GameSessionService : IGameSessionService
         }
     }
 
+    /// <inheritdoc/>
+    public bool CancelInstall()
+    {
+        lock (_ctsLock)
+        {
+            if (_downloadCts == null) return false;
+            _downloadCts.Cancel();
+            return true;
+        }
+    }
+
     public void Dispose()
     {
         lock (_ctsLock)
@@ -174,7 +657,7 @@ public class GameSessionService : IGameSessionService
 
     private async Task<DownloadProgress> HandleInstalledGameAsync(
         string versionPath, string branch, bool isLatestInstance,
-        List<int> versions, CancellationToken ct)
+        List<int> versions, InstanceLock installLock, CancellationToken ct)
     {
         Logger.Success("Download", "Game is already installed");
 
@@ -189,13 +672,15 @@ public class GameSessionService : IGameSessionService
         _progressService.ReportDownloadProgress("complete", 100, "launch.detail.launching_game", null, 0, 0);
         try
         {
+            installLock.Dispose();
             await _gameLauncher.LaunchGameAsync(versionPath, branch, ct);
             return new DownloadProgress { Success = true, Progress = 100 };
         }
+        catch (InstanceLockedException) { throw; }
         catch (Exception ex)
         {
             Logger.Error("Game", $"Launch failed: {ex.Message}");
-            _progressService.ReportError("launch", "Failed to launch game", ex.ToString());
+            _progressService.ReportFailure("launch", MessageCatalog.ErrorLaunchFailed, ex);
             return new DownloadProgress { Error = $"Failed to launch game: {ex.Message}" };
         }
     }
@@ -220,6 +705,7 @@ public class GameSessionService : IGameSessionService
             try
             {
                 await _patchManager.ApplyDifferentialUpdateAsync(versionPath, branch, installedVersion, latestVersion, ct);
+                RecordGameFiles(versionPath, latestVersion);
             }
             catch (OperationCanceledException) { throw; }
             catch (Exception ex)
@@ -235,6 +721,15 @@ public class GameSessionService : IGameSessionService
         }
     }
 
+    /// <summary>
+    /// Writes the instance's game file manifest after Butler has applied a package.
+    /// </summary>
+    private void RecordGameFiles(string versionPath, int version)
+    {
+        _progressService.ReportDownloadProgress("install", 90, "launch.detail.recording_files", null, 0, 0);
+        GameFilesIntegrity.WriteManifest(versionPath, version);
+    }
+
     private int DetectInstalledVersion(string versionPath, string branch)
     {
         var receiptPath = Path.Combine(versionPath, ".itch", "receipt.json.gz");
@@ -274,31 +769,40 @@ public class GameSessionService : IGameSessionService
 
     private async Task<DownloadProgress> HandleFreshInstallAsync(
         string versionPath, string branch, bool isLatestInstance,
-        int targetVersion, Func<bool>? launchAfterDownloadProvider, CancellationToken ct)
+        int targetVersion, Func<bool>? launchAfterDownloadProvider, InstanceLock installLock, CancellationToken ct)
     {
         Logger.Info("Download", "Game not installed, starting download...");
         _progressService.ReportDownloadProgress("download", 1, "launch.detail.preparing_download", null, 0, 0);
 
-        try
-        {
-            _progressService.ReportDownloadProgress("download", 2, "launch.detail.installing_butler", null, 0, 0);
-            await _butlerService.EnsureButlerInstalledAsync((progress, message) =>
-            {
-                int mappedProgress = 2 + (int)(progress * 0.03);
-                _progressService.ReportDownloadProgress("download", mappedProgress, message, null, 0, 0);
-            });
-        }
-        catch (Exception ex)
+        // Butler, the JRE and the game package share MaxConcurrentDownloads slots. With one slot the order
+        // stays Butler, package, JRE; with more, Butler and the JRE download during the version lookup
+        int concurrency = Math.Max(1, _config.MaxConcurrentDownloads);
+        var downloadSlots = new SemaphoreSlim(concurrency);
+        bool packageStarted = false;
+
+        _progressService.ReportDownloadProgress("download", 2, "launch.detail.installing_butler", null, 0, 0);
+        var butlerTask = InstallButlerInSlotAsync(downloadSlots, () => !packageStarted, ct);
+        var jreTask = concurrency > 1 ? PrefetchJreAsync(downloadSlots, ct) : Task.CompletedTask;
+
+        async Task<DownloadProgress?> AwaitButlerAsync()
         {
-            Logger.Error("Download", $"Butler install failed: {ex.Message}");
-            return new DownloadProgress { Error = $"Failed to install Butler: {ex.Message}" };
+            var error = await butlerTask;
+            ct.ThrowIfCancellationRequested();
+            if (error == null) return null;
+
+            Logger.Error("Download", $"Butler install failed: {error.Message}");
+            await ReportNetworkFailureAsync(error);
+            return new DownloadProgress { Error = $"Failed to install Butler: {error.Message}" };
         }
 
+        if (concurrency == 1 && await AwaitButlerAsync() is { } butlerFailure)
+            return butlerFailure;
+
         ct.ThrowIfCancellationRequested();
 
         bool officialDown = _versionService.IsOfficialServerDown(branch);
         string osName = UtilityService.GetOS();
-        string arch = UtilityService.GetArch();
+        string arch = PlatformSupport.RequireArtifactArch(PlatformSupport.GameClient);
         string apiVersionType = UtilityService.NormalizeVersionType(branch);
 
         // Mirror + pre-release: diff-based branch requires applying the entire patch chain
@@ -306,6 +810,8 @@ public class GameSessionService : IGameSessionService
         if (officialDown && _versionService.IsDiffBasedBranch(apiVersionType))
         {
             Logger.Info("Download", $"Mirror pre-release: installing via diff chain v0 -> v{targetVersion}");
+            if (await AwaitButlerAsync() is { } butlerError) return butlerError;
+            packageStarted = true;
             _progressService.ReportDownloadProgress("download", 5, "launch.detail.downloading_mirror", null, 0, 0);
 
             try
@@ -316,6 +822,7 @@ public class GameSessionService : IGameSessionService
             catch (Exception ex)
             {
                 Logger.Error("Download", $"Mirror diff chain install failed: {ex.Message}");
+                await ReportNetworkFailureAsync(ex);
                 return new DownloadProgress { Error = $"Failed to install game from mirror: {ex.Message}" };
             }
         }
@@ -333,11 +840,12 @@ public class GameSessionService : IGameSessionService
             catch (Exception ex)
             {
                 Logger.Error("Download", $"Failed to get download URL: {ex.Message}");
+                await ReportNetworkFailureAsync(ex);
                 return new DownloadProgress { Error = $"Failed to get download URL for v{targetVersion}: {ex.Message}" };
             }
             
             bool hasOfficialUrl = !string.IsNullOrEmpty(versionEntry.PwrUrl) 
-                && versionEntry.PwrUrl.Contains("game-patches.hytale.com") 
+                && versionEntry.PwrUrl.Contains(_endpoints.Current.OfficialPatchHost, StringComparison.OrdinalIgnoreCase) 
                 && versionEntry.PwrUrl.Contains("verify=");
             
             string pwrPath = Path.Combine(_appDir, "Cache", $"{branch}_{(isLatestInstance ? "latest" : "version")}_{targetVersion}.pwr");
@@ -349,12 +857,22 @@ public class GameSessionService : IGameSessionService
 
             try
             {
-                await DownloadPwrWithCachingAsync(downloadUrl, pwrPath, osName, arch, apiVersionType, targetVersion, skipOfficial, hasOfficialUrl, ct);
+                await downloadSlots.WaitAsync(ct);
+                packageStarted = true;
+                try
+                {
+                    await DownloadPwrWithCachingAsync(downloadUrl, pwrPath, osName, arch, apiVersionType, targetVersion, skipOfficial, hasOfficialUrl, ct);
+                }
+                finally
+                {
+                    downloadSlots.Release();
+                }
             }
             catch (MirrorDiffRequiredException)
             {
                 // Pre-release official download failed, mirror requires diff-based approach
                 Logger.Info("Download", $"Switching to mirror diff chain for pre-release v{targetVersion}");
+                if (await AwaitButlerAsync() is { } diffButlerError) return diffButlerError;
                 _progressService.ReportDownloadProgress("download", 5, "launch.detail.downloading_mirror", null, 0, 0);
                 
                 try
@@ -364,8 +882,10 @@ public class GameSessionService : IGameSessionService
                     if (isLatestInstance)
                         _instanceService.SaveLatestInfo(branch, targetVersion);
 
+                    RecordGameFiles(versionPath, targetVersion);
                     _progressService.ReportDownloadProgress("complete", 95, "launch.detail.download_complete", null, 0, 0);
 
+                    await jreTask;
                     await EnsureRuntimeDependenciesAsync(ct);
                     ct.ThrowIfCancellationRequested();
 
@@ -377,18 +897,22 @@ public class GameSessionService : IGameSessionService
                     }
 
                     _progressService.ReportDownloadProgress("complete", 100, "launch.detail.launching_game", null, 0, 0);
+                    installLock.Dispose();
                     await _gameLauncher.LaunchGameAsync(versionPath, branch, ct);
                     return new DownloadProgress { Success = true, Progress = 100 };
                 }
                 catch (OperationCanceledException) { throw; }
+                catch (InstanceLockedException) { throw; }
                 catch (Exception ex)
                 {
                     Logger.Error("Download", $"Mirror diff chain install failed: {ex.Message}");
+                    await ReportNetworkFailureAsync(ex);
                     return new DownloadProgress { Error = $"Failed to install game from mirror: {ex.Message}" };
                 }
             }
 
             // Extract PWR with Butler
+            if (await AwaitButlerAsync() is { } butlerError) return butlerError;
             _progressService.ReportDownloadProgress("install", 65, "launch.detail.installing_butler_pwr", null, 0, 0);
 
             try
@@ -412,8 +936,10 @@ public class GameSessionService : IGameSessionService
         if (isLatestInstance)
             _instanceService.SaveLatestInfo(branch, targetVersion);
 
+        RecordGameFiles(versionPath, targetVersion);
         _progressService.ReportDownloadProgress("complete", 95, "launch.detail.download_complete", null, 0, 0);
 
+        await jreTask;
         await EnsureRuntimeDependenciesAsync(ct);
 
         ct.ThrowIfCancellationRequested();
@@ -429,6 +955,7 @@ public class GameSessionService : IGameSessionService
 
         try
         {
+            installLock.Dispose();
             await _gameLauncher.LaunchGameAsync(versionPath, branch, ct);
 
             // Cleanup cache after successful launch
@@ -441,10 +968,11 @@ public class GameSessionService : IGameSessionService
 
             return new DownloadProgress { Success = true, Progress = 100 };
         }
+        catch (InstanceLockedException) { throw; }
         catch (Exception ex)
         {
             Logger.Error("Game", $"Launch failed: {ex.Message}");
-            _progressService.ReportError("launch", "Failed to launch game", ex.ToString());
+            _progressService.ReportFailure("launch", MessageCatalog.ErrorLaunchFailed, ex);
             return new DownloadProgress { Error = $"Failed to launch game: {ex.Message}" };
         }
     }
@@ -491,6 +1019,7 @@ public class GameSessionService : IGameSessionService
         {
             string partPath = pwrPath + ".part";
             bool downloaded = false;
+            Exception? lastError = null;
 
             // Try official URL first (skip if server is known to be down or no valid URL)
             if (!skipOfficial && hasOfficialUrl)
@@ -499,11 +1028,11 @@ public class GameSessionService : IGameSessionService
                 {
                     Logger.Info("Download", $"Downloading from official: {downloadUrl}");
                     _progressService.ReportDownloadProgress("download", 5, "launch.detail.downloading_official", null, 0, 0);
-                    await _downloadService.DownloadFileAsync(downloadUrl, partPath, (progress, downloaded, total) =>
+                    await _downloadService.DownloadFileAsync(downloadUrl, partPath, p =>
                     {
-                        int mappedProgress = 5 + (int)(progress * 0.60);
-                        _progressService.ReportDownloadProgress("download", mappedProgress, "launch.detail.downloading_official", [progress], downloaded, total);
-                    }, ct);
+                        int mappedProgress = 5 + (int)(p.Percent * 0.60);
+                        _progressService.ReportDownloadProgress("download", mappedProgress, "launch.detail.downloading_official", [p.Percent], p);
+                    }, null, ct);
                     downloaded = true;
                     Logger.Success("Download", "Downloaded from official successfully");
                 }
@@ -511,6 +1040,7 @@ public class GameSessionService : IGameSessionService
                 catch (Exception ex)
                 {
                     Logger.Warning("Download", $"Official download failed: {ex.Message}");
+                    lastError = ex;
                     // Clean up partial file before mirror attempt
                     if (File.Exists(partPath)) try { File.Delete(partPath); } catch { }
                 }
@@ -535,11 +1065,11 @@ public class GameSessionService : IGameSessionService
                         Logger.Info("Download", $"Retrying from mirror: {mirrorUrl}");
                         _progressService.ReportDownloadProgress("download", 5, "launch.detail.downloading_mirror", null, 0, 0);
 
-                        await _downloadService.DownloadFileAsync(mirrorUrl, partPath, (progress, dl, total) =>
+                        await _downloadService.DownloadFileAsync(mirrorUrl, partPath, p =>
                         {
-                            int mappedProgress = 5 + (int)(progress * 0.60);
-                            _progressService.ReportDownloadProgress("download", mappedProgress, "launch.detail.downloading_mirror", [progress], dl, total);
-                        }, ct);
+                            int mappedProgress = 5 + (int)(p.Percent * 0.60);
+                            _progressService.ReportDownloadProgress("download", mappedProgress, "launch.detail.downloading_mirror", [p.Percent], p);
+                        }, null, ct);
                         downloaded = true;
                         Logger.Success("Download", "Downloaded from mirror successfully");
                     }
@@ -547,6 +1077,7 @@ public class GameSessionService : IGameSessionService
                     catch (Exception mirrorEx)
                     {
                         Logger.Error("Download", $"Mirror download also failed: {mirrorEx.Message}");
+                        lastError = mirrorEx;
                     }
                 }
                 else if (_versionService.IsDiffBasedBranch(branch))
@@ -559,7 +1090,7 @@ public class GameSessionService : IGameSessionService
 
             if (!downloaded)
             {
-                throw new Exception("Download failed from both official server and mirror. Please try again later.");
+                throw new Exception("Download failed from both official server and mirror. Please try again later.", lastError);
             }
 
             if (File.Exists(partPath))
@@ -571,6 +1102,72 @@ public class GameSessionService : IGameSessionService
         }
     }
 
+    /// <summary>
+    /// Installs Butler once a download slot is free, reporting its progress until the game package starts.
+    /// </summary>
+    /// <returns>The failure, or <c>null</c> once Butler is ready.</returns>
+    private async Task<Exception?> InstallButlerInSlotAsync(SemaphoreSlim downloadSlots, Func<bool> reportProgress, CancellationToken ct)
+    {
+        try
+        {
+            await downloadSlots.WaitAsync(ct);
+        }
+        catch (OperationCanceledException ex)
+        {
+            return ex;
+        }
+
+        try
+        {
+            await _butlerService.EnsureButlerInstalledAsync((progress, message) =>
+            {
+                if (!reportProgress()) return;
+                int mappedProgress = 2 + (int)(progress * 0.03);
+                _progressService.ReportDownloadProgress("download", mappedProgress, message, null, 0, 0);
+            });
+            return null;
+        }
+        catch (Exception ex)
+        {
+            return ex;
+        }
+        finally
+        {
+            downloadSlots.Release();
+        }
+    }
+
+    /// <summary>
+    /// Installs the JRE while the game package downloads. A failure is only logged; the runtime check
+    /// after the install tries again and reports it.
+    /// </summary>
+    private async Task PrefetchJreAsync(SemaphoreSlim downloadSlots, CancellationToken ct)
+    {
+        if (File.Exists(_launchService.GetJavaPath())) return;
+
+        try
+        {
+            await downloadSlots.WaitAsync(ct);
+            try
+            {
+                Logger.Info("Download", "JRE missing, downloading it next to the game");
+                await _launchService.EnsureJREInstalledAsync((_, _) => { });
+            }
+            finally
+            {
+                downloadSlots.Release();
+            }
+        }
+        catch (OperationCanceledException)
+        {
+            // The session reports the cancellation
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Download", $"JRE download failed, retrying after the game install: {ex.Message}");
+        }
+    }
+
     private async Task EnsureRuntimeDependenciesAsync(CancellationToken ct)
     {
         // VC++ Redist check (Windows only)
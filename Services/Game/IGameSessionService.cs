@@ -10,13 +10,65 @@ public interface IGameSessionService : IDisposable
 {
     /// <summary>
     /// Downloads/updates the game and optionally launches it upon completion.
+    /// Targets the selected instance (see <see cref="LaunchInstanceAsync"/>); without one, the branch and
+    /// version are read from config when called. If another session is running, this one waits in the
+    /// install queue and, once it runs, installs without launching.
     /// </summary>
     /// <param name="launchAfterDownloadProvider">Optional function that returns whether to launch the game after download completes.</param>
     /// <returns>A <see cref="DownloadProgress"/> object for tracking download state and progress.</returns>
     Task<DownloadProgress> DownloadAndLaunchAsync(Func<bool>? launchAfterDownloadProvider = null);
 
+    /// <summary>
+    /// Installs or updates one instance in its own folder and launches exactly that instance, with its
+    /// branch and version. The instance becomes the selected one, and the config's branch and version
+    /// are set to match it. Queues like <see cref="DownloadAndLaunchAsync"/>.
+    /// </summary>
+    /// <param name="instanceId">The instance to launch.</param>
+    /// <param name="launchAfterDownloadProvider">Optional function that returns whether to launch the game after download completes.</param>
+    Task<DownloadProgress> LaunchInstanceAsync(string instanceId, Func<bool>? launchAfterDownloadProvider = null);
+
+    /// <summary>
+    /// Checks every game file of an instance against the manifest recorded at install and, if any is
+    /// missing or changed, deletes the changed ones and reinstalls the instance's version over the rest.
+    /// Runs through the install queue like any other session and never launches the game.
+    /// </summary>
+    /// <param name="instanceId">The instance to repair.</param>
+    Task<DownloadProgress> RepairInstanceAsync(string instanceId);
+
     /// <summary>
     /// Cancels any ongoing download operation.
     /// </summary>
     void CancelDownload();
+
+    /// <summary>
+    /// Cancels the running install or update and removes its partial downloads and extracted files.
+    /// Unlike <see cref="CancelDownload"/>, does nothing when no session is running.
+    /// </summary>
+    /// <returns><c>true</c> if a running session was cancelled.</returns>
+    bool CancelInstall();
+
+    /// <summary>
+    /// Raised with the full queue whenever a session is queued, starts, finishes, is cancelled, or moves.
+    /// </summary>
+    event Action<List<InstallQueueEntry>>? InstallQueueChanged;
+
+    /// <summary>
+    /// Gets the running session (position 0) followed by the queued ones in the order they will run.
+    /// </summary>
+    List<InstallQueueEntry> GetInstallQueue();
+
+    /// <summary>
+    /// Removes a queued session, or cancels it if it is the one running.
+    /// </summary>
+    /// <param name="id">The queue entry ID.</param>
+    /// <returns><c>false</c> if no such entry exists.</returns>
+    bool CancelQueuedInstall(string id);
+
+    /// <summary>
+    /// Moves a queued session to another place in the queue.
+    /// </summary>
+    /// <param name="id">The queue entry ID.</param>
+    /// <param name="position">New 1-based position among queued sessions; clamped to the queue length.</param>
+    /// <returns><c>false</c> if the entry is not queued (unknown or already running).</returns>
+    bool MoveQueuedInstall(string id, int position);
 }
@@ -0,0 +1,206 @@
+using System.Security.Cryptography;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Launch;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Records and checks the game files of an instance so damage or edits can be found without a reinstall.
+/// </summary>
+/// <remarks>
+/// The manifest covers everything Butler installs. Launcher-owned entries (<c>UserData</c>, <c>meta.json</c>,
+/// <c>latest.json</c>, Butler's <c>.itch</c> receipt and staging folder, patcher backups and flags, the instance lock) are skipped.
+/// A quick check only hashes files whose size or timestamp changed since the manifest was written.
+/// </remarks>
+public static class GameFilesIntegrity
+{
+    public const string ManifestFileName = ".game_manifest.json";
+
+    private static readonly HashSet<string> IgnoredTopLevel = new(StringComparer.OrdinalIgnoreCase)
+    {
+        ManifestFileName, ManifestFileName + ".tmp", "UserData", "meta.json", "latest.json", ".itch", "staging-temp",
+        InstanceLock.FileName, InstanceLock.FileName + ".tmp"
+    };
+
+    private static readonly string[] IgnoredSuffixes = { ".original", ".patched_custom" };
+
+    private static readonly HashSet<string> CriticalFiles = new(StringComparer.Ordinal)
+    {
+        "Server/HytaleServer.jar", "Assets.zip"
+    };
+
+    /// <summary>
+    /// Records size, timestamp and SHA-256 of every game file under <paramref name="instancePath"/>.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="version">The installed game version, if known.</param>
+    public static void WriteManifest(string instancePath, int version)
+    {
+        try
+        {
+            var manifest = new GameFilesManifest { Version = version };
+            foreach (var file in EnumerateGameFiles(instancePath))
+            {
+                var info = new FileInfo(file);
+                manifest.Files[GetRelativeKey(instancePath, file)] = new GameFileEntry
+                {
+                    Size = info.Length,
+                    ModifiedUtc = info.LastWriteTimeUtc,
+                    Sha256 = HashFile(file)
+                };
+            }
+
+            var path = Path.Combine(instancePath, ManifestFileName);
+            File.WriteAllText(path + ".tmp", JsonSerializer.Serialize(manifest));
+            File.Move(path + ".tmp", path, true);
+            Logger.Info("Integrity", $"Recorded game manifest ({manifest.Files.Count} files, v{version}) for {instancePath}");
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Integrity", $"Failed to write game manifest: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Loads the manifest of an instance.
+    /// </summary>
+    /// <returns>The manifest, or <c>null</c> if it is missing or unreadable.</returns>
+    public static GameFilesManifest? LoadManifest(string instancePath)
+    {
+        try
+        {
+            var path = Path.Combine(instancePath, ManifestFileName);
+            if (!File.Exists(path)) return null;
+            return JsonSerializer.Deserialize<GameFilesManifest>(File.ReadAllText(path));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Integrity", $"Unreadable game manifest in {instancePath}: {ex.Message}");
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Checks the game files of an instance against its manifest.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="deep">Hash every file instead of only those whose size or timestamp changed.</param>
+    /// <param name="ct">Cancels a long check.</param>
+    public static GameFilesReport Verify(string instancePath, bool deep, CancellationToken ct = default) =>
+        Verify(instancePath, deep, _ => true, ct);
+
+    /// <summary>
+    /// Quick check of only the files the game cannot start without: the client binary and the libraries
+    /// next to it, <c>Server/HytaleServer.jar</c> and <c>Assets.zip</c>. Cheap enough to run before every launch.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="clientPath">The client executable.</param>
+    /// <param name="ct">Cancels the check.</param>
+    public static GameFilesReport VerifyCritical(string instancePath, string clientPath, CancellationToken ct = default)
+    {
+        var clientKey = GetRelativeKey(instancePath, clientPath);
+        var clientDir = clientKey.Contains('/') ? clientKey[..(clientKey.LastIndexOf('/') + 1)] : "";
+
+        return Verify(instancePath, deep: false, relative =>
+            CriticalFiles.Contains(relative)
+            || (clientDir.Length > 0 && relative.StartsWith(clientDir, StringComparison.Ordinal)
+                && relative.IndexOf('/', clientDir.Length) < 0),
+            ct);
+    }
+
+    private static GameFilesReport Verify(string instancePath, bool deep, Func<string, bool> include, CancellationToken ct)
+    {
+        var report = new GameFilesReport { Deep = deep };
+        var manifest = LoadManifest(instancePath);
+        if (manifest == null || manifest.Files.Count == 0) return report;
+
+        report.HasManifest = true;
+        report.Version = manifest.Version;
+
+        foreach (var (relative, entry) in manifest.Files)
+        {
+            ct.ThrowIfCancellationRequested();
+            if (!include(relative)) continue;
+            report.CheckedFiles++;
+
+            var file = Path.Combine(instancePath, relative.Replace('/', Path.DirectorySeparatorChar));
+            var info = new FileInfo(file);
+            if (!info.Exists)
+            {
+                report.Missing.Add(relative);
+                continue;
+            }
+
+            // Unchanged size and timestamp: trust the file unless a deep check was asked for
+            if (!deep && info.Length == entry.Size && info.LastWriteTimeUtc == entry.ModifiedUtc) continue;
+
+            if (info.Length == entry.Size && string.Equals(HashFile(file), entry.Sha256, StringComparison.OrdinalIgnoreCase))
+                continue;
+
+            if (IsLauncherPatched(file, entry))
+                report.Patched.Add(relative);
+            else
+                report.Modified.Add(relative);
+        }
+
+        return report;
+    }
+
+    /// <summary>
+    /// Deletes the modified files of <paramref name="report"/> so a reinstall rewrites them.
+    /// </summary>
+    /// <returns>The number of files deleted.</returns>
+    public static int DeleteBrokenFiles(string instancePath, GameFilesReport report)
+    {
+        int deleted = 0;
+        foreach (var relative in report.Modified)
+        {
+            var file = Path.Combine(instancePath, relative.Replace('/', Path.DirectorySeparatorChar));
+            try
+            {
+                File.Delete(file);
+                deleted++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Integrity", $"Could not delete {relative}: {ex.Message}");
+            }
+        }
+        return deleted;
+    }
+
+    /// <summary>
+    /// A file the client patcher rewrote still has its original next to it; compare that instead.
+    /// </summary>
+    private static bool IsLauncherPatched(string file, GameFileEntry entry)
+    {
+        var backup = ClientPatcher.GetBackupFilePath(file);
+        if (!File.Exists(backup)) return false;
+        return new FileInfo(backup).Length == entry.Size
+            && string.Equals(HashFile(backup), entry.Sha256, StringComparison.OrdinalIgnoreCase);
+    }
+
+    private static IEnumerable<string> EnumerateGameFiles(string instancePath)
+    {
+        foreach (var file in Directory.EnumerateFiles(instancePath, "*", SearchOption.AllDirectories))
+        {
+            var relative = GetRelativeKey(instancePath, file);
+            var topLevel = relative.Split('/')[0];
+            if (IgnoredTopLevel.Contains(topLevel)) continue;
+            if (IgnoredSuffixes.Any(s => file.EndsWith(s, StringComparison.OrdinalIgnoreCase))) continue;
+            if (File.GetAttributes(file).HasFlag(FileAttributes.ReparsePoint)) continue;
+            yield return file;
+        }
+    }
+
+    private static string HashFile(string path)
+    {
+        using var stream = new FileStream(path, FileMode.Open, FileAccess.Read, FileShare.ReadWrite);
+        return Convert.ToHexString(SHA256.HashData(stream)).ToLowerInvariant();
+    }
+
+    private static string GetRelativeKey(string instancePath, string file) =>
+        Path.GetRelativePath(instancePath, file).Replace('\\', '/');
+}
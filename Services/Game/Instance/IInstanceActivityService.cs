@@ -0,0 +1,28 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Records instance launches and keeps the player's favorite instances.
+/// </summary>
+public interface IInstanceActivityService
+{
+    /// <summary>
+    /// Gets the most recently launched instances, newest first.
+    /// </summary>
+    /// <param name="count">Maximum number of instances.</param>
+    List<InstanceActivity> GetRecentInstances(int count = 5);
+
+    /// <summary>
+    /// Gets the favorite instances, most recently launched first.
+    /// </summary>
+    List<InstanceActivity> GetFavoriteInstances();
+
+    /// <summary>
+    /// Marks an instance as a favorite or removes the mark.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="favorite">Whether the instance is a favorite.</param>
+    /// <returns><c>true</c> if the change was saved; <c>false</c> if the instance does not exist.</returns>
+    bool SetFavorite(string instanceId, bool favorite);
+}
@@ -0,0 +1,47 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Manages the custom icon and banner of each instance.
+/// </summary>
+public interface IInstanceArtworkService
+{
+    /// <summary>
+    /// Gets the icon and banner of an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    /// <returns>The artwork; empty when the instance is not found.</returns>
+    InstanceArtwork GetArtwork(string instanceId);
+
+    /// <summary>
+    /// Gets the names of the bundled images that can be used as artwork.
+    /// </summary>
+    List<string> GetBundledArtwork();
+
+    /// <summary>
+    /// Uses an image picked by the user, cropped to the size of <paramref name="kind"/>.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    /// <param name="kind">An <see cref="ArtworkKind"/> value.</param>
+    /// <param name="image">The encoded image (PNG, JPEG, WebP…).</param>
+    /// <returns><c>true</c> if the image was saved.</returns>
+    Task<bool> SetCustomArtworkAsync(string instanceId, string kind, byte[] image);
+
+    /// <summary>
+    /// Uses one of <see cref="GetBundledArtwork"/>.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    /// <param name="kind">An <see cref="ArtworkKind"/> value.</param>
+    /// <param name="name">The bundled image name.</param>
+    /// <returns><c>true</c> if the name is known and the instance was updated.</returns>
+    bool SetBundledArtwork(string instanceId, string kind, string name);
+
+    /// <summary>
+    /// Goes back to the default look and deletes a custom image.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    /// <param name="kind">An <see cref="ArtworkKind"/> value.</param>
+    /// <returns><c>true</c> if the instance was updated.</returns>
+    bool ClearArtwork(string instanceId, string kind);
+}
@@ -0,0 +1,42 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Backs up an instance's worlds, mods and settings together, and restores them in one step.
+/// </summary>
+public interface IInstanceBackupService
+{
+    /// <summary>
+    /// Zips the instance's <c>UserData</c> and <c>meta.json</c> into <c>Backups/Instances/{instanceId}</c>
+    /// in the launcher data directory, then deletes backups beyond <c>InstanceBackupRetention</c>.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <returns>The new backup, or <c>null</c> if the instance does not exist.</returns>
+    /// <exception cref="InstanceLockedException">The instance is in use.</exception>
+    Task<InstanceBackupInfo?> BackupInstanceAsync(string instanceId);
+
+    /// <summary>
+    /// Lists the backups of an instance, newest first.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    List<InstanceBackupInfo> GetInstanceBackups(string instanceId);
+
+    /// <summary>
+    /// Replaces the instance's <c>UserData</c> with the backup's and restores its settings. The instance keeps
+    /// its ID, name, branch and version, since the game files are not part of the backup.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="fileName">Backup file name from <see cref="GetInstanceBackups"/>.</param>
+    /// <returns><c>true</c> if the backup was restored.</returns>
+    /// <exception cref="InstanceLockedException">The instance is in use.</exception>
+    Task<bool> RestoreInstanceBackupAsync(string instanceId, string fileName);
+
+    /// <summary>
+    /// Deletes a backup by moving it to the trash.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="fileName">Backup file name from <see cref="GetInstanceBackups"/>.</param>
+    /// <returns><c>true</c> if the backup was deleted.</returns>
+    bool DeleteInstanceBackup(string instanceId, string fileName);
+}
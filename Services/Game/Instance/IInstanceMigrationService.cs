@@ -0,0 +1,20 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Moves worlds, mods and game settings from one instance to another, typically between branches.
+/// </summary>
+public interface IInstanceMigrationService
+{
+    /// <summary>
+    /// Copies the selected content from the source to the target instance.
+    /// With <see cref="InstanceMigrationOptions.DryRun"/>, only reports what would be copied.
+    /// </summary>
+    /// <param name="options">Source, target and what to copy.</param>
+    /// <param name="ct">Cancels before the next item.</param>
+    /// <returns>What was (or would be) copied, and the mods that could not be moved.</returns>
+    /// <exception cref="ArgumentException">Thrown if either instance does not exist or both are the same.</exception>
+    /// <exception cref="InstanceLockedException">Thrown if the target instance is running or being changed.</exception>
+    Task<InstanceMigrationReport> MigrateAsync(InstanceMigrationOptions options, CancellationToken ct = default);
+}
@@ -77,6 +77,12 @@ public interface IInstanceService
     /// <param name="version">The version number to save as latest.</param>
     void SaveLatestInfo(string branch, int version);
 
+    /// <summary>
+    /// Gets the installed latest version of each branch, keyed by normalized branch name.
+    /// </summary>
+    /// <returns>Only the branches that have a latest instance.</returns>
+    Dictionary<string, LatestInstanceInfo> GetInstalledLatestVersions();
+
     /// <summary>
     /// Migrates data from legacy installation formats to the current structure.
     /// </summary>
@@ -119,6 +125,7 @@ public interface IInstanceService
     /// <param name="branch">The game branch.</param>
     /// <param name="versionNumber">The version number to delete.</param>
     /// <returns><c>true</c> if the instance was successfully deleted; otherwise, <c>false</c>.</returns>
+    /// <exception cref="InstanceLockedException">The game runs from the instance or another operation is changing it.</exception>
     bool DeleteGame(string branch, int versionNumber);
 
     /// <summary>
@@ -142,6 +149,52 @@ public interface IInstanceService
     /// <param name="customName">The custom name to set, or null to clear.</param>
     void SetInstanceCustomNameById(string instanceId, string? customName);
 
+    /// <summary>
+    /// Gets the extra client arguments of an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <returns>The arguments as entered, or an empty string if none are set.</returns>
+    string GetExtraClientArgs(string instanceId);
+
+    /// <summary>
+    /// Sets or clears the extra client arguments of an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <param name="extraArgs">Arguments appended to the client command line, or null to clear.</param>
+    /// <returns><c>true</c> if the instance was found and updated.</returns>
+    bool SetExtraClientArgs(string instanceId, string? extraArgs);
+
+    /// <summary>
+    /// Gets the display server preference of an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <returns>"auto", "wayland" or "x11".</returns>
+    string GetDisplayServer(string instanceId);
+
+    /// <summary>
+    /// Sets the display server the instance's client uses on Linux.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <param name="mode">"auto", "wayland" or "x11".</param>
+    /// <returns><c>true</c> if the instance was found and updated.</returns>
+    /// <exception cref="ArgumentException">The mode is not one of the above.</exception>
+    bool SetDisplayServer(string instanceId, string mode);
+
+    /// <summary>
+    /// Gets the CurseForge game version set for an instance.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <returns>The version, or null if none is set.</returns>
+    string? GetGameVersion(string instanceId);
+
+    /// <summary>
+    /// Sets or clears the CurseForge game version mods of the instance are checked against at launch.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <param name="gameVersion">The version, or null to turn the check off.</param>
+    /// <returns><c>true</c> if the instance was found and updated.</returns>
+    bool SetGameVersion(string instanceId, string? gameVersion);
+
     /// <summary>
     /// Gets the instance metadata from the meta.json file.
     /// </summary>
@@ -166,6 +219,18 @@ public interface IInstanceService
     /// <returns>The created instance metadata.</returns>
     InstanceMeta CreateInstanceMeta(string branch, int version, string? name = null, bool isLatest = false);
 
+    /// <summary>
+    /// Registers a game copy extracted outside the launcher as a new instance.
+    /// </summary>
+    /// <param name="sourcePath">Folder containing <c>Client</c> (directly or in a <c>game</c> subfolder).</param>
+    /// <param name="branch">The game branch of the copy.</param>
+    /// <param name="version">The version number; 0 adopts it as the branch's latest instance.</param>
+    /// <param name="mode"><c>move</c>, <c>copy</c>, or <c>link</c> (symlinks to the original; UserData is copied).</param>
+    /// <param name="name">Optional instance name.</param>
+    /// <returns>The new instance's metadata.</returns>
+    /// <exception cref="InvalidOperationException">Thrown if no client is found or the target already exists.</exception>
+    InstanceMeta AdoptExistingInstall(string sourcePath, string branch, int version, string mode, string? name = null);
+
     /// <summary>
     /// Gets the currently selected instance based on SelectedInstanceId.
     /// </summary>
@@ -206,6 +271,14 @@ public interface IInstanceService
     /// <returns>The instance info, or null if not found.</returns>
     InstanceInfo? FindInstanceByBranchAndVersion(string branch, int version);
 
+    /// <summary>
+    /// Finds an instance the way a user would name it on the command line: by ID, by <c>branch/version</c>
+    /// (e.g. <c>release/3</c>, or <c>release/latest</c> for the latest instance), or by its name.
+    /// </summary>
+    /// <param name="reference">The ID, branch and version, or name.</param>
+    /// <returns>The instance info, or null if none matches or the name is shared by several instances.</returns>
+    InstanceInfo? FindInstanceByReference(string reference);
+
     /// <summary>
     /// Migrates instance folders from version-based naming (e.g., release/5) to ID-based naming (e.g., release/{guid}).
     /// Should be called during startup after MigrateLegacyData.
@@ -0,0 +1,41 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Guards the first pre-release launch with a warning and keeps pre-release worlds apart from release ones.
+/// </summary>
+public interface IPreReleaseService
+{
+    /// <summary>
+    /// Raised when a pre-release launch was held back because the notice was not acknowledged yet.
+    /// </summary>
+    event Action<PreReleaseNotice>? NoticeRequired;
+
+    /// <summary>
+    /// Gets the notice if it still has to be acknowledged.
+    /// </summary>
+    /// <returns>The notice, or <c>null</c> once acknowledged.</returns>
+    PreReleaseNotice? GetPendingNotice();
+
+    /// <summary>
+    /// Checks whether a session for <paramref name="branch"/> may start, raising
+    /// <see cref="NoticeRequired"/> when it may not.
+    /// </summary>
+    /// <param name="branch">Normalized branch of the session.</param>
+    /// <returns><c>true</c> for release, or for pre-release once the notice was acknowledged.</returns>
+    bool CheckBeforeLaunch(string branch);
+
+    /// <summary>
+    /// Acknowledges the notice, first backing up the worlds of every release instance if asked to.
+    /// </summary>
+    /// <param name="backupWorlds">Whether to back up release worlds.</param>
+    PreReleaseAcknowledgeResult Acknowledge(bool backupWorlds);
+
+    /// <summary>
+    /// Makes sure a pre-release instance has its own <c>UserData</c>: a link to another instance's
+    /// <c>UserData</c> (or a release instance linking to this one) is replaced with a copy.
+    /// </summary>
+    /// <param name="instancePath">Folder of the pre-release instance.</param>
+    void EnsureIsolated(string instancePath);
+}
@@ -0,0 +1,22 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Finds installs and downloads that an earlier launcher run did not finish, because it crashed, was killed
+/// or lost power, and either keeps them so the next install continues or removes them.
+/// </summary>
+public interface IStartupRecoveryService
+{
+    /// <summary>
+    /// Checks every instance, including those in legacy locations, the download cache and the Java runtimes.
+    /// Instances locked by a running game or another launcher are skipped.
+    /// </summary>
+    /// <returns>What was found and what was done with it.</returns>
+    StartupRecoveryReport Recover();
+
+    /// <summary>
+    /// Gets the report of the last <see cref="Recover"/> run, or an empty report before it ran.
+    /// </summary>
+    StartupRecoveryReport GetLastReport();
+}
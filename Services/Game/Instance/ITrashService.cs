@@ -0,0 +1,44 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Keeps deleted worlds and backups for a while so they can be restored.
+/// </summary>
+public interface ITrashService
+{
+    /// <summary>
+    /// Moves a world folder or backup file into the trash.
+    /// </summary>
+    /// <param name="kind"><see cref="TrashEntry.World"/> or <see cref="TrashEntry.InstanceBackup"/>.</param>
+    /// <param name="path">Folder or file to move.</param>
+    /// <param name="instanceId">Instance the item belongs to.</param>
+    /// <returns>The new trash entry.</returns>
+    TrashEntry MoveToTrash(string kind, string path, string instanceId);
+
+    /// <summary>
+    /// Lists the trash, newest first, after purging entries older than the retention window.
+    /// </summary>
+    List<TrashEntry> GetEntries();
+
+    /// <summary>
+    /// Moves an entry back to where it was. If that name is taken again, the item is restored as "name (2)".
+    /// </summary>
+    /// <param name="id">Trash entry ID.</param>
+    /// <returns><c>true</c> if the item was restored; <c>false</c> if the entry or its instance no longer exists.</returns>
+    /// <exception cref="InstanceLockedException">The world's instance is in use.</exception>
+    bool RestoreFromTrash(string id);
+
+    /// <summary>
+    /// Deletes one entry for good.
+    /// </summary>
+    /// <param name="id">Trash entry ID.</param>
+    /// <returns><c>true</c> if the entry was deleted.</returns>
+    bool DeleteFromTrash(string id);
+
+    /// <summary>
+    /// Deletes every entry for good.
+    /// </summary>
+    /// <returns>Number of entries deleted.</returns>
+    int EmptyTrash();
+}
@@ -0,0 +1,86 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Lists, opens and deletes worlds per instance.
+/// </summary>
+public interface IWorldService
+{
+    /// <summary>
+    /// Gets the saves folder (<c>UserData/Saves</c>) of an instance.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <returns>The folder path, or <c>null</c> if the instance does not exist. The folder itself may not exist yet.</returns>
+    string? GetSavesDir(string instanceId);
+
+    /// <summary>
+    /// Lists the worlds of an instance, newest first.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    List<WorldInfo> GetWorlds(string instanceId);
+
+    /// <summary>
+    /// Lists the worlds of every instance, newest first, each tagged with its instance.
+    /// </summary>
+    List<WorldInfo> GetAllWorlds();
+
+    /// <summary>
+    /// Resolves a world folder, refusing names that point outside the saves folder.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="worldName">World folder name.</param>
+    /// <returns>The world path, or <c>null</c> if it does not exist or the name is invalid.</returns>
+    string? GetWorldPath(string instanceId, string worldName);
+
+    /// <summary>
+    /// Deletes a world by moving it to the trash.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="worldName">World folder name.</param>
+    /// <returns><c>true</c> if the world was deleted.</returns>
+    /// <exception cref="InstanceLockedException">The instance is in use.</exception>
+    bool DeleteWorld(string instanceId, string worldName);
+
+    /// <summary>
+    /// Copies a world within its instance under a new folder name, e.g. to try mods on the copy.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="worldName">World folder name.</param>
+    /// <param name="newName">Folder name of the copy; must be a valid file name that is not taken.</param>
+    /// <returns>The name of the copy, or <c>null</c> if the world does not exist or the name is invalid or taken.</returns>
+    /// <exception cref="InstanceLockedException">The instance is in use.</exception>
+    string? DuplicateWorld(string instanceId, string worldName, string newName);
+
+    /// <summary>
+    /// Zips all worlds of an instance into <c>Backups/Worlds</c> in the launcher data directory.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <returns>Path of the archive, or <c>null</c> if the instance has no worlds.</returns>
+    /// <exception cref="LauncherException">The instance is in use.</exception>
+    string? BackupWorlds(string instanceId);
+
+    /// <summary>
+    /// Gets the saves folders of the official Hytale launcher found on this computer.
+    /// </summary>
+    List<string> GetOfficialSavesDirs();
+
+    /// <summary>
+    /// Lists the worlds in a saves folder, or in every folder from <see cref="GetOfficialSavesDirs"/>.
+    /// The returned worlds have no instance.
+    /// </summary>
+    /// <param name="savesDir">Folder to scan; <c>null</c> scans the official launcher's folders.</param>
+    List<WorldInfo> FindOfficialWorlds(string? savesDir = null);
+
+    /// <summary>
+    /// Copies worlds from another saves folder into an instance. A world whose name is taken is imported
+    /// as "name (2)"; the source is left untouched.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="sourceSavesDir">Saves folder the worlds are in.</param>
+    /// <param name="worldNames">Folder names of the worlds to import.</param>
+    /// <returns>Names of the imported worlds in the instance.</returns>
+    /// <exception cref="InstanceLockedException">The instance is in use.</exception>
+    List<string> ImportWorlds(string instanceId, string sourceSavesDir, IEnumerable<string> worldNames);
+}
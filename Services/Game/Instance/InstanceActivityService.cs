@@ -0,0 +1,151 @@
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Records every game start in <c>launch-history.json</c> and sets <see cref="InstanceMeta.LastPlayedAt"/>,
+/// and keeps the favorite instances in <see cref="Config.FavoriteInstanceIds"/>.
+/// </summary>
+/// <remarks>
+/// Like <see cref="WorldService"/> and the stats services, a launch belongs to the selected instance.
+/// Launches of deleted instances stay in the history but are left out of every list.
+/// </remarks>
+public class InstanceActivityService : IInstanceActivityService
+{
+    private const int MaxHistory = 200;
+
+    private readonly string _historyPath;
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly object _lock = new();
+    private List<InstanceLaunchRecord>? _history;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="InstanceActivityService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory, where the launch history is stored.</param>
+    /// <param name="configService">Provides the selected instance and stores favorites.</param>
+    /// <param name="instanceService">Resolves instances and writes their last played time.</param>
+    /// <param name="progressService">Raises the game state change that records a launch.</param>
+    public InstanceActivityService(string appDir, IConfigService configService, IInstanceService instanceService,
+        IProgressNotificationService progressService)
+    {
+        _historyPath = Path.Combine(appDir, "launch-history.json");
+        _configService = configService;
+        _instanceService = instanceService;
+
+        progressService.GameStateChanged += (state, _) =>
+        {
+            if (state == "started") RecordLaunch();
+        };
+    }
+
+    /// <inheritdoc/>
+    public List<InstanceActivity> GetRecentInstances(int count = 5)
+    {
+        List<InstanceLaunchRecord> history;
+        lock (_lock) history = LoadHistory().ToList();
+
+        return history
+            .GroupBy(r => r.InstanceId)
+            .Select(g => ToActivity(g.Key, history))
+            .OfType<InstanceActivity>()
+            .OrderByDescending(a => a.LastPlayedAt)
+            .Take(Math.Max(1, count))
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public List<InstanceActivity> GetFavoriteInstances()
+    {
+        List<InstanceLaunchRecord> history;
+        lock (_lock) history = LoadHistory().ToList();
+
+        return _configService.Configuration.FavoriteInstanceIds
+            .Select(id => ToActivity(id, history))
+            .OfType<InstanceActivity>()
+            .OrderByDescending(a => a.LastPlayedAt ?? DateTime.MinValue)
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public bool SetFavorite(string instanceId, bool favorite)
+    {
+        if (_instanceService.FindInstanceById(instanceId) == null) return false;
+
+        return _configService.TryUpdate(c =>
+        {
+            c.FavoriteInstanceIds.Remove(instanceId);
+            if (favorite) c.FavoriteInstanceIds.Add(instanceId);
+        });
+    }
+
+    private void RecordLaunch()
+    {
+        var instanceId = _configService.Configuration.SelectedInstanceId;
+        if (string.IsNullOrEmpty(instanceId)) return;
+
+        var now = DateTime.UtcNow;
+        try
+        {
+            lock (_lock)
+            {
+                var history = LoadHistory();
+                history.Insert(0, new InstanceLaunchRecord { InstanceId = instanceId, LaunchedAt = now });
+                if (history.Count > MaxHistory) history.RemoveRange(MaxHistory, history.Count - MaxHistory);
+                File.WriteAllText(_historyPath + ".tmp", JsonSerializer.Serialize(history));
+                File.Move(_historyPath + ".tmp", _historyPath, true);
+            }
+
+            var instancePath = _instanceService.GetInstancePathById(instanceId);
+            var meta = string.IsNullOrEmpty(instancePath) ? null : _instanceService.GetInstanceMeta(instancePath);
+            if (meta != null)
+            {
+                meta.LastPlayedAt = now;
+                _instanceService.SaveInstanceMeta(instancePath!, meta);
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Activity", $"Could not record the launch of {instanceId}: {ex.Message}");
+        }
+    }
+
+    private InstanceActivity? ToActivity(string instanceId, List<InstanceLaunchRecord> history)
+    {
+        var instance = _instanceService.FindInstanceById(instanceId);
+        if (instance == null) return null;
+
+        var launches = history.Where(r => r.InstanceId == instanceId).ToList();
+        return new InstanceActivity
+        {
+            InstanceId = instance.Id,
+            Name = instance.Name,
+            Branch = instance.Branch,
+            Version = instance.Version,
+            IsFavorite = _configService.Configuration.FavoriteInstanceIds.Contains(instance.Id),
+            LastPlayedAt = launches.Count > 0 ? launches.Max(r => r.LaunchedAt) : null,
+            LaunchCount = launches.Count
+        };
+    }
+
+    private List<InstanceLaunchRecord> LoadHistory()
+    {
+        if (_history != null) return _history;
+        try
+        {
+            _history = File.Exists(_historyPath)
+                ? JsonSerializer.Deserialize<List<InstanceLaunchRecord>>(File.ReadAllText(_historyPath)) ?? []
+                : [];
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Activity", $"Could not read {_historyPath}: {ex.Message}");
+            _history = [];
+        }
+        return _history;
+    }
+}
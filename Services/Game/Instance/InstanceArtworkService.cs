@@ -0,0 +1,167 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using SixLabors.ImageSharp;
+using SixLabors.ImageSharp.Processing;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Stores instance icons and banners in the instance folder and records the choice in <c>meta.json</c>.
+/// </summary>
+/// <remarks>
+/// Picked images are cropped to 256×256 (<c>logo.png</c>, the file older versions already used) or
+/// 1200×400 (<c>banner.png</c>). Bundled art is referenced by name as <c>bundled:{name}</c> and shares
+/// the background images, so nothing is copied. Instances without an <see cref="InstanceMeta.Icon"/>
+/// keep showing an existing <c>logo.png</c> or legacy <c>icon.png</c>.
+/// </remarks>
+public class InstanceArtworkService : IInstanceArtworkService
+{
+    private const string BundledPrefix = "bundled:";
+    private const string IconFileName = "logo.png";
+    private const string LegacyIconFileName = "icon.png";
+    private const string BannerFileName = "banner.png";
+
+    private readonly IInstanceService _instanceService;
+    private readonly ISettingsService _settingsService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="InstanceArtworkService"/> class.
+    /// </summary>
+    /// <param name="instanceService">Resolves instance folders and reads and writes <c>meta.json</c>.</param>
+    /// <param name="settingsService">Lists the bundled background images.</param>
+    public InstanceArtworkService(IInstanceService instanceService, ISettingsService settingsService)
+    {
+        _instanceService = instanceService;
+        _settingsService = settingsService;
+    }
+
+    /// <summary>
+    /// Resolves the artwork of an instance folder whose <c>meta.json</c> is already loaded.
+    /// </summary>
+    /// <param name="instancePath">The instance folder.</param>
+    /// <param name="meta">Its metadata, or <c>null</c> if it has none.</param>
+    public static InstanceArtwork Resolve(string instancePath, InstanceMeta? meta)
+    {
+        var icon = meta?.Icon;
+        if (icon == null)
+        {
+            icon = File.Exists(Path.Combine(instancePath, IconFileName)) ? IconFileName
+                : File.Exists(Path.Combine(instancePath, LegacyIconFileName)) ? LegacyIconFileName
+                : null;
+        }
+
+        return new InstanceArtwork
+        {
+            Icon = ToUrl(instancePath, icon),
+            Banner = ToUrl(instancePath, meta?.Banner)
+        };
+    }
+
+    /// <inheritdoc/>
+    public InstanceArtwork GetArtwork(string instanceId)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath)) return new InstanceArtwork();
+        return Resolve(instancePath, _instanceService.GetInstanceMeta(instancePath));
+    }
+
+    /// <inheritdoc/>
+    public List<string> GetBundledArtwork() => _settingsService.GetAvailableBackgrounds();
+
+    /// <inheritdoc/>
+    public async Task<bool> SetCustomArtworkAsync(string instanceId, string kind, byte[] image)
+    {
+        if (!IsKind(kind)) return false;
+        var (instancePath, meta) = Load(instanceId);
+        if (instancePath == null || meta == null) return false;
+
+        var fileName = kind == ArtworkKind.Icon ? IconFileName : BannerFileName;
+        var size = kind == ArtworkKind.Icon ? new Size(256, 256) : new Size(1200, 400);
+
+        using var input = new MemoryStream(image);
+        using var loaded = await Image.LoadAsync(input);
+        loaded.Mutate(x => x.Resize(new ResizeOptions { Size = size, Mode = ResizeMode.Crop }));
+        await loaded.SaveAsPngAsync(Path.Combine(instancePath, fileName));
+
+        Set(meta, kind, fileName);
+        _instanceService.SaveInstanceMeta(instancePath, meta);
+        Logger.Info("InstanceArtwork", $"Set custom {kind} of {instanceId} ({size.Width}x{size.Height})");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public bool SetBundledArtwork(string instanceId, string kind, string name)
+    {
+        if (!IsKind(kind)) return false;
+        if (!GetBundledArtwork().Contains(name))
+        {
+            Logger.Warning("InstanceArtwork", $"Unknown bundled artwork: {name}");
+            return false;
+        }
+
+        var (instancePath, meta) = Load(instanceId);
+        if (instancePath == null || meta == null) return false;
+
+        DeleteCustomFiles(instancePath, kind);
+        Set(meta, kind, BundledPrefix + name);
+        _instanceService.SaveInstanceMeta(instancePath, meta);
+        Logger.Info("InstanceArtwork", $"Set {kind} of {instanceId} to bundled {name}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public bool ClearArtwork(string instanceId, string kind)
+    {
+        if (!IsKind(kind)) return false;
+        var (instancePath, meta) = Load(instanceId);
+        if (instancePath == null || meta == null) return false;
+
+        DeleteCustomFiles(instancePath, kind);
+        Set(meta, kind, null);
+        _instanceService.SaveInstanceMeta(instancePath, meta);
+        Logger.Info("InstanceArtwork", $"Cleared {kind} of {instanceId}");
+        return true;
+    }
+
+    private (string? Path, InstanceMeta? Meta) Load(string instanceId)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var meta = string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath)
+            ? null
+            : _instanceService.GetInstanceMeta(instancePath);
+        if (meta == null)
+        {
+            Logger.Warning("InstanceArtwork", $"Instance not found by ID: {instanceId}");
+            return (null, null);
+        }
+        return (instancePath, meta);
+    }
+
+    private static bool IsKind(string kind) => kind == ArtworkKind.Icon || kind == ArtworkKind.Banner;
+
+    private static void Set(InstanceMeta meta, string kind, string? value)
+    {
+        if (kind == ArtworkKind.Icon) meta.Icon = value;
+        else meta.Banner = value;
+    }
+
+    private static void DeleteCustomFiles(string instancePath, string kind)
+    {
+        var files = kind == ArtworkKind.Icon ? new[] { IconFileName, LegacyIconFileName } : new[] { BannerFileName };
+        foreach (var file in files)
+        {
+            try { File.Delete(Path.Combine(instancePath, file)); } catch { /* ignore */ }
+        }
+    }
+
+    private static string? ToUrl(string instancePath, string? value)
+    {
+        if (string.IsNullOrEmpty(value)) return null;
+        if (value.StartsWith(BundledPrefix, StringComparison.Ordinal)) return value;
+
+        // Only plain file names inside the instance folder
+        var path = Path.Combine(instancePath, Path.GetFileName(value));
+        return File.Exists(path) ? $"file://{path.Replace("\\", "/")}" : null;
+    }
+}
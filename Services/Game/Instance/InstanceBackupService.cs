@@ -0,0 +1,224 @@
+using System.IO.Compression;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Makes full backups of an instance (worlds, mods, mod manifest and settings) under
+/// <c>Backups/Instances/{instanceId}</c> and restores them.
+/// </summary>
+/// <remarks>
+/// Game files are left out: they can be downloaded again and would make every backup several gigabytes.
+/// Backups are written under the instance's <c>Backup</c> lock and restored under its <c>Modifying</c> lock,
+/// so they never run while the game or an install uses the instance.
+/// </remarks>
+public class InstanceBackupService : IInstanceBackupService
+{
+    private const string UserDataFolder = "UserData";
+    private const string MetaFileName = "meta.json";
+
+    private readonly IInstanceService _instanceService;
+    private readonly IConfigService _configService;
+    private readonly ITrashService _trashService;
+    private readonly string _backupsDir;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="InstanceBackupService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding <c>Backups/Instances</c>.</param>
+    /// <param name="instanceService">Resolves instance folders and reads and writes their settings.</param>
+    /// <param name="configService">Provides the number of backups to keep.</param>
+    /// <param name="trashService">Receives backups deleted by the user.</param>
+    public InstanceBackupService(string appDir, IInstanceService instanceService, IConfigService configService,
+        ITrashService trashService)
+    {
+        _backupsDir = Path.Combine(appDir, "Backups", "Instances");
+        _instanceService = instanceService;
+        _configService = configService;
+        _trashService = trashService;
+    }
+
+    /// <inheritdoc/>
+    public Task<InstanceBackupInfo?> BackupInstanceAsync(string instanceId) => Task.Run(() =>
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath)) return null;
+
+        using var backupLock = InstanceLock.Acquire(instancePath, InstanceLock.Backup, "backing up the instance");
+
+        var dir = Path.Combine(_backupsDir, instanceId);
+        Directory.CreateDirectory(dir);
+        var stamp = $"{DateTime.Now:yyyy-MM-dd_HH-mm-ss}";
+        var archivePath = Path.Combine(dir, stamp + ".zip");
+        // Backups taken within the same second get a counter instead of replacing each other
+        for (int n = 2; File.Exists(archivePath); n++) archivePath = Path.Combine(dir, $"{stamp}-{n}.zip");
+        var tempPath = archivePath + ".tmp";
+
+        try
+        {
+            using (var zip = ZipFile.Open(tempPath, ZipArchiveMode.Create))
+            {
+                var metaPath = Path.Combine(instancePath, MetaFileName);
+                if (File.Exists(metaPath)) zip.CreateEntryFromFile(metaPath, MetaFileName, CompressionLevel.Optimal);
+
+                var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+                if (Directory.Exists(userData))
+                {
+                    foreach (var file in Directory.EnumerateFiles(userData, "*", SearchOption.AllDirectories))
+                    {
+                        var entryName = UserDataFolder + "/" + Path.GetRelativePath(userData, file).Replace('\\', '/');
+                        zip.CreateEntryFromFile(file, entryName, CompressionLevel.Optimal);
+                    }
+                }
+            }
+            File.Move(tempPath, archivePath);
+        }
+        catch
+        {
+            try { File.Delete(tempPath); } catch { /* ignore */ }
+            throw;
+        }
+
+        Logger.Success("Backup", $"Backed up instance {instanceId} to {archivePath}");
+        ApplyRetention(instanceId);
+        return ToInfo(instanceId, new FileInfo(archivePath));
+    });
+
+    /// <inheritdoc/>
+    public List<InstanceBackupInfo> GetInstanceBackups(string instanceId)
+    {
+        var dir = GetBackupDir(instanceId);
+        if (dir == null || !Directory.Exists(dir)) return [];
+
+        return new DirectoryInfo(dir).EnumerateFiles("*.zip")
+            .Select(f => ToInfo(instanceId, f))
+            .OrderByDescending(b => b.CreatedAt)
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public Task<bool> RestoreInstanceBackupAsync(string instanceId, string fileName) => Task.Run(() =>
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var archivePath = GetBackupPath(instanceId, fileName);
+        if (string.IsNullOrEmpty(instancePath) || archivePath == null || !File.Exists(archivePath)) return false;
+
+        using var restoreLock = InstanceLock.Acquire(instancePath, InstanceLock.Modifying, "restoring a backup");
+
+        var extractDir = Path.Combine(instancePath, ".restore-tmp");
+        var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+        var previousUserData = userData + ".restore-old";
+        try
+        {
+            if (Directory.Exists(extractDir)) Directory.Delete(extractDir, true);
+            ZipFile.ExtractToDirectory(archivePath, extractDir);
+
+            // Swap UserData so a failed move leaves the current one in place
+            var restoredUserData = Path.Combine(extractDir, UserDataFolder);
+            if (Directory.Exists(restoredUserData))
+            {
+                if (Directory.Exists(previousUserData)) Directory.Delete(previousUserData, true);
+                if (Directory.Exists(userData)) Directory.Move(userData, previousUserData);
+                try
+                {
+                    Directory.Move(restoredUserData, userData);
+                }
+                catch
+                {
+                    if (Directory.Exists(previousUserData) && !Directory.Exists(userData))
+                        Directory.Move(previousUserData, userData);
+                    throw;
+                }
+                try { if (Directory.Exists(previousUserData)) Directory.Delete(previousUserData, true); } catch { /* ignore */ }
+            }
+
+            RestoreSettings(instancePath, Path.Combine(extractDir, MetaFileName));
+            Logger.Success("Backup", $"Restored instance {instanceId} from {fileName}");
+            LongPaths.WarnIfTooLong(userData, "Backup restore");
+            return true;
+        }
+        finally
+        {
+            try { if (Directory.Exists(extractDir)) Directory.Delete(extractDir, true); } catch { /* ignore */ }
+        }
+    });
+
+    /// <inheritdoc/>
+    public bool DeleteInstanceBackup(string instanceId, string fileName)
+    {
+        var archivePath = GetBackupPath(instanceId, fileName);
+        if (archivePath == null || !File.Exists(archivePath)) return false;
+
+        _trashService.MoveToTrash(TrashEntry.InstanceBackup, archivePath, instanceId);
+        Logger.Info("Backup", $"Deleted backup {fileName} of instance {instanceId}");
+        return true;
+    }
+
+    /// <summary>
+    /// Takes the settings from the backup's <c>meta.json</c>, keeping what describes the installed game files.
+    /// </summary>
+    private void RestoreSettings(string instancePath, string backupMetaPath)
+    {
+        if (!File.Exists(backupMetaPath)) return;
+
+        var current = _instanceService.GetInstanceMeta(instancePath);
+        var restored = JsonSerializer.Deserialize<InstanceMeta>(File.ReadAllText(backupMetaPath),
+            new JsonSerializerOptions { PropertyNameCaseInsensitive = true });
+        if (current == null || restored == null) return;
+
+        restored.Id = current.Id;
+        restored.Name = current.Name;
+        restored.Branch = current.Branch;
+        restored.Version = current.Version;
+        restored.IsLatest = current.IsLatest;
+        restored.CreatedAt = current.CreatedAt;
+        restored.LastPlayedAt = current.LastPlayedAt;
+        _instanceService.SaveInstanceMeta(instancePath, restored);
+    }
+
+    private void ApplyRetention(string instanceId)
+    {
+        var keep = Math.Max(1, _configService.Configuration.InstanceBackupRetention);
+        foreach (var old in GetInstanceBackups(instanceId).Skip(keep))
+        {
+            try
+            {
+                File.Delete(old.Path);
+                Logger.Info("Backup", $"Deleted old backup {old.FileName} of instance {instanceId}");
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Backup", $"Could not delete old backup {old.FileName}: {ex.Message}");
+            }
+        }
+    }
+
+    private string? GetBackupDir(string instanceId) =>
+        _instanceService.FindInstanceById(instanceId) == null ? null : Path.Combine(_backupsDir, instanceId);
+
+    /// <summary>
+    /// Resolves a backup file, refusing names that are not plain archive names.
+    /// </summary>
+    private string? GetBackupPath(string instanceId, string fileName)
+    {
+        var dir = GetBackupDir(instanceId);
+        if (dir == null || string.IsNullOrWhiteSpace(fileName) || Path.GetFileName(fileName) != fileName ||
+            !fileName.EndsWith(".zip", StringComparison.OrdinalIgnoreCase))
+        {
+            Logger.Warning("Backup", $"Blocked invalid backup name: {fileName}");
+            return null;
+        }
+        return Path.Combine(dir, fileName);
+    }
+
+    private static InstanceBackupInfo ToInfo(string instanceId, FileInfo file) => new()
+    {
+        FileName = file.Name,
+        Path = file.FullName,
+        InstanceId = instanceId,
+        CreatedAt = file.LastWriteTime,
+        SizeBytes = file.Length
+    };
+}
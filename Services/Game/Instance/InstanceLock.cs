@@ -0,0 +1,311 @@
+using System.Diagnostics;
+using System.Runtime.InteropServices;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// A lock on an instance folder, held while the game runs from it or while the launcher changes it.
+/// </summary>
+/// <remarks>
+/// The lock is the <c>.hyprism.lock</c> file in the instance folder, so other launcher processes honor it
+/// too. A lock whose process is gone, or was taken on another machine, is stale and replaced.
+/// Inside this process, <see cref="Modifying"/> locks are shared (mod operations on one instance already
+/// queue on the mod manifest), every other combination conflicts. Launches hand their lock to the game
+/// process with <see cref="TransferTo"/> so it stays valid if the launcher exits before the game.
+/// </remarks>
+public sealed class InstanceLock : IDisposable
+{
+    public const string FileName = ".hyprism.lock";
+
+    public const string Running = "running";
+    public const string Installing = "installing";
+    public const string Modifying = "modifying";
+    public const string Backup = "backup";
+
+    /// <summary>
+    /// An unreadable lock file this young is probably still being written by its owner.
+    /// </summary>
+    private static readonly TimeSpan WriteGrace = TimeSpan.FromSeconds(5);
+
+    private static readonly StringComparer PathComparer =
+        RuntimeInformation.IsOSPlatform(OSPlatform.Linux) ? StringComparer.Ordinal : StringComparer.OrdinalIgnoreCase;
+
+    private static readonly Dictionary<string, HeldLock> Held = new(PathComparer);
+
+    private sealed class HeldLock
+    {
+        public required string InstancePath { get; init; }
+        public required InstanceLockInfo Info { get; init; }
+        public int Count { get; set; }
+    }
+
+    private readonly string _key;
+    private bool _released;
+
+    private InstanceLock(string key)
+    {
+        _key = key;
+    }
+
+    /// <summary>
+    /// Takes the lock of an instance.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="kind">One of <see cref="Running"/>, <see cref="Installing"/>, <see cref="Modifying"/>, <see cref="Backup"/>.</param>
+    /// <param name="operation">Short description for logs.</param>
+    /// <exception cref="InstanceLockedException">Another operation or the game holds the lock.</exception>
+    public static InstanceLock Acquire(string instancePath, string kind, string operation)
+    {
+        var key = GetKey(instancePath);
+        lock (Held)
+        {
+            if (Held.TryGetValue(key, out var held))
+            {
+                if (held.Info.Kind != Modifying || kind != Modifying)
+                    throw new InstanceLockedException(instancePath, held.Info);
+
+                held.Count++;
+                return new InstanceLock(key);
+            }
+
+            var info = new InstanceLockInfo
+            {
+                Kind = kind,
+                Operation = operation,
+                ProcessId = Environment.ProcessId,
+                Host = Environment.MachineName,
+                Since = DateTime.UtcNow
+            };
+            CreateLockFile(instancePath, info);
+            Held[key] = new HeldLock { InstancePath = instancePath, Info = info, Count = 1 };
+            return new InstanceLock(key);
+        }
+    }
+
+    /// <summary>
+    /// Gets who holds the lock of an instance.
+    /// </summary>
+    /// <returns>The holder, or <c>null</c> if the instance is free.</returns>
+    public static InstanceLockInfo? GetHolder(string instancePath)
+    {
+        lock (Held)
+        {
+            if (Held.TryGetValue(GetKey(instancePath), out var held)) return held.Info;
+        }
+
+        var path = Path.Combine(instancePath, FileName);
+        if (!File.Exists(path)) return null;
+
+        var info = ReadLockFile(path);
+        return info != null && IsHolderAlive(info) ? info : null;
+    }
+
+    /// <summary>
+    /// Gets the holder recorded in a lock file whose process is gone, such as the lock of an install
+    /// the launcher did not finish because it crashed or was killed.
+    /// </summary>
+    /// <returns>The stale holder, or <c>null</c> if the instance is free or a live process holds it.</returns>
+    public static InstanceLockInfo? GetStaleHolder(string instancePath)
+    {
+        lock (Held)
+        {
+            if (Held.ContainsKey(GetKey(instancePath))) return null;
+        }
+
+        var path = Path.Combine(instancePath, FileName);
+        if (!File.Exists(path)) return null;
+
+        var info = ReadLockFile(path);
+        return info != null && !IsHolderAlive(info) ? info : null;
+    }
+
+    /// <summary>
+    /// Removes the lock file of an instance if its holder is gone.
+    /// </summary>
+    public static void RemoveStale(string instancePath)
+    {
+        if (GetStaleHolder(instancePath) == null) return;
+
+        try
+        {
+            File.Delete(Path.Combine(instancePath, FileName));
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            Logger.Warning("InstanceLock", $"Failed to remove the stale lock of {instancePath}: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Throws if anything holds the lock of an instance, without taking it.
+    /// </summary>
+    /// <exception cref="InstanceLockedException">The instance is locked.</exception>
+    public static void ThrowIfLocked(string instancePath)
+    {
+        var holder = GetHolder(instancePath);
+        if (holder != null) throw new InstanceLockedException(instancePath, holder);
+    }
+
+    /// <summary>
+    /// Makes the game process the holder of this lock, as a <see cref="Running"/> lock.
+    /// </summary>
+    /// <param name="processId">The game process ID.</param>
+    public void TransferTo(int processId)
+    {
+        lock (Held)
+        {
+            if (_released || !Held.TryGetValue(_key, out var held)) return;
+
+            held.Info.Kind = Running;
+            held.Info.ProcessId = processId;
+            held.Info.Since = DateTime.UtcNow;
+            try
+            {
+                WriteLockFile(Path.Combine(held.InstancePath, FileName), held.Info);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("InstanceLock", $"Failed to hand the lock of {held.InstancePath} to the game: {ex.Message}");
+            }
+        }
+    }
+
+    /// <summary>
+    /// Releases the lock; the file is removed once the last shared holder releases it.
+    /// </summary>
+    public void Dispose()
+    {
+        lock (Held)
+        {
+            if (_released) return;
+            _released = true;
+
+            if (!Held.TryGetValue(_key, out var held) || --held.Count > 0) return;
+            Held.Remove(_key);
+
+            try
+            {
+                File.Delete(Path.Combine(held.InstancePath, FileName));
+            }
+            catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+            {
+                Logger.Warning("InstanceLock", $"Failed to remove the lock of {held.InstancePath}: {ex.Message}");
+            }
+        }
+    }
+
+    private static void CreateLockFile(string instancePath, InstanceLockInfo info)
+    {
+        var path = Path.Combine(instancePath, FileName);
+        Directory.CreateDirectory(instancePath);
+
+        // Second attempt after removing a stale lock
+        for (var attempt = 0; ; attempt++)
+        {
+            try
+            {
+                using (var stream = new FileStream(path, FileMode.CreateNew, FileAccess.Write, FileShare.None))
+                    JsonSerializer.Serialize(stream, info);
+                return;
+            }
+            catch (IOException) when (attempt == 0 && File.Exists(path))
+            {
+                var holder = ReadLockFile(path);
+                if (holder == null && DateTime.UtcNow - File.GetLastWriteTimeUtc(path) < WriteGrace)
+                    throw new InstanceLockedException(instancePath, new InstanceLockInfo { Kind = Modifying, Operation = "unknown" });
+                if (holder != null && IsHolderAlive(holder))
+                    throw new InstanceLockedException(instancePath, holder);
+
+                Logger.Info("InstanceLock", $"Replacing stale {holder?.Kind ?? "unreadable"} lock in {instancePath}");
+                File.Delete(path);
+            }
+        }
+    }
+
+    private static void WriteLockFile(string path, InstanceLockInfo info)
+    {
+        File.WriteAllText(path + ".tmp", JsonSerializer.Serialize(info));
+        File.Move(path + ".tmp", path, true);
+    }
+
+    private static InstanceLockInfo? ReadLockFile(string path)
+    {
+        try
+        {
+            return JsonSerializer.Deserialize<InstanceLockInfo>(File.ReadAllText(path));
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException or JsonException)
+        {
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Whether the process recorded in a lock file (held by another launcher, or a game it started) still runs.
+    /// </summary>
+    private static bool IsHolderAlive(InstanceLockInfo info)
+    {
+        if (!string.Equals(info.Host, Environment.MachineName, StringComparison.OrdinalIgnoreCase)) return false;
+
+        // Our own locks are all in Held; this one is left over from an earlier run that had the same ID
+        if (info.ProcessId == Environment.ProcessId) return false;
+
+        try
+        {
+            using var process = Process.GetProcessById(info.ProcessId);
+            if (process.HasExited) return false;
+            return process.StartTime.ToUniversalTime() <= info.Since.AddSeconds(1);
+        }
+        catch (ArgumentException)
+        {
+            return false;
+        }
+        catch (Exception ex) when (ex is InvalidOperationException or System.ComponentModel.Win32Exception or NotSupportedException)
+        {
+            // No access to the process details; assume it is the holder
+            return true;
+        }
+    }
+
+    private static string GetKey(string instancePath) =>
+        Path.TrimEndingDirectorySeparator(Path.GetFullPath(instancePath));
+}
+
+/// <summary>
+/// Thrown when an instance is locked by the running game or another operation.
+/// </summary>
+public class InstanceLockedException : Exception
+{
+    public string InstancePath { get; }
+    public InstanceLockInfo Holder { get; }
+
+    /// <summary>
+    /// Message ID for the user; one per lock kind.
+    /// </summary>
+    public string MessageKey { get; }
+
+    public InstanceLockedException(string instancePath, InstanceLockInfo holder)
+        : this(instancePath, holder, GetMessageKey(holder.Kind))
+    {
+    }
+
+    private InstanceLockedException(string instancePath, InstanceLockInfo holder, string messageKey)
+        : base(MessageCatalog.Format(messageKey))
+    {
+        InstancePath = instancePath;
+        Holder = holder;
+        MessageKey = messageKey;
+    }
+
+    private static string GetMessageKey(string kind) => kind switch
+    {
+        InstanceLock.Running => MessageCatalog.ErrorInstanceRunning,
+        InstanceLock.Installing => MessageCatalog.ErrorInstanceInstalling,
+        InstanceLock.Backup => MessageCatalog.ErrorInstanceBackingUp,
+        _ => MessageCatalog.ErrorInstanceModifying
+    };
+}
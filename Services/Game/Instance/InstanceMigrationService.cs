@@ -0,0 +1,228 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Mod;
+using HyPrism.Services.Game.Settings;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Copies worlds (<c>UserData/Saves</c>), mods and settings files between instances.
+/// </summary>
+/// <remarks>
+/// Mods are checked only when a target game version label is given. A CurseForge mod whose installed
+/// file lists that version (or lists none) is copied as is; otherwise the newest file of the mod that
+/// lists it is downloaded into the target instead. Mods with no such file are reported and left out.
+/// </remarks>
+public class InstanceMigrationService : IInstanceMigrationService
+{
+    private const int FileLookupPageSize = 50;
+
+    private readonly IInstanceService _instanceService;
+    private readonly IModService _modService;
+    private readonly IGameSettingsService _gameSettingsService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="InstanceMigrationService"/> class.
+    /// </summary>
+    /// <param name="instanceService">Resolves instance folders.</param>
+    /// <param name="modService">Reads mod manifests and looks up CurseForge files.</param>
+    /// <param name="gameSettingsService">Copies game settings files.</param>
+    public InstanceMigrationService(IInstanceService instanceService, IModService modService, IGameSettingsService gameSettingsService)
+    {
+        _instanceService = instanceService;
+        _modService = modService;
+        _gameSettingsService = gameSettingsService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<InstanceMigrationReport> MigrateAsync(InstanceMigrationOptions options, CancellationToken ct = default)
+    {
+        if (options.SourceId == options.TargetId)
+            throw new ArgumentException("Source and target must be different instances");
+
+        var sourcePath = _instanceService.GetInstancePathById(options.SourceId);
+        var targetPath = _instanceService.GetInstancePathById(options.TargetId);
+        if (string.IsNullOrEmpty(sourcePath) || !Directory.Exists(sourcePath))
+            throw new ArgumentException($"Instance {options.SourceId} not found");
+        if (string.IsNullOrEmpty(targetPath) || !Directory.Exists(targetPath))
+            throw new ArgumentException($"Instance {options.TargetId} not found");
+
+        // Nested mod installs into the target share this lock
+        using var targetLock = options.DryRun
+            ? null
+            : InstanceLock.Acquire(targetPath, InstanceLock.Modifying, "migrating into the instance");
+
+        var report = new InstanceMigrationReport { DryRun = options.DryRun };
+        Logger.Info("Migration", $"{(options.DryRun ? "Planning" : "Starting")} migration {options.SourceId} -> {options.TargetId}");
+
+        if (options.Worlds)
+            MigrateWorlds(sourcePath, targetPath, options, report, ct);
+
+        if (options.Mods)
+            await MigrateModsAsync(sourcePath, targetPath, options, report, ct);
+
+        if (options.Settings)
+        {
+            report.SettingsFiles = _gameSettingsService.GetSettingsFiles(sourcePath);
+            if (!options.DryRun && report.SettingsFiles.Count > 0)
+                _gameSettingsService.CopySettings(sourcePath, targetPath, report.SettingsFiles);
+        }
+
+        Logger.Info("Migration", $"Migration {options.SourceId} -> {options.TargetId}: {report.WorldsCopied.Count} world(s), " +
+            $"{report.ModsCopied.Count + report.ModsSwitched.Count} mod(s), {report.SettingsFiles.Count} settings file(s), " +
+            $"{report.IncompatibleMods.Count} incompatible mod(s)");
+        return report;
+    }
+
+    private static void MigrateWorlds(string sourcePath, string targetPath, InstanceMigrationOptions options,
+        InstanceMigrationReport report, CancellationToken ct)
+    {
+        var sourceSaves = Path.Combine(sourcePath, "UserData", "Saves");
+        var targetSaves = Path.Combine(targetPath, "UserData", "Saves");
+        if (!Directory.Exists(sourceSaves)) return;
+
+        foreach (var world in Directory.GetDirectories(sourceSaves))
+        {
+            ct.ThrowIfCancellationRequested();
+            var name = Path.GetFileName(world);
+            var destination = Path.Combine(targetSaves, name);
+
+            if (Directory.Exists(destination) && !options.OverwriteWorlds)
+            {
+                report.WorldsSkipped.Add(name);
+                continue;
+            }
+
+            if (!options.DryRun)
+            {
+                try
+                {
+                    UtilityService.CopyDirectory(world, destination, true);
+                }
+                catch (Exception ex)
+                {
+                    report.Errors.Add($"World {name}: {ex.Message}");
+                    continue;
+                }
+            }
+            report.WorldsCopied.Add(name);
+        }
+    }
+
+    private async Task MigrateModsAsync(string sourcePath, string targetPath, InstanceMigrationOptions options,
+        InstanceMigrationReport report, CancellationToken ct)
+    {
+        var sourceMods = _modService.GetInstanceInstalledMods(sourcePath);
+        var targetMods = _modService.GetInstanceInstalledMods(targetPath);
+        var gameVersion = options.GameVersion?.Trim();
+        report.CompatibilityChecked = !string.IsNullOrEmpty(gameVersion);
+
+        // Game versions of the files installed in the source, in one bulk lookup
+        var installedFiles = new Dictionary<int, CurseForgeFile>();
+        if (report.CompatibilityChecked)
+        {
+            var fileIds = sourceMods
+                .Select(m => int.TryParse(m.FileId, out var id) ? id : 0)
+                .Where(id => id > 0);
+            foreach (var file in await _modService.GetFilesByIdsAsync(fileIds, ct))
+                installedFiles[file.Id] = file;
+        }
+
+        var toCopy = new List<InstalledMod>();
+        foreach (var mod in sourceMods)
+        {
+            ct.ThrowIfCancellationRequested();
+
+            if (targetMods.Any(t => t.Id == mod.Id
+                || (!string.IsNullOrEmpty(mod.CurseForgeId) && t.CurseForgeId == mod.CurseForgeId)))
+            {
+                report.ModsSkipped.Add(mod.Name);
+                continue;
+            }
+
+            if (!report.CompatibilityChecked || string.IsNullOrEmpty(mod.CurseForgeId)
+                || (int.TryParse(mod.FileId, out var fileId) && installedFiles.TryGetValue(fileId, out var installed)
+                    && IsCompatible(installed.GameVersions, gameVersion!)))
+            {
+                toCopy.Add(mod);
+                continue;
+            }
+
+            var files = await _modService.GetModFilesAsync(mod.CurseForgeId, 0, FileLookupPageSize);
+            var replacement = files.Files
+                .Where(f => IsCompatible(f.GameVersions, gameVersion!))
+                .OrderByDescending(f => f.FileDate, StringComparer.Ordinal)
+                .FirstOrDefault();
+
+            if (replacement == null)
+            {
+                report.IncompatibleMods.Add(new MigrationModIssue
+                {
+                    Id = mod.Id,
+                    Name = mod.Name,
+                    Reason = $"No file for game version {gameVersion}"
+                });
+                continue;
+            }
+
+            if (!options.DryRun && !await _modService.InstallModFileToInstanceAsync(mod.CurseForgeId, replacement.Id, targetPath))
+            {
+                report.IncompatibleMods.Add(new MigrationModIssue
+                {
+                    Id = mod.Id,
+                    Name = mod.Name,
+                    Reason = $"Download of {replacement.FileName} failed"
+                });
+                continue;
+            }
+            report.ModsSwitched.Add(mod.Name);
+        }
+
+        if (toCopy.Count == 0) return;
+        if (options.DryRun)
+        {
+            report.ModsCopied.AddRange(toCopy.Select(m => m.Name));
+            return;
+        }
+
+        var sourceDir = Path.Combine(sourcePath, "UserData", "Mods");
+        var targetDir = Path.Combine(targetPath, "UserData", "Mods");
+        Directory.CreateDirectory(targetDir);
+
+        // Switched mods were added to the target manifest by the install above; re-read it
+        var manifest = _modService.GetInstanceInstalledMods(targetPath);
+        foreach (var mod in toCopy)
+        {
+            try
+            {
+                if (!string.IsNullOrEmpty(mod.FileName))
+                {
+                    var file = Path.Combine(sourceDir, mod.FileName);
+                    if (!File.Exists(file))
+                    {
+                        report.Errors.Add($"Mod {mod.Name}: file {mod.FileName} not found");
+                        continue;
+                    }
+                    File.Copy(file, Path.Combine(targetDir, mod.FileName), true);
+                }
+
+                manifest.RemoveAll(m => m.Id == mod.Id);
+                manifest.Add(mod);
+                report.ModsCopied.Add(mod.Name);
+            }
+            catch (Exception ex)
+            {
+                report.Errors.Add($"Mod {mod.Name}: {ex.Message}");
+            }
+        }
+
+        await _modService.SaveInstanceModsAsync(targetPath, manifest);
+    }
+
+    /// <summary>
+    /// A file that lists no game versions is assumed to work with any.
+    /// </summary>
+    private static bool IsCompatible(List<string>? gameVersions, string gameVersion) =>
+        gameVersions == null || gameVersions.Count == 0
+        || gameVersions.Any(v => string.Equals(v.Trim(), gameVersion, StringComparison.OrdinalIgnoreCase));
+}
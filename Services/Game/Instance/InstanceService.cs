@@ -3,6 +3,7 @@ using System.Runtime.InteropServices;
 using System.Text.Encodings.Web;
 using System.Text.Json;
 using HyPrism.Models;
+using HyPrism.Services.Core.Platform;
 
 namespace HyPrism.Services.Game.Instance;
 
@@ -97,14 +98,17 @@ public class InstanceService : IInstanceService
 
     /// <summary>
     /// Resolve version to actual number. Returns 0 if not found.
-    /// Checks in order: provided version > config.SelectedVersion > latest.json > local folders
+    /// Checks in order: provided version > config.SelectedVersion (same branch only) > latest.json > local folders
     /// </summary>
     public int ResolveVersionOrLatest(string branch, int version)
     {
         var config = GetConfig();
         if (version > 0) return version;
         #pragma warning disable CS0618 // Backward compatibility: SelectedVersion and VersionType kept for migration
-        if (config.SelectedVersion > 0) return config.SelectedVersion;
+        // The legacy selection belongs to one branch; don't apply a release pick to pre-release
+        if (config.SelectedVersion > 0 && (string.IsNullOrWhiteSpace(branch)
+            || NormalizeVersionType(branch) == NormalizeVersionType(config.VersionType)))
+            return config.SelectedVersion;
 
         var info = LoadLatestInfo(branch);
         if (info?.Version > 0) return info.Version;
@@ -261,6 +265,7 @@ public class InstanceService : IInstanceService
 
     /// <summary>
     /// Load latest instance info from latest.json.
+    /// Records written for another branch (e.g. a copied latest folder) are ignored.
     /// </summary>
     public LatestInstanceInfo? LoadLatestInfo(string branch)
     {
@@ -269,7 +274,14 @@ public class InstanceService : IInstanceService
             var path = GetLatestInfoPath(branch);
             if (!File.Exists(path)) return null;
             var json = File.ReadAllText(path);
-            return JsonSerializer.Deserialize<LatestInstanceInfo>(json, JsonOptions);
+            var info = JsonSerializer.Deserialize<LatestInstanceInfo>(json, JsonOptions);
+            if (info != null && !string.IsNullOrEmpty(info.Branch)
+                && NormalizeVersionType(info.Branch) != NormalizeVersionType(branch))
+            {
+                Logger.Warning("Instance", $"Ignoring latest.json for {info.Branch} found in the {branch} latest instance");
+                return null;
+            }
+            return info;
         }
         catch
         {
@@ -285,7 +297,12 @@ public class InstanceService : IInstanceService
         try
         {
             Directory.CreateDirectory(GetBranchPath(branch));
-            var info = new LatestInstanceInfo { Version = version, UpdatedAt = DateTime.UtcNow };
+            var info = new LatestInstanceInfo
+            {
+                Version = version,
+                Branch = NormalizeVersionType(branch),
+                UpdatedAt = DateTime.UtcNow
+            };
             var json = JsonSerializer.Serialize(info, new JsonSerializerOptions(JsonOptions) { WriteIndented = true });
             File.WriteAllText(GetLatestInfoPath(branch), json);
         }
@@ -295,6 +312,24 @@ public class InstanceService : IInstanceService
         }
     }
 
+    /// <summary>
+    /// Gets the installed latest version of every branch that has one.
+    /// </summary>
+    public Dictionary<string, LatestInstanceInfo> GetInstalledLatestVersions()
+    {
+        var result = new Dictionary<string, LatestInstanceInfo>();
+        foreach (var branch in new[] { "release", "pre-release" })
+        {
+            var info = LoadLatestInfo(branch);
+            if (info != null)
+            {
+                info.Branch = branch;
+                result[branch] = info;
+            }
+        }
+        return result;
+    }
+
     /// <summary>
     /// Migrate legacy data from old launcher versions.
     /// Merges config settings and copies instance directories.
@@ -1057,6 +1092,8 @@ public class InstanceService : IInstanceService
             
             if (Directory.Exists(versionPath))
             {
+                // Never delete files the game or another operation is using
+                InstanceLock.ThrowIfLocked(versionPath);
                 Directory.Delete(versionPath, true);
             }
             
@@ -1071,7 +1108,7 @@ public class InstanceService : IInstanceService
             
             return true;
         }
-        catch (Exception ex)
+        catch (Exception ex) when (ex is not InstanceLockedException)
         {
             Logger.Error("Game", $"Error deleting game: {ex.Message}");
             return false;
@@ -1106,6 +1143,7 @@ public class InstanceService : IInstanceService
                     string instanceId = "";
                     int version = -1;
                     bool isLatest = false;
+                    InstanceMeta? folderMeta = null;
                     var metaPath = Path.Combine(folder, "meta.json");
                     
                     if (File.Exists(metaPath))
@@ -1116,6 +1154,7 @@ public class InstanceService : IInstanceService
                             var meta = JsonSerializer.Deserialize<InstanceMeta>(json, JsonOptions);
                             if (meta != null)
                             {
+                                folderMeta = meta;
                                 instanceId = meta.Id ?? "";
                                 customName = meta.Name;
                                 version = meta.Version;
@@ -1221,6 +1260,7 @@ public class InstanceService : IInstanceService
 
                     // Perform deep validation
                     var validationResult = ValidateGameIntegrity(folder);
+                    var artwork = InstanceArtworkService.Resolve(folder, folderMeta);
 
                     results.Add(new InstalledInstance
                     {
@@ -1234,7 +1274,9 @@ public class InstanceService : IInstanceService
                         IsValid = validationResult.Status == InstanceValidationStatus.Valid,
                         ValidationStatus = validationResult.Status,
                         ValidationDetails = validationResult.Details,
-                        CustomName = customName
+                        CustomName = customName,
+                        Icon = artwork.Icon,
+                        Banner = artwork.Banner
                     });
                 }
             }
@@ -1493,6 +1535,84 @@ public class InstanceService : IInstanceService
         SetInstanceNameInternal(instancePath, customName, instanceId);
     }
 
+    /// <inheritdoc/>
+    public string GetExtraClientArgs(string instanceId)
+    {
+        var instancePath = GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath)) return "";
+        return GetInstanceMeta(instancePath)?.ExtraClientArgs ?? "";
+    }
+
+    /// <inheritdoc/>
+    public bool SetExtraClientArgs(string instanceId, string? extraArgs)
+    {
+        var instancePath = GetInstancePathById(instanceId);
+        var meta = string.IsNullOrEmpty(instancePath) ? null : GetInstanceMeta(instancePath);
+        if (meta == null)
+        {
+            Logger.Warning("InstanceService", $"Instance not found by ID: {instanceId}");
+            return false;
+        }
+
+        meta.ExtraClientArgs = string.IsNullOrWhiteSpace(extraArgs) ? null : extraArgs.Trim();
+        SaveInstanceMeta(instancePath!, meta);
+        Logger.Info("InstanceService", $"Extra client arguments of {instanceId}: {meta.ExtraClientArgs ?? "(none)"}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public string GetDisplayServer(string instanceId)
+    {
+        var instancePath = GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath)) return DisplayServerEnvironment.Auto;
+        return GetInstanceMeta(instancePath)?.DisplayServer ?? DisplayServerEnvironment.Auto;
+    }
+
+    /// <inheritdoc/>
+    public bool SetDisplayServer(string instanceId, string mode)
+    {
+        if (!DisplayServerEnvironment.IsValid(mode))
+            throw new ArgumentException($"Unknown display server: {mode}", nameof(mode));
+
+        var instancePath = GetInstancePathById(instanceId);
+        var meta = string.IsNullOrEmpty(instancePath) ? null : GetInstanceMeta(instancePath);
+        if (meta == null)
+        {
+            Logger.Warning("InstanceService", $"Instance not found by ID: {instanceId}");
+            return false;
+        }
+
+        meta.DisplayServer = mode == DisplayServerEnvironment.Auto ? null : mode;
+        SaveInstanceMeta(instancePath!, meta);
+        Logger.Info("InstanceService", $"Display server of {instanceId}: {mode}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public string? GetGameVersion(string instanceId)
+    {
+        var instancePath = GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath)) return null;
+        return GetInstanceMeta(instancePath)?.GameVersion;
+    }
+
+    /// <inheritdoc/>
+    public bool SetGameVersion(string instanceId, string? gameVersion)
+    {
+        var instancePath = GetInstancePathById(instanceId);
+        var meta = string.IsNullOrEmpty(instancePath) ? null : GetInstanceMeta(instancePath);
+        if (meta == null)
+        {
+            Logger.Warning("InstanceService", $"Instance not found by ID: {instanceId}");
+            return false;
+        }
+
+        meta.GameVersion = string.IsNullOrWhiteSpace(gameVersion) ? null : gameVersion.Trim();
+        SaveInstanceMeta(instancePath!, meta);
+        Logger.Info("InstanceService", $"Game version of {instanceId}: {meta.GameVersion ?? "(none)"}");
+        return true;
+    }
+
     private void SetInstanceNameInternal(string instancePath, string? customName, string logIdentifier)
     {
         try
@@ -1653,6 +1773,117 @@ public class InstanceService : IInstanceService
         return meta;
     }
 
+    /// <inheritdoc/>
+    public InstanceMeta AdoptExistingInstall(string sourcePath, string branch, int version, string mode, string? name = null)
+    {
+        mode = mode.ToLowerInvariant();
+        if (mode is not ("move" or "copy" or "link"))
+            throw new ArgumentException($"Unknown adopt mode '{mode}' (expected move, copy or link)");
+
+        var fullSource = Path.GetFullPath(sourcePath);
+        if (!Directory.Exists(fullSource))
+            throw new DirectoryNotFoundException($"Folder not found: {fullSource}");
+
+        // Game files may sit in the folder itself or in a "game" subfolder, like IsClientPresent accepts
+        var gameRoot = new[] { fullSource, Path.Combine(fullSource, "game") }
+            .FirstOrDefault(p => Directory.Exists(Path.Combine(p, "Client")) && IsClientPresent(p));
+        if (gameRoot == null)
+            throw new InvalidOperationException($"No game client found in {fullSource}");
+
+        var instancesRoot = Path.GetFullPath(GetInstanceRoot());
+        if (fullSource.StartsWith(instancesRoot, StringComparison.OrdinalIgnoreCase))
+            throw new InvalidOperationException("The folder is already inside the instances directory");
+
+        bool isLatest = version <= 0;
+        var normalizedBranch = NormalizeVersionType(branch);
+        if (isLatest && IsClientPresent(GetLatestInstancePath(normalizedBranch)))
+            throw new InvalidOperationException($"The {normalizedBranch} latest instance is already installed; pick a version number instead");
+
+        var meta = CreateInstanceMeta(normalizedBranch, Math.Max(version, 0), name ?? $"{normalizedBranch} (adopted)", isLatest);
+        var instancePath = GetInstancePathById(meta.Id) ?? (isLatest ? GetLatestInstancePath(normalizedBranch) : "");
+
+        try
+        {
+            foreach (var entry in Directory.EnumerateFileSystemEntries(gameRoot).ToList())
+            {
+                var entryName = Path.GetFileName(entry);
+                if (entryName.Equals("meta.json", StringComparison.OrdinalIgnoreCase)) continue;
+
+                var destination = Path.Combine(instancePath, entryName);
+                bool isDir = Directory.Exists(entry);
+                // Saves and settings always live in the instance, even for a linked install
+                var entryMode = mode == "link" && entryName.Equals("UserData", StringComparison.OrdinalIgnoreCase) ? "copy" : mode;
+
+                switch (entryMode)
+                {
+                    case "link":
+                        if (isDir) Directory.CreateSymbolicLink(destination, entry);
+                        else File.CreateSymbolicLink(destination, entry);
+                        break;
+                    case "copy":
+                        if (isDir) UtilityService.CopyDirectory(entry, destination, true);
+                        else File.Copy(entry, destination, true);
+                        break;
+                    default:
+                        MoveEntry(entry, destination, isDir);
+                        break;
+                }
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("InstanceService", $"Failed to adopt {fullSource}: {ex.Message}");
+            RemoveAdoptedInstance(meta.Id, instancePath);
+            throw;
+        }
+
+        // An adopted latest instance has no latest.json: its build is unknown, so no differential
+        // patches are applied to it until it is force-updated
+        GameFilesIntegrity.WriteManifest(instancePath, Math.Max(version, 0));
+
+        Logger.Success("InstanceService", $"Adopted game install from {fullSource} as {meta.Id} ({mode})");
+        return meta;
+    }
+
+    private static void MoveEntry(string source, string destination, bool isDir)
+    {
+        try
+        {
+            if (isDir) Directory.Move(source, destination);
+            else File.Move(source, destination);
+        }
+        catch (IOException)
+        {
+            // Different volume: copy, then delete the original
+            if (isDir)
+            {
+                UtilityService.CopyDirectory(source, destination, true);
+                Directory.Delete(source, true);
+            }
+            else
+            {
+                File.Copy(source, destination, true);
+                File.Delete(source);
+            }
+        }
+    }
+
+    private void RemoveAdoptedInstance(string instanceId, string instancePath)
+    {
+        try
+        {
+            if (Directory.Exists(instancePath)) Directory.Delete(instancePath, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("InstanceService", $"Could not remove half-adopted instance folder: {ex.Message}");
+        }
+
+        var config = GetConfig();
+        if (config.Instances?.RemoveAll(i => i.Id == instanceId) > 0)
+            SaveConfig(config);
+    }
+
     /// <inheritdoc/>
     public InstanceInfo? GetSelectedInstance()
     {
@@ -1875,6 +2106,37 @@ public class InstanceService : IInstanceService
         return null;
     }
 
+    /// <inheritdoc/>
+    public InstanceInfo? FindInstanceByReference(string reference)
+    {
+        reference = reference.Trim();
+        if (reference.Length == 0) return null;
+
+        var byId = FindInstanceById(reference);
+        if (byId != null) return byId;
+
+        var slash = reference.IndexOf('/');
+        if (slash > 0)
+        {
+            var versionPart = reference[(slash + 1)..];
+            var version = versionPart.Equals("latest", StringComparison.OrdinalIgnoreCase) ? 0
+                : int.TryParse(versionPart, out var v) ? v : -1;
+            if (version >= 0)
+            {
+                var byVersion = FindInstanceByBranchAndVersion(reference[..slash], version);
+                if (byVersion != null) return byVersion;
+            }
+        }
+
+        // Names are not unique; refuse to guess between several
+        var config = GetConfig();
+        var byName = (config.Instances ?? new List<InstanceInfo>())
+            .Where(i => i.Name.Equals(reference, StringComparison.OrdinalIgnoreCase))
+            .Take(2)
+            .ToList();
+        return byName.Count == 1 ? byName[0] : null;
+    }
+
     /// <inheritdoc/>
     public string CreateInstanceDirectory(string branch, string instanceId)
     {
@@ -0,0 +1,143 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Holds back pre-release sessions until <c>Config.PreReleaseNoticeAcknowledged</c> is set, and keeps
+/// pre-release <c>UserData</c> separate, since worlds saved by a pre-release may not load in release.
+/// </summary>
+/// <remarks>
+/// Every instance normally has its own <c>UserData</c>; it is only shared when a user linked the folders by
+/// hand. Such a link is turned into a copy before a pre-release session, so the original stays untouched.
+/// </remarks>
+public class PreReleaseService : IPreReleaseService
+{
+    private const string PreReleaseBranch = "pre-release";
+
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IWorldService _worldService;
+
+    /// <inheritdoc/>
+    public event Action<PreReleaseNotice>? NoticeRequired;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="PreReleaseService"/> class.
+    /// </summary>
+    /// <param name="configService">Holds the acknowledgement and the instance list.</param>
+    /// <param name="instanceService">Resolves instance folders.</param>
+    /// <param name="worldService">Counts and backs up worlds.</param>
+    public PreReleaseService(IConfigService configService, IInstanceService instanceService, IWorldService worldService)
+    {
+        _configService = configService;
+        _instanceService = instanceService;
+        _worldService = worldService;
+    }
+
+    /// <inheritdoc/>
+    public PreReleaseNotice? GetPendingNotice()
+    {
+        if (_configService.Configuration.PreReleaseNoticeAcknowledged) return null;
+
+        var notice = new PreReleaseNotice();
+        foreach (var instance in ReleaseInstances())
+        {
+            var worldCount = _worldService.GetWorlds(instance.Id).Count;
+            if (worldCount == 0) continue;
+            notice.ReleaseInstances.Add(new PreReleaseWorldSource
+            {
+                InstanceId = instance.Id,
+                InstanceName = instance.Name,
+                WorldCount = worldCount
+            });
+        }
+        return notice;
+    }
+
+    /// <inheritdoc/>
+    public bool CheckBeforeLaunch(string branch)
+    {
+        if (branch != PreReleaseBranch) return true;
+
+        var notice = GetPendingNotice();
+        if (notice == null) return true;
+
+        Logger.Info("PreRelease", "Pre-release session held back until the pre-release notice is acknowledged");
+        NoticeRequired?.Invoke(notice);
+        return false;
+    }
+
+    /// <inheritdoc/>
+    public PreReleaseAcknowledgeResult Acknowledge(bool backupWorlds)
+    {
+        var result = new PreReleaseAcknowledgeResult();
+        if (backupWorlds)
+        {
+            foreach (var instance in ReleaseInstances())
+            {
+                try
+                {
+                    var archive = _worldService.BackupWorlds(instance.Id);
+                    if (archive != null) result.Backups.Add(archive);
+                }
+                catch (Exception ex)
+                {
+                    Logger.Error("PreRelease", $"Could not back up worlds of {instance.Name}: {ex.Message}");
+                    result.Errors.Add(instance.Name);
+                }
+            }
+        }
+
+        if (result.Errors.Count == 0)
+        {
+            _configService.TryUpdate(c => c.PreReleaseNoticeAcknowledged = true);
+            Logger.Info("PreRelease", $"Pre-release notice acknowledged ({result.Backups.Count} world backup(s))");
+        }
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public void EnsureIsolated(string instancePath)
+    {
+        var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+        if (!Directory.Exists(userData)) return;
+
+        var resolved = ResolveDirectory(userData);
+        foreach (var instance in ReleaseInstances())
+        {
+            var releasePath = _instanceService.GetInstancePathById(instance.Id);
+            if (string.IsNullOrEmpty(releasePath)) continue;
+
+            var releaseUserData = _instanceService.GetInstanceUserDataPath(releasePath);
+            if (!Directory.Exists(releaseUserData)
+                || !string.Equals(ResolveDirectory(releaseUserData), resolved, StringComparison.OrdinalIgnoreCase))
+                continue;
+
+            // Copy into whichever side is the link; the folder it points at stays as it is
+            var link = new DirectoryInfo(userData).LinkTarget != null ? userData : releaseUserData;
+            Logger.Warning("PreRelease", $"UserData of {instancePath} is shared with release instance {instance.Name}; copying it to {link}");
+            ReplaceLinkWithCopy(link);
+            return;
+        }
+    }
+
+    private IEnumerable<InstanceInfo> ReleaseInstances() =>
+        (_configService.Configuration.Instances ?? [])
+            .Where(i => !string.Equals(i.Branch, PreReleaseBranch, StringComparison.OrdinalIgnoreCase));
+
+    private static string ResolveDirectory(string path)
+    {
+        var target = new DirectoryInfo(path).ResolveLinkTarget(returnFinalTarget: true);
+        return Path.GetFullPath(target?.FullName ?? path).TrimEnd(Path.DirectorySeparatorChar);
+    }
+
+    private static void ReplaceLinkWithCopy(string linkPath)
+    {
+        var target = ResolveDirectory(linkPath);
+        var tempPath = linkPath + ".isolating";
+        UtilityService.CopyDirectory(target, tempPath, true);
+        Directory.Delete(linkPath);
+        Directory.Move(tempPath, linkPath);
+    }
+}
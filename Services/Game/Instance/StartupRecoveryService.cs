@@ -0,0 +1,291 @@
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Cleans up or resumes what an interrupted install left behind.
+/// </summary>
+/// <remarks>
+/// An install holds an <see cref="InstanceLock.Installing"/> lock on its instance, so a lock of that kind whose
+/// process is gone marks an install that never finished. Without a client the instance is reset to its
+/// <c>UserData</c> and <c>meta.json</c> so the next launch installs it again; with a client an update was cut
+/// off halfway and the instance is reported for repair. Butler's <c>staging-temp</c> folder and temporary
+/// files, Java runtime <c>.staging</c> folders and partial mod downloads are always removed. Partial game
+/// downloads in <c>Cache</c> are kept for <see cref="ResumeWindow"/>, since the download service continues
+/// a partial file where it stopped.
+/// </remarks>
+public class StartupRecoveryService : IStartupRecoveryService
+{
+    private const string StagingDirName = "staging-temp";
+    private static readonly TimeSpan ResumeWindow = TimeSpan.FromDays(7);
+
+    private readonly string _appDir;
+    private readonly IInstanceService _instanceService;
+    private StartupRecoveryReport _lastReport = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="StartupRecoveryService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory, holding <c>Cache</c> and <c>Runtimes</c>.</param>
+    /// <param name="instanceService">Finds the instance folders and tells whether a client is installed.</param>
+    public StartupRecoveryService(string appDir, IInstanceService instanceService)
+    {
+        _appDir = appDir;
+        _instanceService = instanceService;
+    }
+
+    /// <inheritdoc/>
+    public StartupRecoveryReport GetLastReport() => _lastReport;
+
+    /// <inheritdoc/>
+    public StartupRecoveryReport Recover()
+    {
+        var report = new StartupRecoveryReport();
+        var interruptedBranches = new Dictionary<string, DateTime>(StringComparer.OrdinalIgnoreCase);
+
+        foreach (var instancePath in EnumerateInstanceDirs())
+        {
+            try
+            {
+                RecoverInstance(instancePath, report, interruptedBranches);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Recovery", $"Could not check {instancePath}: {ex.Message}");
+            }
+        }
+
+        RecoverDownloads(report, interruptedBranches);
+        RecoverRuntimeStaging(report);
+
+        if (report.Items.Count > 0)
+        {
+            Logger.Info("Recovery",
+                $"Recovered {report.Items.Count} item(s) from an earlier run, freed {report.FreedBytes / 1024 / 1024} MB");
+        }
+
+        _lastReport = report;
+        return report;
+    }
+
+    /// <summary>
+    /// Yields every folder that can be an instance: <c>{root}/{branch}/{instance}</c> and the legacy
+    /// <c>{root}/{branch}-{version}</c> layout.
+    /// </summary>
+    private IEnumerable<string> EnumerateInstanceDirs()
+    {
+        foreach (var root in _instanceService.GetInstanceRootsIncludingLegacy())
+        {
+            string[] children;
+            try
+            {
+                children = Directory.GetDirectories(root);
+            }
+            catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+            {
+                continue;
+            }
+
+            foreach (var child in children)
+            {
+                yield return child;
+
+                string[] instances;
+                try
+                {
+                    instances = Directory.GetDirectories(child);
+                }
+                catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+                {
+                    continue;
+                }
+
+                foreach (var instance in instances)
+                    yield return instance;
+            }
+        }
+    }
+
+    private void RecoverInstance(string instancePath, StartupRecoveryReport report, Dictionary<string, DateTime> interruptedBranches)
+    {
+        // The game or another launcher is using it; anything in there may still be in progress
+        if (InstanceLock.GetHolder(instancePath) != null) return;
+
+        var staleLock = InstanceLock.GetStaleHolder(instancePath);
+        bool interrupted = staleLock?.Kind == InstanceLock.Installing;
+        var stagingDir = Path.Combine(instancePath, StagingDirName);
+        var butlerTemp = Directory.EnumerateFiles(instancePath)
+            .Where(f =>
+            {
+                var name = Path.GetFileName(f);
+                return name.EndsWith(".tmp") || name.StartsWith("sf-");
+            })
+            .ToList();
+        var modParts = Directory.Exists(Path.Combine(instancePath, "UserData", "Mods"))
+            ? Directory.GetFiles(Path.Combine(instancePath, "UserData", "Mods"), "*.part")
+            : [];
+
+        if (!interrupted && !Directory.Exists(stagingDir) && butlerTemp.Count == 0 && modParts.Length == 0) return;
+
+        var meta = _instanceService.GetInstanceMeta(instancePath);
+        RecoveredItem Item(string kind, string action, string path, long size) => new()
+        {
+            Kind = kind,
+            Action = action,
+            Path = path,
+            InstanceId = meta?.Id,
+            InstanceName = meta?.Name,
+            SizeBytes = size
+        };
+
+        if (Directory.Exists(stagingDir) || butlerTemp.Count > 0)
+        {
+            long size = GetSize(stagingDir) + butlerTemp.Sum(GetSize);
+            bool cleaned = TryDelete(stagingDir);
+            foreach (var file in butlerTemp) cleaned &= TryDelete(file);
+            if (cleaned)
+            {
+                report.Items.Add(Item("staging", "cleaned", stagingDir, size));
+                report.FreedBytes += size;
+            }
+        }
+
+        foreach (var part in modParts)
+        {
+            long size = GetSize(part);
+            if (!TryDelete(part)) continue;
+            report.Items.Add(Item("download", "cleaned", part, size));
+            report.FreedBytes += size;
+        }
+
+        if (!interrupted) return;
+
+        if (meta != null && staleLock != null)
+        {
+            var branch = meta.Branch;
+            if (!interruptedBranches.TryGetValue(branch, out var since) || staleLock.Since < since)
+                interruptedBranches[branch] = staleLock.Since;
+        }
+
+        if (_instanceService.IsClientPresent(instancePath))
+        {
+            Logger.Warning("Recovery", $"Update of {instancePath} was interrupted; its game files need a repair");
+            report.Items.Add(Item("update", "repair", instancePath, 0));
+        }
+        else
+        {
+            long size = 0;
+            bool cleaned = true;
+            foreach (var entry in Directory.EnumerateFileSystemEntries(instancePath).ToList())
+            {
+                var name = Path.GetFileName(entry);
+                if (name.Equals("UserData", StringComparison.OrdinalIgnoreCase)
+                    || name.Equals("meta.json", StringComparison.OrdinalIgnoreCase)
+                    || name.Equals(InstanceLock.FileName, StringComparison.OrdinalIgnoreCase))
+                    continue;
+
+                long entrySize = GetSize(entry);
+                if (TryDelete(entry)) size += entrySize;
+                else cleaned = false;
+            }
+            report.FreedBytes += size;
+
+            // Keep the lock so the next start tries again
+            if (!cleaned) return;
+
+            Logger.Info("Recovery", $"Install into {instancePath} was interrupted; removed its partial game files");
+            report.Items.Add(Item("install", "cleaned", instancePath, size));
+        }
+
+        InstanceLock.RemoveStale(instancePath);
+    }
+
+    /// <summary>
+    /// Keeps recent partial downloads and the packages of interrupted sessions for the next install to
+    /// continue from, and removes partial downloads too old to be worth resuming.
+    /// </summary>
+    private void RecoverDownloads(StartupRecoveryReport report, Dictionary<string, DateTime> interruptedBranches)
+    {
+        var cacheDir = Path.Combine(_appDir, "Cache");
+        if (!Directory.Exists(cacheDir)) return;
+
+        foreach (var file in new DirectoryInfo(cacheDir).EnumerateFiles())
+        {
+            try
+            {
+                if (file.Name.EndsWith(".part", StringComparison.OrdinalIgnoreCase))
+                {
+                    if (DateTime.UtcNow - file.LastWriteTimeUtc < ResumeWindow)
+                    {
+                        report.Items.Add(new RecoveredItem { Kind = "download", Action = "resume", Path = file.FullName, SizeBytes = file.Length });
+                        continue;
+                    }
+
+                    long size = file.Length;
+                    file.Delete();
+                    report.Items.Add(new RecoveredItem { Kind = "download", Action = "cleaned", Path = file.FullName, SizeBytes = size });
+                    report.FreedBytes += size;
+                }
+                else if (file.Extension.Equals(".pwr", StringComparison.OrdinalIgnoreCase)
+                         && interruptedBranches.TryGetValue(file.Name.Split('_')[0], out var since)
+                         && file.LastWriteTimeUtc >= since)
+                {
+                    // Written by the interrupted session and possibly cut short; the download service
+                    // checks its size against the server and fetches only what is missing
+                    report.Items.Add(new RecoveredItem { Kind = "download", Action = "resume", Path = file.FullName, SizeBytes = file.Length });
+                }
+            }
+            catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+            {
+                Logger.Warning("Recovery", $"Could not check {file.Name}: {ex.Message}");
+            }
+        }
+    }
+
+    /// <summary>
+    /// Removes Java runtime folders whose extraction never finished.
+    /// </summary>
+    private void RecoverRuntimeStaging(StartupRecoveryReport report)
+    {
+        var runtimesDir = Path.Combine(_appDir, "Runtimes");
+        if (!Directory.Exists(runtimesDir)) return;
+
+        foreach (var dir in Directory.GetDirectories(runtimesDir, "*.staging"))
+        {
+            long size = GetSize(dir);
+            if (!TryDelete(dir)) continue;
+            report.Items.Add(new RecoveredItem { Kind = "staging", Action = "cleaned", Path = dir, SizeBytes = size });
+            report.FreedBytes += size;
+        }
+    }
+
+    private static long GetSize(string path)
+    {
+        try
+        {
+            if (File.Exists(path)) return new FileInfo(path).Length;
+            if (!Directory.Exists(path)) return 0;
+            return new DirectoryInfo(path).EnumerateFiles("*", SearchOption.AllDirectories).Sum(f => f.Length);
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            return 0;
+        }
+    }
+
+    private static bool TryDelete(string path)
+    {
+        try
+        {
+            if (Directory.Exists(path)) Directory.Delete(path, true);
+            else if (File.Exists(path)) File.Delete(path);
+            return true;
+        }
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException)
+        {
+            Logger.Warning("Recovery", $"Could not remove {path}: {ex.Message}");
+            return false;
+        }
+    }
+}
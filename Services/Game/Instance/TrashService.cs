@@ -0,0 +1,258 @@
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Moves deleted worlds and backups to <c>Trash</c> in the launcher data directory and restores them.
+/// </summary>
+/// <remarks>
+/// Every entry is a folder <c>Trash/{id}</c> holding the item under its original name and an
+/// <c>entry.json</c> describing it. Entries older than <see cref="Config.TrashRetentionDays"/> are removed
+/// whenever the trash is listed or something is moved into it.
+/// </remarks>
+public class TrashService : ITrashService
+{
+    private const string EntryFileName = "entry.json";
+
+    private readonly IInstanceService _instanceService;
+    private readonly IConfigService _configService;
+    private readonly string _trashDir;
+    private readonly object _lock = new();
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="TrashService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding <c>Trash</c>.</param>
+    /// <param name="instanceService">Resolves the instance a world is restored into.</param>
+    /// <param name="configService">Provides the retention window.</param>
+    public TrashService(string appDir, IInstanceService instanceService, IConfigService configService)
+    {
+        _trashDir = Path.Combine(appDir, "Trash");
+        _instanceService = instanceService;
+        _configService = configService;
+    }
+
+    /// <inheritdoc/>
+    public TrashEntry MoveToTrash(string kind, string path, string instanceId)
+    {
+        var isDirectory = Directory.Exists(path);
+        if (!isDirectory && !File.Exists(path)) throw new FileNotFoundException("Nothing to move to the trash", path);
+
+        var entry = new TrashEntry
+        {
+            Id = $"{DateTime.Now:yyyyMMdd-HHmmss}-{Guid.NewGuid().ToString("N")[..8]}",
+            Kind = kind,
+            Name = Path.GetFileName(path.TrimEnd(Path.DirectorySeparatorChar, Path.AltDirectorySeparatorChar)),
+            InstanceId = instanceId,
+            OriginalPath = Path.GetFullPath(path),
+            DeletedAt = DateTime.Now,
+            SizeBytes = isDirectory
+                ? new DirectoryInfo(path).EnumerateFiles("*", SearchOption.AllDirectories).Sum(f => f.Length)
+                : new FileInfo(path).Length
+        };
+
+        lock (_lock)
+        {
+            var entryDir = Path.Combine(_trashDir, entry.Id);
+            Directory.CreateDirectory(entryDir);
+            try
+            {
+                MoveItem(path, Path.Combine(entryDir, entry.Name));
+                File.WriteAllText(Path.Combine(entryDir, EntryFileName), JsonSerializer.Serialize(entry));
+            }
+            catch
+            {
+                // Only the empty entry folder is left if the item could not be moved
+                if (!Directory.Exists(Path.Combine(entryDir, entry.Name)) && !File.Exists(Path.Combine(entryDir, entry.Name)))
+                    try { Directory.Delete(entryDir, true); } catch { /* ignore */ }
+                throw;
+            }
+        }
+
+        Logger.Info("Trash", $"Moved {entry.Kind} {entry.Name} of instance {instanceId} to the trash");
+        PurgeExpired();
+        return entry;
+    }
+
+    /// <inheritdoc/>
+    public List<TrashEntry> GetEntries()
+    {
+        PurgeExpired();
+        lock (_lock)
+        {
+            return ReadEntries().OrderByDescending(e => e.DeletedAt).ToList();
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool RestoreFromTrash(string id)
+    {
+        lock (_lock)
+        {
+            var entry = ReadEntry(id);
+            if (entry == null) return false;
+
+            var itemPath = Path.Combine(_trashDir, entry.Id, entry.Name);
+            if (!Directory.Exists(itemPath) && !File.Exists(itemPath)) return false;
+
+            InstanceLock? worldLock = null;
+            try
+            {
+                if (entry.Kind == TrashEntry.World)
+                {
+                    var instancePath = _instanceService.GetInstancePathById(entry.InstanceId);
+                    if (string.IsNullOrEmpty(instancePath))
+                    {
+                        Logger.Warning("Trash", $"Cannot restore world {entry.Name}: instance {entry.InstanceId} no longer exists");
+                        return false;
+                    }
+                    worldLock = InstanceLock.Acquire(instancePath, InstanceLock.Modifying, "restoring a world");
+                }
+
+                var target = GetFreePath(entry.OriginalPath, Directory.Exists(itemPath));
+                Directory.CreateDirectory(Path.GetDirectoryName(target)!);
+                MoveItem(itemPath, target);
+            }
+            finally
+            {
+                worldLock?.Dispose();
+            }
+
+            try { Directory.Delete(Path.Combine(_trashDir, entry.Id), true); } catch { /* ignore */ }
+            Logger.Success("Trash", $"Restored {entry.Kind} {entry.Name} of instance {entry.InstanceId}");
+            return true;
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool DeleteFromTrash(string id)
+    {
+        lock (_lock)
+        {
+            var entry = ReadEntry(id);
+            if (entry == null) return false;
+
+            Directory.Delete(Path.Combine(_trashDir, entry.Id), true);
+            Logger.Info("Trash", $"Deleted {entry.Kind} {entry.Name} from the trash");
+            return true;
+        }
+    }
+
+    /// <inheritdoc/>
+    public int EmptyTrash()
+    {
+        var count = 0;
+        lock (_lock)
+        {
+            foreach (var entry in ReadEntries())
+            {
+                try
+                {
+                    Directory.Delete(Path.Combine(_trashDir, entry.Id), true);
+                    count++;
+                }
+                catch (Exception ex)
+                {
+                    Logger.Warning("Trash", $"Could not delete {entry.Name} from the trash: {ex.Message}");
+                }
+            }
+        }
+        Logger.Info("Trash", $"Emptied the trash ({count} item(s))");
+        return count;
+    }
+
+    private void PurgeExpired()
+    {
+        var cutoff = DateTime.Now.AddDays(-Math.Max(0, _configService.Configuration.TrashRetentionDays));
+        lock (_lock)
+        {
+            foreach (var entry in ReadEntries().Where(e => e.DeletedAt < cutoff))
+            {
+                try
+                {
+                    Directory.Delete(Path.Combine(_trashDir, entry.Id), true);
+                    Logger.Info("Trash", $"Removed {entry.Kind} {entry.Name} after the retention window");
+                }
+                catch (Exception ex)
+                {
+                    Logger.Warning("Trash", $"Could not remove expired {entry.Name}: {ex.Message}");
+                }
+            }
+        }
+    }
+
+    private IEnumerable<TrashEntry> ReadEntries()
+    {
+        if (!Directory.Exists(_trashDir)) yield break;
+
+        foreach (var dir in Directory.GetDirectories(_trashDir))
+        {
+            var entry = ReadEntry(Path.GetFileName(dir));
+            if (entry != null) yield return entry;
+        }
+    }
+
+    /// <summary>
+    /// Reads an entry, refusing IDs that are not plain folder names.
+    /// </summary>
+    private TrashEntry? ReadEntry(string id)
+    {
+        if (string.IsNullOrWhiteSpace(id) || Path.GetFileName(id) != id || id is "." or "..")
+        {
+            Logger.Warning("Trash", $"Blocked invalid trash entry: {id}");
+            return null;
+        }
+
+        var path = Path.Combine(_trashDir, id, EntryFileName);
+        try
+        {
+            var entry = File.Exists(path) ? JsonSerializer.Deserialize<TrashEntry>(File.ReadAllText(path)) : null;
+            if (entry == null || entry.Id != id || Path.GetFileName(entry.Name) != entry.Name) return null;
+            return entry;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Trash", $"Could not read {path}: {ex.Message}");
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Moves a folder or file, falling back to copy and delete when source and target are on different drives.
+    /// </summary>
+    private static void MoveItem(string source, string target)
+    {
+        if (File.Exists(source))
+        {
+            File.Move(source, target);
+            return;
+        }
+
+        try
+        {
+            Directory.Move(source, target);
+        }
+        catch (IOException) when (Directory.Exists(source) && !Directory.Exists(target))
+        {
+            UtilityService.CopyDirectory(source, target, false);
+            Directory.Delete(source, true);
+        }
+    }
+
+    /// <summary>
+    /// Gets <paramref name="path"/>, or "name (2)", "name (3)"… next to it if it is taken.
+    /// </summary>
+    private static string GetFreePath(string path, bool isDirectory)
+    {
+        var dir = Path.GetDirectoryName(path)!;
+        var name = isDirectory ? Path.GetFileName(path) : Path.GetFileNameWithoutExtension(path);
+        var extension = isDirectory ? "" : Path.GetExtension(path);
+
+        var candidate = path;
+        for (var i = 2; Directory.Exists(candidate) || File.Exists(candidate); i++)
+            candidate = Path.Combine(dir, $"{name} ({i}){extension}");
+        return candidate;
+    }
+}
@@ -0,0 +1,282 @@
+using System.IO.Compression;
+using System.Runtime.InteropServices;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Instance;
+
+/// <summary>
+/// Reads worlds from the <c>UserData/Saves</c> folder of each instance, resolved by instance ID so
+/// several instances of the same branch and version keep their worlds apart.
+/// </summary>
+public class WorldService : IWorldService
+{
+    private readonly IInstanceService _instanceService;
+    private readonly IConfigService _configService;
+    private readonly ITrashService _trashService;
+    private readonly string _backupsDir;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="WorldService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding <c>Backups/Worlds</c>.</param>
+    /// <param name="instanceService">Resolves instance folders.</param>
+    /// <param name="configService">Lists the instances.</param>
+    /// <param name="trashService">Receives deleted worlds.</param>
+    public WorldService(string appDir, IInstanceService instanceService, IConfigService configService, ITrashService trashService)
+    {
+        _backupsDir = Path.Combine(appDir, "Backups", "Worlds");
+        _instanceService = instanceService;
+        _configService = configService;
+        _trashService = trashService;
+    }
+
+    /// <inheritdoc/>
+    public string? GetSavesDir(string instanceId)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        return string.IsNullOrEmpty(instancePath)
+            ? null
+            : Path.Combine(_instanceService.GetInstanceUserDataPath(instancePath), "Saves");
+    }
+
+    /// <inheritdoc/>
+    public List<WorldInfo> GetWorlds(string instanceId)
+    {
+        var instance = _instanceService.FindInstanceById(instanceId);
+        return instance == null ? [] : ScanWorlds(instance).OrderByDescending(w => w.LastModified).ToList();
+    }
+
+    /// <inheritdoc/>
+    public List<WorldInfo> GetAllWorlds()
+    {
+        var instances = _configService.Configuration.Instances ?? [];
+        return instances.SelectMany(ScanWorlds).OrderByDescending(w => w.LastModified).ToList();
+    }
+
+    /// <inheritdoc/>
+    public string? GetWorldPath(string instanceId, string worldName)
+    {
+        var savesDir = GetSavesDir(instanceId);
+        if (savesDir == null || string.IsNullOrWhiteSpace(worldName)) return null;
+
+        var root = Path.GetFullPath(savesDir) + Path.DirectorySeparatorChar;
+        var worldPath = Path.GetFullPath(Path.Combine(savesDir, worldName));
+        if (!worldPath.StartsWith(root, StringComparison.OrdinalIgnoreCase))
+        {
+            Logger.Warning("Worlds", $"Blocked world path outside saves directory: {worldPath}");
+            return null;
+        }
+        return Directory.Exists(worldPath) ? worldPath : null;
+    }
+
+    /// <inheritdoc/>
+    public bool DeleteWorld(string instanceId, string worldName)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var worldPath = GetWorldPath(instanceId, worldName);
+        if (instancePath == null || worldPath == null) return false;
+
+        using var deleteLock = InstanceLock.Acquire(instancePath, InstanceLock.Modifying, "deleting a world");
+        _trashService.MoveToTrash(TrashEntry.World, worldPath, instanceId);
+        Logger.Info("Worlds", $"Deleted world {worldName} of instance {instanceId}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public string? DuplicateWorld(string instanceId, string worldName, string newName)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var worldPath = GetWorldPath(instanceId, worldName);
+        if (instancePath == null || worldPath == null) return null;
+
+        newName = newName.Trim();
+        if (newName.Length == 0 || newName.IndexOfAny(Path.GetInvalidFileNameChars()) >= 0 || newName is "." or "..")
+        {
+            Logger.Warning("Worlds", $"Blocked invalid world name: {newName}");
+            return null;
+        }
+
+        var savesDir = Path.GetDirectoryName(worldPath)!;
+        var target = Path.Combine(savesDir, newName);
+        if (Directory.Exists(target))
+        {
+            Logger.Warning("Worlds", $"Cannot duplicate {worldName}: a world named {newName} already exists");
+            return null;
+        }
+
+        // The game must not write to the world while it is copied
+        using var copyLock = InstanceLock.Acquire(instancePath, InstanceLock.Modifying, "duplicating a world");
+
+        var tempTarget = target + ".copying";
+        try
+        {
+            if (Directory.Exists(tempTarget)) Directory.Delete(tempTarget, true);
+            UtilityService.CopyDirectory(worldPath, tempTarget, false);
+            Directory.Move(tempTarget, target);
+        }
+        catch
+        {
+            try { if (Directory.Exists(tempTarget)) Directory.Delete(tempTarget, true); } catch { /* ignore */ }
+            throw;
+        }
+
+        Logger.Success("Worlds", $"Duplicated world {worldName} of instance {instanceId} as {newName}");
+        return newName;
+    }
+
+    /// <inheritdoc/>
+    public string? BackupWorlds(string instanceId)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var savesDir = GetSavesDir(instanceId);
+        if (instancePath == null || savesDir == null || !Directory.Exists(savesDir) || !Directory.EnumerateDirectories(savesDir).Any())
+            return null;
+
+        using var backupLock = InstanceLock.Acquire(instancePath, InstanceLock.Backup, "backing up worlds");
+
+        Directory.CreateDirectory(_backupsDir);
+        var instanceName = _instanceService.FindInstanceById(instanceId)?.Name ?? instanceId;
+        var safeName = string.Concat(instanceName.Select(c => Path.GetInvalidFileNameChars().Contains(c) ? '_' : c));
+        var archivePath = Path.Combine(_backupsDir, $"{safeName}_{DateTime.Now:yyyy-MM-dd_HH-mm-ss}.zip");
+
+        var tempPath = archivePath + ".tmp";
+        ZipFile.CreateFromDirectory(savesDir, tempPath, CompressionLevel.Optimal, includeBaseDirectory: false);
+        File.Move(tempPath, archivePath, true);
+
+        Logger.Success("Worlds", $"Backed up worlds of {instanceName} to {archivePath}");
+        return archivePath;
+    }
+
+    /// <inheritdoc/>
+    public List<string> GetOfficialSavesDirs()
+    {
+        var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+        var roots = new List<string>();
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            roots.Add(Path.Combine(Environment.GetFolderPath(Environment.SpecialFolder.ApplicationData), "Hytale"));
+        }
+        else if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
+        {
+            roots.Add(Path.Combine(home, "Library", "Application Support", "Hytale"));
+        }
+        else
+        {
+            var dataHome = Environment.GetEnvironmentVariable("XDG_DATA_HOME");
+            roots.Add(Path.Combine(string.IsNullOrEmpty(dataHome) ? Path.Combine(home, ".local", "share") : dataHome, "Hytale"));
+            // Flatpak build of the official launcher
+            roots.Add(Path.Combine(home, ".var", "app", "com.hypixel.HytaleLauncher", "data", "Hytale"));
+        }
+
+        // Folder case differs between builds, which matters on case-sensitive file systems
+        return roots
+            .SelectMany(root => new[] { Path.Combine(root, "UserData", "Saves"), Path.Combine(root, "UserData", "saves") })
+            .Where(Directory.Exists)
+            .Select(Path.GetFullPath)
+            .Distinct()
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public List<WorldInfo> FindOfficialWorlds(string? savesDir = null)
+    {
+        var dirs = string.IsNullOrWhiteSpace(savesDir) ? GetOfficialSavesDirs() : [savesDir];
+        return dirs
+            .Where(Directory.Exists)
+            .SelectMany(dir => Directory.GetDirectories(dir).Select(ReadWorld))
+            .OrderByDescending(w => w.LastModified)
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public List<string> ImportWorlds(string instanceId, string sourceSavesDir, IEnumerable<string> worldNames)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var savesDir = GetSavesDir(instanceId);
+        if (instancePath == null || savesDir == null || !Directory.Exists(sourceSavesDir)) return [];
+
+        using var importLock = InstanceLock.Acquire(instancePath, InstanceLock.Modifying, "importing worlds");
+        Directory.CreateDirectory(savesDir);
+
+        var imported = new List<string>();
+        foreach (var name in worldNames.Distinct())
+        {
+            var source = Path.Combine(sourceSavesDir, name);
+            if (string.IsNullOrWhiteSpace(name) || Path.GetFileName(name) != name || !Directory.Exists(source))
+            {
+                Logger.Warning("Worlds", $"Skipping world {name}: not found in {sourceSavesDir}");
+                continue;
+            }
+
+            var targetName = GetFreeWorldName(savesDir, name);
+            var target = Path.Combine(savesDir, targetName);
+            var tempTarget = target + ".importing";
+            try
+            {
+                // Copy under a temporary name so a failed copy never shows up as a broken world
+                if (Directory.Exists(tempTarget)) Directory.Delete(tempTarget, true);
+                UtilityService.CopyDirectory(source, tempTarget, false);
+                Directory.Move(tempTarget, target);
+                imported.Add(targetName);
+                Logger.Success("Worlds", $"Imported world {name} into instance {instanceId} as {targetName}");
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Worlds", $"Failed to import world {name}: {ex.Message}");
+                try { if (Directory.Exists(tempTarget)) Directory.Delete(tempTarget, true); } catch { /* ignore */ }
+            }
+        }
+        return imported;
+    }
+
+    /// <summary>
+    /// Gets <paramref name="name"/>, or "name (2)", "name (3)"… if a world of that name exists.
+    /// </summary>
+    private static string GetFreeWorldName(string savesDir, string name)
+    {
+        var candidate = name;
+        for (var i = 2; Directory.Exists(Path.Combine(savesDir, candidate)); i++)
+            candidate = $"{name} ({i})";
+        return candidate;
+    }
+
+    private IEnumerable<WorldInfo> ScanWorlds(InstanceInfo instance)
+    {
+        var savesDir = GetSavesDir(instance.Id);
+        if (savesDir == null || !Directory.Exists(savesDir)) yield break;
+
+        foreach (var worldDir in Directory.GetDirectories(savesDir))
+        {
+            var world = ReadWorld(worldDir);
+            world.InstanceId = instance.Id;
+            world.InstanceName = instance.Name;
+            world.Branch = instance.Branch;
+            world.Version = instance.Version;
+            yield return world;
+        }
+    }
+
+    private static WorldInfo ReadWorld(string worldDir)
+    {
+        var dirInfo = new DirectoryInfo(worldDir);
+        var previewPath = Path.Combine(worldDir, "preview.png");
+
+        long sizeBytes = 0;
+        try
+        {
+            sizeBytes = dirInfo.EnumerateFiles("*", SearchOption.AllDirectories).Sum(f => f.Length);
+        }
+        catch { /* ignore */ }
+
+        return new WorldInfo
+        {
+            Name = dirInfo.Name,
+            Path = worldDir,
+            PreviewPath = File.Exists(previewPath) ? $"file://{previewPath.Replace("\\", "/")}" : null,
+            LastModified = dirInfo.LastWriteTime,
+            SizeBytes = sizeBytes,
+            HasModSnapshot = File.Exists(Path.Combine(worldDir, WorldModSnapshot.FileName))
+        };
+    }
+}
@@ -56,7 +56,7 @@ public class ClientPatcher
     /// Get the backup file path for the original binary.
     /// On macOS, stores outside the app bundle to avoid breaking code signature.
     /// </summary>
-    private static string GetBackupFilePath(string clientPath)
+    internal static string GetBackupFilePath(string clientPath)
     {
         if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
         {
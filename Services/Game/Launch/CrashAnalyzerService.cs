@@ -0,0 +1,209 @@
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Scans game client output for known crash signatures (GPU drivers, Wayland,
+/// broken mod jars, memory exhaustion) and suggests fixes.
+/// </summary>
+public class CrashAnalyzerService : ICrashAnalyzerService
+{
+    private const int MaxFileLines = 5000;
+    private const int MaxEvidence = 3;
+    private const int MaxExcerpt = 20;
+
+    private readonly ILogStreamService _logStreamService;
+    private readonly IInstanceService _instanceService;
+    private readonly IGraphicsDiagnosticsService _graphicsDiagnostics;
+
+    private sealed record Signature(
+        string Id,
+        string Severity,
+        string Title,
+        string Description,
+        string[] Suggestions,
+        Regex Pattern);
+
+    private static readonly Signature[] Signatures =
+    [
+        new("out_of_memory", "error",
+            "Out of memory",
+            "The game or its Java runtime ran out of memory.",
+            [
+                "Close other memory-heavy applications before playing.",
+                "Disable large mods or resource-heavy packs.",
+                "Lower render distance and texture quality in game settings."
+            ],
+            new Regex(@"OutOfMemoryError|Java heap space|GC overhead limit exceeded|Cannot allocate memory|std::bad_alloc|insufficient memory", RegexOptions.IgnoreCase | RegexOptions.Compiled)),
+
+        new("gpu_driver", "error",
+            "Graphics driver problem",
+            "The client could not create a graphics context. This usually means the GPU driver is missing, outdated or does not support the required API.",
+            [
+                "Install the latest driver from your GPU vendor (NVIDIA, AMD or Intel).",
+                "On laptops, switch the GPU preference in Settings to 'dedicated' or 'integrated' and try again.",
+                "On Linux, make sure Mesa or the proprietary driver including 64-bit OpenGL/Vulkan libraries is installed."
+            ],
+            new Regex(@"GLFW error|Failed to create (?:an? )?(?:OpenGL|GL|Vulkan)|OpenGL \d\.\d (?:is )?not supported|VK_ERROR_(?:INCOMPATIBLE_DRIVER|INITIALIZATION_FAILED)|vkCreateInstance failed|No suitable (?:GPU|graphics)|Could not create (?:the )?(?:GL|graphics) context|libGL error", RegexOptions.IgnoreCase | RegexOptions.Compiled)),
+
+        new("wayland", "error",
+            "Wayland session issue",
+            "The client failed while talking to the Wayland compositor.",
+            [
+                "Set the display server to X11 in the instance's Edit dialog, which runs the game under Xwayland.",
+                "Update your compositor and graphics drivers."
+            ],
+            new Regex(@"wl_display|Failed to connect to (?:the )?Wayland display|wayland.*(?:error|failed)|xdg_wm_base|libdecor", RegexOptions.IgnoreCase | RegexOptions.Compiled)),
+
+        new("broken_mod", "error",
+            "Broken or incompatible mod",
+            "A mod archive could not be read or loaded.",
+            [
+                "Disable or remove the mod named in the evidence and relaunch.",
+                "Re-download the mod in case the file was corrupted.",
+                "Check that the mod supports the installed game version."
+            ],
+            new Regex(@"ZipException|invalid (?:LOC|CEN) header|zip END header not found|error in opening zip file|Failed to load (?:mod|plugin)|(?:ClassNotFoundException|NoClassDefFoundError).*\.jar|\.jar.*(?:ClassNotFoundException|NoClassDefFoundError|corrupt)", RegexOptions.IgnoreCase | RegexOptions.Compiled)),
+
+        new("auth_session", "warning",
+            "Authentication session rejected",
+            "The game could not validate its session with the authentication server.",
+            [
+                "Log out and back in from the profile settings.",
+                "Check that the configured auth domain is reachable."
+            ],
+            new Regex(@"SESSION EXPIRED|Invalid (?:identity|session) token|Authentication failed", RegexOptions.IgnoreCase | RegexOptions.Compiled))
+    ];
+
+    private static readonly Regex ErrorLinePattern = new(
+        @"\|(?:ERROR|SEVERE|FATAL)\||Exception|Error:|FATAL|Unhandled|Segmentation fault|SIGSEGV",
+        RegexOptions.IgnoreCase | RegexOptions.Compiled);
+
+    private static readonly Regex JarNamePattern = new(@"([\w\-. +()\[\]]+\.jar)", RegexOptions.Compiled);
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="CrashAnalyzerService"/> class.
+    /// </summary>
+    /// <param name="logStreamService">Source of the captured game session output.</param>
+    /// <param name="instanceService">Used to locate instance log files.</param>
+    /// <param name="graphicsDiagnostics">Provides the pre-launch graphics check attached to each diagnosis.</param>
+    public CrashAnalyzerService(ILogStreamService logStreamService, IInstanceService instanceService,
+        IGraphicsDiagnosticsService graphicsDiagnostics)
+    {
+        _logStreamService = logStreamService;
+        _instanceService = instanceService;
+        _graphicsDiagnostics = graphicsDiagnostics;
+    }
+
+    /// <inheritdoc/>
+    public CrashDiagnosis AnalyzeLastCrash()
+    {
+        var page = _logStreamService.Query(new LogQuery { Source = "game", Limit = 1000 });
+        if (page.Lines.Count > 0)
+        {
+            var sessionLines = page.Lines
+                .Select(l => string.IsNullOrEmpty(l.Category) ? l.Message : $"{l.Category}|{l.Message}")
+                .ToList();
+            return Analyze(sessionLines);
+        }
+
+        var logPath = FindLatestInstanceLog();
+        if (logPath == null)
+        {
+            Logger.Info("CrashAnalyzer", "No game output or log file available to analyze");
+            return new CrashDiagnosis { HasLog = false, Graphics = _graphicsDiagnostics.GetLast() };
+        }
+
+        try
+        {
+            var lines = File.ReadLines(logPath).TakeLast(MaxFileLines).ToList();
+            var diagnosis = Analyze(lines);
+            diagnosis.Source = "file";
+            diagnosis.LogPath = logPath;
+            return diagnosis;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("CrashAnalyzer", $"Failed to read log file {logPath}: {ex.Message}");
+            return new CrashDiagnosis { HasLog = false, Source = "file", LogPath = logPath, Graphics = _graphicsDiagnostics.GetLast() };
+        }
+    }
+
+    /// <inheritdoc/>
+    public CrashDiagnosis Analyze(IReadOnlyList<string> lines)
+    {
+        var diagnosis = new CrashDiagnosis { HasLog = lines.Count > 0, Graphics = _graphicsDiagnostics.GetLast() };
+
+        foreach (var signature in Signatures)
+        {
+            var evidence = lines.Where(l => signature.Pattern.IsMatch(l)).TakeLast(MaxEvidence).ToList();
+            if (evidence.Count == 0) continue;
+
+            var issue = new CrashIssue
+            {
+                Id = signature.Id,
+                Severity = signature.Severity,
+                Title = signature.Title,
+                Description = signature.Description,
+                Suggestions = signature.Suggestions.ToList(),
+                Evidence = evidence
+            };
+
+            if (signature.Id == "broken_mod")
+            {
+                var jar = evidence.Select(l => JarNamePattern.Match(l)).FirstOrDefault(m => m.Success);
+                if (jar != null)
+                {
+                    issue.Description += $" Suspected file: {Path.GetFileName(jar.Groups[1].Value.Trim())}";
+                }
+            }
+
+            diagnosis.Issues.Add(issue);
+        }
+
+        diagnosis.Issues = diagnosis.Issues
+            .OrderBy(i => i.Severity == "error" ? 0 : 1)
+            .ToList();
+
+        diagnosis.Excerpt = lines.Where(l => ErrorLinePattern.IsMatch(l)).TakeLast(MaxExcerpt).ToList();
+
+        if (diagnosis.Issues.Count > 0)
+        {
+            Logger.Info("CrashAnalyzer", $"Matched known issues: {string.Join(", ", diagnosis.Issues.Select(i => i.Id))}");
+        }
+
+        return diagnosis;
+    }
+
+    private string? FindLatestInstanceLog()
+    {
+        try
+        {
+            var selected = _instanceService.GetSelectedInstance();
+            if (selected == null) return null;
+
+            var instancePath = _instanceService.GetInstancePathById(selected.Id);
+            if (string.IsNullOrEmpty(instancePath)) return null;
+
+            var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+            var logsDir = new[] { "Logs", "logs" }
+                .Select(name => Path.Combine(userData, name))
+                .FirstOrDefault(Directory.Exists);
+            if (logsDir == null) return null;
+
+            return new DirectoryInfo(logsDir)
+                .EnumerateFiles("*.log", SearchOption.TopDirectoryOnly)
+                .OrderByDescending(f => f.LastWriteTimeUtc)
+                .FirstOrDefault()?.FullName;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("CrashAnalyzer", $"Failed to locate instance logs: {ex.Message}");
+            return null;
+        }
+    }
+}
@@ -13,7 +13,7 @@ public static class DualAuthService
     private const string AgentUrl = "https://github.com/sanasol/hytale-auth-server/releases/latest/download/dualauth-agent.jar";
     private const string AgentFilename = "dualauth-agent.jar";
     private const int MinAgentSizeBytes = 1024;
-    private static readonly HttpClient _httpClient = new() { Timeout = TimeSpan.FromSeconds(60) };
+    private static readonly TimeSpan DownloadTimeout = TimeSpan.FromSeconds(60);
 
     /// <summary>
     /// Gets the path where the DualAuth agent should be stored for an instance.
@@ -46,6 +46,7 @@ public static class DualAuthService
     /// Downloads the DualAuth agent JAR if not already present or invalid.
     /// </summary>
     public static async Task<DualAuthResult> EnsureAgentAvailableAsync(
+        HttpClient httpClient,
         string gameDir,
         Action<string, int?>? progressCallback = null,
         CancellationToken ct = default)
@@ -73,7 +74,11 @@ public static class DualAuthService
 
         try
         {
-            using var response = await _httpClient.GetAsync(AgentUrl, HttpCompletionOption.ResponseHeadersRead, ct);
+            using var timeoutCts = CancellationTokenSource.CreateLinkedTokenSource(ct);
+            timeoutCts.CancelAfter(DownloadTimeout);
+            ct = timeoutCts.Token;
+
+            using var response = await httpClient.GetAsync(AgentUrl, HttpCompletionOption.ResponseHeadersRead, ct);
             response.EnsureSuccessStatusCode();
 
             var totalBytes = response.Content.Headers.ContentLength ?? 0;
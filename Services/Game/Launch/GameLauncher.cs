@@ -8,9 +8,11 @@ using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
 using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Game.Asset;
 using HyPrism.Services.Game.Auth;
 using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Mod;
 using HyPrism.Services.User;
 
 namespace HyPrism.Services.Game.Launch;
@@ -27,6 +29,7 @@ public class GameLauncher : IGameLauncher
 {
     private readonly IConfigService _configService;
     private readonly ILaunchService _launchService;
+    private readonly IJavaRuntimeService _javaRuntimeService;
     private readonly IInstanceService _instanceService;
     private readonly IGameProcessService _gameProcessService;
     private readonly IProgressNotificationService _progressService;
@@ -36,6 +39,10 @@ public class GameLauncher : IGameLauncher
     private readonly AvatarService _avatarService;
     private readonly HttpClient _httpClient;
     private readonly HytaleAuthService _hytaleAuthService;
+    private readonly ILogStreamService _logStreamService;
+    private readonly ISteamDeckService _steamDeckService;
+    private readonly IGraphicsDiagnosticsService _graphicsDiagnostics;
+    private readonly IModService _modService;
     
     private Config _config => _configService.Configuration;
 
@@ -44,11 +51,17 @@ public class GameLauncher : IGameLauncher
     /// </summary>
     private string? _dualAuthAgentPath;
 
+    /// <summary>
+    /// Lock on the instance the game runs from; released when the game exits.
+    /// </summary>
+    private InstanceLock? _runningLock;
+
     /// <summary>
     /// Initializes a new instance of the <see cref="GameLauncher"/> class.
     /// </summary>
     /// <param name="configService">Service for accessing configuration.</param>
     /// <param name="launchService">Service for launch prerequisites (JRE, VC++ Redist).</param>
+    /// <param name="javaRuntimeService">Service resolving the Java runtime selected for the instance.</param>
     /// <param name="instanceService">Service for instance path management.</param>
     /// <param name="gameProcessService">Service for game process tracking.</param>
     /// <param name="progressService">Service for progress notifications.</param>
@@ -58,9 +71,14 @@ public class GameLauncher : IGameLauncher
     /// <param name="avatarService">Service for avatar backup.</param>
     /// <param name="httpClient">HTTP client for authentication requests.</param>
     /// <param name="hytaleAuthService">Service for official Hytale OAuth authentication.</param>
+    /// <param name="logStreamService">Service that buffers game output for the log viewer.</param>
+    /// <param name="steamDeckService">Service providing the client variables for Steam Deck and Gamescope.</param>
+    /// <param name="graphicsDiagnostics">Service checking the graphics drivers before launch.</param>
+    /// <param name="modService">Service checking the instance's mods against its game version.</param>
     public GameLauncher(
         IConfigService configService,
         ILaunchService launchService,
+        IJavaRuntimeService javaRuntimeService,
         IInstanceService instanceService,
         IGameProcessService gameProcessService,
         IProgressNotificationService progressService,
@@ -69,10 +87,15 @@ public class GameLauncher : IGameLauncher
         IUserIdentityService userIdentityService,
         AvatarService avatarService,
         HttpClient httpClient,
-        HytaleAuthService hytaleAuthService)
+        HytaleAuthService hytaleAuthService,
+        ILogStreamService logStreamService,
+        ISteamDeckService steamDeckService,
+        IGraphicsDiagnosticsService graphicsDiagnostics,
+        IModService modService)
     {
         _configService = configService;
         _launchService = launchService;
+        _javaRuntimeService = javaRuntimeService;
         _instanceService = instanceService;
         _gameProcessService = gameProcessService;
         _progressService = progressService;
@@ -82,6 +105,10 @@ public class GameLauncher : IGameLauncher
         _avatarService = avatarService;
         _httpClient = httpClient;
         _hytaleAuthService = hytaleAuthService;
+        _logStreamService = logStreamService;
+        _steamDeckService = steamDeckService;
+        _graphicsDiagnostics = graphicsDiagnostics;
+        _modService = modService;
         _gameProcessService.ProcessExited += OnGameProcessExited;
     }
 
@@ -90,6 +117,7 @@ public class GameLauncher : IGameLauncher
         try
         {
             Logger.Info("Game", "Game process exited, performing cleanup...");
+            Interlocked.Exchange(ref _runningLock, null)?.Dispose();
 
             var uuid = _userIdentityService.GetUuidForUser(_config.Nick);
             _skinService.StopSkinProtection();
@@ -112,6 +140,22 @@ public class GameLauncher : IGameLauncher
     {
         Logger.Info("Game", $"Preparing to launch from {versionPath}");
 
+        var runningLock = InstanceLock.Acquire(versionPath, InstanceLock.Running, "launching the game");
+        try
+        {
+            await LaunchLockedAsync(versionPath, runningLock, ct);
+        }
+        catch
+        {
+            // Once the process started, the lock belongs to it until it exits
+            if (_runningLock != runningLock) runningLock.Dispose();
+            throw;
+        }
+    }
+
+    private async Task LaunchLockedAsync(string versionPath, InstanceLock runningLock, CancellationToken ct)
+    {
+
         // Validate profile/server compatibility before proceeding
         string sessionUuid = _userIdentityService.GetUuidForUser(_config.Nick);
         var currentProfile = _config.Profiles?.FirstOrDefault(p => p.UUID == sessionUuid);
@@ -130,7 +174,49 @@ public class GameLauncher : IGameLauncher
         if (!File.Exists(executable))
         {
             Logger.Error("Game", $"Game client not found at {executable}");
-            throw new Exception($"Game client not found at {executable}");
+            throw new LauncherException(ErrorCodes.ClientMissing, MessageCatalog.ErrorClientMissing, null,
+                $"Game client not found at {executable}");
+        }
+
+        if (_config.VerifyFilesBeforeLaunch)
+        {
+            _progressService.ReportDownloadProgress("launching", 0, "launch.detail.verifying_files", null, 0, 0);
+            var report = await Task.Run(() => GameFilesIntegrity.VerifyCritical(versionPath, executable, ct), ct);
+            var broken = report.Missing.Concat(report.Modified).ToList();
+            if (broken.Count > 0)
+            {
+                Logger.Error("Integrity", $"Refusing to launch, {broken.Count} critical game file(s) altered: {string.Join(", ", broken)}");
+                var shown = string.Join(", ", broken.Take(3)) + (broken.Count > 3 ? $" (+{broken.Count - 3})" : "");
+                throw new LauncherException(ErrorCodes.FilesAltered, MessageCatalog.ErrorFilesAltered, [shown],
+                    $"Critical game files altered or missing: {string.Join(", ", broken)}");
+            }
+            if (!report.HasManifest)
+                Logger.Info("Integrity", "No game manifest for this instance; skipping the pre-launch file check");
+        }
+
+        // Only warns: a wrong guess here must never keep a working setup from launching
+        _progressService.ReportDownloadProgress("launching", 0, "launch.detail.checking_graphics", null, 0, 0);
+        try
+        {
+            await _graphicsDiagnostics.RunAsync(ct: ct);
+        }
+        catch (Exception ex) when (ex is not OperationCanceledException)
+        {
+            Logger.Warning("Graphics", $"Graphics check failed: {ex.Message}");
+        }
+
+        var gameVersion = _instanceService.GetInstanceMeta(versionPath)?.GameVersion;
+        if (!string.IsNullOrEmpty(gameVersion))
+        {
+            _progressService.ReportDownloadProgress("launching", 0, "launch.detail.checking_mods", null, 0, 0);
+            try
+            {
+                await _modService.CheckGameVersionCompatibilityAsync(versionPath, gameVersion, ct);
+            }
+            catch (Exception ex) when (ex is not OperationCanceledException)
+            {
+                Logger.Warning("Game", $"Mod compatibility check failed: {ex.Message}");
+            }
         }
 
         if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
@@ -153,8 +239,10 @@ public class GameLauncher : IGameLauncher
         var (identityToken, sessionToken, authPlayerName) = await AuthenticateAsync(sessionUuid);
         string launchPlayerName = ResolveLaunchPlayerName(authPlayerName, identityToken);
 
-        string javaPath = _launchService.GetJavaPath();
-        if (!File.Exists(javaPath)) throw new Exception($"Java not found at {javaPath}");
+        string javaPath = await _javaRuntimeService.EnsureJavaForInstanceAsync(versionPath,
+            (_, message) => _progressService.ReportDownloadProgress("launching", 0, message, null, 0, 0), ct);
+        if (!File.Exists(javaPath))
+            throw new LauncherException(ErrorCodes.JavaMissing, MessageCatalog.ErrorJavaMissing, null, $"Java not found at {javaPath}");
 
         string userDataDir = _instanceService.GetInstanceUserDataPath(versionPath);
         Directory.CreateDirectory(userDataDir);
@@ -165,11 +253,14 @@ public class GameLauncher : IGameLauncher
 
         LogLaunchInfo(executable, javaPath, versionPath, userDataDir, sessionUuid, launchPlayerName);
 
-        var startInfo = BuildProcessStartInfo(executable, workingDir, versionPath, userDataDir, javaPath, sessionUuid, identityToken, sessionToken, launchPlayerName);
+        var command = BuildLaunchCommand(executable, workingDir, versionPath, userDataDir, javaPath, sessionUuid,
+            identityToken, sessionToken, launchPlayerName, _dualAuthAgentPath);
+        var startInfo = BuildProcessStartInfo(command);
+        DeleteLegacyLaunchScript(versionPath);
 
         ct.ThrowIfCancellationRequested();
 
-        await StartAndMonitorProcessAsync(startInfo, sessionUuid);
+        await StartAndMonitorProcessAsync(startInfo, sessionUuid, runningLock);
     }
 
     private static (string executable, string workingDir) ResolveExecutablePaths(string versionPath)
@@ -287,7 +378,7 @@ public class GameLauncher : IGameLauncher
 
             try
             {
-                var dualAuthResult = await DualAuthService.EnsureAgentAvailableAsync(versionPath, (msg, progress) =>
+                var dualAuthResult = await DualAuthService.EnsureAgentAvailableAsync(_httpClient, versionPath, (msg, progress) =>
                 {
                     Logger.Info("DualAuth", progress.HasValue ? $"{msg} ({progress}%)" : msg);
                     if (progress.HasValue)
@@ -608,269 +699,360 @@ public class GameLauncher : IGameLauncher
         Logger.Info("Game", $"Launch Player Name: {launchPlayerName}");
     }
 
-    private ProcessStartInfo BuildProcessStartInfo(
-        string executable, string workingDir, string versionPath,
-        string userDataDir, string javaPath, string sessionUuid,
-        string? identityToken, string? sessionToken, string launchPlayerName)
+    private const string IdentityTokenPlaceholder = "<identity-token>";
+    private const string SessionTokenPlaceholder = "<session-token>";
+
+    /// <inheritdoc/>
+    public async Task<LaunchCommand?> GetLaunchCommandPreviewAsync(string instanceId)
+    {
+        var versionPath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(versionPath)) return null;
+
+        // Online launches fall back to offline arguments if authentication fails
+        return BuildCommandForInstance(versionPath,
+            await _javaRuntimeService.GetJavaPathForInstanceAsync(versionPath) ?? "<java, installed on launch>",
+            _config.OnlineMode);
+    }
+
+    /// <inheritdoc/>
+    public async Task<string?> ExportLaunchScriptAsync(string instanceId, string destinationPath)
     {
+        var versionPath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(versionPath)) return null;
+
+        var javaPath = await _javaRuntimeService.GetJavaPathForInstanceAsync(versionPath)
+            ?? throw new InvalidOperationException("The instance's Java runtime is not installed yet; launch the instance once first");
+        var command = BuildCommandForInstance(versionPath, javaPath, withTokens: false);
+        var instanceName = _instanceService.GetInstanceMeta(versionPath)?.Name ?? instanceId;
+        // A line break in the name would end the comment and run the rest as a command
+        var commentName = new string(instanceName.Select(c => char.IsControl(c) ? ' ' : c).ToArray());
+
+        string script;
         if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
         {
-            var startInfo = BuildWindowsStartInfo(executable, workingDir, versionPath, userDataDir, javaPath, sessionUuid, identityToken, sessionToken, launchPlayerName);
-            ApplyGpuEnvironment(startInfo);
-            ApplyDualAuthEnvironment(startInfo);
-            return startInfo;
+            var lines = new List<string>
+            {
+                "@echo off",
+                $"rem Launch script exported by HyPrism for {commentName.Replace("%", "%%")} on {DateTime.Now:yyyy-MM-dd}",
+                "rem Starts the game in offline mode; session tokens are never written to this file",
+                $"cd /d {QuoteWindows(command.WorkingDirectory)}"
+            };
+            // A quote in the value would end set's quoted form early; such values are escaped with ^ instead
+            lines.AddRange(command.Environment.Select(e => e.Value.Contains('"')
+                ? $"set {EscapeCmd($"{e.Key}={e.Value}").Replace("%", "%%")}"
+                : $"set \"{e.Key}={e.Value.Replace("%", "%%")}\""));
+            lines.Add(string.Join(" ", command.Arguments.Select(QuoteWindows).Prepend(QuoteWindows(command.Executable))).Replace("%", "%%"));
+            script = string.Join("\r\n", lines) + "\r\n";
+        }
+        else
+        {
+            script = $"""
+                #!/bin/bash
+                # Launch script exported by HyPrism for {commentName} on {DateTime.Now:yyyy-MM-dd}
+                # Starts the game in offline mode; session tokens are never written to this file
+                cd {QuoteShell(command.WorkingDirectory)} || exit 1
+                exec {command.CommandLine}
+
+                """;
         }
 
-        return BuildUnixStartInfo(executable, workingDir, versionPath, userDataDir, javaPath, sessionUuid, identityToken, sessionToken, launchPlayerName);
+        WritePrivateFile(destinationPath, script, executable: true);
+        Logger.Success("Game", $"Exported launch script of {instanceName} to {destinationPath}");
+        return destinationPath;
     }
 
     /// <summary>
-    /// Applies DualAuth environment variables for custom auth server authentication.
+    /// Builds the launch command of an instance without patching, authenticating or installing anything.
     /// </summary>
-    private void ApplyDualAuthEnvironment(ProcessStartInfo startInfo)
+    /// <param name="withTokens">Use authenticated arguments with token placeholders instead of offline ones.</param>
+    private LaunchCommand BuildCommandForInstance(string versionPath, string javaPath, bool withTokens)
     {
-        if (string.IsNullOrEmpty(_dualAuthAgentPath) || IsOfficialServerMode())
-            return;
+        var (executable, workingDir) = ResolveExecutablePaths(versionPath);
+        string sessionUuid = _userIdentityService.GetUuidForUser(_config.Nick);
 
-        string baseDomain = _config.AuthDomain ?? "";
-        if (baseDomain.StartsWith("sessions."))
-            baseDomain = baseDomain["sessions.".Length..];
+        // The agent is downloaded while patching; show where it will be
+        string? agentPath = IsOfficialServerMode() || string.IsNullOrWhiteSpace(_config.AuthDomain)
+            ? null
+            : DualAuthService.GetAgentPath(versionPath);
 
-        DualAuthService.ApplyToProcess(startInfo, _dualAuthAgentPath, baseDomain, trustOfficialIssuers: true);
-        Logger.Info("Game", $"DualAuth environment applied to process");
+        return BuildLaunchCommand(executable, workingDir, versionPath,
+            _instanceService.GetInstanceUserDataPath(versionPath), javaPath, sessionUuid,
+            withTokens ? IdentityTokenPlaceholder : null,
+            withTokens ? SessionTokenPlaceholder : null,
+            _config.Nick, agentPath);
     }
 
     /// <summary>
-    /// Applies GPU environment variables to a ProcessStartInfo based on the configured GPU preference.
-    /// Used for Windows direct-launch mode. Linux/macOS uses the launch script approach.
+    /// Writes a file readable (and optionally executable) by the current user only.
     /// </summary>
-    private void ApplyGpuEnvironment(ProcessStartInfo startInfo)
+    private static void WritePrivateFile(string path, string content, bool executable)
     {
-        var gpuPref = _config.GpuPreference?.ToLowerInvariant() ?? "dedicated";
-        if (gpuPref == "auto") return;
-
-        if (gpuPref == "dedicated")
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
         {
-            // NVIDIA Optimus: request dedicated GPU
-            startInfo.Environment["__NV_PRIME_RENDER_OFFLOAD"] = "1";
-            startInfo.Environment["__GLX_VENDOR_LIBRARY_NAME"] = "nvidia";
-            // AMD switchable graphics
-            startInfo.Environment["DRI_PRIME"] = "1";
-            // Windows: hint to driver to use high-performance GPU
-            startInfo.Environment["DXGI_GPU_PREFERENCE"] = "2";
-            Logger.Info("Game", "GPU preference: dedicated (NVIDIA/AMD env vars set)");
+            File.WriteAllText(path, content);
+            return;
         }
-        else if (gpuPref == "integrated")
+
+        var mode = UnixFileMode.UserRead | UnixFileMode.UserWrite | (executable ? UnixFileMode.UserExecute : 0);
+        File.Delete(path);
+        using (var stream = new FileStream(path, new FileStreamOptions
+               {
+                   Mode = FileMode.CreateNew,
+                   Access = FileAccess.Write,
+                   UnixCreateMode = mode
+               }))
+        using (var writer = new StreamWriter(stream))
         {
-            startInfo.Environment["DRI_PRIME"] = "0";
-            startInfo.Environment["__NV_PRIME_RENDER_OFFLOAD"] = "0";
-            startInfo.Environment["DXGI_GPU_PREFERENCE"] = "1";
-            Logger.Info("Game", "GPU preference: integrated (env vars set)");
+            writer.Write(content);
         }
     }
 
-    private ProcessStartInfo BuildWindowsStartInfo(
-        string executable, string workingDir, string gameDir,
+    /// <summary>
+    /// Builds the client command: arguments, the instance's extra client arguments, and the GPU,
+    /// display server, DualAuth and library path variables. Used for launching and for the launch preview.
+    /// </summary>
+    private LaunchCommand BuildLaunchCommand(
+        string executable, string workingDir, string versionPath,
         string userDataDir, string javaPath, string sessionUuid,
-        string? identityToken, string? sessionToken, string launchPlayerName)
+        string? identityToken, string? sessionToken, string launchPlayerName, string? dualAuthAgentPath)
     {
-        var startInfo = new ProcessStartInfo
-        {
-            FileName = executable,
-            WorkingDirectory = workingDir,
-            UseShellExecute = false,
-            CreateNoWindow = true,
-            RedirectStandardOutput = true,
-            RedirectStandardError = true
-        };
+        var command = new LaunchCommand { Executable = executable, WorkingDirectory = workingDir };
+        var args = command.Arguments;
 
-        startInfo.ArgumentList.Add("--app-dir");
-        startInfo.ArgumentList.Add(gameDir);
-        startInfo.ArgumentList.Add("--user-dir");
-        startInfo.ArgumentList.Add(userDataDir);
-        startInfo.ArgumentList.Add("--java-exec");
-        startInfo.ArgumentList.Add(javaPath);
-        startInfo.ArgumentList.Add("--name");
-        startInfo.ArgumentList.Add(launchPlayerName);
+        args.AddRange(["--app-dir", versionPath, "--user-dir", userDataDir, "--java-exec", javaPath, "--name", launchPlayerName]);
 
         if (_config.OnlineMode && !string.IsNullOrEmpty(identityToken) && !string.IsNullOrEmpty(sessionToken))
         {
-            startInfo.ArgumentList.Add("--auth-mode");
-            startInfo.ArgumentList.Add("authenticated");
-            startInfo.ArgumentList.Add("--uuid");
-            startInfo.ArgumentList.Add(sessionUuid);
-            startInfo.ArgumentList.Add("--identity-token");
-            startInfo.ArgumentList.Add(identityToken);
-            startInfo.ArgumentList.Add("--session-token");
-            startInfo.ArgumentList.Add(sessionToken);
+            args.AddRange(["--auth-mode", "authenticated", "--uuid", sessionUuid,
+                "--identity-token", identityToken, "--session-token", sessionToken]);
             Logger.Info("Game", $"Using authenticated mode with session UUID: {sessionUuid}");
         }
         else
         {
-            startInfo.ArgumentList.Add("--auth-mode");
-            startInfo.ArgumentList.Add("offline");
-            startInfo.ArgumentList.Add("--uuid");
-            startInfo.ArgumentList.Add(sessionUuid);
+            args.AddRange(["--auth-mode", "offline", "--uuid", sessionUuid]);
             Logger.Info("Game", $"Using offline mode with UUID: {sessionUuid}");
         }
 
-        Logger.Info("Game", $"Windows launch args: {string.Join(" ", startInfo.ArgumentList)}");
-        return startInfo;
+        var extraArgs = SplitArguments(_instanceService.GetInstanceMeta(versionPath)?.ExtraClientArgs);
+        if (extraArgs.Count > 0)
+        {
+            args.AddRange(extraArgs);
+            Logger.Info("Game", $"Extra client arguments: {string.Join(" ", extraArgs)}");
+        }
+
+        foreach (var (key, value) in BuildGpuEnvironment())
+            command.Environment[key] = value;
+
+        foreach (var (key, value) in _steamDeckService.GetClientEnvironment())
+            command.Environment[key] = value;
+
+        // After the Steam Deck defaults: an explicit per-instance choice wins over the Gamescope default
+        var displayServer = _instanceService.GetInstanceMeta(versionPath)?.DisplayServer;
+        foreach (var (key, value) in DisplayServerEnvironment.GetClientEnvironment(displayServer))
+            command.Environment[key] = value;
+
+        if (!string.IsNullOrEmpty(dualAuthAgentPath) && !IsOfficialServerMode())
+        {
+            string baseDomain = _config.AuthDomain ?? "";
+            if (baseDomain.StartsWith("sessions."))
+                baseDomain = baseDomain["sessions.".Length..];
+
+            foreach (var (key, value) in DualAuthService.BuildDualAuthEnvironment(dualAuthAgentPath, baseDomain, trustOfficialIssuers: true))
+                command.Environment[key] = value;
+        }
+
+        if (!RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            // The client loads its shared libraries from the Client directory
+            var libraryPath = Environment.GetEnvironmentVariable("LD_LIBRARY_PATH");
+            string clientDir = Path.Combine(versionPath, "Client");
+            command.Environment["HOME"] = Environment.GetEnvironmentVariable("HOME") ?? "/Users/" + Environment.UserName;
+            command.Environment["USER"] = Environment.GetEnvironmentVariable("USER") ?? Environment.UserName;
+            command.Environment["PATH"] = "/usr/bin:/bin:/usr/sbin:/sbin:/usr/local/bin";
+            command.Environment["SHELL"] = "/bin/zsh";
+            command.Environment["TMPDIR"] = Path.GetTempPath().TrimEnd('/');
+            command.Environment["LD_LIBRARY_PATH"] = string.IsNullOrEmpty(libraryPath) ? clientDir : $"{clientDir}:{libraryPath}";
+        }
+
+        command.CommandLine = FormatCommandLine(command);
+        return command;
     }
 
-    private ProcessStartInfo BuildUnixStartInfo(
-        string executable, string workingDir, string versionPath,
-        string userDataDir, string javaPath, string sessionUuid,
-        string? identityToken, string? sessionToken, string launchPlayerName)
+    /// <summary>
+    /// Gets the GPU selection variables for the configured GPU preference.
+    /// </summary>
+    private Dictionary<string, string> BuildGpuEnvironment()
     {
-        var gameArgs = new List<string>
+        var env = new Dictionary<string, string>();
+        var gpuPref = _config.GpuPreference?.ToLowerInvariant() ?? "dedicated";
+        bool isWindows = RuntimeInformation.IsOSPlatform(OSPlatform.Windows);
+
+        if (gpuPref == "dedicated")
         {
-            $"--app-dir \"{versionPath}\"",
-            $"--user-dir \"{userDataDir}\"",
-            $"--java-exec \"{javaPath}\"",
-            $"--name \"{launchPlayerName}\""
-        };
+            // NVIDIA Optimus: request dedicated GPU
+            env["__NV_PRIME_RENDER_OFFLOAD"] = "1";
+            env["__GLX_VENDOR_LIBRARY_NAME"] = "nvidia";
+            // AMD switchable graphics
+            env["DRI_PRIME"] = "1";
+            // Windows: hint to driver to use high-performance GPU
+            if (isWindows) env["DXGI_GPU_PREFERENCE"] = "2";
+            Logger.Info("Game", "GPU preference: dedicated (NVIDIA/AMD env vars set)");
+        }
+        else if (gpuPref == "integrated")
+        {
+            env["DRI_PRIME"] = "0";
+            env["__NV_PRIME_RENDER_OFFLOAD"] = "0";
+            if (isWindows) env["DXGI_GPU_PREFERENCE"] = "1";
+            Logger.Info("Game", "GPU preference: integrated (env vars set)");
+        }
 
-        if (_config.OnlineMode && !string.IsNullOrEmpty(identityToken) && !string.IsNullOrEmpty(sessionToken))
+        return env;
+    }
+
+    /// <summary>
+    /// Splits extra client arguments like a shell: whitespace separates, single or double quotes group.
+    /// Backslashes are kept as they are so Windows paths need no escaping.
+    /// </summary>
+    internal static List<string> SplitArguments(string? text)
+    {
+        var result = new List<string>();
+        if (string.IsNullOrWhiteSpace(text)) return result;
+
+        var current = new StringBuilder();
+        bool inToken = false;
+        char quote = '\0';
+        foreach (char c in text)
         {
-            gameArgs.Add("--auth-mode authenticated");
-            gameArgs.Add($"--uuid \"{sessionUuid}\"");
-            gameArgs.Add($"--identity-token \"{identityToken}\"");
-            gameArgs.Add($"--session-token \"{sessionToken}\"");
-            Logger.Info("Game", $"Using authenticated mode with session UUID: {sessionUuid}");
+            if (quote != '\0')
+            {
+                if (c == quote) quote = '\0';
+                else current.Append(c);
+            }
+            else if (c is '"' or '\'')
+            {
+                quote = c;
+                inToken = true;
+            }
+            else if (char.IsWhiteSpace(c))
+            {
+                if (inToken) result.Add(current.ToString());
+                current.Clear();
+                inToken = false;
+            }
+            else
+            {
+                current.Append(c);
+                inToken = true;
+            }
+        }
+        if (inToken) result.Add(current.ToString());
+        return result;
+    }
+
+    private static string FormatCommandLine(LaunchCommand command)
+    {
+        var parts = new List<string>();
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            parts.AddRange(command.Environment.Select(e => e.Value.Contains('"')
+                ? $"set {EscapeCmd($"{e.Key}={e.Value}")} &&"
+                : $"set \"{e.Key}={e.Value}\" &&"));
+            parts.Add(QuoteWindows(command.Executable));
+            parts.AddRange(command.Arguments.Select(QuoteWindows));
         }
         else
         {
-            gameArgs.Add("--auth-mode offline");
-            gameArgs.Add($"--uuid \"{sessionUuid}\"");
-            Logger.Info("Game", $"Using offline mode with UUID: {sessionUuid}");
+            parts.Add("env");
+            parts.AddRange(command.Environment.Select(e => QuoteShell($"{e.Key}={e.Value}")));
+            parts.Add(QuoteShell(command.Executable));
+            parts.AddRange(command.Arguments.Select(QuoteShell));
         }
+        return string.Join(" ", parts);
+    }
 
-        string argsString = string.Join(" ", gameArgs);
-        string launchScript = Path.Combine(versionPath, "launch.sh");
-        string homeDir = Environment.GetEnvironmentVariable("HOME") ?? "/Users/" + Environment.UserName;
-        string userName = Environment.GetEnvironmentVariable("USER") ?? Environment.UserName;
-        string clientDir = Path.Combine(versionPath, "Client");
-
-        string scriptContent = $@"#!/bin/bash
-# Launch script generated by HyPrism
-# Uses env to set a clean environment before launching game
-
-# Set LD_LIBRARY_PATH to include Client directory for shared libraries
-CLIENT_DIR=""{clientDir}""
-
-{BuildGpuEnvLines()}{BuildDualAuthEnvLines()}
-# Build env args for a clean process environment
-ENV_ARGS=()
-ENV_ARGS+=(HOME=""{homeDir}"")
-ENV_ARGS+=(USER=""{userName}"")
-ENV_ARGS+=(PATH=""/usr/bin:/bin:/usr/sbin:/sbin:/usr/local/bin"")
-ENV_ARGS+=(SHELL=""/bin/zsh"")
-ENV_ARGS+=(TMPDIR=""{Path.GetTempPath().TrimEnd('/')}"")
-ENV_ARGS+=(LD_LIBRARY_PATH=""$CLIENT_DIR:$LD_LIBRARY_PATH"")
-
-# Add DualAuth env vars if set (JAVA_TOOL_OPTIONS needs special handling for paths with spaces)
-if [[ -n ""$DUALAUTH_JAVA_TOOL_OPTIONS"" ]]; then
-    ENV_ARGS+=(""JAVA_TOOL_OPTIONS=$DUALAUTH_JAVA_TOOL_OPTIONS"")
-fi
-[[ -n ""$DUALAUTH_AUTH_DOMAIN"" ]] && ENV_ARGS+=(""HYTALE_AUTH_DOMAIN=$DUALAUTH_AUTH_DOMAIN"")
-[[ -n ""$DUALAUTH_TRUST_ALL"" ]] && ENV_ARGS+=(""HYTALE_TRUST_ALL_ISSUERS=$DUALAUTH_TRUST_ALL"")
-[[ -n ""$DUALAUTH_TRUST_OFFICIAL"" ]] && ENV_ARGS+=(""HYTALE_TRUST_OFFICIAL=$DUALAUTH_TRUST_OFFICIAL"")
-
-exec env ""${{ENV_ARGS[@]}}"" ""{executable}"" {argsString}
-";
-        File.WriteAllText(launchScript, scriptContent);
-
-        using var chmod = Process.Start(new ProcessStartInfo
-        {
-            FileName = "/bin/chmod",
-            Arguments = $"+x \"{launchScript}\"",
-            UseShellExecute = false,
-            CreateNoWindow = true
-        });
-        chmod?.WaitForExit();
+    private static string QuoteShell(string value) =>
+        value.Length > 0 && value.All(c => char.IsLetterOrDigit(c) || "-_./:=@+,".Contains(c))
+            ? value
+            : "'" + value.Replace("'", "'\\''") + "'";
+
+    // cmd treats these as operators or separators unless they are inside quotes
+    private const string CmdSpecialChars = "\"&|^<>()%!,;=";
+    private const string CmdCaretChars = "\"&|^<>()!";
 
+    /// <summary>
+    /// Quotes an argument for a cmd.exe command line. Arguments with operators are quoted so cmd leaves
+    /// them alone; one that contains a quote would end that quoted part early, so all of its operators
+    /// and quotes are escaped with <c>^</c> instead and cmd passes it on unchanged. <c>%</c> is left to the caller.
+    /// </summary>
+    private static string QuoteWindows(string value)
+    {
+        if (value.Length > 0 && !value.Any(c => char.IsWhiteSpace(c) || CmdSpecialChars.Contains(c)))
+            return value;
+
+        var quoted = UtilityService.QuoteWindowsArgument(value, alwaysQuote: true);
+        return value.Contains('"') ? EscapeCmd(quoted) : quoted;
+    }
+
+    private static string EscapeCmd(string value)
+    {
+        var escaped = new StringBuilder();
+        foreach (var c in value)
+        {
+            if (CmdCaretChars.Contains(c)) escaped.Append('^');
+            escaped.Append(c);
+        }
+        return escaped.ToString();
+    }
+
+    /// <summary>
+    /// Starts the client directly with the command's variables added to the launcher's environment.
+    /// Nothing is written to disk, so session tokens stay in memory.
+    /// </summary>
+    private static ProcessStartInfo BuildProcessStartInfo(LaunchCommand command)
+    {
         var startInfo = new ProcessStartInfo
         {
-            FileName = "/bin/bash",
-            WorkingDirectory = workingDir,
+            FileName = command.Executable,
+            WorkingDirectory = command.WorkingDirectory,
             UseShellExecute = false,
             CreateNoWindow = true,
             RedirectStandardOutput = true,
             RedirectStandardError = true
         };
-        startInfo.ArgumentList.Add(launchScript);
 
-        Logger.Info("Game", $"Launch script: {launchScript}");
+        foreach (var arg in command.Arguments)
+            startInfo.ArgumentList.Add(arg);
+        foreach (var (key, value) in command.Environment)
+            startInfo.Environment[key] = value;
+
+        Logger.Info("Game", $"Launch args: {string.Join(" ", RedactTokens(command.Arguments))}");
         return startInfo;
     }
 
+    private static IEnumerable<string> RedactTokens(List<string> args) =>
+        args.Select((arg, i) => i > 0 && args[i - 1] is "--identity-token" or "--session-token" ? "<redacted>" : arg);
+
     /// <summary>
-    /// Builds GPU environment variable lines for the Unix launch script.
-    /// Returns a string with export lines to be placed before 'exec env'.
+    /// Removes the launch.sh that earlier versions wrote into the instance on every launch, with the
+    /// player name and session tokens in it.
     /// </summary>
-    private string BuildGpuEnvLines()
+    private static void DeleteLegacyLaunchScript(string versionPath)
     {
-        var gpuPref = _config.GpuPreference?.ToLowerInvariant() ?? "dedicated";
-        if (gpuPref == "auto") return "";
+        var script = Path.Combine(versionPath, "launch.sh");
+        if (!File.Exists(script)) return;
 
-        if (gpuPref == "dedicated")
+        try
         {
-            Logger.Info("Game", "GPU preference: dedicated (NVIDIA/AMD env vars in launch script)");
-            return @"# GPU preference: dedicated (discrete GPU)
-export __NV_PRIME_RENDER_OFFLOAD=1
-export __GLX_VENDOR_LIBRARY_NAME=nvidia
-export DRI_PRIME=1
-
-";
+            File.Delete(script);
+            Logger.Info("Game", "Removed the launch script left by an earlier version");
         }
-
-        if (gpuPref == "integrated")
+        catch (Exception ex)
         {
-            Logger.Info("Game", "GPU preference: integrated");
-            return @"# GPU preference: integrated
-export DRI_PRIME=0
-export __NV_PRIME_RENDER_OFFLOAD=0
-
-";
+            Logger.Warning("Game", $"Could not remove old launch script: {ex.Message}");
         }
-
-        return "";
-    }
-
-    /// <summary>
-    /// Builds DualAuth environment variable lines for the Unix launch script.
-    /// Returns a string with variable assignments to be placed before 'exec env'.
-    /// Each variable is quoted individually to handle paths with spaces.
-    /// </summary>
-    private string BuildDualAuthEnvLines()
-    {
-        if (string.IsNullOrEmpty(_dualAuthAgentPath) || IsOfficialServerMode())
-            return "# No DualAuth (official server mode or agent unavailable)\nDUALAUTH_JAVA_TOOL_OPTIONS=\"\"\nDUALAUTH_AUTH_DOMAIN=\"\"\nDUALAUTH_TRUST_ALL=\"\"\nDUALAUTH_TRUST_OFFICIAL=\"\"\n\n";
-
-        string baseDomain = _config.AuthDomain ?? "";
-        if (baseDomain.StartsWith("sessions."))
-            baseDomain = baseDomain["sessions.".Length..];
-
-        Logger.Info("Game", $"DualAuth env lines for Unix script: {baseDomain}");
-        
-        // Store DualAuth values in separate shell variables, then compose the
-        // JAVA_TOOL_OPTIONS=KEY=VALUE pair when building ENV_ARGS.
-        // This avoids nested quoting issues where paths with spaces (e.g.
-        // "Application Support") broke the javaagent argument.
-        // The JAVA_TOOL_OPTIONS value includes literal double quotes so Java's
-        // tokenizer treats the entire -javaagent:... as one token even when
-        // the path contains spaces.
-        return $@"# DualAuth Agent Configuration
-DUALAUTH_JAVA_TOOL_OPTIONS=""\""-javaagent:{_dualAuthAgentPath}\""""
-DUALAUTH_AUTH_DOMAIN=""{baseDomain}""
-DUALAUTH_TRUST_ALL=""true""
-DUALAUTH_TRUST_OFFICIAL=""true""
-
-";
     }
 
-    private async Task StartAndMonitorProcessAsync(ProcessStartInfo startInfo, string sessionUuid)
+    private async Task StartAndMonitorProcessAsync(ProcessStartInfo startInfo, string sessionUuid, InstanceLock runningLock)
     {
 
         Process? process = null;
@@ -879,6 +1061,7 @@ DUALAUTH_TRUST_OFFICIAL=""true""
             _progressService.ReportDownloadProgress("launching", 80, "launch.detail.starting_process", null, 0, 0);
 
             process = new Process { StartInfo = startInfo };
+            _logStreamService.ClearGameLines();
             var interfaceLoadedTcs = new TaskCompletionSource<bool>();
 
             var sysInfoBuffer = new List<string>();
@@ -891,10 +1074,12 @@ DUALAUTH_TRUST_OFFICIAL=""true""
                 string line = e.Data;
                 bool isNewLogEntry = Regex.IsMatch(line, @"^\d{4}-\d{2}-\d{2}");
 
+                // The log path and the system info lines are logged through Logger, which already adds them
+                // to the log stream as launcher lines; adding them as game lines as well would show them twice
                 if (line.StartsWith("Set log path to")) { Logger.Info("Game", line); return; }
 
                 if (line.Trim() == "System informations" || line.Contains("|System informations"))
-                { capturingSysInfo = true; return; }
+                { _logStreamService.AppendGameLine(line); capturingSysInfo = true; return; }
 
                 if (capturingSysInfo)
                 {
@@ -907,7 +1092,7 @@ DUALAUTH_TRUST_OFFICIAL=""true""
                     }
                 }
 
-                if (line.Contains("|Audio:")) { capturingAudio = true; return; }
+                if (line.Contains("|Audio:")) { _logStreamService.AppendGameLine(line); capturingAudio = true; return; }
 
                 if (capturingAudio)
                 {
@@ -924,10 +1109,13 @@ DUALAUTH_TRUST_OFFICIAL=""true""
                         if (trimmed.StartsWith("OpenAL") || trimmed.StartsWith("Renderer") ||
                             trimmed.StartsWith("Vendor") || trimmed.StartsWith("Using device"))
                         { sysInfoBuffer.Add(trimmed); }
+                        else { _logStreamService.AppendGameLine(line); }
                         return;
                     }
                 }
 
+                _logStreamService.AppendGameLine(line);
+
                 if (line.Contains("|INFO|HytaleClient.Application.AppStartup|Interface loaded.") ||
                     line.Contains("Interface loaded."))
                 {
@@ -936,18 +1124,24 @@ DUALAUTH_TRUST_OFFICIAL=""true""
                 }
             };
 
-            process.ErrorDataReceived += (_, _) => { };
+            process.ErrorDataReceived += (_, e) =>
+            {
+                if (!string.IsNullOrEmpty(e.Data)) _logStreamService.AppendGameLine(e.Data, isError: true);
+            };
 
             if (!process.Start())
             {
                 Logger.Error("Game", "Process.Start returned false - game failed to launch");
-                _progressService.ReportError("launch", "Failed to start game", "Process.Start returned false");
+                _progressService.ReportLocalizedError("launch", MessageCatalog.ErrorStartFailed, null, "Process.Start returned false");
                 throw new Exception("Failed to start game process");
             }
 
             process.BeginOutputReadLine();
             process.BeginErrorReadLine();
 
+            runningLock.TransferTo(process.Id);
+            _runningLock = runningLock;
+
             // Transfer ownership to GameProcessService (it will handle disposal and notify subscribers)
             _gameProcessService.SetGameProcess(process);
             Logger.Success("Game", $"Game started with PID: {process.Id}");
@@ -977,7 +1171,7 @@ DUALAUTH_TRUST_OFFICIAL=""true""
                 try { process.Dispose(); } catch { }
             }
             
-            _progressService.ReportError("launch", "Failed to start game", ex.Message);
+            _progressService.ReportLocalizedError("launch", MessageCatalog.ErrorStartFailed, null, ex.Message);
             throw new Exception($"Failed to start game: {ex.Message}");
         }
     }
@@ -0,0 +1,265 @@
+using System.Text;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Polls the newest <c>UserData/Logs/*.log</c> of an instance and pushes appended lines.
+/// </summary>
+/// <remarks>
+/// The client keeps writing to its log file, so the file is opened with full sharing and read from the
+/// last known position every <see cref="PollInterval"/>. A log file created after the stream started
+/// (a new session) replaces the current one. The stream ends once the game exits, or after
+/// <see cref="StartGrace"/> if no game session shows up at all.
+/// </remarks>
+public class GameLogStreamService : IGameLogStreamService
+{
+    private const int BacklogLines = 200;
+    private const long BacklogBytes = 256 * 1024;
+    private static readonly TimeSpan PollInterval = TimeSpan.FromMilliseconds(250);
+    private static readonly TimeSpan ProcessScanInterval = TimeSpan.FromSeconds(2);
+    private static readonly TimeSpan StartGrace = TimeSpan.FromSeconds(60);
+
+    private readonly IInstanceService _instanceService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly object _lock = new();
+    private CancellationTokenSource? _cts;
+    private volatile bool _gameExited;
+
+    /// <inheritdoc/>
+    public event Action<GameLogChunk>? ChunkReceived;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GameLogStreamService"/> class.
+    /// </summary>
+    /// <param name="instanceService">Resolves instance log folders.</param>
+    /// <param name="gameProcessService">Tells when the game session ends.</param>
+    public GameLogStreamService(IInstanceService instanceService, IGameProcessService gameProcessService)
+    {
+        _instanceService = instanceService;
+        _gameProcessService = gameProcessService;
+        _gameProcessService.ProcessExited += (_, _) => _gameExited = true;
+    }
+
+    /// <inheritdoc/>
+    public bool Start(string instanceId)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(instancePath)) return false;
+
+        var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+        var cts = new CancellationTokenSource();
+        lock (_lock)
+        {
+            _cts?.Cancel();
+            _cts = cts;
+            _gameExited = false;
+        }
+
+        _ = Task.Run(() => FollowAsync(instanceId, userData, cts.Token));
+        Logger.Info("GameLog", $"Following game log for instance {instanceId}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public void Stop()
+    {
+        lock (_lock)
+        {
+            _cts?.Cancel();
+            _cts = null;
+        }
+    }
+
+    private async Task FollowAsync(string instanceId, string userData, CancellationToken ct)
+    {
+        var startedAt = DateTime.UtcNow;
+        var seenFiles = new HashSet<string>(StringComparer.Ordinal);
+        var lastProcessScan = DateTime.MinValue;
+        bool sawGame = false;
+        LogFile? current = null;
+        string? fileName = null;
+
+        try
+        {
+            var newest = FindNewestLog(userData);
+            if (newest != null)
+            {
+                seenFiles.Add(newest);
+                current = LogFile.Open(newest, fromEnd: true);
+                var backlog = current.ReadNew();
+                Publish(instanceId, current, backlog.Skip(Math.Max(0, backlog.Count - BacklogLines)).ToList());
+            }
+
+            while (!ct.IsCancellationRequested)
+            {
+                await Task.Delay(PollInterval, ct);
+
+                var running = _gameProcessService.IsGameRunning();
+                if (!running && DateTime.UtcNow - lastProcessScan >= ProcessScanInterval)
+                {
+                    // Untracked sessions (e.g. started through the OS) only show up in a process scan
+                    lastProcessScan = DateTime.UtcNow;
+                    running = _gameProcessService.CheckForRunningGame();
+                }
+                sawGame |= running;
+
+                newest = FindNewestLog(userData);
+                if (newest != null && seenFiles.Add(newest))
+                {
+                    // A new session log: finish the old file, then read the new one from the start
+                    if (current != null)
+                    {
+                        Publish(instanceId, current, current.ReadNew(flush: true));
+                        current.Dispose();
+                    }
+                    current = LogFile.Open(newest, fromEnd: false);
+                }
+
+                if (current != null)
+                {
+                    Publish(instanceId, current, current.ReadNew());
+                }
+
+                bool ended = _gameExited || (!running && (sawGame || DateTime.UtcNow - startedAt > StartGrace));
+                if (ended)
+                {
+                    if (current != null) Publish(instanceId, current, current.ReadNew(flush: true));
+                    break;
+                }
+            }
+        }
+        catch (OperationCanceledException)
+        {
+            // Stopped or replaced by another stream
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameLog", $"Game log stream failed: {ex.Message}");
+        }
+        finally
+        {
+            fileName = current?.Name;
+            current?.Dispose();
+            Logger.Info("GameLog", $"Stopped following game log for instance {instanceId}");
+        }
+
+        // Streams that were stopped or replaced end quietly; the caller already knows
+        if (ct.IsCancellationRequested) return;
+        lock (_lock)
+        {
+            if (_cts?.Token == ct) _cts = null;
+        }
+        ChunkReceived?.Invoke(new GameLogChunk { InstanceId = instanceId, File = fileName, Ended = true });
+    }
+
+    private void Publish(string instanceId, LogFile file, List<string> lines)
+    {
+        if (lines.Count == 0) return;
+        ChunkReceived?.Invoke(new GameLogChunk { InstanceId = instanceId, File = file.Name, Lines = lines });
+    }
+
+    private static string? FindNewestLog(string userData)
+    {
+        try
+        {
+            var logsDir = new[] { "Logs", "logs" }
+                .Select(name => Path.Combine(userData, name))
+                .FirstOrDefault(Directory.Exists);
+            if (logsDir == null) return null;
+
+            return new DirectoryInfo(logsDir)
+                .EnumerateFiles("*.log", SearchOption.TopDirectoryOnly)
+                .OrderByDescending(f => f.LastWriteTimeUtc)
+                .FirstOrDefault()?.FullName;
+        }
+        catch (IOException)
+        {
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// A log file read incrementally; keeps an unterminated last line until more data arrives.
+    /// </summary>
+    private sealed class LogFile : IDisposable
+    {
+        private readonly FileStream _stream;
+        private readonly StreamReader _reader;
+        private readonly StringBuilder _pending = new();
+        private bool _skipPartialFirstLine;
+
+        public string Name { get; }
+
+        private LogFile(string path, FileStream stream)
+        {
+            Name = Path.GetFileName(path);
+            _stream = stream;
+            _reader = new StreamReader(stream, Encoding.UTF8, detectEncodingFromByteOrderMarks: true);
+        }
+
+        public static LogFile Open(string path, bool fromEnd)
+        {
+            var stream = new FileStream(path, FileMode.Open, FileAccess.Read, FileShare.ReadWrite | FileShare.Delete);
+            var file = new LogFile(path, stream);
+            if (fromEnd && stream.Length > BacklogBytes)
+            {
+                stream.Seek(-BacklogBytes, SeekOrigin.End);
+                file._skipPartialFirstLine = true;
+            }
+            return file;
+        }
+
+        public List<string> ReadNew(bool flush = false)
+        {
+            if (_stream.Length < _stream.Position)
+            {
+                // Truncated or rewritten in place: start over
+                _stream.Position = 0;
+                _reader.DiscardBufferedData();
+                _pending.Clear();
+            }
+
+            _pending.Append(_reader.ReadToEnd());
+
+            var lines = new List<string>();
+            var text = _pending.ToString();
+            int start = 0;
+            for (int i = 0; i < text.Length; i++)
+            {
+                if (text[i] != '\n') continue;
+                AddLine(lines, text[start..i]);
+                start = i + 1;
+            }
+
+            _pending.Clear();
+            if (flush)
+            {
+                if (start < text.Length) AddLine(lines, text[start..]);
+            }
+            else
+            {
+                _pending.Append(text, start, text.Length - start);
+            }
+            return lines;
+        }
+
+        private void AddLine(List<string> lines, string line)
+        {
+            if (_skipPartialFirstLine)
+            {
+                _skipPartialFirstLine = false;
+                return;
+            }
+            lines.Add(line.TrimEnd('\r'));
+        }
+
+        public void Dispose()
+        {
+            _reader.Dispose();
+            _stream.Dispose();
+        }
+    }
+}
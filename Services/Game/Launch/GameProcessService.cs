@@ -2,6 +2,9 @@ using System.Diagnostics;
 using System.Linq;
 using System.Runtime.InteropServices;
 using System.IO;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
 
 namespace HyPrism.Services.Game.Launch;
 
@@ -9,42 +12,95 @@ namespace HyPrism.Services.Game.Launch;
 /// Manages the game process lifecycle including tracking, monitoring, and termination.
 /// Handles detection of running Hytale instances across different platforms.
 /// </summary>
+/// <remarks>
+/// The launched process is tracked by its handle, and its ID, executable path and start time are kept in
+/// <c>game.pid</c>, so a restarted launcher picks the game up again by PID. Scanning process names is only
+/// the fallback when no PID is known (e.g. the game was started by an older launcher version), and then
+/// only <c>HytaleClient</c> processes count, not every process with "Hytale" in its window title.
+/// </remarks>
 public class GameProcessService : IGameProcessService
 {
+    private const string ClientProcessName = "HytaleClient";
+
+    private static readonly StringComparison PathComparison =
+        RuntimeInformation.IsOSPlatform(OSPlatform.Linux) ? StringComparison.Ordinal : StringComparison.OrdinalIgnoreCase;
+
+    private readonly string _recordPath;
+    private readonly object _lock = new();
     private Process? _gameProcess;
 
     /// <inheritdoc/>
     public event EventHandler? ProcessExited;
 
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GameProcessService"/> class.
+    /// </summary>
+    /// <param name="appDir">Launcher data directory holding <c>game.pid</c>.</param>
+    public GameProcessService(string appDir)
+    {
+        _recordPath = Path.Combine(appDir, "game.pid");
+    }
+
     /// <inheritdoc/>
     public void SetGameProcess(Process? p)
     {
-        if (_gameProcess != null)
+        Track(p);
+
+        if (p == null)
         {
-            _gameProcess.Exited -= OnGameProcessExited;
-            _gameProcess.Dispose();
+            DeleteRecord();
+            return;
+        }
+
+        try
+        {
+            WriteRecord(new GameProcessRecord
+            {
+                ProcessId = p.Id,
+                ExecutablePath = GetExecutablePath(p) ?? "",
+                StartedAt = p.StartTime.ToUniversalTime()
+            });
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Game", $"Could not record game PID: {ex.Message}");
         }
+    }
 
-        _gameProcess = p;
-        
-        if (p != null)
+    private void Track(Process? p)
+    {
+        lock (_lock)
         {
-            p.EnableRaisingEvents = true;
-            p.Exited += OnGameProcessExited;
+            if (_gameProcess != null && _gameProcess != p)
+            {
+                _gameProcess.Exited -= OnGameProcessExited;
+                _gameProcess.Dispose();
+            }
+
+            _gameProcess = p;
+
+            if (p != null)
+            {
+                p.EnableRaisingEvents = true;
+                p.Exited += OnGameProcessExited;
+            }
         }
     }
 
     private void OnGameProcessExited(object? sender, EventArgs e)
     {
-        if (_gameProcess != null)
+        lock (_lock)
         {
+            if (_gameProcess == null || sender != _gameProcess) return;
+
             _gameProcess.Exited -= OnGameProcessExited;
             _gameProcess.Dispose();
             _gameProcess = null;
-
-            // Уведомляем подписчиков о завершении процесса
-            ProcessExited?.Invoke(this, EventArgs.Empty);
         }
+        DeleteRecord();
+
+        // Уведомляем подписчиков о завершении процесса
+        ProcessExited?.Invoke(this, EventArgs.Empty);
     }
     
     /// <inheritdoc/>
@@ -53,7 +109,16 @@ public class GameProcessService : IGameProcessService
     /// <inheritdoc/>
     public bool IsGameRunning()
     {
-        return _gameProcess != null && !_gameProcess.HasExited;
+        var process = _gameProcess;
+        try
+        {
+            return process != null && !process.HasExited;
+        }
+        catch (InvalidOperationException)
+        {
+            // Disposed by a concurrent exit
+            return false;
+        }
     }
 
     /// <inheritdoc/>
@@ -61,44 +126,71 @@ public class GameProcessService : IGameProcessService
     {
         if (IsGameRunning()) return true;
 
+        var record = ReadRecord();
+        if (record != null)
+        {
+            // The PID is known, so a HytaleClient found by name belongs to someone else
+            if (TryAttach(record)) return true;
+            DeleteRecord();
+            return false;
+        }
+
         return ScanForOrphanedGameProcess();
     }
 
+    /// <summary>
+    /// Picks up the recorded game process if its PID still runs the same executable, started at the same time.
+    /// </summary>
+    private bool TryAttach(GameProcessRecord record)
+    {
+        Process? process = null;
+        try
+        {
+            process = Process.GetProcessById(record.ProcessId);
+            if (process.HasExited
+                || Math.Abs((process.StartTime.ToUniversalTime() - record.StartedAt).TotalSeconds) > 1)
+            {
+                process.Dispose();
+                return false;
+            }
+
+            var path = GetExecutablePath(process);
+            if (path != null && record.ExecutablePath != "" && !string.Equals(path, record.ExecutablePath, PathComparison))
+            {
+                process.Dispose();
+                return false;
+            }
+
+            Track(process);
+            Logger.Info("Game", $"Found running game by PID {record.ProcessId}");
+            return true;
+        }
+        catch (Exception)
+        {
+            // Gone, or no access to its details
+            process?.Dispose();
+            return false;
+        }
+    }
+
     private bool ScanForOrphanedGameProcess()
     {
         try
         {
-            // Scan for java processes that look like Hytale
-            // Common names: "java", "javaw", "HytaleClient", "java.real" (wrapper)
-            var potentialProcesses = Process.GetProcessesByName("java")
-                .Concat(Process.GetProcessesByName("javaw"))
-                .Concat(Process.GetProcessesByName("java.real")) // Wrapper script target
-                .Concat(Process.GetProcessesByName("HytaleClient"))
-                .ToArray();
+            // Java processes are left out: the client starts its own server on Java, and other tools run on it too
+            var potentialProcesses = Process.GetProcessesByName(ClientProcessName);
 
+            Process? found = null;
             try
             {
                 foreach (var p in potentialProcesses)
                 {
                     try 
                     {
-                        // 1. Check Window Title (Works well on Windows, sometime on Linux/macOS)
-                        if (!string.IsNullOrEmpty(p.MainWindowTitle) && 
-                            p.MainWindowTitle.Contains("Hytale"))
-                        {
-                            _gameProcess = p;
-                            return true;
-                        }
-
-                        // 2. Check Command Line (More reliable on Linux)
-                        if (RuntimeInformation.IsOSPlatform(OSPlatform.Linux))
+                        if (!p.HasExited)
                         {
-                            var cmdLine = GetLinuxCommandLine(p.Id);
-                            if (!string.IsNullOrEmpty(cmdLine) && cmdLine.Contains("Hytale"))
-                            {
-                                _gameProcess = p;
-                                return true;
-                            }
+                            found = p;
+                            break;
                         }
                     }
                     catch { /* Ignore access denied / exited process */ }
@@ -109,43 +201,84 @@ public class GameProcessService : IGameProcessService
                 // Dispose all processes that we didn't keep
                 foreach (var p in potentialProcesses)
                 {
-                    if (p != _gameProcess)
+                    if (p != found)
                     {
                         try { p.Dispose(); } catch { }
                     }
                 }
             }
+
+            if (found != null)
+            {
+                Logger.Info("Game", $"Found running game by name (PID {found.Id})");
+                SetGameProcess(found);
+                return true;
+            }
         }
         catch { /* Ignore enumeration errors */ }
 
         return false;
     }
 
-    private string? GetLinuxCommandLine(int pid)
+    private static string? GetExecutablePath(Process process)
     {
         try
         {
-            string path = $"/proc/{pid}/cmdline";
-            if (File.Exists(path))
-            {
-                // cmdline arguments are null-terminated strings
-                var text = File.ReadAllText(path);
-                return text.Replace("\0", " ");
-            }
+            var path = process.MainModule?.FileName;
+            return string.IsNullOrEmpty(path) ? null : Path.GetFullPath(path);
+        }
+        catch (Exception)
+        {
+            // Access denied (another user, sandboxing) or the process is gone
+            return null;
+        }
+    }
+
+    private GameProcessRecord? ReadRecord()
+    {
+        try
+        {
+            return File.Exists(_recordPath)
+                ? JsonSerializer.Deserialize<GameProcessRecord>(File.ReadAllText(_recordPath))
+                : null;
         }
-        catch { }
-        return null;
+        catch (Exception ex) when (ex is IOException or UnauthorizedAccessException or JsonException)
+        {
+            return null;
+        }
+    }
+
+    private void WriteRecord(GameProcessRecord record)
+    {
+        File.WriteAllText(_recordPath + ".tmp", JsonSerializer.Serialize(record));
+        File.Move(_recordPath + ".tmp", _recordPath, true);
     }
 
+    private void DeleteRecord()
+    {
+        try { File.Delete(_recordPath); } catch { /* Best effort */ }
+    }
+
+    /// <inheritdoc/>
     public bool ExitGame()
     {
         var gameProcess = _gameProcess;
-        if (gameProcess != null && !gameProcess.HasExited)
+        try
         {
-            gameProcess.Kill();
-            SetGameProcess(null);
+            if (gameProcess == null || gameProcess.HasExited) return false;
+
+            // The client runs the singleplayer server as a child Java process; stop it too
+            gameProcess.Kill(entireProcessTree: true);
+
+            // Not waited for here, which would hold up the IPC caller: the Exited handler clears the tracking
+            // and notifies subscribers, so the instance lock, Discord presence and playtime session end
+            // the same way as when the player quits
             return true;
         }
-        return false;
+        catch (InvalidOperationException)
+        {
+            // Exited and disposed in the meantime
+            return false;
+        }
     }
 }
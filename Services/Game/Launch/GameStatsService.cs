@@ -0,0 +1,431 @@
+using System.ComponentModel;
+using System.Diagnostics;
+using System.Runtime.InteropServices;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Mod;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Samples the game process every <see cref="SampleInterval"/> while it runs and records peak values per session.
+/// </summary>
+/// <remarks>
+/// CPU and memory come from <see cref="Process"/>. GPU usage is read from the DRM <c>fdinfo</c> entries
+/// on Linux (AMD and Intel drivers), and from <c>nvidia-smi pmon</c> on NVIDIA systems; elsewhere it is
+/// left empty. <c>nvidia-smi</c> is slow, so it only runs every <see cref="GpuQueryEvery"/> samples.
+/// Finished sessions are kept in <c>game-stats.json</c> together with the mods that were enabled.
+/// </remarks>
+public class GameStatsService : IGameStatsService
+{
+    private const int MaxHistory = 50;
+    private const int GpuQueryEvery = 3;
+    private static readonly TimeSpan SampleInterval = TimeSpan.FromSeconds(2);
+
+    private readonly string _historyPath;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IModService _modService;
+    private readonly object _lock = new();
+    private CancellationTokenSource? _cts;
+    private GameSessionStats? _current;
+    private List<GameSessionStats>? _history;
+
+    /// <inheritdoc/>
+    public event Action<GameStatsSample>? SampleTaken;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GameStatsService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory, where the session history is stored.</param>
+    /// <param name="gameProcessService">Provides the game process to sample.</param>
+    /// <param name="progressService">Raises the game state changes that start and stop sampling.</param>
+    /// <param name="configService">Provides the selected instance.</param>
+    /// <param name="instanceService">Resolves the instance folder and name.</param>
+    /// <param name="modService">Lists the mods enabled for the session.</param>
+    public GameStatsService(string appDir, IGameProcessService gameProcessService, IProgressNotificationService progressService,
+        IConfigService configService, IInstanceService instanceService, IModService modService)
+    {
+        _historyPath = Path.Combine(appDir, "game-stats.json");
+        _gameProcessService = gameProcessService;
+        _configService = configService;
+        _instanceService = instanceService;
+        _modService = modService;
+
+        progressService.GameStateChanged += (state, _) =>
+        {
+            if (state == "started") Start();
+            else if (state == "stopped") Stop();
+        };
+    }
+
+    /// <inheritdoc/>
+    public GameSessionStats? GetCurrentSession()
+    {
+        lock (_lock) return _current;
+    }
+
+    /// <inheritdoc/>
+    public List<GameSessionStats> GetSessionHistory()
+    {
+        lock (_lock) return LoadHistory().ToList();
+    }
+
+    private void Start()
+    {
+        var tracked = _gameProcessService.GetGameProcess();
+        Process process;
+        try
+        {
+            // A handle of our own: the tracked one is disposed as soon as the game exits
+            process = Process.GetProcessById(tracked?.Id ?? 0);
+        }
+        catch (Exception ex) when (ex is ArgumentException or InvalidOperationException)
+        {
+            Logger.Warning("GameStats", "Game started without a trackable process; not sampling");
+            return;
+        }
+
+        var instanceId = _configService.Configuration.SelectedInstanceId;
+        var session = new GameSessionStats
+        {
+            InstanceId = string.IsNullOrEmpty(instanceId) ? null : instanceId,
+            Pid = process.Id,
+            StartedAt = DateTime.UtcNow
+        };
+        FillInstanceDetails(session);
+
+        var cts = new CancellationTokenSource();
+        lock (_lock)
+        {
+            _cts?.Cancel();
+            _cts = cts;
+            _current = session;
+        }
+
+        Logger.Info("GameStats", $"Sampling game process {process.Id}");
+        _ = Task.Run(() => SampleLoopAsync(process, session, cts.Token));
+    }
+
+    private void Stop()
+    {
+        lock (_lock)
+        {
+            _cts?.Cancel();
+            _cts = null;
+        }
+    }
+
+    private void FillInstanceDetails(GameSessionStats session)
+    {
+        if (session.InstanceId == null) return;
+        try
+        {
+            session.InstanceName = _instanceService.FindInstanceById(session.InstanceId)?.Name;
+            var path = _instanceService.GetInstancePathById(session.InstanceId);
+            if (!string.IsNullOrEmpty(path))
+            {
+                session.EnabledMods = _modService.GetInstanceInstalledMods(path)
+                    .Where(m => m.Enabled)
+                    .Select(m => m.Name)
+                    .ToList();
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameStats", $"Could not read instance details: {ex.Message}");
+        }
+    }
+
+    private async Task SampleLoopAsync(Process process, GameSessionStats session, CancellationToken ct)
+    {
+        var gpu = new GpuUsageReader(process.Id);
+        var clock = Stopwatch.StartNew();
+        var lastCpu = TimeSpan.Zero;
+        var lastTime = TimeSpan.Zero;
+        double cpuSum = 0;
+
+        try
+        {
+            lastCpu = process.TotalProcessorTime;
+            using var timer = new PeriodicTimer(SampleInterval);
+            while (await timer.WaitForNextTickAsync(ct))
+            {
+                process.Refresh();
+                if (process.HasExited) break;
+
+                var cpu = process.TotalProcessorTime;
+                var now = clock.Elapsed;
+                var cpuPercent = (cpu - lastCpu).TotalMilliseconds
+                    / ((now - lastTime).TotalMilliseconds * Environment.ProcessorCount) * 100;
+                lastCpu = cpu;
+                lastTime = now;
+
+                var sample = new GameStatsSample
+                {
+                    InstanceId = session.InstanceId,
+                    Pid = process.Id,
+                    CpuPercent = Math.Round(Math.Clamp(cpuPercent, 0, 100), 1),
+                    RssBytes = process.WorkingSet64
+                };
+                gpu.Read(sample, session.SampleCount % GpuQueryEvery == 0);
+
+                lock (_lock)
+                {
+                    session.SampleCount++;
+                    cpuSum += sample.CpuPercent;
+                    session.AverageCpuPercent = Math.Round(cpuSum / session.SampleCount, 1);
+                    session.PeakCpuPercent = Math.Max(session.PeakCpuPercent, sample.CpuPercent);
+                    session.PeakRssBytes = Math.Max(session.PeakRssBytes, sample.RssBytes);
+                    if (sample.GpuPercent is double gpuPercent)
+                        session.PeakGpuPercent = Math.Max(session.PeakGpuPercent ?? 0, gpuPercent);
+                    if (sample.GpuMemoryBytes is long gpuMemory)
+                        session.PeakGpuMemoryBytes = Math.Max(session.PeakGpuMemoryBytes ?? 0, gpuMemory);
+                }
+
+                SampleTaken?.Invoke(sample);
+            }
+        }
+        catch (OperationCanceledException)
+        {
+            // Game stopped, or a new session replaced this one
+        }
+        catch (Exception ex) when (ex is InvalidOperationException or Win32Exception)
+        {
+            // The process went away between two readings
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameStats", $"Game sampling failed: {ex.Message}");
+        }
+        finally
+        {
+            process.Dispose();
+            FinishSession(session);
+        }
+    }
+
+    private void FinishSession(GameSessionStats session)
+    {
+        lock (_lock)
+        {
+            session.EndedAt = DateTime.UtcNow;
+            if (_current == session) _current = null;
+            if (session.SampleCount == 0) return;
+
+            var history = LoadHistory();
+            history.Insert(0, session);
+            if (history.Count > MaxHistory) history.RemoveRange(MaxHistory, history.Count - MaxHistory);
+            SaveHistory(history);
+        }
+
+        Logger.Info("GameStats", $"Session on {session.InstanceName ?? session.InstanceId ?? "unknown instance"}: " +
+            $"{session.SampleCount} samples, CPU avg {session.AverageCpuPercent}% peak {session.PeakCpuPercent}%, " +
+            $"RSS peak {session.PeakRssBytes / (1024 * 1024)} MB" +
+            (session.PeakGpuPercent is double g ? $", GPU peak {g}%" : "") +
+            (session.PeakGpuMemoryBytes is long m ? $", VRAM peak {m / (1024 * 1024)} MB" : "") +
+            $", {session.EnabledMods.Count} mod(s)");
+    }
+
+    private List<GameSessionStats> LoadHistory()
+    {
+        if (_history != null) return _history;
+        try
+        {
+            _history = File.Exists(_historyPath)
+                ? JsonSerializer.Deserialize<List<GameSessionStats>>(File.ReadAllText(_historyPath)) ?? new()
+                : new();
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameStats", $"Unreadable session history, starting over: {ex.Message}");
+            _history = new();
+        }
+        return _history;
+    }
+
+    private void SaveHistory(List<GameSessionStats> history)
+    {
+        try
+        {
+            File.WriteAllText(_historyPath + ".tmp", JsonSerializer.Serialize(history));
+            File.Move(_historyPath + ".tmp", _historyPath, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameStats", $"Failed to save session history: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Reads per-process GPU usage; gives up on a source after it fails once.
+    /// </summary>
+    private sealed class GpuUsageReader
+    {
+        private readonly int _pid;
+        private readonly Stopwatch _clock = Stopwatch.StartNew();
+        private bool _fdinfoAvailable = RuntimeInformation.IsOSPlatform(OSPlatform.Linux);
+        private bool _nvidiaAvailable = !RuntimeInformation.IsOSPlatform(OSPlatform.OSX);
+        private Dictionary<string, long>? _lastEngineNs;
+        private TimeSpan _lastEngineTime;
+        private double? _lastNvidiaPercent;
+        private long? _lastNvidiaMemory;
+
+        public GpuUsageReader(int pid) => _pid = pid;
+
+        public void Read(GameStatsSample sample, bool queryNvidia)
+        {
+            if (_fdinfoAvailable && ReadFdinfo(sample)) return;
+
+            if (_nvidiaAvailable && queryNvidia) ReadNvidiaSmi();
+            sample.GpuPercent = _lastNvidiaPercent;
+            sample.GpuMemoryBytes = _lastNvidiaMemory;
+        }
+
+        /// <summary>
+        /// DRM clients report cumulative busy time per engine (<c>drm-engine-*</c>, nanoseconds)
+        /// and memory per region (<c>drm-memory-*</c> / <c>drm-resident-*</c>) in /proc/&lt;pid&gt;/fdinfo.
+        /// </summary>
+        private bool ReadFdinfo(GameStatsSample sample)
+        {
+            var engineNs = new Dictionary<string, long>();
+            long memoryBytes = 0;
+            var seenClients = new HashSet<string>();
+
+            try
+            {
+                foreach (var file in Directory.EnumerateFiles($"/proc/{_pid}/fdinfo"))
+                {
+                    string[] lines;
+                    try { lines = File.ReadAllLines(file); }
+                    catch (IOException) { continue; }
+                    catch (UnauthorizedAccessException) { continue; }
+
+                    var clientId = lines.FirstOrDefault(l => l.StartsWith("drm-client-id:"));
+                    // Several descriptors can point at the same DRM client
+                    if (clientId == null || !seenClients.Add(clientId)) continue;
+
+                    foreach (var line in lines)
+                    {
+                        var colon = line.IndexOf(':');
+                        if (colon < 0) continue;
+                        var key = line[..colon];
+                        var value = line[(colon + 1)..].Trim();
+
+                        if (key.StartsWith("drm-engine-") && !key.StartsWith("drm-engine-capacity"))
+                        {
+                            var ns = long.TryParse(value.Split(' ')[0], out var n) ? n : 0;
+                            engineNs[key] = engineNs.GetValueOrDefault(key) + ns;
+                        }
+                        else if (key.StartsWith("drm-memory-vram") || key.StartsWith("drm-resident-vram")
+                                 || key.StartsWith("drm-resident-local"))
+                        {
+                            memoryBytes += ParseSize(value);
+                        }
+                    }
+                }
+            }
+            catch (Exception ex) when (ex is DirectoryNotFoundException or UnauthorizedAccessException or IOException)
+            {
+                _fdinfoAvailable = false;
+                return false;
+            }
+
+            if (seenClients.Count == 0)
+            {
+                _fdinfoAvailable = false;
+                return false;
+            }
+
+            var now = _clock.Elapsed;
+            if (_lastEngineNs != null && engineNs.Count > 0 && now > _lastEngineTime)
+            {
+                // The busiest engine is the closest match to a single "GPU %" figure
+                var elapsedNs = (now - _lastEngineTime).TotalMilliseconds * 1_000_000;
+                var busiest = engineNs.Max(e => e.Value - _lastEngineNs.GetValueOrDefault(e.Key));
+                sample.GpuPercent = Math.Round(Math.Clamp(busiest / elapsedNs * 100, 0, 100), 1);
+            }
+            _lastEngineNs = engineNs;
+            _lastEngineTime = now;
+            if (memoryBytes > 0) sample.GpuMemoryBytes = memoryBytes;
+            return engineNs.Count > 0 || memoryBytes > 0;
+        }
+
+        private static long ParseSize(string value)
+        {
+            var parts = value.Split(' ', StringSplitOptions.RemoveEmptyEntries);
+            if (parts.Length == 0 || !long.TryParse(parts[0], out var amount)) return 0;
+            return (parts.Length > 1 ? parts[1] : "") switch
+            {
+                "KiB" => amount * 1024,
+                "MiB" => amount * 1024 * 1024,
+                "GiB" => amount * 1024 * 1024 * 1024,
+                _ => amount
+            };
+        }
+
+        /// <summary>
+        /// Parses one <c>nvidia-smi pmon</c> snapshot; columns differ between driver versions, so they are
+        /// located through the header line.
+        /// </summary>
+        private void ReadNvidiaSmi()
+        {
+            try
+            {
+                using var process = Process.Start(new ProcessStartInfo
+                {
+                    FileName = "nvidia-smi",
+                    Arguments = "pmon -c 1 -s um",
+                    UseShellExecute = false,
+                    RedirectStandardOutput = true,
+                    RedirectStandardError = true,
+                    CreateNoWindow = true
+                });
+                if (process == null)
+                {
+                    _nvidiaAvailable = false;
+                    return;
+                }
+
+                var output = process.StandardOutput.ReadToEnd();
+                process.WaitForExit(5000);
+
+                string[]? header = null;
+                double? percent = null;
+                long? memory = null;
+                foreach (var line in output.Split('\n', StringSplitOptions.RemoveEmptyEntries))
+                {
+                    var columns = line.TrimStart('#').Split(' ', StringSplitOptions.RemoveEmptyEntries);
+                    if (line.StartsWith('#'))
+                    {
+                        header ??= columns;
+                        continue;
+                    }
+                    if (header == null) continue;
+
+                    int pidCol = Array.IndexOf(header, "pid");
+                    if (pidCol < 0 || pidCol >= columns.Length || columns[pidCol] != _pid.ToString()) continue;
+
+                    int smCol = Array.IndexOf(header, "sm");
+                    int fbCol = Array.IndexOf(header, "fb");
+                    if (smCol >= 0 && smCol < columns.Length && double.TryParse(columns[smCol], out var sm))
+                        percent = (percent ?? 0) + sm;
+                    if (fbCol >= 0 && fbCol < columns.Length && long.TryParse(columns[fbCol], out var fb))
+                        memory = (memory ?? 0) + fb * 1024 * 1024;
+                }
+
+                _lastNvidiaPercent = percent;
+                _lastNvidiaMemory = memory;
+            }
+            catch (Exception)
+            {
+                // No NVIDIA driver tools on this system
+                _nvidiaAvailable = false;
+            }
+        }
+    }
+}
@@ -0,0 +1,23 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Matches game output against known failure signatures and produces a structured diagnosis.
+/// </summary>
+public interface ICrashAnalyzerService
+{
+    /// <summary>
+    /// Analyzes the output of the last game session, falling back to the newest
+    /// log file of the selected instance when no output was captured.
+    /// </summary>
+    /// <returns>The diagnosis, with an empty issue list when nothing known matched.</returns>
+    CrashDiagnosis AnalyzeLastCrash();
+
+    /// <summary>
+    /// Analyzes an arbitrary set of log lines.
+    /// </summary>
+    /// <param name="lines">The log lines, oldest first.</param>
+    /// <returns>The diagnosis for the given lines.</returns>
+    CrashDiagnosis Analyze(IReadOnlyList<string> lines);
+}
@@ -1,3 +1,5 @@
+using HyPrism.Models;
+
 namespace HyPrism.Services.Game.Launch;
 
 /// <summary>
@@ -13,6 +15,24 @@ public interface IGameLauncher
     /// <param name="branch">The game branch ("release" or "pre-release").</param>
     /// <param name="ct">Token to cancel the launch operation.</param>
     /// <exception cref="InvalidOperationException">Thrown if the game is already running.</exception>
-    /// <exception cref="FileNotFoundException">Thrown if the client executable is not found.</exception>
+    /// <exception cref="HyPrism.Services.Core.App.LauncherException">Thrown if the client executable or Java is missing.</exception>
     Task LaunchGameAsync(string versionPath, string branch, CancellationToken ct = default);
+
+    /// <summary>
+    /// Builds the command a launch of the instance would run, with the instance's extra client arguments,
+    /// without patching, authenticating or installing anything. Session tokens are shown as placeholders.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <returns>The command, or <c>null</c> if the instance does not exist.</returns>
+    Task<LaunchCommand?> GetLaunchCommandPreviewAsync(string instanceId);
+
+    /// <summary>
+    /// Writes the launch command of the instance as a shell script (<c>.bat</c> on Windows) readable by the
+    /// current user only. The script starts the game in offline mode, so no session tokens end up on disk.
+    /// </summary>
+    /// <param name="instanceId">The instance ID (GUID).</param>
+    /// <param name="destinationPath">Where to write the script.</param>
+    /// <returns>The written path, or <c>null</c> if the instance does not exist.</returns>
+    /// <exception cref="InvalidOperationException">The instance's Java runtime is not installed yet.</exception>
+    Task<string?> ExportLaunchScriptAsync(string instanceId, string destinationPath);
 }
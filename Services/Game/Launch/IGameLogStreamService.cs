@@ -0,0 +1,28 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Follows the game's session log file (like <c>tail -f</c>) and reports new lines in batches.
+/// </summary>
+public interface IGameLogStreamService
+{
+    /// <summary>
+    /// Raised for every batch of new lines, and once more with <see cref="GameLogChunk.Ended"/> set
+    /// when the stream ends on its own (not after <see cref="Stop"/>).
+    /// </summary>
+    event Action<GameLogChunk>? ChunkReceived;
+
+    /// <summary>
+    /// Starts following the newest log of an instance, replacing any active stream.
+    /// The most recent lines are sent first; the stream ends when the game exits.
+    /// </summary>
+    /// <param name="instanceId">The instance whose <c>UserData/Logs</c> to follow.</param>
+    /// <returns><c>false</c> if the instance does not exist.</returns>
+    bool Start(string instanceId);
+
+    /// <summary>
+    /// Stops the active stream, if any.
+    /// </summary>
+    void Stop();
+}
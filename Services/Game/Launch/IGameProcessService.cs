@@ -37,7 +37,8 @@ public interface IGameProcessService
     bool CheckForRunningGame();
 
     /// <summary>
-    /// Terminates the current game process if it is running.
+    /// Terminates the current game process and its child processes if it is running.
+    /// <see cref="ProcessExited"/> is raised as for a normal exit.
     /// </summary>
     /// <returns><c>true</c> if the game was successfully terminated; otherwise, <c>false</c>.</returns>
     bool ExitGame();
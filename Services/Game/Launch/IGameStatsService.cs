@@ -0,0 +1,25 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Samples CPU, memory and (where obtainable) GPU usage of the running game and keeps per-session peaks.
+/// </summary>
+public interface IGameStatsService
+{
+    /// <summary>
+    /// Raised for every reading while the game runs.
+    /// </summary>
+    event Action<GameStatsSample>? SampleTaken;
+
+    /// <summary>
+    /// Gets the summary of the running session so far.
+    /// </summary>
+    /// <returns>The session, or <c>null</c> if the game is not running.</returns>
+    GameSessionStats? GetCurrentSession();
+
+    /// <summary>
+    /// Gets the summaries of recent finished sessions, newest first.
+    /// </summary>
+    List<GameSessionStats> GetSessionHistory();
+}
@@ -0,0 +1,92 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Installs and tracks Java runtimes side by side with the shared Hytale JRE
+/// and resolves which one an instance launches with.
+/// </summary>
+public interface IJavaRuntimeService
+{
+    /// <summary>
+    /// Gets the shared Hytale JRE followed by all managed runtimes on disk.
+    /// </summary>
+    List<JavaRuntimeInfo> GetInstalledRuntimes();
+
+    /// <summary>
+    /// Gets runtimes that can be installed for this platform, with <see cref="JavaRuntimeInfo.IsInstalled"/> set.
+    /// </summary>
+    Task<List<JavaRuntimeInfo>> GetAvailableRuntimesAsync();
+
+    /// <summary>
+    /// Scans <c>JAVA_HOME</c>, <c>PATH</c>, common install folders and (on Windows) the registry for
+    /// Java installations and checks their versions. Results are cached until <paramref name="refresh"/> is set.
+    /// </summary>
+    /// <param name="refresh">Whether to rescan instead of returning the cached result.</param>
+    /// <returns>Detected runtimes with <see cref="JavaRuntimeInfo.IsCompatible"/> set.</returns>
+    Task<List<JavaRuntimeInfo>> DetectSystemRuntimesAsync(bool refresh = false);
+
+    /// <summary>
+    /// Downloads, verifies and extracts a runtime. Already installed runtimes are returned as-is.
+    /// </summary>
+    /// <param name="runtimeId">"default" or "temurin-{feature}".</param>
+    /// <param name="progressCallback">Receives progress (0-100) and a status message.</param>
+    /// <param name="ct">Cancellation token.</param>
+    Task<JavaRuntimeInfo> InstallRuntimeAsync(string runtimeId, Action<int, string>? progressCallback = null, CancellationToken ct = default);
+
+    /// <summary>
+    /// Checks that the runtime's java binary exists and reports the expected feature version.
+    /// </summary>
+    /// <param name="runtimeId">The runtime ID.</param>
+    /// <returns><c>true</c> if the runtime is usable.</returns>
+    Task<bool> VerifyRuntimeAsync(string runtimeId);
+
+    /// <summary>
+    /// Deletes a runtime's files and downloads it again.
+    /// </summary>
+    /// <param name="runtimeId">The runtime ID.</param>
+    /// <param name="progressCallback">Receives progress (0-100) and a status message.</param>
+    /// <param name="ct">Cancellation token.</param>
+    Task<JavaRuntimeInfo> RepairRuntimeAsync(string runtimeId, Action<int, string>? progressCallback = null, CancellationToken ct = default);
+
+    /// <summary>
+    /// Removes a managed runtime. The shared Hytale JRE cannot be removed.
+    /// </summary>
+    /// <param name="runtimeId">The runtime ID.</param>
+    /// <returns><c>true</c> if the runtime was removed.</returns>
+    bool RemoveRuntime(string runtimeId);
+
+    /// <summary>
+    /// Gets the runtime selected for an instance; "default" when none is set.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    string GetInstanceRuntimeId(string instanceId);
+
+    /// <summary>
+    /// Selects the runtime an instance launches with. Null or "default" selects the shared Hytale JRE;
+    /// a detected system runtime must be new enough to run the game.
+    /// </summary>
+    /// <param name="instanceId">The instance ID.</param>
+    /// <param name="runtimeId">The runtime ID.</param>
+    /// <returns><c>true</c> if the selection was saved.</returns>
+    Task<bool> SetInstanceRuntimeAsync(string instanceId, string? runtimeId);
+
+    /// <summary>
+    /// Resolves the java executable for the instance at <paramref name="versionPath"/>,
+    /// installing its selected runtime first if it is missing. A selected system runtime that is
+    /// no longer usable falls back to the shared Hytale JRE.
+    /// </summary>
+    /// <param name="versionPath">The instance directory.</param>
+    /// <param name="progressCallback">Receives progress (0-100) and a status message while installing.</param>
+    /// <param name="ct">Cancellation token.</param>
+    /// <returns>Path to the java executable.</returns>
+    Task<string> EnsureJavaForInstanceAsync(string versionPath, Action<int, string>? progressCallback = null, CancellationToken ct = default);
+
+    /// <summary>
+    /// Resolves the java executable the instance at <paramref name="versionPath"/> would launch with,
+    /// without installing, repairing or linking anything.
+    /// </summary>
+    /// <param name="versionPath">The instance directory.</param>
+    /// <returns>Path to the java executable, or <c>null</c> if the selected runtime is installed on launch.</returns>
+    Task<string?> GetJavaPathForInstanceAsync(string versionPath);
+}
@@ -0,0 +1,47 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Tracks daily playtime and enforces the optional limits in <c>Config.PlaytimeLimits</c>:
+/// warns before a limit, then closes the game once it is reached.
+/// </summary>
+public interface IPlaytimeLimitService
+{
+    /// <summary>
+    /// Raised when a limit is close or reached.
+    /// </summary>
+    event Action<PlaytimeWarning>? Warning;
+
+    /// <summary>
+    /// Gets the current settings and today's playtime.
+    /// </summary>
+    PlaytimeStatus GetStatus();
+
+    /// <summary>
+    /// Changes the limits.
+    /// </summary>
+    /// <param name="update">The new settings, with the PIN if one is set.</param>
+    /// <returns>The updated status.</returns>
+    /// <exception cref="UnauthorizedAccessException">A PIN is set and <paramref name="update"/> does not carry it.</exception>
+    /// <exception cref="ArgumentException">The allowed hours are not valid "HH:mm" times.</exception>
+    PlaytimeStatus UpdateLimits(PlaytimeLimitUpdate update);
+
+    /// <summary>
+    /// Sets, changes or removes the PIN.
+    /// </summary>
+    /// <param name="currentPin">The current PIN; ignored when none is set.</param>
+    /// <param name="newPin">The new PIN (at least 4 digits), or empty to remove it.</param>
+    /// <exception cref="UnauthorizedAccessException">The current PIN is wrong.</exception>
+    /// <exception cref="ArgumentException">The new PIN is too short or not numeric.</exception>
+    void SetPin(string? currentPin, string newPin);
+
+    /// <summary>
+    /// Lifts the limits for a while, e.g. to finish a match.
+    /// </summary>
+    /// <param name="pin">The PIN; required, so an override needs a PIN to be set first.</param>
+    /// <param name="minutes">How long the limits are lifted.</param>
+    /// <returns>The updated status.</returns>
+    /// <exception cref="UnauthorizedAccessException">No PIN is set or it is wrong.</exception>
+    PlaytimeStatus GrantOverride(string pin, int minutes);
+}
@@ -0,0 +1,747 @@
+using System.Diagnostics;
+using System.IO.Compression;
+using System.Runtime.InteropServices;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
+using HyPrism.Services.Game.Download;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Manages Java runtimes installed under <c>Runtimes/{id}</c> next to the shared Hytale JRE.
+/// </summary>
+/// <remarks>
+/// The shared JRE ("default") is still installed by <see cref="ILaunchService"/>; additional
+/// runtimes come from Eclipse Temurin via the Adoptium API. Archives are verified against the
+/// published SHA-256 before extraction, and repairs extract into a staging directory so a
+/// failed download never leaves a half-deleted runtime behind.
+/// Java installations already on the system can be selected as well; they are used in place
+/// and only get a wrapper shim under <c>Runtimes/{id}</c> on Unix.
+/// Before launch, launcher-owned runtimes are checked with <see cref="RuntimeIntegrity"/> and
+/// re-downloaded automatically when they turn out to be damaged.
+/// </remarks>
+public class JavaRuntimeService : IJavaRuntimeService
+{
+    public const string DefaultRuntimeId = "default";
+
+    private const string AdoptiumApi = "https://api.adoptium.net/v3";
+
+    /// <summary>
+    /// Lowest Java feature version the game runs on (the Hytale JRE is 25).
+    /// </summary>
+    public const int RequiredFeatureVersion = 25;
+
+    private static readonly Regex TemurinIdPattern = new(@"^temurin-(\d+)$", RegexOptions.Compiled);
+    private static readonly Regex SystemIdPattern = new(@"^system-[0-9a-f]{12}$", RegexOptions.Compiled);
+    private static readonly JsonSerializerOptions RegistryJsonOptions = new() { WriteIndented = true };
+
+    private readonly string _appDir;
+    private readonly string _runtimesDir;
+    private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly IDownloadService _downloadService;
+    private readonly ILaunchService _launchService;
+    private readonly IInstanceService _instanceService;
+    private readonly IProgressNotificationService _progressService;
+    private readonly object _registryLock = new();
+    private readonly SemaphoreSlim _installLock = new(1, 1);
+    private readonly SemaphoreSlim _detectLock = new(1, 1);
+
+    private List<JavaRuntimeInfo>? _systemRuntimes;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="JavaRuntimeService"/> class.
+    /// </summary>
+    /// <param name="appDir">The application data directory path.</param>
+    /// <param name="httpClient">The HTTP client for downloading runtimes.</param>
+    /// <param name="networkPolicy">Timeout and retry policy for API calls.</param>
+    /// <param name="downloadService">Verified download primitive for runtime archives.</param>
+    /// <param name="launchService">Service that owns the shared Hytale JRE.</param>
+    /// <param name="instanceService">Service for reading and saving instance metadata.</param>
+    /// <param name="progressService">Receives the runtime download progress.</param>
+    public JavaRuntimeService(string appDir, HttpClient httpClient, NetworkPolicy networkPolicy,
+        IDownloadService downloadService, ILaunchService launchService, IInstanceService instanceService,
+        IProgressNotificationService progressService)
+    {
+        _progressService = progressService;
+        _networkPolicy = networkPolicy;
+        _downloadService = downloadService;
+        _appDir = appDir;
+        _runtimesDir = Path.Combine(appDir, "Runtimes");
+        _httpClient = httpClient;
+        _launchService = launchService;
+        _instanceService = instanceService;
+    }
+
+    private string RegistryPath => Path.Combine(_runtimesDir, "runtimes.json");
+
+    /// <inheritdoc/>
+    public List<JavaRuntimeInfo> GetInstalledRuntimes()
+    {
+        var result = new List<JavaRuntimeInfo> { GetDefaultRuntime() };
+        result.AddRange(LoadRegistry().Runtimes
+            .Where(r => File.Exists(r.JavaPath))
+            .OrderByDescending(r => r.FeatureVersion)
+            .Select(r => { r.IsInstalled = true; r.IsCompatible = r.FeatureVersion >= RequiredFeatureVersion; return r; }));
+        if (_systemRuntimes != null) result.AddRange(_systemRuntimes);
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<JavaRuntimeInfo>> GetAvailableRuntimesAsync()
+    {
+        var installed = GetInstalledRuntimes().ToDictionary(r => r.Id);
+        var result = new List<JavaRuntimeInfo> { installed[DefaultRuntimeId] };
+
+        var featureVersions = new List<int>();
+        try
+        {
+            var json = await _networkPolicy.ExecuteAsync(RequestClass.Metadata, "Java release list",
+                token => _httpClient.GetStringAsync($"{AdoptiumApi}/info/available_releases", token));
+            using var doc = JsonDocument.Parse(json);
+            if (doc.RootElement.TryGetProperty("available_releases", out var releases))
+            {
+                featureVersions.AddRange(releases.EnumerateArray()
+                    .Select(e => e.GetInt32())
+                    .Where(v => v >= RequiredFeatureVersion));
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("JRE", $"Failed to fetch available Java releases: {ex.Message}");
+        }
+
+        // Installed runtimes stay listed even when the API is unreachable
+        featureVersions.AddRange(installed.Values.Where(r => !r.IsDefault && !r.IsSystem).Select(r => r.FeatureVersion));
+
+        foreach (var feature in featureVersions.Distinct().OrderByDescending(v => v))
+        {
+            var id = $"temurin-{feature}";
+            result.Add(installed.TryGetValue(id, out var runtime) ? runtime : new JavaRuntimeInfo
+            {
+                Id = id,
+                Name = $"Eclipse Temurin {feature}",
+                FeatureVersion = feature,
+                Vendor = "Eclipse Adoptium",
+                IsInstalled = false
+            });
+        }
+
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<JavaRuntimeInfo>> DetectSystemRuntimesAsync(bool refresh = false)
+    {
+        if (!refresh && _systemRuntimes != null) return _systemRuntimes;
+
+        await _detectLock.WaitAsync();
+        try
+        {
+            if (!refresh && _systemRuntimes != null) return _systemRuntimes;
+
+            var found = new List<JavaRuntimeInfo>();
+            foreach (var home in SystemJavaLocator.FindJavaHomes())
+            {
+                // Our own runtimes live under the app directory and are listed separately
+                if (home.StartsWith(_appDir, StringComparison.OrdinalIgnoreCase)) continue;
+
+                var javaPath = SystemJavaLocator.GetJavaBinary(home);
+                var feature = await _launchService.GetJavaFeatureVersionAsync(javaPath);
+                if (feature == 0)
+                {
+                    Logger.Debug("JRE", $"Ignoring {home}: java did not report a version");
+                    continue;
+                }
+
+                var (vendor, version) = SystemJavaLocator.ReadReleaseInfo(home);
+                found.Add(new JavaRuntimeInfo
+                {
+                    Id = GetSystemRuntimeId(home),
+                    Name = $"{vendor ?? "Java"} {version ?? feature.ToString()}",
+                    FeatureVersion = feature,
+                    Version = version,
+                    Vendor = vendor ?? "",
+                    HomePath = home,
+                    JavaPath = javaPath,
+                    IsSystem = true,
+                    IsInstalled = true,
+                    IsCompatible = feature >= RequiredFeatureVersion
+                });
+            }
+
+            _systemRuntimes = found
+                .GroupBy(r => r.Id)
+                .Select(g => g.First())
+                .OrderByDescending(r => r.FeatureVersion)
+                .ToList();
+            Logger.Info("JRE", $"Detected {_systemRuntimes.Count} system Java installation(s), " +
+                               $"{_systemRuntimes.Count(r => r.IsCompatible)} compatible");
+            return _systemRuntimes;
+        }
+        finally
+        {
+            _detectLock.Release();
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<JavaRuntimeInfo> InstallRuntimeAsync(string runtimeId, Action<int, string>? progressCallback = null, CancellationToken ct = default)
+    {
+        progressCallback ??= (_, _) => { };
+
+        if (runtimeId == DefaultRuntimeId)
+        {
+            await _launchService.EnsureJREInstalledAsync(progressCallback);
+            return GetDefaultRuntime();
+        }
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            throw new InvalidOperationException("System Java installations are managed outside the launcher");
+        }
+
+        var existing = FindManagedRuntime(runtimeId);
+        if (existing != null && File.Exists(existing.JavaPath))
+        {
+            progressCallback(100, "Java Runtime ready");
+            return existing;
+        }
+
+        return await DownloadManagedRuntimeAsync(runtimeId, progressCallback, ct);
+    }
+
+    /// <inheritdoc/>
+    public async Task<bool> VerifyRuntimeAsync(string runtimeId)
+    {
+        var runtime = await FindRuntimeAsync(runtimeId);
+        if (runtime == null || !File.Exists(runtime.JavaPath))
+        {
+            Logger.Warning("JRE", $"Runtime {runtimeId} is not installed");
+            return false;
+        }
+
+        if (!runtime.IsSystem && !RuntimeIntegrity.Verify(runtime.HomePath, out var problem))
+        {
+            Logger.Warning("JRE", $"Runtime {runtimeId} failed integrity check: {problem}");
+            return false;
+        }
+
+        var feature = await _launchService.GetJavaFeatureVersionAsync(runtime.JavaPath);
+        if (feature == 0)
+        {
+            Logger.Warning("JRE", $"Runtime {runtimeId} failed to start");
+            return false;
+        }
+        if (runtime.IsSystem && feature < RequiredFeatureVersion)
+        {
+            Logger.Warning("JRE", $"Runtime {runtimeId} is Java {feature}, the game needs {RequiredFeatureVersion}+");
+            return false;
+        }
+        if (!runtime.IsDefault && feature != runtime.FeatureVersion)
+        {
+            Logger.Warning("JRE", $"Runtime {runtimeId} reports Java {feature}, expected {runtime.FeatureVersion}");
+            return false;
+        }
+
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public async Task<JavaRuntimeInfo> RepairRuntimeAsync(string runtimeId, Action<int, string>? progressCallback = null, CancellationToken ct = default)
+    {
+        progressCallback ??= (_, _) => { };
+        Logger.Info("JRE", $"Repairing runtime {runtimeId}...");
+
+        if (runtimeId == DefaultRuntimeId)
+        {
+            // Dropping the version marker makes the launch service reinstall from scratch
+            try { File.Delete(Path.Combine(_appDir, "Jre", ".jre_version")); } catch { }
+            await _launchService.EnsureJREInstalledAsync(progressCallback);
+            return GetDefaultRuntime();
+        }
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            throw new InvalidOperationException("System Java installations are managed outside the launcher");
+        }
+
+        return await DownloadManagedRuntimeAsync(runtimeId, progressCallback, ct);
+    }
+
+    /// <inheritdoc/>
+    public bool RemoveRuntime(string runtimeId)
+    {
+        if (runtimeId == DefaultRuntimeId)
+        {
+            Logger.Warning("JRE", "The shared Hytale JRE cannot be removed");
+            return false;
+        }
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            Logger.Warning("JRE", "System Java installations cannot be removed from the launcher");
+            return false;
+        }
+
+        var runtime = FindManagedRuntime(runtimeId);
+        if (runtime == null) return false;
+
+        try
+        {
+            var dir = Path.Combine(_runtimesDir, runtimeId);
+            if (Directory.Exists(dir)) Directory.Delete(dir, true);
+
+            lock (_registryLock)
+            {
+                var registry = LoadRegistry();
+                registry.Runtimes.RemoveAll(r => r.Id == runtimeId);
+                SaveRegistry(registry);
+            }
+
+            Logger.Success("JRE", $"Removed runtime {runtimeId}");
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("JRE", $"Failed to remove runtime {runtimeId}: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <inheritdoc/>
+    public string GetInstanceRuntimeId(string instanceId)
+    {
+        var path = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(path)) return DefaultRuntimeId;
+        return _instanceService.GetInstanceMeta(path)?.JavaRuntimeId ?? DefaultRuntimeId;
+    }
+
+    /// <inheritdoc/>
+    public async Task<bool> SetInstanceRuntimeAsync(string instanceId, string? runtimeId)
+    {
+        if (string.IsNullOrWhiteSpace(runtimeId)) runtimeId = DefaultRuntimeId;
+        if (runtimeId != DefaultRuntimeId && !TemurinIdPattern.IsMatch(runtimeId) && !SystemIdPattern.IsMatch(runtimeId))
+        {
+            Logger.Warning("JRE", $"Unknown runtime ID: {runtimeId}");
+            return false;
+        }
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            var system = (await DetectSystemRuntimesAsync()).FirstOrDefault(r => r.Id == runtimeId);
+            if (system == null || !system.IsCompatible)
+            {
+                Logger.Warning("JRE", $"System runtime {runtimeId} is missing or older than Java {RequiredFeatureVersion}");
+                return false;
+            }
+        }
+
+        var path = _instanceService.GetInstancePathById(instanceId);
+        if (string.IsNullOrEmpty(path)) return false;
+
+        var meta = _instanceService.GetInstanceMeta(path);
+        if (meta == null) return false;
+
+        meta.JavaRuntimeId = runtimeId == DefaultRuntimeId ? null : runtimeId;
+        _instanceService.SaveInstanceMeta(path, meta);
+        Logger.Info("JRE", $"Instance {instanceId} now uses runtime {runtimeId}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public async Task<string> EnsureJavaForInstanceAsync(string versionPath, Action<int, string>? progressCallback = null, CancellationToken ct = default)
+    {
+        var runtimeId = _instanceService.GetInstanceMeta(versionPath)?.JavaRuntimeId;
+        if (string.IsNullOrEmpty(runtimeId) || runtimeId == DefaultRuntimeId)
+        {
+            var defaultRuntime = GetDefaultRuntime();
+            if (!defaultRuntime.IsInstalled)
+            {
+                await _launchService.EnsureJREInstalledAsync(progressCallback ?? ((_, _) => { }));
+            }
+            else
+            {
+                await EnsureIntactAsync(defaultRuntime, progressCallback, ct);
+            }
+            return _launchService.GetJavaPath();
+        }
+
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            var system = (await DetectSystemRuntimesAsync()).FirstOrDefault(r => r.Id == runtimeId);
+            if (system is { IsCompatible: true } && File.Exists(system.JavaPath))
+            {
+                return EnsureSystemShim(system);
+            }
+
+            // The selected system Java was uninstalled or downgraded; keep the game launchable
+            Logger.Warning("JRE", $"System runtime {runtimeId} is no longer usable, falling back to the Hytale JRE");
+            return _launchService.GetJavaPath();
+        }
+
+        var runtime = FindManagedRuntime(runtimeId);
+        if (runtime == null || !File.Exists(runtime.JavaPath))
+        {
+            Logger.Info("JRE", $"Runtime {runtimeId} selected for instance is missing, installing...");
+            runtime = await InstallRuntimeAsync(runtimeId, progressCallback, ct);
+        }
+        else
+        {
+            runtime = await EnsureIntactAsync(runtime, progressCallback, ct);
+        }
+
+        return runtime.JavaPath;
+    }
+
+    /// <inheritdoc/>
+    public async Task<string?> GetJavaPathForInstanceAsync(string versionPath)
+    {
+        var runtimeId = _instanceService.GetInstanceMeta(versionPath)?.JavaRuntimeId;
+        if (string.IsNullOrEmpty(runtimeId) || runtimeId == DefaultRuntimeId)
+            return GetDefaultRuntime().IsInstalled ? _launchService.GetJavaPath() : null;
+
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            var system = (await DetectSystemRuntimesAsync()).FirstOrDefault(r => r.Id == runtimeId);
+            if (system is not { IsCompatible: true } || !File.Exists(system.JavaPath))
+                return _launchService.GetJavaPath();
+
+            // Outside Windows the launch goes through the shim EnsureSystemShim keeps next to the link
+            return RuntimeInformation.IsOSPlatform(OSPlatform.Windows)
+                ? system.JavaPath
+                : Path.Combine(_runtimesDir, runtimeId, "bin", "java");
+        }
+
+        var runtime = FindManagedRuntime(runtimeId);
+        return runtime != null && File.Exists(runtime.JavaPath) ? runtime.JavaPath : null;
+    }
+
+    /// <summary>
+    /// Re-downloads a launcher-owned runtime whose files no longer match its manifest.
+    /// </summary>
+    private async Task<JavaRuntimeInfo> EnsureIntactAsync(JavaRuntimeInfo runtime, Action<int, string>? progressCallback, CancellationToken ct)
+    {
+        if (RuntimeIntegrity.Verify(runtime.HomePath, out var problem))
+        {
+            return runtime;
+        }
+
+        Logger.Warning("JRE", $"Runtime {runtime.Id} is damaged ({problem}), downloading it again...");
+        var repaired = await RepairRuntimeAsync(runtime.Id, progressCallback, ct);
+
+        if (!RuntimeIntegrity.Verify(repaired.HomePath, out problem))
+        {
+            throw new InvalidOperationException($"Java runtime {runtime.Id} is still damaged after re-download: {problem}");
+        }
+
+        Logger.Success("JRE", $"Runtime {runtime.Id} repaired");
+        return repaired;
+    }
+
+    private async Task<JavaRuntimeInfo> DownloadManagedRuntimeAsync(string runtimeId, Action<int, string> progressCallback, CancellationToken ct)
+    {
+        var match = TemurinIdPattern.Match(runtimeId);
+        if (!match.Success)
+        {
+            throw new ArgumentException($"Unknown runtime ID: {runtimeId}", nameof(runtimeId));
+        }
+        int feature = int.Parse(match.Groups[1].Value);
+
+        await _installLock.WaitAsync(ct);
+        try
+        {
+            progressCallback(0, "Downloading Java Runtime...");
+            var (url, sha256, version) = await ResolveTemurinPackageAsync(feature, ct);
+            Logger.Info("JRE", $"Installing {runtimeId} ({version}) from {url}");
+
+            Directory.CreateDirectory(_runtimesDir);
+            string archiveType = url.EndsWith(".zip", StringComparison.OrdinalIgnoreCase) ? "zip" : "tar.gz";
+            string archivePath = Path.Combine(_runtimesDir, $"{runtimeId}.{archiveType}");
+            string stagingDir = Path.Combine(_runtimesDir, $"{runtimeId}.staging");
+            string targetDir = Path.Combine(_runtimesDir, runtimeId);
+            using var operation = _progressService.BeginOperation("jre");
+
+            try
+            {
+                if (File.Exists(archivePath)) File.Delete(archivePath);
+                if (string.IsNullOrWhiteSpace(sha256))
+                {
+                    Logger.Warning("JRE", $"No checksum published for {runtimeId}, skipping verification");
+                }
+                await _downloadService.DownloadFileAsync(url, archivePath, p =>
+                {
+                    var scaled = p.Percent * 80 / 100; // 0-80%
+                    progressCallback(scaled, $"Downloading Java Runtime... {scaled}%");
+                    operation.Report("download", scaled, p, "launch.detail.java_download");
+                }, string.IsNullOrWhiteSpace(sha256) ? null : ExpectedHash.Sha256(sha256), ct);
+
+                progressCallback(85, "Extracting Java Runtime...");
+                operation.Report("extract", 85, messageKey: "launch.detail.java_extract");
+                if (Directory.Exists(stagingDir)) Directory.Delete(stagingDir, true);
+                Directory.CreateDirectory(stagingDir);
+                ExtractArchive(archivePath, archiveType, stagingDir);
+                NormalizeRuntimeLayout(stagingDir);
+
+                // Swap only after the new copy extracted successfully
+                if (Directory.Exists(targetDir)) Directory.Delete(targetDir, true);
+                Directory.Move(stagingDir, targetDir);
+                operation.Complete();
+            }
+            catch (OperationCanceledException)
+            {
+                operation.MarkCancelled();
+                throw;
+            }
+            finally
+            {
+                try { File.Delete(archivePath); } catch { }
+                try { if (Directory.Exists(stagingDir)) Directory.Delete(stagingDir, true); } catch { }
+            }
+
+            string javaPath = GetJavaBinary(targetDir);
+            if (!File.Exists(javaPath))
+            {
+                throw new FileNotFoundException($"java executable not found in runtime {runtimeId}", javaPath);
+            }
+
+            if (!RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+            {
+                LaunchService.RunSilentProcess("chmod", $"+x \"{javaPath}\"");
+            }
+            if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
+            {
+                LaunchService.RunSilentProcess("xattr", $"-cr \"{targetDir}\"");
+                LaunchService.RunSilentProcess("codesign", $"--force --deep --sign - \"{targetDir}\"");
+            }
+            LaunchService.EnsureJavaWrapper(javaPath);
+            RuntimeIntegrity.WriteManifest(targetDir);
+
+            var runtime = new JavaRuntimeInfo
+            {
+                Id = runtimeId,
+                Name = $"Eclipse Temurin {feature}",
+                FeatureVersion = feature,
+                Version = version,
+                Vendor = "Eclipse Adoptium",
+                HomePath = targetDir,
+                JavaPath = javaPath,
+                ArchiveSha256 = sha256,
+                SourceUrl = url,
+                InstalledAt = DateTime.UtcNow,
+                IsInstalled = true
+            };
+
+            lock (_registryLock)
+            {
+                var registry = LoadRegistry();
+                registry.Runtimes.RemoveAll(r => r.Id == runtimeId);
+                registry.Runtimes.Add(runtime);
+                SaveRegistry(registry);
+            }
+
+            progressCallback(100, "Java Runtime installed");
+            Logger.Success("JRE", $"Runtime {runtimeId} ({version}) installed");
+            return runtime;
+        }
+        finally
+        {
+            _installLock.Release();
+        }
+    }
+
+    /// <summary>
+    /// Points a launcher-owned wrapper at a system java so unsupported flags are filtered
+    /// the same way as for the bundled JRE. Windows uses the system binary directly.
+    /// </summary>
+    private string EnsureSystemShim(JavaRuntimeInfo runtime)
+    {
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            return runtime.JavaPath;
+        }
+
+        try
+        {
+            var binDir = Path.Combine(_runtimesDir, runtime.Id, "bin");
+            var realJava = Path.Combine(binDir, "java.real");
+            var shim = Path.Combine(binDir, "java");
+            Directory.CreateDirectory(binDir);
+
+            var current = File.Exists(realJava) ? new FileInfo(realJava).LinkTarget : null;
+            if (current != runtime.JavaPath)
+            {
+                File.Delete(realJava);
+                File.CreateSymbolicLink(realJava, runtime.JavaPath);
+            }
+
+            if (!File.Exists(shim))
+            {
+                LaunchService.EnsureJavaWrapper(shim);
+            }
+            return File.Exists(shim) ? shim : runtime.JavaPath;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("JRE", $"Failed to create wrapper for system Java, using it directly: {ex.Message}");
+            return runtime.JavaPath;
+        }
+    }
+
+    private async Task<(string url, string? sha256, string? version)> ResolveTemurinPackageAsync(int feature, CancellationToken ct)
+    {
+        string os = UtilityService.GetOS() switch { "darwin" => "mac", var o => o };
+        string arch = PlatformSupport.RequireArtifactArch(PlatformSupport.Temurin) == "arm64" ? "aarch64" : "x64";
+        string url = $"{AdoptiumApi}/assets/latest/{feature}/hotspot?architecture={arch}&image_type=jre&os={os}&vendor=eclipse";
+
+        var json = await _networkPolicy.ExecuteAsync(RequestClass.Metadata, $"Temurin {feature} lookup",
+            token => _httpClient.GetStringAsync(url, token), ct);
+        using var doc = JsonDocument.Parse(json);
+
+        foreach (var asset in doc.RootElement.EnumerateArray())
+        {
+            if (!asset.TryGetProperty("binary", out var binary) ||
+                !binary.TryGetProperty("package", out var package) ||
+                !package.TryGetProperty("link", out var link))
+            {
+                continue;
+            }
+
+            string? sha256 = package.TryGetProperty("checksum", out var checksum) ? checksum.GetString() : null;
+            string? version = asset.TryGetProperty("release_name", out var release) ? release.GetString() : null;
+            return (link.GetString()!, sha256, version);
+        }
+
+        throw new LauncherException(ErrorCodes.JavaMissing, MessageCatalog.ErrorJavaMissing, null,
+            $"No Temurin {feature} JRE available for {os}/{arch}");
+    }
+
+    private static void ExtractArchive(string archivePath, string archiveType, string destination)
+    {
+        if (archiveType == "zip")
+        {
+            ZipFile.ExtractToDirectory(archivePath, destination, true);
+            return;
+        }
+
+        var tar = Process.Start(new ProcessStartInfo("tar", $"-xzf \"{archivePath}\" -C \"{destination}\"")
+        {
+            UseShellExecute = false,
+            CreateNoWindow = true
+        });
+        tar?.WaitForExit();
+        if (tar == null || tar.ExitCode != 0)
+        {
+            throw new IOException($"Failed to extract {Path.GetFileName(archivePath)}");
+        }
+    }
+
+    /// <summary>
+    /// Moves the runtime home (the archive's single top-level folder, or <c>Contents/Home</c>
+    /// inside it on macOS) up to <paramref name="dir"/>.
+    /// </summary>
+    private static void NormalizeRuntimeLayout(string dir)
+    {
+        var entries = Directory.GetDirectories(dir);
+        if (entries.Length != 1 || Directory.GetFiles(dir).Length > 0) return;
+
+        var topLevel = entries[0];
+        var home = Path.Combine(topLevel, "Contents", "Home");
+        if (!Directory.Exists(home)) home = topLevel;
+
+        foreach (var entry in Directory.GetFileSystemEntries(home))
+        {
+            var dest = Path.Combine(dir, Path.GetFileName(entry));
+            if (File.Exists(dest) || Directory.Exists(dest)) continue;
+            if (Directory.Exists(entry)) Directory.Move(entry, dest);
+            else File.Move(entry, dest);
+        }
+
+        try { Directory.Delete(topLevel, true); } catch { }
+    }
+
+    private static string GetJavaBinary(string homeDir) =>
+        Path.Combine(homeDir, "bin", RuntimeInformation.IsOSPlatform(OSPlatform.Windows) ? "java.exe" : "java");
+
+    private JavaRuntimeInfo GetDefaultRuntime()
+    {
+        var javaPath = _launchService.GetJavaPath();
+        string? version = null;
+        try
+        {
+            var marker = Path.Combine(_appDir, "Jre", ".jre_version");
+            if (File.Exists(marker)) version = File.ReadAllText(marker).Trim();
+        }
+        catch { /* ignore */ }
+
+        return new JavaRuntimeInfo
+        {
+            Id = DefaultRuntimeId,
+            Name = "Hytale JRE",
+            FeatureVersion = ParseFeatureVersion(version),
+            Version = version,
+            Vendor = "Hytale",
+            HomePath = Path.Combine(_appDir, "Jre"),
+            JavaPath = javaPath,
+            IsDefault = true,
+            IsInstalled = File.Exists(javaPath)
+        };
+    }
+
+    private static int ParseFeatureVersion(string? version)
+    {
+        if (string.IsNullOrEmpty(version)) return 0;
+        var head = version.Split('.', '_', '+')[0];
+        return int.TryParse(head, out var feature) ? feature : 0;
+    }
+
+    private async Task<JavaRuntimeInfo?> FindRuntimeAsync(string runtimeId)
+    {
+        if (runtimeId == DefaultRuntimeId) return GetDefaultRuntime();
+        if (SystemIdPattern.IsMatch(runtimeId))
+        {
+            return (await DetectSystemRuntimesAsync()).FirstOrDefault(r => r.Id == runtimeId);
+        }
+        return FindManagedRuntime(runtimeId);
+    }
+
+    private static string GetSystemRuntimeId(string home)
+    {
+        var key = RuntimeInformation.IsOSPlatform(OSPlatform.Linux) ? home : home.ToLowerInvariant();
+        var hash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(key)));
+        return $"system-{hash[..12].ToLowerInvariant()}";
+    }
+
+    private JavaRuntimeInfo? FindManagedRuntime(string runtimeId) =>
+        LoadRegistry().Runtimes.FirstOrDefault(r => r.Id == runtimeId);
+
+    private JavaRuntimeRegistry LoadRegistry()
+    {
+        lock (_registryLock)
+        {
+            try
+            {
+                if (File.Exists(RegistryPath))
+                {
+                    return JsonSerializer.Deserialize<JavaRuntimeRegistry>(File.ReadAllText(RegistryPath)) ?? new JavaRuntimeRegistry();
+                }
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("JRE", $"Failed to read runtime registry: {ex.Message}");
+            }
+            return new JavaRuntimeRegistry();
+        }
+    }
+
+    private void SaveRegistry(JavaRuntimeRegistry registry)
+    {
+        Directory.CreateDirectory(_runtimesDir);
+        var tmp = RegistryPath + ".tmp";
+        File.WriteAllText(tmp, JsonSerializer.Serialize(registry, RegistryJsonOptions));
+        File.Move(tmp, RegistryPath, true);
+    }
+}
@@ -3,7 +3,12 @@ using System.IO.Compression;
 using System.Runtime.InteropServices;
 using System.Text.Json;
 using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
+using HyPrism.Services.Core.Platform;
+using HyPrism.Services.Game.Download;
 
 namespace HyPrism.Services.Game.Launch;
 
@@ -22,22 +27,46 @@ public class LaunchService : ILaunchService
     
     private readonly string _appDir;
     private readonly HttpClient _httpClient;
+    private readonly IDownloadService _downloadService;
+    private readonly IProgressNotificationService _progressService;
+    private readonly IEndpointService _endpoints;
+    private readonly SemaphoreSlim _jreGate = new(1, 1);
     
     /// <summary>
     /// Initializes a new instance of the <see cref="LaunchService"/> class.
     /// </summary>
     /// <param name="appDir">The application data directory path.</param>
     /// <param name="httpClient">The HTTP client for downloading runtimes.</param>
-    public LaunchService(string appDir, HttpClient httpClient)
+    /// <param name="downloadService">Verified download primitive for runtime archives.</param>
+    /// <param name="progressService">Receives the JRE download progress.</param>
+    /// <param name="endpoints">Provides the JRE manifest and download URLs.</param>
+    public LaunchService(string appDir, HttpClient httpClient, IDownloadService downloadService, IProgressNotificationService progressService, IEndpointService endpoints)
     {
         _appDir = appDir;
         _httpClient = httpClient;
+        _downloadService = downloadService;
+        _progressService = progressService;
+        _endpoints = endpoints;
     }
 
     #region JRE Management
 
     /// <inheritdoc/>
     public async Task EnsureJREInstalledAsync(Action<int, string> progressCallback)
+    {
+        // A first install downloads the JRE next to the game, so a runtime repair may ask for it at the same time
+        await _jreGate.WaitAsync();
+        try
+        {
+            await InstallJreIfNeededAsync(progressCallback);
+        }
+        finally
+        {
+            _jreGate.Release();
+        }
+    }
+
+    private async Task InstallJreIfNeededAsync(Action<int, string> progressCallback)
     {
         string jreDir = Path.Combine(_appDir, "Jre");
         string javaBin;
@@ -99,7 +128,7 @@ public class LaunchService : ILaunchService
         // Determine platform - Hytale uses different naming convention
         string osName = RuntimeInformation.IsOSPlatform(OSPlatform.OSX) ? "darwin" : 
                         RuntimeInformation.IsOSPlatform(OSPlatform.Windows) ? "windows" : "linux";
-        string arch = RuntimeInformation.OSArchitecture == Architecture.Arm64 ? "arm64" : "amd64";
+        string arch = PlatformSupport.RequireArtifactArch(PlatformSupport.HytaleJre);
         string archiveType = osName == "windows" ? "zip" : "tar.gz";
         
         // First try to fetch latest JRE info from Hytale launcher directly
@@ -109,7 +138,7 @@ public class LaunchService : ILaunchService
         try
         {
             Logger.Info("JRE", "Fetching JRE info from launcher.hytale.com...");
-            var jreInfoResponse = await _httpClient.GetStringAsync("https://launcher.hytale.com/version/release/jre.json");
+            var jreInfoResponse = await _httpClient.GetStringAsync(EndpointConfig.Expand(_endpoints.Current.JreManifest, ("branch", "release")));
             var jreInfo = JsonSerializer.Deserialize<JsonElement>(jreInfoResponse);
             
             if (jreInfo.TryGetProperty("download_url", out var downloadUrls) &&
@@ -168,7 +197,7 @@ public class LaunchService : ILaunchService
         // Ultimate fallback - hardcoded URLs for official Hytale JRE
         if (string.IsNullOrEmpty(url))
         {
-            url = $"https://launcher.hytale.com/redist/jre/{osName}/{arch}/jre-{RequiredJreVersion}.{archiveType}";
+            url = EndpointConfig.Expand(_endpoints.Current.JreDownload, ("os", osName), ("arch", arch), ("version", RequiredJreVersion), ("ext", archiveType));
             Logger.Info("JRE", $"Using hardcoded Hytale JRE URL: {url}");
         }
         
@@ -176,37 +205,24 @@ public class LaunchService : ILaunchService
         Directory.CreateDirectory(cacheDir);
         string archivePath = Path.Combine(cacheDir, $"jre.{archiveType}");
         
-        // Download with proper headers for Adoptium API
-        // Reuse injected HttpClient instead of creating a new one (avoids socket exhaustion)
-        using var request = new HttpRequestMessage(HttpMethod.Get, url);
-        request.Headers.Add("User-Agent", "HyPrism/1.0");
-        request.Headers.Add("Accept", "*/*");
-        
-        using var response = await _httpClient.SendAsync(request, HttpCompletionOption.ResponseHeadersRead);
-        response.EnsureSuccessStatusCode();
-        
-        var totalBytes = response.Content.Headers.ContentLength ?? -1;
-        using var stream = await response.Content.ReadAsStreamAsync();
-        using var fileStream = new FileStream(archivePath, FileMode.Create, FileAccess.Write, FileShare.None, 8192);
-        
-        var buffer = new byte[8192];
-        long totalRead = 0;
-        int bytesRead;
-        
-        while ((bytesRead = await stream.ReadAsync(buffer)) > 0)
+        // A leftover archive may belong to an older JRE, never resume into it
+        if (File.Exists(archivePath)) File.Delete(archivePath);
+
+        if (string.IsNullOrWhiteSpace(expectedSha256))
         {
-            await fileStream.WriteAsync(buffer.AsMemory(0, bytesRead));
-            totalRead += bytesRead;
-            
-            if (totalBytes > 0)
-            {
-                var progress = (int)((totalRead * 80) / totalBytes); // 0-80%
-                progressCallback(progress, $"Downloading Java Runtime... {progress}%");
-            }
+            Logger.Warning("JRE", "No checksum published for runtime archive, skipping verification");
         }
-        fileStream.Close();
+
+        using var operation = _progressService.BeginOperation("jre");
+        await _downloadService.DownloadFileAsync(url, archivePath, p =>
+        {
+            var scaled = p.Percent * 80 / 100; // 0-80%
+            progressCallback(scaled, $"Downloading Java Runtime... {scaled}%");
+            operation.Report("download", scaled, p, "launch.detail.java_download");
+        }, string.IsNullOrWhiteSpace(expectedSha256) ? null : ExpectedHash.Sha256(expectedSha256));
         
         progressCallback(85, "Extracting Java Runtime...");
+        operation.Report("extract", 85, messageKey: "launch.detail.java_extract");
         Logger.Info("JRE", "Extracting Java Runtime...");
         
         // Create jre directory
@@ -293,8 +309,11 @@ public class LaunchService : ILaunchService
         {
             Logger.Warning("JRE", $"Failed to write version marker: {ex.Message}");
         }
+
+        RuntimeIntegrity.WriteManifest(jreDir);
         
         progressCallback(100, "Java Runtime installed");
+        operation.Complete();
         Logger.Success("JRE", $"Hytale Java Runtime {RequiredJreVersion} installed successfully");
     }
 
@@ -447,7 +466,7 @@ public class LaunchService : ILaunchService
         return false;
     }
 
-    private void EnsureJavaWrapper(string javaBin)
+    internal static void EnsureJavaWrapper(string javaBin)
     {
         if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
         {
@@ -683,7 +702,7 @@ public class LaunchService : ILaunchService
 
     #region Utilities
 
-    private void RunSilentProcess(string fileName, string arguments)
+    internal static void RunSilentProcess(string fileName, string arguments)
     {
         try
         {
@@ -0,0 +1,452 @@
+using System.Diagnostics;
+using System.Globalization;
+using System.Runtime.InteropServices;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Counts playtime per local day while the game runs and enforces <c>Config.PlaytimeLimits</c>.
+/// </summary>
+/// <remarks>
+/// Usage is added every <see cref="CheckInterval"/> and kept in <c>playtime.json</c> for
+/// <see cref="HistoryDays"/> days. <see cref="PlaytimeLimitConfig.WarnMinutes"/> before a limit the
+/// player is warned once; when it is reached the game is asked to close (SIGTERM, or closing the
+/// window on Windows) and killed if it is still running after <see cref="CloseGracePeriod"/>.
+/// A parent can set <c>PlaytimeLimits.Pin</c> in config.json; it is replaced by a salted hash on the
+/// next start. Five wrong PINs lock PIN entry for <see cref="PinLockout"/>.
+/// </remarks>
+public class PlaytimeLimitService : IPlaytimeLimitService
+{
+    private const int HistoryDays = 14;
+    private const int PinIterations = 100_000;
+    private const int MaxPinAttempts = 5;
+    private const int SigTerm = 15;
+    private static readonly TimeSpan CheckInterval = TimeSpan.FromSeconds(15);
+    private static readonly TimeSpan CloseGracePeriod = TimeSpan.FromSeconds(60);
+    private static readonly TimeSpan PinLockout = TimeSpan.FromMinutes(5);
+
+    private readonly string _usagePath;
+    private readonly IConfigService _configService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly object _lock = new();
+    private CancellationTokenSource? _monitorCts;
+    private CancellationTokenSource? _closeCts;
+    private Dictionary<string, double>? _usage;
+    private bool _warned;
+    private int _failedPinAttempts;
+    private DateTime _pinLockedUntil;
+
+    /// <inheritdoc/>
+    public event Action<PlaytimeWarning>? Warning;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="PlaytimeLimitService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory, where daily usage is stored.</param>
+    /// <param name="configService">Holds the limits and the PIN hash.</param>
+    /// <param name="gameProcessService">Provides and stops the game process.</param>
+    /// <param name="progressService">Raises the game state changes that start and stop monitoring.</param>
+    public PlaytimeLimitService(string appDir, IConfigService configService, IGameProcessService gameProcessService,
+        IProgressNotificationService progressService)
+    {
+        _usagePath = Path.Combine(appDir, "playtime.json");
+        _configService = configService;
+        _gameProcessService = gameProcessService;
+
+        HashPinFromConfig();
+
+        progressService.GameStateChanged += (state, _) =>
+        {
+            if (state == "started") StartMonitor();
+            else if (state == "stopped") StopMonitor();
+        };
+    }
+
+    private PlaytimeLimitConfig Limits => _configService.Configuration.PlaytimeLimits ??= new();
+
+    /// <inheritdoc/>
+    public PlaytimeStatus GetStatus()
+    {
+        lock (_lock)
+        {
+            var limits = Limits;
+            var remaining = GetRemainingMinutes(DateTime.Now, out _);
+            return new PlaytimeStatus
+            {
+                Enabled = limits.Enabled,
+                DailyLimitMinutes = limits.DailyLimitMinutes,
+                AllowedFrom = limits.AllowedFrom,
+                AllowedUntil = limits.AllowedUntil,
+                WarnMinutes = limits.WarnMinutes,
+                PinSet = !string.IsNullOrEmpty(limits.PinHash),
+                PlayedTodayMinutes = (int)GetPlayedToday(),
+                RemainingMinutes = remaining is { } r ? Math.Max(0, (int)Math.Ceiling(r)) : null,
+                OverrideUntil = limits.OverrideUntil > DateTime.UtcNow ? limits.OverrideUntil : null
+            };
+        }
+    }
+
+    /// <inheritdoc/>
+    public PlaytimeStatus UpdateLimits(PlaytimeLimitUpdate update)
+    {
+        lock (_lock)
+        {
+            if (!string.IsNullOrEmpty(Limits.PinHash) && !VerifyPin(update.Pin))
+                throw new UnauthorizedAccessException("Wrong PIN");
+
+            if (!IsValidTime(update.AllowedFrom) || !IsValidTime(update.AllowedUntil))
+                throw new ArgumentException("Allowed hours must be HH:mm, e.g. 08:00");
+
+            Limits.Enabled = update.Enabled;
+            Limits.DailyLimitMinutes = Math.Clamp(update.DailyLimitMinutes, 0, 24 * 60);
+            Limits.AllowedFrom = update.AllowedFrom.Trim();
+            Limits.AllowedUntil = update.AllowedUntil.Trim();
+            Limits.WarnMinutes = Math.Clamp(update.WarnMinutes, 1, 60);
+            _warned = false;
+            _configService.SaveConfig();
+        }
+
+        Logger.Info("Playtime", "Playtime limits updated");
+        return GetStatus();
+    }
+
+    /// <inheritdoc/>
+    public void SetPin(string? currentPin, string newPin)
+    {
+        lock (_lock)
+        {
+            if (!string.IsNullOrEmpty(Limits.PinHash) && !VerifyPin(currentPin))
+                throw new UnauthorizedAccessException("Wrong PIN");
+
+            if (string.IsNullOrEmpty(newPin))
+            {
+                Limits.PinHash = "";
+                Limits.PinSalt = "";
+            }
+            else
+            {
+                StorePin(newPin);
+            }
+            _configService.SaveConfig();
+        }
+
+        Logger.Info("Playtime", string.IsNullOrEmpty(newPin) ? "Playtime PIN removed" : "Playtime PIN changed");
+    }
+
+    /// <inheritdoc/>
+    public PlaytimeStatus GrantOverride(string pin, int minutes)
+    {
+        lock (_lock)
+        {
+            if (!VerifyPin(pin))
+                throw new UnauthorizedAccessException(string.IsNullOrEmpty(Limits.PinHash) ? "No PIN is set" : "Wrong PIN");
+
+            Limits.OverrideUntil = DateTime.UtcNow.AddMinutes(Math.Clamp(minutes, 1, 12 * 60));
+            _configService.SaveConfig();
+
+            // Keep the game if it is already being closed
+            _closeCts?.Cancel();
+            _closeCts = null;
+            _warned = false;
+        }
+
+        Logger.Info("Playtime", $"Playtime limits lifted for {minutes} minutes");
+        return GetStatus();
+    }
+
+    private void StartMonitor()
+    {
+        var cts = new CancellationTokenSource();
+        lock (_lock)
+        {
+            _monitorCts?.Cancel();
+            _monitorCts = cts;
+            _warned = false;
+        }
+        _ = Task.Run(() => MonitorLoopAsync(cts.Token));
+    }
+
+    private void StopMonitor()
+    {
+        lock (_lock)
+        {
+            _monitorCts?.Cancel();
+            _monitorCts = null;
+            _closeCts?.Cancel();
+            _closeCts = null;
+        }
+    }
+
+    private async Task MonitorLoopAsync(CancellationToken ct)
+    {
+        var last = DateTime.Now;
+        try
+        {
+            Evaluate();
+            using var timer = new PeriodicTimer(CheckInterval);
+            while (await timer.WaitForNextTickAsync(ct))
+            {
+                var now = DateTime.Now;
+                AddUsage(now - last);
+                last = now;
+                Evaluate();
+            }
+        }
+        catch (OperationCanceledException)
+        {
+            // The game stopped; count the time since the last tick
+            AddUsage(DateTime.Now - last);
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Playtime", $"Playtime monitor failed: {ex.Message}");
+        }
+    }
+
+    private void Evaluate()
+    {
+        PlaytimeWarning? warning = null;
+        CancellationTokenSource? closeCts = null;
+
+        lock (_lock)
+        {
+            var remaining = GetRemainingMinutes(DateTime.Now, out var reason);
+            if (remaining == null)
+            {
+                _warned = false;
+                return;
+            }
+
+            if (remaining <= 0)
+            {
+                if (_closeCts != null) return;
+                _closeCts = closeCts = new CancellationTokenSource();
+                warning = new PlaytimeWarning { Reason = reason, MinutesLeft = 0, Terminating = true };
+            }
+            else if (remaining <= Limits.WarnMinutes && !_warned)
+            {
+                _warned = true;
+                warning = new PlaytimeWarning { Reason = reason, MinutesLeft = (int)Math.Ceiling(remaining.Value) };
+            }
+        }
+
+        if (warning == null) return;
+
+        Logger.Warning("Playtime", warning.Terminating
+            ? $"Playtime limit reached ({warning.Reason}); closing the game"
+            : $"Playtime limit ({warning.Reason}) in {warning.MinutesLeft} min");
+        Warning?.Invoke(warning);
+
+        if (closeCts != null) _ = CloseGameAsync(closeCts.Token);
+    }
+
+    private async Task CloseGameAsync(CancellationToken ct)
+    {
+        try
+        {
+            var process = _gameProcessService.GetGameProcess();
+            if (process == null || process.HasExited) return;
+            RequestClose(process);
+
+            var deadline = DateTime.UtcNow + CloseGracePeriod;
+            while (DateTime.UtcNow < deadline)
+            {
+                await Task.Delay(1000, ct);
+                if (!_gameProcessService.IsGameRunning()) return;
+            }
+
+            Logger.Warning("Playtime", "Game did not close in time; killing it");
+            _gameProcessService.ExitGame();
+        }
+        catch (OperationCanceledException)
+        {
+            Logger.Info("Playtime", "Closing the game was cancelled");
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Playtime", $"Failed to close the game: {ex.Message}");
+        }
+    }
+
+    private static void RequestClose(Process process)
+    {
+        try
+        {
+            if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+                process.CloseMainWindow();
+            else
+                _ = Kill(process.Id, SigTerm);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Playtime", $"Could not ask the game to close: {ex.Message}");
+        }
+    }
+
+    [DllImport("libc", EntryPoint = "kill", SetLastError = true)]
+    private static extern int Kill(int pid, int signal);
+
+    /// <summary>
+    /// Minutes until the nearest limit, 0 or less when one is reached; <c>null</c> when nothing limits play.
+    /// </summary>
+    private double? GetRemainingMinutes(DateTime now, out string reason)
+    {
+        reason = "";
+        var limits = Limits;
+        if (!limits.Enabled || limits.OverrideUntil > DateTime.UtcNow) return null;
+
+        double? remaining = null;
+        if (limits.DailyLimitMinutes > 0)
+        {
+            remaining = limits.DailyLimitMinutes - GetPlayedToday();
+            reason = "dailyLimit";
+        }
+
+        if (GetMinutesLeftInAllowedHours(now) is { } windowLeft && (remaining == null || windowLeft < remaining))
+        {
+            remaining = windowLeft;
+            reason = "allowedHours";
+        }
+
+        return remaining;
+    }
+
+    private double? GetMinutesLeftInAllowedHours(DateTime now)
+    {
+        var limits = Limits;
+        if (string.IsNullOrWhiteSpace(limits.AllowedFrom) && string.IsNullOrWhiteSpace(limits.AllowedUntil))
+            return null;
+
+        var from = ParseTime(limits.AllowedFrom) ?? TimeSpan.Zero;
+        var until = ParseTime(limits.AllowedUntil) ?? TimeSpan.FromDays(1);
+        var time = now.TimeOfDay;
+
+        if (from <= until)
+            return time >= from && time < until ? (until - time).TotalMinutes : 0;
+
+        // Spans midnight, e.g. 20:00–02:00
+        if (time >= from) return (TimeSpan.FromDays(1) - time + until).TotalMinutes;
+        return time < until ? (until - time).TotalMinutes : 0;
+    }
+
+    private static bool IsValidTime(string value) => string.IsNullOrWhiteSpace(value) || ParseTime(value) != null;
+
+    private static TimeSpan? ParseTime(string value) =>
+        TimeOnly.TryParseExact(value.Trim(), ["HH:mm", "H:mm"], CultureInfo.InvariantCulture, DateTimeStyles.None, out var t)
+            ? t.ToTimeSpan()
+            : null;
+
+    private double GetPlayedToday() => LoadUsage().GetValueOrDefault(DateTime.Now.ToString("yyyy-MM-dd"));
+
+    private void AddUsage(TimeSpan elapsed)
+    {
+        if (elapsed <= TimeSpan.Zero) return;
+
+        lock (_lock)
+        {
+            var usage = LoadUsage();
+            var today = DateTime.Now.ToString("yyyy-MM-dd");
+            usage[today] = usage.GetValueOrDefault(today) + elapsed.TotalMinutes;
+
+            var oldest = DateTime.Now.AddDays(-HistoryDays).ToString("yyyy-MM-dd");
+            foreach (var day in usage.Keys.Where(d => string.CompareOrdinal(d, oldest) < 0).ToList())
+                usage.Remove(day);
+
+            try
+            {
+                File.WriteAllText(_usagePath, JsonSerializer.Serialize(usage));
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Playtime", $"Could not save playtime: {ex.Message}");
+            }
+        }
+    }
+
+    private Dictionary<string, double> LoadUsage()
+    {
+        if (_usage != null) return _usage;
+        try
+        {
+            if (File.Exists(_usagePath))
+                _usage = JsonSerializer.Deserialize<Dictionary<string, double>>(File.ReadAllText(_usagePath));
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Playtime", $"Could not read playtime: {ex.Message}");
+        }
+        return _usage ??= new Dictionary<string, double>();
+    }
+
+    /// <summary>
+    /// Replaces a plain PIN written into config.json by its hash.
+    /// </summary>
+    private void HashPinFromConfig()
+    {
+        var pin = Limits.Pin;
+        if (string.IsNullOrEmpty(pin)) return;
+
+        try
+        {
+            StorePin(pin);
+            Logger.Info("Playtime", "Playtime PIN from config.json stored as a hash");
+        }
+        catch (ArgumentException ex)
+        {
+            Logger.Warning("Playtime", $"Ignoring playtime PIN from config.json: {ex.Message}");
+        }
+        Limits.Pin = "";
+        _configService.SaveConfig();
+    }
+
+    private void StorePin(string pin)
+    {
+        if (pin.Length < 4 || !pin.All(char.IsAsciiDigit))
+            throw new ArgumentException("The PIN must be at least 4 digits");
+
+        var salt = RandomNumberGenerator.GetBytes(16);
+        Limits.PinSalt = Convert.ToHexString(salt);
+        Limits.PinHash = Convert.ToHexString(HashPin(pin, salt));
+    }
+
+    private bool VerifyPin(string? pin)
+    {
+        if (string.IsNullOrEmpty(Limits.PinHash) || string.IsNullOrEmpty(pin)) return false;
+        if (DateTime.UtcNow < _pinLockedUntil) throw new UnauthorizedAccessException("Too many wrong PINs; try again later");
+
+        bool valid;
+        try
+        {
+            valid = CryptographicOperations.FixedTimeEquals(
+                HashPin(pin, Convert.FromHexString(Limits.PinSalt)),
+                Convert.FromHexString(Limits.PinHash));
+        }
+        catch (FormatException)
+        {
+            valid = false;
+        }
+
+        if (valid)
+        {
+            _failedPinAttempts = 0;
+            return true;
+        }
+
+        if (++_failedPinAttempts >= MaxPinAttempts)
+        {
+            _failedPinAttempts = 0;
+            _pinLockedUntil = DateTime.UtcNow + PinLockout;
+            Logger.Warning("Playtime", "Too many wrong PINs; PIN entry locked");
+        }
+        return false;
+    }
+
+    private static byte[] HashPin(string pin, byte[] salt) =>
+        Rfc2898DeriveBytes.Pbkdf2(Encoding.UTF8.GetBytes(pin), salt, PinIterations, HashAlgorithmName.SHA256, 32);
+}
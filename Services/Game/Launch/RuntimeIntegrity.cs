@@ -0,0 +1,172 @@
+using System.Runtime.InteropServices;
+using System.Security.Cryptography;
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Detects half-extracted or damaged Java runtimes before the game is started with them.
+/// </summary>
+/// <remarks>
+/// A runtime passes when its key files (java, the JVM library, <c>lib/modules</c>) exist and every
+/// file recorded in <c>.runtime_manifest.json</c> still has the recorded size and SHA-256.
+/// Runtimes installed before manifests existed get one written the first time their key files check out.
+/// </remarks>
+public static class RuntimeIntegrity
+{
+    public const string ManifestFileName = ".runtime_manifest.json";
+
+    private static readonly HashSet<string> IgnoredFiles = new(StringComparer.OrdinalIgnoreCase)
+    {
+        ManifestFileName, ".jre_version"
+    };
+
+    /// <summary>
+    /// Records size and SHA-256 of every regular file under <paramref name="home"/>.
+    /// </summary>
+    public static void WriteManifest(string home)
+    {
+        try
+        {
+            var manifest = new RuntimeManifest();
+            foreach (var file in EnumerateRuntimeFiles(home))
+            {
+                manifest.Files[GetRelativeKey(home, file)] = new RuntimeManifestEntry
+                {
+                    Size = new FileInfo(file).Length,
+                    Sha256 = HashFile(file)
+                };
+            }
+
+            var path = Path.Combine(home, ManifestFileName);
+            File.WriteAllText(path + ".tmp", JsonSerializer.Serialize(manifest));
+            File.Move(path + ".tmp", path, true);
+            Logger.Info("JRE", $"Recorded integrity manifest ({manifest.Files.Count} files) for {home}");
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("JRE", $"Failed to write runtime manifest: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Checks the runtime at <paramref name="home"/>.
+    /// </summary>
+    /// <param name="home">Runtime home directory.</param>
+    /// <param name="problem">Short description of the first problem found.</param>
+    /// <returns><c>true</c> if the runtime looks intact.</returns>
+    public static bool Verify(string home, out string? problem)
+    {
+        problem = null;
+
+        foreach (var keyFile in GetKeyFiles(home))
+        {
+            if (!File.Exists(keyFile))
+            {
+                problem = $"missing {GetRelativeKey(home, keyFile)}";
+                return false;
+            }
+        }
+
+        var manifestPath = Path.Combine(home, ManifestFileName);
+        if (!File.Exists(manifestPath))
+        {
+            WriteManifest(home);
+            return true;
+        }
+
+        RuntimeManifest? manifest;
+        try
+        {
+            manifest = JsonSerializer.Deserialize<RuntimeManifest>(File.ReadAllText(manifestPath));
+        }
+        catch (Exception ex)
+        {
+            problem = $"unreadable manifest ({ex.Message})";
+            return false;
+        }
+        if (manifest == null || manifest.Files.Count == 0)
+        {
+            problem = "empty manifest";
+            return false;
+        }
+
+        foreach (var (relative, entry) in manifest.Files)
+        {
+            var file = Path.Combine(home, relative.Replace('/', Path.DirectorySeparatorChar));
+            var info = new FileInfo(file);
+            if (!info.Exists)
+            {
+                problem = $"missing {relative}";
+                return false;
+            }
+            if (info.Length != entry.Size)
+            {
+                problem = $"size mismatch for {relative}";
+                return false;
+            }
+            if (!string.Equals(HashFile(file), entry.Sha256, StringComparison.OrdinalIgnoreCase))
+            {
+                problem = $"checksum mismatch for {relative}";
+                return false;
+            }
+        }
+
+        return true;
+    }
+
+    private static IEnumerable<string> GetKeyFiles(string home)
+    {
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            yield return Path.Combine(home, "bin", "java.exe");
+            yield return Path.Combine(home, "bin", "server", "jvm.dll");
+        }
+        else
+        {
+            var java = Path.Combine(home, "bin", "java");
+            yield return java;
+            // The wrapper script is useless without the real binary next to it
+            if (IsScript(java)) yield return Path.Combine(home, "bin", "java.real");
+            yield return Path.Combine(home, "lib", "server",
+                RuntimeInformation.IsOSPlatform(OSPlatform.OSX) ? "libjvm.dylib" : "libjvm.so");
+        }
+
+        yield return Path.Combine(home, "lib", "modules");
+    }
+
+    private static IEnumerable<string> EnumerateRuntimeFiles(string home)
+    {
+        foreach (var file in Directory.EnumerateFiles(home, "*", SearchOption.AllDirectories))
+        {
+            if (IgnoredFiles.Contains(Path.GetFileName(file))) continue;
+            if (File.GetAttributes(file).HasFlag(FileAttributes.ReparsePoint)) continue;
+            yield return file;
+        }
+    }
+
+    private static bool IsScript(string path)
+    {
+        try
+        {
+            if (!File.Exists(path)) return false;
+            using var fs = new FileStream(path, FileMode.Open, FileAccess.Read, FileShare.ReadWrite);
+            return fs.ReadByte() == '#' && fs.ReadByte() == '!';
+        }
+        catch
+        {
+            return false;
+        }
+    }
+
+    private static string HashFile(string path)
+    {
+        using var stream = File.OpenRead(path);
+        return Convert.ToHexString(SHA256.HashData(stream)).ToLowerInvariant();
+    }
+
+    private static string GetRelativeKey(string home, string file) =>
+        Path.GetRelativePath(home, file).Replace('\\', '/');
+}
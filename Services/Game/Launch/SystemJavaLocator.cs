@@ -0,0 +1,217 @@
+using System.Runtime.InteropServices;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Launch;
+
+/// <summary>
+/// Finds Java installations that are already present on the system.
+/// </summary>
+/// <remarks>
+/// Looks at <c>JAVA_HOME</c>, <c>PATH</c>, the usual per-platform install folders and,
+/// on Windows, the JavaSoft and vendor registry keys. Only candidate home directories are
+/// returned; the caller is responsible for checking the version by running java.
+/// </remarks>
+public static class SystemJavaLocator
+{
+    private static readonly string[] WindowsVendorFolders =
+    [
+        "Java", "Eclipse Adoptium", "Eclipse Foundation", "Microsoft", "Zulu", "BellSoft", "Amazon Corretto", "Semeru"
+    ];
+
+    private static readonly string[] WindowsRegistryRoots =
+    [
+        @"SOFTWARE\JavaSoft\JDK",
+        @"SOFTWARE\JavaSoft\JRE",
+        @"SOFTWARE\Eclipse Adoptium\JDK",
+        @"SOFTWARE\Eclipse Adoptium\JRE",
+        @"SOFTWARE\Azul Systems\Zulu",
+        @"SOFTWARE\Microsoft\JDK"
+    ];
+
+    /// <summary>
+    /// Returns distinct, existing Java home directories (folders containing <c>bin/java</c>).
+    /// </summary>
+    public static List<string> FindJavaHomes()
+    {
+        var candidates = new List<string>();
+
+        var javaHome = Environment.GetEnvironmentVariable("JAVA_HOME");
+        if (!string.IsNullOrWhiteSpace(javaHome)) candidates.Add(javaHome);
+
+        candidates.AddRange(FindOnPath());
+
+        if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
+        {
+            candidates.AddRange(FindWindowsFolders());
+            candidates.AddRange(FindWindowsRegistry());
+        }
+        else if (RuntimeInformation.IsOSPlatform(OSPlatform.OSX))
+        {
+            var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+            candidates.AddRange(Subdirectories("/Library/Java/JavaVirtualMachines").Select(d => Path.Combine(d, "Contents", "Home")));
+            candidates.AddRange(Subdirectories(Path.Combine(home, "Library", "Java", "JavaVirtualMachines")).Select(d => Path.Combine(d, "Contents", "Home")));
+            candidates.AddRange(Subdirectories("/opt/homebrew/opt").Where(IsOpenJdkFormula).Select(d => Path.Combine(d, "libexec", "openjdk.jdk", "Contents", "Home")));
+            candidates.AddRange(Subdirectories("/usr/local/opt").Where(IsOpenJdkFormula).Select(d => Path.Combine(d, "libexec", "openjdk.jdk", "Contents", "Home")));
+            candidates.AddRange(Subdirectories(Path.Combine(home, ".sdkman", "candidates", "java")));
+        }
+        else
+        {
+            var home = Environment.GetFolderPath(Environment.SpecialFolder.UserProfile);
+            candidates.AddRange(Subdirectories("/usr/lib/jvm"));
+            candidates.AddRange(Subdirectories("/usr/lib64/jvm"));
+            candidates.AddRange(Subdirectories("/usr/java"));
+            candidates.AddRange(Subdirectories("/opt/java"));
+            candidates.AddRange(Subdirectories("/opt").Where(d => Path.GetFileName(d).StartsWith("jdk", StringComparison.OrdinalIgnoreCase) ||
+                                                                  Path.GetFileName(d).StartsWith("jre", StringComparison.OrdinalIgnoreCase)));
+            candidates.AddRange(Subdirectories(Path.Combine(home, ".sdkman", "candidates", "java")));
+            candidates.AddRange(Subdirectories(Path.Combine(home, ".jdks")));
+        }
+
+        return candidates
+            .Select(NormalizeHome)
+            .Where(h => h != null && File.Exists(GetJavaBinary(h)))
+            .Select(h => h!)
+            .Distinct(RuntimeInformation.IsOSPlatform(OSPlatform.Linux) ? StringComparer.Ordinal : StringComparer.OrdinalIgnoreCase)
+            .ToList();
+    }
+
+    /// <summary>
+    /// Gets the java executable inside a Java home directory.
+    /// </summary>
+    public static string GetJavaBinary(string javaHome) =>
+        Path.Combine(javaHome, "bin", RuntimeInformation.IsOSPlatform(OSPlatform.Windows) ? "java.exe" : "java");
+
+    /// <summary>
+    /// Reads <c>IMPLEMENTOR</c> and <c>JAVA_VERSION</c> from the home's <c>release</c> file.
+    /// </summary>
+    public static (string? vendor, string? version) ReadReleaseInfo(string javaHome)
+    {
+        string? vendor = null, version = null;
+        try
+        {
+            var releaseFile = Path.Combine(javaHome, "release");
+            if (!File.Exists(releaseFile)) return (null, null);
+
+            foreach (var line in File.ReadLines(releaseFile))
+            {
+                var eq = line.IndexOf('=');
+                if (eq <= 0) continue;
+                var key = line[..eq].Trim();
+                var value = line[(eq + 1)..].Trim().Trim('"');
+                if (key == "IMPLEMENTOR") vendor = value;
+                else if (key == "JAVA_VERSION") version = value;
+            }
+        }
+        catch { /* ignore */ }
+        return (vendor, version);
+    }
+
+    private static IEnumerable<string> FindOnPath()
+    {
+        var path = Environment.GetEnvironmentVariable("PATH");
+        if (string.IsNullOrEmpty(path)) yield break;
+
+        var exe = RuntimeInformation.IsOSPlatform(OSPlatform.Windows) ? "java.exe" : "java";
+        foreach (var dir in path.Split(Path.PathSeparator, StringSplitOptions.RemoveEmptyEntries))
+        {
+            string? home = null;
+            try
+            {
+                var candidate = Path.Combine(dir.Trim('"'), exe);
+                if (!File.Exists(candidate)) continue;
+
+                // /usr/bin/java is usually a chain of alternatives symlinks
+                var resolved = new FileInfo(candidate).ResolveLinkTarget(true)?.FullName ?? candidate;
+                home = Path.GetDirectoryName(Path.GetDirectoryName(resolved));
+            }
+            catch { /* ignore */ }
+
+            if (home != null) yield return home;
+        }
+    }
+
+    private static IEnumerable<string> FindWindowsFolders()
+    {
+        foreach (var root in new[]
+                 {
+                     Environment.GetFolderPath(Environment.SpecialFolder.ProgramFiles),
+                     Environment.GetFolderPath(Environment.SpecialFolder.ProgramFilesX86)
+                 }.Where(r => !string.IsNullOrEmpty(r)))
+        {
+            foreach (var vendor in WindowsVendorFolders)
+            {
+                foreach (var dir in Subdirectories(Path.Combine(root, vendor)))
+                {
+                    yield return dir;
+                }
+            }
+        }
+    }
+
+    private static IEnumerable<string> FindWindowsRegistry()
+    {
+        var homes = new List<string>();
+        if (!RuntimeInformation.IsOSPlatform(OSPlatform.Windows)) return homes;
+
+        try
+        {
+            foreach (var rootPath in WindowsRegistryRoots)
+            {
+                using var root = Microsoft.Win32.Registry.LocalMachine.OpenSubKey(rootPath);
+                if (root == null) continue;
+
+                foreach (var versionName in root.GetSubKeyNames())
+                {
+                    using var versionKey = root.OpenSubKey(versionName);
+                    if (versionKey == null) continue;
+
+                    if (versionKey.GetValue("JavaHome") is string javaHome) homes.Add(javaHome);
+                    if (versionKey.GetValue("InstallationPath") is string installPath) homes.Add(installPath);
+
+                    // Adoptium: {version}\hotspot\MSI\Path
+                    using var msiKey = versionKey.OpenSubKey(@"hotspot\MSI");
+                    if (msiKey?.GetValue("Path") is string msiPath) homes.Add(msiPath);
+                }
+            }
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("JRE", $"Failed to read Java registry keys: {ex.Message}");
+        }
+
+        return homes;
+    }
+
+    private static bool IsOpenJdkFormula(string dir) =>
+        Path.GetFileName(dir).StartsWith("openjdk", StringComparison.OrdinalIgnoreCase);
+
+    private static IEnumerable<string> Subdirectories(string dir)
+    {
+        try
+        {
+            return Directory.Exists(dir) ? Directory.GetDirectories(dir) : Array.Empty<string>();
+        }
+        catch
+        {
+            return Array.Empty<string>();
+        }
+    }
+
+    private static string? NormalizeHome(string home)
+    {
+        try
+        {
+            var full = Path.GetFullPath(home.Trim().Trim('"'));
+            // Some JDK layouts keep the runtime in a nested jre/ folder
+            if (!File.Exists(GetJavaBinary(full)) && File.Exists(GetJavaBinary(Path.Combine(full, "jre"))))
+            {
+                full = Path.Combine(full, "jre");
+            }
+            return full.TrimEnd(Path.DirectorySeparatorChar, Path.AltDirectorySeparatorChar);
+        }
+        catch
+        {
+            return null;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Finds the mod that makes an instance crash by launching it with ever smaller sets of mods enabled.
+/// </summary>
+public interface IModBisectService
+{
+    /// <summary>
+    /// Gets the running or finished search, or <c>null</c> if none was started.
+    /// </summary>
+    ModBisectState? GetState();
+
+    /// <summary>
+    /// Starts a search over the mods that are enabled in the instance now, replacing any previous one.
+    /// </summary>
+    /// <param name="instanceId">The instance that crashes.</param>
+    /// <exception cref="ArgumentException">The instance does not exist.</exception>
+    /// <exception cref="InvalidOperationException">Fewer than two mods are enabled.</exception>
+    ModBisectState Start(string instanceId);
+
+    /// <summary>
+    /// Launches the current step through <see cref="ISafeModeService"/>; the instance's mods are
+    /// back to normal once the game exits.
+    /// </summary>
+    /// <exception cref="InvalidOperationException">No search is in progress.</exception>
+    Task<DownloadProgress> LaunchStepAsync();
+
+    /// <summary>
+    /// Records how the launched step went and moves to the next one.
+    /// </summary>
+    /// <param name="crashed">Whether the game crashed with the step's mods enabled.</param>
+    /// <exception cref="InvalidOperationException">No search is in progress or the step was not launched.</exception>
+    ModBisectState Report(bool crashed);
+
+    /// <summary>
+    /// Drops the search.
+    /// </summary>
+    /// <returns><c>false</c> if none was started.</returns>
+    bool Cancel();
+}
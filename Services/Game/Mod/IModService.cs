@@ -13,18 +13,48 @@ public interface IModService
     /// <param name="query">The search query string.</param>
     /// <param name="page">The page number (0-based).</param>
     /// <param name="pageSize">The number of results per page.</param>
-    /// <param name="categories">Array of category slugs to filter by.</param>
+    /// <param name="categories">Class or category IDs to filter by, as strings.</param>
     /// <param name="sortField">The field to sort by (CurseForge sort index).</param>
     /// <param name="sortOrder">The sort order (ascending or descending).</param>
     /// <returns>A result containing matching mods and pagination info.</returns>
     Task<ModSearchResult> SearchModsAsync(string query, int page, int pageSize, string[] categories, int sortField, int sortOrder);
 
+    /// <summary>
+    /// Searches for mods within a class and/or categories.
+    /// </summary>
+    /// <param name="parameters">Query, paging, sorting and filters. Class IDs given as categories are treated as the class.</param>
+    /// <returns>A result containing matching mods and pagination info.</returns>
+    Task<ModSearchResult> SearchModsAsync(SearchModsParams parameters);
+
     /// <summary>
     /// Gets the list of available mod categories.
     /// </summary>
     /// <returns>A list of mod categories.</returns>
     Task<List<ModCategory>> GetModCategoriesAsync();
 
+    /// <summary>
+    /// Lists the Hytale projects of a CurseForge author, newest update first. Pages are cached for a few minutes.
+    /// </summary>
+    /// <param name="authorId">The author's CurseForge ID; takes precedence over the name.</param>
+    /// <param name="authorName">The author's name, looked up when no ID is known.</param>
+    /// <param name="page">The page number (0-based).</param>
+    /// <param name="pageSize">The number of results per page.</param>
+    /// <returns>The author's projects; <see cref="ModAuthorResult.AuthorId"/> is null when the author was not found.</returns>
+    Task<ModAuthorResult> GetModsByAuthorAsync(int? authorId, string? authorName, int page = 0, int pageSize = 20);
+
+    /// <summary>
+    /// Gets local copies of mod screenshots and thumbnails, downloading missing ones into the image cache.
+    /// </summary>
+    /// <param name="urls">CurseForge image URLs; other hosts are ignored.</param>
+    /// <returns>Each URL that is now cached mapped to its <c>file://</c> URL.</returns>
+    Task<Dictionary<string, string>> GetCachedImagesAsync(IEnumerable<string> urls);
+
+    /// <summary>
+    /// Gets every class (Mods, Worlds, Prefabs...) with its categories nested under it.
+    /// </summary>
+    /// <returns>The classes sorted by name, each with its <see cref="ModCategory.Children"/>.</returns>
+    Task<List<ModCategory>> GetModCategoryTreeAsync();
+
     /// <summary>
     /// Downloads and installs a mod file to the specified game instance.
     /// </summary>
@@ -35,6 +65,15 @@ public interface IModService
     /// <returns><c>true</c> if installation succeeded; otherwise, <c>false</c>.</returns>
     Task<bool> InstallModFileToInstanceAsync(string slugOrId, string fileIdOrVersion, string instancePath, Action<string, string>? onProgress = null);
 
+    /// <summary>
+    /// Installs a mod from what a user pasted: a CurseForge project or file URL, a project slug,
+    /// a project ID, or <c>projectID:fileID</c>. Without a file the project's main file is installed.
+    /// </summary>
+    /// <param name="input">The pasted text.</param>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <returns>The resolved mod and file, and whether the install succeeded.</returns>
+    Task<ModResolveResult> ResolveAndInstallAsync(string input, string instancePath);
+
     /// <summary>
     /// Gets the list of mods installed in a game instance.
     /// </summary>
@@ -49,6 +88,61 @@ public interface IModService
     /// <param name="mods">The list of installed mods to save.</param>
     Task SaveInstanceModsAsync(string instancePath, List<InstalledMod> mods);
 
+    /// <summary>
+    /// Removes a mod from an instance's manifest and deletes its file.
+    /// </summary>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <param name="modId">The mod ID, or its name for mods without one.</param>
+    /// <param name="removeOrphanedDependencies">Also removes the mod's dependencies that no other installed mod requires.</param>
+    /// <returns><c>true</c> if the mod was found and removed; otherwise, <c>false</c>.</returns>
+    Task<bool> UninstallInstanceModAsync(string instancePath, string modId, bool removeOrphanedDependencies = false);
+
+    /// <summary>
+    /// Gets the installed mods that require a mod, and the dependencies that would be left unused
+    /// without it. Dependencies of mods installed before they were recorded are looked up on CurseForge.
+    /// </summary>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <param name="modId">The mod ID, or its name for mods without one.</param>
+    /// <param name="ct">Token to cancel the lookup.</param>
+    /// <returns>The impact, or <c>null</c> if the mod is not installed.</returns>
+    Task<ModUninstallImpact?> GetUninstallImpactAsync(string instancePath, string modId, CancellationToken ct = default);
+
+    /// <summary>
+    /// Raised after a compatibility check found enabled mods that do not list the instance's game version.
+    /// </summary>
+    event Action<ModCompatibilityReport>? IncompatibleModsFound;
+
+    /// <summary>
+    /// Finds the enabled mods of an instance whose files list game versions, none of them
+    /// <paramref name="gameVersion"/>. Files that list no versions count as compatible.
+    /// </summary>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <param name="gameVersion">The instance's game version as CurseForge names it.</param>
+    /// <param name="ct">Token to cancel the lookup of files installed before versions were recorded.</param>
+    Task<ModCompatibilityReport> CheckGameVersionCompatibilityAsync(string instancePath, string gameVersion, CancellationToken ct = default);
+
+    /// <summary>
+    /// Gets the game versions CurseForge knows for Hytale, cached for a few hours.
+    /// </summary>
+    Task<List<string>> GetGameVersionsAsync();
+
+    /// <summary>
+    /// Enables or disables a mod in an instance by renaming its file to or from <c>.disabled</c>.
+    /// </summary>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <param name="modId">The mod ID, or its name for mods without one.</param>
+    /// <returns><c>true</c> if the mod was toggled; otherwise, <c>false</c>.</returns>
+    Task<bool> ToggleInstanceModAsync(string instancePath, string modId);
+
+    /// <summary>
+    /// Enables or disables every mod of an instance in one pass, saving the manifest once.
+    /// </summary>
+    /// <param name="instancePath">The path to the game instance.</param>
+    /// <param name="enabled"><c>true</c> to enable all mods, <c>false</c> to disable them.</param>
+    /// <param name="onProgress">Optional callback after each mod is renamed.</param>
+    /// <returns>How many mods were switched and how many failed.</returns>
+    Task<ModBulkToggleResult> SetAllInstanceModsEnabledAsync(string instancePath, bool enabled, Action<ModBulkToggleProgress>? onProgress = null);
+
     /// <summary>
     /// Gets available files for a specific mod.
     /// </summary>
@@ -58,12 +152,31 @@ public interface IModService
     /// <returns>A result containing mod files and pagination info.</returns>
     Task<ModFilesResult> GetModFilesAsync(string modId, int page, int pageSize);
 
+    /// <summary>
+    /// Resolves many CurseForge projects in as few requests as possible (POST /v1/mods).
+    /// </summary>
+    /// <param name="modIds">CurseForge project IDs; duplicates are ignored.</param>
+    /// <param name="ct">Cancellation token.</param>
+    /// <returns>The projects that were found; unknown IDs are omitted.</returns>
+    Task<List<CurseForgeMod>> GetModsByIdsAsync(IEnumerable<int> modIds, CancellationToken ct = default);
+
+    /// <summary>
+    /// Resolves many CurseForge files in as few requests as possible (POST /v1/mods/files).
+    /// </summary>
+    /// <param name="fileIds">CurseForge file IDs; duplicates are ignored.</param>
+    /// <param name="ct">Cancellation token.</param>
+    /// <returns>The files that were found; unknown IDs are omitted.</returns>
+    Task<List<CurseForgeFile>> GetFilesByIdsAsync(IEnumerable<int> fileIds, CancellationToken ct = default);
+
     /// <summary>
     /// Checks for available updates for mods installed in an instance.
+    /// Mods are checked concurrently; <paramref name="onProgress"/> is called after each one.
     /// </summary>
     /// <param name="instancePath">The path to the game instance.</param>
-    /// <returns>A list of mods that have updates available.</returns>
-    Task<List<InstalledMod>> CheckInstanceModUpdatesAsync(string instancePath);
+    /// <param name="onProgress">Optional callback receiving each result as it arrives.</param>
+    /// <param name="ct">Cancellation token.</param>
+    /// <returns>A list of mods that have updates available, in manifest order.</returns>
+    Task<List<InstalledMod>> CheckInstanceModUpdatesAsync(string instancePath, Action<ModUpdateCheckProgress>? onProgress = null, CancellationToken ct = default);
 
     /// <summary>
     /// Installs a mod from a local file.
@@ -0,0 +1,36 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Launches an instance with its mods temporarily disabled and enables them again when the game exits,
+/// so users can tell whether a crash is caused by a mod.
+/// </summary>
+public interface ISafeModeService
+{
+    /// <summary>
+    /// Disables every enabled mod of an instance, selects the instance and launches it.
+    /// </summary>
+    /// <param name="instanceId">The instance to launch.</param>
+    /// <returns>The launch result; the mods are already restored if the game did not start.</returns>
+    Task<DownloadProgress> LaunchSafeModeAsync(string instanceId);
+
+    /// <summary>
+    /// Launches an instance with only the given mods left enabled; the other enabled mods are disabled
+    /// until the game exits. Mods that were already disabled stay disabled.
+    /// </summary>
+    /// <param name="instanceId">The instance to launch.</param>
+    /// <param name="enabledModIds">IDs of the mods to keep enabled.</param>
+    Task<DownloadProgress> LaunchWithModsAsync(string instanceId, IReadOnlyCollection<string> enabledModIds);
+
+    /// <summary>
+    /// Gets the active session, or <c>null</c> if no mods are disabled by this service.
+    /// </summary>
+    SafeModeSession? GetActiveSession();
+
+    /// <summary>
+    /// Enables the mods of a session left over from a previous launcher run.
+    /// If the game is still running, they are enabled when it exits instead.
+    /// </summary>
+    Task RestorePendingAsync();
+}
@@ -0,0 +1,26 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Remembers which mods were enabled when each world was last played and puts that mod set back on request.
+/// </summary>
+public interface IWorldModSnapshotService
+{
+    /// <summary>
+    /// Compares the instance's enabled mods with the mods a world was last played with.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="worldName">World folder name.</param>
+    /// <returns>The differences, or <c>null</c> if the world has no recorded mods.</returns>
+    WorldModSnapshotStatus? GetStatus(string instanceId, string worldName);
+
+    /// <summary>
+    /// Enables the mods of the snapshot, downloads the recorded CurseForge files of missing or changed ones
+    /// and disables enabled mods that are not in the snapshot. Local mods that were removed cannot be restored
+    /// and are reported as failed.
+    /// </summary>
+    /// <param name="instanceId">Instance ID.</param>
+    /// <param name="worldName">World folder name.</param>
+    Task<WorldModRestoreResult> RestoreAsync(string instanceId, string worldName);
+}
@@ -0,0 +1,235 @@
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Bisects the enabled mods of an instance: each step enables half of the suspects, the user reports
+/// whether the game crashed, and the half that explains the report becomes the new suspects.
+/// </summary>
+/// <remarks>
+/// Assumes a single mod causes the crash; with <c>n</c> suspects it takes about <c>log2(n)</c> launches.
+/// Mods are disabled only for the test session itself (see <see cref="ISafeModeService"/>), so the
+/// instance can be played normally between steps. The state is saved after every change.
+/// </remarks>
+public class ModBisectService : IModBisectService
+{
+    private readonly string _statePath;
+    private readonly IInstanceService _instanceService;
+    private readonly IModService _modService;
+    private readonly ISafeModeService _safeModeService;
+    private readonly object _lock = new();
+    private ModBisectState? _state;
+    private bool _stepRunning;
+    private bool _stepStarted;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="ModBisectService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory, where the search is stored.</param>
+    /// <param name="instanceService">Resolves the instance.</param>
+    /// <param name="modService">Lists the instance's mods.</param>
+    /// <param name="safeModeService">Launches the game with a subset of mods enabled.</param>
+    /// <param name="progressService">Tells whether a step's game actually started.</param>
+    public ModBisectService(string appDir, IInstanceService instanceService, IModService modService,
+        ISafeModeService safeModeService, IProgressNotificationService progressService)
+    {
+        _statePath = Path.Combine(appDir, "mod-bisect.json");
+        _instanceService = instanceService;
+        _modService = modService;
+        _safeModeService = safeModeService;
+        _state = Load();
+
+        progressService.GameStateChanged += (state, exitCode) =>
+        {
+            lock (_lock)
+            {
+                if (state == "started" && _stepRunning) _stepStarted = true;
+            }
+        };
+    }
+
+    /// <inheritdoc/>
+    public ModBisectState? GetState()
+    {
+        lock (_lock) return _state;
+    }
+
+    /// <inheritdoc/>
+    public ModBisectState Start(string instanceId)
+    {
+        var info = _instanceService.FindInstanceById(instanceId);
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        if (info == null || string.IsNullOrEmpty(instancePath))
+            throw new ArgumentException($"Instance {instanceId} not found");
+
+        var enabled = _modService.GetInstanceInstalledMods(instancePath)
+            .Where(m => m.Enabled)
+            .Select(m => new ModBisectMod { Id = m.Id, Name = m.Name })
+            .ToList();
+        if (enabled.Count < 2)
+            throw new InvalidOperationException("At least two mods must be enabled; use safe mode to test a single mod");
+
+        lock (_lock)
+        {
+            if (_stepRunning)
+                throw new InvalidOperationException("A step of the current search is still running");
+
+            var state = new ModBisectState
+            {
+                InstanceId = instanceId,
+                InstanceName = info.Name,
+                Suspects = enabled
+            };
+            SplitSuspects(state);
+            _state = state;
+            Save(state);
+
+            Logger.Info("ModBisect", $"Started on {instanceId} with {enabled.Count} mods ({state.RemainingSteps} steps at most)");
+            return state;
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<DownloadProgress> LaunchStepAsync()
+    {
+        ModBisectState state;
+        lock (_lock)
+        {
+            if (_state == null || _state.Status != "testing")
+                throw new InvalidOperationException("No mod search is in progress");
+            if (_stepRunning)
+                throw new InvalidOperationException("The current step is already running");
+
+            state = _state;
+            _stepRunning = true;
+            _stepStarted = false;
+        }
+
+        try
+        {
+            Logger.Info("ModBisect", $"Step {state.Step}: launching with {state.Testing.Count} of {state.Suspects.Count} suspects enabled");
+            var result = await _safeModeService.LaunchWithModsAsync(state.InstanceId, state.Testing.Select(m => m.Id).ToList());
+
+            lock (_lock)
+            {
+                if (_stepStarted && ReferenceEquals(_state, state))
+                {
+                    state.Launched = true;
+                    Save(state);
+                }
+            }
+            return result;
+        }
+        finally
+        {
+            lock (_lock) _stepRunning = false;
+        }
+    }
+
+    /// <inheritdoc/>
+    public ModBisectState Report(bool crashed)
+    {
+        lock (_lock)
+        {
+            if (_state == null || _state.Status != "testing")
+                throw new InvalidOperationException("No mod search is in progress");
+            if (!_state.Launched)
+                throw new InvalidOperationException("Launch the current step before reporting its result");
+
+            var state = _state;
+            var tested = state.Testing.Select(m => m.Id).ToHashSet();
+            var untested = state.Suspects.Where(m => !tested.Contains(m.Id)).ToList();
+
+            // A crash with only the tested half enabled clears the other half, and vice versa
+            if (crashed)
+            {
+                state.Cleared.AddRange(untested);
+                state.Suspects = state.Testing.ToList();
+            }
+            else
+            {
+                state.Cleared.AddRange(state.Testing);
+                state.Suspects = untested;
+            }
+
+            state.Step++;
+            state.Launched = false;
+            SplitSuspects(state);
+            Save(state);
+
+            Logger.Info("ModBisect", state.Culprit != null
+                ? $"Step {state.Step - 1} {(crashed ? "crashed" : "passed")}; culprit is {state.Culprit.Name}"
+                : $"Step {state.Step - 1} {(crashed ? "crashed" : "passed")}; {state.Suspects.Count} suspects left");
+            return state;
+        }
+    }
+
+    /// <inheritdoc/>
+    public bool Cancel()
+    {
+        lock (_lock)
+        {
+            if (_state == null) return false;
+
+            Logger.Info("ModBisect", $"Search on {_state.InstanceId} dropped at step {_state.Step}");
+            _state = null;
+            try
+            {
+                if (File.Exists(_statePath)) File.Delete(_statePath);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("ModBisect", $"Failed to remove bisect state: {ex.Message}");
+            }
+            return true;
+        }
+    }
+
+    /// <summary>
+    /// Picks the half to test next, or the culprit once a single suspect is left.
+    /// </summary>
+    private static void SplitSuspects(ModBisectState state)
+    {
+        if (state.Suspects.Count <= 1)
+        {
+            state.Status = "found";
+            state.Culprit = state.Suspects.FirstOrDefault();
+            state.Testing = new List<ModBisectMod>();
+            return;
+        }
+
+        state.Testing = state.Suspects.Take(state.Suspects.Count / 2).ToList();
+    }
+
+    private ModBisectState? Load()
+    {
+        try
+        {
+            return File.Exists(_statePath)
+                ? JsonSerializer.Deserialize<ModBisectState>(File.ReadAllText(_statePath))
+                : null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("ModBisect", $"Unreadable bisect state: {ex.Message}");
+            return null;
+        }
+    }
+
+    private void Save(ModBisectState state)
+    {
+        try
+        {
+            File.WriteAllText(_statePath + ".tmp", JsonSerializer.Serialize(state));
+            File.Move(_statePath + ".tmp", _statePath, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("ModBisect", $"Failed to save bisect state: {ex.Message}");
+        }
+    }
+}
@@ -0,0 +1,202 @@
+using System.Security.Cryptography;
+using System.Text;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Keeps CurseForge screenshots and thumbnails on disk under <c>Cache/Mods/Images</c> so the mod
+/// details panel loads them from <c>file://</c> URLs instead of the CDN each time.
+/// </summary>
+/// <remarks>
+/// Images are downloaded on demand and only from CurseForge hosts. The cache is kept under
+/// <see cref="MaxCacheBytes"/> by deleting the least recently shown images first; showing an image
+/// again moves it to the back of the queue.
+/// </remarks>
+public class ModImageCache
+{
+    private const long MaxImageBytes = 10 * 1024 * 1024;
+    private const long MaxCacheBytes = 200L * 1024 * 1024;
+    // Evict down to this share of the limit so every new image does not trigger a cleanup
+    private const double EvictTargetRatio = 0.8;
+    private const int MaxParallelDownloads = 4;
+
+    private static readonly HashSet<string> KnownExtensions = new(StringComparer.OrdinalIgnoreCase)
+    {
+        ".png", ".jpg", ".jpeg", ".webp", ".gif"
+    };
+
+    private static readonly string[] AllowedHostSuffixes = ["forgecdn.net", "curseforge.com"];
+
+    private readonly HttpClient _httpClient;
+    private readonly string _imageDir;
+    private readonly Dictionary<string, Task<string?>> _inFlight = new();
+    private readonly SemaphoreSlim _downloadSlots = new(MaxParallelDownloads, MaxParallelDownloads);
+    private int _evicting;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="ModImageCache"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for downloading images.</param>
+    /// <param name="imageDir">Directory the images are stored in.</param>
+    public ModImageCache(HttpClient httpClient, string imageDir)
+    {
+        _httpClient = httpClient;
+        _imageDir = imageDir;
+    }
+
+    /// <summary>
+    /// Gets local copies of images, downloading the missing ones.
+    /// </summary>
+    /// <param name="urls">Remote image URLs.</param>
+    /// <returns>Each URL that could be cached mapped to its <c>file://</c> URL; others are left out.</returns>
+    public async Task<Dictionary<string, string>> GetLocalUrlsAsync(IEnumerable<string> urls)
+    {
+        var pending = urls
+            .Where(IsAllowed)
+            .Distinct()
+            .Select(async url => (url, local: await GetLocalPathAsync(url)))
+            .ToList();
+
+        var result = new Dictionary<string, string>();
+        foreach (var (url, local) in await Task.WhenAll(pending))
+        {
+            if (local != null) result[url] = ToFileUrl(local);
+        }
+
+        if (result.Count > 0) _ = Task.Run(EvictIfNeeded);
+        return result;
+    }
+
+    private Task<string?> GetLocalPathAsync(string url)
+    {
+        var path = GetLocalPath(url);
+        if (File.Exists(path))
+        {
+            // Touch so eviction keeps images that are still shown
+            try { File.SetLastWriteTimeUtc(path, DateTime.UtcNow); } catch { /* ignore */ }
+            return Task.FromResult<string?>(path);
+        }
+
+        lock (_inFlight)
+        {
+            if (_inFlight.TryGetValue(path, out var running)) return running;
+            var download = DownloadAsync(url, path);
+            // A download that already finished has removed itself; don't keep it around
+            if (!download.IsCompleted) _inFlight[path] = download;
+            return download;
+        }
+    }
+
+    private async Task<string?> DownloadAsync(string url, string path)
+    {
+        await _downloadSlots.WaitAsync();
+        try
+        {
+            using var cts = new CancellationTokenSource(TimeSpan.FromSeconds(30));
+            using var response = await _httpClient.GetAsync(url, HttpCompletionOption.ResponseHeadersRead, cts.Token);
+            response.EnsureSuccessStatusCode();
+
+            var mediaType = response.Content.Headers.ContentType?.MediaType ?? "";
+            if (!mediaType.StartsWith("image/", StringComparison.OrdinalIgnoreCase))
+            {
+                Logger.Debug("ModService", $"Skipping non-image screenshot ({mediaType}): {url}");
+                return null;
+            }
+            if (response.Content.Headers.ContentLength > MaxImageBytes)
+            {
+                Logger.Debug("ModService", $"Skipping oversized screenshot: {url}");
+                return null;
+            }
+
+            Directory.CreateDirectory(_imageDir);
+            var tempPath = path + ".part";
+            await using (var source = await response.Content.ReadAsStreamAsync(cts.Token))
+            await using (var target = File.Create(tempPath))
+            {
+                var buffer = new byte[81920];
+                long total = 0;
+                int read;
+                while ((read = await source.ReadAsync(buffer, cts.Token)) > 0)
+                {
+                    total += read;
+                    if (total > MaxImageBytes) throw new InvalidDataException("Screenshot exceeds size limit");
+                    await target.WriteAsync(buffer.AsMemory(0, read), cts.Token);
+                }
+            }
+            File.Move(tempPath, path, true);
+            return path;
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("ModService", $"Failed to cache screenshot {url}: {ex.Message}");
+            try { File.Delete(path + ".part"); } catch { /* ignore */ }
+            return null;
+        }
+        finally
+        {
+            _downloadSlots.Release();
+            lock (_inFlight) _inFlight.Remove(path);
+        }
+    }
+
+    /// <summary>
+    /// Deletes the least recently shown images once the cache is over <see cref="MaxCacheBytes"/>.
+    /// </summary>
+    private void EvictIfNeeded()
+    {
+        if (Interlocked.Exchange(ref _evicting, 1) == 1) return;
+        try
+        {
+            if (!Directory.Exists(_imageDir)) return;
+
+            var files = new DirectoryInfo(_imageDir).EnumerateFiles()
+                .Where(f => !f.Name.EndsWith(".part", StringComparison.OrdinalIgnoreCase))
+                .ToList();
+            long total = files.Sum(f => f.Length);
+            if (total <= MaxCacheBytes) return;
+
+            long target = (long)(MaxCacheBytes * EvictTargetRatio);
+            int removed = 0;
+            foreach (var file in files.OrderBy(f => f.LastWriteTimeUtc))
+            {
+                if (total <= target) break;
+                try
+                {
+                    long size = file.Length;
+                    file.Delete();
+                    total -= size;
+                    removed++;
+                }
+                catch (IOException) { /* in use; try the next one */ }
+            }
+            Logger.Info("ModService", $"Screenshot cache over {MaxCacheBytes / (1024 * 1024)} MB, removed {removed} image(s)");
+        }
+        catch (Exception ex)
+        {
+            Logger.Debug("ModService", $"Screenshot cache cleanup failed: {ex.Message}");
+        }
+        finally
+        {
+            Interlocked.Exchange(ref _evicting, 0);
+        }
+    }
+
+    private string GetLocalPath(string url)
+    {
+        var hash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(url)))[..24].ToLowerInvariant();
+        var ext = Uri.TryCreate(url, UriKind.Absolute, out var uri) ? Path.GetExtension(uri.AbsolutePath) : "";
+        if (!KnownExtensions.Contains(ext)) ext = ".img";
+        return Path.Combine(_imageDir, hash + ext);
+    }
+
+    /// <summary>
+    /// Only HTTPS images from CurseForge are cached, so the frontend cannot make the launcher fetch arbitrary URLs.
+    /// </summary>
+    private static bool IsAllowed(string? url) =>
+        Uri.TryCreate(url, UriKind.Absolute, out var uri) &&
+        uri.Scheme == Uri.UriSchemeHttps &&
+        AllowedHostSuffixes.Any(h => uri.Host == h || uri.Host.EndsWith("." + h, StringComparison.OrdinalIgnoreCase));
+
+    private static string ToFileUrl(string path) => $"file://{path.Replace("\\", "/")}";
+}
@@ -1,6 +1,8 @@
 using HyPrism.Services.Core.App;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Download;
 using HyPrism.Services.Game.Instance;
+using System.Collections.Concurrent;
 using System.Text.Json;
 using System.Text.Json.Serialization;
 using HyPrism.Models;
@@ -16,7 +18,10 @@ namespace HyPrism.Services.Game.Mod;
 public class ModService : IModService
 {
     private readonly HttpClient _httpClient;
+    private readonly IDownloadService _downloadService;
+    private readonly IConnectivityService _connectivityService;
     private readonly string _appDir;
+    private readonly ModImageCache _imageCache;
     
     // CurseForge API base URL
     private const string CfApiBaseUrl = "https://api.curseforge.com";
@@ -27,6 +32,29 @@ public class ModService : IModService
     // Hytale game ID on CurseForge
     private const int HytaleGameId = 70216;
 
+    // Parallel CurseForge requests when checking an instance for mod updates
+    private const int UpdateCheckConcurrency = 8;
+
+    // IDs per POST /v1/mods or /v1/mods/files request
+    private const int BulkLookupBatchSize = 100;
+
+    // CurseForge rejects searches with more categoryIds
+    private const int MaxSearchCategories = 10;
+
+    // Classes and categories change rarely; one fetch serves the filters and the class lookup
+    private static readonly TimeSpan CategoryCacheDuration = TimeSpan.FromHours(6);
+    private List<CurseForgeCategory>? _rawCategories;
+    private DateTime _rawCategoriesFetchedAt;
+    private List<string>? _gameVersions;
+    private DateTime _gameVersionsFetchedAt;
+
+    // Author pages are opened repeatedly while browsing from one mod to the next
+    private static readonly TimeSpan AuthorCacheDuration = TimeSpan.FromMinutes(10);
+    private const int MaxCachedAuthorPages = 50;
+    private const int CurseForgeSortLastUpdated = 3;
+    private readonly ConcurrentDictionary<string, (DateTime FetchedAt, ModAuthorResult Result)> _authorPages = new();
+    private readonly ConcurrentDictionary<string, (int Id, string Name)> _authorIdsByName = new(StringComparer.OrdinalIgnoreCase);
+
     // Lock for mod manifest operations to prevent concurrent writes
     private static readonly SemaphoreSlim _modManifestLock = new(1, 1);
     
@@ -40,6 +68,9 @@ public class ModService : IModService
     private readonly ConfigService _configService;
     private readonly InstanceService _instanceService;
     private readonly ProgressNotificationService _progressNotificationService;
+
+    /// <inheritdoc/>
+    public event Action<ModCompatibilityReport>? IncompatibleModsFound;
     
     /// <summary>
     /// Gets the CurseForge API key from configuration.
@@ -51,13 +82,18 @@ public class ModService : IModService
     /// </summary>
     public ModService(
         HttpClient httpClient, 
+        IDownloadService downloadService,
+        IConnectivityService connectivityService,
         string appDir,
         ConfigService configService,
         InstanceService instanceService,
         ProgressNotificationService progressNotificationService)
     {
         _httpClient = httpClient;
+        _downloadService = downloadService;
+        _connectivityService = connectivityService;
         _appDir = appDir;
+        _imageCache = new ModImageCache(httpClient, Path.Combine(appDir, "Cache", "Mods", "Images"));
         _configService = configService;
         _instanceService = instanceService;
         _progressNotificationService = progressNotificationService;
@@ -74,6 +110,24 @@ public class ModService : IModService
         return request;
     }
     
+    /// <summary>
+    /// Locks an instance for a mod change; reports the reason to the user if it is in use.
+    /// </summary>
+    /// <returns>The lock, or <c>null</c> if the game runs from the instance or it is being changed otherwise.</returns>
+    private InstanceLock? TryLockInstance(string instancePath, string operation)
+    {
+        try
+        {
+            return InstanceLock.Acquire(instancePath, InstanceLock.Modifying, operation);
+        }
+        catch (InstanceLockedException ex)
+        {
+            Logger.Warning("ModService", $"Not {operation} in {instancePath}: {ex.Message}");
+            _progressNotificationService.ReportLocalizedError("instanceLocked", ex.MessageKey);
+            return null;
+        }
+    }
+
     /// <summary>
     /// Validates that the CurseForge API key is available.
     /// </summary>
@@ -85,26 +139,49 @@ public class ModService : IModService
     }
     
     /// <inheritdoc/>
-    public async Task<ModSearchResult> SearchModsAsync(string query, int page, int pageSize, string[] categories, int sortField, int sortOrder)
+    public Task<ModSearchResult> SearchModsAsync(string query, int page, int pageSize, string[] categories, int sortField, int sortOrder)
+    {
+        return SearchModsAsync(new SearchModsParams
+        {
+            Query = query,
+            Page = page,
+            PageSize = pageSize,
+            CategoryIds = categories
+                .Select(c => int.TryParse(c, out var id) ? id : 0)
+                .Where(id => id > 0)
+                .ToList(),
+            SortField = sortField,
+            SortOrder = sortOrder
+        });
+    }
+
+    /// <inheritdoc/>
+    public async Task<ModSearchResult> SearchModsAsync(SearchModsParams parameters)
     {
         if (!HasApiKey())
             return new ModSearchResult { Mods = new List<ModInfo>(), TotalCount = 0 };
 
         try
         {
-            var index = page * pageSize;
-            var sortOrderStr = sortOrder == 0 ? "asc" : "desc";
+            var (classId, categoryIds) = await SplitClassFilterAsync(parameters);
+
+            var index = parameters.Page * parameters.PageSize;
+            var sortOrderStr = parameters.SortOrder == 0 ? "asc" : "desc";
             var endpoint = $"/v1/mods/search?gameId={HytaleGameId}" +
-                           $"&searchFilter={Uri.EscapeDataString(query)}" +
-                           $"&index={index}&pageSize={pageSize}" +
-                           $"&sortField={sortField}&sortOrder={sortOrderStr}";
-            
-            if (categories is { Length: > 0 })
-            {
-                var catId = categories[0];
-                if (int.TryParse(catId, out var categoryId) && categoryId > 0)
-                    endpoint += $"&categoryId={categoryId}";
-            }
+                           $"&searchFilter={Uri.EscapeDataString(parameters.Query)}" +
+                           $"&index={index}&pageSize={parameters.PageSize}" +
+                           $"&sortField={parameters.SortField}&sortOrder={sortOrderStr}";
+
+            if (classId != null)
+                endpoint += $"&classId={classId}";
+
+            if (parameters.AuthorId != null)
+                endpoint += $"&authorId={parameters.AuthorId}";
+
+            if (categoryIds.Count == 1)
+                endpoint += $"&categoryId={categoryIds[0]}";
+            else if (categoryIds.Count > 1)
+                endpoint += $"&categoryIds={Uri.EscapeDataString($"[{string.Join(",", categoryIds.Take(MaxSearchCategories))}]")}";
             
             using var request = CreateCurseForgeRequest(HttpMethod.Get, endpoint);
             using var response = await _httpClient.SendAsync(request);
@@ -132,16 +209,148 @@ public class ModService : IModService
         catch (Exception ex)
         {
             Logger.Error("ModService", $"Search failed: {ex.Message}");
-            return new ModSearchResult { Mods = new List<ModInfo>(), TotalCount = 0 };
+            var status = await _connectivityService.DescribeFailureAsync(ex);
+            return new ModSearchResult
+            {
+                Mods = new List<ModInfo>(),
+                TotalCount = 0,
+                MessageKey = status?.MessageKey,
+                Args = status != null ? [string.Join(", ", status.BlockedHosts)] : null
+            };
         }
     }
 
     /// <inheritdoc/>
-    public async Task<List<ModCategory>> GetModCategoriesAsync()
+    public async Task<ModAuthorResult> GetModsByAuthorAsync(int? authorId, string? authorName, int page = 0, int pageSize = 20)
     {
-        if (!HasApiKey())
-            return GetFallbackCategories();
-        
+        string name = authorName?.Trim() ?? "";
+        if (authorId == null)
+        {
+            if (string.IsNullOrEmpty(name))
+                return new ModAuthorResult();
+
+            var found = await FindAuthorByNameAsync(name);
+            if (found == null)
+            {
+                Logger.Info("ModService", $"No CurseForge author named {name}");
+                return new ModAuthorResult { AuthorName = name };
+            }
+            (authorId, name) = found.Value;
+        }
+
+        var key = $"{authorId}:{page}:{pageSize}";
+        if (_authorPages.TryGetValue(key, out var cached) && DateTime.UtcNow - cached.FetchedAt < AuthorCacheDuration)
+            return cached.Result;
+
+        var search = await SearchModsAsync(new SearchModsParams
+        {
+            AuthorId = authorId,
+            Page = page,
+            PageSize = pageSize,
+            SortField = CurseForgeSortLastUpdated,
+            SortOrder = 1
+        });
+
+        if (string.IsNullOrEmpty(name))
+            name = search.Mods.FirstOrDefault(m => m.AuthorId == authorId)?.Author ?? "";
+
+        var result = new ModAuthorResult
+        {
+            AuthorId = authorId,
+            AuthorName = name,
+            AuthorUrl = string.IsNullOrEmpty(name) ? "" : $"{CfBaseUrl}/members/{Uri.EscapeDataString(name)}",
+            Mods = search.Mods,
+            TotalCount = search.TotalCount,
+            MessageKey = search.MessageKey,
+            Args = search.Args
+        };
+
+        // Failed lookups are retried on the next request instead of being served from the cache
+        if (search.MessageKey == null)
+        {
+            if (_authorPages.Count >= MaxCachedAuthorPages)
+            {
+                foreach (var oldest in _authorPages.OrderBy(p => p.Value.FetchedAt).Take(_authorPages.Count - MaxCachedAuthorPages + 1))
+                    _authorPages.TryRemove(oldest.Key, out _);
+            }
+            _authorPages[key] = (DateTime.UtcNow, result);
+        }
+        return result;
+    }
+
+    /// <inheritdoc/>
+    public Task<Dictionary<string, string>> GetCachedImagesAsync(IEnumerable<string> urls) =>
+        _imageCache.GetLocalUrlsAsync(urls);
+
+    /// <summary>
+    /// Finds an author's ID by searching for their name and matching the authors of the results.
+    /// </summary>
+    private async Task<(int Id, string Name)?> FindAuthorByNameAsync(string name)
+    {
+        if (_authorIdsByName.TryGetValue(name, out var known)) return known;
+        if (!HasApiKey()) return null;
+
+        try
+        {
+            var endpoint = $"/v1/mods/search?gameId={HytaleGameId}&searchFilter={Uri.EscapeDataString(name)}&pageSize=50";
+            using var request = CreateCurseForgeRequest(HttpMethod.Get, endpoint);
+            using var response = await _httpClient.SendAsync(request);
+            if (!response.IsSuccessStatusCode)
+            {
+                Logger.Warning("ModService", $"Author lookup returned {response.StatusCode}");
+                return null;
+            }
+
+            var json = await response.Content.ReadAsStringAsync();
+            var author = JsonSerializer.Deserialize<CurseForgeSearchResponse>(json, _jsonOptions)?.Data?
+                .SelectMany(m => m.Authors ?? [])
+                .FirstOrDefault(a => string.Equals(a.Name, name, StringComparison.OrdinalIgnoreCase));
+            if (author == null) return null;
+
+            var match = (author.Id, author.Name ?? name);
+            _authorIdsByName[name] = match;
+            return match;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("ModService", $"Author lookup failed: {ex.Message}");
+            return null;
+        }
+    }
+
+    /// <summary>
+    /// Moves class IDs passed as categories to the class filter; CurseForge returns nothing when a
+    /// class ID is sent as <c>categoryId</c>.
+    /// </summary>
+    private async Task<(int? ClassId, List<int> CategoryIds)> SplitClassFilterAsync(SearchModsParams parameters)
+    {
+        var classId = parameters.ClassId;
+        var categoryIds = parameters.CategoryIds.Where(id => id > 0).Distinct().ToList();
+        if (categoryIds.Count == 0) return (classId, categoryIds);
+
+        var raw = await GetRawCategoriesAsync();
+        if (raw == null) return (classId, categoryIds);
+
+        var classIds = raw.Where(c => c.IsClass == true).Select(c => c.Id).ToHashSet();
+        foreach (var id in categoryIds.Where(classIds.Contains).ToList())
+        {
+            categoryIds.Remove(id);
+            classId ??= id;
+        }
+        return (classId, categoryIds);
+    }
+
+    /// <summary>
+    /// Gets every CurseForge class and category of the game, cached for <see cref="CategoryCacheDuration"/>.
+    /// </summary>
+    /// <returns>The categories, or null when they could not be loaded.</returns>
+    private async Task<List<CurseForgeCategory>?> GetRawCategoriesAsync()
+    {
+        if (_rawCategories != null && DateTime.UtcNow - _rawCategoriesFetchedAt < CategoryCacheDuration)
+            return _rawCategories;
+
+        if (!HasApiKey()) return null;
+
         try
         {
             var endpoint = $"/v1/categories?gameId={HytaleGameId}";
@@ -151,62 +360,112 @@ public class ModService : IModService
             if (!response.IsSuccessStatusCode)
             {
                 Logger.Warning("ModService", $"Categories request returned {response.StatusCode}");
-                return GetFallbackCategories();
+                return _rawCategories;
             }
             
             var json = await response.Content.ReadAsStringAsync();
             var cfResponse = JsonSerializer.Deserialize<CurseForgeCategoriesResponse>(json, _jsonOptions);
-            
             if (cfResponse?.Data == null || cfResponse.Data.Count == 0)
-                return GetFallbackCategories();
-            
-            // Find the "Mods" class category dynamically (matching original repo)
-            var modsClass = cfResponse.Data.FirstOrDefault(c => c.IsClass == true &&
-                string.Equals(c.Name, "mods", StringComparison.OrdinalIgnoreCase));
-            int modsClassId = modsClass?.Id ?? 0;
-            
-            var categories = new List<ModCategory>
-            {
-                new ModCategory { Id = 0, Name = "All Mods", Slug = "all" }
-            };
-            
-            // Get subcategories under the Mods class
-            var modCategories = cfResponse.Data
-                .Where(c => c.ParentCategoryId == modsClassId && c.IsClass != true)
-                .Select(c => new ModCategory
-                {
-                    Id = c.Id,
-                    Name = c.Name ?? "",
-                    Slug = c.Slug ?? ""
-                })
-                .OrderBy(c => c.Name)
-                .ToList();
-            
-            // Fallback: if no subcategories found, return all non-class categories
-            if (modCategories.Count == 0)
-            {
-                modCategories = cfResponse.Data
-                    .Where(c => c.IsClass != true)
-                    .Select(c => new ModCategory
-                    {
-                        Id = c.Id,
-                        Name = c.Name ?? "",
-                        Slug = c.Slug ?? ""
-                    })
-                    .OrderBy(c => c.Name)
-                    .ToList();
-            }
-            
-            categories.AddRange(modCategories);
-            
-            return categories;
+                return _rawCategories;
+
+            _rawCategories = cfResponse.Data;
+            _rawCategoriesFetchedAt = DateTime.UtcNow;
+            return _rawCategories;
         }
         catch (Exception ex)
         {
             Logger.Warning("ModService", $"Failed to load categories: {ex.Message}");
+            return _rawCategories;
+        }
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<ModCategory>> GetModCategoriesAsync()
+    {
+        var raw = await GetRawCategoriesAsync();
+        if (raw == null)
             return GetFallbackCategories();
+        
+        // Find the "Mods" class category dynamically (matching original repo)
+        var modsClass = raw.FirstOrDefault(c => c.IsClass == true &&
+            string.Equals(c.Name, "mods", StringComparison.OrdinalIgnoreCase));
+        int modsClassId = modsClass?.Id ?? 0;
+        
+        var categories = new List<ModCategory>
+        {
+            new ModCategory { Id = 0, Name = "All Mods", Slug = "all" }
+        };
+        
+        // Get subcategories under the Mods class
+        var modCategories = raw
+            .Where(c => c.ParentCategoryId == modsClassId && c.IsClass != true)
+            .Select(ToModCategory)
+            .OrderBy(c => c.Name)
+            .ToList();
+        
+        // Fallback: if no subcategories found, return all non-class categories
+        if (modCategories.Count == 0)
+        {
+            modCategories = raw
+                .Where(c => c.IsClass != true)
+                .Select(ToModCategory)
+                .OrderBy(c => c.Name)
+                .ToList();
         }
+        
+        categories.AddRange(modCategories);
+        
+        return categories;
     }
+
+    /// <inheritdoc/>
+    public async Task<List<ModCategory>> GetModCategoryTreeAsync()
+    {
+        var raw = await GetRawCategoriesAsync();
+        if (raw == null)
+        {
+            var fallback = GetFallbackCategories().Where(c => c.Id != 0).ToList();
+            return [new ModCategory { Id = 0, Name = "Mods", Slug = "mc-mods", IsClass = true, Children = fallback }];
+        }
+
+        var nodes = raw.ToDictionary(c => c.Id, ToModCategory);
+        var roots = new List<ModCategory>();
+        foreach (var node in nodes.Values)
+        {
+            if (node.IsClass)
+            {
+                roots.Add(node);
+                continue;
+            }
+
+            // Subcategories name their parent category; the rest hang directly off their class
+            var parentId = node.ParentId is > 0 && nodes.ContainsKey(node.ParentId.Value) ? node.ParentId : node.ClassId;
+            if (parentId != null && nodes.TryGetValue(parentId.Value, out var parent) && parent != node)
+                parent.Children.Add(node);
+            else
+                roots.Add(node);
+        }
+
+        SortTree(roots);
+        return roots;
+    }
+
+    private static void SortTree(List<ModCategory> categories)
+    {
+        categories.Sort((a, b) => string.Compare(a.Name, b.Name, StringComparison.OrdinalIgnoreCase));
+        foreach (var category in categories)
+            SortTree(category.Children);
+    }
+
+    private static ModCategory ToModCategory(CurseForgeCategory c) => new()
+    {
+        Id = c.Id,
+        Name = c.Name ?? "",
+        Slug = c.Slug ?? "",
+        IsClass = c.IsClass == true,
+        ClassId = c.IsClass == true ? null : c.ClassId,
+        ParentId = c.IsClass == true || c.ParentCategoryId == 0 ? null : c.ParentCategoryId
+    };
     
     private static List<ModCategory> GetFallbackCategories()
     {
@@ -224,6 +483,9 @@ public class ModService : IModService
     {
         if (!HasApiKey()) return false;
 
+        using var instanceLock = TryLockInstance(instancePath, "installing a mod");
+        if (instanceLock == null) return false;
+
         try
         {
             // Get file info first
@@ -255,15 +517,29 @@ public class ModService : IModService
             
             var filePath = Path.Combine(modsPath, cfFile.FileName ?? $"mod_{cfFile.Id}.jar");
             
-            using var downloadResponse = await _httpClient.GetAsync(cfFile.DownloadUrl);
-            if (!downloadResponse.IsSuccessStatusCode)
+            // Download next to the target so a failed or mismatched file never replaces a working mod
+            var partPath = filePath + ".part";
+            if (File.Exists(partPath)) File.Delete(partPath);
+            
+            var sha1 = cfFile.Hashes?.FirstOrDefault(h => h.Algo == 1 && !string.IsNullOrEmpty(h.Value))?.Value;
+            var modName = cfFile.FileName ?? "mod file";
+            using (var operation = _progressNotificationService.BeginOperation("mod"))
             {
-                Logger.Warning("ModService", $"Download returned {downloadResponse.StatusCode}");
-                return false;
+                try
+                {
+                    await _downloadService.DownloadFileAsync(cfFile.DownloadUrl, partPath,
+                        p => operation.Report("download", p.Percent, p, "launch.detail.downloading_mod", [modName]),
+                        sha1 != null ? ExpectedHash.Sha1(sha1) : null);
+                    operation.Complete();
+                }
+                catch (HashMismatchException ex)
+                {
+                    Logger.Warning("ModService", $"Rejected {cfFile.FileName}: {ex.Message}");
+                    return false;
+                }
             }
-            
-            await using var fs = new FileStream(filePath, FileMode.Create, FileAccess.Write);
-            await downloadResponse.Content.CopyToAsync(fs);
+            File.Move(partPath, filePath, true);
+            LongPaths.WarnIfTooLong(filePath, "Mod install");
             
             onProgress?.Invoke("installing", cfFile.FileName ?? "mod file");
             
@@ -308,6 +584,8 @@ public class ModService : IModService
                 CurseForgeId = numericModId,  // Always save numeric ID
                 FileDate = cfFile.FileDate ?? "",
                 ReleaseType = cfFile.ReleaseType,
+                Dependencies = GetRequiredDependencies(cfFile),
+                GameVersions = cfFile.GameVersions ?? new List<string>(),
                 Screenshots = modInfo?.Screenshots?.Select(s => new CurseForgeScreenshot
                 {
                     Id = s.Id,
@@ -332,6 +610,118 @@ public class ModService : IModService
         }
     }
 
+    /// <inheritdoc/>
+    public async Task<ModResolveResult> ResolveAndInstallAsync(string input, string instancePath)
+    {
+        var reference = ParseModReference(input);
+        if (reference == null)
+            return new ModResolveResult { MessageKey = "modManager.resolve.invalid" };
+        if (!HasApiKey())
+            return new ModResolveResult { MessageKey = "modManager.resolve.notFound" };
+
+        var (projectId, slug, fileId) = reference.Value;
+        try
+        {
+            CurseForgeMod? mod = projectId != null
+                ? await GetCurseForgeModAsync(projectId.Value)
+                : await FindModBySlugAsync(slug!);
+            if (mod == null)
+            {
+                Logger.Warning("ModService", $"No CurseForge project found for '{input}'");
+                return new ModResolveResult { MessageKey = "modManager.resolve.notFound" };
+            }
+
+            fileId ??= mod.MainFileId > 0
+                ? mod.MainFileId
+                : mod.LatestFiles?.OrderByDescending(f => f.FileDate).FirstOrDefault()?.Id;
+
+            var result = new ModResolveResult
+            {
+                ModId = mod.Id.ToString(),
+                FileId = fileId?.ToString() ?? "",
+                Name = mod.Name ?? mod.Slug ?? ""
+            };
+            if (fileId == null)
+            {
+                result.MessageKey = "modManager.resolve.noFiles";
+                return result;
+            }
+
+            Logger.Info("ModService", $"Resolved '{input}' to {result.Name} (project {result.ModId}, file {result.FileId})");
+            result.Success = await InstallModFileToInstanceAsync(result.ModId, result.FileId, instancePath);
+            if (!result.Success) result.MessageKey = "modManager.resolve.installFailed";
+            return result;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("ModService", $"Resolve and install failed: {ex.Message}");
+            return new ModResolveResult { MessageKey = "modManager.resolve.installFailed" };
+        }
+    }
+
+    /// <summary>
+    /// Reads a project ID or slug, and optionally a file ID, from pasted text.
+    /// </summary>
+    /// <remarks>
+    /// Accepts <c>123:456</c>, <c>123</c>, a bare slug, and curseforge.com links such as
+    /// <c>/hytale/mods/slug</c>, <c>/hytale/mods/slug/files/456</c>, <c>/hytale/mods/slug/download/456</c>
+    /// and <c>/projects/123</c>.
+    /// </remarks>
+    private static (int? ProjectId, string? Slug, int? FileId)? ParseModReference(string? input)
+    {
+        var text = input?.Trim() ?? "";
+        if (text.Length == 0) return null;
+
+        var pair = Regex.Match(text, @"^(\d+)\s*:\s*(\d+)$");
+        if (pair.Success) return (int.Parse(pair.Groups[1].Value), null, int.Parse(pair.Groups[2].Value));
+        if (int.TryParse(text, out var bareId) && bareId > 0) return (bareId, null, null);
+
+        // Links copied from the address bar often lose their scheme
+        if (!text.Contains("://") && (text.StartsWith("www.curseforge.com/", StringComparison.OrdinalIgnoreCase) || text.StartsWith("curseforge.com/", StringComparison.OrdinalIgnoreCase)))
+            text = "https://" + text;
+
+        if (Uri.TryCreate(text, UriKind.Absolute, out var uri) && (uri.Scheme == Uri.UriSchemeHttps || uri.Scheme == Uri.UriSchemeHttp))
+        {
+            if (uri.Host != "curseforge.com" && !uri.Host.EndsWith(".curseforge.com", StringComparison.OrdinalIgnoreCase))
+                return null;
+
+            var segments = uri.AbsolutePath.Split('/', StringSplitOptions.RemoveEmptyEntries);
+            if (segments.Length >= 2 && segments[0] == "projects" && int.TryParse(segments[1], out var linkedId))
+                return (linkedId, null, null);
+
+            // /hytale/<class>/<slug>[/files|download/<fileId>]
+            if (segments.Length < 3 || !segments[0].Equals("hytale", StringComparison.OrdinalIgnoreCase))
+                return null;
+
+            int? linkedFile = segments.Length >= 5 && segments[3] is "files" or "download" && int.TryParse(segments[4], out var file) ? file : null;
+            return (null, segments[2], linkedFile);
+        }
+
+        return Regex.IsMatch(text, @"^[a-z0-9][a-z0-9_-]*$", RegexOptions.IgnoreCase) ? (null, text.ToLowerInvariant(), null) : null;
+    }
+
+    private async Task<CurseForgeMod?> GetCurseForgeModAsync(int projectId)
+    {
+        using var request = CreateCurseForgeRequest(HttpMethod.Get, $"/v1/mods/{projectId}");
+        using var response = await _httpClient.SendAsync(request);
+        if (!response.IsSuccessStatusCode) return null;
+
+        var json = await response.Content.ReadAsStringAsync();
+        return JsonSerializer.Deserialize<CurseForgeModResponse>(json, _jsonOptions)?.Data;
+    }
+
+    private async Task<CurseForgeMod?> FindModBySlugAsync(string slug)
+    {
+        var endpoint = $"/v1/mods/search?gameId={HytaleGameId}&slug={Uri.EscapeDataString(slug)}";
+        using var request = CreateCurseForgeRequest(HttpMethod.Get, endpoint);
+        using var response = await _httpClient.SendAsync(request);
+        if (!response.IsSuccessStatusCode) return null;
+
+        var json = await response.Content.ReadAsStringAsync();
+        return JsonSerializer.Deserialize<CurseForgeSearchResponse>(json, _jsonOptions)?.Data?
+            .FirstOrDefault(m => string.Equals(m.Slug, slug, StringComparison.OrdinalIgnoreCase));
+    }
+
     /// <inheritdoc/>
     public List<InstalledMod> GetInstanceInstalledMods(string instancePath)
     {
@@ -574,6 +964,369 @@ public class ModService : IModService
         }
     }
 
+    /// <inheritdoc/>
+    public async Task<bool> UninstallInstanceModAsync(string instancePath, string modId, bool removeOrphanedDependencies = false)
+    {
+        using var instanceLock = TryLockInstance(instancePath, "removing a mod");
+        if (instanceLock == null) return false;
+
+        var mods = GetInstanceInstalledMods(instancePath);
+        var mod = mods.FirstOrDefault(m => m.Id == modId || m.Name == modId);
+        if (mod == null) return false;
+
+        var impact = BuildUninstallImpact(mods, mod);
+        if (impact.Dependents.Count > 0)
+        {
+            Logger.Warning("ModService", $"Removing {mod.Name}, which is required by {string.Join(", ", impact.Dependents.Select(d => d.Name))}");
+        }
+
+        var removed = new List<InstalledMod> { mod };
+        if (removeOrphanedDependencies) removed.AddRange(impact.Orphans);
+
+        foreach (var target in removed)
+        {
+            mods.Remove(target);
+            DeleteInstanceModFile(instancePath, target);
+        }
+
+        await SaveInstanceModsAsync(instancePath, mods);
+        Logger.Info("ModService", removed.Count > 1
+            ? $"Uninstalled mod: {mod.Name} and {removed.Count - 1} unused dependencies ({string.Join(", ", removed.Skip(1).Select(m => m.Name))})"
+            : $"Uninstalled mod: {mod.Name}");
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public async Task<ModUninstallImpact?> GetUninstallImpactAsync(string instancePath, string modId, CancellationToken ct = default)
+    {
+        var mods = GetInstanceInstalledMods(instancePath);
+        var mod = mods.FirstOrDefault(m => m.Id == modId || m.Name == modId);
+        if (mod == null) return null;
+
+        if (await BackfillFileMetadataAsync(mods, ct))
+        {
+            await SaveInstanceModsAsync(instancePath, mods);
+        }
+
+        return BuildUninstallImpact(mods, mod);
+    }
+
+    /// <inheritdoc/>
+    public async Task<ModCompatibilityReport> CheckGameVersionCompatibilityAsync(string instancePath, string gameVersion, CancellationToken ct = default)
+    {
+        var report = new ModCompatibilityReport { InstancePath = instancePath, GameVersion = gameVersion.Trim() };
+        if (report.GameVersion.Length == 0) return report;
+
+        var mods = GetInstanceInstalledMods(instancePath);
+        if (await BackfillFileMetadataAsync(mods, ct))
+        {
+            await SaveInstanceModsAsync(instancePath, mods);
+        }
+
+        report.Mods = mods.Where(m => m.Enabled && !SupportsGameVersion(m.GameVersions, report.GameVersion)).ToList();
+        if (report.Mods.Count > 0)
+        {
+            Logger.Warning("ModService", $"{report.Mods.Count} enabled mod(s) do not list game version {report.GameVersion}: " +
+                string.Join(", ", report.Mods.Select(m => m.Name)));
+            IncompatibleModsFound?.Invoke(report);
+        }
+        return report;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<string>> GetGameVersionsAsync()
+    {
+        if (_gameVersions != null && DateTime.UtcNow - _gameVersionsFetchedAt < CategoryCacheDuration)
+            return _gameVersions;
+        if (!HasApiKey()) return new List<string>();
+
+        try
+        {
+            using var request = CreateCurseForgeRequest(HttpMethod.Get, $"/v1/games/{HytaleGameId}/versions");
+            using var response = await _httpClient.SendAsync(request);
+            if (!response.IsSuccessStatusCode)
+            {
+                Logger.Warning("ModService", $"Get game versions returned {response.StatusCode}");
+                return _gameVersions ?? new List<string>();
+            }
+
+            var json = await response.Content.ReadAsStringAsync();
+            var versions = JsonSerializer.Deserialize<CurseForgeGameVersionsResponse>(json, _jsonOptions)?.Data?
+                .SelectMany(t => t.Versions ?? [])
+                .Where(v => !string.IsNullOrWhiteSpace(v))
+                .Distinct(StringComparer.OrdinalIgnoreCase)
+                .ToList() ?? new List<string>();
+
+            _gameVersions = versions;
+            _gameVersionsFetchedAt = DateTime.UtcNow;
+            return versions;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("ModService", $"Get game versions failed: {ex.Message}");
+            return _gameVersions ?? new List<string>();
+        }
+    }
+
+    /// <summary>
+    /// A file that lists no game versions (or whose versions are unknown) is assumed to work with any.
+    /// </summary>
+    private static bool SupportsGameVersion(List<string>? gameVersions, string gameVersion) =>
+        gameVersions == null || gameVersions.Count == 0
+        || gameVersions.Any(v => string.Equals(v.Trim(), gameVersion, StringComparison.OrdinalIgnoreCase));
+
+    /// <summary>
+    /// Looks up the dependencies and game versions of CurseForge mods installed before they were
+    /// recorded in the manifest.
+    /// </summary>
+    /// <returns><c>true</c> if any mod was filled in.</returns>
+    private async Task<bool> BackfillFileMetadataAsync(List<InstalledMod> mods, CancellationToken ct)
+    {
+        var missing = mods
+            .Where(m => (m.Dependencies == null || m.GameVersions == null) && int.TryParse(m.FileId, out _))
+            .ToList();
+        if (missing.Count == 0 || !HasApiKey()) return false;
+
+        try
+        {
+            var files = (await GetFilesByIdsAsync(missing.Select(m => int.Parse(m.FileId)), ct))
+                .ToDictionary(f => f.Id.ToString());
+            var filled = false;
+            foreach (var mod in missing)
+            {
+                if (!files.TryGetValue(mod.FileId, out var file)) continue;
+                mod.Dependencies ??= GetRequiredDependencies(file);
+                mod.GameVersions ??= file.GameVersions ?? new List<string>();
+                filled = true;
+            }
+            return filled;
+        }
+        catch (Exception ex) when (ex is not OperationCanceledException)
+        {
+            Logger.Warning("ModService", $"Failed to look up installed mod files: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <summary>
+    /// Finds the mods that require <paramref name="mod"/>, and the dependencies that nothing else
+    /// needs once it is gone. Orphans are followed transitively, so a dependency only kept by
+    /// another orphan is listed too.
+    /// </summary>
+    private static ModUninstallImpact BuildUninstallImpact(List<InstalledMod> mods, InstalledMod mod)
+    {
+        static bool Requires(InstalledMod m, InstalledMod dependency) =>
+            !string.IsNullOrEmpty(dependency.CurseForgeId) && m.Dependencies?.Contains(dependency.CurseForgeId) == true;
+
+        var impact = new ModUninstallImpact
+        {
+            ModId = mod.Id,
+            Dependents = mods.Where(m => m != mod && Requires(m, mod)).ToList()
+        };
+
+        var removed = new HashSet<InstalledMod> { mod };
+        var changed = true;
+        while (changed)
+        {
+            changed = false;
+            foreach (var candidate in mods)
+            {
+                if (removed.Contains(candidate)) continue;
+                if (!removed.Any(r => Requires(r, candidate))) continue;
+                if (mods.Any(m => !removed.Contains(m) && Requires(m, candidate))) continue;
+
+                removed.Add(candidate);
+                impact.Orphans.Add(candidate);
+                changed = true;
+            }
+        }
+
+        return impact;
+    }
+
+    private static List<string> GetRequiredDependencies(CurseForgeFile file) =>
+        file.Dependencies?
+            .Where(d => d.RelationType == 3 && d.ModId > 0)
+            .Select(d => d.ModId.ToString())
+            .Distinct()
+            .ToList() ?? new List<string>();
+
+    private static void DeleteInstanceModFile(string instancePath, InstalledMod mod)
+    {
+        if (string.IsNullOrEmpty(mod.FileName)) return;
+
+        var modFilePath = Path.Combine(instancePath, "UserData", "Mods", mod.FileName);
+        if (!File.Exists(modFilePath)) return;
+
+        try { File.Delete(modFilePath); }
+        catch (Exception ex) { Logger.Warning("ModService", $"Failed to delete mod file: {ex.Message}"); }
+    }
+
+    /// <inheritdoc/>
+    public async Task<bool> ToggleInstanceModAsync(string instancePath, string modId)
+    {
+        using var instanceLock = TryLockInstance(instancePath, "toggling a mod");
+        if (instanceLock == null) return false;
+
+        var mods = GetInstanceInstalledMods(instancePath);
+        var mod = mods.FirstOrDefault(m => m.Id == modId || m.Name == modId);
+        if (mod == null || string.IsNullOrEmpty(mod.FileName)) return false;
+
+        if (!SwitchModFile(Path.Combine(instancePath, "UserData", "Mods"), mod)) return false;
+
+        await SaveInstanceModsAsync(instancePath, mods);
+        return true;
+    }
+
+    /// <inheritdoc/>
+    public async Task<ModBulkToggleResult> SetAllInstanceModsEnabledAsync(string instancePath, bool enabled, Action<ModBulkToggleProgress>? onProgress = null)
+    {
+        var result = new ModBulkToggleResult();
+        using var instanceLock = TryLockInstance(instancePath, enabled ? "enabling mods" : "disabling mods");
+        if (instanceLock == null) return result;
+
+        var mods = GetInstanceInstalledMods(instancePath);
+        var targets = mods.Where(m => m.Enabled != enabled && !string.IsNullOrEmpty(m.FileName)).ToList();
+        var modsDir = Path.Combine(instancePath, "UserData", "Mods");
+
+        for (var i = 0; i < targets.Count; i++)
+        {
+            var mod = targets[i];
+            try
+            {
+                if (SwitchModFile(modsDir, mod)) result.Changed++;
+                else result.Failed++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("ModService", $"Failed to {(enabled ? "enable" : "disable")} {mod.Name}: {ex.Message}");
+                result.Failed++;
+            }
+
+            onProgress?.Invoke(new ModBulkToggleProgress
+            {
+                InstancePath = instancePath,
+                Enabled = enabled,
+                Done = i + 1,
+                Total = targets.Count,
+                ModName = mod.Name
+            });
+        }
+
+        // One manifest write for the whole batch
+        if (targets.Count > 0) await SaveInstanceModsAsync(instancePath, mods);
+        Logger.Info("ModService", $"{(enabled ? "Enabled" : "Disabled")} {result.Changed} mod(s) in {instancePath}" +
+            (result.Failed > 0 ? $", {result.Failed} failed" : ""));
+        return result;
+    }
+
+    /// <summary>
+    /// Renames a mod's file to or from <c>.disabled</c> and flips <see cref="InstalledMod.Enabled"/>.
+    /// The manifest is not saved.
+    /// </summary>
+    /// <returns><c>false</c> if the mod's file could not be found.</returns>
+    private static bool SwitchModFile(string modsDir, InstalledMod mod)
+    {
+        var currentPath = Path.Combine(modsDir, mod.FileName);
+        var fileName = mod.FileName;
+        var sourceExists = File.Exists(currentPath);
+
+        if (!sourceExists)
+        {
+            // Recover from stale manifest filenames by probing likely variants
+            var stem = Path.GetFileNameWithoutExtension(fileName);
+            var candidates = new[]
+            {
+                Path.Combine(modsDir, fileName),
+                Path.Combine(modsDir, $"{stem}.jar"),
+                Path.Combine(modsDir, $"{stem}.zip"),
+                Path.Combine(modsDir, $"{stem}.disabled"),
+                Path.Combine(modsDir, $"{stem}.jar.disabled"),
+                Path.Combine(modsDir, $"{stem}.zip.disabled"),
+            };
+
+            var found = candidates.FirstOrDefault(File.Exists);
+            if (!string.IsNullOrEmpty(found))
+            {
+                currentPath = found;
+                fileName = Path.GetFileName(found);
+                mod.FileName = fileName;
+                sourceExists = true;
+            }
+        }
+
+        if (!sourceExists)
+        {
+            Logger.Warning("ModService", $"Mod file for {mod.Name} not found in {modsDir}");
+            return false;
+        }
+        
+        if (mod.Enabled)
+        {
+            // Disable: rename file.jar/file.zip -> file.disabled
+            var currentFileName = Path.GetFileName(currentPath);
+            var baseName = Path.GetFileNameWithoutExtension(currentFileName);
+            var ext = Path.GetExtension(currentFileName).ToLowerInvariant();
+
+            if (currentFileName.EndsWith(".disabled", StringComparison.OrdinalIgnoreCase))
+            {
+                mod.Enabled = false;
+                mod.FileName = currentFileName;
+            }
+            else
+            {
+                if (ext is ".jar" or ".zip")
+                {
+                    mod.DisabledOriginalExtension = ext;
+                }
+
+                var disabledFileName = $"{baseName}.disabled";
+                var disabledPath = Path.Combine(modsDir, disabledFileName);
+                File.Move(currentPath, disabledPath, true);
+                mod.FileName = disabledFileName;
+                mod.Enabled = false;
+                Logger.Info("ModService", $"Disabled mod: {mod.Name}");
+            }
+        }
+        else
+        {
+            // Enable: rename *.disabled -> *.jar or *.zip (restored)
+            var currentFileName = Path.GetFileName(currentPath);
+            var stem = currentFileName.EndsWith(".disabled", StringComparison.OrdinalIgnoreCase)
+                ? currentFileName[..^".disabled".Length]
+                : Path.GetFileNameWithoutExtension(currentFileName);
+
+            string restoreExtension;
+            if (!string.IsNullOrWhiteSpace(mod.DisabledOriginalExtension))
+            {
+                restoreExtension = mod.DisabledOriginalExtension.StartsWith('.')
+                    ? mod.DisabledOriginalExtension
+                    : $".{mod.DisabledOriginalExtension}";
+            }
+            else if (stem.EndsWith(".jar", StringComparison.OrdinalIgnoreCase) || stem.EndsWith(".zip", StringComparison.OrdinalIgnoreCase))
+            {
+                restoreExtension = "";
+            }
+            else
+            {
+                restoreExtension = ".jar";
+            }
+
+            var enabledFileName = string.IsNullOrEmpty(restoreExtension)
+                ? stem
+                : $"{stem}{restoreExtension}";
+            var enabledPath = Path.Combine(modsDir, enabledFileName);
+
+            File.Move(currentPath, enabledPath, true);
+            mod.FileName = enabledFileName;
+            mod.Enabled = true;
+            mod.DisabledOriginalExtension = "";
+            Logger.Info("ModService", $"Enabled mod: {mod.Name}");
+        }
+
+        return true;
+    }
+
     /// <inheritdoc/>
     public async Task<ModFilesResult> GetModFilesAsync(string modId, int page, int pageSize)
     {
@@ -625,52 +1378,130 @@ public class ModService : IModService
     }
 
     /// <inheritdoc/>
-    public async Task<List<InstalledMod>> CheckInstanceModUpdatesAsync(string instancePath)
+    public async Task<List<CurseForgeMod>> GetModsByIdsAsync(IEnumerable<int> modIds, CancellationToken ct = default)
+    {
+        var results = new List<CurseForgeMod>();
+        foreach (var chunk in modIds.Distinct().Chunk(BulkLookupBatchSize))
+        {
+            var data = await PostBulkLookupAsync<CurseForgeSearchResponse>("/v1/mods", new { modIds = chunk }, ct);
+            if (data?.Data != null) results.AddRange(data.Data);
+        }
+        return results;
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<CurseForgeFile>> GetFilesByIdsAsync(IEnumerable<int> fileIds, CancellationToken ct = default)
+    {
+        var results = new List<CurseForgeFile>();
+        foreach (var chunk in fileIds.Distinct().Chunk(BulkLookupBatchSize))
+        {
+            var data = await PostBulkLookupAsync<CurseForgeFilesResponse>("/v1/mods/files", new { fileIds = chunk }, ct);
+            if (data?.Data != null) results.AddRange(data.Data);
+        }
+        return results;
+    }
+
+    private async Task<T?> PostBulkLookupAsync<T>(string endpoint, object body, CancellationToken ct) where T : class
+    {
+        if (!HasApiKey()) return null;
+
+        using var request = CreateCurseForgeRequest(HttpMethod.Post, endpoint);
+        request.Content = JsonContent.Create(body);
+        using var response = await _httpClient.SendAsync(request, ct);
+        
+        if (!response.IsSuccessStatusCode)
+        {
+            Logger.Warning("ModService", $"Bulk lookup {endpoint} returned {response.StatusCode}");
+            return null;
+        }
+        
+        var json = await response.Content.ReadAsStringAsync(ct);
+        return JsonSerializer.Deserialize<T>(json, _jsonOptions);
+    }
+
+    /// <inheritdoc/>
+    public async Task<List<InstalledMod>> CheckInstanceModUpdatesAsync(string instancePath, Action<ModUpdateCheckProgress>? onProgress = null, CancellationToken ct = default)
     {
         if (!HasApiKey())
             return new List<InstalledMod>();
             
-        var installedMods = GetInstanceInstalledMods(instancePath);
-        var modsWithUpdates = new List<InstalledMod>();
+        var tracked = GetInstanceInstalledMods(instancePath)
+            .Where(m => !string.IsNullOrEmpty(m.CurseForgeId))
+            .ToList();
+        var updates = new InstalledMod?[tracked.Count];
+        int checkedCount = 0;
         
-        foreach (var mod in installedMods)
+        // One bulk lookup covers most mods; the newest of each project's latest files is the candidate
+        var latestByMod = new Dictionary<int, CurseForgeFile>();
+        try
         {
-            if (string.IsNullOrEmpty(mod.CurseForgeId)) continue;
-            
+            var ids = tracked.Select(m => int.TryParse(m.CurseForgeId, out var id) ? id : 0).Where(id => id > 0);
+            foreach (var project in await GetModsByIdsAsync(ids, ct))
+            {
+                var newest = project.LatestFiles?.MaxBy(f => f.Id);
+                if (newest != null) latestByMod[project.Id] = newest;
+            }
+        }
+        catch (Exception ex) when (!ct.IsCancellationRequested)
+        {
+            Logger.Warning("ModService", $"Bulk update lookup failed, checking mods one by one: {ex.Message}");
+        }
+        
+        // Mods the bulk lookup missed (slug IDs, failures) fall back to a bounded pool of per-mod requests
+        var options = new ParallelOptions { MaxDegreeOfParallelism = UpdateCheckConcurrency, CancellationToken = ct };
+        await Parallel.ForEachAsync(Enumerable.Range(0, tracked.Count), options, async (index, token) =>
+        {
+            var mod = tracked[index];
             try
             {
-                var endpoint = $"/v1/mods/{mod.CurseForgeId}/files?pageSize=1";
-                using var request = CreateCurseForgeRequest(HttpMethod.Get, endpoint);
-                using var response = await _httpClient.SendAsync(request);
-                
-                if (!response.IsSuccessStatusCode) continue;
-                
-                var json = await response.Content.ReadAsStringAsync();
-                var cfResponse = JsonSerializer.Deserialize<CurseForgeFilesResponse>(json, _jsonOptions);
-                
-                var latestFile = cfResponse?.Data?.FirstOrDefault();
-                if (latestFile == null) continue;
+                var latestFile = int.TryParse(mod.CurseForgeId, out var numericId) && latestByMod.TryGetValue(numericId, out var known)
+                    ? known
+                    : await GetLatestFileAsync(mod.CurseForgeId, token);
                 
                 // If we have a newer file than what's installed
-                if (!string.IsNullOrEmpty(mod.FileId) && latestFile.Id.ToString() != mod.FileId)
+                if (latestFile != null && !string.IsNullOrEmpty(mod.FileId) && latestFile.Id.ToString() != mod.FileId)
                 {
                     mod.LatestFileId = latestFile.Id.ToString();
                     mod.LatestVersion = latestFile.DisplayName ?? "";
-                    modsWithUpdates.Add(mod);
+                    updates[index] = mod;
                 }
             }
-            catch (Exception ex)
+            catch (Exception ex) when (!token.IsCancellationRequested)
             {
                 Logger.Warning("ModService", $"Update check failed for {mod.Name}: {ex.Message}");
             }
-        }
+            
+            onProgress?.Invoke(new ModUpdateCheckProgress
+            {
+                InstancePath = instancePath,
+                Checked = Interlocked.Increment(ref checkedCount),
+                Total = tracked.Count,
+                Update = updates[index]
+            });
+        });
+        
+        return updates.OfType<InstalledMod>().ToList();
+    }
+
+    private async Task<CurseForgeFile?> GetLatestFileAsync(string curseForgeId, CancellationToken ct)
+    {
+        var endpoint = $"/v1/mods/{curseForgeId}/files?pageSize=1";
+        using var request = CreateCurseForgeRequest(HttpMethod.Get, endpoint);
+        using var response = await _httpClient.SendAsync(request, ct);
         
-        return modsWithUpdates;
+        if (!response.IsSuccessStatusCode) return null;
+        
+        var json = await response.Content.ReadAsStringAsync(ct);
+        var cfResponse = JsonSerializer.Deserialize<CurseForgeFilesResponse>(json, _jsonOptions);
+        return cfResponse?.Data?.FirstOrDefault();
     }
 
     /// <inheritdoc/>
     public async Task<bool> InstallLocalModFile(string sourcePath, string instancePath)
     {
+        using var instanceLock = TryLockInstance(instancePath, "installing a mod");
+        if (instanceLock == null) return false;
+
         try
         {
             if (!File.Exists(sourcePath))
@@ -686,6 +1517,7 @@ public class ModService : IModService
             var destPath = Path.Combine(modsPath, fileName);
             
             File.Copy(sourcePath, destPath, true);
+            LongPaths.WarnIfTooLong(destPath, "Mod install");
             
             // Add to manifest
             var mods = GetInstanceInstalledMods(instancePath);
@@ -717,6 +1549,9 @@ public class ModService : IModService
     /// <inheritdoc/>
     public async Task<bool> InstallModFromBase64(string fileName, string base64Content, string instancePath)
     {
+        using var instanceLock = TryLockInstance(instancePath, "installing a mod");
+        if (instanceLock == null) return false;
+
         try
         {
             var modsPath = Path.Combine(instancePath, "UserData", "Mods");
@@ -796,6 +1631,7 @@ public class ModService : IModService
             Slug = cfMod.Slug ?? "",
             Summary = cfMod.Summary ?? "",
             Author = cfMod.Authors?.FirstOrDefault()?.Name ?? "",
+            AuthorId = cfMod.Authors?.FirstOrDefault()?.Id,
             DownloadCount = cfMod.DownloadCount,
             IconUrl = cfMod.Logo?.ThumbnailUrl ?? "",
             ThumbnailUrl = cfMod.Logo?.Url ?? "",
@@ -0,0 +1,262 @@
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+using HyPrism.Services.Game.Launch;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Disables mods (renaming them to <c>.disabled</c> through <see cref="IModService"/>) for one game session
+/// and enables them again when the game stops.
+/// </summary>
+/// <remarks>
+/// The disabled mods are written to <c>safe-mode.json</c> before the launch and the file is removed once
+/// they are restored. If the launcher exits first, <see cref="RestorePendingAsync"/> picks the session up
+/// on the next start. Only one session can be active at a time.
+/// </remarks>
+public class SafeModeService : ISafeModeService
+{
+    private readonly string _statePath;
+    private readonly IInstanceService _instanceService;
+    private readonly IModService _modService;
+    private readonly IGameProcessService _gameProcessService;
+    private readonly IGameSessionService _gameSessionService;
+    private readonly SemaphoreSlim _gate = new(1, 1);
+    private readonly object _lock = new();
+    private SafeModeSession? _session;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="SafeModeService"/> class.
+    /// </summary>
+    /// <param name="appDir">The launcher data directory, where the active session is stored.</param>
+    /// <param name="instanceService">Resolves and selects the instance.</param>
+    /// <param name="modService">Lists and toggles the instance's mods.</param>
+    /// <param name="gameProcessService">Tells whether the game is already running.</param>
+    /// <param name="gameSessionService">Launches the game.</param>
+    /// <param name="progressService">Raises the game state changes that end a session.</param>
+    public SafeModeService(string appDir, IInstanceService instanceService,
+        IModService modService, IGameProcessService gameProcessService, IGameSessionService gameSessionService,
+        IProgressNotificationService progressService)
+    {
+        _statePath = Path.Combine(appDir, "safe-mode.json");
+        _instanceService = instanceService;
+        _modService = modService;
+        _gameProcessService = gameProcessService;
+        _gameSessionService = gameSessionService;
+
+        progressService.GameStateChanged += (state, exitCode) =>
+        {
+            if (state == "started") MarkLaunched();
+            else if (state == "stopped") _ = RestoreLaunchedAsync();
+        };
+    }
+
+    /// <inheritdoc/>
+    public Task<DownloadProgress> LaunchSafeModeAsync(string instanceId) =>
+        LaunchAsync(instanceId, Array.Empty<string>(), "safe-mode");
+
+    /// <inheritdoc/>
+    public Task<DownloadProgress> LaunchWithModsAsync(string instanceId, IReadOnlyCollection<string> enabledModIds) =>
+        LaunchAsync(instanceId, enabledModIds, "subset");
+
+    /// <inheritdoc/>
+    public SafeModeSession? GetActiveSession()
+    {
+        lock (_lock) return _session;
+    }
+
+    /// <inheritdoc/>
+    public async Task RestorePendingAsync()
+    {
+        var pending = Load();
+        if (pending == null) return;
+
+        if (_gameProcessService.CheckForRunningGame())
+        {
+            Logger.Info("SafeMode", $"Game still running; mods of {pending.InstanceId} are restored when it exits");
+            pending.Launched = true;
+            lock (_lock) _session = pending;
+            return;
+        }
+
+        await _gate.WaitAsync();
+        try
+        {
+            await RestoreAsync(pending);
+        }
+        finally
+        {
+            _gate.Release();
+        }
+    }
+
+    private async Task<DownloadProgress> LaunchAsync(string instanceId, IReadOnlyCollection<string> keepEnabled, string kind)
+    {
+        if (!await _gate.WaitAsync(0))
+            return new DownloadProgress { Error = "Another safe mode launch is in progress" };
+
+        try
+        {
+            if (_session != null)
+                return new DownloadProgress { Error = "Mods are still disabled from a previous safe mode launch" };
+            if (_gameProcessService.IsGameRunning())
+                return new DownloadProgress { Error = "The game is already running" };
+
+            var info = _instanceService.FindInstanceById(instanceId);
+            var instancePath = _instanceService.GetInstancePathById(instanceId);
+            if (info == null || string.IsNullOrEmpty(instancePath) || !Directory.Exists(instancePath))
+                return new DownloadProgress { Error = "Instance not found" };
+
+            var session = new SafeModeSession { InstanceId = instanceId, InstancePath = instancePath, Kind = kind };
+            var toDisable = _modService.GetInstanceInstalledMods(instancePath)
+                .Where(m => m.Enabled && !keepEnabled.Contains(m.Id))
+                .ToList();
+
+            // Saved after each rename so a crash half way still knows what to enable again
+            foreach (var mod in toDisable)
+            {
+                if (!await _modService.ToggleInstanceModAsync(instancePath, mod.Id)) continue;
+                session.DisabledMods.Add(new SafeModeDisabledMod
+                {
+                    Id = mod.Id,
+                    Name = mod.Name,
+                    FileName = _modService.GetInstanceInstalledMods(instancePath)
+                        .FirstOrDefault(m => m.Id == mod.Id)?.FileName ?? ""
+                });
+                Save(session);
+            }
+            Save(session);
+            lock (_lock) _session = session;
+
+            Logger.Info("SafeMode", $"Launching {instanceId} with {session.DisabledMods.Count} mod(s) disabled " +
+                $"({keepEnabled.Count} kept enabled)");
+        }
+        finally
+        {
+            _gate.Release();
+        }
+
+        DownloadProgress result;
+        try
+        {
+            result = await _gameSessionService.LaunchInstanceAsync(instanceId);
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("SafeMode", $"Safe mode launch failed: {ex.Message}");
+            result = new DownloadProgress { Error = ex.Message };
+        }
+
+        // Failed, cancelled, or only installed behind another queued session: nothing to wait for
+        await _gate.WaitAsync();
+        try
+        {
+            if (_session != null && !_session.Launched) await RestoreAsync(_session);
+        }
+        finally
+        {
+            _gate.Release();
+        }
+        return result;
+    }
+
+    private void MarkLaunched()
+    {
+        lock (_lock)
+        {
+            if (_session == null || _session.Launched) return;
+            _session.Launched = true;
+            Save(_session);
+        }
+    }
+
+    private async Task RestoreLaunchedAsync()
+    {
+        await _gate.WaitAsync();
+        try
+        {
+            if (_session != null && _session.Launched) await RestoreAsync(_session);
+        }
+        finally
+        {
+            _gate.Release();
+        }
+    }
+
+    /// <summary>
+    /// Enables the session's mods again. Callers hold <see cref="_gate"/>.
+    /// </summary>
+    private async Task RestoreAsync(SafeModeSession session)
+    {
+        var failed = 0;
+        if (Directory.Exists(session.InstancePath))
+        {
+            var mods = _modService.GetInstanceInstalledMods(session.InstancePath);
+            foreach (var disabled in session.DisabledMods)
+            {
+                var mod = mods.FirstOrDefault(m => m.Id == disabled.Id)
+                    ?? mods.FirstOrDefault(m => !string.IsNullOrEmpty(disabled.FileName)
+                        && string.Equals(m.FileName, disabled.FileName, StringComparison.OrdinalIgnoreCase));
+
+                // Already enabled by the user, or removed meanwhile
+                if (mod == null || mod.Enabled) continue;
+
+                if (!await _modService.ToggleInstanceModAsync(session.InstancePath, mod.Id))
+                {
+                    failed++;
+                    Logger.Warning("SafeMode", $"Could not enable {disabled.Name} again");
+                }
+            }
+        }
+        else
+        {
+            Logger.Warning("SafeMode", $"Instance folder {session.InstancePath} is gone; nothing to restore");
+        }
+
+        Logger.Info("SafeMode", $"Restored {session.DisabledMods.Count - failed} mod(s) of {session.InstanceId}");
+        lock (_lock) _session = null;
+        Delete();
+    }
+
+    private SafeModeSession? Load()
+    {
+        try
+        {
+            return File.Exists(_statePath)
+                ? JsonSerializer.Deserialize<SafeModeSession>(File.ReadAllText(_statePath))
+                : null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("SafeMode", $"Unreadable safe mode state: {ex.Message}");
+            return null;
+        }
+    }
+
+    private void Save(SafeModeSession session)
+    {
+        try
+        {
+            File.WriteAllText(_statePath + ".tmp", JsonSerializer.Serialize(session));
+            File.Move(_statePath + ".tmp", _statePath, true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("SafeMode", $"Failed to save safe mode state: {ex.Message}");
+        }
+    }
+
+    private void Delete()
+    {
+        try
+        {
+            if (File.Exists(_statePath)) File.Delete(_statePath);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("SafeMode", $"Failed to remove safe mode state: {ex.Message}");
+        }
+    }
+}
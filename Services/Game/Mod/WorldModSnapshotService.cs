@@ -0,0 +1,248 @@
+using System.Text.Json;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Mod;
+
+/// <summary>
+/// Records the enabled mods of an instance while the game runs and writes them into every world that
+/// was saved during the session.
+/// </summary>
+/// <remarks>
+/// The mod list is taken when the game starts and written as <c>hyprism-mods.json</c> when it stops,
+/// into each world folder with a file changed since the start. Safe mode and bisect launches are not
+/// recorded, since their mod set is temporary. Like <see cref="GameStatsService"/>, the session belongs
+/// to the selected instance.
+/// </remarks>
+public class WorldModSnapshotService : IWorldModSnapshotService
+{
+    private readonly IConfigService _configService;
+    private readonly IInstanceService _instanceService;
+    private readonly IWorldService _worldService;
+    private readonly IModService _modService;
+    private readonly ISafeModeService _safeModeService;
+    private readonly object _lock = new();
+    private PendingSession? _session;
+
+    private sealed record PendingSession(string InstanceId, DateTime StartedAt, List<WorldModEntry> Mods);
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="WorldModSnapshotService"/> class.
+    /// </summary>
+    /// <param name="configService">Provides the selected instance.</param>
+    /// <param name="instanceService">Resolves the instance folder.</param>
+    /// <param name="worldService">Resolves the saves and world folders.</param>
+    /// <param name="modService">Lists, installs and toggles the instance's mods.</param>
+    /// <param name="safeModeService">Tells whether the launch had mods temporarily disabled.</param>
+    /// <param name="progressService">Raises the game state changes that start and end a session.</param>
+    public WorldModSnapshotService(IConfigService configService, IInstanceService instanceService, IWorldService worldService,
+        IModService modService, ISafeModeService safeModeService, IProgressNotificationService progressService)
+    {
+        _configService = configService;
+        _instanceService = instanceService;
+        _worldService = worldService;
+        _modService = modService;
+        _safeModeService = safeModeService;
+
+        progressService.GameStateChanged += (state, exitCode) =>
+        {
+            if (state == "started") Start();
+            else if (state == "stopped") _ = Task.Run(Stop);
+        };
+    }
+
+    /// <inheritdoc/>
+    public WorldModSnapshotStatus? GetStatus(string instanceId, string worldName)
+    {
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var snapshot = Load(instanceId, worldName);
+        if (string.IsNullOrEmpty(instancePath) || snapshot == null) return null;
+
+        var installed = _modService.GetInstanceInstalledMods(instancePath);
+        var status = new WorldModSnapshotStatus { Snapshot = snapshot };
+
+        foreach (var entry in snapshot.Mods)
+        {
+            var mod = FindInstalled(installed, entry);
+            if (mod == null || !mod.Enabled) status.Missing.Add(entry);
+            else if (!string.IsNullOrEmpty(entry.FileId) && mod.FileId != entry.FileId) status.Changed.Add(entry);
+        }
+
+        status.Extra = installed
+            .Where(m => m.Enabled && !snapshot.Mods.Any(e => Matches(m, e)))
+            .Select(ToEntry)
+            .ToList();
+        return status;
+    }
+
+    /// <inheritdoc/>
+    public async Task<WorldModRestoreResult> RestoreAsync(string instanceId, string worldName)
+    {
+        var result = new WorldModRestoreResult();
+        var instancePath = _instanceService.GetInstancePathById(instanceId);
+        var snapshot = Load(instanceId, worldName);
+        if (string.IsNullOrEmpty(instancePath) || snapshot == null)
+        {
+            result.MessageKey = "instances.modSnapshot.notFound";
+            return result;
+        }
+        if (InstanceLock.GetHolder(instancePath) != null)
+        {
+            result.MessageKey = "instances.modSnapshot.busy";
+            return result;
+        }
+
+        var modsDir = Path.Combine(instancePath, "UserData", "Mods");
+        foreach (var entry in snapshot.Mods)
+        {
+            var mod = FindInstalled(_modService.GetInstanceInstalledMods(instancePath), entry);
+            var sameFile = mod != null && (string.IsNullOrEmpty(entry.FileId) || mod.FileId == entry.FileId);
+
+            try
+            {
+                if (sameFile)
+                {
+                    if (mod!.Enabled) continue;
+                    if (await _modService.ToggleInstanceModAsync(instancePath, mod.Id)) result.Enabled++;
+                    else result.Failed.Add(entry.Name);
+                    continue;
+                }
+
+                if (string.IsNullOrEmpty(entry.CurseForgeId) || string.IsNullOrEmpty(entry.FileId))
+                {
+                    Logger.Warning("WorldMods", $"{entry.Name} is a local mod that is no longer installed; skipping");
+                    result.Failed.Add(entry.Name);
+                    continue;
+                }
+
+                if (!await _modService.InstallModFileToInstanceAsync(entry.CurseForgeId, entry.FileId, instancePath))
+                {
+                    result.Failed.Add(entry.Name);
+                    continue;
+                }
+                result.Installed++;
+
+                // Installing another file of a mod replaces its manifest entry but leaves the old file behind
+                var installed = FindInstalled(_modService.GetInstanceInstalledMods(instancePath), entry);
+                if (mod != null && !string.IsNullOrEmpty(mod.FileName) &&
+                    !string.Equals(mod.FileName, installed?.FileName, StringComparison.OrdinalIgnoreCase))
+                {
+                    var oldPath = Path.Combine(modsDir, mod.FileName);
+                    if (File.Exists(oldPath)) File.Delete(oldPath);
+                }
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("WorldMods", $"Failed to restore {entry.Name}: {ex.Message}");
+                result.Failed.Add(entry.Name);
+            }
+        }
+
+        var extra = _modService.GetInstanceInstalledMods(instancePath)
+            .Where(m => m.Enabled && !snapshot.Mods.Any(e => Matches(m, e)))
+            .ToList();
+        foreach (var mod in extra)
+        {
+            if (await _modService.ToggleInstanceModAsync(instancePath, mod.Id)) result.Disabled++;
+            else result.Failed.Add(mod.Name);
+        }
+
+        result.Success = result.Failed.Count == 0;
+        Logger.Info("WorldMods", $"Restored mods of world {worldName}: {result.Installed} installed, {result.Enabled} enabled, " +
+            $"{result.Disabled} disabled, {result.Failed.Count} failed");
+        return result;
+    }
+
+    private void Start()
+    {
+        lock (_lock) _session = null;
+        var instanceId = _configService.Configuration.SelectedInstanceId;
+        if (string.IsNullOrEmpty(instanceId) || _safeModeService.GetActiveSession() != null) return;
+
+        try
+        {
+            var instancePath = _instanceService.GetInstancePathById(instanceId);
+            if (string.IsNullOrEmpty(instancePath)) return;
+
+            var mods = _modService.GetInstanceInstalledMods(instancePath)
+                .Where(m => m.Enabled)
+                .Select(ToEntry)
+                .ToList();
+            lock (_lock) _session = new PendingSession(instanceId, DateTime.UtcNow, mods);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("WorldMods", $"Could not read the mods of {instanceId}: {ex.Message}");
+        }
+    }
+
+    private void Stop()
+    {
+        PendingSession? session;
+        lock (_lock)
+        {
+            session = _session;
+            _session = null;
+        }
+        if (session == null) return;
+
+        var savesDir = _worldService.GetSavesDir(session.InstanceId);
+        if (savesDir == null || !Directory.Exists(savesDir)) return;
+
+        var snapshot = new WorldModSnapshot { PlayedAt = DateTime.UtcNow, Mods = session.Mods };
+        foreach (var worldDir in Directory.GetDirectories(savesDir))
+        {
+            try
+            {
+                if (!WasPlayedSince(worldDir, session.StartedAt)) continue;
+
+                var path = Path.Combine(worldDir, WorldModSnapshot.FileName);
+                File.WriteAllText(path + ".tmp", JsonSerializer.Serialize(snapshot));
+                File.Move(path + ".tmp", path, true);
+                Logger.Info("WorldMods", $"Recorded {snapshot.Mods.Count} mod(s) for world {Path.GetFileName(worldDir)}");
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("WorldMods", $"Could not record the mods of {Path.GetFileName(worldDir)}: {ex.Message}");
+            }
+        }
+    }
+
+    private WorldModSnapshot? Load(string instanceId, string worldName)
+    {
+        var worldPath = _worldService.GetWorldPath(instanceId, worldName);
+        if (worldPath == null) return null;
+
+        var path = Path.Combine(worldPath, WorldModSnapshot.FileName);
+        try
+        {
+            return File.Exists(path) ? JsonSerializer.Deserialize<WorldModSnapshot>(File.ReadAllText(path)) : null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("WorldMods", $"Could not read {path}: {ex.Message}");
+            return null;
+        }
+    }
+
+    private static bool WasPlayedSince(string worldDir, DateTime startedAt) =>
+        new DirectoryInfo(worldDir).EnumerateFiles("*", SearchOption.AllDirectories)
+            .Any(f => f.Name != WorldModSnapshot.FileName && f.LastWriteTimeUtc >= startedAt);
+
+    private static InstalledMod? FindInstalled(List<InstalledMod> installed, WorldModEntry entry) =>
+        installed.FirstOrDefault(m => Matches(m, entry));
+
+    private static bool Matches(InstalledMod mod, WorldModEntry entry) =>
+        !string.IsNullOrEmpty(entry.CurseForgeId) ? mod.CurseForgeId == entry.CurseForgeId : mod.Id == entry.Id;
+
+    private static WorldModEntry ToEntry(InstalledMod mod) => new()
+    {
+        Id = mod.Id,
+        Name = mod.Name,
+        CurseForgeId = mod.CurseForgeId,
+        FileId = mod.FileId,
+        FileName = mod.FileName
+    };
+}
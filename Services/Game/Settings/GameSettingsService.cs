@@ -0,0 +1,376 @@
+using System.IO.Enumeration;
+using System.Text.Json;
+using System.Text.Json.Nodes;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Game.Instance;
+
+namespace HyPrism.Services.Game.Settings;
+
+/// <summary>
+/// Edits the JSON settings files the game client keeps at the top of <c>UserData</c>.
+/// </summary>
+/// <remarks>
+/// The client owns these files and their layout changes between builds, so each common option is
+/// looked up under a few known paths (case-insensitive) and unknown keys are always written back
+/// unchanged. Options the client has never written go to <see cref="PrimaryFile"/> under the first path.
+/// </remarks>
+public class GameSettingsService : IGameSettingsService
+{
+    /// <summary>
+    /// The client's main settings file.
+    /// </summary>
+    public const string PrimaryFile = "Settings.json";
+
+    private static readonly JsonSerializerOptions WriteOptions = new() { WriteIndented = true };
+
+    private static readonly string[] RenderDistancePaths = ["RenderingSettings.ViewDistance", "Graphics.ViewDistance", "ViewDistance", "RenderDistance"];
+    private static readonly string[] FieldOfViewPaths = ["RenderingSettings.FieldOfView", "Graphics.FieldOfView", "FieldOfView", "Fov"];
+    private static readonly string[] FullscreenPaths = ["RenderingSettings.Fullscreen", "Graphics.Fullscreen", "Fullscreen"];
+    private static readonly string[] VSyncPaths = ["RenderingSettings.VSync", "Graphics.VSync", "VSync"];
+    private static readonly string[] MasterVolumePaths = ["AudioSettings.MasterVolume", "Audio.MasterVolume", "MasterVolume"];
+    private static readonly string[] MusicVolumePaths = ["AudioSettings.MusicVolume", "Audio.MusicVolume", "MusicVolume"];
+    private static readonly string[] SfxVolumePaths = ["AudioSettings.SoundEffectsVolume", "AudioSettings.SfxVolume", "Audio.SfxVolume", "SfxVolume"];
+    private static readonly string[] KeybindPaths = ["InputBindings", "Controls.Bindings", "Keybinds", "KeyBindings"];
+
+    private readonly IInstanceService _instanceService;
+    private readonly IConfigService _configService;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="GameSettingsService"/> class.
+    /// </summary>
+    /// <param name="instanceService">Resolves instance UserData folders.</param>
+    /// <param name="configService">Config holding the settings sync rules.</param>
+    public GameSettingsService(IInstanceService instanceService, IConfigService configService)
+    {
+        _instanceService = instanceService;
+        _configService = configService;
+    }
+
+    /// <inheritdoc/>
+    public List<string> GetSettingsFiles(string instancePath)
+    {
+        var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+        if (!Directory.Exists(userData)) return new List<string>();
+
+        // Primary file first so option lookups prefer it
+        return Directory.EnumerateFiles(userData, "*.json", SearchOption.TopDirectoryOnly)
+            .Select(Path.GetFileName)
+            .OfType<string>()
+            .OrderBy(name => string.Equals(name, PrimaryFile, StringComparison.OrdinalIgnoreCase) ? 0 : 1)
+            .ThenBy(name => name, StringComparer.OrdinalIgnoreCase)
+            .ToList();
+    }
+
+    /// <inheritdoc/>
+    public GameSettings GetSettings(string instancePath)
+    {
+        var files = LoadFiles(instancePath);
+        var settings = new GameSettings { Files = files.Select(f => f.Name).ToList() };
+
+        settings.RenderDistance = ReadNumber(files, RenderDistancePaths) is { } rd ? (int)Math.Round(rd) : null;
+        settings.FieldOfView = ReadNumber(files, FieldOfViewPaths) is { } fov ? (int)Math.Round(fov) : null;
+        settings.Fullscreen = ReadBool(files, FullscreenPaths);
+        settings.VSync = ReadBool(files, VSyncPaths);
+        settings.MasterVolume = ReadNumber(files, MasterVolumePaths);
+        settings.MusicVolume = ReadNumber(files, MusicVolumePaths);
+        settings.SfxVolume = ReadNumber(files, SfxVolumePaths);
+
+        if (Find(files, KeybindPaths) is { Node: JsonObject bindings })
+        {
+            foreach (var (action, key) in bindings)
+            {
+                if (key == null) continue;
+                settings.Keybinds[action] = key is JsonValue v && v.TryGetValue<string>(out var s) ? s : key.ToJsonString();
+            }
+        }
+
+        return settings;
+    }
+
+    /// <inheritdoc/>
+    public bool UpdateSettings(string instancePath, GameSettingsUpdate update)
+    {
+        try
+        {
+            var files = LoadFiles(instancePath);
+            var changed = new HashSet<SettingsFile>();
+
+            void Set(string[] paths, JsonNode? value)
+            {
+                var file = Write(files, paths, value, instancePath);
+                changed.Add(file);
+            }
+
+            if (update.RenderDistance is { } rd) Set(RenderDistancePaths, JsonValue.Create(rd));
+            if (update.FieldOfView is { } fov) Set(FieldOfViewPaths, JsonValue.Create(fov));
+            if (update.Fullscreen is { } fs) Set(FullscreenPaths, JsonValue.Create(fs));
+            if (update.VSync is { } vs) Set(VSyncPaths, JsonValue.Create(vs));
+            if (update.MasterVolume is { } mv) Set(MasterVolumePaths, JsonValue.Create(mv));
+            if (update.MusicVolume is { } mu) Set(MusicVolumePaths, JsonValue.Create(mu));
+            if (update.SfxVolume is { } sfx) Set(SfxVolumePaths, JsonValue.Create(sfx));
+
+            if (update.Keybinds is { Count: > 0 })
+            {
+                var found = Find(files, KeybindPaths);
+                var file = found?.File ?? GetOrCreatePrimary(files, instancePath);
+                var bindings = found?.Node as JsonObject;
+                if (bindings == null)
+                {
+                    bindings = new JsonObject();
+                    SetPath(file.Root, found?.Path ?? KeybindPaths[0], bindings);
+                }
+                foreach (var (action, key) in update.Keybinds)
+                {
+                    bindings[action] = key;
+                }
+                changed.Add(file);
+            }
+
+            foreach (var file in changed)
+            {
+                Save(file);
+            }
+
+            Logger.Info("GameSettings", $"Updated {changed.Count} settings file(s) in {instancePath}");
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("GameSettings", $"Failed to update game settings: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <inheritdoc/>
+    public JsonNode? GetValue(string instancePath, string file, string path)
+    {
+        var settingsFile = LoadFile(ResolveFilePath(instancePath, file));
+        return settingsFile == null ? null : GetPath(settingsFile.Root, path)?.DeepClone();
+    }
+
+    /// <inheritdoc/>
+    public bool SetValue(string instancePath, string file, string path, JsonNode? value)
+    {
+        try
+        {
+            var fullPath = ResolveFilePath(instancePath, file);
+            var settingsFile = LoadFile(fullPath) ?? new SettingsFile(Path.GetFileName(fullPath), fullPath, new JsonObject());
+            SetPath(settingsFile.Root, path, value);
+            Save(settingsFile);
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("GameSettings", $"Failed to set {file}:{path}: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <inheritdoc/>
+    public int CopySettings(string sourceInstancePath, string targetInstancePath, IEnumerable<string>? files = null)
+    {
+        var names = files?.ToList() ?? GetSettingsFiles(sourceInstancePath);
+        int copied = 0;
+
+        foreach (var name in names)
+        {
+            try
+            {
+                var source = ResolveFilePath(sourceInstancePath, name);
+                if (!File.Exists(source)) continue;
+
+                var target = ResolveFilePath(targetInstancePath, name);
+                Directory.CreateDirectory(Path.GetDirectoryName(target)!);
+                File.Copy(source, target, true);
+                copied++;
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("GameSettings", $"Failed to copy {name}: {ex.Message}");
+            }
+        }
+
+        Logger.Info("GameSettings", $"Copied {copied} settings file(s) from {sourceInstancePath} to {targetInstancePath}");
+        return copied;
+    }
+
+    /// <inheritdoc/>
+    public SettingsSyncConfig GetSyncConfig() =>
+        _configService.Configuration.SettingsSync ?? new SettingsSyncConfig();
+
+    /// <inheritdoc/>
+    public void SetSyncConfig(SettingsSyncConfig config)
+    {
+        config.Include = config.Include.Select(p => p.Trim()).Where(p => p.Length > 0).Distinct().ToList();
+        config.Exclude = config.Exclude.Select(p => p.Trim()).Where(p => p.Length > 0).Distinct().ToList();
+        _configService.Configuration.SettingsSync = config;
+        _configService.SaveConfig();
+    }
+
+    /// <inheritdoc/>
+    public int ApplySettingsSync(string targetInstanceId)
+    {
+        var sync = GetSyncConfig();
+        if (!sync.Enabled) return 0;
+
+        var sourceId = string.IsNullOrEmpty(sync.SourceInstanceId)
+            ? _instanceService.GetSelectedInstance()?.Id
+            : sync.SourceInstanceId;
+        if (string.IsNullOrEmpty(sourceId) || sourceId == targetInstanceId) return 0;
+
+        var sourcePath = _instanceService.GetInstancePathById(sourceId);
+        var targetPath = _instanceService.GetInstancePathById(targetInstanceId);
+        if (sourcePath == null || targetPath == null)
+        {
+            Logger.Warning("GameSettings", $"Settings sync skipped: instance {(sourcePath == null ? sourceId : targetInstanceId)} not found");
+            return 0;
+        }
+
+        // Never overwrite what an existing instance already has
+        if (GetSettingsFiles(targetPath).Count > 0) return 0;
+
+        var files = GetSettingsFiles(sourcePath)
+            .Where(name => MatchesAny(name, sync.Include) && !MatchesAny(name, sync.Exclude))
+            .ToList();
+        return files.Count == 0 ? 0 : CopySettings(sourcePath, targetPath, files);
+    }
+
+    private static bool MatchesAny(string name, IEnumerable<string> patterns) =>
+        patterns.Any(p => FileSystemName.MatchesSimpleExpression(p, name, ignoreCase: true));
+
+    private sealed record SettingsFile(string Name, string FullPath, JsonObject Root);
+
+    private sealed record Match(SettingsFile File, string Path, JsonNode Node);
+
+    private List<SettingsFile> LoadFiles(string instancePath)
+    {
+        var userData = _instanceService.GetInstanceUserDataPath(instancePath);
+        return GetSettingsFiles(instancePath)
+            .Select(name => LoadFile(Path.Combine(userData, name)))
+            .OfType<SettingsFile>()
+            .ToList();
+    }
+
+    private static SettingsFile? LoadFile(string fullPath)
+    {
+        if (!File.Exists(fullPath)) return null;
+
+        try
+        {
+            var root = JsonNode.Parse(File.ReadAllText(fullPath), documentOptions: new JsonDocumentOptions
+            {
+                AllowTrailingCommas = true,
+                CommentHandling = JsonCommentHandling.Skip
+            });
+            // Top-level arrays or values aren't settings we understand
+            return root is JsonObject obj ? new SettingsFile(Path.GetFileName(fullPath), fullPath, obj) : null;
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("GameSettings", $"Skipping unreadable settings file {fullPath}: {ex.Message}");
+            return null;
+        }
+    }
+
+    private static void Save(SettingsFile file)
+    {
+        var tmp = file.FullPath + ".tmp";
+        File.WriteAllText(tmp, file.Root.ToJsonString(WriteOptions));
+        File.Move(tmp, file.FullPath, true);
+    }
+
+    /// <summary>
+    /// Maps a file name from the caller to a path inside the instance's UserData folder.
+    /// </summary>
+    private string ResolveFilePath(string instancePath, string file)
+    {
+        var userData = Path.GetFullPath(_instanceService.GetInstanceUserDataPath(instancePath));
+        var fullPath = Path.GetFullPath(Path.Combine(userData, file));
+        if (!fullPath.StartsWith(userData + Path.DirectorySeparatorChar, StringComparison.OrdinalIgnoreCase))
+            throw new ArgumentException($"Settings file must be inside UserData: {file}", nameof(file));
+        return fullPath;
+    }
+
+    private SettingsFile GetOrCreatePrimary(List<SettingsFile> files, string instancePath)
+    {
+        var primary = files.FirstOrDefault(f => string.Equals(f.Name, PrimaryFile, StringComparison.OrdinalIgnoreCase));
+        if (primary != null) return primary;
+
+        var fullPath = ResolveFilePath(instancePath, PrimaryFile);
+        Directory.CreateDirectory(Path.GetDirectoryName(fullPath)!);
+        primary = new SettingsFile(PrimaryFile, fullPath, new JsonObject());
+        files.Insert(0, primary);
+        return primary;
+    }
+
+    private SettingsFile Write(List<SettingsFile> files, string[] paths, JsonNode? value, string instancePath)
+    {
+        var found = Find(files, paths);
+        var file = found?.File ?? GetOrCreatePrimary(files, instancePath);
+        SetPath(file.Root, found?.Path ?? paths[0], value);
+        return file;
+    }
+
+    private static Match? Find(List<SettingsFile> files, string[] paths)
+    {
+        foreach (var file in files)
+        {
+            foreach (var path in paths)
+            {
+                if (GetPath(file.Root, path) is { } node)
+                    return new Match(file, path, node);
+            }
+        }
+        return null;
+    }
+
+    private static double? ReadNumber(List<SettingsFile> files, string[] paths) =>
+        Find(files, paths)?.Node is JsonValue v && v.TryGetValue<double>(out var d) ? d : null;
+
+    private static bool? ReadBool(List<SettingsFile> files, string[] paths) =>
+        Find(files, paths)?.Node is JsonValue v && v.TryGetValue<bool>(out var b) ? b : null;
+
+    private static JsonNode? GetPath(JsonObject root, string path)
+    {
+        JsonNode? current = root;
+        foreach (var segment in path.Split('.'))
+        {
+            if (current is not JsonObject obj) return null;
+            current = GetProperty(obj, segment)?.Value;
+        }
+        return current;
+    }
+
+    private static void SetPath(JsonObject root, string path, JsonNode? value)
+    {
+        var segments = path.Split('.');
+        var current = root;
+        for (int i = 0; i < segments.Length - 1; i++)
+        {
+            var existing = GetProperty(current, segments[i]);
+            if (existing?.Value is JsonObject child)
+            {
+                current = child;
+                continue;
+            }
+            child = new JsonObject();
+            current[existing?.Key ?? segments[i]] = child;
+            current = child;
+        }
+
+        // Keep the client's spelling of the key
+        var last = GetProperty(current, segments[^1]);
+        current[last?.Key ?? segments[^1]] = value;
+    }
+
+    private static KeyValuePair<string, JsonNode?>? GetProperty(JsonObject obj, string name)
+    {
+        foreach (var pair in obj)
+        {
+            if (string.Equals(pair.Key, name, StringComparison.OrdinalIgnoreCase))
+                return pair;
+        }
+        return null;
+    }
+}
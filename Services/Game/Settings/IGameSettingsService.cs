@@ -0,0 +1,72 @@
+using System.Text.Json.Nodes;
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Settings;
+
+/// <summary>
+/// Reads and edits the game client's own settings files in an instance's UserData folder.
+/// </summary>
+public interface IGameSettingsService
+{
+    /// <summary>
+    /// Lists the settings files of an instance, relative to its UserData folder.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    List<string> GetSettingsFiles(string instancePath);
+
+    /// <summary>
+    /// Reads the common options (render distance, audio, display, key bindings) of an instance.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    GameSettings GetSettings(string instancePath);
+
+    /// <summary>
+    /// Writes the non-null fields of <paramref name="update"/>, keeping every other key in the files.
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="update">The options to change.</param>
+    /// <returns><c>true</c> if the settings were saved.</returns>
+    bool UpdateSettings(string instancePath, GameSettingsUpdate update);
+
+    /// <summary>
+    /// Reads a raw value by dotted path (for example <c>AudioSettings.MasterVolume</c>).
+    /// </summary>
+    /// <param name="instancePath">The instance directory.</param>
+    /// <param name="file">Settings file relative to UserData.</param>
+    /// <param name="path">Dotted property path.</param>
+    /// <returns>The value, or <c>null</c> when the file or key does not exist.</returns>
+    JsonNode? GetValue(string instancePath, string file, string path);
+
+    /// <summary>
+    /// Sets a raw value by dotted path, creating missing objects along the way.
+    /// </summary>
+    /// <returns><c>true</c> if the file was saved.</returns>
+    bool SetValue(string instancePath, string file, string path, JsonNode? value);
+
+    /// <summary>
+    /// Copies settings files from one instance to another, replacing the target's copies.
+    /// </summary>
+    /// <param name="sourceInstancePath">Instance to copy from.</param>
+    /// <param name="targetInstancePath">Instance to copy to.</param>
+    /// <param name="files">Files to copy, relative to UserData; all settings files when null.</param>
+    /// <returns>The number of files copied.</returns>
+    int CopySettings(string sourceInstancePath, string targetInstancePath, IEnumerable<string>? files = null);
+
+    /// <summary>
+    /// Gets the rules for copying settings into new instances.
+    /// </summary>
+    SettingsSyncConfig GetSyncConfig();
+
+    /// <summary>
+    /// Saves the rules for copying settings into new instances.
+    /// </summary>
+    void SetSyncConfig(SettingsSyncConfig config);
+
+    /// <summary>
+    /// Copies the settings files selected by <see cref="SettingsSyncConfig"/> into a new instance.
+    /// Does nothing when sync is disabled or the instance already has settings files.
+    /// </summary>
+    /// <param name="targetInstanceId">The newly created instance.</param>
+    /// <returns>The number of files copied.</returns>
+    int ApplySettingsSync(string targetInstanceId);
+}
@@ -2,6 +2,7 @@ using System.Net.Http.Headers;
 using System.Text.Json;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
 using HyPrism.Services.User;
 
 namespace HyPrism.Services.Game.Sources;
@@ -19,32 +20,35 @@ internal class HytaleAuthExpiredException : Exception
 /// Requires an authenticated Hytale account with a purchased game.
 /// </summary>
 /// <remarks>
-/// Endpoint: https://account-data.hytale.com/patches/{os}/{arch}/{channel}/{from_build}
+/// Endpoint: <see cref="EndpointConfig.PatchesApi"/>, by default https://account-data.hytale.com/patches/{os}/{arch}/{channel}/{from_build}
 /// The official API returns patch steps with signed download URLs.
 /// Automatically refreshes access token on auth errors.
 /// </remarks>
 public class HytaleVersionSource : IVersionSource
 {
-    private const string PatchesApiBaseUrl = "https://account-data.hytale.com/patches";
     private const string PatchesCacheFileName = "patches.json";
     private static readonly TimeSpan CacheTtl = TimeSpan.FromMinutes(15);
     private const int MaxAuthRetries = 2;
 
     private readonly string _appDir;
     private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
     private readonly HytaleAuthService _authService;
     private readonly IConfigService _configService;
+    private readonly IEndpointService _endpoints;
     private readonly SemaphoreSlim _fetchLock = new(1, 1);
 
     // In-memory cache: cacheKey -> (timestamp, response)
     private readonly Dictionary<string, (DateTime CachedAt, OfficialPatchesResponse Response)> _cache = new();
 
-    public HytaleVersionSource(string appDir, HttpClient httpClient, HytaleAuthService authService, IConfigService configService)
+    public HytaleVersionSource(string appDir, HttpClient httpClient, NetworkPolicy networkPolicy, HytaleAuthService authService, IConfigService configService, IEndpointService endpoints)
     {
         _appDir = appDir;
         _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
         _authService = authService;
         _configService = configService;
+        _endpoints = endpoints;
     }
 
     #region IVersionSource Implementation
@@ -285,15 +289,13 @@ public class HytaleVersionSource : IVersionSource
                 return cached.Response;
             }
 
-            string url = $"{PatchesApiBaseUrl}/{os}/{arch}/{branch}/{fromBuild}";
+            string url = EndpointConfig.Expand(_endpoints.Current.PatchesApi, ("os", os), ("arch", arch), ("branch", branch), ("fromBuild", fromBuild));
             Logger.Info("HytaleSource", $"Fetching patches from {url}...");
 
             using var request = new HttpRequestMessage(HttpMethod.Get, url);
             request.Headers.Authorization = new AuthenticationHeaderValue("Bearer", accessToken);
-            request.Headers.Add("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36");
 
-            using var cts = CancellationTokenSource.CreateLinkedTokenSource(ct);
-            cts.CancelAfter(TimeSpan.FromSeconds(30));
+            using var cts = _networkPolicy.CreateTimeout(RequestClass.Metadata, ct);
 
             var response = await _httpClient.SendAsync(request, cts.Token);
 
@@ -2,6 +2,7 @@ using System.Text.Json;
 using System.Text.Json.Serialization;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
 
 namespace HyPrism.Services.Game.Sources;
 
@@ -20,10 +21,11 @@ namespace HyPrism.Services.Game.Sources;
 /// </remarks>
 public class MirrorVersionSource : IVersionSource
 {
-    private const string MirrorApiUrl = "https://thecute.cloud/ShipOfYarn/api.php";
     private static readonly TimeSpan CacheTtl = TimeSpan.FromMinutes(30);
 
     private readonly HttpClient _httpClient;
+    private readonly NetworkPolicy _networkPolicy;
+    private readonly IEndpointService _endpoints;
     private readonly string _mirrorId;
     private readonly SemaphoreSlim _fetchLock = new(1, 1);
 
@@ -36,9 +38,11 @@ public class MirrorVersionSource : IVersionSource
     /// </summary>
     private readonly Dictionary<string, Dictionary<string, string>> _cachedUrlsByBranch = new(StringComparer.OrdinalIgnoreCase);
 
-    public MirrorVersionSource(HttpClient httpClient, string mirrorId = "default")
+    public MirrorVersionSource(HttpClient httpClient, NetworkPolicy networkPolicy, IEndpointService endpoints, string mirrorId = "default")
     {
         _httpClient = httpClient;
+        _networkPolicy = networkPolicy;
+        _endpoints = endpoints;
         _mirrorId = mirrorId;
     }
 
@@ -255,12 +259,12 @@ public class MirrorVersionSource : IVersionSource
             if (_cachedIndex != null && DateTime.UtcNow - _cachedAt < CacheTtl)
                 return _cachedIndex;
 
-            Logger.Info("MirrorSource", $"Fetching mirror index from {MirrorApiUrl}...");
+            var indexUrl = _endpoints.Current.MirrorIndex;
+            Logger.Info("MirrorSource", $"Fetching mirror index from {indexUrl}...");
 
-            using var cts = CancellationTokenSource.CreateLinkedTokenSource(ct);
-            cts.CancelAfter(TimeSpan.FromSeconds(15));
+            using var cts = _networkPolicy.CreateTimeout(RequestClass.Metadata, ct);
 
-            var response = await _httpClient.GetAsync(MirrorApiUrl, cts.Token);
+            var response = await _httpClient.GetAsync(indexUrl, cts.Token);
 
             if (!response.IsSuccessStatusCode)
             {
@@ -0,0 +1,130 @@
+using System.Net.Http;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Integration;
+
+namespace HyPrism.Services.Game.Version;
+
+/// <summary>
+/// Serves per-version patch notes from a community changelog feed, falling back to Hytale blog posts.
+/// </summary>
+/// <remarks>
+/// The feed (<see cref="Config.ChangelogFeedUrl"/>) is a JSON array, or an object with an <c>entries</c> array,
+/// of <c>{ branch, version, title, date, url, notes[] }</c>. It is cached for <see cref="FeedTtl"/>, and the last
+/// copy is kept if a refresh fails. Without a feed entry, a Hytale blog post whose title names the version
+/// (for example "Update 5" or "Patch Notes v5") is used, with its excerpt as the summary.
+/// </remarks>
+public class ChangelogService : IChangelogService
+{
+    private static readonly TimeSpan FeedTtl = TimeSpan.FromMinutes(30);
+    private static readonly JsonSerializerOptions FeedJsonOptions = new() { PropertyNameCaseInsensitive = true };
+
+    private readonly HttpClient _httpClient;
+    private readonly IConfigService _configService;
+    private readonly INewsService _newsService;
+    private readonly SemaphoreSlim _feedLock = new(1, 1);
+
+    private List<GameChangelog> _feed = new();
+    private string _feedUrl = "";
+    private DateTime _feedFetchedAtUtc = DateTime.MinValue;
+
+    /// <summary>
+    /// Initializes a new instance of the <see cref="ChangelogService"/> class.
+    /// </summary>
+    /// <param name="httpClient">The HTTP client for fetching the feed.</param>
+    /// <param name="configService">Holds the feed URL.</param>
+    /// <param name="newsService">Provides Hytale blog posts for the fallback.</param>
+    public ChangelogService(HttpClient httpClient, IConfigService configService, INewsService newsService)
+    {
+        _httpClient = httpClient;
+        _configService = configService;
+        _newsService = newsService;
+    }
+
+    /// <inheritdoc/>
+    public async Task<GameChangelog?> GetGameChangelogAsync(string branch, int version, CancellationToken ct = default)
+    {
+        var normalizedBranch = UtilityService.NormalizeVersionType(branch);
+
+        var feed = await GetFeedAsync(ct);
+        var entry = feed.FirstOrDefault(e => e.Version == version
+            && UtilityService.NormalizeVersionType(e.Branch) == normalizedBranch);
+        if (entry != null) return entry;
+
+        return await FindBlogPostAsync(normalizedBranch, version);
+    }
+
+    private async Task<List<GameChangelog>> GetFeedAsync(CancellationToken ct)
+    {
+        var url = _configService.Configuration.ChangelogFeedUrl?.Trim() ?? "";
+        if (string.IsNullOrEmpty(url)) return new List<GameChangelog>();
+
+        await _feedLock.WaitAsync(ct);
+        try
+        {
+            if (url == _feedUrl && DateTime.UtcNow - _feedFetchedAtUtc < FeedTtl) return _feed;
+
+            try
+            {
+                var json = await _httpClient.GetStringAsync(url, ct);
+                _feed = ParseFeed(json);
+                Logger.Info("Changelog", $"Loaded {_feed.Count} changelog entries from {url}");
+            }
+            catch (Exception ex) when (ex is not OperationCanceledException)
+            {
+                Logger.Warning("Changelog", $"Failed to fetch changelog feed: {ex.Message}");
+                if (url != _feedUrl) _feed = new List<GameChangelog>();
+            }
+
+            // Failed fetches are not retried until the TTL passes either
+            _feedUrl = url;
+            _feedFetchedAtUtc = DateTime.UtcNow;
+            return _feed;
+        }
+        finally
+        {
+            _feedLock.Release();
+        }
+    }
+
+    private static List<GameChangelog> ParseFeed(string json)
+    {
+        using var doc = JsonDocument.Parse(json);
+        var entries = doc.RootElement.ValueKind == JsonValueKind.Array
+            ? doc.RootElement
+            : doc.RootElement.GetProperty("entries");
+
+        var result = entries.Deserialize<List<GameChangelog>>(FeedJsonOptions) ?? new List<GameChangelog>();
+        foreach (var entry in result) entry.Source = "feed";
+        return result.Where(e => e.Version > 0).ToList();
+    }
+
+    private async Task<GameChangelog?> FindBlogPostAsync(string branch, int version)
+    {
+        try
+        {
+            var pattern = new Regex($@"\b(update|patch|hotfix|version|build|v)\s*#?\s*{version}\b", RegexOptions.IgnoreCase);
+            var posts = await _newsService.GetNewsAsync(50, NewsSource.Hytale);
+            var post = posts.FirstOrDefault(p => pattern.IsMatch(p.Title));
+            if (post == null) return null;
+
+            return new GameChangelog
+            {
+                Branch = branch,
+                Version = version,
+                Title = post.Title,
+                Date = post.Date,
+                Url = post.Url,
+                Summary = post.Excerpt,
+                Source = "hytale"
+            };
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Changelog", $"Failed to search Hytale news for v{version}: {ex.Message}");
+            return null;
+        }
+    }
+}
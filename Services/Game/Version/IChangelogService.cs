@@ -0,0 +1,18 @@
+using HyPrism.Models;
+
+namespace HyPrism.Services.Game.Version;
+
+/// <summary>
+/// Looks up the patch notes of a game version so they can be shown before switching to it.
+/// </summary>
+public interface IChangelogService
+{
+    /// <summary>
+    /// Gets the patch notes of a version.
+    /// </summary>
+    /// <param name="branch">The game branch.</param>
+    /// <param name="version">The version number.</param>
+    /// <param name="ct">Token to cancel the request.</param>
+    /// <returns>The changelog, or <c>null</c> if no source has notes for this version.</returns>
+    Task<GameChangelog?> GetGameChangelogAsync(string branch, int version, CancellationToken ct = default);
+}
@@ -24,6 +24,20 @@ public interface IVersionService
     /// <returns><c>true</c> if valid cached data was found; otherwise, <c>false</c>.</returns>
     bool TryGetCachedVersions(string branch, TimeSpan maxAge, out List<int> versions);
 
+    /// <summary>
+    /// Raised with the branch and its new version list when a fetch finds a list different from the previous one.
+    /// </summary>
+    event Action<string, List<int>>? VersionsChanged;
+
+    /// <summary>
+    /// Returns the last known versions of a branch from the on-disk cache, however old, and refreshes them in the
+    /// background when the cache is stale. Lets the UI start without waiting for the network.
+    /// </summary>
+    /// <param name="branch">The game branch.</param>
+    /// <param name="versions">The last known versions, sorted descending.</param>
+    /// <returns><c>true</c> if any versions were cached; otherwise, <c>false</c>.</returns>
+    bool TryGetLastKnownVersions(string branch, out List<int> versions);
+
     /// <summary>
     /// Checks if the latest installed version needs an update.
     /// </summary>
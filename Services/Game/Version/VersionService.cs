@@ -1,7 +1,9 @@
+using System.Collections.Concurrent;
 using System.Text.Json;
 using System.Runtime.InteropServices;
 using HyPrism.Models;
 using HyPrism.Services.Core.Infrastructure;
+using HyPrism.Services.Core.Platform;
 using HyPrism.Services.Game.Sources;
 
 namespace HyPrism.Services.Game.Version;
@@ -12,8 +14,12 @@ namespace HyPrism.Services.Game.Version;
 /// </summary>
 /// <remarks>
 /// Version information is cached to avoid excessive network requests.
-/// Fetches from ALL sources and stores them separately, merging for queries.
-/// Sources are queried by priority (official first, then mirrors).
+/// Fetches from ALL sources in parallel and stores them separately, merging for queries.
+/// Results are merged by priority (official first, then mirrors).
+/// The merged list of each branch is also remembered for <see cref="RecentFetchTtl"/>, even when
+/// empty, so screens that ask repeatedly do not hit unreachable sources on every call.
+/// At startup <see cref="TryGetLastKnownVersions"/> serves the on-disk snapshot regardless of its age and
+/// refreshes it in the background; <see cref="VersionsChanged"/> reports a refresh that changed the list.
 /// </remarks>
 public class VersionService : IVersionService
 {
@@ -22,6 +28,14 @@ public class VersionService : IVersionService
     private readonly List<IVersionSource> _sources;
     private readonly SemaphoreSlim _versionFetchLock = new(1, 1);
 
+    private static readonly TimeSpan SnapshotMaxAge = TimeSpan.FromMinutes(15);
+    private static readonly TimeSpan RecentFetchTtl = TimeSpan.FromSeconds(60);
+
+    /// <summary>
+    /// Last merged version list per branch and when it was fetched.
+    /// </summary>
+    private readonly ConcurrentDictionary<string, (DateTime FetchedAtUtc, List<int> Versions)> _recentFetches = new();
+
     // Keep direct references for source-specific operations
     private readonly HytaleVersionSource? _hytaleSource;
     private readonly MirrorVersionSource? _mirrorSource;
@@ -31,6 +45,14 @@ public class VersionService : IVersionService
     /// </summary>
     private VersionsCacheSnapshot? _memoryCache;
 
+    /// <summary>
+    /// Branches with a background refresh in flight.
+    /// </summary>
+    private readonly ConcurrentDictionary<string, byte> _backgroundRefreshes = new();
+
+    /// <inheritdoc/>
+    public event Action<string, List<int>>? VersionsChanged;
+
     /// <summary>
     /// Initializes a new instance of the <see cref="VersionService"/> class.
     /// </summary>
@@ -67,23 +89,25 @@ public class VersionService : IVersionService
     /// </summary>
     public bool HasOfficialAccount => _hytaleSource?.IsAvailable ?? false;
 
+    /// <summary>
+    /// Architecture of the client builds listed and downloaded; an unsupported machine still lists the x64
+    /// versions so the install fails with a clear error instead of an empty list.
+    /// </summary>
+    private static string GameArch => PlatformSupport.GetArtifactArch(PlatformSupport.GameClient) ?? "amd64";
+
     /// <inheritdoc/>
     public async Task<List<int>> GetVersionListAsync(string branch, CancellationToken ct = default)
     {
         var normalizedBranch = NormalizeBranch(branch);
         string osName = UtilityService.GetOS();
-        string arch = UtilityService.GetArch();
+        string arch = GameArch;
 
         // Fast path: return from cache without locking
-        var freshCache = TryLoadFreshCache(osName, arch, TimeSpan.FromMinutes(15));
-        if (freshCache != null)
+        var cached = TryGetCachedResult(normalizedBranch, osName, arch);
+        if (cached != null)
         {
-            var versions = GetMergedVersionList(freshCache, normalizedBranch);
-            if (versions.Count > 0)
-            {
-                Logger.Info("Version", $"Using cached versions for {branch}: [{string.Join(", ", versions)}]");
-                return versions;
-            }
+            Logger.Debug("Version", $"Using cached versions for {branch}: [{string.Join(", ", cached)}]");
+            return cached;
         }
 
         // Serialize network fetches so parallel callers don't duplicate work
@@ -91,14 +115,10 @@ public class VersionService : IVersionService
         try
         {
             // Re-check cache: another caller may have populated it while we waited
-            freshCache = TryLoadFreshCache(osName, arch, TimeSpan.FromMinutes(15));
-            if (freshCache != null)
+            cached = TryGetCachedResult(normalizedBranch, osName, arch);
+            if (cached != null)
             {
-                var versions = GetMergedVersionList(freshCache, normalizedBranch);
-                if (versions.Count > 0)
-                {
-                    return versions;
-                }
+                return cached;
             }
 
             return await FetchVersionListCoreAsync(normalizedBranch, osName, arch, ct);
@@ -109,10 +129,36 @@ public class VersionService : IVersionService
         }
     }
 
+    /// <summary>
+    /// Returns the versions from a fresh snapshot, or the recent result of a fetch that found nothing.
+    /// </summary>
+    private List<int>? TryGetCachedResult(string normalizedBranch, string osName, string arch)
+    {
+        var freshCache = TryLoadFreshCache(osName, arch, SnapshotMaxAge);
+        if (freshCache != null)
+        {
+            var versions = GetMergedVersionList(freshCache, normalizedBranch);
+            if (versions.Count > 0)
+            {
+                return versions;
+            }
+        }
+
+        if (_recentFetches.TryGetValue(normalizedBranch, out var recent)
+            && DateTime.UtcNow - recent.FetchedAtUtc < RecentFetchTtl)
+        {
+            return new List<int>(recent.Versions);
+        }
+
+        return null;
+    }
+
     private async Task<List<int>> FetchVersionListCoreAsync(string normalizedBranch, string osName, string arch, CancellationToken ct)
     {
         // Load existing cache or create new
-        var snapshot = LoadCacheSnapshot() ?? new VersionsCacheSnapshot
+        var previous = LoadCacheSnapshot();
+        var previousVersions = previous != null ? GetMergedVersionList(previous, normalizedBranch) : [];
+        var snapshot = previous ?? new VersionsCacheSnapshot
         {
             Os = osName,
             Arch = arch,
@@ -125,49 +171,38 @@ public class VersionService : IVersionService
         snapshot.Arch = arch;
         snapshot.FetchedAtUtc = DateTime.UtcNow;
 
-        // Fetch from ALL sources using IVersionSource interface
-        foreach (var source in _sources)
+        // Query ALL sources at once; a slow mirror shouldn't hold up the official API
+        var available = _sources.Where(source =>
+        {
+            if (source.IsAvailable) return true;
+            Logger.Debug("Version", $"Source {source.SourceId} not available, skipping");
+            return false;
+        }).ToList();
+        var results = await Task.WhenAll(available.Select(source => FetchFromSourceAsync(source, normalizedBranch, osName, arch, ct)));
+
+        // Store in priority order (_sources is sorted)
+        for (int i = 0; i < available.Count; i++)
         {
-            if (!source.IsAvailable)
+            var source = available[i];
+            var versions = results[i];
+            if (versions == null || versions.Count == 0) continue;
+
+            // Store in appropriate cache based on source type
+            if (source.Type == VersionSourceType.Official)
             {
-                Logger.Debug("Version", $"Source {source.SourceId} not available, skipping");
-                continue;
+                snapshot.Data.Hytale ??= new OfficialSourceCache();
+                snapshot.Data.Hytale.Branches[normalizedBranch] = versions;
             }
-
-            Logger.Info("Version", $"Fetching from {source.SourceId} for {normalizedBranch}...");
-            try
+            else
             {
-                var versions = await source.GetVersionsAsync(osName, arch, normalizedBranch, ct);
-                if (versions.Count > 0)
-                {
-                    // Store in appropriate cache based on source type
-                    if (source.Type == VersionSourceType.Official)
-                    {
-                        snapshot.Data.Hytale ??= new OfficialSourceCache();
-                        snapshot.Data.Hytale.Branches[normalizedBranch] = versions;
-                    }
-                    else
-                    {
-                        // Mirror source
-                        var mirrorCache = snapshot.Data.Mirrors.FirstOrDefault(m => m.MirrorId == source.SourceId);
-                        if (mirrorCache == null)
-                        {
-                            mirrorCache = new MirrorSourceCache { MirrorId = source.SourceId };
-                            snapshot.Data.Mirrors.Add(mirrorCache);
-                        }
-                        mirrorCache.Branches[normalizedBranch] = versions;
-                    }
-                    
-                    Logger.Success("Version", $"{source.SourceId} returned {versions.Count} versions for {normalizedBranch}: [{string.Join(", ", versions.Select(v => v.Version))}]");
-                }
-                else
+                // Mirror source
+                var mirrorCache = snapshot.Data.Mirrors.FirstOrDefault(m => m.MirrorId == source.SourceId);
+                if (mirrorCache == null)
                 {
-                    Logger.Warning("Version", $"{source.SourceId} returned no versions for {normalizedBranch}");
+                    mirrorCache = new MirrorSourceCache { MirrorId = source.SourceId };
+                    snapshot.Data.Mirrors.Add(mirrorCache);
                 }
-            }
-            catch (Exception ex)
-            {
-                Logger.Warning("Version", $"{source.SourceId} fetch failed for {normalizedBranch}: {ex.Message}");
+                mirrorCache.Branches[normalizedBranch] = versions;
             }
         }
 
@@ -177,10 +212,39 @@ public class VersionService : IVersionService
 
         // Return merged version list
         var result = GetMergedVersionList(snapshot, normalizedBranch);
+        _recentFetches[normalizedBranch] = (DateTime.UtcNow, new List<int>(result));
         Logger.Info("Version", $"Total versions for {normalizedBranch}: [{string.Join(", ", result)}]");
+        if (!result.SequenceEqual(previousVersions))
+        {
+            VersionsChanged?.Invoke(normalizedBranch, new List<int>(result));
+        }
         return result;
     }
 
+    private static async Task<List<CachedVersionEntry>?> FetchFromSourceAsync(
+        IVersionSource source, string normalizedBranch, string osName, string arch, CancellationToken ct)
+    {
+        Logger.Info("Version", $"Fetching from {source.SourceId} for {normalizedBranch}...");
+        try
+        {
+            var versions = await source.GetVersionsAsync(osName, arch, normalizedBranch, ct);
+            if (versions.Count > 0)
+            {
+                Logger.Success("Version", $"{source.SourceId} returned {versions.Count} versions for {normalizedBranch}: [{string.Join(", ", versions.Select(v => v.Version))}]");
+            }
+            else
+            {
+                Logger.Warning("Version", $"{source.SourceId} returned no versions for {normalizedBranch}");
+            }
+            return versions;
+        }
+        catch (Exception ex) when (!ct.IsCancellationRequested)
+        {
+            Logger.Warning("Version", $"{source.SourceId} fetch failed for {normalizedBranch}: {ex.Message}");
+            return null;
+        }
+    }
+
     /// <summary>
     /// Gets merged version list from cache, preferring official source for duplicates.
     /// </summary>
@@ -217,7 +281,7 @@ public class VersionService : IVersionService
         versions = new List<int>();
         var normalizedBranch = NormalizeBranch(branch);
         string osName = UtilityService.GetOS();
-        string arch = UtilityService.GetArch();
+        string arch = GameArch;
 
         var cached = TryLoadFreshCache(osName, arch, maxAge);
         if (cached == null)
@@ -229,6 +293,56 @@ public class VersionService : IVersionService
         return versions.Count > 0;
     }
 
+    /// <inheritdoc/>
+    public bool TryGetLastKnownVersions(string branch, out List<int> versions)
+    {
+        versions = new List<int>();
+        var normalizedBranch = NormalizeBranch(branch);
+        string osName = UtilityService.GetOS();
+        string arch = GameArch;
+
+        if (TryGetCachedResult(normalizedBranch, osName, arch) is { } fresh)
+        {
+            versions = fresh;
+            return versions.Count > 0;
+        }
+
+        var snapshot = _memoryCache ?? LoadCacheSnapshot();
+        if (snapshot != null
+            && string.Equals(snapshot.Os, osName, StringComparison.OrdinalIgnoreCase)
+            && string.Equals(snapshot.Arch, arch, StringComparison.OrdinalIgnoreCase))
+        {
+            versions = GetMergedVersionList(snapshot, normalizedBranch);
+        }
+
+        RefreshInBackground(normalizedBranch);
+        return versions.Count > 0;
+    }
+
+    /// <summary>
+    /// Fetches a branch's versions without waiting; the result reaches callers through the cache and <see cref="VersionsChanged"/>.
+    /// </summary>
+    private void RefreshInBackground(string normalizedBranch)
+    {
+        if (!_backgroundRefreshes.TryAdd(normalizedBranch, 0)) return;
+
+        _ = Task.Run(async () =>
+        {
+            try
+            {
+                await GetVersionListAsync(normalizedBranch);
+            }
+            catch (Exception ex)
+            {
+                Logger.Warning("Version", $"Background refresh failed for {normalizedBranch}: {ex.Message}");
+            }
+            finally
+            {
+                _backgroundRefreshes.TryRemove(normalizedBranch, out _);
+            }
+        });
+    }
+
     /// <summary>
     /// Gets version list with source information (official vs mirror).
     /// </summary>
@@ -452,10 +566,11 @@ public class VersionService : IVersionService
     {
         var normalizedBranch = NormalizeBranch(branch);
         string osName = UtilityService.GetOS();
-        string arch = UtilityService.GetArch();
+        string arch = GameArch;
         
-        // Clear memory cache to force re-fetch
+        // Clear memory caches to force re-fetch
         _memoryCache = null;
+        _recentFetches.TryRemove(normalizedBranch, out _);
         
         await _versionFetchLock.WaitAsync(ct);
         try
@@ -22,7 +22,7 @@ public interface IProfileManagementService
     /// <summary>
     /// Creates a new profile with the specified name and UUID.
     /// </summary>
-    /// <param name="name">The profile name (1-16 characters).</param>
+    /// <param name="name">The profile name; must pass <see cref="NicknameValidator"/>.</param>
     /// <param name="uuid">The UUID for the profile.</param>
     /// <returns>The created profile, or null if creation failed.</returns>
     Profile? CreateProfile(string name, string uuid);
@@ -16,10 +16,17 @@ public interface IProfileService
     /// <summary>
     /// Sets the current user's nickname.
     /// </summary>
-    /// <param name="nick">The new nickname (max 16 characters).</param>
+    /// <param name="nick">The new nickname; must pass <see cref="ValidateNick"/>.</param>
     /// <returns>True if the nickname was set successfully; otherwise, false.</returns>
     bool SetNick(string nick);
 
+    /// <summary>
+    /// Checks a nickname against the configured nickname rules without saving it.
+    /// </summary>
+    /// <param name="nick">The nickname to check.</param>
+    /// <returns>The result, with the message ID of the first broken rule.</returns>
+    NicknameValidationResult ValidateNick(string nick);
+
     /// <summary>
     /// Gets the current user's UUID.
     /// </summary>
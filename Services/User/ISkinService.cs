@@ -58,4 +58,21 @@ public interface ISkinService : IDisposable
     /// <param name="uuid">The source UUID.</param>
     /// <param name="profileDir">The destination profile directory.</param>
     void CopyProfileSkinData(string uuid, string profileDir);
+
+    /// <summary>
+    /// Gets the skins a profile had saved before, newest first. A skin is added each time a backup
+    /// replaces the profile's saved skin with a different one; only the most recent are kept.
+    /// </summary>
+    /// <param name="profileId">The profile ID.</param>
+    /// <returns>The history, empty if the profile is unknown or has none.</returns>
+    List<SkinHistoryEntry> GetSkinHistory(string profileId);
+
+    /// <summary>
+    /// Puts a skin from the history back as the profile's saved skin, and into the game if the profile is
+    /// active. The replaced skin is added to the history, so an undo can be undone.
+    /// </summary>
+    /// <param name="profileId">The profile ID.</param>
+    /// <param name="entryId">The history entry to restore; <c>null</c> restores the newest.</param>
+    /// <returns><c>true</c> if a skin was restored.</returns>
+    bool UndoSkinChange(string profileId, string? entryId = null);
 }
@@ -0,0 +1,75 @@
+using System.Text;
+using System.Text.RegularExpressions;
+using HyPrism.Models;
+using HyPrism.Services.Core.App;
+using HyPrism.Services.Core.Infrastructure;
+
+namespace HyPrism.Services.User;
+
+/// <summary>
+/// Checks player nicknames against the configured <see cref="NicknameRules"/>.
+/// </summary>
+/// <remarks>
+/// Used wherever a nickname is accepted (onboarding, profile edits) and again before a launch, since a
+/// nickname saved under older or hand-edited rules reaches the game server otherwise. Leading and trailing
+/// whitespace is not trimmed here; callers trim input they consider user-typed.
+/// </remarks>
+public static class NicknameValidator
+{
+    private static readonly NicknameRules Defaults = new();
+
+    /// <summary>
+    /// Validates <paramref name="nickname"/> and reports the first rule it breaks.
+    /// </summary>
+    /// <param name="nickname">The nickname to check.</param>
+    /// <param name="rules">The rules to apply; <c>null</c> uses the defaults.</param>
+    public static NicknameValidationResult Validate(string? nickname, NicknameRules? rules)
+    {
+        rules ??= Defaults;
+        var nick = nickname ?? "";
+        int min = Math.Max(1, rules.MinLength);
+        int max = Math.Max(min, rules.MaxLength);
+
+        if (nick.Length < min || nick.Length > max)
+            return Reject(MessageCatalog.ErrorInvalidNickname, min, max);
+
+        var allowed = BuildCharacterPattern(rules.AllowedCharacters);
+        foreach (var rune in nick.EnumerateRunes())
+        {
+            var ch = rune.ToString();
+            bool ok = allowed?.IsMatch(ch) ?? !(Rune.IsWhiteSpace(rune) || Rune.IsControl(rune));
+            if (!ok)
+                return Reject(MessageCatalog.ErrorNicknameCharacters, ch);
+        }
+
+        if (rules.ReservedNames?.Any(r => string.Equals(r?.Trim(), nick, StringComparison.OrdinalIgnoreCase)) == true)
+            return Reject(MessageCatalog.ErrorNicknameReserved, nick);
+
+        return new NicknameValidationResult { Valid = true };
+    }
+
+    /// <summary>
+    /// Anchors the configured single-character pattern; a broken pattern falls back to the default one.
+    /// </summary>
+    private static Regex? BuildCharacterPattern(string? pattern)
+    {
+        if (string.IsNullOrWhiteSpace(pattern)) return null;
+        try
+        {
+            return new Regex($"^(?:{pattern})$", RegexOptions.CultureInvariant, TimeSpan.FromMilliseconds(100));
+        }
+        catch (ArgumentException ex)
+        {
+            Logger.Warning("Profile", $"Invalid NicknameRules.AllowedCharacters pattern '{pattern}': {ex.Message}");
+            return new Regex($"^(?:{Defaults.AllowedCharacters})$", RegexOptions.CultureInvariant);
+        }
+    }
+
+    private static NicknameValidationResult Reject(string messageKey, params object[] args) => new()
+    {
+        Valid = false,
+        MessageKey = messageKey,
+        Args = args,
+        Message = MessageCatalog.Format(messageKey, args)
+    };
+}
@@ -74,7 +74,7 @@ public class ProfileManagementService : IProfileManagementService
     }
 
     /// <inheritdoc/>
-    /// <remarks>Validates name length (1-16 characters) and UUID format before creation.</remarks>
+    /// <remarks>Validates the name against the nickname rules and the UUID format before creation.</remarks>
     public Profile? CreateProfile(string name, string uuid)
     {
         try
@@ -85,11 +85,11 @@ public class ProfileManagementService : IProfileManagementService
                 return null;
             }
             
-            // Validate name length (1-16 characters)
             var trimmedName = name.Trim();
-            if (trimmedName.Length < 1 || trimmedName.Length > 16)
+            var validation = NicknameValidator.Validate(trimmedName, _configService.Configuration.NicknameRules);
+            if (!validation.Valid)
             {
-                Logger.Warning("Profile", $"Invalid name length: {trimmedName.Length} (must be 1-16 chars)");
+                Logger.Warning("Profile", $"Invalid profile name '{trimmedName}': {validation.Message}");
                 return null;
             }
             
@@ -242,6 +242,12 @@ public class ProfileManagementService : IProfileManagementService
             
             if (!string.IsNullOrWhiteSpace(newName))
             {
+                var validation = NicknameValidator.Validate(newName.Trim(), config.NicknameRules);
+                if (!validation.Valid)
+                {
+                    Logger.Warning("Profile", $"Invalid profile name '{newName.Trim()}': {validation.Message}");
+                    return false;
+                }
                 profile.Name = newName.Trim();
             }
             
@@ -606,6 +612,14 @@ public class ProfileManagementService : IProfileManagementService
                 }
             }
             
+            // xdg-open cannot open folders from inside Flatpak
+            if (UtilityService.IsFlatpak())
+            {
+                if (!FileService.OpenFolderInExplorer(profileDir)) return false;
+                Logger.Success("Profile", $"Opened profile folder: {profileDir}");
+                return true;
+            }
+
             // Open folder in file manager (cross-platform) — use ProcessStartInfo to handle paths with spaces
             var psi = new ProcessStartInfo();
             if (RuntimeInformation.IsOSPlatform(OSPlatform.Windows))
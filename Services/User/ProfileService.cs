@@ -33,8 +33,12 @@ public class ProfileService : IProfileService
     /// <inheritdoc/>
     public bool SetNick(string nick)
     {
-        if (string.IsNullOrWhiteSpace(nick) || nick.Length > 16)
+        var validation = ValidateNick(nick);
+        if (!validation.Valid)
+        {
+            Logger.Warning("Profile", $"Rejected nickname '{nick}': {validation.Message}");
             return false;
+        }
         
         var config = _configService.Configuration;
         var oldNick = config.Nick;
@@ -60,6 +64,10 @@ public class ProfileService : IProfileService
         return true;
     }
     
+    /// <inheritdoc/>
+    public NicknameValidationResult ValidateNick(string nick) =>
+        NicknameValidator.Validate(nick, _configService.Configuration.NicknameRules);
+
     /// <summary>
     /// Renames the profile folder from old name to new name.
     /// </summary>
@@ -289,7 +297,7 @@ public class ProfileService : IProfileService
             }
             else if (OperatingSystem.IsLinux())
             {
-                System.Diagnostics.Process.Start("xdg-open", avatarDir);
+                FileService.OpenFolderInExplorer(avatarDir);
             }
             else if (OperatingSystem.IsMacOS())
             {
@@ -15,6 +15,10 @@ namespace HyPrism.Services.User;
 /// </remarks>
 public class SkinService : ISkinService
 {
+    private const string SkinHistoryFolder = "SkinHistory";
+    private const string SkinHistoryIdFormat = "yyyyMMdd'T'HHmmssfff";
+    private const int MaxSkinHistory = 10;
+
     // Skin protection: Watch for skin file overwrites during gameplay
     private FileSystemWatcher? _skinWatcher;
     private string? _protectedSkinPath;
@@ -487,6 +491,7 @@ public class SkinService : ISkinService
                     }
                 }
                 var skinJson = File.ReadAllText(skinPath);
+                ArchiveSavedSkin(profileDir, skinJson);
                 File.Copy(skinPath, destPath, true);
                 Logger.Info("Profile", $"Backed up skin for {profile.Name} ({skinJson.Length} bytes)");
             }
@@ -603,6 +608,123 @@ public class SkinService : ISkinService
 
     #endregion
 
+    #region Skin History
+
+    /// <inheritdoc/>
+    public List<SkinHistoryEntry> GetSkinHistory(string profileId)
+    {
+        var profile = _configService.Configuration.Profiles?.FirstOrDefault(p => p.Id == profileId);
+        if (profile == null) return new List<SkinHistoryEntry>();
+
+        var entries = new List<SkinHistoryEntry>();
+        foreach (var dir in GetSkinHistoryDirs(GetProfileDir(profile)))
+        {
+            var id = Path.GetFileName(dir);
+            if (!DateTime.TryParseExact(id, SkinHistoryIdFormat, System.Globalization.CultureInfo.InvariantCulture,
+                    System.Globalization.DateTimeStyles.AssumeUniversal | System.Globalization.DateTimeStyles.AdjustToUniversal, out var savedAt))
+                continue;
+
+            string? avatar = null;
+            var avatarPath = Path.Combine(dir, "avatar.png");
+            if (File.Exists(avatarPath) && new FileInfo(avatarPath).Length > 100)
+                avatar = $"data:image/png;base64,{Convert.ToBase64String(File.ReadAllBytes(avatarPath))}";
+
+            entries.Add(new SkinHistoryEntry { Id = id, SavedAtUtc = savedAt, Avatar = avatar });
+        }
+        return entries;
+    }
+
+    /// <inheritdoc/>
+    public bool UndoSkinChange(string profileId, string? entryId = null)
+    {
+        try
+        {
+            var profile = _configService.Configuration.Profiles?.FirstOrDefault(p => p.Id == profileId);
+            if (profile == null) return false;
+
+            var profileDir = GetProfileDir(profile);
+            var entryDir = entryId == null
+                ? GetSkinHistoryDirs(profileDir).FirstOrDefault()
+                // Only plain entry names, never a path out of the history folder
+                : Path.GetFileName(entryId) == entryId ? Path.Combine(profileDir, SkinHistoryFolder, entryId) : null;
+            var entrySkin = entryDir == null ? null : Path.Combine(entryDir, "skin.json");
+            if (entrySkin == null || !File.Exists(entrySkin))
+            {
+                Logger.Warning("Profile", $"No skin history entry {entryId ?? "(newest)"} for {profile.Name}");
+                return false;
+            }
+
+            // Read first: archiving the current skin may trim the oldest entry, which can be this one
+            var skinJson = File.ReadAllText(entrySkin);
+            var avatarPath = Path.Combine(entryDir!, "avatar.png");
+            var avatar = File.Exists(avatarPath) ? File.ReadAllBytes(avatarPath) : null;
+
+            ArchiveSavedSkin(profileDir, skinJson);
+
+            var destPath = Path.Combine(profileDir, "skin.json");
+            if (File.Exists(destPath)) new FileInfo(destPath).IsReadOnly = false;
+            File.WriteAllText(destPath, skinJson);
+            if (avatar != null) File.WriteAllBytes(Path.Combine(profileDir, "avatar.png"), avatar);
+
+            if (Directory.Exists(entryDir)) Directory.Delete(entryDir!, recursive: true);
+            Logger.Success("Profile", $"Restored skin from {Path.GetFileName(entryDir)} for {profile.Name}");
+
+            // The game reads the active profile's skin from its cache
+            if (profile.UUID == _configService.Configuration.UUID)
+                RestoreProfileSkinData(profile);
+
+            return true;
+        }
+        catch (Exception ex)
+        {
+            Logger.Error("Profile", $"Failed to undo skin change: {ex.Message}");
+            return false;
+        }
+    }
+
+    /// <summary>
+    /// Moves the profile's saved skin into the history before it is replaced, unless the new skin is the same.
+    /// </summary>
+    private void ArchiveSavedSkin(string profileDir, string newSkinJson)
+    {
+        try
+        {
+            var savedSkin = Path.Combine(profileDir, "skin.json");
+            if (!File.Exists(savedSkin) || File.ReadAllText(savedSkin) == newSkinJson) return;
+
+            var entryDir = Path.Combine(profileDir, SkinHistoryFolder, DateTime.UtcNow.ToString(SkinHistoryIdFormat));
+            Directory.CreateDirectory(entryDir);
+            File.Copy(savedSkin, Path.Combine(entryDir, "skin.json"), true);
+            new FileInfo(Path.Combine(entryDir, "skin.json")).IsReadOnly = false;
+
+            var savedAvatar = Path.Combine(profileDir, "avatar.png");
+            if (File.Exists(savedAvatar)) File.Copy(savedAvatar, Path.Combine(entryDir, "avatar.png"), true);
+
+            foreach (var old in GetSkinHistoryDirs(profileDir).Skip(MaxSkinHistory))
+                Directory.Delete(old, recursive: true);
+        }
+        catch (Exception ex)
+        {
+            Logger.Warning("Profile", $"Failed to keep the previous skin in history: {ex.Message}");
+        }
+    }
+
+    /// <summary>
+    /// Gets the history entry folders of a profile, newest first.
+    /// </summary>
+    private static IEnumerable<string> GetSkinHistoryDirs(string profileDir)
+    {
+        var historyDir = Path.Combine(profileDir, SkinHistoryFolder);
+        if (!Directory.Exists(historyDir)) return Enumerable.Empty<string>();
+        // Entry names are sortable timestamps
+        return Directory.GetDirectories(historyDir).OrderByDescending(d => Path.GetFileName(d), StringComparer.Ordinal);
+    }
+
+    private string GetProfileDir(Profile profile) =>
+        Path.Combine(GetProfilesFolder(), UtilityService.SanitizeFileName(profile.Name));
+
+    #endregion
+
     /// <summary>
     /// Releases resources used by the skin service, stopping any active skin protection.
     /// </summary>